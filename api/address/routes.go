@@ -2,34 +2,53 @@ package address
 
 import (
 	"encoding/hex"
+	errs "errors"
 	"fmt"
 	"math/big"
 	"net/http"
+	"strconv"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/gin-gonic/gin"
 )
 
 const (
-	getAccountPath  = "/:address"
-	getBalancePath  = "/:address/balance"
-	getUsernamePath = "/:address/username"
-	getKeyPath      = "/:address/key/:key"
-	getESDTTokens   = "/:address/esdt"
-	getESDTBalance  = "/:address/esdt/:tokenIdentifier"
+	getAccountPath      = "/:address"
+	getBalancePath      = "/:address/balance"
+	getUsernamePath     = "/:address/username"
+	getKeyPath          = "/:address/key/:key"
+	getESDTTokens       = "/:address/esdt"
+	getESDTBalance      = "/:address/esdt/:tokenIdentifier"
+	getESDTAllowance    = "/:address/esdt/:tokenIdentifier/allowance/:spender"
+	getNFTs             = "/:address/nft"
+	getNFT              = "/:address/nft/:tokenIdentifier/nonce/:nonce"
+	getTransactionsPath = "/:address/transactions"
+	getAccountProofPath = "/:address/proof"
+	getKeyProofPath     = "/:address/key/:key/proof"
+
+	defaultTransactionsLimit = 20
 )
 
 // FacadeHandler interface defines methods that can be used by the gin webserver
 type FacadeHandler interface {
-	GetBalance(address string) (*big.Int, error)
+	GetBalance(address string, options state.BlockQueryOptions) (*big.Int, error)
 	GetUsername(address string) (string, error)
-	GetValueForKey(address string, key string) (string, error)
-	GetAccount(address string) (state.UserAccountHandler, error)
+	GetValueForKey(address string, key string, options state.BlockQueryOptions) (string, error)
+	GetAccount(address string, options state.BlockQueryOptions) (state.UserAccountHandler, error)
 	GetESDTBalance(address string, key string) (string, string, error)
 	GetAllESDTTokens(address string) ([]string, error)
+	GetESDTAllowance(owner string, spender string, tokenName string) (string, error)
+	GetNFT(address string, tokenIdentifier string, nonce uint64) (*esdt.ApiNFTTokenData, error)
+	GetAllNFTs(address string) ([]*esdt.ApiNFTTokenData, error)
+	GetTransactionsByAddress(address string, cursor string, limit int) (*transaction.ApiTransactionsByAddress, error)
+	GetAccountProof(address string, options state.BlockQueryOptions) (*state.TrieProof, error)
+	GetKeyProof(address string, key string, options state.BlockQueryOptions) (*state.TrieProof, *state.TrieProof, error)
 	IsInterfaceNil() bool
 }
 
@@ -43,6 +62,11 @@ type accountResponse struct {
 	RootHash []byte `json:"rootHash"`
 }
 
+type trieProofResponse struct {
+	RootHash string   `json:"rootHash"`
+	Proof    []string `json:"proof"`
+}
+
 type esdtTokenData struct {
 	TokenIdentifier string `json:"tokenIdentifier"`
 	Balance         string `json:"balance"`
@@ -57,6 +81,12 @@ func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, getKeyPath, GetValueForKey)
 	router.RegisterHandler(http.MethodGet, getESDTBalance, GetESDTBalance)
 	router.RegisterHandler(http.MethodGet, getESDTTokens, GetESDTTokens)
+	router.RegisterHandler(http.MethodGet, getESDTAllowance, GetESDTAllowance)
+	router.RegisterHandler(http.MethodGet, getNFTs, GetNFTs)
+	router.RegisterHandler(http.MethodGet, getNFT, GetNFT)
+	router.RegisterHandler(http.MethodGet, getTransactionsPath, GetTransactions)
+	router.RegisterHandler(http.MethodGet, getAccountProofPath, GetAccountProof)
+	router.RegisterHandler(http.MethodGet, getKeyProofPath, GetKeyProof)
 }
 
 func getFacade(c *gin.Context) (FacadeHandler, bool) {
@@ -98,7 +128,20 @@ func GetAccount(c *gin.Context) {
 	}
 
 	addr := c.Param("address")
-	acc, err := facade.GetAccount(addr)
+	options, err := getBlockQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrCouldNotGetAccount.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	acc, err := facade.GetAccount(addr, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -141,7 +184,20 @@ func GetBalance(c *gin.Context) {
 		return
 	}
 
-	balance, err := facade.GetBalance(addr)
+	options, err := getBlockQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetBalance.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	balance, err := facade.GetBalance(addr, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -238,7 +294,20 @@ func GetValueForKey(c *gin.Context) {
 		return
 	}
 
-	value, err := facade.GetValueForKey(addr, key)
+	options, err := getBlockQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetValueForKey.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	value, err := facade.GetValueForKey(addr, key, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -323,6 +392,75 @@ func GetESDTBalance(c *gin.Context) {
 	)
 }
 
+// GetESDTAllowance returns the value a spender is allowed to transfer on behalf of the given address for an esdt token
+func GetESDTAllowance(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTAllowance.Error(), errors.ErrEmptyAddress.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	tokenIdentifier := c.Param("tokenIdentifier")
+	if tokenIdentifier == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTAllowance.Error(), errors.ErrEmptyKey.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	spender := c.Param("spender")
+	if spender == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTAllowance.Error(), errors.ErrEmptyAddress.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	allowance, err := facade.GetESDTAllowance(addr, spender, tokenIdentifier)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTAllowance.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"allowance": allowance},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 // GetESDTTokens returns the tokens list from this account
 func GetESDTTokens(c *gin.Context) {
 	facade, ok := getFacade(c)
@@ -366,6 +504,390 @@ func GetESDTTokens(c *gin.Context) {
 	)
 }
 
+// GetNFTs returns the non-fungible and semi-fungible tokens held by an account
+func GetNFTs(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetNFTs.Error(), errors.ErrEmptyAddress.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	tokens, err := facade.GetAllNFTs(addr)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetNFTs.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"tokens": tokens},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// GetNFT returns the data of a non-fungible or semi-fungible token nonce held by an account
+func GetNFT(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetNFT.Error(), errors.ErrEmptyAddress.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	tokenIdentifier := c.Param("tokenIdentifier")
+	if tokenIdentifier == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetNFT.Error(), errors.ErrEmptyKey.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	nonce, err := strconv.ParseUint(c.Param("nonce"), 10, 64)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetNFT.Error(), errors.ErrInvalidNFTNonce.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	tokenData, err := facade.GetNFT(addr, tokenIdentifier, nonce)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetNFT.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"tokenData": tokenData},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// GetTransactions returns a page of the transactions sent or received by the given address, starting from the
+// optional cursor query parameter
+func GetTransactions(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionsByAddress.Error(), errors.ErrEmptyAddress.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	limit, err := getQueryParamLimit(c)
+	if err != nil || limit <= 0 {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionsByAddress.Error(), errors.ErrInvalidLimit.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	cursor := c.Request.URL.Query().Get("cursor")
+	_, err = hex.DecodeString(cursor)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionsByAddress.Error(), errors.ErrInvalidCursor.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	transactions, err := facade.GetTransactionsByAddress(addr, cursor, limit)
+	if isTransactionsPageError(err) {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionsByAddress.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionsByAddress.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"transactions": transactions.Transactions, "nextCursor": transactions.NextCursor},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// isTransactionsPageError returns true if the provided error signals a limit above the maximum page size or a cursor
+// which can not point inside the transactions index, both being request errors
+func isTransactionsPageError(err error) bool {
+	return errs.Is(err, dblookupext.ErrInvalidLimit) || errs.Is(err, dblookupext.ErrInvalidCursor)
+}
+
+// GetAccountProof returns the Merkle proof of the account found at the provided address, which can be verified
+// against the state root hash without trusting the node
+func GetAccountProof(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), errors.ErrEmptyAddress.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	options, err := getBlockQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	accountProof, err := facade.GetAccountProof(addr, options)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"proof": newTrieProofResponse(accountProof)},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// GetKeyProof returns the Merkle proof of the account found at the provided address, together with the Merkle proof
+// of the provided key in the account's data trie
+func GetKeyProof(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), errors.ErrEmptyAddress.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	key := c.Param("key")
+	if key == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), errors.ErrEmptyKey.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	options, err := getBlockQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	accountProof, dataTrieProof, err := facade.GetKeyProof(addr, key, options)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data: gin.H{
+				"accountProof":  newTrieProofResponse(accountProof),
+				"dataTrieProof": newTrieProofResponse(dataTrieProof),
+			},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func newTrieProofResponse(trieProof *state.TrieProof) trieProofResponse {
+	proof := make([]string, 0, len(trieProof.Proof))
+	for _, encodedNode := range trieProof.Proof {
+		proof = append(proof, hex.EncodeToString(encodedNode))
+	}
+
+	return trieProofResponse{
+		RootHash: hex.EncodeToString(trieProof.RootHash),
+		Proof:    proof,
+	}
+}
+
+// getBlockQueryOptions reads the optional blockNonce and blockHash query parameters, used for selecting the block
+// at which the account state should be read
+func getBlockQueryOptions(c *gin.Context) (state.BlockQueryOptions, error) {
+	options := state.BlockQueryOptions{}
+
+	blockNonceStr := c.Request.URL.Query().Get("blockNonce")
+	if blockNonceStr != "" {
+		blockNonce, err := strconv.ParseUint(blockNonceStr, 10, 64)
+		if err != nil {
+			return state.BlockQueryOptions{}, errors.ErrInvalidBlockNonce
+		}
+
+		options.BlockNonce = blockNonce
+		options.HasBlockNonce = true
+	}
+
+	blockHashStr := c.Request.URL.Query().Get("blockHash")
+	if blockHashStr != "" {
+		blockHash, err := hex.DecodeString(blockHashStr)
+		if err != nil {
+			return state.BlockQueryOptions{}, fmt.Errorf("%w: blockHash", errors.ErrInvalidQueryParameter)
+		}
+
+		options.BlockHash = blockHash
+	}
+
+	if options.HasBlockNonce && len(options.BlockHash) > 0 {
+		return state.BlockQueryOptions{}, errors.ErrBlockNonceAndHashProvided
+	}
+
+	return options, nil
+}
+
+func getQueryParamLimit(c *gin.Context) (int, error) {
+	limitStr := c.Request.URL.Query().Get("limit")
+	if limitStr == "" {
+		return defaultTransactionsLimit, nil
+	}
+
+	return strconv.Atoi(limitStr)
+}
+
 func accountResponseFromBaseAccount(address string, account state.UserAccountHandler) accountResponse {
 	return accountResponse{
 		Address:  address,
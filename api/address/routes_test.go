@@ -1,6 +1,7 @@
 package address_test
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -18,7 +19,10 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -65,6 +69,16 @@ type esdtTokenResponse struct {
 	Code  string                `json:"code"`
 }
 
+type esdtAllowanceResponseData struct {
+	Allowance string `json:"allowance"`
+}
+
+type esdtAllowanceResponse struct {
+	Data  esdtAllowanceResponseData `json:"data"`
+	Error string                    `json:"error"`
+	Code  string                    `json:"code"`
+}
+
 type esdtTokensResponseData struct {
 	Tokens []string `json:"tokens"`
 }
@@ -75,6 +89,37 @@ type esdtTokensResponse struct {
 	Code  string
 }
 
+type nftTokensResponseData struct {
+	Tokens []*esdt.ApiNFTTokenData `json:"tokens"`
+}
+
+type nftTokensResponse struct {
+	Data  nftTokensResponseData `json:"data"`
+	Error string                `json:"error"`
+	Code  string                `json:"code"`
+}
+
+type nftTokenResponseData struct {
+	TokenData *esdt.ApiNFTTokenData `json:"tokenData"`
+}
+
+type nftTokenResponse struct {
+	Data  nftTokenResponseData `json:"data"`
+	Error string               `json:"error"`
+	Code  string               `json:"code"`
+}
+
+type transactionsResponseData struct {
+	Transactions []*transaction.ApiTransactionResult `json:"transactions"`
+	NextCursor   string                              `json:"nextCursor"`
+}
+
+type transactionsResponse struct {
+	Data  transactionsResponseData `json:"data"`
+	Error string                   `json:"error"`
+	Code  string                   `json:"code"`
+}
+
 type usernameResponseData struct {
 	Username string `json:"username"`
 }
@@ -128,7 +173,7 @@ func TestGetBalance_WithCorrectAddressShouldNotReturnError(t *testing.T) {
 	amount := big.NewInt(10)
 	addr := "testAddress"
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ state.BlockQueryOptions) (i *big.Int, e error) {
 			return amount, nil
 		},
 	}
@@ -150,11 +195,70 @@ func TestGetBalance_WithCorrectAddressShouldNotReturnError(t *testing.T) {
 	assert.Equal(t, "", response.Error)
 }
 
+func TestGetBalance_WithBlockNonceShouldPassOptions(t *testing.T) {
+	t.Parallel()
+	facade := mock.Facade{
+		BalanceHandler: func(s string, options state.BlockQueryOptions) (i *big.Int, e error) {
+			assert.True(t, options.HasBlockNonce)
+			assert.Equal(t, uint64(7), options.BlockNonce)
+			return big.NewInt(10), nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/testAddress/balance?blockNonce=7", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "10", getValueForKey(response.Data, "balance"))
+}
+
+func TestGetBalance_WithBlockNonceAndHashShouldError(t *testing.T) {
+	t.Parallel()
+	facade := mock.Facade{
+		BalanceHandler: func(s string, _ state.BlockQueryOptions) (i *big.Int, e error) {
+			assert.Fail(t, "should not have been called")
+			return nil, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/testAddress/balance?blockNonce=7&blockHash=aabb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrBlockNonceAndHashProvided.Error()))
+}
+
+func TestGetAccount_WithInvalidBlockHashShouldError(t *testing.T) {
+	t.Parallel()
+	facade := mock.Facade{}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/testAddress?blockHash=not-hex", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()))
+}
+
 func TestGetBalance_WithWrongAddressShouldError(t *testing.T) {
 	t.Parallel()
 	otherAddress := "otherAddress"
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ state.BlockQueryOptions) (i *big.Int, e error) {
 			return big.NewInt(0), nil
 		},
 	}
@@ -176,7 +280,7 @@ func TestGetBalance_NodeGetBalanceReturnsError(t *testing.T) {
 	addr := "addr"
 	balanceError := errors.New("error")
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ state.BlockQueryOptions) (i *big.Int, e error) {
 			return nil, balanceError
 		},
 	}
@@ -196,7 +300,7 @@ func TestGetBalance_NodeGetBalanceReturnsError(t *testing.T) {
 func TestGetBalance_WithEmptyAddressShoudReturnError(t *testing.T) {
 	t.Parallel()
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ state.BlockQueryOptions) (i *big.Int, e error) {
 			return big.NewInt(0), errors.New("address was empty")
 		},
 	}
@@ -251,7 +355,7 @@ func TestGetValueForKey_NodeFailsShouldError(t *testing.T) {
 	testAddress := "address"
 	expectedErr := errors.New("expected error")
 	facade := mock.Facade{
-		GetValueForKeyCalled: func(_ string, _ string) (string, error) {
+		GetValueForKeyCalled: func(_ string, _ string, _ state.BlockQueryOptions) (string, error) {
 			return "", expectedErr
 		},
 	}
@@ -274,7 +378,7 @@ func TestGetValueForKey_ShouldWork(t *testing.T) {
 	testAddress := "address"
 	testValue := "value"
 	facade := mock.Facade{
-		GetValueForKeyCalled: func(_ string, _ string) (string, error) {
+		GetValueForKeyCalled: func(_ string, _ string, _ state.BlockQueryOptions) (string, error) {
 			return testValue, nil
 		},
 	}
@@ -383,7 +487,7 @@ func TestGetAccount_FailWhenFacadeGetAccountFails(t *testing.T) {
 	t.Parallel()
 	returnedError := "i am an error"
 	facade := mock.Facade{
-		GetAccountHandler: func(address string) (state.UserAccountHandler, error) {
+		GetAccountHandler: func(address string, _ state.BlockQueryOptions) (state.UserAccountHandler, error) {
 			return nil, errors.New(returnedError)
 		},
 	}
@@ -404,7 +508,7 @@ func TestGetAccount_FailWhenFacadeGetAccountFails(t *testing.T) {
 func TestGetAccount_ReturnsSuccessfully(t *testing.T) {
 	t.Parallel()
 	facade := mock.Facade{
-		GetAccountHandler: func(address string) (state.UserAccountHandler, error) {
+		GetAccountHandler: func(address string, _ state.BlockQueryOptions) (state.UserAccountHandler, error) {
 			acc, _ := state.NewUserAccount([]byte("1234"))
 			_ = acc.AddToBalance(big.NewInt(100))
 			acc.IncreaseNonce(1)
@@ -533,6 +637,54 @@ func TestGetESDTBalance_ShouldWork(t *testing.T) {
 	assert.Equal(t, testProperties, esdtBalanceResponseObj.Data.Properties)
 }
 
+func TestGetESDTAllowance_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetESDTAllowanceCalled: func(_ string, _ string, _ string) (string, error) {
+			return "", expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/owner/esdt/newToken/allowance/spender", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetESDTAllowance.Error()))
+}
+
+func TestGetESDTAllowance_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	testAllowance := "1000"
+	facade := mock.Facade{
+		GetESDTAllowanceCalled: func(owner string, spender string, tokenName string) (string, error) {
+			assert.Equal(t, "owner", owner)
+			assert.Equal(t, "spender", spender)
+			assert.Equal(t, "newToken", tokenName)
+			return testAllowance, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/owner/esdt/newToken/allowance/spender", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	esdtAllowanceResponseObj := esdtAllowanceResponse{}
+	loadResponse(resp.Body, &esdtAllowanceResponseObj)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, testAllowance, esdtAllowanceResponseObj.Data.Allowance)
+}
+
 func TestGetESDTTokens_NilContextShouldError(t *testing.T) {
 	t.Parallel()
 
@@ -595,6 +747,330 @@ func TestGetESDTTokens_ShouldWork(t *testing.T) {
 	assert.Equal(t, []string{testValue1, testValue2}, esdtTokenResponseObj.Data.Tokens)
 }
 
+func TestGetNFTs_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetAllNFTsCalled: func(_ string) ([]*esdt.ApiNFTTokenData, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/nft", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := nftTokensResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetNFTs.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetNFTs_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	tokens := []*esdt.ApiNFTTokenData{
+		{TokenIdentifier: "NFT-abcdef", Nonce: 1, Balance: "1", Name: "first"},
+		{TokenIdentifier: "NFT-abcdef", Nonce: 2, Balance: "1", Name: "second"},
+	}
+	facade := mock.Facade{
+		GetAllNFTsCalled: func(address string) ([]*esdt.ApiNFTTokenData, error) {
+			assert.Equal(t, testAddress, address)
+			return tokens, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/nft", testAddress), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := nftTokensResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, tokens, response.Data.Tokens)
+}
+
+func TestGetNFT_InvalidNonceShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+
+	req, _ := http.NewRequest("GET", "/address/address/nft/NFT-abcdef/nonce/not-a-number", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := nftTokenResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidNFTNonce.Error()))
+}
+
+func TestGetNFT_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	token := &esdt.ApiNFTTokenData{TokenIdentifier: "NFT-abcdef", Nonce: 7, Balance: "1", Name: "name"}
+	facade := mock.Facade{
+		GetNFTCalled: func(address string, tokenIdentifier string, nonce uint64) (*esdt.ApiNFTTokenData, error) {
+			assert.Equal(t, "address", address)
+			assert.Equal(t, "NFT-abcdef", tokenIdentifier)
+			assert.Equal(t, uint64(7), nonce)
+			return token, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/nft/NFT-abcdef/nonce/7", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := nftTokenResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, token, response.Data.TokenData)
+}
+
+func TestGetTransactions_NilContextShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(nil)
+
+	req, _ := http.NewRequest("GET", "/address/some/transactions", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrNilAppContext.Error()))
+}
+
+func TestGetTransactions_InvalidQueryParametersShouldError(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetTransactionsByAddressCalled: func(_ string, _ string, _ int) (*transaction.ApiTransactionsByAddress, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	testCases := map[string]error{
+		"/address/address/transactions?limit=abc":  apiErrors.ErrInvalidLimit,
+		"/address/address/transactions?limit=0":    apiErrors.ErrInvalidLimit,
+		"/address/address/transactions?limit=-5":   apiErrors.ErrInvalidLimit,
+		"/address/address/transactions?cursor=xyz": apiErrors.ErrInvalidCursor,
+	}
+	for url, expectedErr := range testCases {
+		req, _ := http.NewRequest("GET", url, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := transactionsResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code, url)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()), url)
+	}
+}
+
+func TestGetTransactions_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetTransactionsByAddressCalled: func(_ string, _ string, _ int) (*transaction.ApiTransactionsByAddress, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/transactions", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := transactionsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetTransactions_NodeRejectsLimitOrCursorShouldReturnBadRequest(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]error{
+		"/address/address/transactions?limit=1000":          fmt.Errorf("%w, should be between 1 and 100", dblookupext.ErrInvalidLimit),
+		"/address/address/transactions?cursor=00000001abcd": dblookupext.ErrInvalidCursor,
+	}
+	for url, facadeErr := range testCases {
+		returnedErr := facadeErr
+		facade := mock.Facade{
+			GetTransactionsByAddressCalled: func(_ string, _ string, _ int) (*transaction.ApiTransactionsByAddress, error) {
+				return nil, returnedErr
+			},
+		}
+		ws := startNodeServer(&facade)
+
+		req, _ := http.NewRequest("GET", url, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := transactionsResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code, url)
+		assert.Equal(t, string(shared.ReturnCodeRequestError), response.Code, url)
+		assert.True(t, strings.Contains(response.Error, facadeErr.Error()), url)
+	}
+}
+
+func TestGetTransactions_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	testCursor := "0000000100000002"
+	testLimit := 5
+	nextCursor := "0000000100000000"
+	facade := mock.Facade{
+		GetTransactionsByAddressCalled: func(address string, cursor string, limit int) (*transaction.ApiTransactionsByAddress, error) {
+			assert.Equal(t, testAddress, address)
+			assert.Equal(t, testCursor, cursor)
+			assert.Equal(t, testLimit, limit)
+
+			return &transaction.ApiTransactionsByAddress{
+				Transactions: []*transaction.ApiTransactionResult{{Hash: "hash1"}, {Hash: "hash2"}},
+				NextCursor:   nextCursor,
+			}, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/transactions?cursor=%s&limit=%d", testAddress, testCursor, testLimit), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := transactionsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, 2, len(response.Data.Transactions))
+	assert.Equal(t, "hash1", response.Data.Transactions[0].Hash)
+	assert.Equal(t, nextCursor, response.Data.NextCursor)
+}
+
+type trieProofResponseData struct {
+	RootHash string   `json:"rootHash"`
+	Proof    []string `json:"proof"`
+}
+
+type accountProofResponse struct {
+	Data struct {
+		Proof trieProofResponseData `json:"proof"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type keyProofResponse struct {
+	Data struct {
+		AccountProof  trieProofResponseData `json:"accountProof"`
+		DataTrieProof trieProofResponseData `json:"dataTrieProof"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func TestGetAccountProof_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetAccountProofCalled: func(address string, options state.BlockQueryOptions) (*state.TrieProof, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/testAddress/proof", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetProof.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetAccountProof_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "testAddress"
+	facade := mock.Facade{
+		GetAccountProofCalled: func(address string, options state.BlockQueryOptions) (*state.TrieProof, error) {
+			assert.Equal(t, testAddress, address)
+			assert.Equal(t, []byte{0xaa, 0xbb}, options.BlockHash)
+
+			return &state.TrieProof{
+				RootHash: []byte("root"),
+				Proof:    [][]byte{[]byte("node1"), []byte("node2")},
+			}, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/proof?blockHash=aabb", testAddress), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := accountProofResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, hex.EncodeToString([]byte("root")), response.Data.Proof.RootHash)
+	assert.Equal(t, []string{hex.EncodeToString([]byte("node1")), hex.EncodeToString([]byte("node2"))}, response.Data.Proof.Proof)
+}
+
+func TestGetKeyProof_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "testAddress"
+	testKey := "abcd"
+	facade := mock.Facade{
+		GetKeyProofCalled: func(address string, key string, options state.BlockQueryOptions) (*state.TrieProof, *state.TrieProof, error) {
+			assert.Equal(t, testAddress, address)
+			assert.Equal(t, testKey, key)
+
+			accountProof := &state.TrieProof{RootHash: []byte("root"), Proof: [][]byte{[]byte("account node")}}
+			dataTrieProof := &state.TrieProof{RootHash: []byte("data root"), Proof: [][]byte{[]byte("data node")}}
+			return accountProof, dataTrieProof, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/key/%s/proof", testAddress, testKey), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := keyProofResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, hex.EncodeToString([]byte("root")), response.Data.AccountProof.RootHash)
+	assert.Equal(t, []string{hex.EncodeToString([]byte("account node"))}, response.Data.AccountProof.Proof)
+	assert.Equal(t, hex.EncodeToString([]byte("data root")), response.Data.DataTrieProof.RootHash)
+	assert.Equal(t, []string{hex.EncodeToString([]byte("data node"))}, response.Data.DataTrieProof.Proof)
+}
+
 func getRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
@@ -606,6 +1082,12 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:address/key/:key", Open: true},
 					{Name: "/:address/esdt", Open: true},
 					{Name: "/:address/esdt/:tokenIdentifier", Open: true},
+					{Name: "/:address/esdt/:tokenIdentifier/allowance/:spender", Open: true},
+					{Name: "/:address/nft", Open: true},
+					{Name: "/:address/nft/:tokenIdentifier/nonce/:nonce", Open: true},
+					{Name: "/:address/transactions", Open: true},
+					{Name: "/:address/proof", Open: true},
+					{Name: "/:address/key/:key/proof", Open: true},
 				},
 			},
 		},
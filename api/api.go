@@ -8,12 +8,16 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/api/address"
 	"github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/api/events"
 	"github.com/ElrondNetwork/elrond-go/api/hardfork"
+	"github.com/ElrondNetwork/elrond-go/api/hyperblock"
 	"github.com/ElrondNetwork/elrond-go/api/logs"
 	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/network"
 	"github.com/ElrondNetwork/elrond-go/api/node"
+	"github.com/ElrondNetwork/elrond-go/api/subscription"
 	"github.com/ElrondNetwork/elrond-go/api/transaction"
+	"github.com/ElrondNetwork/elrond-go/api/transactionsPool"
 	valStats "github.com/ElrondNetwork/elrond-go/api/validator"
 	"github.com/ElrondNetwork/elrond-go/api/vmValues"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
@@ -124,6 +128,12 @@ func registerRoutes(ws *gin.Engine, routesConfig config.ApiRoutesConfig, elrondF
 		transaction.Routes(wrappedTransactionRouter)
 	}
 
+	txPoolRoutes := ws.Group("/transaction-pool")
+	wrappedTxPoolRouter, err := wrapper.NewRouterWrapper("transaction-pool", txPoolRoutes, routesConfig)
+	if err == nil {
+		transactionsPool.Routes(wrappedTxPoolRouter)
+	}
+
 	vmValuesRoutes := ws.Group("/vm-values")
 	wrappedVmValuesRouter, err := wrapper.NewRouterWrapper("vm-values", vmValuesRoutes, routesConfig)
 	if err == nil {
@@ -148,6 +158,24 @@ func registerRoutes(ws *gin.Engine, routesConfig config.ApiRoutesConfig, elrondF
 		block.Routes(wrappedBlockRouter)
 	}
 
+	hyperblockRoutes := ws.Group("/hyperblock")
+	wrappedHyperblockRouter, err := wrapper.NewRouterWrapper("hyperblock", hyperblockRoutes, routesConfig)
+	if err == nil {
+		hyperblock.Routes(wrappedHyperblockRouter)
+	}
+
+	eventsRoutes := ws.Group("/events")
+	wrappedEventsRouter, err := wrapper.NewRouterWrapper("events", eventsRoutes, routesConfig)
+	if err == nil {
+		events.Routes(wrappedEventsRouter)
+	}
+
+	subscriptionRoutes := ws.Group("/subscription")
+	wrappedSubscriptionRouter, err := wrapper.NewRouterWrapper("subscription", subscriptionRoutes, routesConfig)
+	if err == nil {
+		subscription.Routes(wrappedSubscriptionRouter)
+	}
+
 	apiHandler, ok := elrondFacade.(MainApiHandler)
 	if ok && apiHandler.PprofEnabled() {
 		pprof.Register(ws)
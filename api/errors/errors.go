@@ -31,6 +31,30 @@ var ErrGetESDTTokens = errors.New("get esdt tokens for account error")
 // ErrGetESDTBalance signals an error in getting esdt balance for given address
 var ErrGetESDTBalance = errors.New("get esdt balance for account error")
 
+// ErrGetESDTAllowance signals an error in getting esdt allowance for given owner and spender
+var ErrGetESDTAllowance = errors.New("get esdt allowance for account error")
+
+// ErrGetNFTs signals an error in getting the non-fungible tokens for a given address
+var ErrGetNFTs = errors.New("get non-fungible tokens for account error")
+
+// ErrGetNFT signals an error in getting a non-fungible token for a given address
+var ErrGetNFT = errors.New("get non-fungible token for account error")
+
+// ErrInvalidNFTNonce signals that an invalid non-fungible token nonce was provided
+var ErrInvalidNFTNonce = errors.New("invalid non-fungible token nonce")
+
+// ErrGetTransactionsByAddress signals an error in getting the transactions of a given address
+var ErrGetTransactionsByAddress = errors.New("get transactions for account error")
+
+// ErrGetProof signals an error in getting the Merkle proof of an account or of a key
+var ErrGetProof = errors.New("get proof error")
+
+// ErrInvalidLimit signals that an invalid limit query parameter was provided
+var ErrInvalidLimit = errors.New("invalid limit")
+
+// ErrInvalidCursor signals that an invalid cursor query parameter was provided
+var ErrInvalidCursor = errors.New("invalid cursor")
+
 // ErrEmptyAddress signals an empty address was provided
 var ErrEmptyAddress = errors.New("address is empty")
 
@@ -52,6 +76,9 @@ var ErrInvalidBlockNonce = errors.New("invalid block nonce")
 // ErrInvalidQueryParameter signals and invalid query parameter was provided
 var ErrInvalidQueryParameter = errors.New("invalid query parameter")
 
+// ErrBlockNonceAndHashProvided signals that both the block nonce and the block hash were provided
+var ErrBlockNonceAndHashProvided = errors.New("only one of blockNonce and blockHash can be provided")
+
 // ErrValidationEmptyBlockHash signals an empty block hash was provided
 var ErrValidationEmptyBlockHash = errors.New("block hash is empty")
 
@@ -61,6 +88,24 @@ var ErrGetTransaction = errors.New("getting transaction failed")
 // ErrGetBlock signals an error happening when trying to fetch a block
 var ErrGetBlock = errors.New("getting block failed")
 
+// ErrGetHyperblock signals an error happening when trying to fetch a hyperblock
+var ErrGetHyperblock = errors.New("getting hyperblock failed")
+
+// ErrGetLogEvents signals an error happening when trying to search the smart contract log events
+var ErrGetLogEvents = errors.New("getting log events failed")
+
+// ErrEmptyLogEventsCriteria signals that neither an address nor an identifier was provided when searching log events
+var ErrEmptyLogEventsCriteria = errors.New("an address or an identifier should be provided")
+
+// ErrInvalidNoncesRange signals that the provided start nonce is greater than the end nonce
+var ErrInvalidNoncesRange = errors.New("invalid nonces range")
+
+// ErrInvalidAddress signals that an address which can not be decoded was provided
+var ErrInvalidAddress = errors.New("invalid address")
+
+// ErrInvalidTopic signals that a topic which is not hex encoded was provided
+var ErrInvalidTopic = errors.New("invalid topic")
+
 // ErrQueryError signals a general query error
 var ErrQueryError = errors.New("query error")
 
@@ -69,3 +114,27 @@ var ErrGetPidInfo = errors.New("error getting peer id info")
 
 // ErrTooManyRequests signals that too many requests were simultaneously received
 var ErrTooManyRequests = errors.New("too many requests")
+
+// ErrSubscribe signals an error happening when trying to subscribe to the node events
+var ErrSubscribe = errors.New("subscribe failed")
+
+// ErrGetTransactionsPoolForSender signals an error happening when trying to fetch the pending transactions of a sender
+var ErrGetTransactionsPoolForSender = errors.New("getting pending transactions for sender failed")
+
+// ErrGetTransactionsPoolSenderInfo signals an error happening when trying to fetch the transactions pool details of a sender
+var ErrGetTransactionsPoolSenderInfo = errors.New("getting transactions pool sender info failed")
+
+// ErrGetTransactionsPoolStats signals an error happening when trying to fetch the transactions pool statistics
+var ErrGetTransactionsPoolStats = errors.New("getting transactions pool statistics failed")
+
+// ErrGetLastPendingNonce signals an error happening when trying to fetch the last pending nonce of a sender
+var ErrGetLastPendingNonce = errors.New("getting last pending nonce failed")
+
+// ErrNodeNotAlive signals that the node reported itself as not alive
+var ErrNodeNotAlive = errors.New("node is not alive")
+
+// ErrNodeNotReady signals that the node reported itself as not ready
+var ErrNodeNotReady = errors.New("node is not ready")
+
+// ErrInvalidNumberOfTransactions signals that the number of transactions provided for simulation is not accepted
+var ErrInvalidNumberOfTransactions = errors.New("invalid number of transactions")
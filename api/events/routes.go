@@ -0,0 +1,175 @@
+package events
+
+import (
+	"encoding/hex"
+	errs "errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	getLogEventsPath = ""
+
+	defaultLogEventsLimit = 20
+)
+
+var log = logger.GetOrCreate("api/events")
+
+// EventsService interface defines methods that can be used from `elrondFacade` context variable
+type EventsService interface {
+	GetLogEvents(query *transaction.ApiLogEventsQuery) (*transaction.ApiLogEvents, error)
+	DecodeAddressPubkey(pk string) ([]byte, error)
+}
+
+// Routes defines log events related routes
+func Routes(routes *wrapper.RouterWrapper) {
+	routes.RegisterHandler(http.MethodGet, getLogEventsPath, getLogEvents)
+}
+
+// getLogEvents returns a page of the smart contract log events emitted by an address and/or having an identifier,
+// optionally filtered by the first topic and by the nonces range of the blocks that included them. Only the events of
+// the blocks currently committed by the node are returned: the ones of the reverted blocks are skipped.
+func getLogEvents(c *gin.Context) {
+	ef, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	query, err := getLogEventsQuery(c, ef)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+		)
+		return
+	}
+
+	start := time.Now()
+	logEvents, err := ef.GetLogEvents(query)
+	log.Debug(fmt.Sprintf("GetLogEvents took %s", time.Since(start)))
+	if isLogEventsPageError(err) {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetLogEvents.Error(), err.Error()),
+			shared.ReturnCodeRequestError,
+		)
+		return
+	}
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetLogEvents.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(
+		c,
+		http.StatusOK,
+		gin.H{"events": logEvents.Events, "nextCursor": logEvents.NextCursor},
+		"",
+		shared.ReturnCodeSuccess,
+	)
+}
+
+// isLogEventsPageError returns true if the provided error signals a limit above the maximum page size or a cursor
+// which can not point inside the log events index, both being request errors
+func isLogEventsPageError(err error) bool {
+	return errs.Is(err, dblookupext.ErrInvalidLimit) || errs.Is(err, dblookupext.ErrInvalidCursor)
+}
+
+func getLogEventsQuery(c *gin.Context, ef EventsService) (*transaction.ApiLogEventsQuery, error) {
+	urlQuery := c.Request.URL.Query()
+	query := &transaction.ApiLogEventsQuery{
+		Address:    urlQuery.Get("address"),
+		Identifier: urlQuery.Get("identifier"),
+		Topic0:     urlQuery.Get("topic0"),
+		Cursor:     urlQuery.Get("cursor"),
+		ToNonce:    math.MaxUint64,
+		Limit:      defaultLogEventsLimit,
+	}
+	if query.Address == "" && query.Identifier == "" {
+		return nil, errors.ErrEmptyLogEventsCriteria
+	}
+
+	var err error
+	if urlQuery.Get("fromNonce") != "" {
+		query.FromNonce, err = strconv.ParseUint(urlQuery.Get("fromNonce"), 10, 64)
+		if err != nil {
+			return nil, errors.ErrInvalidBlockNonce
+		}
+	}
+	if urlQuery.Get("toNonce") != "" {
+		query.ToNonce, err = strconv.ParseUint(urlQuery.Get("toNonce"), 10, 64)
+		if err != nil {
+			return nil, errors.ErrInvalidBlockNonce
+		}
+	}
+	if urlQuery.Get("limit") != "" {
+		query.Limit, err = strconv.Atoi(urlQuery.Get("limit"))
+		if err != nil || query.Limit <= 0 {
+			return nil, errors.ErrInvalidLimit
+		}
+	}
+	if query.FromNonce > query.ToNonce {
+		return nil, errors.ErrInvalidNoncesRange
+	}
+	if _, err = hex.DecodeString(query.Topic0); err != nil {
+		return nil, errors.ErrInvalidTopic
+	}
+	if _, err = hex.DecodeString(query.Cursor); err != nil {
+		return nil, errors.ErrInvalidCursor
+	}
+	if query.Address != "" {
+		if _, err = ef.DecodeAddressPubkey(query.Address); err != nil {
+			return nil, errors.ErrInvalidAddress
+		}
+	}
+
+	return query, nil
+}
+
+func getFacade(c *gin.Context) (EventsService, bool) {
+	facadeObj, ok := c.Get("facade")
+	if !ok {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrNilAppContext.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return nil, false
+	}
+
+	facade, ok := facadeObj.(EventsService)
+	if !ok {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrInvalidAppContext.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return nil, false
+	}
+
+	return facade, true
+}
@@ -0,0 +1,256 @@
+package events_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/events"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type logEventsResponseData struct {
+	Events     []*transaction.ApiLogEvent `json:"events"`
+	NextCursor string                     `json:"nextCursor"`
+}
+
+type logEventsResponse struct {
+	Data  logEventsResponseData `json:"data"`
+	Error string                `json:"error"`
+	Code  string                `json:"code"`
+}
+
+func TestGetLogEvents_NilContextShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(nil)
+
+	req, _ := http.NewRequest("GET", "/events?address=erd1", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrNilAppContext.Error()))
+}
+
+func TestGetLogEvents_WrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+
+	req, _ := http.NewRequest("GET", "/events?address=erd1", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := logEventsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidAppContext.Error()))
+}
+
+func TestGetLogEvents_InvalidQueryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetLogEventsCalled: func(_ *transaction.ApiLogEventsQuery) (*transaction.ApiLogEvents, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	testCases := map[string]error{
+		"/events":                                        apiErrors.ErrEmptyLogEventsCriteria,
+		"/events?topic0=aa":                              apiErrors.ErrEmptyLogEventsCriteria,
+		"/events?identifier=swap&fromNonce=abc":          apiErrors.ErrInvalidBlockNonce,
+		"/events?identifier=swap&toNonce=-1":             apiErrors.ErrInvalidBlockNonce,
+		"/events?address=erd1&limit=many":                apiErrors.ErrInvalidLimit,
+		"/events?identifier=swap&limit=0":                apiErrors.ErrInvalidLimit,
+		"/events?identifier=swap&fromNonce=10&toNonce=9": apiErrors.ErrInvalidNoncesRange,
+		"/events?identifier=swap&topic0=zz":              apiErrors.ErrInvalidTopic,
+		"/events?identifier=swap&cursor=zz":              apiErrors.ErrInvalidCursor,
+		"/events?address=erd1":                           apiErrors.ErrInvalidAddress,
+	}
+	for url, expectedErr := range testCases {
+		req, _ := http.NewRequest("GET", url, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := logEventsResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code, url)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()), url)
+	}
+}
+
+func TestGetLogEvents_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("local err")
+	facade := mock.Facade{
+		GetLogEventsCalled: func(_ *transaction.ApiLogEventsQuery) (*transaction.ApiLogEvents, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/events?identifier=swap", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := logEventsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetLogEvents.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetLogEvents_NodeRejectsLimitOrCursorShouldReturnBadRequest(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]error{
+		"/events?identifier=swap&limit=1000":      fmt.Errorf("%w, should be between 1 and 100", dblookupext.ErrInvalidLimit),
+		"/events?identifier=swap&cursor=0001abcd": dblookupext.ErrInvalidCursor,
+	}
+	for url, facadeErr := range testCases {
+		returnedErr := facadeErr
+		facade := mock.Facade{
+			GetLogEventsCalled: func(_ *transaction.ApiLogEventsQuery) (*transaction.ApiLogEvents, error) {
+				return nil, returnedErr
+			},
+		}
+		ws := startNodeServer(&facade)
+
+		req, _ := http.NewRequest("GET", url, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := logEventsResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code, url)
+		assert.Equal(t, string(shared.ReturnCodeRequestError), response.Code, url)
+		assert.True(t, strings.Contains(response.Error, facadeErr.Error()), url)
+	}
+}
+
+func TestGetLogEvents_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedEvents := &transaction.ApiLogEvents{
+		Events: []*transaction.ApiLogEvent{
+			{TxHash: "aa", Address: "erd1", Identifier: "swap", Topics: []string{"bb"}, BlockNonce: 37},
+		},
+		NextCursor: "cc",
+	}
+	facade := mock.Facade{
+		GetLogEventsCalled: func(query *transaction.ApiLogEventsQuery) (*transaction.ApiLogEvents, error) {
+			assert.Equal(t, &transaction.ApiLogEventsQuery{
+				Address:    "aabb",
+				Identifier: "swap",
+				Topic0:     "bb",
+				FromNonce:  10,
+				ToNonce:    40,
+				Cursor:     "dd",
+				Limit:      5,
+			}, query)
+			return expectedEvents, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/events?address=aabb&identifier=swap&topic0=bb&fromNonce=10&toNonce=40&cursor=dd&limit=5", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := logEventsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedEvents.Events, response.Data.Events)
+	assert.Equal(t, expectedEvents.NextCursor, response.Data.NextCursor)
+}
+
+func TestGetLogEvents_DefaultsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetLogEventsCalled: func(query *transaction.ApiLogEventsQuery) (*transaction.ApiLogEvents, error) {
+			assert.Equal(t, uint64(0), query.FromNonce)
+			assert.Equal(t, uint64(math.MaxUint64), query.ToNonce)
+			assert.True(t, query.Limit > 0)
+			return &transaction.ApiLogEvents{}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/events?identifier=swap", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+func startNodeServer(handler events.EventsService) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	eventsRoutes := ws.Group("/events")
+	if handler != nil {
+		eventsRoutes.Use(middleware.WithFacade(handler))
+	}
+	eventsRoute, _ := wrapper.NewRouterWrapper("events", eventsRoutes, getRoutesConfig())
+	events.Routes(eventsRoute)
+	return ws
+}
+
+func startNodeServerWrongFacade() *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("facade", mock.WrongFacade{})
+	})
+	ginEventsRoute := ws.Group("/events")
+	eventsRoute, _ := wrapper.NewRouterWrapper("events", ginEventsRoute, getRoutesConfig())
+	events.Routes(eventsRoute)
+	return ws
+}
+
+func getRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"events": {
+				Routes: []config.RouteConfig{
+					{Name: "", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	err := jsonParser.Decode(destination)
+	logError(err)
+}
+
+func logError(err error) {
+	if err != nil {
+		fmt.Println(err)
+	}
+}
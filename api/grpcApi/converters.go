@@ -0,0 +1,96 @@
+package grpcApi
+
+import (
+	"github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
+func transactionFromApi(tx *transaction.ApiTransactionResult) *TransactionResponse {
+	response := &TransactionResponse{
+		Type:                 tx.Type,
+		Hash:                 tx.Hash,
+		Nonce:                tx.Nonce,
+		Round:                tx.Round,
+		Epoch:                tx.Epoch,
+		Value:                tx.Value,
+		Receiver:             tx.Receiver,
+		Sender:               tx.Sender,
+		GasPrice:             tx.GasPrice,
+		GasLimit:             tx.GasLimit,
+		Data:                 tx.Data,
+		Signature:            tx.Signature,
+		SourceShard:          tx.SourceShard,
+		DestinationShard:     tx.DestinationShard,
+		BlockNonce:           tx.BlockNonce,
+		BlockHash:            tx.BlockHash,
+		MiniBlockType:        tx.MiniBlockType,
+		MiniBlockHash:        tx.MiniBlockHash,
+		Status:               string(tx.Status),
+		FinalityLevel:        string(tx.FinalityLevel),
+		ReturnMessage:        tx.ReturnMessage,
+		SmartContractResults: make([]*SmartContractResult, 0, len(tx.SmartContractResults)),
+	}
+	for _, scr := range tx.SmartContractResults {
+		response.SmartContractResults = append(response.SmartContractResults, smartContractResultFromApi(scr))
+	}
+
+	return response
+}
+
+func smartContractResultFromApi(scr *transaction.ApiSmartContractResult) *SmartContractResult {
+	value := ""
+	if scr.Value != nil {
+		value = scr.Value.String()
+	}
+
+	return &SmartContractResult{
+		Hash:           scr.Hash,
+		Nonce:          scr.Nonce,
+		Value:          value,
+		Receiver:       scr.RcvAddr,
+		Sender:         scr.SndAddr,
+		Data:           scr.Data,
+		PrevTxHash:     scr.PrevTxHash,
+		OriginalTxHash: scr.OriginalTxHash,
+		GasLimit:       scr.GasLimit,
+		GasPrice:       scr.GasPrice,
+		ReturnMessage:  scr.ReturnMessage,
+	}
+}
+
+func blockFromApi(apiBlock *block.APIBlock) *BlockResponse {
+	response := &BlockResponse{
+		Nonce:           apiBlock.Nonce,
+		Round:           apiBlock.Round,
+		Hash:            apiBlock.Hash,
+		PrevBlockHash:   apiBlock.PrevBlockHash,
+		Epoch:           apiBlock.Epoch,
+		Shard:           apiBlock.Shard,
+		NumTxs:          apiBlock.NumTxs,
+		NotarizedBlocks: make([]*NotarizedBlock, 0, len(apiBlock.NotarizedBlocks)),
+		MiniBlocks:      make([]*MiniBlock, 0, len(apiBlock.MiniBlocks)),
+	}
+	for _, notarizedBlock := range apiBlock.NotarizedBlocks {
+		response.NotarizedBlocks = append(response.NotarizedBlocks, &NotarizedBlock{
+			Hash:  notarizedBlock.Hash,
+			Nonce: notarizedBlock.Nonce,
+			Shard: notarizedBlock.Shard,
+		})
+	}
+	for _, miniBlock := range apiBlock.MiniBlocks {
+		transactions := make([]*TransactionResponse, 0, len(miniBlock.Transactions))
+		for _, tx := range miniBlock.Transactions {
+			transactions = append(transactions, transactionFromApi(tx))
+		}
+
+		response.MiniBlocks = append(response.MiniBlocks, &MiniBlock{
+			Hash:             miniBlock.Hash,
+			Type:             miniBlock.Type,
+			SourceShard:      miniBlock.SourceShard,
+			DestinationShard: miniBlock.DestinationShard,
+			Transactions:     transactions,
+		})
+	}
+
+	return response
+}
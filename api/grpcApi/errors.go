@@ -0,0 +1,33 @@
+package grpcApi
+
+import "errors"
+
+// ErrNilFacade signals that a nil facade has been provided
+var ErrNilFacade = errors.New("nil facade")
+
+// ErrNilSourceThrottler signals that a nil source throttler has been provided
+var ErrNilSourceThrottler = errors.New("nil source throttler")
+
+// ErrNilGlobalThrottler signals that a nil global throttler has been provided
+var ErrNilGlobalThrottler = errors.New("nil global throttler")
+
+// ErrEmptyListenAddress signals that an empty listen address has been provided
+var ErrEmptyListenAddress = errors.New("empty listen address")
+
+// ErrInvalidMaxBlockStreams signals that an invalid maximum number of block streams has been provided
+var ErrInvalidMaxBlockStreams = errors.New("invalid maximum number of block streams")
+
+// ErrTooManyBlockStreams signals that the maximum number of block streams open at the same time has been reached
+var ErrTooManyBlockStreams = errors.New("too many block streams")
+
+// ErrRouteNotOpen signals that the REST route mirrored by the called method is not open
+var ErrRouteNotOpen = errors.New("the mirrored REST route is not open")
+
+// ErrRouteNotPublic signals that the REST route mirrored by the called method requires a role
+var ErrRouteNotPublic = errors.New("the mirrored REST route requires a role, which is not supported on gRPC")
+
+// ErrMissingTransaction signals that the request does not hold a transaction
+var ErrMissingTransaction = errors.New("missing transaction")
+
+// ErrUnknownCallSource signals that the address of the caller could not be determined
+var ErrUnknownCallSource = errors.New("unknown call source")
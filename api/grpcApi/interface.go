@@ -0,0 +1,47 @@
+package grpcApi
+
+import (
+	"github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/subscription"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/vm"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// FacadeHandler defines the facade methods used by the gRPC service, the same ones used by the REST routes
+type FacadeHandler interface {
+	GetAccount(address string, options state.BlockQueryOptions) (state.UserAccountHandler, error)
+	GetESDTBalance(address string, key string) (string, string, error)
+	GetAllESDTTokens(address string) ([]string, error)
+	CreateTransaction(nonce uint64, value string, receiver string, sender string, gasPrice uint64,
+		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*transaction.Transaction, []byte, error)
+	ValidateTransaction(tx *transaction.Transaction) error
+	ValidateTransactionForSimulation(tx *transaction.Transaction) error
+	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
+	SimulateTransactionExecution(tx *transaction.Transaction, withTrace bool) (*transaction.SimulationResults, error)
+	ComputeTransactionGasLimit(tx *transaction.Transaction) (uint64, error)
+	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	GetBlockByNonce(nonce uint64, withTxs bool) (*block.APIBlock, error)
+	GetBlockByHash(hash string, withTxs bool) (*block.APIBlock, error)
+	ExecuteSCQuery(*process.SCQuery) (*vm.VMOutputApi, error)
+	DecodeAddressPubkey(pk string) ([]byte, error)
+	Subscribe(filter subscription.Filter, fromNonce *uint64) (*subscription.Subscription, error)
+	Unsubscribe(subscriptionID uint64)
+	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
+	IsInterfaceNil() bool
+}
+
+// SourceThrottler defines the limiter of the requests originating from the same source, shared with the REST API
+type SourceThrottler interface {
+	CanProcessSource(source string) bool
+	IsInterfaceNil() bool
+}
+
+// GlobalThrottler defines the limiter of the simultaneous requests, shared with the REST API
+type GlobalThrottler interface {
+	StartProcessing(path string) bool
+	EndProcessing(path string)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,616 @@
+package grpcApi
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"net"
+	"sort"
+	"sync"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/subscription"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// mirroredRoute is the REST route mirrored by a gRPC method, along with the name of the endpoint throttler shared
+// with the REST route, if any
+type mirroredRoute struct {
+	packageName string
+	route       string
+	throttler   string
+}
+
+var mirroredRoutes = map[string]mirroredRoute{
+	"GetAccount":             {packageName: "address", route: "/:address"},
+	"GetESDTBalance":         {packageName: "address", route: "/:address/esdt/:tokenIdentifier"},
+	"GetESDTTokens":          {packageName: "address", route: "/:address/esdt"},
+	"SendTransaction":        {packageName: "transaction", route: "/send", throttler: "/transaction/send"},
+	"SimulateTransaction":    {packageName: "transaction", route: "/simulate", throttler: "/transaction/simulate"},
+	"ComputeTransactionCost": {packageName: "transaction", route: "/cost"},
+	"GetTransaction":         {packageName: "transaction", route: "/:txhash", throttler: "/transaction/:hash"},
+	"GetBlockByNonce":        {packageName: "block", route: "/by-nonce/:nonce"},
+	"GetBlockByHash":         {packageName: "block", route: "/by-hash/:hash"},
+	"QueryVM":                {packageName: "vm-values", route: "/query"},
+	"StreamBlocks":           {packageName: "subscription", route: "/ws"},
+}
+
+// methodAccess holds, for a gRPC method, the error returned if its mirrored route is not available and the endpoint
+// throttler to be used
+type methodAccess struct {
+	err       error
+	throttler string
+}
+
+// nodeService implements the Node gRPC service on top of the facade used by the REST routes. A method is available
+// only if the REST route it mirrors is open and public
+type nodeService struct {
+	facade          FacadeHandler
+	sourceThrottler SourceThrottler
+	globalThrottler GlobalThrottler
+	methodsAccess   map[string]methodAccess
+
+	mutBlockStreams sync.Mutex
+	numBlockStreams uint32
+	maxBlockStreams uint32
+}
+
+func newNodeService(
+	facade FacadeHandler,
+	sourceThrottler SourceThrottler,
+	globalThrottler GlobalThrottler,
+	routesConfig config.ApiRoutesConfig,
+	maxBlockStreams uint32,
+) (*nodeService, error) {
+	if check.IfNil(facade) {
+		return nil, ErrNilFacade
+	}
+	if check.IfNil(sourceThrottler) {
+		return nil, ErrNilSourceThrottler
+	}
+	if check.IfNil(globalThrottler) {
+		return nil, ErrNilGlobalThrottler
+	}
+
+	return &nodeService{
+		facade:          facade,
+		sourceThrottler: sourceThrottler,
+		globalThrottler: globalThrottler,
+		methodsAccess:   computeMethodsAccess(routesConfig),
+		maxBlockStreams: maxBlockStreams,
+	}, nil
+}
+
+func computeMethodsAccess(routesConfig config.ApiRoutesConfig) map[string]methodAccess {
+	methodsAccess := make(map[string]methodAccess, len(mirroredRoutes))
+	for method, mirrored := range mirroredRoutes {
+		methodsAccess[fullMethodName(method)] = methodAccess{
+			err:       checkRouteAccess(routesConfig, mirrored),
+			throttler: mirrored.throttler,
+		}
+	}
+
+	return methodsAccess
+}
+
+func checkRouteAccess(routesConfig config.ApiRoutesConfig, mirrored mirroredRoute) error {
+	for _, route := range routesConfig.APIPackages[mirrored.packageName].Routes {
+		if route.Name != mirrored.route || !route.Open {
+			continue
+		}
+
+		role, err := middleware.ParseRole(route.Role)
+		if err != nil || role != middleware.RolePublic {
+			return status.Error(codes.PermissionDenied, ErrRouteNotPublic.Error())
+		}
+
+		return nil
+	}
+
+	return status.Error(codes.Unimplemented, ErrRouteNotOpen.Error())
+}
+
+func fullMethodName(method string) string {
+	return fmt.Sprintf("/%s/%s", _Node_serviceDesc.ServiceName, method)
+}
+
+// acquire checks that the called method is available and reserves a slot on the endpoint throttler of the mirrored
+// route. The returned function releases the slot
+func (ns *nodeService) acquire(fullMethod string) (func(), error) {
+	access, ok := ns.methodsAccess[fullMethod]
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, ErrRouteNotOpen.Error())
+	}
+	if access.err != nil {
+		return nil, access.err
+	}
+
+	throttlerName := access.throttler
+	if len(throttlerName) == 0 {
+		return func() {}, nil
+	}
+
+	endpointThrottler, ok := ns.facade.GetThrottlerForEndpoint(throttlerName)
+	if !ok {
+		return func() {}, nil
+	}
+	if !endpointThrottler.CanProcess() {
+		return nil, status.Errorf(codes.ResourceExhausted, "%s for endpoint %s",
+			middleware.ErrTooManyRequests.Error(), throttlerName)
+	}
+
+	endpointThrottler.StartProcessing()
+
+	return endpointThrottler.EndProcessing, nil
+}
+
+// throttle applies the source and global limits of the REST API to the call, then acquires the called method. The
+// returned function releases all the reserved slots
+func (ns *nodeService) throttle(ctx context.Context, fullMethod string) (func(), error) {
+	err := ns.throttleSource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !ns.globalThrottler.StartProcessing(fullMethod) {
+		return nil, status.Error(codes.ResourceExhausted, middleware.ErrTooManyRequests.Error())
+	}
+
+	release, err := ns.acquire(fullMethod)
+	if err != nil {
+		ns.globalThrottler.EndProcessing(fullMethod)
+		return nil, err
+	}
+
+	return func() {
+		release()
+		ns.globalThrottler.EndProcessing(fullMethod)
+	}, nil
+}
+
+func (ns *nodeService) throttleSource(ctx context.Context) error {
+	source, err := getCallSource(ctx)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if !ns.sourceThrottler.CanProcessSource(source) {
+		return status.Errorf(codes.ResourceExhausted, "%s for address %s",
+			middleware.ErrTooManyRequests.Error(), source)
+	}
+
+	return nil
+}
+
+// throttleStream applies the source limit of the REST API to the stream, reserves one of the block streams slots and
+// acquires the called method. A stream lasts until the client cancels it, so it does not hold a slot of the global
+// throttler, which would be taken away from the REST calls. The returned function releases all the reserved slots
+func (ns *nodeService) throttleStream(ctx context.Context, fullMethod string) (func(), error) {
+	err := ns.throttleSource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !ns.startBlockStream() {
+		return nil, status.Error(codes.ResourceExhausted, ErrTooManyBlockStreams.Error())
+	}
+
+	release, err := ns.acquire(fullMethod)
+	if err != nil {
+		ns.endBlockStream()
+		return nil, err
+	}
+
+	return func() {
+		release()
+		ns.endBlockStream()
+	}, nil
+}
+
+func (ns *nodeService) startBlockStream() bool {
+	ns.mutBlockStreams.Lock()
+	defer ns.mutBlockStreams.Unlock()
+
+	if ns.numBlockStreams >= ns.maxBlockStreams {
+		return false
+	}
+	ns.numBlockStreams++
+
+	return true
+}
+
+func (ns *nodeService) endBlockStream() {
+	ns.mutBlockStreams.Lock()
+	ns.numBlockStreams--
+	ns.mutBlockStreams.Unlock()
+}
+
+func getCallSource(ctx context.Context) (string, error) {
+	remotePeer, ok := peer.FromContext(ctx)
+	if !ok || remotePeer.Addr == nil {
+		return "", ErrUnknownCallSource
+	}
+
+	host, _, err := net.SplitHostPort(remotePeer.Addr.String())
+	if err != nil {
+		return "", err
+	}
+
+	return host, nil
+}
+
+func (ns *nodeService) unaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	release, err := ns.throttle(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return handler(ctx, req)
+}
+
+func (ns *nodeService) streamInterceptor(
+	srv interface{},
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	release, err := ns.throttleStream(stream.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return handler(srv, stream)
+}
+
+// GetAccount returns the account with the provided address
+func (ns *nodeService) GetAccount(_ context.Context, request *AccountRequest) (*AccountResponse, error) {
+	options, err := blockQueryOptionsFromRequest(request.Options)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: %s", apiErrors.ErrCouldNotGetAccount.Error(), err.Error())
+	}
+
+	account, err := ns.facade.GetAccount(request.Address, options)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s: %s", apiErrors.ErrCouldNotGetAccount.Error(), err.Error())
+	}
+
+	return &AccountResponse{
+		Address:  request.Address,
+		Nonce:    account.GetNonce(),
+		Balance:  account.GetBalance().String(),
+		Username: string(account.GetUserName()),
+		Code:     hex.EncodeToString(account.GetCode()),
+		CodeHash: account.GetCodeHash(),
+		RootHash: account.GetRootHash(),
+	}, nil
+}
+
+// GetESDTBalance returns the balance of an ESDT token held by the provided address
+func (ns *nodeService) GetESDTBalance(_ context.Context, request *ESDTBalanceRequest) (*ESDTBalanceResponse, error) {
+	if len(request.Address) == 0 || len(request.TokenIdentifier) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: %s", apiErrors.ErrGetESDTBalance.Error(),
+			apiErrors.ErrEmptyAddress.Error())
+	}
+
+	balance, properties, err := ns.facade.GetESDTBalance(request.Address, request.TokenIdentifier)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s: %s", apiErrors.ErrGetESDTBalance.Error(), err.Error())
+	}
+
+	return &ESDTBalanceResponse{
+		TokenIdentifier: request.TokenIdentifier,
+		Balance:         balance,
+		Properties:      properties,
+	}, nil
+}
+
+// GetESDTTokens returns the identifiers of the ESDT tokens held by the provided address
+func (ns *nodeService) GetESDTTokens(_ context.Context, request *ESDTTokensRequest) (*ESDTTokensResponse, error) {
+	if len(request.Address) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: %s", apiErrors.ErrGetESDTTokens.Error(),
+			apiErrors.ErrEmptyAddress.Error())
+	}
+
+	tokens, err := ns.facade.GetAllESDTTokens(request.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s: %s", apiErrors.ErrGetESDTTokens.Error(), err.Error())
+	}
+
+	return &ESDTTokensResponse{Tokens: tokens}, nil
+}
+
+// SendTransaction validates the provided transaction and propagates it through the network
+func (ns *nodeService) SendTransaction(_ context.Context, request *Transaction) (*SendTransactionResponse, error) {
+	tx, txHash, err := ns.createTransaction(request)
+	if err == nil {
+		err = ns.facade.ValidateTransaction(tx)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: %s", apiErrors.ErrTxGenerationFailed.Error(), err.Error())
+	}
+
+	_, err = ns.facade.SendBulkTransactions([]*transaction.Transaction{tx})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &SendTransactionResponse{TxHash: hex.EncodeToString(txHash)}, nil
+}
+
+// SimulateTransaction simulates the execution of the provided transaction
+func (ns *nodeService) SimulateTransaction(_ context.Context, request *SimulateTransactionRequest) (*SimulationResponse, error) {
+	tx, txHash, err := ns.createTransaction(request.Tx)
+	if err == nil {
+		err = ns.facade.ValidateTransactionForSimulation(tx)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: %s", apiErrors.ErrTxGenerationFailed.Error(), err.Error())
+	}
+
+	results, err := ns.facade.SimulateTransactionExecution(tx, request.WithTrace)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response := &SimulationResponse{
+		Hash:       hex.EncodeToString(txHash),
+		Status:     string(results.Status),
+		FailReason: results.FailReason,
+		ScResults:  make([]*SmartContractResult, 0, len(results.ScResults)),
+		Logs:       make([]*LogEvent, 0, len(results.Logs)),
+	}
+	scrHashes := make([]string, 0, len(results.ScResults))
+	for scrHash := range results.ScResults {
+		scrHashes = append(scrHashes, scrHash)
+	}
+	sort.Strings(scrHashes)
+	for _, scrHash := range scrHashes {
+		response.ScResults = append(response.ScResults, smartContractResultFromApi(results.ScResults[scrHash]))
+	}
+	for _, logEvent := range results.Logs {
+		response.Logs = append(response.Logs, &LogEvent{
+			Address:    logEvent.Address,
+			Identifier: logEvent.Identifier,
+			Topics:     logEvent.Topics,
+			Data:       logEvent.Data,
+		})
+	}
+
+	return response, nil
+}
+
+// ComputeTransactionCost returns the gas limit needed by the provided transaction
+func (ns *nodeService) ComputeTransactionCost(_ context.Context, request *Transaction) (*TransactionCostResponse, error) {
+	tx, _, err := ns.createTransaction(request)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: %s", apiErrors.ErrTxGenerationFailed.Error(), err.Error())
+	}
+
+	gasLimit, err := ns.facade.ComputeTransactionGasLimit(tx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &TransactionCostResponse{GasLimit: gasLimit}, nil
+}
+
+// GetTransaction returns the transaction with the provided hash
+func (ns *nodeService) GetTransaction(_ context.Context, request *TransactionRequest) (*TransactionResponse, error) {
+	if len(request.Hash) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: %s", apiErrors.ErrValidation.Error(),
+			apiErrors.ErrValidationEmptyTxHash.Error())
+	}
+
+	tx, err := ns.facade.GetTransaction(request.Hash, request.WithResults)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s: %s", apiErrors.ErrGetTransaction.Error(), err.Error())
+	}
+
+	return transactionFromApi(tx), nil
+}
+
+// GetBlockByNonce returns the block with the provided nonce
+func (ns *nodeService) GetBlockByNonce(_ context.Context, request *BlockByNonceRequest) (*BlockResponse, error) {
+	apiBlock, err := ns.facade.GetBlockByNonce(request.Nonce, request.WithTxs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s: %s", apiErrors.ErrGetBlock.Error(), err.Error())
+	}
+
+	return blockFromApi(apiBlock), nil
+}
+
+// GetBlockByHash returns the block with the provided hash
+func (ns *nodeService) GetBlockByHash(_ context.Context, request *BlockByHashRequest) (*BlockResponse, error) {
+	if len(request.Hash) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: %s", apiErrors.ErrValidation.Error(),
+			apiErrors.ErrValidationEmptyBlockHash.Error())
+	}
+
+	apiBlock, err := ns.facade.GetBlockByHash(request.Hash, request.WithTxs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s: %s", apiErrors.ErrGetBlock.Error(), err.Error())
+	}
+
+	return blockFromApi(apiBlock), nil
+}
+
+// QueryVM executes the provided smart contract query
+func (ns *nodeService) QueryVM(_ context.Context, request *VMQueryRequest) (*VMQueryResponse, error) {
+	scQuery, err := ns.createSCQuery(request)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	vmOutput, err := ns.facade.ExecuteSCQuery(scQuery)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	response := &VMQueryResponse{
+		ReturnData:    vmOutput.ReturnData,
+		ReturnCode:    vmOutput.ReturnCode,
+		ReturnMessage: vmOutput.ReturnMessage,
+		GasRemaining:  vmOutput.GasRemaining,
+		Logs:          make([]*LogEvent, 0, len(vmOutput.Logs)),
+	}
+	for _, logEntry := range vmOutput.Logs {
+		topics := make([]string, 0, len(logEntry.Topics))
+		for _, topic := range logEntry.Topics {
+			topics = append(topics, hex.EncodeToString(topic))
+		}
+
+		response.Logs = append(response.Logs, &LogEvent{
+			Address:    logEntry.Address,
+			Identifier: string(logEntry.Identifier),
+			Topics:     topics,
+			Data:       hex.EncodeToString(logEntry.Data),
+		})
+	}
+
+	return response, nil
+}
+
+// StreamBlocks sends the new block headers until either the client cancels the stream or the node drops the
+// subscription
+func (ns *nodeService) StreamBlocks(request *StreamBlocksRequest, stream Node_StreamBlocksServer) error {
+	filter := subscription.Filter{Topic: subscription.HeadersTopic}
+	if request.HasShardID {
+		shardID := request.ShardID
+		filter.ShardID = &shardID
+	}
+
+	var fromNonce *uint64
+	if request.HasFromNonce {
+		nonce := request.FromNonce
+		fromNonce = &nonce
+	}
+
+	sub, err := ns.facade.Subscribe(filter, fromNonce)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "%s: %s", apiErrors.ErrSubscribe.Error(), err.Error())
+	}
+	defer ns.facade.Unsubscribe(sub.ID())
+
+	for {
+		select {
+		case event, isOpen := <-sub.Events():
+			if !isOpen {
+				return nil
+			}
+			if event.Header == nil {
+				continue
+			}
+
+			err = stream.Send(&BlockEvent{
+				Hash:     event.Header.Hash,
+				Nonce:    event.Header.Nonce,
+				Round:    event.Header.Round,
+				Epoch:    event.Header.Epoch,
+				ShardID:  event.Header.ShardID,
+				RootHash: event.Header.RootHash,
+				TxCount:  event.Header.TxCount,
+			})
+			if err != nil {
+				log.Debug("StreamBlocks.Send", "id", sub.ID(), "error", err.Error())
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+func (ns *nodeService) createTransaction(request *Transaction) (*transaction.Transaction, []byte, error) {
+	if request == nil {
+		return nil, nil, ErrMissingTransaction
+	}
+
+	return ns.facade.CreateTransaction(
+		request.Nonce,
+		request.Value,
+		request.Receiver,
+		request.Sender,
+		request.GasPrice,
+		request.GasLimit,
+		request.Data,
+		request.Signature,
+		request.ChainID,
+		request.Version,
+		request.Options,
+	)
+}
+
+func (ns *nodeService) createSCQuery(request *VMQueryRequest) (*process.SCQuery, error) {
+	scAddress, err := ns.facade.DecodeAddressPubkey(request.ScAddress)
+	if err != nil {
+		return nil, fmt.Errorf("'%s' is not a valid address: %s", request.ScAddress, err.Error())
+	}
+
+	options, err := blockQueryOptionsFromRequest(request.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	scQuery := &process.SCQuery{
+		ScAddress:         scAddress,
+		FuncName:          request.FuncName,
+		Arguments:         request.Args,
+		BlockQueryOptions: options,
+	}
+
+	if len(request.Caller) > 0 {
+		scQuery.CallerAddr, err = ns.facade.DecodeAddressPubkey(request.Caller)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(request.CallValue) > 0 {
+		callValue, ok := big.NewInt(0).SetString(request.CallValue, 10)
+		if !ok {
+			return nil, fmt.Errorf("non numeric call value provided: %s", request.CallValue)
+		}
+		scQuery.CallValue = callValue
+	}
+
+	return scQuery, nil
+}
+
+func blockQueryOptionsFromRequest(requestOptions *BlockQueryOptions) (state.BlockQueryOptions, error) {
+	options := state.BlockQueryOptions{}
+	if requestOptions == nil {
+		return options, nil
+	}
+
+	options.BlockNonce = requestOptions.BlockNonce
+	options.HasBlockNonce = requestOptions.HasBlockNonce
+
+	if len(requestOptions.BlockHash) > 0 {
+		blockHash, err := hex.DecodeString(requestOptions.BlockHash)
+		if err != nil {
+			return state.BlockQueryOptions{}, fmt.Errorf("%w: blockHash", apiErrors.ErrInvalidQueryParameter)
+		}
+
+		options.BlockHash = blockHash
+	}
+
+	if options.HasBlockNonce && len(options.BlockHash) > 0 {
+		return state.BlockQueryOptions{}, apiErrors.ErrBlockNonceAndHashProvided
+	}
+
+	return options, nil
+}
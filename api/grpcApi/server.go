@@ -0,0 +1,105 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=plugins=grpc:. node.proto
+package grpcApi
+
+import (
+	"net"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"google.golang.org/grpc"
+)
+
+var log = logger.GetOrCreate("api/grpcApi")
+
+// ArgsServer holds the arguments needed to create a gRPC server
+type ArgsServer struct {
+	Facade               FacadeHandler
+	SourceThrottler      SourceThrottler
+	GlobalThrottler      GlobalThrottler
+	RoutesConfig         config.ApiRoutesConfig
+	ListenAddress        string
+	MaxConcurrentStreams uint32
+	MaxBlockStreams      uint32
+}
+
+// server serves the Node gRPC service on its own port, next to the REST API
+type server struct {
+	grpcServer    *grpc.Server
+	listenAddress string
+	listener      net.Listener
+}
+
+// NewServer creates a gRPC server exposing the Node service. Each method is gated by the REST route it mirrors and
+// all the calls are limited by the source throttler of the REST API. The unary calls are also limited by the global
+// throttler, while the streams, which last until the client cancels them, have their own limit
+func NewServer(args ArgsServer) (*server, error) {
+	if len(args.ListenAddress) == 0 {
+		return nil, ErrEmptyListenAddress
+	}
+
+	if args.MaxBlockStreams == 0 {
+		return nil, ErrInvalidMaxBlockStreams
+	}
+
+	service, err := newNodeService(args.Facade, args.SourceThrottler, args.GlobalThrottler, args.RoutesConfig, args.MaxBlockStreams)
+	if err != nil {
+		return nil, err
+	}
+
+	options := []grpc.ServerOption{
+		grpc.UnaryInterceptor(service.unaryInterceptor),
+		grpc.StreamInterceptor(service.streamInterceptor),
+	}
+	if args.MaxConcurrentStreams > 0 {
+		options = append(options, grpc.MaxConcurrentStreams(args.MaxConcurrentStreams))
+	}
+
+	grpcServer := grpc.NewServer(options...)
+	RegisterNodeServer(grpcServer, service)
+
+	return &server{
+		grpcServer:    grpcServer,
+		listenAddress: args.ListenAddress,
+	}, nil
+}
+
+// Start opens the listening socket and serves the incoming connections on a separate go routine
+func (s *server) Start() error {
+	listener, err := net.Listen("tcp", s.listenAddress)
+	if err != nil {
+		return err
+	}
+	s.listener = listener
+
+	go func() {
+		log.Debug("starting gRPC server", "address", listener.Addr().String())
+		errServe := s.grpcServer.Serve(listener)
+		if errServe != nil {
+			log.Error("gRPC server stopped", "error", errServe.Error())
+		}
+	}()
+
+	return nil
+}
+
+// Address returns the address the server listens on, useful when the configured port is 0
+func (s *server) Address() string {
+	if s.listener == nil {
+		return s.listenAddress
+	}
+
+	return s.listener.Addr().String()
+}
+
+// Close stops the server, closing the open connections and cancelling the active calls. The block streams never end
+// on their own, so a graceful stop would hang while a client is subscribed
+func (s *server) Close() error {
+	s.grpcServer.Stop()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *server) IsInterfaceNil() bool {
+	return s == nil
+}
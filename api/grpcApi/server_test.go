@@ -0,0 +1,459 @@
+package grpcApi_test
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/api/grpcApi"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	coreMock "github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/core/subscription"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func getRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"address": {
+				Routes: []config.RouteConfig{
+					{Name: "/:address", Open: true},
+					{Name: "/:address/esdt", Open: false},
+					{Name: "/:address/esdt/:tokenIdentifier", Open: true, Role: "operator"},
+				},
+			},
+			"transaction": {
+				Routes: []config.RouteConfig{
+					{Name: "/send", Open: true},
+				},
+			},
+			"subscription": {
+				Routes: []config.RouteConfig{
+					{Name: "/ws", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func createMockArgsServer(facade grpcApi.FacadeHandler) grpcApi.ArgsServer {
+	return grpcApi.ArgsServer{
+		Facade:          facade,
+		SourceThrottler: &mock.SourceThrottlerStub{},
+		GlobalThrottler: &mock.GlobalThrottlerStub{},
+		RoutesConfig:    getRoutesConfig(),
+		ListenAddress:   "127.0.0.1:0",
+		MaxBlockStreams: 10,
+	}
+}
+
+func startServer(t *testing.T, facade grpcApi.FacadeHandler) (grpcApi.NodeClient, func()) {
+	return startServerWithArgs(t, createMockArgsServer(facade))
+}
+
+func startServerWithArgs(t *testing.T, args grpcApi.ArgsServer) (grpcApi.NodeClient, func()) {
+	server, err := grpcApi.NewServer(args)
+	require.Nil(t, err)
+	require.Nil(t, server.Start())
+
+	conn, err := grpc.Dial(server.Address(), grpc.WithInsecure())
+	require.Nil(t, err)
+
+	return grpcApi.NewNodeClient(conn), func() {
+		_ = conn.Close()
+		_ = server.Close()
+	}
+}
+
+func TestNewServer_NilFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	server, err := grpcApi.NewServer(createMockArgsServer(nil))
+
+	assert.True(t, check.IfNil(server))
+	assert.Equal(t, grpcApi.ErrNilFacade, err)
+}
+
+func TestNewServer_NilSourceThrottlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsServer(&mock.Facade{})
+	args.SourceThrottler = nil
+	server, err := grpcApi.NewServer(args)
+
+	assert.True(t, check.IfNil(server))
+	assert.Equal(t, grpcApi.ErrNilSourceThrottler, err)
+}
+
+func TestNewServer_NilGlobalThrottlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsServer(&mock.Facade{})
+	args.GlobalThrottler = nil
+	server, err := grpcApi.NewServer(args)
+
+	assert.True(t, check.IfNil(server))
+	assert.Equal(t, grpcApi.ErrNilGlobalThrottler, err)
+}
+
+func TestNewServer_EmptyListenAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsServer(&mock.Facade{})
+	args.ListenAddress = ""
+	server, err := grpcApi.NewServer(args)
+
+	assert.True(t, check.IfNil(server))
+	assert.Equal(t, grpcApi.ErrEmptyListenAddress, err)
+}
+
+func TestNewServer_ZeroMaxBlockStreamsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsServer(&mock.Facade{})
+	args.MaxBlockStreams = 0
+	server, err := grpcApi.NewServer(args)
+
+	assert.True(t, check.IfNil(server))
+	assert.Equal(t, grpcApi.ErrInvalidMaxBlockStreams, err)
+}
+
+func TestNewServer(t *testing.T) {
+	t.Parallel()
+
+	server, err := grpcApi.NewServer(createMockArgsServer(&mock.Facade{}))
+
+	assert.False(t, check.IfNil(server))
+	assert.Nil(t, err)
+}
+
+func TestServer_MethodsShouldBeGatedByTheMirroredRoutes(t *testing.T) {
+	t.Parallel()
+
+	client, closeFunc := startServer(t, &mock.Facade{})
+	defer closeFunc()
+
+	_, err := client.GetESDTTokens(context.Background(), &grpcApi.ESDTTokensRequest{Address: "address"})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	_, err = client.GetESDTBalance(context.Background(), &grpcApi.ESDTBalanceRequest{Address: "address", TokenIdentifier: "TKN"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = client.QueryVM(context.Background(), &grpcApi.VMQueryRequest{})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestServer_GetAccount(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetAccountHandler: func(address string, options state.BlockQueryOptions) (state.UserAccountHandler, error) {
+			if options.BlockNonce != 7 || !options.HasBlockNonce {
+				return nil, errors.New("unexpected options")
+			}
+
+			acc, _ := state.NewUserAccount([]byte("1234"))
+			_ = acc.AddToBalance(big.NewInt(100))
+			acc.IncreaseNonce(1)
+
+			return acc, nil
+		},
+	}
+	client, closeFunc := startServer(t, facade)
+	defer closeFunc()
+
+	response, err := client.GetAccount(context.Background(), &grpcApi.AccountRequest{
+		Address: "address",
+		Options: &grpcApi.BlockQueryOptions{BlockNonce: 7, HasBlockNonce: true},
+	})
+	require.Nil(t, err)
+	assert.Equal(t, "address", response.Address)
+	assert.Equal(t, uint64(1), response.Nonce)
+	assert.Equal(t, "100", response.Balance)
+
+	_, err = client.GetAccount(context.Background(), &grpcApi.AccountRequest{
+		Address: "address",
+		Options: &grpcApi.BlockQueryOptions{BlockNonce: 7, HasBlockNonce: true, BlockHash: "aa"},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.GetAccount(context.Background(), &grpcApi.AccountRequest{Address: "address"})
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestServer_SendTransaction(t *testing.T) {
+	t.Parallel()
+
+	txHash := []byte("tx hash")
+	sentTxs := make([]*transaction.Transaction, 0)
+	throttler := &mock.ThrottlerStub{
+		CanProcessCalled: func() bool {
+			return true
+		},
+	}
+	facade := &mock.Facade{
+		CreateTransactionHandler: func(nonce uint64, value string, receiver string, sender string, gasPrice uint64,
+			gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*transaction.Transaction, []byte, error) {
+			valueBig, _ := big.NewInt(0).SetString(value, 10)
+			return &transaction.Transaction{Nonce: nonce, Value: valueBig, Data: data}, txHash, nil
+		},
+		ValidateTransactionHandler: func(tx *transaction.Transaction) error {
+			return nil
+		},
+		SendBulkTransactionsHandler: func(txs []*transaction.Transaction) (uint64, error) {
+			sentTxs = append(sentTxs, txs...)
+			return uint64(len(txs)), nil
+		},
+		GetThrottlerForEndpointCalled: func(endpoint string) (core.Throttler, bool) {
+			return throttler, endpoint == "/transaction/send"
+		},
+	}
+	client, closeFunc := startServer(t, facade)
+	defer closeFunc()
+
+	response, err := client.SendTransaction(context.Background(), &grpcApi.Transaction{
+		Nonce: 3,
+		Value: "10",
+		Data:  []byte("data"),
+	})
+	require.Nil(t, err)
+	assert.Equal(t, hex.EncodeToString(txHash), response.TxHash)
+	require.Equal(t, 1, len(sentTxs))
+	assert.Equal(t, uint64(3), sentTxs[0].Nonce)
+	assert.Equal(t, []byte("data"), sentTxs[0].Data)
+	assert.True(t, throttler.StartWasCalled)
+	assert.True(t, throttler.EndWasCalled)
+}
+
+func TestServer_SendTransactionThrottledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetThrottlerForEndpointCalled: func(_ string) (core.Throttler, bool) {
+			return &mock.ThrottlerStub{
+				CanProcessCalled: func() bool {
+					return false
+				},
+			}, true
+		},
+	}
+	client, closeFunc := startServer(t, facade)
+	defer closeFunc()
+
+	_, err := client.SendTransaction(context.Background(), &grpcApi.Transaction{})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestServer_CallsShouldBeLimitedBySourceThrottler(t *testing.T) {
+	t.Parallel()
+
+	throttledSource := atomic.String{}
+	args := createMockArgsServer(&mock.Facade{})
+	args.SourceThrottler = &mock.SourceThrottlerStub{
+		CanProcessSourceCalled: func(source string) bool {
+			throttledSource.Set(source)
+			return false
+		},
+	}
+	client, closeFunc := startServerWithArgs(t, args)
+	defer closeFunc()
+
+	_, err := client.GetAccount(context.Background(), &grpcApi.AccountRequest{Address: "address"})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, "127.0.0.1", throttledSource.Get())
+
+	stream, err := client.StreamBlocks(context.Background(), &grpcApi.StreamBlocksRequest{})
+	require.Nil(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestServer_CallsShouldBeLimitedByGlobalThrottler(t *testing.T) {
+	t.Parallel()
+
+	numStarted := atomic.Counter{}
+	numEnded := atomic.Counter{}
+	args := createMockArgsServer(&mock.Facade{})
+	args.GlobalThrottler = &mock.GlobalThrottlerStub{
+		StartProcessingCalled: func(path string) bool {
+			return numStarted.Increment() == 1
+		},
+		EndProcessingCalled: func(path string) {
+			numEnded.Increment()
+		},
+	}
+	client, closeFunc := startServerWithArgs(t, args)
+	defer closeFunc()
+
+	// the slot is released even if the method is not available
+	_, err := client.QueryVM(context.Background(), &grpcApi.VMQueryRequest{})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+	assert.Equal(t, int64(1), numEnded.Get())
+
+	_, err = client.GetAccount(context.Background(), &grpcApi.AccountRequest{Address: "address"})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, int64(1), numEnded.Get())
+}
+
+func createSubscriptionFacade(t *testing.T, maxSubscribers uint32) *mock.Facade {
+	hub, err := subscription.NewHub(subscription.ArgsHub{
+		AddressPubkeyConverter: coreMock.NewPubkeyConverterMock(32),
+		ReplayBufferSize:       10,
+		SubscriberQueueSize:    10,
+		MaxSubscribers:         maxSubscribers,
+	})
+	require.Nil(t, err)
+	hub.NotifyCommittedBlock([]byte("header hash"), &block.Header{Nonce: 5}, &block.Body{})
+
+	return &mock.Facade{
+		SubscribeCalled: func(filter subscription.Filter, fromNonce *uint64) (*subscription.Subscription, error) {
+			return hub.Subscribe(filter, fromNonce)
+		},
+		UnsubscribeCalled: func(subscriptionID uint64) {
+			hub.Unsubscribe(subscriptionID)
+		},
+		GetAccountHandler: func(address string, options state.BlockQueryOptions) (state.UserAccountHandler, error) {
+			return state.NewUserAccount([]byte("1234"))
+		},
+	}
+}
+
+func openBlockStream(t *testing.T, ctx context.Context, client grpcApi.NodeClient) {
+	fromNonce := uint64(5)
+	stream, err := client.StreamBlocks(ctx, &grpcApi.StreamBlocksRequest{HasFromNonce: true, FromNonce: fromNonce})
+	require.Nil(t, err)
+
+	// the replayed block is received only after the stream got through the interceptor
+	event, err := stream.Recv()
+	require.Nil(t, err)
+	require.Equal(t, fromNonce, event.Nonce)
+}
+
+func TestServer_LongLivedBlockStreamShouldNotUseTheGlobalThrottler(t *testing.T) {
+	t.Parallel()
+
+	// the global throttler of the REST API, which processes a single request at a time
+	numInProgress := atomic.Counter{}
+	streamsThrottled := atomic.Flag{}
+	args := createMockArgsServer(createSubscriptionFacade(t, 1))
+	args.GlobalThrottler = &mock.GlobalThrottlerStub{
+		StartProcessingCalled: func(path string) bool {
+			if strings.HasSuffix(path, "/StreamBlocks") {
+				streamsThrottled.Set()
+			}
+			if numInProgress.Increment() > 1 {
+				numInProgress.Decrement()
+				return false
+			}
+			return true
+		},
+		EndProcessingCalled: func(path string) {
+			numInProgress.Decrement()
+		},
+	}
+	client, closeFunc := startServerWithArgs(t, args)
+	defer closeFunc()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	openBlockStream(t, ctx, client)
+
+	_, err := client.GetAccount(context.Background(), &grpcApi.AccountRequest{Address: "address"})
+	assert.Nil(t, err)
+	assert.False(t, streamsThrottled.IsSet())
+	assert.Equal(t, int64(0), numInProgress.Get())
+}
+
+func TestServer_BlockStreamsShouldBeLimited(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsServer(createSubscriptionFacade(t, 10))
+	args.MaxBlockStreams = 1
+	client, closeFunc := startServerWithArgs(t, args)
+	defer closeFunc()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	openBlockStream(t, ctx, client)
+
+	stream, err := client.StreamBlocks(context.Background(), &grpcApi.StreamBlocksRequest{})
+	require.Nil(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// the slot is released once the client cancels its stream
+	cancel()
+	assert.Eventually(t, func() bool {
+		streamCtx, streamCancel := context.WithCancel(context.Background())
+		defer streamCancel()
+
+		newStream, errStream := client.StreamBlocks(streamCtx, &grpcApi.StreamBlocksRequest{HasFromNonce: true, FromNonce: 5})
+		if errStream != nil {
+			return false
+		}
+		_, errStream = newStream.Recv()
+		return errStream == nil
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestServer_StreamBlocks(t *testing.T) {
+	t.Parallel()
+
+	hub, err := subscription.NewHub(subscription.ArgsHub{
+		AddressPubkeyConverter: coreMock.NewPubkeyConverterMock(32),
+		ReplayBufferSize:       10,
+		SubscriberQueueSize:    10,
+		MaxSubscribers:         1,
+	})
+	require.Nil(t, err)
+	hub.NotifyCommittedBlock([]byte("header hash"), &block.Header{Nonce: 5, Round: 6, ShardID: 1}, &block.Body{})
+
+	var receivedFilter subscription.Filter
+	chanUnsubscribed := make(chan uint64, 1)
+	facade := &mock.Facade{
+		SubscribeCalled: func(filter subscription.Filter, fromNonce *uint64) (*subscription.Subscription, error) {
+			receivedFilter = filter
+			return hub.Subscribe(filter, fromNonce)
+		},
+		UnsubscribeCalled: func(subscriptionID uint64) {
+			hub.Unsubscribe(subscriptionID)
+			chanUnsubscribed <- subscriptionID
+		},
+	}
+	client, closeFunc := startServer(t, facade)
+	defer closeFunc()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := client.StreamBlocks(ctx, &grpcApi.StreamBlocksRequest{
+		HasShardID:   true,
+		ShardID:      1,
+		HasFromNonce: true,
+		FromNonce:    5,
+	})
+	require.Nil(t, err)
+
+	event, err := stream.Recv()
+	require.Nil(t, err)
+	assert.Equal(t, uint64(5), event.Nonce)
+	assert.Equal(t, uint64(6), event.Round)
+	assert.Equal(t, hex.EncodeToString([]byte("header hash")), event.Hash)
+	assert.Equal(t, subscription.HeadersTopic, receivedFilter.Topic)
+	assert.Equal(t, uint32(1), *receivedFilter.ShardID)
+
+	cancel()
+	<-chanUnsubscribed
+}
@@ -0,0 +1,156 @@
+package hyperblock
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	getHyperblockByNoncePath = "/by-nonce/:nonce"
+	getHyperblockByHashPath  = "/by-hash/:hash"
+)
+
+var log = logger.GetOrCreate("api/hyperblock")
+
+// HyperblockService interface defines methods that can be used from `elrondFacade` context variable
+type HyperblockService interface {
+	GetHyperblockByHash(hash string) (*APIHyperblock, error)
+	GetHyperblockByNonce(nonce uint64) (*APIHyperblock, error)
+}
+
+// APIHyperblock represents the structure for a metachain block together with all the shard blocks it notarizes and
+// the transactions executed in them
+type APIHyperblock struct {
+	Nonce         uint64                              `json:"nonce"`
+	Round         uint64                              `json:"round"`
+	Hash          string                              `json:"hash"`
+	PrevBlockHash string                              `json:"prevBlockHash"`
+	Epoch         uint32                              `json:"epoch"`
+	NumTxs        uint32                              `json:"numTxs"`
+	ShardBlocks   []*APINotarizedShardBlock           `json:"shardBlocks"`
+	Transactions  []*transaction.ApiTransactionResult `json:"transactions"`
+}
+
+// APINotarizedShardBlock represents a shard block notarized by the metachain block of a hyperblock
+type APINotarizedShardBlock struct {
+	Hash  string `json:"hash"`
+	Nonce uint64 `json:"nonce"`
+	Round uint64 `json:"round"`
+	Shard uint32 `json:"shard"`
+}
+
+// Routes defines hyperblock related routes
+func Routes(routes *wrapper.RouterWrapper) {
+	routes.RegisterHandler(http.MethodGet, getHyperblockByNoncePath, getHyperblockByNonce)
+	routes.RegisterHandler(http.MethodGet, getHyperblockByHashPath, getHyperblockByHash)
+}
+
+func getHyperblockByNonce(c *gin.Context) {
+	ef, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	nonce, err := getQueryParamNonce(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidBlockNonce.Error()),
+		)
+		return
+	}
+
+	start := time.Now()
+	hyperblock, err := ef.GetHyperblockByNonce(nonce)
+	log.Debug(fmt.Sprintf("GetHyperblockByNonce took %s", time.Since(start)))
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetHyperblock.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"hyperblock": hyperblock}, "", shared.ReturnCodeSuccess)
+}
+
+func getHyperblockByHash(c *gin.Context) {
+	ef, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	hash := c.Param("hash")
+	if hash == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyBlockHash.Error()),
+		)
+		return
+	}
+
+	start := time.Now()
+	hyperblock, err := ef.GetHyperblockByHash(hash)
+	log.Debug(fmt.Sprintf("GetHyperblockByHash took %s", time.Since(start)))
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetHyperblock.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"hyperblock": hyperblock}, "", shared.ReturnCodeSuccess)
+}
+
+func getQueryParamNonce(c *gin.Context) (uint64, error) {
+	nonceStr := c.Param("nonce")
+	if nonceStr == "" {
+		return 0, errors.ErrInvalidBlockNonce
+	}
+
+	return strconv.ParseUint(nonceStr, 10, 64)
+}
+
+func getFacade(c *gin.Context) (HyperblockService, bool) {
+	facadeObj, ok := c.Get("facade")
+	if !ok {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrNilAppContext.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return nil, false
+	}
+
+	facade, ok := facadeObj.(HyperblockService)
+	if !ok {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrInvalidAppContext.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return nil, false
+	}
+
+	return facade, true
+}
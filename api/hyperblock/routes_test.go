@@ -0,0 +1,239 @@
+package hyperblock_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/hyperblock"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type hyperblockResponseData struct {
+	Hyperblock hyperblock.APIHyperblock `json:"hyperblock"`
+}
+
+type hyperblockResponse struct {
+	Data  hyperblockResponseData `json:"data"`
+	Error string                 `json:"error"`
+	Code  string                 `json:"code"`
+}
+
+func TestGetHyperblockByNonce_NilContextShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(nil)
+
+	req, _ := http.NewRequest("GET", "/hyperblock/by-nonce/5", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrNilAppContext.Error()))
+}
+
+func TestGetHyperblockByNonce_WrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+
+	req, _ := http.NewRequest("GET", "/hyperblock/by-nonce/2", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := hyperblockResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidAppContext.Error()))
+}
+
+func TestGetHyperblockByNonce_InvalidNonceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetHyperblockByNonceCalled: func(_ uint64) (*hyperblock.APIHyperblock, error) {
+			return &hyperblock.APIHyperblock{}, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/hyperblock/by-nonce/invalid", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := hyperblockResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidBlockNonce.Error()))
+}
+
+func TestGetHyperblockByNonce_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("local err")
+	facade := mock.Facade{
+		GetHyperblockByNonceCalled: func(_ uint64) (*hyperblock.APIHyperblock, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/hyperblock/by-nonce/37", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := hyperblockResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetHyperblock.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetHyperblockByNonce_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedHyperblock := hyperblock.APIHyperblock{
+		Nonce:  37,
+		Round:  39,
+		NumTxs: 1,
+		ShardBlocks: []*hyperblock.APINotarizedShardBlock{
+			{Hash: "aa", Nonce: 20, Round: 38, Shard: 1},
+		},
+		Transactions: []*transaction.ApiTransactionResult{
+			{Hash: "bb", Status: transaction.TxStatusSuccess},
+		},
+	}
+	facade := mock.Facade{
+		GetHyperblockByNonceCalled: func(nonce uint64) (*hyperblock.APIHyperblock, error) {
+			assert.Equal(t, uint64(37), nonce)
+			return &expectedHyperblock, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/hyperblock/by-nonce/37", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := hyperblockResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedHyperblock, response.Data.Hyperblock)
+}
+
+func TestGetHyperblockByHash_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("local err")
+	facade := mock.Facade{
+		GetHyperblockByHashCalled: func(_ string) (*hyperblock.APIHyperblock, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/hyperblock/by-hash/aa", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := hyperblockResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetHyperblock.Error()))
+}
+
+func TestGetHyperblockByHash_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedHyperblock := hyperblock.APIHyperblock{
+		Nonce: 37,
+		Hash:  "aa",
+	}
+	facade := mock.Facade{
+		GetHyperblockByHashCalled: func(hash string) (*hyperblock.APIHyperblock, error) {
+			assert.Equal(t, "aa", hash)
+			return &expectedHyperblock, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/hyperblock/by-hash/aa", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := hyperblockResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedHyperblock.Nonce, response.Data.Hyperblock.Nonce)
+	assert.Equal(t, expectedHyperblock.Hash, response.Data.Hyperblock.Hash)
+}
+
+func startNodeServer(handler hyperblock.HyperblockService) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	hyperblockRoutes := ws.Group("/hyperblock")
+	if handler != nil {
+		hyperblockRoutes.Use(middleware.WithFacade(handler))
+	}
+	hyperblockRoute, _ := wrapper.NewRouterWrapper("hyperblock", hyperblockRoutes, getRoutesConfig())
+	hyperblock.Routes(hyperblockRoute)
+	return ws
+}
+
+func startNodeServerWrongFacade() *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("facade", mock.WrongFacade{})
+	})
+	ginHyperblockRoute := ws.Group("/hyperblock")
+	hyperblockRoute, _ := wrapper.NewRouterWrapper("hyperblock", ginHyperblockRoute, getRoutesConfig())
+	hyperblock.Routes(hyperblockRoute)
+	return ws
+}
+
+func getRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"hyperblock": {
+				Routes: []config.RouteConfig{
+					{Name: "/by-nonce/:nonce", Open: true},
+					{Name: "/by-hash/:hash", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	err := jsonParser.Decode(destination)
+	logError(err)
+}
+
+func logError(err error) {
+	if err != nil {
+		fmt.Println(err)
+	}
+}
@@ -0,0 +1,305 @@
+package middleware
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/gin-gonic/gin"
+)
+
+// ApiKeyNameContextKey is the gin context key under which the name of the API key authenticating the request is stored
+const ApiKeyNameContextKey = "apiKeyName"
+
+// logPackageName is the package of the logger route, which is registered on the root of the server
+const logPackageName = "log"
+
+var auditLog = logger.GetOrCreate("api/audit")
+
+// Role defines the access level required by a route or granted by an API key
+type Role uint8
+
+const (
+	// RolePublic is the access level of the routes which do not require an API key
+	RolePublic Role = iota
+	// RoleOperator is the access level of the routes exposing node internals
+	RoleOperator
+	// RoleAdmin is the access level of the routes changing the node behaviour
+	RoleAdmin
+)
+
+// String returns the name of the role, as used in the configuration
+func (r Role) String() string {
+	switch r {
+	case RolePublic:
+		return "public"
+	case RoleOperator:
+		return "operator"
+	case RoleAdmin:
+		return "admin"
+	default:
+		return fmt.Sprintf("unknown role %d", r)
+	}
+}
+
+// ParseRole returns the role with the provided name. An empty name stands for the public role
+func ParseRole(name string) (Role, error) {
+	switch strings.ToLower(name) {
+	case "", "public":
+		return RolePublic, nil
+	case "operator":
+		return RoleOperator, nil
+	case "admin":
+		return RoleAdmin, nil
+	default:
+		return RolePublic, fmt.Errorf("%w: %s", ErrInvalidRole, name)
+	}
+}
+
+type apiKey struct {
+	name        string
+	role        Role
+	maxRequests uint32
+}
+
+// apiKeyAuthenticator is a middleware which authenticates the requests by their API key, enforces the role required
+// by each route and limits the number of requests done with each key
+type apiKeyAuthenticator struct {
+	keyHeader       string
+	auditLogEnabled bool
+	keys            map[string]*apiKey
+	routeRoles      map[string]Role
+	mutRequests     sync.Mutex
+	keyRequests     map[string]uint32
+}
+
+// NewApiKeyAuthenticator creates a new instance of an apiKeyAuthenticator
+func NewApiKeyAuthenticator(routesConfig config.ApiRoutesConfig) (*apiKeyAuthenticator, error) {
+	authConfig := routesConfig.Authentication
+	if len(authConfig.KeyHeader) == 0 {
+		return nil, ErrEmptyApiKeyHeader
+	}
+
+	keys, err := createApiKeys(authConfig.Keys)
+	if err != nil {
+		return nil, err
+	}
+
+	routeRoles, err := createRouteRoles(routesConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiKeyAuthenticator{
+		keyHeader:       authConfig.KeyHeader,
+		auditLogEnabled: authConfig.AuditLogEnabled,
+		keys:            keys,
+		routeRoles:      routeRoles,
+		keyRequests:     make(map[string]uint32),
+	}, nil
+}
+
+func createApiKeys(keysConfig []config.ApiKeyConfig) (map[string]*apiKey, error) {
+	keys := make(map[string]*apiKey, len(keysConfig))
+	names := make(map[string]struct{}, len(keysConfig))
+	for _, keyConfig := range keysConfig {
+		if len(keyConfig.Name) == 0 {
+			return nil, ErrEmptyApiKeyName
+		}
+		_, exists := names[keyConfig.Name]
+		if exists {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicatedApiKey, keyConfig.Name)
+		}
+
+		keyHash := strings.ToLower(keyConfig.KeyHash)
+		decodedHash, err := hex.DecodeString(keyHash)
+		if err != nil || len(decodedHash) != sha256.Size {
+			return nil, fmt.Errorf("%w for API key %s", ErrInvalidApiKeyHash, keyConfig.Name)
+		}
+		_, exists = keys[keyHash]
+		if exists {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicatedApiKey, keyConfig.Name)
+		}
+
+		role, err := ParseRole(keyConfig.Role)
+		if err != nil {
+			return nil, fmt.Errorf("%w for API key %s", err, keyConfig.Name)
+		}
+
+		names[keyConfig.Name] = struct{}{}
+		keys[keyHash] = &apiKey{
+			name:        keyConfig.Name,
+			role:        role,
+			maxRequests: keyConfig.MaxRequests,
+		}
+	}
+
+	return keys, nil
+}
+
+func createRouteRoles(routesConfig config.ApiRoutesConfig) (map[string]Role, error) {
+	routeRoles := make(map[string]Role)
+	for packageName, packageConfig := range routesConfig.APIPackages {
+		for _, route := range packageConfig.Routes {
+			role, err := ParseRole(route.Role)
+			if err != nil {
+				return nil, fmt.Errorf("%w for route %s of package %s", err, route.Name, packageName)
+			}
+
+			path := "/" + packageName + route.Name
+			if packageName == logPackageName {
+				path = route.Name
+			}
+			routeRoles[path] = role
+		}
+	}
+
+	return routeRoles, nil
+}
+
+// MiddlewareHandlerFunc returns the handler func used by the gin server when processing requests
+func (aka *apiKeyAuthenticator) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key, isKeyValid := aka.authenticate(c)
+		if !isKeyValid {
+			aka.abort(c, http.StatusUnauthorized, ErrInvalidApiKey.Error(), shared.ReturnCodeUnauthorized)
+			return
+		}
+
+		requiredRole := aka.requiredRole(c)
+		if requiredRole > RolePublic {
+			if !aka.hasRole(key, requiredRole) {
+				aka.auditDeniedCall(c, key, requiredRole)
+				aka.abort(c, http.StatusForbidden, ErrMissingApiRole.Error(), shared.ReturnCodeUnauthorized)
+				return
+			}
+
+			defer aka.auditCall(c, key)
+		}
+
+		if key == nil {
+			c.Next()
+			return
+		}
+
+		if !aka.canProcess(key) {
+			aka.abort(
+				c,
+				http.StatusTooManyRequests,
+				fmt.Sprintf("%s for API key %s", ErrTooManyRequests.Error(), key.name),
+				shared.ReturnCodeSystemBusy,
+			)
+			return
+		}
+
+		c.Set(ApiKeyNameContextKey, key.name)
+		c.Next()
+	}
+}
+
+// authenticate returns the API key provided in the request, if any, and false if the provided key is unknown
+func (aka *apiKeyAuthenticator) authenticate(c *gin.Context) (*apiKey, bool) {
+	providedKey := c.GetHeader(aka.keyHeader)
+	if len(providedKey) == 0 {
+		return nil, true
+	}
+
+	keyHash := sha256.Sum256([]byte(providedKey))
+	key, ok := aka.keys[hex.EncodeToString(keyHash[:])]
+
+	return key, ok
+}
+
+// requiredRole returns the role required by the matched route. The registered routes missing from the routes
+// configuration, such as the pprof ones, are only available to the admin keys
+func (aka *apiKeyAuthenticator) requiredRole(c *gin.Context) Role {
+	route := c.FullPath()
+	if len(route) == 0 {
+		return RolePublic
+	}
+
+	role, ok := aka.routeRoles[route]
+	if !ok {
+		return RoleAdmin
+	}
+
+	return role
+}
+
+func (aka *apiKeyAuthenticator) hasRole(key *apiKey, role Role) bool {
+	return key != nil && key.role >= role
+}
+
+func (aka *apiKeyAuthenticator) canProcess(key *apiKey) bool {
+	aka.mutRequests.Lock()
+	defer aka.mutRequests.Unlock()
+
+	requests := aka.keyRequests[key.name]
+	if key.maxRequests > 0 && requests >= key.maxRequests {
+		return false
+	}
+	aka.keyRequests[key.name]++
+
+	return true
+}
+
+func (aka *apiKeyAuthenticator) abort(c *gin.Context, status int, errMessage string, code shared.ReturnCode) {
+	c.AbortWithStatusJSON(
+		status,
+		shared.GenericAPIResponse{
+			Data:  nil,
+			Error: errMessage,
+			Code:  code,
+		},
+	)
+}
+
+func (aka *apiKeyAuthenticator) auditCall(c *gin.Context, key *apiKey) {
+	if !aka.auditLogEnabled {
+		return
+	}
+
+	auditLog.Info("privileged API call",
+		"key", key.name,
+		"role", key.role.String(),
+		"method", c.Request.Method,
+		"path", c.Request.URL.Path,
+		"source", c.ClientIP(),
+		"status", c.Writer.Status())
+}
+
+func (aka *apiKeyAuthenticator) auditDeniedCall(c *gin.Context, key *apiKey, requiredRole Role) {
+	if !aka.auditLogEnabled {
+		return
+	}
+
+	keyName := ""
+	if key != nil {
+		keyName = key.name
+	}
+
+	auditLog.Warn("denied privileged API call",
+		"key", keyName,
+		"required role", requiredRole.String(),
+		"method", c.Request.Method,
+		"path", c.Request.URL.Path,
+		"source", c.ClientIP())
+}
+
+// Reset resets the requests counters of all API keys
+func (aka *apiKeyAuthenticator) Reset() {
+	aka.mutRequests.Lock()
+	aka.keyRequests = make(map[string]uint32)
+	aka.mutRequests.Unlock()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (aka *apiKeyAuthenticator) IsInterfaceNil() bool {
+	return aka == nil
+}
@@ -0,0 +1,245 @@
+package middleware_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+const apiKeyHeader = "X-Api-Key"
+const operatorKey = "operator key"
+const adminKey = "admin key"
+
+func hashApiKey(key string) string {
+	keyHash := sha256.Sum256([]byte(key))
+
+	return hex.EncodeToString(keyHash[:])
+}
+
+func getAuthRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"node": {
+				Routes: []config.RouteConfig{
+					{Name: "/status", Open: true},
+					{Name: "/debug", Open: true, Role: "operator"},
+				},
+			},
+			"hardfork": {
+				Routes: []config.RouteConfig{
+					{Name: "/trigger", Open: true, Role: "admin"},
+				},
+			},
+			"log": {
+				Routes: []config.RouteConfig{
+					{Name: "/log", Open: true, Role: "operator"},
+				},
+			},
+		},
+		Authentication: config.ApiAuthenticationConfig{
+			Enabled:                 true,
+			KeyHeader:               apiKeyHeader,
+			AuditLogEnabled:         true,
+			QuotaResetIntervalInSec: 1,
+			Keys: []config.ApiKeyConfig{
+				{Name: "operator", KeyHash: hashApiKey(operatorKey), Role: "operator", MaxRequests: 2},
+				{Name: "admin", KeyHash: hashApiKey(adminKey), Role: "admin"},
+			},
+		},
+	}
+}
+
+func startNodeServerApiKeyAuthenticator(routesConfig config.ApiRoutesConfig, maxSourceRequests uint32) *gin.Engine {
+	ws := gin.New()
+	authenticator, _ := middleware.NewApiKeyAuthenticator(routesConfig)
+	sourceThrottler, _ := middleware.NewSourceThrottler(maxSourceRequests)
+	ws.Use(authenticator.MiddlewareHandlerFunc(), sourceThrottler.MiddlewareHandlerFunc())
+
+	okHandler := func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{})
+	}
+	ws.GET("/node/status", okHandler)
+	ws.GET("/node/debug", okHandler)
+	ws.POST("/hardfork/trigger", okHandler)
+	ws.GET("/log", okHandler)
+	ws.GET("/debug/pprof/heap", okHandler)
+
+	return ws
+}
+
+func makeRequestWithApiKey(ws *gin.Engine, method string, path string, key string) int {
+	req, _ := http.NewRequest(method, path, nil)
+	req.RemoteAddr = "127.0.0.1:8080"
+	if len(key) > 0 {
+		req.Header.Set(apiKeyHeader, key)
+	}
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp.Code
+}
+
+func TestNewApiKeyAuthenticator_EmptyKeyHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	routesConfig := getAuthRoutesConfig()
+	routesConfig.Authentication.KeyHeader = ""
+	aka, err := middleware.NewApiKeyAuthenticator(routesConfig)
+
+	assert.True(t, check.IfNil(aka))
+	assert.Equal(t, middleware.ErrEmptyApiKeyHeader, err)
+}
+
+func TestNewApiKeyAuthenticator_InvalidKeyHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	routesConfig := getAuthRoutesConfig()
+	routesConfig.Authentication.Keys[0].KeyHash = "not a hash"
+	aka, err := middleware.NewApiKeyAuthenticator(routesConfig)
+
+	assert.True(t, check.IfNil(aka))
+	assert.True(t, errors.Is(err, middleware.ErrInvalidApiKeyHash))
+}
+
+func TestNewApiKeyAuthenticator_DuplicatedKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	routesConfig := getAuthRoutesConfig()
+	routesConfig.Authentication.Keys[1].KeyHash = routesConfig.Authentication.Keys[0].KeyHash
+	aka, err := middleware.NewApiKeyAuthenticator(routesConfig)
+
+	assert.True(t, check.IfNil(aka))
+	assert.True(t, errors.Is(err, middleware.ErrDuplicatedApiKey))
+}
+
+func TestNewApiKeyAuthenticator_InvalidRoleShouldErr(t *testing.T) {
+	t.Parallel()
+
+	routesConfig := getAuthRoutesConfig()
+	routesConfig.Authentication.Keys[0].Role = "root"
+	aka, err := middleware.NewApiKeyAuthenticator(routesConfig)
+	assert.True(t, check.IfNil(aka))
+	assert.True(t, errors.Is(err, middleware.ErrInvalidRole))
+
+	routesConfig = getAuthRoutesConfig()
+	routesConfig.APIPackages["node"].Routes[0].Role = "root"
+	aka, err = middleware.NewApiKeyAuthenticator(routesConfig)
+	assert.True(t, check.IfNil(aka))
+	assert.True(t, errors.Is(err, middleware.ErrInvalidRole))
+}
+
+func TestNewApiKeyAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	aka, err := middleware.NewApiKeyAuthenticator(getAuthRoutesConfig())
+
+	assert.False(t, check.IfNil(aka))
+	assert.Nil(t, err)
+}
+
+func TestApiKeyAuthenticator_PublicRouteShouldNotRequireKey(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerApiKeyAuthenticator(getAuthRoutesConfig(), 10)
+
+	assert.Equal(t, http.StatusOK, makeRequestWithApiKey(ws, http.MethodGet, "/node/status", ""))
+	assert.Equal(t, http.StatusOK, makeRequestWithApiKey(ws, http.MethodGet, "/node/status", operatorKey))
+}
+
+func TestApiKeyAuthenticator_UnknownKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerApiKeyAuthenticator(getAuthRoutesConfig(), 10)
+
+	assert.Equal(t, http.StatusUnauthorized, makeRequestWithApiKey(ws, http.MethodGet, "/node/status", "unknown key"))
+}
+
+func TestApiKeyAuthenticator_PrivilegedRouteShouldRequireRole(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerApiKeyAuthenticator(getAuthRoutesConfig(), 10)
+
+	assert.Equal(t, http.StatusForbidden, makeRequestWithApiKey(ws, http.MethodGet, "/node/debug", ""))
+	assert.Equal(t, http.StatusOK, makeRequestWithApiKey(ws, http.MethodGet, "/node/debug", operatorKey))
+	assert.Equal(t, http.StatusOK, makeRequestWithApiKey(ws, http.MethodGet, "/log", adminKey))
+
+	assert.Equal(t, http.StatusForbidden, makeRequestWithApiKey(ws, http.MethodPost, "/hardfork/trigger", ""))
+	assert.Equal(t, http.StatusForbidden, makeRequestWithApiKey(ws, http.MethodPost, "/hardfork/trigger", operatorKey))
+	assert.Equal(t, http.StatusOK, makeRequestWithApiKey(ws, http.MethodPost, "/hardfork/trigger", adminKey))
+}
+
+func TestApiKeyAuthenticator_UnconfiguredRouteShouldRequireAdmin(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerApiKeyAuthenticator(getAuthRoutesConfig(), 10)
+
+	assert.Equal(t, http.StatusForbidden, makeRequestWithApiKey(ws, http.MethodGet, "/debug/pprof/heap", operatorKey))
+	assert.Equal(t, http.StatusOK, makeRequestWithApiKey(ws, http.MethodGet, "/debug/pprof/heap", adminKey))
+	assert.Equal(t, http.StatusNotFound, makeRequestWithApiKey(ws, http.MethodGet, "/missing", ""))
+}
+
+func TestApiKeyAuthenticator_KeyQuotaShouldReplaceSourceLimit(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerApiKeyAuthenticator(getAuthRoutesConfig(), 1)
+
+	assert.Equal(t, http.StatusOK, makeRequestWithApiKey(ws, http.MethodGet, "/node/status", ""))
+	assert.Equal(t, http.StatusTooManyRequests, makeRequestWithApiKey(ws, http.MethodGet, "/node/status", ""))
+
+	assert.Equal(t, http.StatusOK, makeRequestWithApiKey(ws, http.MethodGet, "/node/status", operatorKey))
+	assert.Equal(t, http.StatusOK, makeRequestWithApiKey(ws, http.MethodGet, "/node/status", operatorKey))
+	assert.Equal(t, http.StatusTooManyRequests, makeRequestWithApiKey(ws, http.MethodGet, "/node/status", operatorKey))
+
+	for i := 0; i < 5; i++ {
+		assert.Equal(t, http.StatusOK, makeRequestWithApiKey(ws, http.MethodGet, "/node/status", adminKey))
+	}
+}
+
+func TestApiKeyAuthenticator_ResetShouldRestoreQuota(t *testing.T) {
+	t.Parallel()
+
+	routesConfig := getAuthRoutesConfig()
+	routesConfig.Authentication.Keys[0].MaxRequests = 1
+	aka, _ := middleware.NewApiKeyAuthenticator(routesConfig)
+	ws := gin.New()
+	ws.Use(aka.MiddlewareHandlerFunc())
+	ws.GET("/node/status", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{})
+	})
+
+	assert.Equal(t, http.StatusOK, makeRequestWithApiKey(ws, http.MethodGet, "/node/status", operatorKey))
+	assert.Equal(t, http.StatusTooManyRequests, makeRequestWithApiKey(ws, http.MethodGet, "/node/status", operatorKey))
+
+	aka.Reset()
+
+	assert.Equal(t, http.StatusOK, makeRequestWithApiKey(ws, http.MethodGet, "/node/status", operatorKey))
+}
+
+func TestParseRole(t *testing.T) {
+	t.Parallel()
+
+	role, err := middleware.ParseRole("")
+	assert.Nil(t, err)
+	assert.Equal(t, middleware.RolePublic, role)
+
+	role, err = middleware.ParseRole("Operator")
+	assert.Nil(t, err)
+	assert.Equal(t, middleware.RoleOperator, role)
+	assert.Equal(t, "operator", role.String())
+
+	role, err = middleware.ParseRole("admin")
+	assert.Nil(t, err)
+	assert.Equal(t, middleware.RoleAdmin, role)
+
+	_, err = middleware.ParseRole("root")
+	assert.True(t, errors.Is(err, middleware.ErrInvalidRole))
+}
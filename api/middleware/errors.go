@@ -7,3 +7,24 @@ var ErrInvalidMaxNumRequests = errors.New("max number of requests value is inval
 
 // ErrTooManyRequests signals that too many requests were simultaneously received
 var ErrTooManyRequests = errors.New("too many requests")
+
+// ErrEmptyApiKeyHeader signals that the name of the header holding the API key was not provided
+var ErrEmptyApiKeyHeader = errors.New("empty API key header")
+
+// ErrEmptyApiKeyName signals that an API key without name was provided
+var ErrEmptyApiKeyName = errors.New("empty API key name")
+
+// ErrDuplicatedApiKey signals that the same API key, or the same API key name, was provided twice
+var ErrDuplicatedApiKey = errors.New("duplicated API key")
+
+// ErrInvalidApiKeyHash signals that the provided API key hash is not a hex encoded sha256 hash
+var ErrInvalidApiKeyHash = errors.New("invalid API key hash")
+
+// ErrInvalidRole signals that an unknown role was provided
+var ErrInvalidRole = errors.New("invalid role")
+
+// ErrInvalidApiKey signals that the request was done with an unknown API key
+var ErrInvalidApiKey = errors.New("invalid API key")
+
+// ErrMissingApiRole signals that the request was done without an API key granting the role required by the route
+var ErrMissingApiRole = errors.New("the API key does not grant the role required by the route")
@@ -37,12 +37,7 @@ func (gt *globalThrottler) MiddlewareHandlerFunc() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		path := c.Request.URL.Path
 
-		select {
-		case gt.queue <- struct{}{}:
-			gt.mutDebugRequests.Lock()
-			gt.debugRequests[path]++
-			gt.mutDebugRequests.Unlock()
-		default:
+		if !gt.StartProcessing(path) {
 			c.AbortWithStatusJSON(
 				http.StatusTooManyRequests,
 				shared.GenericAPIResponse{
@@ -51,19 +46,34 @@ func (gt *globalThrottler) MiddlewareHandlerFunc() gin.HandlerFunc {
 					Code:  shared.ReturnCodeSystemBusy,
 				},
 			)
-
-			gt.printDebugInfo()
-
 			return
 		}
 
-		defer gt.finish(path)
+		defer gt.EndProcessing(path)
 
 		c.Next()
 	}
 }
 
-func (gt *globalThrottler) finish(path string) {
+// StartProcessing reserves a slot for a request on the provided path and returns false if all the slots are taken.
+// A reserved slot should be released by calling EndProcessing
+func (gt *globalThrottler) StartProcessing(path string) bool {
+	select {
+	case gt.queue <- struct{}{}:
+		gt.mutDebugRequests.Lock()
+		gt.debugRequests[path]++
+		gt.mutDebugRequests.Unlock()
+
+		return true
+	default:
+		gt.printDebugInfo()
+
+		return false
+	}
+}
+
+// EndProcessing releases the slot reserved for a request on the provided path
+func (gt *globalThrottler) EndProcessing(path string) {
 	gt.mutDebugRequests.Lock()
 	gt.debugRequests[path]--
 	if gt.debugRequests[path] < 1 {
@@ -16,6 +16,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -62,7 +63,7 @@ func TestGlobalThrottler_LimitUnderShouldProcessRequest(t *testing.T) {
 
 	addr := "testAddress"
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ state.BlockQueryOptions) (i *big.Int, e error) {
 			return big.NewInt(10), nil
 		},
 	}
@@ -83,7 +84,7 @@ func TestGlobalThrottler_LimitOverShouldError(t *testing.T) {
 	numCalls := uint32(0)
 	responseDelay := time.Second
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ state.BlockQueryOptions) (i *big.Int, e error) {
 			time.Sleep(responseDelay)
 			atomic.AddUint32(&numCalls, 1)
 
@@ -139,3 +140,15 @@ func getRoutesConfig() config.ApiRoutesConfig {
 		},
 	}
 }
+
+func TestGlobalThrottler_StartEndProcessing(t *testing.T) {
+	t.Parallel()
+
+	gt, _ := middleware.NewGlobalThrottler(1)
+
+	assert.True(t, gt.StartProcessing("path"))
+	assert.False(t, gt.StartProcessing("other path"))
+
+	gt.EndProcessing("path")
+	assert.True(t, gt.StartProcessing("other path"))
+}
@@ -33,6 +33,13 @@ func NewSourceThrottler(maxNumRequests uint32) (*sourceThrottler, error) {
 // MiddlewareHandlerFunc returns the handler func used by the gin server when processing requests
 func (st *sourceThrottler) MiddlewareHandlerFunc() gin.HandlerFunc {
 	return func(c *gin.Context) {
+		// the requests authenticated by an API key are limited by the quota of the key instead
+		_, isAuthenticated := c.Get(ApiKeyNameContextKey)
+		if isAuthenticated {
+			c.Next()
+			return
+		}
+
 		remoteAddr, _, err := net.SplitHostPort(c.Request.RemoteAddr)
 		if err != nil {
 			c.AbortWithStatusJSON(
@@ -46,13 +53,7 @@ func (st *sourceThrottler) MiddlewareHandlerFunc() gin.HandlerFunc {
 			return
 		}
 
-		st.mutRequests.Lock()
-		requests := st.sourceRequests[remoteAddr]
-		isQuotaReached := requests >= st.maxNumRequests
-		st.sourceRequests[remoteAddr]++
-		st.mutRequests.Unlock()
-
-		if isQuotaReached {
+		if !st.CanProcessSource(remoteAddr) {
 			c.AbortWithStatusJSON(
 				http.StatusTooManyRequests,
 				shared.GenericAPIResponse{
@@ -68,6 +69,18 @@ func (st *sourceThrottler) MiddlewareHandlerFunc() gin.HandlerFunc {
 	}
 }
 
+// CanProcessSource counts a new request originating from the provided source and returns false if the source
+// already reached its quota
+func (st *sourceThrottler) CanProcessSource(source string) bool {
+	st.mutRequests.Lock()
+	defer st.mutRequests.Unlock()
+
+	isQuotaReached := st.sourceRequests[source] >= st.maxNumRequests
+	st.sourceRequests[source]++
+
+	return !isQuotaReached
+}
+
 // Reset resets all accumulated counters
 func (st *sourceThrottler) Reset() {
 	st.mutRequests.Lock()
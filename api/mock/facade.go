@@ -5,8 +5,11 @@ import (
 	"math/big"
 
 	apiBlock "github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/api/hyperblock"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/core/subscription"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/data/vm"
@@ -22,8 +25,8 @@ type Facade struct {
 	ShouldErrorStop            bool
 	TpsBenchmarkHandler        func() *statistics.TpsBenchmark
 	GetHeartbeatsHandler       func() ([]data.PubKeyHeartbeat, error)
-	BalanceHandler             func(string) (*big.Int, error)
-	GetAccountHandler          func(address string) (state.UserAccountHandler, error)
+	BalanceHandler             func(address string, options state.BlockQueryOptions) (*big.Int, error)
+	GetAccountHandler          func(address string, options state.BlockQueryOptions) (state.UserAccountHandler, error)
 	GenerateTransactionHandler func(sender string, receiver string, value *big.Int, code string) (*transaction.Transaction, error)
 	GetTransactionHandler      func(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	CreateTransactionHandler   func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64,
@@ -37,18 +40,36 @@ type Facade struct {
 	ComputeTransactionGasLimitHandler       func(tx *transaction.Transaction) (uint64, error)
 	NodeConfigCalled                        func() map[string]interface{}
 	GetQueryHandlerCalled                   func(name string) (debug.QueryHandler, error)
-	GetValueForKeyCalled                    func(address string, key string) (string, error)
+	GetValueForKeyCalled                    func(address string, key string, options state.BlockQueryOptions) (string, error)
 	GetPeerInfoCalled                       func(pid string) ([]core.QueryP2PPeerInfo, error)
 	GetThrottlerForEndpointCalled           func(endpoint string) (core.Throttler, bool)
 	GetUsernameCalled                       func(address string) (string, error)
-	SimulateTransactionExecutionHandler     func(tx *transaction.Transaction) (*transaction.SimulationResults, error)
+	SimulateTransactionExecutionHandler     func(tx *transaction.Transaction, withTrace bool) (*transaction.SimulationResults, error)
+	SimulateTransactionsExecutionHandler    func(txs []*transaction.Transaction, withTrace bool) (*transaction.BatchSimulationResults, error)
 	GetNumCheckpointsFromAccountStateCalled func() uint32
 	GetNumCheckpointsFromPeerStateCalled    func() uint32
+	GetLivenessCalled                       func() core.HealthReport
+	GetReadinessCalled                      func() core.HealthReport
 	GetESDTBalanceCalled                    func(address string, key string) (string, string, error)
 	GetAllESDTTokensCalled                  func(address string) ([]string, error)
+	GetESDTAllowanceCalled                  func(owner string, spender string, tokenName string) (string, error)
+	GetNFTCalled                            func(address string, tokenIdentifier string, nonce uint64) (*esdt.ApiNFTTokenData, error)
+	GetAllNFTsCalled                        func(address string) ([]*esdt.ApiNFTTokenData, error)
 	GetBlockByHashCalled                    func(hash string, withTxs bool) (*apiBlock.APIBlock, error)
 	GetBlockByNonceCalled                   func(nonce uint64, withTxs bool) (*apiBlock.APIBlock, error)
+	GetHyperblockByHashCalled               func(hash string) (*hyperblock.APIHyperblock, error)
+	GetHyperblockByNonceCalled              func(nonce uint64) (*hyperblock.APIHyperblock, error)
 	GetTotalStakedValueHandler              func() (*big.Int, error)
+	GetTransactionsByAddressCalled          func(address string, cursor string, limit int) (*transaction.ApiTransactionsByAddress, error)
+	GetLogEventsCalled                      func(query *transaction.ApiLogEventsQuery) (*transaction.ApiLogEvents, error)
+	GetAccountProofCalled                   func(address string, options state.BlockQueryOptions) (*state.TrieProof, error)
+	GetKeyProofCalled                       func(address string, key string, options state.BlockQueryOptions) (*state.TrieProof, *state.TrieProof, error)
+	GetTransactionsPoolForSenderCalled      func(sender string) (*transaction.ApiTransactionsPoolForSender, error)
+	GetTransactionsPoolSenderInfoCalled     func(sender string) (*transaction.ApiTransactionsPoolSenderInfo, error)
+	GetTransactionsPoolStatsCalled          func() ([]*transaction.ApiTransactionsPoolCacheStats, error)
+	GetLastPendingNonceForSenderCalled      func(sender string) (*transaction.ApiLastPendingNonce, error)
+	SubscribeCalled                         func(filter subscription.Filter, fromNonce *uint64) (*subscription.Subscription, error)
+	UnsubscribeCalled                       func(subscriptionID uint64)
 }
 
 // GetUsername -
@@ -98,14 +119,14 @@ func (f *Facade) GetHeartbeats() ([]data.PubKeyHeartbeat, error) {
 }
 
 // GetBalance is the mock implementation of a handler's GetBalance method
-func (f *Facade) GetBalance(address string) (*big.Int, error) {
-	return f.BalanceHandler(address)
+func (f *Facade) GetBalance(address string, options state.BlockQueryOptions) (*big.Int, error) {
+	return f.BalanceHandler(address, options)
 }
 
 // GetValueForKey is the mock implementation of a handler's GetValueForKey method
-func (f *Facade) GetValueForKey(address string, key string) (string, error) {
+func (f *Facade) GetValueForKey(address string, key string, options state.BlockQueryOptions) (string, error) {
 	if f.GetValueForKeyCalled != nil {
-		return f.GetValueForKeyCalled(address, key)
+		return f.GetValueForKeyCalled(address, key, options)
 	}
 
 	return "", nil
@@ -129,9 +150,124 @@ func (f *Facade) GetAllESDTTokens(address string) ([]string, error) {
 	return []string{""}, nil
 }
 
+// GetESDTAllowance -
+func (f *Facade) GetESDTAllowance(owner string, spender string, tokenName string) (string, error) {
+	if f.GetESDTAllowanceCalled != nil {
+		return f.GetESDTAllowanceCalled(owner, spender, tokenName)
+	}
+
+	return "", nil
+}
+
+// GetNFT -
+func (f *Facade) GetNFT(address string, tokenIdentifier string, nonce uint64) (*esdt.ApiNFTTokenData, error) {
+	if f.GetNFTCalled != nil {
+		return f.GetNFTCalled(address, tokenIdentifier, nonce)
+	}
+
+	return nil, nil
+}
+
+// GetAllNFTs -
+func (f *Facade) GetAllNFTs(address string) ([]*esdt.ApiNFTTokenData, error) {
+	if f.GetAllNFTsCalled != nil {
+		return f.GetAllNFTsCalled(address)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsByAddress -
+func (f *Facade) GetTransactionsByAddress(address string, cursor string, limit int) (*transaction.ApiTransactionsByAddress, error) {
+	if f.GetTransactionsByAddressCalled != nil {
+		return f.GetTransactionsByAddressCalled(address, cursor, limit)
+	}
+
+	return &transaction.ApiTransactionsByAddress{}, nil
+}
+
+// GetLogEvents -
+func (f *Facade) GetLogEvents(query *transaction.ApiLogEventsQuery) (*transaction.ApiLogEvents, error) {
+	if f.GetLogEventsCalled != nil {
+		return f.GetLogEventsCalled(query)
+	}
+
+	return &transaction.ApiLogEvents{}, nil
+}
+
+// GetAccountProof -
+func (f *Facade) GetAccountProof(address string, options state.BlockQueryOptions) (*state.TrieProof, error) {
+	if f.GetAccountProofCalled != nil {
+		return f.GetAccountProofCalled(address, options)
+	}
+
+	return &state.TrieProof{}, nil
+}
+
+// GetKeyProof -
+func (f *Facade) GetKeyProof(address string, key string, options state.BlockQueryOptions) (*state.TrieProof, *state.TrieProof, error) {
+	if f.GetKeyProofCalled != nil {
+		return f.GetKeyProofCalled(address, key, options)
+	}
+
+	return &state.TrieProof{}, &state.TrieProof{}, nil
+}
+
+// Subscribe -
+func (f *Facade) Subscribe(filter subscription.Filter, fromNonce *uint64) (*subscription.Subscription, error) {
+	if f.SubscribeCalled != nil {
+		return f.SubscribeCalled(filter, fromNonce)
+	}
+
+	return nil, nil
+}
+
+// Unsubscribe -
+func (f *Facade) Unsubscribe(subscriptionID uint64) {
+	if f.UnsubscribeCalled != nil {
+		f.UnsubscribeCalled(subscriptionID)
+	}
+}
+
+// GetTransactionsPoolForSender -
+func (f *Facade) GetTransactionsPoolForSender(sender string) (*transaction.ApiTransactionsPoolForSender, error) {
+	if f.GetTransactionsPoolForSenderCalled != nil {
+		return f.GetTransactionsPoolForSenderCalled(sender)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolSenderInfo -
+func (f *Facade) GetTransactionsPoolSenderInfo(sender string) (*transaction.ApiTransactionsPoolSenderInfo, error) {
+	if f.GetTransactionsPoolSenderInfoCalled != nil {
+		return f.GetTransactionsPoolSenderInfoCalled(sender)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolStats -
+func (f *Facade) GetTransactionsPoolStats() ([]*transaction.ApiTransactionsPoolCacheStats, error) {
+	if f.GetTransactionsPoolStatsCalled != nil {
+		return f.GetTransactionsPoolStatsCalled()
+	}
+
+	return nil, nil
+}
+
+// GetLastPendingNonceForSender -
+func (f *Facade) GetLastPendingNonceForSender(sender string) (*transaction.ApiLastPendingNonce, error) {
+	if f.GetLastPendingNonceForSenderCalled != nil {
+		return f.GetLastPendingNonceForSenderCalled(sender)
+	}
+
+	return nil, nil
+}
+
 // GetAccount is the mock implementation of a handler's GetAccount method
-func (f *Facade) GetAccount(address string) (state.UserAccountHandler, error) {
-	return f.GetAccountHandler(address)
+func (f *Facade) GetAccount(address string, options state.BlockQueryOptions) (state.UserAccountHandler, error) {
+	return f.GetAccountHandler(address, options)
 }
 
 // CreateTransaction is  mock implementation of a handler's CreateTransaction method
@@ -157,8 +293,13 @@ func (f *Facade) GetTransaction(hash string, withResults bool) (*transaction.Api
 }
 
 // SimulateTransactionExecution is the mock implementation of a handler's SimulateTransactionExecution method
-func (f *Facade) SimulateTransactionExecution(tx *transaction.Transaction) (*transaction.SimulationResults, error) {
-	return f.SimulateTransactionExecutionHandler(tx)
+func (f *Facade) SimulateTransactionExecution(tx *transaction.Transaction, withTrace bool) (*transaction.SimulationResults, error) {
+	return f.SimulateTransactionExecutionHandler(tx, withTrace)
+}
+
+// SimulateTransactionsExecution is the mock implementation of a handler's SimulateTransactionsExecution method
+func (f *Facade) SimulateTransactionsExecution(txs []*transaction.Transaction, withTrace bool) (*transaction.BatchSimulationResults, error) {
+	return f.SimulateTransactionsExecutionHandler(txs, withTrace)
 }
 
 // SendBulkTransactions is the mock implementation of a handler's SendBulkTransactions method
@@ -244,6 +385,24 @@ func (f *Facade) GetNumCheckpointsFromPeerState() uint32 {
 	return 0
 }
 
+// GetLiveness -
+func (f *Facade) GetLiveness() core.HealthReport {
+	if f.GetLivenessCalled != nil {
+		return f.GetLivenessCalled()
+	}
+
+	return core.HealthReport{Status: core.HealthStatusOK}
+}
+
+// GetReadiness -
+func (f *Facade) GetReadiness() core.HealthReport {
+	if f.GetReadinessCalled != nil {
+		return f.GetReadinessCalled()
+	}
+
+	return core.HealthReport{Status: core.HealthStatusOK}
+}
+
 // GetBlockByNonce -
 func (f *Facade) GetBlockByNonce(nonce uint64, withTxs bool) (*apiBlock.APIBlock, error) {
 	return f.GetBlockByNonceCalled(nonce, withTxs)
@@ -254,6 +413,16 @@ func (f *Facade) GetBlockByHash(hash string, withTxs bool) (*apiBlock.APIBlock,
 	return f.GetBlockByHashCalled(hash, withTxs)
 }
 
+// GetHyperblockByNonce -
+func (f *Facade) GetHyperblockByNonce(nonce uint64) (*hyperblock.APIHyperblock, error) {
+	return f.GetHyperblockByNonceCalled(nonce)
+}
+
+// GetHyperblockByHash -
+func (f *Facade) GetHyperblockByHash(hash string) (*hyperblock.APIHyperblock, error) {
+	return f.GetHyperblockByHashCalled(hash)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (f *Facade) IsInterfaceNil() bool {
 	return f == nil
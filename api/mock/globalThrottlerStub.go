@@ -0,0 +1,28 @@
+package mock
+
+// GlobalThrottlerStub -
+type GlobalThrottlerStub struct {
+	StartProcessingCalled func(path string) bool
+	EndProcessingCalled   func(path string)
+}
+
+// StartProcessing -
+func (gts *GlobalThrottlerStub) StartProcessing(path string) bool {
+	if gts.StartProcessingCalled != nil {
+		return gts.StartProcessingCalled(path)
+	}
+
+	return true
+}
+
+// EndProcessing -
+func (gts *GlobalThrottlerStub) EndProcessing(path string) {
+	if gts.EndProcessingCalled != nil {
+		gts.EndProcessingCalled(path)
+	}
+}
+
+// IsInterfaceNil -
+func (gts *GlobalThrottlerStub) IsInterfaceNil() bool {
+	return gts == nil
+}
@@ -0,0 +1,20 @@
+package mock
+
+// SourceThrottlerStub -
+type SourceThrottlerStub struct {
+	CanProcessSourceCalled func(source string) bool
+}
+
+// CanProcessSource -
+func (sts *SourceThrottlerStub) CanProcessSource(source string) bool {
+	if sts.CanProcessSourceCalled != nil {
+		return sts.CanProcessSourceCalled(source)
+	}
+
+	return true
+}
+
+// IsInterfaceNil -
+func (sts *SourceThrottlerStub) IsInterfaceNil() bool {
+	return sts == nil
+}
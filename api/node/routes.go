@@ -19,6 +19,8 @@ import (
 const (
 	pidQueryParam       = "pid"
 	debugPath           = "/debug"
+	healthLivePath      = "/health/live"
+	healthReadyPath     = "/health/ready"
 	heartbeatStatusPath = "/heartbeatstatus"
 	metricsPath         = "/metrics"
 	p2pStatusPath       = "/p2pstatus"
@@ -42,6 +44,8 @@ type FacadeHandler interface {
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
 	GetNumCheckpointsFromAccountState() uint32
 	GetNumCheckpointsFromPeerState() uint32
+	GetLiveness() core.HealthReport
+	GetReadiness() core.HealthReport
 	IsInterfaceNil() bool
 }
 
@@ -84,6 +88,8 @@ func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, metricsPath, PrometheusMetrics)
 	router.RegisterHandler(http.MethodPost, debugPath, QueryDebug)
 	router.RegisterHandler(http.MethodGet, peerInfoPath, PeerInfo)
+	router.RegisterHandler(http.MethodGet, healthLivePath, HealthLive)
+	router.RegisterHandler(http.MethodGet, healthReadyPath, HealthReady)
 	// placeholder for custom routes
 }
 
@@ -326,3 +332,46 @@ func PrometheusMetrics(c *gin.Context) {
 		metrics,
 	)
 }
+
+// HealthLive returns whether the node process is alive
+func HealthLive(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	respondWithHealthReport(c, facade.GetLiveness(), errors.ErrNodeNotAlive)
+}
+
+// HealthReady returns whether the node is ready to serve requests, along with the health of each of its components
+func HealthReady(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	respondWithHealthReport(c, facade.GetReadiness(), errors.ErrNodeNotReady)
+}
+
+func respondWithHealthReport(c *gin.Context, report core.HealthReport, errUnavailable error) {
+	if report.Status == core.HealthStatusUnavailable {
+		c.JSON(
+			http.StatusServiceUnavailable,
+			shared.GenericAPIResponse{
+				Data:  gin.H{"health": report},
+				Error: errUnavailable.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"health": report},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
@@ -461,6 +461,99 @@ func TestPeerInfo_PeerInfoShouldWork(t *testing.T) {
 	assert.NotNil(t, responseInfo["info"])
 }
 
+func TestHealthLive_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetLivenessCalled: func() core.HealthReport {
+			return core.HealthReport{Status: core.HealthStatusOK}
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("GET", "/node/health/live", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+	assert.Contains(t, fmt.Sprintf("%v", response.Data), string(core.HealthStatusOK))
+}
+
+func TestHealthLive_NotAliveShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetLivenessCalled: func() core.HealthReport {
+			return core.HealthReport{Status: core.HealthStatusUnavailable}
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("GET", "/node/health/live", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+	assert.Equal(t, errors.ErrNodeNotAlive.Error(), response.Error)
+}
+
+func TestHealthReady_DegradedShouldReturnOk(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetReadinessCalled: func() core.HealthReport {
+			return core.HealthReport{
+				Status: core.HealthStatusDegraded,
+				Components: map[string]core.ComponentHealth{
+					core.HealthComponentTxPool: {Status: core.HealthStatusDegraded, Reason: "cache is full"},
+				},
+			}
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("GET", "/node/health/ready", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+	assert.Contains(t, fmt.Sprintf("%v", response.Data), "cache is full")
+}
+
+func TestHealthReady_NotReadyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetReadinessCalled: func() core.HealthReport {
+			return core.HealthReport{
+				Status: core.HealthStatusUnavailable,
+				Components: map[string]core.ComponentHealth{
+					core.HealthComponentSync: {Status: core.HealthStatusUnavailable, Reason: "node is syncing"},
+				},
+			}
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("GET", "/node/health/ready", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+	assert.Equal(t, errors.ErrNodeNotReady.Error(), response.Error)
+	assert.Contains(t, fmt.Sprintf("%v", response.Data), "node is syncing")
+}
+
 func TestPrometheusMetrics_NilContextShouldErr(t *testing.T) {
 	ws := startNodeServer(nil)
 	req, _ := http.NewRequest("GET", "/node/metrics", nil)
@@ -559,6 +652,8 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/p2pstatus", Open: true},
 					{Name: "/debug", Open: true},
 					{Name: "/peerinfo", Open: true},
+					{Name: "/health/live", Open: true},
+					{Name: "/health/ready", Open: true},
 				},
 			},
 		},
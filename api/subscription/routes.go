@@ -0,0 +1,177 @@
+package subscription
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/core/subscription"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+const wsPath = "/ws"
+
+var log = logger.GetOrCreate("api/subscription")
+
+// FacadeHandler interface defines methods that can be used from `elrondFacade` context variable
+type FacadeHandler interface {
+	Subscribe(filter subscription.Filter, fromNonce *uint64) (*subscription.Subscription, error)
+	Unsubscribe(subscriptionID uint64)
+	IsInterfaceNil() bool
+}
+
+// Routes defines the subscription related routes
+func Routes(router *wrapper.RouterWrapper) {
+	router.RegisterHandler(http.MethodGet, wsPath, subscribe)
+}
+
+// subscribe creates a subscription from the query parameters and then streams the matching events, encoded as JSON,
+// on the upgraded WebSocket connection until either the client disconnects or the node drops the subscription
+func subscribe(c *gin.Context) {
+	ef, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	filter, fromNonce, err := getFilterFromQueryParams(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+		)
+		return
+	}
+
+	sub, err := ef.Subscribe(filter, fromNonce)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrSubscribe.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+	defer ef.Unsubscribe(sub.ID())
+
+	upgrader := websocket.Upgrader{
+		CheckOrigin: func(r *http.Request) bool {
+			return true
+		},
+	}
+	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		log.Debug("subscription upgrade", "error", err.Error())
+		return
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	chanClosed := make(chan struct{})
+	go monitorConnection(conn, chanClosed)
+
+	for {
+		select {
+		case event, isOpen := <-sub.Events():
+			if !isOpen {
+				_ = conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+				return
+			}
+
+			err = conn.WriteJSON(event)
+			if err != nil {
+				log.Debug("subscription write", "id", sub.ID(), "error", err.Error())
+				return
+			}
+		case <-chanClosed:
+			return
+		}
+	}
+}
+
+// monitorConnection consumes the messages sent by the client so that the close frames are processed
+func monitorConnection(conn *websocket.Conn, chanClosed chan struct{}) {
+	defer close(chanClosed)
+
+	for {
+		_, _, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+	}
+}
+
+func getFilterFromQueryParams(c *gin.Context) (subscription.Filter, *uint64, error) {
+	query := c.Request.URL.Query()
+	filter := subscription.Filter{
+		Topic:      query.Get("topic"),
+		TxHash:     query.Get("txHash"),
+		Address:    query.Get("address"),
+		Identifier: query.Get("identifier"),
+		LogTopic:   query.Get("logTopic"),
+	}
+
+	shardIDStr := query.Get("shardID")
+	if shardIDStr != "" {
+		shardID, err := strconv.ParseUint(shardIDStr, 10, 32)
+		if err != nil {
+			return subscription.Filter{}, nil, errors.ErrInvalidQueryParameter
+		}
+
+		shardID32 := uint32(shardID)
+		filter.ShardID = &shardID32
+	}
+
+	err := filter.Check()
+	if err != nil {
+		return subscription.Filter{}, nil, err
+	}
+
+	fromNonceStr := query.Get("fromNonce")
+	if fromNonceStr == "" {
+		return filter, nil, nil
+	}
+
+	fromNonce, err := strconv.ParseUint(fromNonceStr, 10, 64)
+	if err != nil {
+		return subscription.Filter{}, nil, errors.ErrInvalidBlockNonce
+	}
+
+	return filter, &fromNonce, nil
+}
+
+func getFacade(c *gin.Context) (FacadeHandler, bool) {
+	facadeObj, ok := c.Get("facade")
+	if !ok {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrNilAppContext.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return nil, false
+	}
+
+	facade, ok := facadeObj.(FacadeHandler)
+	if !ok {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrInvalidAppContext.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return nil, false
+	}
+
+	return facade, true
+}
@@ -0,0 +1,168 @@
+package subscription_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	subscriptionApi "github.com/ElrondNetwork/elrond-go/api/subscription"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	coreMock "github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/core/subscription"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscribe_NilContextShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(nil)
+
+	req, _ := http.NewRequest("GET", "/subscription/ws?topic=headers", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrNilAppContext.Error()))
+}
+
+func TestSubscribe_InvalidQueryParametersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		SubscribeCalled: func(_ subscription.Filter, _ *uint64) (*subscription.Subscription, error) {
+			assert.Fail(t, "should have not called subscribe")
+			return nil, nil
+		},
+	}
+	ws := startNodeServer(facade)
+
+	testCases := map[string]error{
+		"/subscription/ws":                              subscription.ErrInvalidTopic,
+		"/subscription/ws?topic=unknown":                subscription.ErrInvalidTopic,
+		"/subscription/ws?topic=headers&shardID=a":      apiErrors.ErrInvalidQueryParameter,
+		"/subscription/ws?topic=headers&fromNonce=-1":   apiErrors.ErrInvalidBlockNonce,
+		"/subscription/ws?topic=txStatus&fromNonce=abc": apiErrors.ErrInvalidBlockNonce,
+	}
+	for path, expectedErr := range testCases {
+		req, _ := http.NewRequest("GET", path, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code, path)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()), path)
+	}
+}
+
+func TestSubscribe_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := &mock.Facade{
+		SubscribeCalled: func(_ subscription.Filter, _ *uint64) (*subscription.Subscription, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(facade)
+
+	req, _ := http.NewRequest("GET", "/subscription/ws?topic=headers", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, fmt.Sprintf("%s: %s", apiErrors.ErrSubscribe.Error(), expectedErr.Error()), response.Error)
+}
+
+func TestSubscribe_ShouldStreamEvents(t *testing.T) {
+	t.Parallel()
+
+	hub, err := subscription.NewHub(subscription.ArgsHub{
+		AddressPubkeyConverter: coreMock.NewPubkeyConverterMock(32),
+		ReplayBufferSize:       10,
+		SubscriberQueueSize:    10,
+		MaxSubscribers:         1,
+	})
+	require.Nil(t, err)
+	hub.NotifyCommittedBlock([]byte("header hash"), &block.Header{Nonce: 5, ShardID: 1}, &block.Body{})
+
+	var receivedFilter subscription.Filter
+	chanUnsubscribed := make(chan uint64, 1)
+	facade := &mock.Facade{
+		SubscribeCalled: func(filter subscription.Filter, fromNonce *uint64) (*subscription.Subscription, error) {
+			receivedFilter = filter
+			return hub.Subscribe(filter, fromNonce)
+		},
+		UnsubscribeCalled: func(subscriptionID uint64) {
+			hub.Unsubscribe(subscriptionID)
+			chanUnsubscribed <- subscriptionID
+		},
+	}
+	server := httptest.NewServer(startNodeServer(facade))
+	defer server.Close()
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/subscription/ws?topic=headers&shardID=1&fromNonce=5"
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.Nil(t, err)
+
+	event := subscription.Event{}
+	err = conn.ReadJSON(&event)
+	require.Nil(t, err)
+	assert.Equal(t, subscription.HeadersTopic, event.Topic)
+	assert.Equal(t, uint64(5), event.Header.Nonce)
+	assert.Equal(t, uint32(1), *receivedFilter.ShardID)
+
+	_ = conn.Close()
+	<-chanUnsubscribed
+}
+
+func startNodeServer(handler subscriptionApi.FacadeHandler) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	subscriptionRoutes := ws.Group("/subscription")
+	if handler != nil {
+		subscriptionRoutes.Use(middleware.WithFacade(handler))
+	}
+	subscriptionRoute, _ := wrapper.NewRouterWrapper("subscription", subscriptionRoutes, getRoutesConfig())
+	subscriptionApi.Routes(subscriptionRoute)
+	return ws
+}
+
+func getRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"subscription": {
+				Routes: []config.RouteConfig{
+					{Name: "/ws", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	err := jsonParser.Decode(destination)
+	if err != nil {
+		fmt.Println(err)
+	}
+}
@@ -19,13 +19,18 @@ import (
 const (
 	sendTransactionEndpoint          = "/transaction/send"
 	simulateTransactionEndpoint      = "/transaction/simulate"
+	simulateMultipleEndpoint         = "/transaction/simulate-multiple"
 	sendMultipleTransactionsEndpoint = "/transaction/send-multiple"
 	getTransactionEndpoint           = "/transaction/:hash"
 	sendTransactionPath              = "/send"
 	simulateTransactionPath          = "/simulate"
+	simulateMultiplePath             = "/simulate-multiple"
 	costPath                         = "/cost"
 	sendMultiplePath                 = "/send-multiple"
 	getTransactionPath               = "/:txhash"
+	queryParamWithResults            = "withResults"
+	queryParamTrace                  = "trace"
+	maxNumSimulatedTransactions      = 100
 )
 
 // FacadeHandler interface defines methods that can be used by the gin webserver
@@ -35,7 +40,8 @@ type FacadeHandler interface {
 	ValidateTransaction(tx *transaction.Transaction) error
 	ValidateTransactionForSimulation(tx *transaction.Transaction) error
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
-	SimulateTransactionExecution(tx *transaction.Transaction) (*transaction.SimulationResults, error)
+	SimulateTransactionExecution(tx *transaction.Transaction, withTrace bool) (*transaction.SimulationResults, error)
+	SimulateTransactionsExecution(txs []*transaction.Transaction, withTrace bool) (*transaction.BatchSimulationResults, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (uint64, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
@@ -97,6 +103,12 @@ func Routes(router *wrapper.RouterWrapper) {
 		middleware.CreateEndpointThrottler(simulateTransactionEndpoint),
 		SimulateTransaction,
 	)
+	router.RegisterHandler(
+		http.MethodPost,
+		simulateMultiplePath,
+		middleware.CreateEndpointThrottler(simulateMultipleEndpoint),
+		SimulateMultipleTransactions,
+	)
 	router.RegisterHandler(http.MethodPost, costPath, ComputeTransactionGasLimit)
 	router.RegisterHandler(
 		http.MethodPost,
@@ -149,8 +161,21 @@ func SimulateTransaction(c *gin.Context) {
 		return
 	}
 
+	withTrace, err := getQueryParamBool(c, queryParamTrace)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
 	var gtx = SendTxRequest{}
-	err := c.ShouldBindJSON(&gtx)
+	err = c.ShouldBindJSON(&gtx)
 	if err != nil {
 		c.JSON(
 			http.StatusBadRequest,
@@ -201,7 +226,7 @@ func SimulateTransaction(c *gin.Context) {
 		return
 	}
 
-	executionResults, err := facade.SimulateTransactionExecution(tx)
+	executionResults, err := facade.SimulateTransactionExecution(tx, withTrace)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -225,6 +250,116 @@ func SimulateTransaction(c *gin.Context) {
 	)
 }
 
+// SimulateMultipleTransactions will receive a list of transactions from the client and will simulate their execution
+// one after another, each transaction seeing the state changes done by the previous ones
+func SimulateMultipleTransactions(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	withTrace, err := getQueryParamBool(c, queryParamTrace)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	var gtx []SendTxRequest
+	err = c.ShouldBindJSON(&gtx)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+	if len(gtx) == 0 || len(gtx) > maxNumSimulatedTransactions {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data: nil,
+				Error: fmt.Sprintf("%s: %s, provided %d, maximum %d", errors.ErrValidation.Error(),
+					errors.ErrInvalidNumberOfTransactions.Error(), len(gtx), maxNumSimulatedTransactions),
+				Code: shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	txs := make([]*transaction.Transaction, 0, len(gtx))
+	txsHashes := make([]string, 0, len(gtx))
+	for idx, receivedTx := range gtx {
+		tx, txHash, errCreate := facade.CreateTransaction(
+			receivedTx.Nonce,
+			receivedTx.Value,
+			receivedTx.Receiver,
+			receivedTx.Sender,
+			receivedTx.GasPrice,
+			receivedTx.GasLimit,
+			receivedTx.Data,
+			receivedTx.Signature,
+			receivedTx.ChainID,
+			receivedTx.Version,
+			receivedTx.Options,
+		)
+		if errCreate == nil {
+			errCreate = facade.ValidateTransactionForSimulation(tx)
+		}
+		if errCreate != nil {
+			c.JSON(
+				http.StatusBadRequest,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: fmt.Sprintf("%s: transaction %d: %s", errors.ErrTxGenerationFailed.Error(), idx, errCreate.Error()),
+					Code:  shared.ReturnCodeRequestError,
+				},
+			)
+			return
+		}
+
+		txs = append(txs, tx)
+		txsHashes = append(txsHashes, hex.EncodeToString(txHash))
+	}
+
+	executionResults, err := facade.SimulateTransactionsExecution(txs, withTrace)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	for idx, results := range executionResults.Results {
+		if idx < len(txsHashes) {
+			results.Hash = txsHashes[idx]
+		}
+	}
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"result": executionResults},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 // SendTransaction will receive a transaction from the client and propagate it for processing
 func SendTransaction(c *gin.Context) {
 	facade, ok := getFacade(c)
@@ -515,10 +650,14 @@ func ComputeTransactionGasLimit(c *gin.Context) {
 }
 
 func getQueryParamWithResults(c *gin.Context) (bool, error) {
-	withResultsStr := c.Request.URL.Query().Get("withResults")
-	if withResultsStr == "" {
+	return getQueryParamBool(c, queryParamWithResults)
+}
+
+func getQueryParamBool(c *gin.Context, name string) (bool, error) {
+	valueStr := c.Request.URL.Query().Get(name)
+	if valueStr == "" {
 		return false, nil
 	}
 
-	return strconv.ParseBool(withResultsStr)
+	return strconv.ParseBool(valueStr)
 }
@@ -581,7 +581,7 @@ func TestSimulateTransaction_CreateErrorsShouldErr(t *testing.T) {
 
 	expectedErr := errors.New("expected error")
 	facade := mock.Facade{
-		SimulateTransactionExecutionHandler: func(tx *tr.Transaction) (*tr.SimulationResults, error) {
+		SimulateTransactionExecutionHandler: func(tx *tr.Transaction, withTrace bool) (*tr.SimulationResults, error) {
 			processTxWasCalled = true
 			return &tr.SimulationResults{
 				Status:     "ok",
@@ -632,7 +632,7 @@ func TestSimulateTransaction_ValidateErrorsShouldErr(t *testing.T) {
 
 	expectedErr := errors.New("expected error")
 	facade := mock.Facade{
-		SimulateTransactionExecutionHandler: func(tx *tr.Transaction) (*tr.SimulationResults, error) {
+		SimulateTransactionExecutionHandler: func(tx *tr.Transaction, withTrace bool) (*tr.SimulationResults, error) {
 			processTxWasCalled = true
 			return &tr.SimulationResults{
 				Status:     "ok",
@@ -681,7 +681,7 @@ func TestSimulateTransaction_ProcessErrorsShouldErr(t *testing.T) {
 
 	expectedErr := errors.New("expected error")
 	facade := mock.Facade{
-		SimulateTransactionExecutionHandler: func(tx *tr.Transaction) (*tr.SimulationResults, error) {
+		SimulateTransactionExecutionHandler: func(tx *tr.Transaction, withTrace bool) (*tr.SimulationResults, error) {
 			return nil, expectedErr
 		},
 		CreateTransactionHandler: func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*tr.Transaction, []byte, error) {
@@ -723,7 +723,7 @@ func TestSimulateTransaction(t *testing.T) {
 	processTxWasCalled := false
 
 	facade := mock.Facade{
-		SimulateTransactionExecutionHandler: func(tx *tr.Transaction) (*tr.SimulationResults, error) {
+		SimulateTransactionExecutionHandler: func(tx *tr.Transaction, withTrace bool) (*tr.SimulationResults, error) {
 			processTxWasCalled = true
 			return &tr.SimulationResults{
 				Status:     "ok",
@@ -767,6 +767,149 @@ func TestSimulateTransaction(t *testing.T) {
 	assert.Equal(t, string(shared.ReturnCodeSuccess), simulateResponse.Code)
 }
 
+func TestSimulateTransaction_WithTraceShouldReturnTheCallTrace(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		SimulateTransactionExecutionHandler: func(tx *tr.Transaction, withTrace bool) (*tr.SimulationResults, error) {
+			assert.True(t, withTrace)
+			return &tr.SimulationResults{
+				Status: "fail",
+				CallTrace: []*tr.SimulationCallFrame{
+					{CallType: "directCall", Function: "swap", ReturnMessage: "swap failed"},
+				},
+			}, nil
+		},
+		CreateTransactionHandler: func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*tr.Transaction, []byte, error) {
+			return &tr.Transaction{}, []byte("hash"), nil
+		},
+		ValidateTransactionForSimulationHandler: func(tx *tr.Transaction) error {
+			return nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	jsonBytes, _ := json.Marshal(transaction.SendTxRequest{Sender: "sender1", Receiver: "receiver1", Value: "0"})
+	req, _ := http.NewRequest("POST", "/transaction/simulate?trace=true", bytes.NewBuffer(jsonBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulateResponse := simulateTxResponse{}
+	loadResponse(resp.Body, &simulateResponse)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Contains(t, fmt.Sprintf("%v", simulateResponse.Data), "swap failed")
+}
+
+func TestSimulateTransaction_InvalidTraceParamShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{}
+	ws := startNodeServer(&facade)
+
+	jsonBytes, _ := json.Marshal(transaction.SendTxRequest{Sender: "sender1", Receiver: "receiver1", Value: "0"})
+	req, _ := http.NewRequest("POST", "/transaction/simulate?trace=maybe", bytes.NewBuffer(jsonBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulateResponse := simulateTxResponse{}
+	loadResponse(resp.Body, &simulateResponse)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, simulateResponse.Error, apiErrors.ErrValidation.Error())
+}
+
+func TestSimulateMultipleTransactions_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	numCreated := 0
+	facade := mock.Facade{
+		SimulateTransactionsExecutionHandler: func(txs []*tr.Transaction, withTrace bool) (*tr.BatchSimulationResults, error) {
+			assert.Equal(t, 2, len(txs))
+			assert.False(t, withTrace)
+			return &tr.BatchSimulationResults{
+				Results:       []*tr.SimulationResults{{Status: "success"}, {Status: "fail"}},
+				BalanceDeltas: map[string]*big.Int{"receiver1": big.NewInt(10)},
+			}, nil
+		},
+		CreateTransactionHandler: func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*tr.Transaction, []byte, error) {
+			numCreated++
+			return &tr.Transaction{Nonce: nonce}, []byte(fmt.Sprintf("hash%d", numCreated)), nil
+		},
+		ValidateTransactionForSimulationHandler: func(tx *tr.Transaction) error {
+			return nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	jsonBytes, _ := json.Marshal([]transaction.SendTxRequest{
+		{Sender: "sender1", Receiver: "receiver1", Value: "10", Nonce: 0},
+		{Sender: "sender1", Receiver: "receiver1", Value: "10", Nonce: 1},
+	})
+	req, _ := http.NewRequest("POST", "/transaction/simulate-multiple", bytes.NewBuffer(jsonBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulateResponse := simulateTxResponse{}
+	loadResponse(resp.Body, &simulateResponse)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, simulateResponse.Error)
+	response := fmt.Sprintf("%v", simulateResponse.Data)
+	assert.Contains(t, response, hex.EncodeToString([]byte("hash1")))
+	assert.Contains(t, response, hex.EncodeToString([]byte("hash2")))
+	assert.Contains(t, response, "receiver1:10")
+}
+
+func TestSimulateMultipleTransactions_NoTransactionsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("POST", "/transaction/simulate-multiple", bytes.NewBuffer([]byte("[]")))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulateResponse := simulateTxResponse{}
+	loadResponse(resp.Body, &simulateResponse)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, simulateResponse.Error, apiErrors.ErrInvalidNumberOfTransactions.Error())
+}
+
+func TestSimulateMultipleTransactions_InvalidTransactionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("invalid nonce")
+	facade := mock.Facade{
+		CreateTransactionHandler: func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*tr.Transaction, []byte, error) {
+			return &tr.Transaction{Nonce: nonce}, []byte("hash"), nil
+		},
+		ValidateTransactionForSimulationHandler: func(tx *tr.Transaction) error {
+			if tx.Nonce == 1 {
+				return expectedErr
+			}
+			return nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	jsonBytes, _ := json.Marshal([]transaction.SendTxRequest{
+		{Sender: "sender1", Receiver: "receiver1", Value: "10", Nonce: 0},
+		{Sender: "sender1", Receiver: "receiver1", Value: "10", Nonce: 1},
+	})
+	req, _ := http.NewRequest("POST", "/transaction/simulate-multiple", bytes.NewBuffer(jsonBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulateResponse := simulateTxResponse{}
+	loadResponse(resp.Body, &simulateResponse)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, simulateResponse.Error, "transaction 1: "+expectedErr.Error())
+}
+
 func loadResponse(rsp io.Reader, destination interface{}) {
 	jsonParser := json.NewDecoder(rsp)
 	err := jsonParser.Decode(destination)
@@ -816,6 +959,7 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:txhash", Open: true},
 					{Name: "/:txhash/status", Open: true},
 					{Name: "/simulate", Open: true},
+					{Name: "/simulate-multiple", Open: true},
 				},
 			},
 		},
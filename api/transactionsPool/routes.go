@@ -0,0 +1,225 @@
+package transactionsPool
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	getStatsPath             = "/stats"
+	getTransactionsForSender = "/by-sender/:sender"
+	getSenderInfoPath        = "/by-sender/:sender/info"
+	getLastPendingNoncePath  = "/by-sender/:sender/last-nonce"
+)
+
+// FacadeHandler interface defines methods that can be used by the gin webserver
+type FacadeHandler interface {
+	GetTransactionsPoolForSender(sender string) (*transaction.ApiTransactionsPoolForSender, error)
+	GetTransactionsPoolSenderInfo(sender string) (*transaction.ApiTransactionsPoolSenderInfo, error)
+	GetTransactionsPoolStats() ([]*transaction.ApiTransactionsPoolCacheStats, error)
+	GetLastPendingNonceForSender(sender string) (*transaction.ApiLastPendingNonce, error)
+	IsInterfaceNil() bool
+}
+
+// Routes defines transactions pool related routes
+func Routes(router *wrapper.RouterWrapper) {
+	router.RegisterHandler(http.MethodGet, getStatsPath, GetStats)
+	router.RegisterHandler(http.MethodGet, getTransactionsForSender, GetTransactionsForSender)
+	router.RegisterHandler(http.MethodGet, getSenderInfoPath, GetSenderInfo)
+	router.RegisterHandler(http.MethodGet, getLastPendingNoncePath, GetLastPendingNonce)
+}
+
+func getFacade(c *gin.Context) (FacadeHandler, bool) {
+	facadeObj, ok := c.Get("facade")
+	if !ok {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrNilAppContext.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return nil, false
+	}
+
+	facade, ok := facadeObj.(FacadeHandler)
+	if !ok {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrInvalidAppContext.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return nil, false
+	}
+
+	return facade, true
+}
+
+// GetStats returns the statistics of each cache of the transactions pool
+func GetStats(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	stats, err := facade.GetTransactionsPoolStats()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionsPoolStats.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"caches": stats},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// GetTransactionsForSender returns the pending transactions of a sender, sorted by nonce, together with the nonce gaps
+func GetTransactionsForSender(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	sender := c.Param("sender")
+	if sender == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionsPoolForSender.Error(), errors.ErrEmptyAddress.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	txsForSender, err := facade.GetTransactionsPoolForSender(sender)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionsPoolForSender.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"transactionsPool": txsForSender},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// GetSenderInfo returns the nonce and score details the transactions pool keeps about a sender
+func GetSenderInfo(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	sender := c.Param("sender")
+	if sender == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionsPoolSenderInfo.Error(), errors.ErrEmptyAddress.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	senderInfo, err := facade.GetTransactionsPoolSenderInfo(sender)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionsPoolSenderInfo.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"senderInfo": senderInfo},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// GetLastPendingNonce returns the last pending nonce of a sender, together with the nonce its next transaction should use
+func GetLastPendingNonce(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	sender := c.Param("sender")
+	if sender == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetLastPendingNonce.Error(), errors.ErrEmptyAddress.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	lastPendingNonce, err := facade.GetLastPendingNonceForSender(sender)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetLastPendingNonce.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"nonce": lastPendingNonce},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
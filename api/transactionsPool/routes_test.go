@@ -0,0 +1,352 @@
+package transactionsPool_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/transactionsPool"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type statsResponseData struct {
+	Caches []*transaction.ApiTransactionsPoolCacheStats `json:"caches"`
+}
+
+type statsResponse struct {
+	Data  statsResponseData `json:"data"`
+	Error string            `json:"error"`
+	Code  string            `json:"code"`
+}
+
+type txsForSenderResponseData struct {
+	TransactionsPool transaction.ApiTransactionsPoolForSender `json:"transactionsPool"`
+}
+
+type txsForSenderResponse struct {
+	Data  txsForSenderResponseData `json:"data"`
+	Error string                   `json:"error"`
+	Code  string                   `json:"code"`
+}
+
+type senderInfoResponseData struct {
+	SenderInfo transaction.ApiTransactionsPoolSenderInfo `json:"senderInfo"`
+}
+
+type senderInfoResponse struct {
+	Data  senderInfoResponseData `json:"data"`
+	Error string                 `json:"error"`
+	Code  string                 `json:"code"`
+}
+
+type lastPendingNonceResponseData struct {
+	Nonce transaction.ApiLastPendingNonce `json:"nonce"`
+}
+
+type lastPendingNonceResponse struct {
+	Data  lastPendingNonceResponseData `json:"data"`
+	Error string                       `json:"error"`
+	Code  string                       `json:"code"`
+}
+
+func TestGetStats_NilContextShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(nil)
+
+	req, _ := http.NewRequest("GET", "/transaction-pool/stats", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrNilAppContext.Error()))
+}
+
+func TestGetStats_WrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+
+	req, _ := http.NewRequest("GET", "/transaction-pool/stats", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := statsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidAppContext.Error()))
+}
+
+func TestGetStats_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetTransactionsPoolStatsCalled: func() ([]*transaction.ApiTransactionsPoolCacheStats, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/transaction-pool/stats", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := statsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetTransactionsPoolStats.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetStats_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedStats := []*transaction.ApiTransactionsPoolCacheStats{
+		{CacheID: "0", NumTxs: 10, NumBytes: 1000, NumSenders: 3, NumEvictionPasses: 2},
+		{CacheID: "1_0", NumTxs: 4, NumBytes: 400},
+	}
+	facade := mock.Facade{
+		GetTransactionsPoolStatsCalled: func() ([]*transaction.ApiTransactionsPoolCacheStats, error) {
+			return expectedStats, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/transaction-pool/stats", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := statsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedStats, response.Data.Caches)
+}
+
+func TestGetTransactionsForSender_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetTransactionsPoolForSenderCalled: func(_ string) (*transaction.ApiTransactionsPoolForSender, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/transaction-pool/by-sender/alice", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := txsForSenderResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetTransactionsPoolForSender.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetTransactionsForSender_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedTxs := transaction.ApiTransactionsPoolForSender{
+		Sender:       "alice",
+		AccountNonce: 3,
+		Transactions: []*transaction.ApiTransactionResult{
+			{Type: string(transaction.TxTypeNormal), Hash: "aa", Nonce: 3, Status: transaction.TxStatusPending},
+			{Type: string(transaction.TxTypeNormal), Hash: "bb", Nonce: 5, Status: transaction.TxStatusPending},
+		},
+		NonceGaps: []*transaction.ApiNonceGap{{From: 4, To: 4}},
+	}
+	facade := mock.Facade{
+		GetTransactionsPoolForSenderCalled: func(sender string) (*transaction.ApiTransactionsPoolForSender, error) {
+			assert.Equal(t, "alice", sender)
+			return &expectedTxs, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/transaction-pool/by-sender/alice", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := txsForSenderResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedTxs, response.Data.TransactionsPool)
+}
+
+func TestGetSenderInfo_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetTransactionsPoolSenderInfoCalled: func(_ string) (*transaction.ApiTransactionsPoolSenderInfo, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/transaction-pool/by-sender/alice/info", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := senderInfoResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetTransactionsPoolSenderInfo.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetSenderInfo_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedSenderInfo := transaction.ApiTransactionsPoolSenderInfo{
+		Sender:            "alice",
+		AccountNonce:      3,
+		AccountNonceKnown: true,
+		NumTxs:            2,
+		Score:             50,
+		HasInitialGap:     true,
+	}
+	facade := mock.Facade{
+		GetTransactionsPoolSenderInfoCalled: func(sender string) (*transaction.ApiTransactionsPoolSenderInfo, error) {
+			assert.Equal(t, "alice", sender)
+			return &expectedSenderInfo, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/transaction-pool/by-sender/alice/info", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := senderInfoResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedSenderInfo, response.Data.SenderInfo)
+}
+
+func TestGetLastPendingNonce_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetLastPendingNonceForSenderCalled: func(_ string) (*transaction.ApiLastPendingNonce, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/transaction-pool/by-sender/alice/last-nonce", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := lastPendingNonceResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetLastPendingNonce.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetLastPendingNonce_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedNonce := transaction.ApiLastPendingNonce{
+		Sender:                 "alice",
+		AccountNonce:           3,
+		HasPendingTransactions: true,
+		LastPendingNonce:       5,
+		NextNonce:              6,
+	}
+	facade := mock.Facade{
+		GetLastPendingNonceForSenderCalled: func(sender string) (*transaction.ApiLastPendingNonce, error) {
+			assert.Equal(t, "alice", sender)
+			return &expectedNonce, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/transaction-pool/by-sender/alice/last-nonce", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := lastPendingNonceResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedNonce, response.Data.Nonce)
+}
+
+func startNodeServer(handler transactionsPool.FacadeHandler) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	txPoolRoutes := ws.Group("/transaction-pool")
+	if handler != nil {
+		txPoolRoutes.Use(middleware.WithFacade(handler))
+	}
+	txPoolRoute, _ := wrapper.NewRouterWrapper("transaction-pool", txPoolRoutes, getRoutesConfig())
+	transactionsPool.Routes(txPoolRoute)
+	return ws
+}
+
+func startNodeServerWrongFacade() *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("facade", mock.WrongFacade{})
+	})
+	ginTxPoolRoute := ws.Group("/transaction-pool")
+	txPoolRoute, _ := wrapper.NewRouterWrapper("transaction-pool", ginTxPoolRoute, getRoutesConfig())
+	transactionsPool.Routes(txPoolRoute)
+	return ws
+}
+
+func getRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"transaction-pool": {
+				Routes: []config.RouteConfig{
+					{Name: "/stats", Open: true},
+					{Name: "/by-sender/:sender", Open: true},
+					{Name: "/by-sender/:sender/info", Open: true},
+					{Name: "/by-sender/:sender/last-nonce", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	err := jsonParser.Decode(destination)
+	logError(err)
+}
+
+func logError(err error) {
+	if err != nil {
+		fmt.Println(err)
+	}
+}
@@ -36,6 +36,8 @@ type VMValueRequest struct {
 	CallerAddr string   `form:"caller" json:"caller"`
 	CallValue  string   `form:"value" json:"value"`
 	Args       []string `form:"args"  json:"args"`
+	BlockNonce *uint64  `form:"blockNonce" json:"blockNonce"`
+	BlockHash  string   `form:"blockHash" json:"blockHash"`
 }
 
 // Routes defines address related routes
@@ -154,6 +156,24 @@ func createSCQuery(fh FacadeHandler, request *VMValueRequest) (*process.SCQuery,
 		scQuery.CallValue = callValue
 	}
 
+	if request.BlockNonce != nil {
+		scQuery.BlockQueryOptions.BlockNonce = *request.BlockNonce
+		scQuery.BlockQueryOptions.HasBlockNonce = true
+	}
+
+	if len(request.BlockHash) > 0 {
+		blockHash, errDecodeHash := hex.DecodeString(request.BlockHash)
+		if errDecodeHash != nil {
+			return nil, fmt.Errorf("'%s' is not a valid block hash: %s", request.BlockHash, errDecodeHash.Error())
+		}
+
+		scQuery.BlockQueryOptions.BlockHash = blockHash
+	}
+
+	if scQuery.BlockQueryOptions.HasBlockNonce && len(scQuery.BlockQueryOptions.BlockHash) > 0 {
+		return nil, errors.ErrBlockNonceAndHashProvided
+	}
+
 	return scQuery, nil
 }
 
@@ -150,6 +150,45 @@ func TestQuery_ShouldWork(t *testing.T) {
 	require.Equal(t, int64(42), big.NewInt(0).SetBytes(response.Data.ReturnData[0]).Int64())
 }
 
+func TestQuery_WithBlockNonceShouldPassOptions(t *testing.T) {
+	t.Parallel()
+
+	blockNonce := uint64(7)
+	facade := mock.Facade{
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
+			require.True(t, query.BlockQueryOptions.HasBlockNonce)
+			require.Equal(t, blockNonce, query.BlockQueryOptions.BlockNonce)
+
+			return &vm.VMOutputApi{}, nil
+		},
+	}
+
+	request := VMValueRequest{
+		ScAddress:  DummyScAddress,
+		FuncName:   "function",
+		BlockNonce: &blockNonce,
+	}
+
+	response := vmOutputResponse{}
+	statusCode := doPost(&facade, "/vm-values/query", request, &response)
+
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Equal(t, "", response.Error)
+}
+
+func TestCreateSCQuery_BlockNonceAndHashShouldErr(t *testing.T) {
+	blockNonce := uint64(7)
+	request := VMValueRequest{
+		ScAddress:  DummyScAddress,
+		FuncName:   "function",
+		BlockNonce: &blockNonce,
+		BlockHash:  "aabb",
+	}
+
+	_, err := createSCQuery(&mock.Facade{}, &request)
+	require.Equal(t, apiErrors.ErrBlockNonceAndHashProvided, err)
+}
+
 func TestCreateSCQuery_ArgumentIsNotHexShouldErr(t *testing.T) {
 	request := VMValueRequest{
 		ScAddress: DummyScAddress,
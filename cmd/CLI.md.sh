@@ -6,6 +6,7 @@ generate() {
     generateForTermUi
     generateForLogViewer
     generateForSeedNode
+    generateForRemoteSigner
 }
 
 generateForNode() {
@@ -78,6 +79,20 @@ $(code)
     echo "$HELP" > ./seednode/CLI.md
 }
 
+generateForRemoteSigner() {
+    HELP="
+# Elrond Remote Signer CLI
+
+The **Elrond Remote Signer** exposes the following Command Line Interface:
+$(code)
+\$ remotesigner --help
+
+$(./remotesigner/remotesigner --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./remotesigner/CLI.md
+}
+
 code() {
     printf "\n\`\`\`\n"
 }
@@ -0,0 +1,246 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/keystore"
+	"github.com/urfave/cli"
+)
+
+const (
+	keyPasswordEnvVar    = "ELROND_KEY_PASSWORD"
+	newKeyPasswordEnvVar = "ELROND_NEW_KEY_PASSWORD"
+)
+
+type keystoreCfg struct {
+	keyFile         string
+	outFile         string
+	passwordFile    string
+	newPasswordFile string
+	kdf             string
+}
+
+var (
+	// keyFile defines a flag for the key file to be encrypted or decrypted
+	keyFile = cli.StringFlag{
+		Name:        "key-file",
+		Usage:       "The key file to be processed. Example: ./validatorKey.pem",
+		Destination: &keystoreArgsConfig.keyFile,
+	}
+	// keystoreOutFile defines a flag for the file where the processed key file is written
+	keystoreOutFile = cli.StringFlag{
+		Name:        "out-file",
+		Usage:       "The file where the result is written. If not set, the key file is replaced",
+		Destination: &keystoreArgsConfig.outFile,
+	}
+	// passwordFile defines a flag for the file holding the keystore password
+	passwordFile = cli.StringFlag{
+		Name: "password-file",
+		Usage: "The file holding the password of the encrypted key files. If not set, the password is read from the " +
+			keyPasswordEnvVar + " environment variable",
+		Destination: &keystoreArgsConfig.passwordFile,
+	}
+	// newPasswordFile defines a flag for the file holding the new keystore password
+	newPasswordFile = cli.StringFlag{
+		Name: "new-password-file",
+		Usage: "The file holding the new password of the encrypted key file. If not set, the password is read from the " +
+			newKeyPasswordEnvVar + " environment variable",
+		Destination: &keystoreArgsConfig.newPasswordFile,
+	}
+	// kdf defines a flag for the key derivation function used when encrypting
+	kdf = cli.StringFlag{
+		Name: "kdf",
+		Usage: fmt.Sprintf(
+			"The key derivation function used when encrypting. Available options: %s, %s",
+			keystore.ScryptKdf,
+			keystore.Argon2idKdf),
+		Value:       keystore.ScryptKdf,
+		Destination: &keystoreArgsConfig.kdf,
+	}
+
+	keystoreArgsConfig = &keystoreCfg{}
+)
+
+func createKeystoreCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:  "encrypt",
+			Usage: "Encrypts a PEM key file into a password protected keystore",
+			Flags: []cli.Flag{
+				keyFile,
+				keystoreOutFile,
+				passwordFile,
+				kdf,
+			},
+			Action: func(_ *cli.Context) error {
+				return encryptKeyFile()
+			},
+		},
+		{
+			Name:  "decrypt",
+			Usage: "Decrypts a keystore back into a plain PEM key file",
+			Flags: []cli.Flag{
+				keyFile,
+				keystoreOutFile,
+				passwordFile,
+			},
+			Action: func(_ *cli.Context) error {
+				return decryptKeyFile()
+			},
+		},
+		{
+			Name:  "change-password",
+			Usage: "Re-encrypts a keystore with a new password",
+			Flags: []cli.Flag{
+				keyFile,
+				keystoreOutFile,
+				passwordFile,
+				newPasswordFile,
+			},
+			Action: func(_ *cli.Context) error {
+				return changeKeyFilePassword()
+			},
+		},
+	}
+}
+
+func encryptKeyFile() error {
+	buff, err := readKeyFile()
+	if err != nil {
+		return err
+	}
+
+	password, err := readRequiredPassword(keystoreArgsConfig.passwordFile, keyPasswordEnvVar)
+	if err != nil {
+		return err
+	}
+
+	encrypted, err := encryptPemContent(buff, password)
+	if err != nil {
+		return err
+	}
+
+	return writeKeyFile(encrypted)
+}
+
+func decryptKeyFile() error {
+	buff, err := readKeyFile()
+	if err != nil {
+		return err
+	}
+
+	password, err := readRequiredPassword(keystoreArgsConfig.passwordFile, keyPasswordEnvVar)
+	if err != nil {
+		return err
+	}
+
+	decrypted, err := keystore.Decrypt(buff, password)
+	if err != nil {
+		return err
+	}
+
+	return writeKeyFile(decrypted)
+}
+
+func changeKeyFilePassword() error {
+	buff, err := readKeyFile()
+	if err != nil {
+		return err
+	}
+
+	password, err := readRequiredPassword(keystoreArgsConfig.passwordFile, keyPasswordEnvVar)
+	if err != nil {
+		return err
+	}
+	newPassword, err := readRequiredPassword(keystoreArgsConfig.newPasswordFile, newKeyPasswordEnvVar)
+	if err != nil {
+		return err
+	}
+
+	encrypted, err := keystore.ChangePassword(buff, password, newPassword)
+	if err != nil {
+		return err
+	}
+
+	return writeKeyFile(encrypted)
+}
+
+func encryptPemContent(buff []byte, password []byte) ([]byte, error) {
+	_, _, err := core.LoadSkPkFromPemBytes(buff, 0)
+	if err != nil && !keystore.IsKeystore(buff) {
+		return nil, err
+	}
+
+	kdfParams, err := keystore.NewKdfParams(keystoreArgsConfig.kdf)
+	if err != nil {
+		return nil, err
+	}
+
+	return keystore.Encrypt(buff, password, kdfParams)
+}
+
+func readRequiredPassword(fileName string, envVarName string) ([]byte, error) {
+	password, err := keystore.ReadPassword(fileName, envVarName)
+	if err != nil {
+		return nil, err
+	}
+	if len(password) == 0 {
+		return nil, fmt.Errorf("%w: provide a password file or set the %s environment variable",
+			keystore.ErrMissingPassword, envVarName)
+	}
+
+	return password, nil
+}
+
+func readKeyFile() ([]byte, error) {
+	if len(keystoreArgsConfig.keyFile) == 0 {
+		return nil, fmt.Errorf("the key file should be provided")
+	}
+
+	return ioutil.ReadFile(keystoreArgsConfig.keyFile)
+}
+
+// writeKeyFile writes the content in a temporary file, renamed afterwards, so that the key file is never left
+// partially written
+func writeKeyFile(buff []byte) error {
+	fileName := keystoreArgsConfig.outFile
+	if len(fileName) == 0 {
+		fileName = keystoreArgsConfig.keyFile
+	}
+
+	tmpFile, err := ioutil.TempFile(filepath.Dir(fileName), filepath.Base(fileName)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.Remove(tmpFile.Name())
+	}()
+
+	_, err = tmpFile.Write(buff)
+	if err != nil {
+		_ = tmpFile.Close()
+		return err
+	}
+	err = tmpFile.Close()
+	if err != nil {
+		return err
+	}
+
+	err = os.Chmod(tmpFile.Name(), core.FileModeUserReadWrite)
+	if err != nil {
+		return err
+	}
+
+	err = os.Rename(tmpFile.Name(), fileName)
+	if err != nil {
+		return err
+	}
+
+	log.Info("key file written", "file", fileName)
+
+	return nil
+}
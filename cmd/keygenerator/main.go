@@ -14,6 +14,7 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/keystore"
 	"github.com/ElrondNetwork/elrond-go/core/pubkeyConverter"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing"
@@ -46,11 +47,14 @@ var (
 	fileGenHelpTemplate = `NAME:
    {{.Name}} - {{.Usage}}
 USAGE:
-   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}{{if .Commands}} command [command options]{{end}}
    {{if len .Authors}}
 AUTHOR:
    {{range .Authors}}{{ . }}{{end}}
    {{end}}{{if .Commands}}
+COMMANDS:
+   {{range .VisibleCommands}}{{join .Names ", "}}{{ "\t" }}{{.Usage}}
+   {{end}}
 GLOBAL OPTIONS:
    {{range .VisibleFlags}}{{.}}
    {{end}}
@@ -118,15 +122,19 @@ func main() {
 		keyType,
 		consoleOut,
 		noSplit,
+		passwordFile,
+		kdf,
 	}
 
+	app.Commands = append(createKeystoreCommands(), createTxCommands()...)
+
 	app.Action = func(_ *cli.Context) error {
 		return process()
 	}
 
 	err := app.Run(os.Args)
 	if err != nil {
-		log.Error("error generating files", "error", err)
+		log.Error("error running the key generation tool", "error", err)
 
 		os.Exit(1)
 	}
@@ -138,7 +146,12 @@ func process() error {
 		return err
 	}
 
-	return outputKeys(validatorKeys, walletKeys, argsConfig.consoleOut, argsConfig.noSplit)
+	password, err := keystore.ReadPassword(keystoreArgsConfig.passwordFile, keyPasswordEnvVar)
+	if err != nil {
+		return err
+	}
+
+	return outputKeys(validatorKeys, walletKeys, argsConfig.consoleOut, argsConfig.noSplit, password)
 }
 
 func generateKeys(typeKey string, numKeys int) ([]key, []key, error) {
@@ -211,12 +224,13 @@ func outputKeys(
 	walletKeys []key,
 	consoleOut bool,
 	noSplit bool,
+	password []byte,
 ) error {
 	if consoleOut {
 		return printKeys(validatorKeys, walletKeys)
 	}
 
-	return saveKeys(validatorKeys, walletKeys, noSplit)
+	return saveKeys(validatorKeys, walletKeys, noSplit, password)
 }
 
 func printKeys(validatorKeys []key, walletKeys []key) error {
@@ -273,20 +287,20 @@ func writeKeyToStream(writer io.Writer, key key, pubkeyConverter core.PubkeyConv
 	return pem.Encode(writer, &blk)
 }
 
-func saveKeys(validatorKeys []key, walletKeys []key, noSplit bool) error {
+func saveKeys(validatorKeys []key, walletKeys []key, noSplit bool, password []byte) error {
 	if len(validatorKeys)+len(walletKeys) == 0 {
 		return fmt.Errorf("internal error: no keys to save")
 	}
 
 	var errFound error
 	if len(validatorKeys) > 0 {
-		err := saveSliceKeys(validatorKeyFilenameTemplate, validatorKeys, validatorPubKeyConverter, noSplit)
+		err := saveSliceKeys(validatorKeyFilenameTemplate, validatorKeys, validatorPubKeyConverter, noSplit, password)
 		if err != nil {
 			errFound = err
 		}
 	}
 	if len(walletKeys) > 0 {
-		err := saveSliceKeys(walletKeyFilenameTemplate, walletKeys, walletPubKeyConverter, noSplit)
+		err := saveSliceKeys(walletKeyFilenameTemplate, walletKeys, walletPubKeyConverter, noSplit, password)
 		if err != nil {
 			errFound = err
 		}
@@ -295,9 +309,16 @@ func saveKeys(validatorKeys []key, walletKeys []key, noSplit bool) error {
 	return errFound
 }
 
-func saveSliceKeys(baseFilenameTemplate string, keys []key, pubkeyConverter core.PubkeyConverter, noSplit bool) error {
+func saveSliceKeys(
+	baseFilenameTemplate string,
+	keys []key,
+	pubkeyConverter core.PubkeyConverter,
+	noSplit bool,
+	password []byte,
+) error {
 	var file *os.File
 	var err error
+	buff := bytes.NewBuffer(make([]byte, 0))
 	for i, k := range keys {
 		shouldCreateFile := !noSplit || i == 0
 		if shouldCreateFile {
@@ -307,12 +328,12 @@ func saveSliceKeys(baseFilenameTemplate string, keys []key, pubkeyConverter core
 			}
 		}
 
-		err = writeKeyToStream(file, k, pubkeyConverter)
+		err = writeKeyToStream(buff, k, pubkeyConverter)
 		if err != nil {
 			return err
 		}
 		if !noSplit {
-			err = file.Close()
+			err = flushKeysToFile(file, buff, password)
 			if err != nil {
 				return err
 			}
@@ -320,12 +341,36 @@ func saveSliceKeys(baseFilenameTemplate string, keys []key, pubkeyConverter core
 	}
 
 	if noSplit {
-		return file.Close()
+		return flushKeysToFile(file, buff, password)
 	}
 
 	return nil
 }
 
+// flushKeysToFile writes the buffered PEM content in the file, encrypted if a password was provided, and closes
+// the file
+func flushKeysToFile(file *os.File, buff *bytes.Buffer, password []byte) error {
+	content := buff.Bytes()
+	buff.Reset()
+
+	var err error
+	if len(password) > 0 {
+		content, err = encryptPemContent(content, password)
+		if err != nil {
+			_ = file.Close()
+			return err
+		}
+	}
+
+	_, err = file.Write(content)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
 func generateFile(index int, numKeys int, noSplit bool, baseFilenameTemplate string) (*os.File, error) {
 	folder, err := generateFolder(index, numKeys, noSplit)
 	if err != nil {
@@ -0,0 +1,407 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/keygenerator/txsigner"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/keystore"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/ed25519"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/ed25519/singlesig"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/hashing/keccak"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/urfave/cli"
+)
+
+type txCfg struct {
+	pemFile   string
+	pemIndex  int
+	txFile    string
+	outFile   string
+	nonce     uint64
+	value     string
+	receiver  string
+	gasPrice  uint64
+	gasLimit  uint64
+	data      string
+	function  string
+	arguments cli.StringSlice
+	chainID   string
+	version   uint
+	options   uint
+}
+
+var (
+	// pemFile defines a flag for the wallet PEM file holding the signing key
+	pemFile = cli.StringFlag{
+		Name:        "pem",
+		Usage:       "The wallet PEM file, plain or encrypted, holding the key used for signing. Example: ./walletKey.pem",
+		Destination: &txArgsConfig.pemFile,
+	}
+	// pemIndex defines a flag for the index of the key in the wallet PEM file
+	pemIndex = cli.IntFlag{
+		Name:        "pem-index",
+		Usage:       "The index of the key in the wallet PEM file",
+		Value:       0,
+		Destination: &txArgsConfig.pemIndex,
+	}
+	// txFile defines a flag for the JSON file holding one transaction or an array of transactions
+	txFile = cli.StringFlag{
+		Name: "tx-file",
+		Usage: "The JSON file holding a transaction or an array of transactions. For signing, the transactions " +
+			"hold the same fields as the flags below. For verifying and decoding, the transactions are the output of the sign command",
+		Destination: &txArgsConfig.txFile,
+	}
+	// outFile defines a flag for the file where the output is written
+	outFile = cli.StringFlag{
+		Name:        "out-file",
+		Usage:       "The file where the output is written. If not set, the output is printed on the console",
+		Destination: &txArgsConfig.outFile,
+	}
+	// nonce defines a flag for the transaction nonce
+	nonce = cli.Uint64Flag{
+		Name:        "nonce",
+		Usage:       "The nonce of the transaction",
+		Destination: &txArgsConfig.nonce,
+	}
+	// value defines a flag for the transaction value
+	value = cli.StringFlag{
+		Name:        "value",
+		Usage:       "The value of the transaction, in the smallest denomination",
+		Value:       "0",
+		Destination: &txArgsConfig.value,
+	}
+	// receiver defines a flag for the transaction receiver
+	receiver = cli.StringFlag{
+		Name:        "receiver",
+		Usage:       "The bech32 address of the receiver",
+		Destination: &txArgsConfig.receiver,
+	}
+	// gasPrice defines a flag for the transaction gas price
+	gasPrice = cli.Uint64Flag{
+		Name:        "gas-price",
+		Usage:       "The gas price of the transaction",
+		Value:       1000000000,
+		Destination: &txArgsConfig.gasPrice,
+	}
+	// gasLimit defines a flag for the transaction gas limit
+	gasLimit = cli.Uint64Flag{
+		Name:        "gas-limit",
+		Usage:       "The gas limit of the transaction",
+		Value:       50000,
+		Destination: &txArgsConfig.gasLimit,
+	}
+	// data defines a flag for the raw transaction data field
+	data = cli.StringFlag{
+		Name:        "data",
+		Usage:       "The raw data field of the transaction. Can not be used along with --function",
+		Destination: &txArgsConfig.data,
+	}
+	// function defines a flag for the called smart contract function
+	function = cli.StringFlag{
+		Name:        "function",
+		Usage:       "The smart contract function to be called. The data field is built from it and from the provided arguments",
+		Destination: &txArgsConfig.function,
+	}
+	// arguments defines a flag for the smart contract call arguments
+	arguments = cli.StringSliceFlag{
+		Name: "arguments",
+		Usage: "The arguments of the smart contract call, one per flag. Accepted forms: 0x prefixed hex, str: " +
+			"prefixed string, true, false, unsigned decimal numbers and bech32 addresses",
+		Value: &txArgsConfig.arguments,
+	}
+	// chainID defines a flag for the chain identifier
+	chainID = cli.StringFlag{
+		Name:        "chain-id",
+		Usage:       "The chain identifier. Example: 1",
+		Destination: &txArgsConfig.chainID,
+	}
+	// version defines a flag for the transaction version
+	version = cli.UintFlag{
+		Name:        "version",
+		Usage:       "The version of the transaction",
+		Value:       1,
+		Destination: &txArgsConfig.version,
+	}
+	// options defines a flag for the transaction options
+	options = cli.UintFlag{
+		Name:        "options",
+		Usage:       "The options of the transaction. Setting 1 with a version greater than 1 signs the hash of the transaction",
+		Destination: &txArgsConfig.options,
+	}
+
+	txArgsConfig = &txCfg{}
+
+	txSignKeyGen = signing.NewKeyGenerator(ed25519.NewEd25519())
+)
+
+func createTxCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:  "sign",
+			Usage: "Builds and signs transactions offline, printing them in the format accepted by /transaction/send and /transaction/send-multiple",
+			Flags: []cli.Flag{
+				pemFile,
+				pemIndex,
+				passwordFile,
+				txFile,
+				outFile,
+				nonce,
+				value,
+				receiver,
+				gasPrice,
+				gasLimit,
+				data,
+				function,
+				arguments,
+				chainID,
+				version,
+				options,
+			},
+			Action: func(_ *cli.Context) error {
+				return signTransactions()
+			},
+		},
+		{
+			Name:  "verify",
+			Usage: "Verifies the signatures of signed transactions",
+			Flags: []cli.Flag{
+				txFile,
+			},
+			Action: func(_ *cli.Context) error {
+				return verifyTransactions()
+			},
+		},
+		{
+			Name:  "decode",
+			Usage: "Decodes signed transactions, printing their hash, their smart contract call and their signature validity",
+			Flags: []cli.Flag{
+				txFile,
+				outFile,
+			},
+			Action: func(_ *cli.Context) error {
+				return decodeTransactions()
+			},
+		},
+	}
+}
+
+func createTxSigner() (txsigner.TxSigner, error) {
+	args := txsigner.ArgsTxSigner{
+		PubkeyConverter:   walletPubKeyConverter,
+		Marshalizer:       &marshal.GogoProtoMarshalizer{},
+		Hasher:            &blake2b.Blake2b{},
+		TxSignMarshalizer: &marshal.JsonMarshalizer{},
+		TxSignHasher:      &keccak.Keccak{},
+		KeyGen:            txSignKeyGen,
+		SingleSigner:      &singlesig.Ed25519Signer{},
+	}
+
+	return txsigner.NewTxSigner(args)
+}
+
+func signTransactions() error {
+	signer, err := createTxSigner()
+	if err != nil {
+		return err
+	}
+
+	privateKey, err := loadWalletPrivateKey(txArgsConfig.pemFile, txArgsConfig.pemIndex)
+	if err != nil {
+		return err
+	}
+	sender, err := privateKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return err
+	}
+
+	requests, isArray, err := loadTxRequests()
+	if err != nil {
+		return err
+	}
+
+	signedTxs := make([]*transaction.FrontendTransaction, 0, len(requests))
+	for idx, request := range requests {
+		tx, errCreate := signer.CreateTransaction(request, sender)
+		if errCreate != nil {
+			return fmt.Errorf("%w for transaction %d", errCreate, idx)
+		}
+
+		errCreate = signer.SignTransaction(tx, privateKey)
+		if errCreate != nil {
+			return fmt.Errorf("%w for transaction %d", errCreate, idx)
+		}
+
+		signedTxs = append(signedTxs, signer.ToFrontendTransaction(tx))
+	}
+
+	if isArray {
+		return writeJsonOutput(signedTxs, txArgsConfig.outFile)
+	}
+
+	return writeJsonOutput(signedTxs[0], txArgsConfig.outFile)
+}
+
+func verifyTransactions() error {
+	signer, err := createTxSigner()
+	if err != nil {
+		return err
+	}
+
+	signedTxs, _, err := loadSignedTransactions()
+	if err != nil {
+		return err
+	}
+
+	numInvalid := 0
+	for idx, signedTx := range signedTxs {
+		tx, errConvert := signer.FromFrontendTransaction(signedTx)
+		if errConvert == nil {
+			errConvert = signer.VerifyTransaction(tx)
+		}
+		if errConvert != nil {
+			numInvalid++
+			log.Error("invalid transaction", "index", idx, "sender", signedTx.Sender, "nonce", signedTx.Nonce, "error", errConvert)
+			continue
+		}
+
+		log.Info("valid transaction", "index", idx, "sender", signedTx.Sender, "nonce", signedTx.Nonce)
+	}
+
+	if numInvalid > 0 {
+		return fmt.Errorf("%d out of %d transactions are not correctly signed", numInvalid, len(signedTxs))
+	}
+
+	return nil
+}
+
+func decodeTransactions() error {
+	signer, err := createTxSigner()
+	if err != nil {
+		return err
+	}
+
+	signedTxs, isArray, err := loadSignedTransactions()
+	if err != nil {
+		return err
+	}
+
+	decodedTxs := make([]*txsigner.DecodedTransaction, 0, len(signedTxs))
+	for idx, signedTx := range signedTxs {
+		decodedTx, errDecode := signer.DecodeTransaction(signedTx)
+		if errDecode != nil {
+			return fmt.Errorf("%w for transaction %d", errDecode, idx)
+		}
+
+		decodedTxs = append(decodedTxs, decodedTx)
+	}
+
+	if isArray {
+		return writeJsonOutput(decodedTxs, txArgsConfig.outFile)
+	}
+
+	return writeJsonOutput(decodedTxs[0], txArgsConfig.outFile)
+}
+
+func loadWalletPrivateKey(pemFileName string, index int) (crypto.PrivateKey, error) {
+	if len(pemFileName) == 0 {
+		return nil, fmt.Errorf("the wallet PEM file should be provided")
+	}
+
+	password, err := keystore.ReadPassword(keystoreArgsConfig.passwordFile, keyPasswordEnvVar)
+	if err != nil {
+		return nil, err
+	}
+
+	encodedSk, _, err := keystore.LoadSkPkFromFile(pemFileName, index, password)
+	if err != nil {
+		return nil, err
+	}
+
+	skBytes, err := hex.DecodeString(string(encodedSk))
+	if err != nil {
+		return nil, fmt.Errorf("%w for encoded secret key", err)
+	}
+
+	return txSignKeyGen.PrivateKeyFromByteArray(skBytes)
+}
+
+func loadTxRequests() ([]*txsigner.TxRequest, bool, error) {
+	if len(txArgsConfig.txFile) == 0 {
+		request := &txsigner.TxRequest{
+			Nonce:     txArgsConfig.nonce,
+			Value:     txArgsConfig.value,
+			Receiver:  txArgsConfig.receiver,
+			GasPrice:  txArgsConfig.gasPrice,
+			GasLimit:  txArgsConfig.gasLimit,
+			Data:      txArgsConfig.data,
+			Function:  txArgsConfig.function,
+			Arguments: txArgsConfig.arguments,
+			ChainID:   txArgsConfig.chainID,
+			Version:   uint32(txArgsConfig.version),
+			Options:   uint32(txArgsConfig.options),
+		}
+
+		return []*txsigner.TxRequest{request}, false, nil
+	}
+
+	requests := make([]*txsigner.TxRequest, 0)
+	isArray, err := loadJsonFile(txArgsConfig.txFile, &requests)
+
+	return requests, isArray, err
+}
+
+func loadSignedTransactions() ([]*transaction.FrontendTransaction, bool, error) {
+	if len(txArgsConfig.txFile) == 0 {
+		return nil, false, fmt.Errorf("the transactions file should be provided")
+	}
+
+	signedTxs := make([]*transaction.FrontendTransaction, 0)
+	isArray, err := loadJsonFile(txArgsConfig.txFile, &signedTxs)
+
+	return signedTxs, isArray, err
+}
+
+// loadJsonFile reads a JSON file holding either an object or an array of objects into the provided slice pointer.
+// It returns true if the file held an array
+func loadJsonFile(fileName string, destination interface{}) (bool, error) {
+	buff, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return false, err
+	}
+
+	content := strings.TrimSpace(string(buff))
+	isArray := strings.HasPrefix(content, "[")
+	if !isArray {
+		content = "[" + content + "]"
+	}
+
+	err = json.Unmarshal([]byte(content), destination)
+	if err != nil {
+		return false, fmt.Errorf("%w while reading %s file", err, fileName)
+	}
+
+	return isArray, nil
+}
+
+func writeJsonOutput(object interface{}, fileName string) error {
+	buff, err := json.MarshalIndent(object, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if len(fileName) == 0 {
+		fmt.Println(string(buff))
+		return nil
+	}
+
+	return ioutil.WriteFile(fileName, buff, core.FileModeUserReadWrite)
+}
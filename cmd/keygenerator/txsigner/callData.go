@@ -0,0 +1,85 @@
+package txsigner
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+)
+
+const (
+	atSeparator     = "@"
+	hexArgPrefix    = "0x"
+	stringArgPrefix = "str:"
+	trueArg         = "true"
+	falseArg        = "false"
+)
+
+// EncodeCallData builds the data field of a smart contract call, following the function@hexArg1@hexArg2 format
+// expected by the arguments parser. Each argument can be provided as:
+//   - 0x prefixed hex string, used as it is
+//   - str: prefixed string, hex encoded
+//   - true or false, encoded as 01 or 00
+//   - unsigned decimal number, encoded as big endian bytes
+//   - address, decoded with the provided public key converter
+func EncodeCallData(function string, arguments []string, pubkeyConverter core.PubkeyConverter) (string, error) {
+	if check.IfNil(pubkeyConverter) {
+		return "", ErrNilPubkeyConverter
+	}
+	if len(function) == 0 || strings.Contains(function, atSeparator) {
+		return "", fmt.Errorf("%w: function %s", ErrInvalidArgument, function)
+	}
+
+	tokens := []string{function}
+	for _, argument := range arguments {
+		encodedArgument, err := encodeArgument(argument, pubkeyConverter)
+		if err != nil {
+			return "", err
+		}
+
+		tokens = append(tokens, encodedArgument)
+	}
+
+	return strings.Join(tokens, atSeparator), nil
+}
+
+func encodeArgument(argument string, pubkeyConverter core.PubkeyConverter) (string, error) {
+	switch {
+	case strings.HasPrefix(argument, hexArgPrefix):
+		hexArgument := strings.ToLower(argument[len(hexArgPrefix):])
+		_, err := hex.DecodeString(hexArgument)
+		if err != nil {
+			return "", fmt.Errorf("%w: %s is not a valid hex string", ErrInvalidArgument, argument)
+		}
+
+		return hexArgument, nil
+	case strings.HasPrefix(argument, stringArgPrefix):
+		return hex.EncodeToString([]byte(argument[len(stringArgPrefix):])), nil
+	case argument == trueArg:
+		return "01", nil
+	case argument == falseArg:
+		return "00", nil
+	}
+
+	number, ok := big.NewInt(0).SetString(argument, 10)
+	if ok {
+		if number.Sign() < 0 {
+			return "", fmt.Errorf("%w: negative numbers are not supported, %s", ErrInvalidArgument, argument)
+		}
+		if number.Sign() == 0 {
+			return "00", nil
+		}
+
+		return hex.EncodeToString(number.Bytes()), nil
+	}
+
+	address, err := pubkeyConverter.Decode(argument)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", ErrInvalidArgument, argument)
+	}
+
+	return hex.EncodeToString(address), nil
+}
@@ -0,0 +1,61 @@
+package txsigner
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/pubkeyConverter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testScAddress = "erd1qqqqqqqqqqqqqpgqhe8t5jewej70zupmh44jurgn29psua5l2jps3ntjj3"
+
+var testPubkeyConverter, _ = pubkeyConverter.NewBech32PubkeyConverter(32)
+
+func TestEncodeCallData_NilPubkeyConverterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	callData, err := EncodeCallData("function", nil, nil)
+	assert.Empty(t, callData)
+	assert.Equal(t, ErrNilPubkeyConverter, err)
+}
+
+func TestEncodeCallData_InvalidFunctionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, err := EncodeCallData("", nil, testPubkeyConverter)
+	assert.True(t, errors.Is(err, ErrInvalidArgument))
+
+	_, err = EncodeCallData("function@01", nil, testPubkeyConverter)
+	assert.True(t, errors.Is(err, ErrInvalidArgument))
+}
+
+func TestEncodeCallData_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	invalidArguments := []string{"0xzz", "0x123", "-5", "not an address", ""}
+	for _, argument := range invalidArguments {
+		_, err := EncodeCallData("function", []string{argument}, testPubkeyConverter)
+		assert.True(t, errors.Is(err, ErrInvalidArgument), "argument %s", argument)
+	}
+}
+
+func TestEncodeCallData_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	address, _ := testPubkeyConverter.Decode(testScAddress)
+
+	callData, err := EncodeCallData("function", nil, testPubkeyConverter)
+	require.Nil(t, err)
+	assert.Equal(t, "function", callData)
+
+	callData, err = EncodeCallData(
+		"function",
+		[]string{"0xABcd", "str:abc", "true", "false", "0", "256", testScAddress},
+		testPubkeyConverter,
+	)
+	require.Nil(t, err)
+	assert.Equal(t, "function@abcd@616263@01@00@00@0100@"+hex.EncodeToString(address), callData)
+}
@@ -0,0 +1,39 @@
+package txsigner
+
+import "errors"
+
+// ErrNilPubkeyConverter signals that a nil public key converter has been provided
+var ErrNilPubkeyConverter = errors.New("nil public key converter")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilKeyGenerator signals that a nil key generator has been provided
+var ErrNilKeyGenerator = errors.New("nil key generator")
+
+// ErrNilSingleSigner signals that a nil single signer has been provided
+var ErrNilSingleSigner = errors.New("nil single signer")
+
+// ErrNilTransaction signals that a nil transaction has been provided
+var ErrNilTransaction = errors.New("nil transaction")
+
+// ErrNilPrivateKey signals that a nil private key has been provided
+var ErrNilPrivateKey = errors.New("nil private key")
+
+// ErrInvalidValue signals that an invalid transaction value has been provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrInvalidArgument signals that a smart contract call argument could not be encoded
+var ErrInvalidArgument = errors.New("invalid argument")
+
+// ErrDataAndFunctionProvided signals that both the raw data field and a smart contract function were provided
+var ErrDataAndFunctionProvided = errors.New("only one of data and function can be provided")
+
+// ErrSenderMismatch signals that the sender of a transaction does not match the signing key
+var ErrSenderMismatch = errors.New("the sender does not match the signing key")
+
+// ErrMissingSignature signals that a transaction is not signed
+var ErrMissingSignature = errors.New("missing signature")
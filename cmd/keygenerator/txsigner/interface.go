@@ -0,0 +1,18 @@
+package txsigner
+
+import (
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
+// TxSigner defines the operations needed to build, sign, verify and decode transactions offline
+type TxSigner interface {
+	CreateTransaction(request *TxRequest, sender []byte) (*transaction.Transaction, error)
+	SignTransaction(tx *transaction.Transaction, privateKey crypto.PrivateKey) error
+	VerifyTransaction(tx *transaction.Transaction) error
+	ComputeTransactionHash(tx *transaction.Transaction) ([]byte, error)
+	ToFrontendTransaction(tx *transaction.Transaction) *transaction.FrontendTransaction
+	FromFrontendTransaction(ftx *transaction.FrontendTransaction) (*transaction.Transaction, error)
+	DecodeTransaction(ftx *transaction.FrontendTransaction) (*DecodedTransaction, error)
+	IsInterfaceNil() bool
+}
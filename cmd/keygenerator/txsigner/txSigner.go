@@ -0,0 +1,336 @@
+package txsigner
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/parsers"
+	"github.com/ElrondNetwork/elrond-go/core/versioning"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+const minTxVersion = uint32(1)
+
+// TxRequest holds the fields of a transaction to be built and signed. The data field can be provided either raw or
+// as a smart contract function along with its arguments
+type TxRequest struct {
+	Nonce     uint64   `json:"nonce"`
+	Value     string   `json:"value"`
+	Receiver  string   `json:"receiver"`
+	Sender    string   `json:"sender,omitempty"`
+	GasPrice  uint64   `json:"gasPrice"`
+	GasLimit  uint64   `json:"gasLimit"`
+	Data      string   `json:"data,omitempty"`
+	Function  string   `json:"function,omitempty"`
+	Arguments []string `json:"arguments,omitempty"`
+	ChainID   string   `json:"chainID"`
+	Version   uint32   `json:"version"`
+	Options   uint32   `json:"options,omitempty"`
+}
+
+// DecodedTransaction holds a signed transaction along with the information that can be extracted from it
+type DecodedTransaction struct {
+	Transaction    *transaction.FrontendTransaction `json:"transaction"`
+	Hash           string                           `json:"hash"`
+	Data           string                           `json:"data,omitempty"`
+	Function       string                           `json:"function,omitempty"`
+	Arguments      []string                         `json:"arguments,omitempty"`
+	SignedWithHash bool                             `json:"signedWithHash"`
+	SignatureValid bool                             `json:"signatureValid"`
+	SignatureError string                           `json:"signatureError,omitempty"`
+}
+
+// ArgsTxSigner holds the arguments needed to create a transaction signer
+type ArgsTxSigner struct {
+	PubkeyConverter   core.PubkeyConverter
+	Marshalizer       marshal.Marshalizer
+	Hasher            hashing.Hasher
+	TxSignMarshalizer marshal.Marshalizer
+	TxSignHasher      hashing.Hasher
+	KeyGen            crypto.KeyGenerator
+	SingleSigner      crypto.SingleSigner
+}
+
+type txSigner struct {
+	pubkeyConverter   core.PubkeyConverter
+	marshalizer       marshal.Marshalizer
+	hasher            hashing.Hasher
+	txSignMarshalizer marshal.Marshalizer
+	txSignHasher      hashing.Hasher
+	keyGen            crypto.KeyGenerator
+	singleSigner      crypto.SingleSigner
+	txVersionChecker  process.TxVersionCheckerHandler
+}
+
+// NewTxSigner creates a component able to build, sign, verify and decode transactions without any connection to
+// the network
+func NewTxSigner(args ArgsTxSigner) (*txSigner, error) {
+	if check.IfNil(args.PubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, fmt.Errorf("%w for the transaction hash", ErrNilMarshalizer)
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, fmt.Errorf("%w for the transaction hash", ErrNilHasher)
+	}
+	if check.IfNil(args.TxSignMarshalizer) {
+		return nil, fmt.Errorf("%w for signing", ErrNilMarshalizer)
+	}
+	if check.IfNil(args.TxSignHasher) {
+		return nil, fmt.Errorf("%w for signing", ErrNilHasher)
+	}
+	if check.IfNil(args.KeyGen) {
+		return nil, ErrNilKeyGenerator
+	}
+	if check.IfNil(args.SingleSigner) {
+		return nil, ErrNilSingleSigner
+	}
+
+	return &txSigner{
+		pubkeyConverter:   args.PubkeyConverter,
+		marshalizer:       args.Marshalizer,
+		hasher:            args.Hasher,
+		txSignMarshalizer: args.TxSignMarshalizer,
+		txSignHasher:      args.TxSignHasher,
+		keyGen:            args.KeyGen,
+		singleSigner:      args.SingleSigner,
+		txVersionChecker:  versioning.NewTxVersionChecker(minTxVersion),
+	}, nil
+}
+
+// CreateTransaction builds an unsigned transaction from the provided request, sent by the provided address
+func (ts *txSigner) CreateTransaction(request *TxRequest, sender []byte) (*transaction.Transaction, error) {
+	if request == nil {
+		return nil, ErrNilTransaction
+	}
+	if len(request.Sender) > 0 && request.Sender != ts.pubkeyConverter.Encode(sender) {
+		return nil, fmt.Errorf("%w, sender %s", ErrSenderMismatch, request.Sender)
+	}
+
+	receiver, err := ts.pubkeyConverter.Decode(request.Receiver)
+	if err != nil {
+		return nil, fmt.Errorf("%w for receiver %s", err, request.Receiver)
+	}
+
+	value := big.NewInt(0)
+	if len(request.Value) > 0 {
+		var ok bool
+		value, ok = value.SetString(request.Value, 10)
+		if !ok || value.Sign() < 0 {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidValue, request.Value)
+		}
+	}
+
+	data := []byte(request.Data)
+	if len(request.Function) > 0 {
+		if len(request.Data) > 0 {
+			return nil, ErrDataAndFunctionProvided
+		}
+
+		callData, errEncode := EncodeCallData(request.Function, request.Arguments, ts.pubkeyConverter)
+		if errEncode != nil {
+			return nil, errEncode
+		}
+		data = []byte(callData)
+	}
+
+	tx := &transaction.Transaction{
+		Nonce:    request.Nonce,
+		Value:    value,
+		RcvAddr:  receiver,
+		SndAddr:  sender,
+		GasPrice: request.GasPrice,
+		GasLimit: request.GasLimit,
+		Data:     data,
+		ChainID:  []byte(request.ChainID),
+		Version:  request.Version,
+		Options:  request.Options,
+	}
+
+	err = ts.txVersionChecker.CheckTxVersion(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// SignTransaction signs the transaction with the provided private key, the same way the node verifies it
+func (ts *txSigner) SignTransaction(tx *transaction.Transaction, privateKey crypto.PrivateKey) error {
+	if tx == nil {
+		return ErrNilTransaction
+	}
+	if check.IfNil(privateKey) {
+		return ErrNilPrivateKey
+	}
+
+	publicKeyBytes, err := privateKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(publicKeyBytes, tx.SndAddr) {
+		return fmt.Errorf("%w, sender %s", ErrSenderMismatch, ts.pubkeyConverter.Encode(tx.SndAddr))
+	}
+
+	message, err := ts.getMessageToSign(tx)
+	if err != nil {
+		return err
+	}
+
+	tx.Signature, err = ts.singleSigner.Sign(privateKey, message)
+
+	return err
+}
+
+// VerifyTransaction checks that the transaction was signed by its sender
+func (ts *txSigner) VerifyTransaction(tx *transaction.Transaction) error {
+	if tx == nil {
+		return ErrNilTransaction
+	}
+	if len(tx.Signature) == 0 {
+		return ErrMissingSignature
+	}
+
+	publicKey, err := ts.keyGen.PublicKeyFromByteArray(tx.SndAddr)
+	if err != nil {
+		return err
+	}
+
+	message, err := ts.getMessageToSign(tx)
+	if err != nil {
+		return err
+	}
+
+	return ts.singleSigner.Verify(publicKey, message, tx.Signature)
+}
+
+func (ts *txSigner) getMessageToSign(tx *transaction.Transaction) ([]byte, error) {
+	message, err := tx.GetDataForSigning(ts.pubkeyConverter, ts.txSignMarshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ts.txVersionChecker.IsSignedWithHash(tx) {
+		return message, nil
+	}
+
+	return ts.txSignHasher.Compute(string(message)), nil
+}
+
+// ComputeTransactionHash computes the hash under which the transaction will be known by the network
+func (ts *txSigner) ComputeTransactionHash(tx *transaction.Transaction) ([]byte, error) {
+	if tx == nil {
+		return nil, ErrNilTransaction
+	}
+
+	return core.CalculateHash(ts.marshalizer, ts.hasher, tx)
+}
+
+// ToFrontendTransaction converts the transaction in the format accepted by the transaction sending endpoints
+func (ts *txSigner) ToFrontendTransaction(tx *transaction.Transaction) *transaction.FrontendTransaction {
+	return &transaction.FrontendTransaction{
+		Nonce:     tx.Nonce,
+		Value:     tx.Value.String(),
+		Receiver:  ts.pubkeyConverter.Encode(tx.RcvAddr),
+		Sender:    ts.pubkeyConverter.Encode(tx.SndAddr),
+		GasPrice:  tx.GasPrice,
+		GasLimit:  tx.GasLimit,
+		Data:      tx.Data,
+		Signature: hex.EncodeToString(tx.Signature),
+		ChainID:   string(tx.ChainID),
+		Version:   tx.Version,
+		Options:   tx.Options,
+	}
+}
+
+// FromFrontendTransaction converts a transaction from the format accepted by the transaction sending endpoints
+func (ts *txSigner) FromFrontendTransaction(ftx *transaction.FrontendTransaction) (*transaction.Transaction, error) {
+	if ftx == nil {
+		return nil, ErrNilTransaction
+	}
+
+	receiver, err := ts.pubkeyConverter.Decode(ftx.Receiver)
+	if err != nil {
+		return nil, fmt.Errorf("%w for receiver %s", err, ftx.Receiver)
+	}
+	sender, err := ts.pubkeyConverter.Decode(ftx.Sender)
+	if err != nil {
+		return nil, fmt.Errorf("%w for sender %s", err, ftx.Sender)
+	}
+	signature, err := hex.DecodeString(ftx.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("%w for signature", err)
+	}
+	value, ok := big.NewInt(0).SetString(ftx.Value, 10)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidValue, ftx.Value)
+	}
+
+	return &transaction.Transaction{
+		Nonce:       ftx.Nonce,
+		Value:       value,
+		RcvAddr:     receiver,
+		RcvUserName: ftx.ReceiverUsername,
+		SndAddr:     sender,
+		SndUserName: ftx.SenderUsername,
+		GasPrice:    ftx.GasPrice,
+		GasLimit:    ftx.GasLimit,
+		Data:        ftx.Data,
+		Signature:   signature,
+		ChainID:     []byte(ftx.ChainID),
+		Version:     ftx.Version,
+		Options:     ftx.Options,
+	}, nil
+}
+
+// DecodeTransaction extracts the hash, the smart contract call and the signature validity of the transaction
+func (ts *txSigner) DecodeTransaction(ftx *transaction.FrontendTransaction) (*DecodedTransaction, error) {
+	tx, err := ts.FromFrontendTransaction(ftx)
+	if err != nil {
+		return nil, err
+	}
+
+	txHash, err := ts.ComputeTransactionHash(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	decoded := &DecodedTransaction{
+		Transaction:    ftx,
+		Hash:           hex.EncodeToString(txHash),
+		Data:           string(tx.Data),
+		SignedWithHash: ts.txVersionChecker.IsSignedWithHash(tx),
+		SignatureValid: true,
+	}
+
+	function, arguments, err := parsers.NewCallArgsParser().ParseData(string(tx.Data))
+	isCall := len(arguments) > 0 || core.IsSmartContractAddress(tx.RcvAddr)
+	if err == nil && isCall {
+		decoded.Function = function
+		for _, argument := range arguments {
+			decoded.Arguments = append(decoded.Arguments, hex.EncodeToString(argument))
+		}
+	}
+
+	err = ts.VerifyTransaction(tx)
+	if err != nil {
+		decoded.SignatureValid = false
+		decoded.SignatureError = err.Error()
+	}
+
+	return decoded, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ts *txSigner) IsInterfaceNil() bool {
+	return ts == nil
+}
@@ -0,0 +1,299 @@
+package txsigner
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/ed25519"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/ed25519/singlesig"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/hashing/keccak"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsTxSigner() ArgsTxSigner {
+	return ArgsTxSigner{
+		PubkeyConverter:   testPubkeyConverter,
+		Marshalizer:       &marshal.GogoProtoMarshalizer{},
+		Hasher:            &blake2b.Blake2b{},
+		TxSignMarshalizer: &marshal.JsonMarshalizer{},
+		TxSignHasher:      &keccak.Keccak{},
+		KeyGen:            signing.NewKeyGenerator(ed25519.NewEd25519()),
+		SingleSigner:      &singlesig.Ed25519Signer{},
+	}
+}
+
+func createTxRequest() *TxRequest {
+	return &TxRequest{
+		Nonce:     7,
+		Value:     "1000",
+		Receiver:  testScAddress,
+		GasPrice:  1000000000,
+		GasLimit:  60000000,
+		Function:  "add",
+		Arguments: []string{"5"},
+		ChainID:   "T",
+		Version:   1,
+	}
+}
+
+func createSigningKeys(t *testing.T, args ArgsTxSigner) (crypto.PrivateKey, []byte) {
+	privateKey, publicKey := args.KeyGen.GeneratePair()
+	sender, err := publicKey.ToByteArray()
+	require.Nil(t, err)
+
+	return privateKey, sender
+}
+
+func TestNewTxSigner_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		modify      func(args *ArgsTxSigner)
+		expectedErr error
+	}{
+		{"nil pubkey converter", func(args *ArgsTxSigner) { args.PubkeyConverter = nil }, ErrNilPubkeyConverter},
+		{"nil marshalizer", func(args *ArgsTxSigner) { args.Marshalizer = nil }, ErrNilMarshalizer},
+		{"nil hasher", func(args *ArgsTxSigner) { args.Hasher = nil }, ErrNilHasher},
+		{"nil tx sign marshalizer", func(args *ArgsTxSigner) { args.TxSignMarshalizer = nil }, ErrNilMarshalizer},
+		{"nil tx sign hasher", func(args *ArgsTxSigner) { args.TxSignHasher = nil }, ErrNilHasher},
+		{"nil key generator", func(args *ArgsTxSigner) { args.KeyGen = nil }, ErrNilKeyGenerator},
+		{"nil single signer", func(args *ArgsTxSigner) { args.SingleSigner = nil }, ErrNilSingleSigner},
+	}
+
+	for _, tt := range tests {
+		args := createMockArgsTxSigner()
+		tt.modify(&args)
+
+		ts, err := NewTxSigner(args)
+		assert.True(t, check.IfNil(ts), tt.name)
+		assert.True(t, errors.Is(err, tt.expectedErr), tt.name)
+	}
+}
+
+func TestNewTxSigner_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ts, err := NewTxSigner(createMockArgsTxSigner())
+	assert.False(t, check.IfNil(ts))
+	assert.Nil(t, err)
+}
+
+func TestTxSigner_CreateTransactionInvalidRequestShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsTxSigner()
+	ts, _ := NewTxSigner(args)
+	_, sender := createSigningKeys(t, args)
+
+	_, err := ts.CreateTransaction(nil, sender)
+	assert.Equal(t, ErrNilTransaction, err)
+
+	request := createTxRequest()
+	request.Sender = testScAddress
+	_, err = ts.CreateTransaction(request, sender)
+	assert.True(t, errors.Is(err, ErrSenderMismatch))
+
+	request = createTxRequest()
+	request.Value = "-1"
+	_, err = ts.CreateTransaction(request, sender)
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+
+	request = createTxRequest()
+	request.Data = "add@05"
+	_, err = ts.CreateTransaction(request, sender)
+	assert.Equal(t, ErrDataAndFunctionProvided, err)
+
+	request = createTxRequest()
+	request.Receiver = "invalid"
+	_, err = ts.CreateTransaction(request, sender)
+	assert.NotNil(t, err)
+
+	request = createTxRequest()
+	request.Version = 0
+	_, err = ts.CreateTransaction(request, sender)
+	assert.Equal(t, process.ErrInvalidTransactionVersion, err)
+}
+
+func TestTxSigner_CreateTransactionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsTxSigner()
+	ts, _ := NewTxSigner(args)
+	_, sender := createSigningKeys(t, args)
+
+	request := createTxRequest()
+	request.Sender = testPubkeyConverter.Encode(sender)
+	tx, err := ts.CreateTransaction(request, sender)
+	require.Nil(t, err)
+
+	receiver, _ := testPubkeyConverter.Decode(testScAddress)
+	assert.Equal(t, &transaction.Transaction{
+		Nonce:    7,
+		Value:    big.NewInt(1000),
+		RcvAddr:  receiver,
+		SndAddr:  sender,
+		GasPrice: 1000000000,
+		GasLimit: 60000000,
+		Data:     []byte("add@05"),
+		ChainID:  []byte("T"),
+		Version:  1,
+	}, tx)
+}
+
+func TestTxSigner_SignTransactionWithAnotherKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsTxSigner()
+	ts, _ := NewTxSigner(args)
+	_, sender := createSigningKeys(t, args)
+	otherPrivateKey, _ := createSigningKeys(t, args)
+
+	tx, _ := ts.CreateTransaction(createTxRequest(), sender)
+
+	err := ts.SignTransaction(tx, nil)
+	assert.Equal(t, ErrNilPrivateKey, err)
+
+	err = ts.SignTransaction(tx, otherPrivateKey)
+	assert.True(t, errors.Is(err, ErrSenderMismatch))
+	assert.Empty(t, tx.Signature)
+}
+
+func TestTxSigner_SignAndVerifyTransaction(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsTxSigner()
+	ts, _ := NewTxSigner(args)
+	privateKey, sender := createSigningKeys(t, args)
+
+	tx, _ := ts.CreateTransaction(createTxRequest(), sender)
+	err := ts.VerifyTransaction(tx)
+	assert.Equal(t, ErrMissingSignature, err)
+
+	err = ts.SignTransaction(tx, privateKey)
+	require.Nil(t, err)
+
+	// the node checks the signature over the json marshalized transaction
+	message, _ := tx.GetDataForSigning(args.PubkeyConverter, args.TxSignMarshalizer)
+	publicKey, _ := args.KeyGen.PublicKeyFromByteArray(sender)
+	err = args.SingleSigner.Verify(publicKey, message, tx.Signature)
+	assert.Nil(t, err)
+
+	err = ts.VerifyTransaction(tx)
+	assert.Nil(t, err)
+
+	tx.Nonce++
+	err = ts.VerifyTransaction(tx)
+	assert.NotNil(t, err)
+}
+
+func TestTxSigner_SignAndVerifyTransactionSignedWithHash(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsTxSigner()
+	ts, _ := NewTxSigner(args)
+	privateKey, sender := createSigningKeys(t, args)
+
+	request := createTxRequest()
+	request.Version = 2
+	request.Options = 1
+	tx, _ := ts.CreateTransaction(request, sender)
+	err := ts.SignTransaction(tx, privateKey)
+	require.Nil(t, err)
+
+	message, _ := tx.GetDataForSigning(args.PubkeyConverter, args.TxSignMarshalizer)
+	publicKey, _ := args.KeyGen.PublicKeyFromByteArray(sender)
+	err = args.SingleSigner.Verify(publicKey, args.TxSignHasher.Compute(string(message)), tx.Signature)
+	assert.Nil(t, err)
+
+	err = ts.VerifyTransaction(tx)
+	assert.Nil(t, err)
+}
+
+func TestTxSigner_FrontendTransactionRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsTxSigner()
+	ts, _ := NewTxSigner(args)
+	privateKey, sender := createSigningKeys(t, args)
+
+	tx, _ := ts.CreateTransaction(createTxRequest(), sender)
+	_ = ts.SignTransaction(tx, privateKey)
+
+	ftx := ts.ToFrontendTransaction(tx)
+	assert.Equal(t, testScAddress, ftx.Receiver)
+	assert.Equal(t, "1000", ftx.Value)
+
+	recreatedTx, err := ts.FromFrontendTransaction(ftx)
+	require.Nil(t, err)
+	assert.Equal(t, tx, recreatedTx)
+
+	_, err = ts.FromFrontendTransaction(nil)
+	assert.Equal(t, ErrNilTransaction, err)
+
+	ftx.Signature = "not hex"
+	_, err = ts.FromFrontendTransaction(ftx)
+	assert.NotNil(t, err)
+}
+
+func TestTxSigner_DecodeTransaction(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsTxSigner()
+	ts, _ := NewTxSigner(args)
+	privateKey, sender := createSigningKeys(t, args)
+
+	tx, _ := ts.CreateTransaction(createTxRequest(), sender)
+	_ = ts.SignTransaction(tx, privateKey)
+	txHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, tx)
+
+	decoded, err := ts.DecodeTransaction(ts.ToFrontendTransaction(tx))
+	require.Nil(t, err)
+	assert.Equal(t, "add", decoded.Function)
+	assert.Equal(t, []string{"05"}, decoded.Arguments)
+	assert.Equal(t, "add@05", decoded.Data)
+	assert.Equal(t, hex.EncodeToString(txHash), decoded.Hash)
+	assert.False(t, decoded.SignedWithHash)
+	assert.True(t, decoded.SignatureValid)
+	assert.Empty(t, decoded.SignatureError)
+
+	tx.GasLimit++
+	decoded, err = ts.DecodeTransaction(ts.ToFrontendTransaction(tx))
+	require.Nil(t, err)
+	assert.False(t, decoded.SignatureValid)
+	assert.NotEmpty(t, decoded.SignatureError)
+}
+
+func TestTxSigner_DecodeMoveBalanceShouldNotSetFunction(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsTxSigner()
+	ts, _ := NewTxSigner(args)
+	privateKey, sender := createSigningKeys(t, args)
+
+	request := createTxRequest()
+	request.Receiver = testPubkeyConverter.Encode(sender)
+	request.Function = ""
+	request.Arguments = nil
+	request.Data = "a message"
+	tx, _ := ts.CreateTransaction(request, sender)
+	_ = ts.SignTransaction(tx, privateKey)
+
+	decoded, err := ts.DecodeTransaction(ts.ToFrontendTransaction(tx))
+	require.Nil(t, err)
+	assert.Equal(t, "a message", decoded.Data)
+	assert.Empty(t, decoded.Function)
+	assert.Empty(t, decoded.Arguments)
+	assert.True(t, decoded.SignatureValid)
+}
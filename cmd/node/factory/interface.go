@@ -3,6 +3,7 @@ package factory
 import (
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/p2p"
@@ -24,6 +25,12 @@ type HeaderIntegrityVerifierHandler interface {
 	IsInterfaceNil() bool
 }
 
+// EquivocationDetectorHandler is the interface needed to detect the double signing from consensus messages
+type EquivocationDetectorHandler interface {
+	ProcessConsensusMessage(cnsMsg *consensus.Message)
+	IsInterfaceNil() bool
+}
+
 // P2PAntifloodHandler defines the behavior of a component able to signal that the system is too busy (or flooded) processing
 // p2p messages
 type P2PAntifloodHandler interface {
@@ -19,6 +19,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/core/statistics/softwareVersion"
 	factorySoftwareVersion "github.com/ElrondNetwork/elrond-go/core/statistics/softwareVersion/factory"
+	"github.com/ElrondNetwork/elrond-go/core/subscription"
 	"github.com/ElrondNetwork/elrond-go/data"
 	dataBlock "github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/endProcess"
@@ -58,6 +59,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/peer"
 	"github.com/ElrondNetwork/elrond-go/process/rewardTransaction"
 	"github.com/ElrondNetwork/elrond-go/process/scToProtocol"
+	"github.com/ElrondNetwork/elrond-go/process/slash"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
@@ -81,6 +83,10 @@ import (
 const (
 	// maxTxsToRequest specifies the maximum number of txs to request
 	maxTxsToRequest = 1000
+	// maxSlashingEvidenceInPool specifies the maximum number of slashing evidence held in pool
+	maxSlashingEvidenceInPool = 1000
+	// maxSlashingEvidencePerBlock specifies the maximum number of slashing evidence included in a metachain block
+	maxSlashingEvidencePerBlock = 10
 	// DefaultDBPath is the default DB path directory
 	DefaultDBPath = "db"
 	// DefaultEpochString is the default Epoch string when creating DB path
@@ -129,6 +135,7 @@ type Process struct {
 	RequestHandler           process.RequestHandler
 	TxLogsProcessor          process.TransactionLogProcessorDatabase
 	HeaderValidator          epochStart.HeaderValidator
+	EquivocationDetector     EquivocationDetectorHandler
 }
 
 type processComponentsFactoryArgs struct {
@@ -173,11 +180,14 @@ type processComponentsFactoryArgs struct {
 	uint64Converter           typeConverters.Uint64ByteSliceConverter
 	tpsBenchmark              statistics.TPSBenchmark
 	historyRepo               dblookupext.HistoryRepository
+	subscriptionNotifier      subscription.Notifier
 	epochNotifier             process.EpochNotifier
 	txSimulatorProcessorArgs  *txsimulator.ArgsTxSimulator
 	storageReolverImportPath  string
 	chanGracefullyClose       chan endProcess.ArgEndProcess
 	fallbackHeaderValidator   process.FallbackHeaderValidator
+	slashingEvidencePool      process.SlashingEvidencePool
+	slashingEvidenceVerifier  process.SlashingEvidenceVerifier
 }
 
 // NewProcessComponentsFactoryArgs initializes the arguments necessary for creating the process components
@@ -221,6 +231,7 @@ func NewProcessComponentsFactoryArgs(
 	indexer indexer.Indexer,
 	tpsBenchmark statistics.TPSBenchmark,
 	historyRepo dblookupext.HistoryRepository,
+	subscriptionNotifier subscription.Notifier,
 	epochNotifier process.EpochNotifier,
 	txSimulatorProcessorArgs *txsimulator.ArgsTxSimulator,
 	storageReolverImportPath string,
@@ -268,6 +279,7 @@ func NewProcessComponentsFactoryArgs(
 		indexer:                   indexer,
 		tpsBenchmark:              tpsBenchmark,
 		historyRepo:               historyRepo,
+		subscriptionNotifier:      subscriptionNotifier,
 		epochNotifier:             epochNotifier,
 		txSimulatorProcessorArgs:  txSimulatorProcessorArgs,
 		storageReolverImportPath:  storageReolverImportPath,
@@ -286,6 +298,7 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		SingleSigVerifier:       args.crypto.SingleSigner,
 		KeyGen:                  args.crypto.BlockSignKeyGen,
 		FallbackHeaderValidator: args.fallbackHeaderValidator,
+		ConsensusType:           args.mainConfig.Consensus.Type,
 	}
 	headerSigVerifier, err := headerCheck.NewHeaderSigVerifier(argsHeaderSig)
 	if err != nil {
@@ -301,6 +314,7 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		args.mainConfig.Versions.VersionsByEpochs,
 		args.mainConfig.Versions.DefaultVersion,
 		versionsCache,
+		args.mainConfig.Consensus.Type,
 	)
 	if err != nil {
 		return nil, err
@@ -347,8 +361,9 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 
 	txLogsStorage := args.data.Store.GetStorer(dataRetriever.TxLogsUnit)
 	txLogsProcessor, err := transactionLog.NewTxLogProcessor(transactionLog.ArgTxLogProcessor{
-		Storer:      txLogsStorage,
-		Marshalizer: args.coreData.InternalMarshalizer,
+		Storer:               txLogsStorage,
+		Marshalizer:          args.coreData.InternalMarshalizer,
+		SubscriptionNotifier: args.subscriptionNotifier,
 	})
 	if err != nil {
 		return nil, err
@@ -482,6 +497,40 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		return nil, err
 	}
 
+	args.slashingEvidencePool, err = slash.NewEvidencePool(maxSlashingEvidenceInPool)
+	if err != nil {
+		return nil, err
+	}
+
+	args.slashingEvidenceVerifier, err = slash.NewEvidenceVerifier(slash.ArgsEvidenceVerifier{
+		Marshalizer:      args.coreData.InternalMarshalizer,
+		Hasher:           args.coreData.Hasher,
+		KeyGen:           args.crypto.BlockSignKeyGen,
+		SingleSigner:     args.crypto.SingleSigner,
+		MultiSigVerifier: args.crypto.MultiSigner,
+		NodesCoordinator: args.nodesCoordinator,
+		ConsensusType:    args.mainConfig.Consensus.Type,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	equivocationDetector, err := slash.NewEquivocationDetector(slash.ArgsEquivocationDetector{
+		Marshalizer:      args.coreData.InternalMarshalizer,
+		Hasher:           args.coreData.Hasher,
+		ShardCoordinator: args.shardCoordinator,
+		NodesCoordinator: args.nodesCoordinator,
+		Verifier:         args.slashingEvidenceVerifier,
+		EvidencePool:     args.slashingEvidencePool,
+		Broadcaster:      args.network.NetMessenger,
+		ConsensusType:    args.mainConfig.Consensus.Type,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	args.data.Datapool.Headers().RegisterHandler(equivocationDetector.ReceivedHeader)
+
 	interceptorContainerFactory, blackListHandler, err := newInterceptorContainerFactory(
 		args.shardCoordinator,
 		args.nodesCoordinator,
@@ -493,6 +542,8 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		args.economicsData,
 		headerSigVerifier,
 		headerIntegrityVerifier,
+		args.slashingEvidenceVerifier,
+		args.slashingEvidencePool,
 		args.sizeCheckDelta,
 		blockTracker,
 		epochStartTrigger,
@@ -586,6 +637,7 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		RequestHandler:           requestHandler,
 		TxLogsProcessor:          txLogsProcessor,
 		HeaderValidator:          headerValidator,
+		EquivocationDetector:     equivocationDetector,
 	}, nil
 }
 
@@ -717,7 +769,7 @@ func indexGenesisBlocks(args *processComponentsFactoryArgs, genesisBlocks map[ui
 		}
 
 		log.Info("indexGenesisBlocks(): historyRepo.RecordBlock", "shardID", shardID, "hash", genesisBlockHash)
-		err = args.historyRepo.RecordBlock(genesisBlockHash, genesisBlockHeader, &dataBlock.Body{}, nil, nil)
+		err = args.historyRepo.RecordBlock(genesisBlockHash, genesisBlockHeader, &dataBlock.Body{}, nil, nil, nil)
 		if err != nil {
 			return err
 		}
@@ -840,6 +892,8 @@ func newInterceptorContainerFactory(
 	economics process.EconomicsDataHandler,
 	headerSigVerifier HeaderSigVerifierHandler,
 	headerIntegrityVerifier HeaderIntegrityVerifierHandler,
+	slashingEvidenceVerifier process.SlashingEvidenceVerifier,
+	slashingEvidencePool process.SlashingEvidencePool,
 	sizeCheckDelta uint32,
 	validityAttester process.ValidityAttester,
 	epochStartTrigger process.EpochStartTriggerHandler,
@@ -860,6 +914,8 @@ func newInterceptorContainerFactory(
 			economics,
 			headerSigVerifier,
 			headerIntegrityVerifier,
+			slashingEvidenceVerifier,
+			slashingEvidencePool,
 			sizeCheckDelta,
 			validityAttester,
 			epochStartTrigger,
@@ -881,6 +937,8 @@ func newInterceptorContainerFactory(
 			economics,
 			headerSigVerifier,
 			headerIntegrityVerifier,
+			slashingEvidenceVerifier,
+			slashingEvidencePool,
 			sizeCheckDelta,
 			validityAttester,
 			epochStartTrigger,
@@ -1104,6 +1162,8 @@ func newShardInterceptorContainerFactory(
 	economics process.EconomicsDataHandler,
 	headerSigVerifier HeaderSigVerifierHandler,
 	headerIntegrityVerifier HeaderIntegrityVerifierHandler,
+	slashingEvidenceVerifier process.SlashingEvidenceVerifier,
+	slashingEvidencePool process.SlashingEvidencePool,
 	sizeCheckDelta uint32,
 	validityAttester process.ValidityAttester,
 	epochStartTrigger process.EpochStartTriggerHandler,
@@ -1134,6 +1194,8 @@ func newShardInterceptorContainerFactory(
 		BlockBlackList:            headerBlackList,
 		HeaderSigVerifier:         headerSigVerifier,
 		HeaderIntegrityVerifier:   headerIntegrityVerifier,
+		SlashingEvidenceVerifier:  slashingEvidenceVerifier,
+		SlashingEvidencePool:      slashingEvidencePool,
 		SizeCheckDelta:            sizeCheckDelta,
 		ValidityAttester:          validityAttester,
 		EpochStartTrigger:         epochStartTrigger,
@@ -1166,6 +1228,8 @@ func newMetaInterceptorContainerFactory(
 	economics process.EconomicsDataHandler,
 	headerSigVerifier HeaderSigVerifierHandler,
 	headerIntegrityVerifier HeaderIntegrityVerifierHandler,
+	slashingEvidenceVerifier process.SlashingEvidenceVerifier,
+	slashingEvidencePool process.SlashingEvidencePool,
 	sizeCheckDelta uint32,
 	validityAttester process.ValidityAttester,
 	epochStartTrigger process.EpochStartTriggerHandler,
@@ -1196,6 +1260,8 @@ func newMetaInterceptorContainerFactory(
 		BlackList:                 headerBlackList,
 		HeaderSigVerifier:         headerSigVerifier,
 		HeaderIntegrityVerifier:   headerIntegrityVerifier,
+		SlashingEvidenceVerifier:  slashingEvidenceVerifier,
+		SlashingEvidencePool:      slashingEvidencePool,
 		SizeCheckDelta:            sizeCheckDelta,
 		ValidityAttester:          validityAttester,
 		EpochStartTrigger:         epochStartTrigger,
@@ -1442,9 +1508,11 @@ func newBlockProcessor(
 			processArgs.tpsBenchmark,
 			headerIntegrityVerifier,
 			processArgs.historyRepo,
+			processArgs.subscriptionNotifier,
 			processArgs.epochNotifier,
 			txSimulatorProcessorArgs,
 			processArgs.mainConfig,
+			processArgs.systemSCConfig,
 			workingDir,
 		)
 	}
@@ -1478,11 +1546,14 @@ func newBlockProcessor(
 			processArgs.tpsBenchmark,
 			headerIntegrityVerifier,
 			processArgs.historyRepo,
+			processArgs.subscriptionNotifier,
 			processArgs.epochNotifier,
 			txSimulatorProcessorArgs,
 			processArgs.mainConfig,
 			workingDir,
 			processArgs.rater,
+			processArgs.slashingEvidenceVerifier,
+			processArgs.slashingEvidencePool,
 		)
 	}
 
@@ -1514,9 +1585,11 @@ func newShardBlockProcessor(
 	tpsBenchmark statistics.TPSBenchmark,
 	headerIntegrityVerifier HeaderIntegrityVerifierHandler,
 	historyRepository dblookupext.HistoryRepository,
+	subscriptionNotifier subscription.Notifier,
 	epochNotifier process.EpochNotifier,
 	txSimulatorProcessorArgs *txsimulator.ArgsTxSimulator,
 	generalConfig config.Config,
+	systemSCConfig *config.SystemSmartContractsConfig,
 	workingDir string,
 ) (process.BlockProcessor, error) {
 	argsParser := smartContract.NewArgumentParser()
@@ -1527,10 +1600,14 @@ func newShardBlockProcessor(
 	}
 
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasSchedule:     gasSchedule,
-		MapDNSAddresses: mapDNSAddresses,
-		Marshalizer:     core.InternalMarshalizer,
-		Accounts:        stateComponents.AccountsAdapter,
+		GasSchedule:              gasSchedule,
+		MapDNSAddresses:          mapDNSAddresses,
+		Marshalizer:              core.InternalMarshalizer,
+		Accounts:                 stateComponents.AccountsAdapter,
+		ShardCoordinator:         shardCoordinator,
+		EpochNotifier:            epochNotifier,
+		ESDTAllowanceEnableEpoch: config.GeneralSettings.ESDTAllowanceEnableEpoch,
+		ESDTNFTEnableEpoch:       systemSCConfig.ESDTSystemSCConfig.NFTEnableEpoch,
 	}
 	builtInFuncFactory, err := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	if err != nil {
@@ -1613,7 +1690,7 @@ func newShardBlockProcessor(
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
 		PubkeyConverter:  stateComponents.AddressPubkeyConverter,
 		ShardCoordinator: shardCoordinator,
-		BuiltInFuncNames: builtInFuncs.Keys(),
+		BuiltInFunctions: builtInFuncs,
 		ArgumentParser:   parsers.NewCallArgsParser(),
 	}
 	txTypeHandler, err := coordinator.NewTxTypeHandler(argsTxTypeHandler)
@@ -1693,7 +1770,20 @@ func newShardBlockProcessor(
 		return nil, errors.New("could not create transaction statisticsProcessor: " + err.Error())
 	}
 
-	err = createShardTxSimulatorProcessor(argsNewScProcessor, argsNewTxProcessor, shardCoordinator, data, core, stateComponents, txSimulatorProcessorArgs)
+	err = createShardTxSimulatorProcessor(
+		argsNewScProcessor,
+		argsNewTxProcessor,
+		argsBuiltIn,
+		argsHook,
+		config,
+		economics,
+		gasSchedule,
+		shardCoordinator,
+		data,
+		core,
+		stateComponents,
+		txSimulatorProcessorArgs,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -1787,6 +1877,7 @@ func newShardBlockProcessor(
 		Indexer:                 indexer,
 		TpsBenchmark:            tpsBenchmark,
 		HistoryRepository:       historyRepository,
+		SubscriptionNotifier:    subscriptionNotifier,
 		EpochNotifier:           epochNotifier,
 		HeaderIntegrityVerifier: headerIntegrityVerifier,
 	}
@@ -1836,18 +1927,25 @@ func newMetaBlockProcessor(
 	tpsBenchmark statistics.TPSBenchmark,
 	headerIntegrityVerifier HeaderIntegrityVerifierHandler,
 	historyRepository dblookupext.HistoryRepository,
+	subscriptionNotifier subscription.Notifier,
 	epochNotifier process.EpochNotifier,
 	txSimulatorProcessorArgs *txsimulator.ArgsTxSimulator,
 	generalConfig config.Config,
 	workingDir string,
 	rater sharding.PeerAccountListAndRatingHandler,
+	slashingEvidenceVerifier process.SlashingEvidenceVerifier,
+	slashingEvidencePool process.SlashingEvidencePool,
 ) (process.BlockProcessor, error) {
 
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasSchedule:     gasSchedule,
-		MapDNSAddresses: make(map[string]struct{}), // no dns for meta
-		Marshalizer:     core.InternalMarshalizer,
-		Accounts:        stateComponents.AccountsAdapter,
+		GasSchedule:              gasSchedule,
+		MapDNSAddresses:          make(map[string]struct{}), // no dns for meta
+		Marshalizer:              core.InternalMarshalizer,
+		Accounts:                 stateComponents.AccountsAdapter,
+		ShardCoordinator:         shardCoordinator,
+		EpochNotifier:            epochNotifier,
+		ESDTAllowanceEnableEpoch: generalConfig.GeneralSettings.ESDTAllowanceEnableEpoch,
+		ESDTNFTEnableEpoch:       systemSCConfig.ESDTSystemSCConfig.NFTEnableEpoch,
 	}
 	builtInFuncFactory, err := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	if err != nil {
@@ -1927,7 +2025,7 @@ func newMetaBlockProcessor(
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
 		PubkeyConverter:  stateComponents.AddressPubkeyConverter,
 		ShardCoordinator: shardCoordinator,
-		BuiltInFuncNames: builtInFuncs.Keys(),
+		BuiltInFunctions: builtInFuncs,
 		ArgumentParser:   parsers.NewCallArgsParser(),
 	}
 	txTypeHandler, err := coordinator.NewTxTypeHandler(argsTxTypeHandler)
@@ -2200,6 +2298,7 @@ func newMetaBlockProcessor(
 		Indexer:                 indexer,
 		TpsBenchmark:            tpsBenchmark,
 		HistoryRepository:       historyRepository,
+		SubscriptionNotifier:    subscriptionNotifier,
 		EpochNotifier:           epochNotifier,
 	}
 
@@ -2229,6 +2328,22 @@ func newMetaBlockProcessor(
 		return nil, err
 	}
 
+	argsSlashingProcessor := slash.ArgsSlashingProcessor{
+		SystemVM:            systemVM,
+		UserAccountsDB:      stateComponents.AccountsAdapter,
+		PeerAccountsDB:      stateComponents.PeerAccounts,
+		Verifier:            slashingEvidenceVerifier,
+		EvidencePool:        slashingEvidencePool,
+		RatingsInfo:         ratingsData,
+		MaxEvidencePerBlock: maxSlashingEvidencePerBlock,
+		JailingAddress:      vm.JailingAddress,
+		StakingSCAddress:    vm.StakingSCAddress,
+	}
+	slashingProcessor, err := slash.NewSlashingProcessor(argsSlashingProcessor)
+	if err != nil {
+		return nil, err
+	}
+
 	arguments := block.ArgMetaProcessor{
 		ArgBaseProcessor:             argumentsBaseProcessor,
 		SCToProtocol:                 smartContractToProtocol,
@@ -2240,6 +2355,7 @@ func newMetaBlockProcessor(
 		ValidatorStatisticsProcessor: validatorStatisticsProcessor,
 		EpochSystemSCProcessor:       epochStartSystemSCProcessor,
 		RewardsV2EnableEpoch:         systemSCConfig.StakingSystemSCConfig.StakingV2Epoch,
+		SlashingProcessor:            slashingProcessor,
 	}
 
 	metaProcessor, err := block.NewMetaProcessor(arguments)
@@ -2258,16 +2374,76 @@ func newMetaBlockProcessor(
 func createShardTxSimulatorProcessor(
 	scProcArgs smartContract.ArgsNewSmartContractProcessor,
 	txProcArgs transaction.ArgsNewTxProcessor,
+	argsBuiltIn builtInFunctions.ArgsCreateBuiltInFunctionContainer,
+	argsHook hooks.ArgBlockChainHook,
+	config *config.Config,
+	economics process.EconomicsDataHandler,
+	gasSchedule core.GasScheduleNotifier,
 	shardCoordinator sharding.Coordinator,
 	data *mainFactory.DataComponents,
 	core *mainFactory.CoreComponents,
 	stateComponents *mainFactory.StateComponents,
 	txSimulatorProcessorArgs *txsimulator.ArgsTxSimulator,
 ) error {
-	readOnlyAccountsDB, err := txsimulator.NewReadOnlyAccountsDB(stateComponents.AccountsAdapter)
+	simulationAccountsDB, err := txsimulator.NewSimulationAccountsDB(stateComponents.AccountsAdapter)
 	if err != nil {
 		return err
 	}
+	txSimulatorProcessorArgs.SimulationAccounts = simulationAccountsDB
+
+	txLogsCollector, err := txsimulator.NewTxLogsCollector(stateComponents.AddressPubkeyConverter)
+	if err != nil {
+		return err
+	}
+	txSimulatorProcessorArgs.TxLogsCollector = txLogsCollector
+
+	// the simulated transactions are executed on their own virtual machine, so that the smart contracts see the
+	// state changes done by the previously simulated transactions of the same batch
+	argsBuiltIn.Accounts = simulationAccountsDB
+	builtInFuncFactory, err := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
+	if err != nil {
+		return err
+	}
+	builtInFuncs, err := builtInFuncFactory.CreateBuiltInFunctionContainer()
+	if err != nil {
+		return err
+	}
+
+	smartContractsCache, err := createCache(config.SmartContractDataPool)
+	if err != nil {
+		return err
+	}
+
+	argsHook.Accounts = simulationAccountsDB
+	argsHook.BuiltInFunctions = builtInFuncs
+	argsHook.CompiledSCPool = smartContractsCache
+	argsHook.NilCompiledSCStore = true
+	vmFactory, err := shard.NewVMContainerFactory(
+		config.VirtualMachine.Execution,
+		economics.MaxGasLimitPerBlock(shardCoordinator.SelfId()),
+		gasSchedule,
+		argsHook,
+		config.GeneralSettings.SCDeployEnableEpoch,
+		config.GeneralSettings.AheadOfTimeGasUsageEnableEpoch,
+	)
+	if err != nil {
+		return err
+	}
+
+	vmContainer, err := vmFactory.Create()
+	if err != nil {
+		return err
+	}
+
+	err = builtInFunctions.SetPayableHandler(builtInFuncs, vmFactory.BlockChainHookImpl())
+	if err != nil {
+		return err
+	}
+
+	scProcArgs.VmContainer = vmContainer
+	scProcArgs.BlockChainHook = vmFactory.BlockChainHookImpl()
+	scProcArgs.BuiltInFunctions = builtInFuncs
+	scProcArgs.TxLogsProcessor = txLogsCollector
 
 	interimProcFactory, err := shard.NewIntermediateProcessorsContainerFactory(
 		shardCoordinator,
@@ -2308,7 +2484,7 @@ func createShardTxSimulatorProcessor(
 	scProcArgs.TxFeeHandler = &processDisabled.FeeHandler{}
 	txProcArgs.TxFeeHandler = &processDisabled.FeeHandler{}
 
-	scProcArgs.AccountsDB = readOnlyAccountsDB
+	scProcArgs.AccountsDB = simulationAccountsDB
 
 	scProcessor, err := smartContract.NewSmartContractProcessor(scProcArgs)
 	if err != nil {
@@ -2316,7 +2492,21 @@ func createShardTxSimulatorProcessor(
 	}
 	txProcArgs.ScProcessor = scProcessor
 
-	txProcArgs.Accounts = readOnlyAccountsDB
+	callTracer, err := txsimulator.NewCallTracer(stateComponents.AddressPubkeyConverter)
+	if err != nil {
+		return err
+	}
+	err = scProcessor.SetCallTracer(callTracer)
+	if err != nil {
+		return err
+	}
+	err = scProcArgs.BlockChainHook.SetCallTracer(callTracer)
+	if err != nil {
+		return err
+	}
+	txSimulatorProcessorArgs.CallTracer = callTracer
+
+	txProcArgs.Accounts = simulationAccountsDB
 
 	txSimulatorProcessorArgs.TransactionProcessor, err = transaction.NewTxProcessor(txProcArgs)
 	if err != nil {
@@ -2370,20 +2560,45 @@ func createMetaTxSimulatorProcessor(
 
 	scProcArgs.TxFeeHandler = &processDisabled.FeeHandler{}
 
+	simulationAccountsDB, err := txsimulator.NewSimulationAccountsDB(stateComponents.AccountsAdapter)
+	if err != nil {
+		return err
+	}
+	txSimulatorProcessorArgs.SimulationAccounts = simulationAccountsDB
+	scProcArgs.AccountsDB = simulationAccountsDB
+
+	txLogsCollector, err := txsimulator.NewTxLogsCollector(stateComponents.AddressPubkeyConverter)
+	if err != nil {
+		return err
+	}
+	txSimulatorProcessorArgs.TxLogsCollector = txLogsCollector
+	scProcArgs.TxLogsProcessor = txLogsCollector
+
 	scProcessor, err := smartContract.NewSmartContractProcessor(scProcArgs)
 	if err != nil {
 		return err
 	}
 
-	accountsWrapper, err := txsimulator.NewReadOnlyAccountsDB(stateComponents.AccountsAdapter)
+	// the blockchain hook is shared with the blocks processing, but the tracer only records the calls
+	// originated by the simulated transaction
+	callTracer, err := txsimulator.NewCallTracer(stateComponents.AddressPubkeyConverter)
+	if err != nil {
+		return err
+	}
+	err = scProcessor.SetCallTracer(callTracer)
+	if err != nil {
+		return err
+	}
+	err = scProcArgs.BlockChainHook.SetCallTracer(callTracer)
 	if err != nil {
 		return err
 	}
+	txSimulatorProcessorArgs.CallTracer = callTracer
 
 	argsNewMetaTx := transaction.ArgsNewMetaTxProcessor{
 		Hasher:           core.Hasher,
 		Marshalizer:      core.InternalMarshalizer,
-		Accounts:         accountsWrapper,
+		Accounts:         simulationAccountsDB,
 		PubkeyConv:       stateComponents.AddressPubkeyConverter,
 		ShardCoordinator: shardCoordinator,
 		ScProcessor:      scProcessor,
@@ -2436,6 +2651,7 @@ func newValidatorStatisticsProcessor(
 		EpochNotifier:                   processComponents.epochNotifier,
 		SwitchJailWaitingEnableEpoch:    processComponents.mainConfig.GeneralSettings.SwitchJailWaitingEnableEpoch,
 		BelowSignedThresholdEnableEpoch: processComponents.mainConfig.GeneralSettings.BelowSignedThresholdEnableEpoch,
+		ConsensusType:                   processComponents.mainConfig.Consensus.Type,
 	}
 
 	validatorStatisticsProcessor, err := peer.NewValidatorStatisticsProcessor(arguments)
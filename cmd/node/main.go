@@ -32,9 +32,11 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/forking"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	indexerFactory "github.com/ElrondNetwork/elrond-go/core/indexer/factory"
+	"github.com/ElrondNetwork/elrond-go/core/keystore"
 	"github.com/ElrondNetwork/elrond-go/core/logging"
 	"github.com/ElrondNetwork/elrond-go/core/parsers"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/core/subscription"
 	"github.com/ElrondNetwork/elrond-go/core/versioning"
 	"github.com/ElrondNetwork/elrond-go/core/watchdog"
 	"github.com/ElrondNetwork/elrond-go/crypto"
@@ -100,6 +102,7 @@ const (
 	secondsToWaitForP2PBootstrap = 20
 	maxTimeToClose               = 10 * time.Second
 	maxMachineIDLen              = 10
+	validatorKeyPasswordEnvVar   = "ELROND_VALIDATOR_KEY_PASSWORD"
 )
 
 var (
@@ -291,6 +294,20 @@ VERSION:
 		Usage: "The `filepath` for the PEM file which contains the secret keys for the validator key.",
 		Value: "./config/validatorKey.pem",
 	}
+	// validatorKeyPasswordFile defines a flag for the path to the file holding the password of the encrypted validator key
+	validatorKeyPasswordFile = cli.StringFlag{
+		Name: "validator-key-password-file",
+		Usage: "The `filepath` for the file holding the password used to decrypt the validator key, if the key file " +
+			"is an encrypted keystore. If not set, the password is read from the " + validatorKeyPasswordEnvVar +
+			" environment variable",
+	}
+	// allValidatorKeysPemFile defines a flag for the path to the file holding all the validator keys the node acts for
+	allValidatorKeysPemFile = cli.StringFlag{
+		Name: "all-validator-keys-pem-file",
+		Usage: "The `filepath` for the PEM file, or encrypted keystore, which contains the secret keys of all the " +
+			"validators the node acts for in multi-key mode. The keys are decrypted with the validator key password. " +
+			"If not set, the node acts only for its own validator key",
+	}
 	// elasticSearchTemplates defines a flag for the path to the elasticsearch templates
 	elasticSearchTemplates = cli.StringFlag{
 		Name:  "elasticsearch-templates-path",
@@ -416,6 +433,8 @@ func main() {
 		gasScheduleConfigurationDirectory,
 		validatorKeyIndex,
 		validatorKeyPemFile,
+		validatorKeyPasswordFile,
+		allValidatorKeysPemFile,
 		port,
 		profileMode,
 		useHealthService,
@@ -458,9 +477,47 @@ func main() {
 	}
 }
 
+func createCryptoParams(
+	ctx *cli.Context,
+	generalConfig *config.Config,
+	validatorPubkeyConverter core.PubkeyConverter,
+	validatorKeyPassword []byte,
+	suite crypto.Suite,
+	isInImportMode bool,
+	log logger.Logger,
+) (*mainFactory.CryptoParams, error) {
+	if generalConfig.RemoteSigner.Enabled {
+		log.Info("validator key is held by the remote signer", "URL", generalConfig.RemoteSigner.URL)
+		return mainFactory.CreateRemoteSignerCryptoParams(
+			validatorPubkeyConverter,
+			generalConfig.RemoteSigner.PublicKey,
+			suite,
+		)
+	}
+
+	cryptoParamsLoader, err := mainFactory.NewCryptoSigningParamsLoader(
+		validatorPubkeyConverter,
+		ctx.GlobalInt(validatorKeyIndex.Name),
+		ctx.GlobalString(validatorKeyPemFile.Name),
+		validatorKeyPassword,
+		suite,
+		isInImportMode,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	cryptoParams, err := cryptoParamsLoader.Get()
+	if err != nil {
+		return nil, fmt.Errorf("%w: consider regenerating your keys", err)
+	}
+
+	return cryptoParams, nil
+}
+
 func getSuite(config *config.Config) (crypto.Suite, error) {
 	switch config.Consensus.Type {
-	case consensus.BlsConsensusType:
+	case consensus.BlsConsensusType, consensus.PbftConsensusType:
 		return mcl.NewSuiteBLS12(), nil
 	default:
 		return nil, errors.New("no consensus provided in config file")
@@ -667,23 +724,27 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
-	validatorKeyPemFileName := ctx.GlobalString(validatorKeyPemFile.Name)
-	cryptoParamsLoader, err := mainFactory.NewCryptoSigningParamsLoader(
+	var validatorKeyPassword []byte
+	if !generalConfig.RemoteSigner.Enabled {
+		validatorKeyPassword, err = keystore.ReadPassword(ctx.GlobalString(validatorKeyPasswordFile.Name), validatorKeyPasswordEnvVar)
+		if err != nil {
+			return err
+		}
+	}
+
+	cryptoParams, err := createCryptoParams(
+		ctx,
+		generalConfig,
 		validatorPubkeyConverter,
-		ctx.GlobalInt(validatorKeyIndex.Name),
-		validatorKeyPemFileName,
+		validatorKeyPassword,
 		suite,
 		isInImportMode,
+		log,
 	)
 	if err != nil {
 		return err
 	}
 
-	cryptoParams, err := cryptoParamsLoader.Get()
-	if err != nil {
-		return fmt.Errorf("%w: consider regenerating your keys", err)
-	}
-
 	log.Debug("block sign pubkey", "value", cryptoParams.PublicKeyString)
 
 	if ctx.IsSet(destinationShardAsObserver.Name) {
@@ -739,6 +800,8 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		KeyGen:                               cryptoParams.KeyGenerator,
 		PrivKey:                              cryptoParams.PrivateKey,
 		ActivateBLSPubKeyMessageVerification: systemSCConfig.StakingSystemSCConfig.ActivateBLSPubKeyMessageVerification,
+		ManagedKeysPemFileName:               ctx.GlobalString(allValidatorKeysPemFile.Name),
+		ManagedKeysPassword:                  validatorKeyPassword,
 	}
 	cryptoComponentsFactory, err := mainFactory.NewCryptoComponentsFactory(cryptoArgs, importDbNoSigCheckFlag)
 	if err != nil {
@@ -946,6 +1009,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		generalConfig.Versions.VersionsByEpochs,
 		generalConfig.Versions.DefaultVersion,
 		versionsCache,
+		generalConfig.Consensus.Type,
 	)
 	if err != nil {
 		return err
@@ -1001,6 +1065,13 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		TriesContainer:      trieContainer,
 		TrieStorageManagers: trieStorageManager,
 	}
+	for name, storageManager := range triesComponents.TrieStorageManagers {
+		err = storageManager.SetAppStatusHandler(coreComponents.StatusHandler)
+		if err != nil {
+			return err
+		}
+		healthService.RegisterHealthChecker(core.HealthComponentTrieStoragePrefix+name, storageManager)
+	}
 
 	log.Info("bootstrap parameters", "shardId", bootstrapParameters.SelfShardId, "epoch", bootstrapParameters.Epoch, "numShards", bootstrapParameters.NumOfShards)
 
@@ -1050,6 +1121,8 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 	healthService.RegisterComponent(dataComponents.Datapool.Transactions())
 	healthService.RegisterComponent(dataComponents.Datapool.UnsignedTransactions())
 	healthService.RegisterComponent(dataComponents.Datapool.RewardTransactions())
+	healthService.RegisterHealthChecker(core.HealthComponentTxPool, dataComponents.Datapool.Transactions())
+	healthService.RegisterHealthChecker(core.HealthComponentP2P, networkComponents.NetMessenger)
 
 	log.Trace("initializing metrics")
 	err = metrics.InitMetrics(
@@ -1234,11 +1307,12 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 	}
 
 	historyRepoFactoryArgs := &dbLookupFactory.ArgsHistoryRepositoryFactory{
-		SelfShardID: shardCoordinator.SelfId(),
-		Config:      generalConfig.DbLookupExtensions,
-		Hasher:      coreComponents.Hasher,
-		Marshalizer: coreComponents.InternalMarshalizer,
-		Store:       dataComponents.Store,
+		SelfShardID:     shardCoordinator.SelfId(),
+		Config:          generalConfig.DbLookupExtensions,
+		Hasher:          coreComponents.Hasher,
+		Marshalizer:     coreComponents.InternalMarshalizer,
+		Store:           dataComponents.Store,
+		Uint64Converter: coreComponents.Uint64ByteSliceConverter,
 	}
 	historyRepositoryFactory, err := dbLookupFactory.NewHistoryRepositoryFactory(historyRepoFactoryArgs)
 	if err != nil {
@@ -1250,9 +1324,16 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
+	subscriptionHub, err := createSubscriptionHub(generalConfig.Subscriptions, shardCoordinator.SelfId(), addressPubkeyConverter)
+	if err != nil {
+		return err
+	}
+
 	txSimulatorProcessorArgs := &txsimulator.ArgsTxSimulator{
 		AddressPubKeyConverter: addressPubkeyConverter,
 		ShardCoordinator:       shardCoordinator,
+		Marshalizer:            coreComponents.InternalMarshalizer,
+		Hasher:                 coreComponents.Hasher,
 	}
 
 	fallbackHeaderValidator, err := fallback.NewFallbackHeaderValidator(
@@ -1305,6 +1386,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		elasticIndexer,
 		tpsBenchmark,
 		historyRepository,
+		subscriptionHub,
 		epochNotifier,
 		txSimulatorProcessorArgs,
 		ctx.GlobalString(importDbDirectory.Name),
@@ -1354,6 +1436,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 	if !elasticIndexer.IsNilIndexer() {
 		elasticIndexer.SetTxLogsProcessor(processComponents.TxLogsProcessor)
 		processComponents.TxLogsProcessor.EnableLogToBeSavedInCache()
+		healthService.RegisterHealthChecker(core.HealthComponentIndexer, elasticIndexer)
 	}
 
 	log.Trace("creating node structure")
@@ -1386,6 +1469,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		hardForkTrigger,
 		historyRepository,
 		fallbackHeaderValidator,
+		healthService,
 		isInImportMode,
 	)
 	if err != nil {
@@ -1410,6 +1494,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 	apiResolver, err := createApiResolver(
 		generalConfig,
 		stateComponents.AccountsAdapter,
+		stateComponents.AccountsRecreator,
 		stateComponents.PeerAccounts,
 		stateComponents.AddressPubkeyConverter,
 		dataComponents.Store,
@@ -1468,6 +1553,8 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		ApiRoutesConfig: *apiRoutesConfig,
 		AccountsState:   stateComponents.AccountsAdapter,
 		PeerState:       stateComponents.PeerAccounts,
+		SubscriptionHub: subscriptionHub,
+		HealthService:   healthService,
 	}
 
 	ef, err := facade.NewNodeFacade(argNodeFacade)
@@ -1501,7 +1588,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 
 	chanCloseComponents := make(chan struct{})
 	go func() {
-		closeAllComponents(log, healthService, dataComponents, triesComponents, networkComponents, chanCloseComponents)
+		closeAllComponents(log, healthService, subscriptionHub, dataComponents, triesComponents, networkComponents, chanCloseComponents)
 	}()
 
 	select {
@@ -1563,6 +1650,7 @@ func changeStorageConfigForDBImport(storageConfig *config.StorageConfig) {
 func closeAllComponents(
 	log logger.Logger,
 	healthService io.Closer,
+	subscriptionHub io.Closer,
 	dataComponents *mainFactory.DataComponents,
 	triesComponents *mainFactory.TriesComponents,
 	networkComponents *mainFactory.NetworkComponents,
@@ -1572,6 +1660,10 @@ func closeAllComponents(
 	err := healthService.Close()
 	log.LogIfError(err)
 
+	log.Debug("closing subscriptions hub...")
+	err = subscriptionHub.Close()
+	log.LogIfError(err)
+
 	log.Debug("closing all store units....")
 	err = dataComponents.Store.CloseAll()
 	log.LogIfError(err)
@@ -1589,6 +1681,24 @@ func closeAllComponents(
 	chanCloseComponents <- struct{}{}
 }
 
+func createSubscriptionHub(
+	subscriptionsConfig config.SubscriptionsConfig,
+	selfShardID uint32,
+	addressPubkeyConverter core.PubkeyConverter,
+) (subscription.Hub, error) {
+	if !subscriptionsConfig.Enabled {
+		return subscription.NewNilHub(), nil
+	}
+
+	return subscription.NewHub(subscription.ArgsHub{
+		SelfShardID:            selfShardID,
+		AddressPubkeyConverter: addressPubkeyConverter,
+		ReplayBufferSize:       subscriptionsConfig.ReplayBufferSize,
+		SubscriberQueueSize:    subscriptionsConfig.SubscriberQueueSize,
+		MaxSubscribers:         subscriptionsConfig.MaxSubscribers,
+	})
+}
+
 func createStringFromRatingsData(ratingsData *rating.RatingsData) string {
 	metaChainStepHandler := ratingsData.MetaChainRatingsStepHandler()
 	shardChainHandler := ratingsData.ShardChainRatingsStepHandler()
@@ -2028,11 +2138,14 @@ func createElasticIndexer(
 
 	indexerFactoryArgs := &indexerFactory.ArgsIndexerFactory{
 		Enabled:                  elasticSearchConfig.Enabled,
+		Driver:                   elasticSearchConfig.Driver,
 		IndexerCacheSize:         elasticSearchConfig.IndexerCacheSize,
 		ShardCoordinator:         shardCoordinator,
 		Url:                      elasticSearchConfig.URL,
 		UserName:                 elasticSearchConfig.Username,
 		Password:                 elasticSearchConfig.Password,
+		SQLDriverName:            elasticSearchConfig.SQLDriverName,
+		SQLDataSourceName:        elasticSearchConfig.SQLDataSourceName,
 		Marshalizer:              marshalizer,
 		Hasher:                   hasher,
 		EpochStartNotifier:       startNotifier,
@@ -2199,6 +2312,7 @@ func createNode(
 	hardForkTrigger node.HardforkTrigger,
 	historyRepository dblookupext.HistoryRepository,
 	fallbackHeaderValidator consensus.FallbackHeaderValidator,
+	healthService node.HealthService,
 	isInImportDbMode bool,
 ) (*node.Node, error) {
 	var err error
@@ -2271,6 +2385,7 @@ func createNode(
 		node.WithAddressPubkeyConverter(stateComponents.AddressPubkeyConverter),
 		node.WithValidatorPubkeyConverter(stateComponents.ValidatorPubkeyConverter),
 		node.WithAccountsAdapter(stateComponents.AccountsAdapter),
+		node.WithAccountsRecreator(stateComponents.AccountsRecreator),
 		node.WithBlockChain(data.Blkc),
 		node.WithDataStore(data.Store),
 		node.WithRoundDuration(nodesConfig.RoundDuration),
@@ -2305,6 +2420,8 @@ func createNode(
 		node.WithRequestedItemsHandler(requestedItemsHandler),
 		node.WithHeaderSigVerifier(process.HeaderSigVerifier),
 		node.WithHeaderIntegrityVerifier(process.HeaderIntegrityVerifier),
+		node.WithEquivocationDetector(process.EquivocationDetector),
+		node.WithHealthService(healthService),
 		node.WithValidatorStatistics(process.ValidatorsStatistics),
 		node.WithValidatorsProvider(process.ValidatorsProvider),
 		node.WithChainID(coreData.ChainID),
@@ -2323,6 +2440,8 @@ func createNode(
 		node.WithFallbackHeaderValidator(fallbackHeaderValidator),
 		node.WithWatchdogTimer(watchdogTimer),
 		node.WithPeerSignatureHandler(crypto.PeerSignatureHandler),
+		node.WithKeysHandler(crypto.ManagedKeysHolder),
+		node.WithSigningHandler(crypto.SigningHandler),
 		node.WithHistoryRepository(historyRepository),
 		node.WithEnableSignTxWithHashEpoch(config.GeneralSettings.TransactionSignedWithTxHashEnableEpoch),
 		node.WithTxSignHasher(coreData.TxSignHasher),
@@ -2424,6 +2543,7 @@ func startStatisticsMonitor(
 func createApiResolver(
 	generalConfig *config.Config,
 	accnts state.AccountsAdapter,
+	accountsRecreator state.AccountsAdapterRecreator,
 	validatorAccounts state.AccountsAdapter,
 	pubkeyConv core.PubkeyConverter,
 	storageService dataRetriever.StorageService,
@@ -2446,6 +2566,7 @@ func createApiResolver(
 	scQueryService, err := createScQueryService(
 		generalConfig,
 		accnts,
+		accountsRecreator,
 		validatorAccounts,
 		pubkeyConv,
 		storageService,
@@ -2472,6 +2593,10 @@ func createApiResolver(
 		gasScheduleNotifier,
 		marshalizer,
 		accnts,
+		shardCoordinator,
+		epochNotifier,
+		generalConfig.GeneralSettings,
+		systemSCConfig.ESDTSystemSCConfig,
 	)
 	if err != nil {
 		return nil, err
@@ -2480,7 +2605,7 @@ func createApiResolver(
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
 		PubkeyConverter:  pubkeyConv,
 		ShardCoordinator: shardCoordinator,
-		BuiltInFuncNames: builtInFuncs.Keys(),
+		BuiltInFunctions: builtInFuncs,
 		ArgumentParser:   parsers.NewCallArgsParser(),
 	}
 	txTypeHandler, err := coordinator.NewTxTypeHandler(argsTxTypeHandler)
@@ -2511,6 +2636,7 @@ func createApiResolver(
 func createScQueryService(
 	generalConfig *config.Config,
 	accnts state.AccountsAdapter,
+	accountsRecreator state.AccountsAdapterRecreator,
 	validatorAccounts state.AccountsAdapter,
 	pubkeyConv core.PubkeyConverter,
 	storageService dataRetriever.StorageService,
@@ -2539,6 +2665,7 @@ func createScQueryService(
 		scQueryService, err := createScQueryElement(
 			generalConfig,
 			accnts,
+			accountsRecreator,
 			validatorAccounts,
 			pubkeyConv,
 			storageService,
@@ -2577,6 +2704,7 @@ func createScQueryService(
 func createScQueryElement(
 	generalConfig *config.Config,
 	accnts state.AccountsAdapter,
+	accountsRecreator state.AccountsAdapterRecreator,
 	validatorAccounts state.AccountsAdapter,
 	pubkeyConv core.PubkeyConverter,
 	storageService dataRetriever.StorageService,
@@ -2599,10 +2727,19 @@ func createScQueryElement(
 	var vmFactory process.VirtualMachinesContainerFactory
 	var err error
 
+	queryAccounts, err := state.NewSwitchableAccountsDB(accnts, accountsRecreator)
+	if err != nil {
+		return nil, err
+	}
+
 	builtInFuncs, err := createBuiltinFuncs(
 		gasScheduleNotifier,
 		marshalizer,
-		accnts,
+		queryAccounts,
+		shardCoordinator,
+		epochNotifier,
+		generalConfig.GeneralSettings,
+		systemSCConfig.ESDTSystemSCConfig,
 	)
 	if err != nil {
 		return nil, err
@@ -2617,7 +2754,7 @@ func createScQueryElement(
 	scStorage := generalConfig.SmartContractsStorageForSCQuery
 	scStorage.DB.FilePath += fmt.Sprintf("%d", index)
 	argsHook := hooks.ArgBlockChainHook{
-		Accounts:           accnts,
+		Accounts:           queryAccounts,
 		PubkeyConv:         pubkeyConv,
 		StorageService:     storageService,
 		BlockChain:         blockChain,
@@ -2676,19 +2813,32 @@ func createScQueryElement(
 		return nil, err
 	}
 
-	return smartContract.NewSCQueryService(vmContainer, economics, vmFactory.BlockChainHookImpl(), blockChain)
+	scQueryService, err := smartContract.NewSCQueryService(vmContainer, economics, vmFactory.BlockChainHookImpl(), blockChain)
+	if err != nil {
+		return nil, err
+	}
+
+	return smartContract.NewSCQueryServiceWithHistory(scQueryService, queryAccounts)
 }
 
 func createBuiltinFuncs(
 	gasScheduleNotifier core.GasScheduleNotifier,
 	marshalizer marshal.Marshalizer,
 	accnts state.AccountsAdapter,
+	shardCoordinator sharding.Coordinator,
+	epochNotifier process.EpochNotifier,
+	generalSettings config.GeneralSettingsConfig,
+	esdtSystemSCConfig config.ESDTSystemSCConfig,
 ) (process.BuiltInFunctionContainer, error) {
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasSchedule:     gasScheduleNotifier,
-		MapDNSAddresses: make(map[string]struct{}),
-		Marshalizer:     marshalizer,
-		Accounts:        accnts,
+		GasSchedule:              gasScheduleNotifier,
+		MapDNSAddresses:          make(map[string]struct{}),
+		Marshalizer:              marshalizer,
+		Accounts:                 accnts,
+		ShardCoordinator:         shardCoordinator,
+		EpochNotifier:            epochNotifier,
+		ESDTAllowanceEnableEpoch: generalSettings.ESDTAllowanceEnableEpoch,
+		ESDTNFTEnableEpoch:       esdtSystemSCConfig.NFTEnableEpoch,
 	}
 	builtInFuncFactory, err := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	if err != nil {
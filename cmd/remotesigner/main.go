@@ -0,0 +1,159 @@
+package main
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/cmd/remotesigner/signer"
+	"github.com/ElrondNetwork/elrond-go/core/keystore"
+	"github.com/urfave/cli"
+)
+
+const keysPasswordEnvVar = "ELROND_REMOTE_SIGNER_KEYS_PASSWORD"
+
+var (
+	remoteSignerHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// keysFile defines a flag for the path to the file holding the BLS keys served by the signer
+	keysFile = cli.StringFlag{
+		Name:  "keys-file",
+		Usage: "The `filepath` for the PEM or keystore file holding the validator BLS keys served by the signer",
+		Value: "./config/allValidatorsKeys.pem",
+	}
+	// passwordFile defines a flag for the file holding the password of the keys file
+	passwordFile = cli.StringFlag{
+		Name: "password-file",
+		Usage: "The `filepath` for the file holding the password of the encrypted keys file. If not set, the " +
+			"password is read from the " + keysPasswordEnvVar + " environment variable",
+	}
+	// listenAddress defines a flag for the address the signer listens on
+	listenAddress = cli.StringFlag{
+		Name:  "listen-address",
+		Usage: "The `address and port` to which the signer binds",
+		Value: "localhost:8090",
+	}
+	// certificateFile defines a flag for the TLS certificate of the signer
+	certificateFile = cli.StringFlag{
+		Name:  "certificate-file",
+		Usage: "The `filepath` for the PEM encoded TLS certificate of the signer",
+		Value: "./config/remoteSigner/server.pem",
+	}
+	// keyFile defines a flag for the TLS private key of the signer
+	keyFile = cli.StringFlag{
+		Name:  "key-file",
+		Usage: "The `filepath` for the PEM encoded TLS private key of the signer",
+		Value: "./config/remoteSigner/server.key",
+	}
+	// caCertificateFile defines a flag for the CA certificate the node certificates should be signed with
+	caCertificateFile = cli.StringFlag{
+		Name:  "ca-certificate-file",
+		Usage: "The `filepath` for the PEM encoded certificate of the CA that signed the nodes certificates",
+		Value: "./config/remoteSigner/ca.pem",
+	}
+	// watermarksFile defines a flag for the file holding the highest signed epoch and round of each key
+	watermarksFile = cli.StringFlag{
+		Name: "watermarks-file",
+		Usage: "The `filepath` for the file persisting the highest signed epoch and round of each key. If set " +
+			"empty, the watermarks are kept in memory only and the double signing protection is lost on restart",
+		Value: "./remoteSignerWatermarks.json",
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value: "*:" + logger.LogInfo.String(),
+	}
+)
+
+var log = logger.GetOrCreate("main")
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = remoteSignerHelpTemplate
+	app.Name = "Remote Signer CLI App"
+	app.Usage = "This is the entry point for starting a remote signer holding the validator BLS keys of the nodes " +
+		"- the signer refuses to sign twice for the same round"
+	app.Flags = []cli.Flag{
+		keysFile,
+		passwordFile,
+		listenAddress,
+		certificateFile,
+		keyFile,
+		caCertificateFile,
+		watermarksFile,
+		logLevel,
+	}
+	app.Version = "v0.0.1"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+
+	app.Action = func(c *cli.Context) error {
+		return startSigner(c)
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func startSigner(ctx *cli.Context) error {
+	err := logger.SetLogLevel(ctx.GlobalString(logLevel.Name))
+	if err != nil {
+		return err
+	}
+
+	password, err := keystore.ReadPassword(ctx.GlobalString(passwordFile.Name), keysPasswordEnvVar)
+	if err != nil {
+		return err
+	}
+
+	signerServer, err := signer.NewSignerServer(signer.ArgsSignerServer{
+		KeysFile:          ctx.GlobalString(keysFile.Name),
+		KeysPassword:      password,
+		WatermarksFile:    ctx.GlobalString(watermarksFile.Name),
+		ListenAddress:     ctx.GlobalString(listenAddress.Name),
+		CertificateFile:   ctx.GlobalString(certificateFile.Name),
+		KeyFile:           ctx.GlobalString(keyFile.Name),
+		CACertificateFile: ctx.GlobalString(caCertificateFile.Name),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, pk := range signerServer.PublicKeys() {
+		log.Info("serving key", "public key", pk)
+	}
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+
+	signerServer.Start()
+	<-sigs
+	log.Info("terminating at user's signal...")
+
+	return signerServer.Close()
+}
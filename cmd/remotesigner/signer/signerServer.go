@@ -0,0 +1,181 @@
+package signer
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/keystore"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/remoteSigner"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl"
+	mclMultiSig "github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/multisig"
+	mclSingleSig "github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/singlesig"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/multisig"
+	"github.com/ElrondNetwork/elrond-go/crypto/signingHandler"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/keysManagement"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+var log = logger.GetOrCreate("remotesigner/signer")
+
+const maxBroadcastsPerKeyInRound = 1
+const readHeaderTimeout = 5 * time.Second
+const shutdownTimeout = 5 * time.Second
+
+// ArgsSignerServer is the DTO used to create a new signer server
+type ArgsSignerServer struct {
+	KeysFile          string
+	KeysPassword      []byte
+	WatermarksFile    string
+	ListenAddress     string
+	CertificateFile   string
+	KeyFile           string
+	CACertificateFile string
+}
+
+type signerServer struct {
+	httpServer *http.Server
+	listener   net.Listener
+	publicKeys []string
+}
+
+// NewSignerServer creates a signer server holding the BLS keys stored in the provided keys file. The server only
+// accepts the clients presenting a certificate signed by the configured CA
+func NewSignerServer(args ArgsSignerServer) (*signerServer, error) {
+	sigHandler, publicKeys, err := createSigningHandler(args.KeysFile, args.KeysPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	watermarksTracker, err := remoteSigner.NewWatermarksTracker(remoteSigner.ArgsWatermarksTracker{
+		FilePath: args.WatermarksFile,
+		Hasher:   &blake2b.Blake2b{},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the headers are decoded and hashed as the nodes do, with the default marshalizer and hasher
+	handler, err := remoteSigner.NewSigningServer(remoteSigner.ArgsSigningServer{
+		SigningHandler:    sigHandler,
+		WatermarksHandler: watermarksTracker,
+		Marshalizer:       &marshal.GogoProtoMarshalizer{},
+		Hasher:            &blake2b.Blake2b{},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig, err := remoteSigner.CreateServerTLSConfig(args.CertificateFile, args.KeyFile, args.CACertificateFile)
+	if err != nil {
+		return nil, err
+	}
+
+	listener, err := net.Listen("tcp", args.ListenAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &signerServer{
+		httpServer: &http.Server{
+			Handler:           handler,
+			TLSConfig:         tlsConfig,
+			ReadHeaderTimeout: readHeaderTimeout,
+		},
+		listener:   listener,
+		publicKeys: publicKeys,
+	}, nil
+}
+
+func createSigningHandler(keysFile string, password []byte) (crypto.SigningHandler, []string, error) {
+	encodedSks, _, err := keystore.LoadAllSkPkFromFile(keysFile, password)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	keysHolder, err := keysManagement.NewManagedKeysHolder(keysManagement.ArgsManagedKeysHolder{
+		KeyGenerator:               keyGen,
+		MaxBroadcastsPerKeyInRound: maxBroadcastsPerKeyInRound,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, encodedSk := range encodedSks {
+		skBytes, errDecode := hex.DecodeString(string(encodedSk))
+		if errDecode != nil {
+			return nil, nil, fmt.Errorf("%w for encoded secret key", errDecode)
+		}
+
+		err = keysHolder.AddManagedKey(skBytes)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	managedKeys := keysHolder.ManagedKeys()
+	publicKeys := make([]string, 0, len(managedKeys))
+	for _, pkBytes := range managedKeys {
+		publicKeys = append(publicKeys, hex.EncodeToString(pkBytes))
+	}
+
+	// all the keys are managed ones, the first of them only completing the signing handler arguments
+	firstSk, err := keysHolder.GetPrivateKey(managedKeys[0])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sigHandler, err := signingHandler.NewSigningHandler(signingHandler.ArgsSigningHandler{
+		PrivateKey:     firstSk,
+		KeysHandler:    keysHolder,
+		SingleSigner:   &mclSingleSig.BlsSingleSigner{},
+		LowLevelSigner: &mclMultiSig.BlsMultiSigner{Hasher: &blake2b.Blake2b{HashSize: multisig.BlsHashSize}},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return sigHandler, publicKeys, nil
+}
+
+// Start starts serving the sign requests in a separate go routine
+func (ss *signerServer) Start() {
+	go func() {
+		err := ss.httpServer.ServeTLS(ss.listener, "", "")
+		if err != nil && err != http.ErrServerClosed {
+			log.Error("signer server stopped", "error", err)
+		}
+	}()
+
+	log.Info("signer server started", "address", ss.Address(), "num keys", len(ss.publicKeys))
+}
+
+// Address returns the address the server listens on
+func (ss *signerServer) Address() string {
+	return ss.listener.Addr().String()
+}
+
+// PublicKeys returns the hex encoded public keys of the keys held by the server
+func (ss *signerServer) PublicKeys() []string {
+	return ss.publicKeys
+}
+
+// Close gracefully stops the server
+func (ss *signerServer) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	err := ss.httpServer.Shutdown(ctx)
+	// the listener is not yet tracked by the http server if the server was not started
+	_ = ss.listener.Close()
+
+	return err
+}
@@ -0,0 +1,280 @@
+package signer
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/remoteSigner"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/singlesig"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testFiles struct {
+	keysFile          string
+	watermarksFile    string
+	caCertificate     string
+	serverCertificate string
+	serverKey         string
+	clientCertificate string
+	clientKey         string
+}
+
+func writePemFile(t *testing.T, filePath string, blockType string, bytes []byte) {
+	err := ioutil.WriteFile(filePath, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: bytes}), 0600)
+	require.Nil(t, err)
+}
+
+func createCertificate(
+	t *testing.T,
+	serialNumber int64,
+	parent *x509.Certificate,
+	parentKey *ecdsa.PrivateKey,
+	certificateFile string,
+	keyFile string,
+) (*x509.Certificate, *ecdsa.PrivateKey) {
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serialNumber),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		DNSNames:     []string{"localhost"},
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		parent = template
+		parentKey = key
+	}
+
+	certificateBytes, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.Nil(t, err)
+	writePemFile(t, certificateFile, "CERTIFICATE", certificateBytes)
+
+	if len(keyFile) > 0 {
+		keyBytes, errMarshal := x509.MarshalECPrivateKey(key)
+		require.Nil(t, errMarshal)
+		writePemFile(t, keyFile, "EC PRIVATE KEY", keyBytes)
+	}
+
+	certificate, err := x509.ParseCertificate(certificateBytes)
+	require.Nil(t, err)
+
+	return certificate, key
+}
+
+func createTestFiles(t *testing.T, dir string, numKeys int) (testFiles, []crypto.PublicKey) {
+	files := testFiles{
+		keysFile:          filepath.Join(dir, "allValidatorsKeys.pem"),
+		watermarksFile:    filepath.Join(dir, "watermarks.json"),
+		caCertificate:     filepath.Join(dir, "ca.crt"),
+		serverCertificate: filepath.Join(dir, "server.crt"),
+		serverKey:         filepath.Join(dir, "server.key"),
+		clientCertificate: filepath.Join(dir, "client.crt"),
+		clientKey:         filepath.Join(dir, "client.key"),
+	}
+
+	caCertificate, caKey := createCertificate(t, 1, nil, nil, files.caCertificate, "")
+	_, _ = createCertificate(t, 2, caCertificate, caKey, files.serverCertificate, files.serverKey)
+	_, _ = createCertificate(t, 3, caCertificate, caKey, files.clientCertificate, files.clientKey)
+
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	publicKeys := make([]crypto.PublicKey, 0, numKeys)
+	pemContent := make([]byte, 0)
+	for i := 0; i < numKeys; i++ {
+		sk, pk := keyGen.GeneratePair()
+		skBytes, _ := sk.ToByteArray()
+		pkBytes, _ := pk.ToByteArray()
+		pemContent = append(pemContent, pem.EncodeToMemory(&pem.Block{
+			Type:  "PRIVATE KEY for " + hex.EncodeToString(pkBytes),
+			Bytes: []byte(hex.EncodeToString(skBytes)),
+		})...)
+		publicKeys = append(publicKeys, pk)
+	}
+
+	err := ioutil.WriteFile(files.keysFile, pemContent, 0600)
+	require.Nil(t, err)
+
+	return files, publicKeys
+}
+
+func createArgs(files testFiles) ArgsSignerServer {
+	return ArgsSignerServer{
+		KeysFile:          files.keysFile,
+		WatermarksFile:    files.watermarksFile,
+		ListenAddress:     "127.0.0.1:0",
+		CertificateFile:   files.serverCertificate,
+		KeyFile:           files.serverKey,
+		CACertificateFile: files.caCertificate,
+	}
+}
+
+func startServer(t *testing.T, files testFiles) (*signerServer, crypto.SigningHandler) {
+	server, err := NewSignerServer(createArgs(files))
+	require.Nil(t, err)
+	server.Start()
+
+	tlsConfig, err := remoteSigner.CreateClientTLSConfig(files.clientCertificate, files.clientKey, files.caCertificate)
+	require.Nil(t, err)
+
+	client, err := remoteSigner.NewRemoteSigningHandler(remoteSigner.ArgsRemoteSigningHandler{
+		URL:            "https://" + server.Address(),
+		TLSConfig:      tlsConfig,
+		RequestTimeout: time.Second * 5,
+	})
+	require.Nil(t, err)
+
+	return server, client
+}
+
+func TestNewSignerServer_MissingKeysFileShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "remoteSigner")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	files, _ := createTestFiles(t, dir, 1)
+	args := createArgs(files)
+	args.KeysFile = filepath.Join(dir, "missing.pem")
+
+	server, err := NewSignerServer(args)
+	assert.Nil(t, server)
+	assert.NotNil(t, err)
+}
+
+func TestNewSignerServer_EmptyKeysFileShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "remoteSigner")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	files, _ := createTestFiles(t, dir, 0)
+
+	server, err := NewSignerServer(createArgs(files))
+	assert.Nil(t, server)
+	assert.True(t, errors.Is(err, core.ErrEmptyFile))
+}
+
+func TestNewSignerServer_MissingCertificateShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "remoteSigner")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	files, _ := createTestFiles(t, dir, 1)
+	args := createArgs(files)
+	args.CertificateFile = filepath.Join(dir, "missing.crt")
+
+	server, err := NewSignerServer(args)
+	assert.Nil(t, server)
+	assert.NotNil(t, err)
+}
+
+func TestSignerServer_SignsForAllHeldKeys(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "remoteSigner")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	files, publicKeys := createTestFiles(t, dir, 3)
+	server, client := startServer(t, files)
+	defer func() {
+		_ = server.Close()
+	}()
+
+	require.Equal(t, 3, len(server.PublicKeys()))
+
+	singleSigner := &singlesig.BlsSingleSigner{}
+	message := marshalHeader(&block.Header{Epoch: 1, Round: 10})
+	for _, pk := range publicKeys {
+		pkBytes, _ := pk.ToByteArray()
+
+		signature, err := client.SignBlockHeader(pkBytes, message)
+		require.Nil(t, err)
+		assert.Nil(t, singleSigner.Verify(pk, message, signature))
+	}
+
+	_, unknownPk := signing.NewKeyGenerator(mcl.NewSuiteBLS12()).GeneratePair()
+	unknownPkBytes, _ := unknownPk.ToByteArray()
+	_, err := client.SignBlockHeader(unknownPkBytes, message)
+	assert.True(t, errors.Is(err, remoteSigner.ErrRemoteSigning))
+}
+
+func TestSignerServer_DoubleSigningProtectionSurvivesRestart(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "remoteSigner")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	files, publicKeys := createTestFiles(t, dir, 1)
+	pkBytes, _ := publicKeys[0].ToByteArray()
+
+	headerA := marshalHeader(&block.Header{Epoch: 2, Round: 20, Nonce: 1})
+	headerB := marshalHeader(&block.Header{Epoch: 2, Round: 20, Nonce: 2})
+
+	server, client := startServer(t, files)
+	_, err := client.SignBlockHeader(pkBytes, headerA)
+	require.Nil(t, err)
+
+	_, err = client.SignBlockHeader(pkBytes, headerA)
+	assert.Nil(t, err)
+
+	_, err = client.SignBlockHeader(pkBytes, headerB)
+	assert.True(t, errors.Is(err, remoteSigner.ErrRemoteSigning))
+	require.Nil(t, server.Close())
+
+	server, client = startServer(t, files)
+	defer func() {
+		_ = server.Close()
+	}()
+
+	_, err = client.SignBlockHeader(pkBytes, headerB)
+	assert.True(t, errors.Is(err, remoteSigner.ErrRemoteSigning))
+
+	_, err = client.SignBlockHeader(pkBytes, marshalHeader(&block.Header{Epoch: 2, Round: 19, Nonce: 3}))
+	assert.True(t, errors.Is(err, remoteSigner.ErrRemoteSigning))
+
+	_, err = client.SignBlockHeader(pkBytes, marshalHeader(&block.Header{Epoch: 2, Round: 21, Nonce: 3}))
+	assert.Nil(t, err)
+}
+
+func marshalHeader(header *block.Header) []byte {
+	buff, _ := (&marshal.GogoProtoMarshalizer{}).Marshal(header)
+	return buff
+}
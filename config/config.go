@@ -9,6 +9,8 @@ type CacheConfig struct {
 	SizeInBytes          uint64
 	SizeInBytesPerSender uint32
 	Shards               uint32
+
+	MinGasPriceIncreasePercentForReplacement uint32
 }
 
 //HeadersPoolConfig will map the headers cache configuration
@@ -51,6 +53,12 @@ type TypeConfig struct {
 	Type string
 }
 
+// ConsensusConfig holds the consensus type and the limits applied to the consensus messages
+type ConsensusConfig struct {
+	Type                       string
+	MaxBroadcastsPerKeyInRound uint32
+}
+
 // MarshalizerConfig holds the marshalizer related configuration
 type MarshalizerConfig struct {
 	Type string
@@ -151,7 +159,8 @@ type Config struct {
 	Heartbeat           HeartbeatConfig
 	ValidatorStatistics ValidatorStatisticsConfig
 	GeneralSettings     GeneralSettingsConfig
-	Consensus           TypeConfig
+	Consensus           ConsensusConfig
+	RemoteSigner        RemoteSignerConfig
 	StoragePruning      StoragePruningConfig
 	TxLogsStorage       StorageConfig
 
@@ -166,11 +175,23 @@ type Config struct {
 
 	SoftwareVersionConfig SoftwareVersionConfig
 	DbLookupExtensions    DbLookupExtensionsConfig
+	Subscriptions         SubscriptionsConfig
 	Versions              VersionsConfig
 	GasSchedule           GasScheduleConfig
 	Logs                  LogsConfig
 }
 
+// RemoteSignerConfig will hold the settings of the signing service holding the node's validator key
+type RemoteSignerConfig struct {
+	Enabled                 bool
+	URL                     string
+	PublicKey               string
+	CertificateFile         string
+	KeyFile                 string
+	CACertificateFile       string
+	RequestTimeoutInSeconds uint32
+}
+
 // LogsConfig will hold settings related to the logging sub-system
 type LogsConfig struct {
 	LogFileLifeSpanInSec int
@@ -228,6 +249,7 @@ type GeneralSettingsConfig struct {
 	MetaProtectionEnableEpoch              uint32
 	AheadOfTimeGasUsageEnableEpoch         uint32
 	GasPriceModifierEnableEpoch            uint32
+	ESDTAllowanceEnableEpoch               uint32
 	MaxNodesChangeEnableEpoch              []MaxNodesChangeConfig
 	GenesisString                          string
 	GenesisMaxNumberOfShards               uint32
@@ -384,6 +406,16 @@ type DbLookupExtensionsConfig struct {
 	MiniblockHashByTxHashStorageConfig StorageConfig
 	EpochByHashStorageConfig           StorageConfig
 	ResultsHashesByTxHashStorageConfig StorageConfig
+	TxsByAddressStorageConfig          StorageConfig
+	LogEventsStorageConfig             StorageConfig
+}
+
+// SubscriptionsConfig holds the configuration for the WebSocket subscriptions hub
+type SubscriptionsConfig struct {
+	Enabled             bool
+	ReplayBufferSize    uint32
+	SubscriberQueueSize uint32
+	MaxSubscribers      uint32
 }
 
 // DebugConfig will hold debugging configuration
@@ -400,6 +432,7 @@ type HealthServiceConfig struct {
 	MemoryUsageToCreateProfiles               int
 	NumMemoryUsageRecordsToKeep               int
 	FolderPath                                string
+	LivenessStaleThresholdInSeconds           int
 }
 
 // InterceptorResolverDebugConfig will hold the interceptor-resolver debug configuration
@@ -422,7 +455,34 @@ type AntifloodDebugConfig struct {
 
 // ApiRoutesConfig holds the configuration related to Rest API routes
 type ApiRoutesConfig struct {
-	APIPackages map[string]APIPackageConfig
+	APIPackages    map[string]APIPackageConfig
+	Authentication ApiAuthenticationConfig
+	GrpcServer     GrpcServerConfig
+}
+
+// GrpcServerConfig holds the configuration of the gRPC server mirroring the public Rest API routes
+type GrpcServerConfig struct {
+	Enabled              bool
+	ListenAddress        string
+	MaxConcurrentStreams uint32
+	MaxBlockStreams      uint32
+}
+
+// ApiAuthenticationConfig holds the configuration of the API keys used to access the Rest API routes
+type ApiAuthenticationConfig struct {
+	Enabled                 bool
+	KeyHeader               string
+	AuditLogEnabled         bool
+	QuotaResetIntervalInSec uint32
+	Keys                    []ApiKeyConfig
+}
+
+// ApiKeyConfig holds the configuration of a single API key
+type ApiKeyConfig struct {
+	Name        string
+	KeyHash     string
+	Role        string
+	MaxRequests uint32
 }
 
 // APIPackageConfig holds the configuration for the routes of each package
@@ -434,6 +494,7 @@ type APIPackageConfig struct {
 type RouteConfig struct {
 	Name string
 	Open bool
+	Role string
 }
 
 // VersionByEpochs represents a version entry that will be applied between the provided epochs
@@ -7,11 +7,14 @@ type ExternalConfig struct {
 
 // ElasticSearchConfig will hold the configuration for the elastic search
 type ElasticSearchConfig struct {
-	Enabled          bool
-	IndexerCacheSize int
-	URL              string
-	UseKibana        bool
-	Username         string
-	Password         string
-	EnabledIndexes   []string
+	Enabled           bool
+	Driver            string
+	IndexerCacheSize  int
+	URL               string
+	UseKibana         bool
+	Username          string
+	Password          string
+	SQLDriverName     string
+	SQLDataSourceName string
+	EnabledIndexes    []string
 }
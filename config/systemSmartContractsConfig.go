@@ -25,6 +25,9 @@ type StakingSystemSCConfig struct {
 	StakeEnableEpoch                     uint32
 	DoubleKeyProtectionEnableEpoch       uint32
 	ActivateBLSPubKeyMessageVerification bool
+	SlashingEnableEpoch                  uint32
+	SlashingPercentage                   float64
+	SlashedFundsDestinationAddress       string
 }
 
 // ESDTSystemSCConfig defines a set of constant to initialize the esdt system smart contract
@@ -32,6 +35,7 @@ type ESDTSystemSCConfig struct {
 	BaseIssuingCost string
 	OwnerAddress    string
 	EnabledEpoch    uint32
+	NFTEnableEpoch  uint32
 }
 
 // GovernanceSystemSCConfig defines the set of constants to initialize the governance system smart contract
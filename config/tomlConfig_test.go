@@ -80,8 +80,18 @@ func TestTomlParser(t *testing.T) {
 		MultisigHasher: TypeConfig{
 			Type: multiSigHasherType,
 		},
-		Consensus: TypeConfig{
-			Type: consensusType,
+		Consensus: ConsensusConfig{
+			Type:                       consensusType,
+			MaxBroadcastsPerKeyInRound: 10,
+		},
+		RemoteSigner: RemoteSignerConfig{
+			Enabled:                 true,
+			URL:                     "https://localhost:8090",
+			PublicKey:               "abcd",
+			CertificateFile:         "node.pem",
+			KeyFile:                 "node.key",
+			CACertificateFile:       "ca.pem",
+			RequestTimeoutInSeconds: 2,
 		},
 	}
 
@@ -127,6 +137,16 @@ func TestTomlParser(t *testing.T) {
 
 [Consensus]
 	Type = "` + consensusType + `"
+	MaxBroadcastsPerKeyInRound = 10
+
+[RemoteSigner]
+	Enabled = true
+	URL = "https://localhost:8090"
+	PublicKey = "abcd"
+	CertificateFile = "node.pem"
+	KeyFile = "node.key"
+	CACertificateFile = "ca.pem"
+	RequestTimeoutInSeconds = 2
 
 `
 	cfg := Config{}
@@ -258,10 +278,25 @@ func TestAPIRoutesToml(t *testing.T) {
 			},
 			package1: {
 				Routes: []RouteConfig{
-					{Name: route2, Open: false},
+					{Name: route2, Open: false, Role: "admin"},
 				},
 			},
 		},
+		Authentication: ApiAuthenticationConfig{
+			Enabled:                 true,
+			KeyHeader:               "X-Api-Key",
+			AuditLogEnabled:         true,
+			QuotaResetIntervalInSec: 1,
+			Keys: []ApiKeyConfig{
+				{Name: "operator", KeyHash: "hash", Role: "operator", MaxRequests: 100},
+			},
+		},
+		GrpcServer: GrpcServerConfig{
+			Enabled:              true,
+			ListenAddress:        "localhost:9090",
+			MaxConcurrentStreams: 100,
+			MaxBlockStreams:      10,
+		},
 	}
 
 	testString := `
@@ -280,8 +315,23 @@ func TestAPIRoutesToml(t *testing.T) {
 [APIPackages.` + package1 + `]
 	Routes = [
          # test comment
-        { Name = "` + route2 + `", Open = false }
+        { Name = "` + route2 + `", Open = false, Role = "admin" }
     ]
+
+[Authentication]
+    Enabled = true
+    KeyHeader = "X-Api-Key"
+    AuditLogEnabled = true
+    QuotaResetIntervalInSec = 1
+    Keys = [
+        { Name = "operator", KeyHash = "hash", Role = "operator", MaxRequests = 100 },
+    ]
+
+[GrpcServer]
+    Enabled = true
+    ListenAddress = "localhost:9090"
+    MaxConcurrentStreams = 100
+    MaxBlockStreams = 10
  `
 
 	cfg := ApiRoutesConfig{}
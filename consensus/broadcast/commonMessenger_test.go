@@ -8,9 +8,11 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/broadcast"
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -48,6 +50,7 @@ func TestCommonMessenger_BroadcastConsensusMessageShouldErrWhenSignMessageFail(t
 		privateKeyMock,
 		shardCoordinatorMock,
 		peerSigHandler,
+		&testscommon.KeysHandlerStub{},
 	)
 
 	msg := &consensus.Message{}
@@ -76,6 +79,7 @@ func TestCommonMessenger_BroadcastConsensusMessageShouldWork(t *testing.T) {
 		privateKeyMock,
 		shardCoordinatorMock,
 		peerSigHandler,
+		&testscommon.KeysHandlerStub{},
 	)
 
 	msg := &consensus.Message{}
@@ -83,6 +87,67 @@ func TestCommonMessenger_BroadcastConsensusMessageShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestCommonMessenger_BroadcastConsensusMessageManagedKeyShouldSignWithItsPrivateKey(t *testing.T) {
+	managedPrivateKey := &mock.PrivateKeyMock{}
+	managedPubKey := []byte("managed public key")
+	var usedPrivateKey crypto.PrivateKey
+	singleSignerMock := &mock.SingleSignerMock{
+		SignStub: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+			usedPrivateKey = private
+			return []byte(""), nil
+		},
+	}
+	keysHandler := &testscommon.KeysHandlerStub{
+		IsKeyManagedCalled: func(pkBytes []byte) bool {
+			return string(pkBytes) == string(managedPubKey)
+		},
+		GetPrivateKeyCalled: func(pkBytes []byte) (crypto.PrivateKey, error) {
+			return managedPrivateKey, nil
+		},
+	}
+
+	cm, _ := broadcast.NewCommonMessenger(
+		&mock.MarshalizerMock{},
+		&mock.MessengerStub{
+			BroadcastCalled: func(topic string, buff []byte) {},
+		},
+		&mock.PrivateKeyMock{},
+		&mock.ShardCoordinatorMock{},
+		&mock.PeerSignatureHandler{Signer: singleSignerMock},
+		keysHandler,
+	)
+
+	err := cm.BroadcastConsensusMessage(&consensus.Message{PubKey: managedPubKey})
+	assert.Nil(t, err)
+	assert.True(t, usedPrivateKey == managedPrivateKey)
+}
+
+func TestCommonMessenger_BroadcastConsensusMessageShouldErrWhenBroadcastLimitReached(t *testing.T) {
+	wasBroadcast := false
+	keysHandler := &testscommon.KeysHandlerStub{
+		CanBroadcastCalled: func(pkBytes []byte) bool {
+			return false
+		},
+	}
+
+	cm, _ := broadcast.NewCommonMessenger(
+		&mock.MarshalizerMock{},
+		&mock.MessengerStub{
+			BroadcastCalled: func(topic string, buff []byte) {
+				wasBroadcast = true
+			},
+		},
+		&mock.PrivateKeyMock{},
+		&mock.ShardCoordinatorMock{},
+		&mock.PeerSignatureHandler{Signer: &mock.SingleSignerMock{}},
+		keysHandler,
+	)
+
+	err := cm.BroadcastConsensusMessage(&consensus.Message{})
+	assert.Equal(t, spos.ErrBroadcastLimitReached, err)
+	assert.False(t, wasBroadcast)
+}
+
 func TestCommonMessenger_SignMessageShouldErrWhenSignFail(t *testing.T) {
 	err := errors.New("sign message error")
 	marshalizerMock := &mock.MarshalizerMock{}
@@ -102,6 +167,7 @@ func TestCommonMessenger_SignMessageShouldErrWhenSignFail(t *testing.T) {
 		privateKeyMock,
 		shardCoordinatorMock,
 		peerSigHandler,
+		&testscommon.KeysHandlerStub{},
 	)
 
 	msg := &consensus.Message{}
@@ -157,6 +223,7 @@ func TestSubroundEndRound_ExtractMiniBlocksAndTransactionsShouldWork(t *testing.
 		privateKeyMock,
 		shardCoordinatorMock,
 		peerSigHandler,
+		&testscommon.KeysHandlerStub{},
 	)
 
 	metaMiniBlocks, metaTransactions := cm.ExtractMetaMiniBlocksAndTransactions(miniBlocks, transactions)
@@ -199,6 +266,7 @@ func TestCommonMessenger_BroadcastBlockData(t *testing.T) {
 		privateKeyMock,
 		shardCoordinatorMock,
 		peerSigHandler,
+		&testscommon.KeysHandlerStub{},
 	)
 
 	miniBlocks := map[uint32][]byte{0: []byte("mbs data1"), 1: []byte("mbs data2")}
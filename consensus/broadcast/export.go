@@ -178,6 +178,7 @@ func NewCommonMessenger(
 	privateKey crypto.PrivateKey,
 	shardCoordinator sharding.Coordinator,
 	peerSigHandler crypto.PeerSignatureHandler,
+	keysHandler consensus.KeysHandler,
 ) (*commonMessenger, error) {
 
 	return &commonMessenger{
@@ -186,5 +187,6 @@ func NewCommonMessenger(
 		privateKey:           privateKey,
 		shardCoordinator:     shardCoordinator,
 		peerSignatureHandler: peerSigHandler,
+		keysHandler:          keysHandler,
 	}, nil
 }
@@ -51,6 +51,7 @@ func NewMetaChainMessenger(
 		privateKey:              args.PrivateKey,
 		shardCoordinator:        args.ShardCoordinator,
 		peerSignatureHandler:    args.PeerSignatureHandler,
+		keysHandler:             args.KeysHandler,
 		delayedBlockBroadcaster: dbb,
 	}
 
@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -33,6 +34,7 @@ func createDefaultMetaChainArgs() broadcast.MetaChainMessengerArgs {
 			PrivateKey:                 privateKeyMock,
 			ShardCoordinator:           shardCoordinatorMock,
 			PeerSignatureHandler:       peerSigHandler,
+			KeysHandler:                &testscommon.KeysHandlerStub{},
 			HeadersSubscriber:          headersSubscriber,
 			InterceptorsContainer:      interceptorsContainer,
 			MaxValidatorDelayCacheSize: 2,
@@ -86,6 +88,15 @@ func TestMetaChainMessenger_NewMetaChainMessengerNilPeerSignatureHandlerShouldFa
 	assert.Equal(t, spos.ErrNilPeerSignatureHandler, err)
 }
 
+func TestMetaChainMessenger_NewMetaChainMessengerNilKeysHandlerShouldFail(t *testing.T) {
+	args := createDefaultMetaChainArgs()
+	args.KeysHandler = nil
+	mcm, err := broadcast.NewMetaChainMessenger(args)
+
+	assert.Nil(t, mcm)
+	assert.Equal(t, spos.ErrNilKeysHandler, err)
+}
+
 func TestMetaChainMessenger_NewMetaChainMessengerShouldWork(t *testing.T) {
 	args := createDefaultMetaChainArgs()
 	mcm, err := broadcast.NewMetaChainMessenger(args)
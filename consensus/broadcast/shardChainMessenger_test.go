@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -68,6 +69,7 @@ func createDefaultShardChainArgs() broadcast.ShardChainMessengerArgs {
 			PrivateKey:                 privateKeyMock,
 			ShardCoordinator:           shardCoordinatorMock,
 			PeerSignatureHandler:       peerSigHandler,
+			KeysHandler:                &testscommon.KeysHandlerStub{},
 			HeadersSubscriber:          headersSubscriber,
 			InterceptorsContainer:      interceptorsContainer,
 			MaxDelayCacheSize:          1,
@@ -121,6 +123,15 @@ func TestShardChainMessenger_NewShardChainMessengerNilPeerSignatureHandlerShould
 	assert.Equal(t, spos.ErrNilPeerSignatureHandler, err)
 }
 
+func TestShardChainMessenger_NewShardChainMessengerNilKeysHandlerShouldFail(t *testing.T) {
+	args := createDefaultShardChainArgs()
+	args.KeysHandler = nil
+	scm, err := broadcast.NewShardChainMessenger(args)
+
+	assert.Nil(t, scm)
+	assert.Equal(t, spos.ErrNilKeysHandler, err)
+}
+
 func TestShardChainMessenger_NewShardChainMessengerNilInterceptorsContainerShouldFail(t *testing.T) {
 	args := createDefaultShardChainArgs()
 	args.InterceptorsContainer = nil
@@ -169,7 +169,15 @@ func (chr *chronology) startRound() {
 	log.Debug(display.Headline(msg, chr.syncTimer.FormattedCurrentTime(), "."))
 	logger.SetCorrelationSubround(sr.Name())
 
-	if !sr.DoWork(chr.rounder) {
+	startTime := time.Now()
+	isSubroundFinished := sr.DoWork(chr.rounder)
+	chr.appStatusHandler.ObserveDuration(
+		core.MetricSubroundDuration,
+		time.Since(startTime),
+		core.MetricLabel{Name: core.MetricLabelSubround, Value: sr.Name()},
+	)
+
+	if !isSubroundFinished {
 		chr.subroundId = srBeforeStartRound
 		return
 	}
@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/chronology"
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/stretchr/testify/assert"
 )
@@ -363,6 +364,37 @@ func TestChronology_SetAppStatusHandlerWithNilValueShouldErr(t *testing.T) {
 	assert.Equal(t, err, chronology.ErrNilAppStatusHandler)
 }
 
+func TestChronology_StartRoundShouldObserveSubroundDuration(t *testing.T) {
+	t.Parallel()
+
+	rounderMock := &mock.RounderMock{}
+	rounderMock.UpdateRound(rounderMock.TimeStamp(), rounderMock.TimeStamp().Add(rounderMock.TimeDuration()))
+	syncTimerMock := &mock.SyncTimerMock{}
+	chr, _ := chronology.NewChronology(
+		time.Now(),
+		rounderMock,
+		syncTimerMock,
+		&mock.WatchdogMock{},
+	)
+
+	observedKey := ""
+	var observedLabels []core.MetricLabel
+	_ = chr.SetAppStatusHandler(&mock.AppStatusHandlerStub{
+		ObserveDurationHandler: func(key string, _ time.Duration, labels ...core.MetricLabel) {
+			observedKey = key
+			observedLabels = labels
+		},
+	})
+
+	srm := initSubroundHandlerMock()
+	chr.AddSubround(srm)
+	chr.SetSubroundId(0)
+	chr.StartRound()
+
+	assert.Equal(t, core.MetricSubroundDuration, observedKey)
+	assert.Equal(t, []core.MetricLabel{{Name: core.MetricLabelSubround, Value: srm.Name()}}, observedLabels)
+}
+
 func TestChronology_SetAppStatusHandlerWithOkValueShouldPass(t *testing.T) {
 	t.Parallel()
 
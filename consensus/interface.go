@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 )
@@ -11,6 +12,9 @@ import (
 // BlsConsensusType specifies the signature scheme used in the consensus
 const BlsConsensusType = "bls"
 
+// PbftConsensusType specifies the pipelined BFT consensus, with leader view changes, which uses the BLS signature scheme
+const PbftConsensusType = "pbft"
+
 // Rounder defines the actions which should be handled by a round implementation
 type Rounder interface {
 	Index() int64
@@ -127,3 +131,13 @@ type FallbackHeaderValidator interface {
 	ShouldApplyFallbackValidation(headerHandler data.HeaderHandler) bool
 	IsInterfaceNil() bool
 }
+
+// KeysHandler defines the behaviour of a component able to hold the additional validator keys a node acts for
+type KeysHandler interface {
+	GetPrivateKey(pkBytes []byte) (crypto.PrivateKey, error)
+	IsKeyManaged(pkBytes []byte) bool
+	ManagedKeys() [][]byte
+	CanBroadcast(pkBytes []byte) bool
+	ResetRoundBroadcasts()
+	IsInterfaceNil() bool
+}
@@ -41,6 +41,7 @@ type Message struct {
 	AggregateSignature []byte `protobuf:"bytes,11,opt,name=AggregateSignature,proto3" json:"AggregateSignature,omitempty"`
 	LeaderSignature    []byte `protobuf:"bytes,12,opt,name=LeaderSignature,proto3" json:"LeaderSignature,omitempty"`
 	OriginatorPid      []byte `protobuf:"bytes,13,opt,name=OriginatorPid,proto3" json:"OriginatorPid,omitempty"`
+	View               uint32 `protobuf:"varint,14,opt,name=View,proto3" json:"View,omitempty"`
 }
 
 func (m *Message) Reset()      { *m = Message{} }
@@ -162,6 +163,13 @@ func (m *Message) GetOriginatorPid() []byte {
 	return nil
 }
 
+func (m *Message) GetView() uint32 {
+	if m != nil {
+		return m.View
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Message)(nil), "proto.Message")
 }
@@ -169,31 +177,32 @@ func init() {
 func init() { proto.RegisterFile("message.proto", fileDescriptor_33c57e4bae7b9afd) }
 
 var fileDescriptor_33c57e4bae7b9afd = []byte{
-	// 372 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x4f, 0x4e, 0xc2, 0x40,
-	0x14, 0xc6, 0x3b, 0xf2, 0x4f, 0x46, 0xd0, 0x64, 0x16, 0x66, 0x62, 0xcc, 0x84, 0x18, 0x63, 0xd8,
-	0x08, 0x0b, 0x4f, 0x60, 0x71, 0x01, 0x51, 0x22, 0x01, 0x57, 0xee, 0xa6, 0x74, 0x9c, 0x36, 0x4a,
-	0x87, 0x74, 0xda, 0x44, 0x76, 0x1e, 0xc1, 0x63, 0x78, 0x03, 0xaf, 0xe0, 0x92, 0x25, 0x4b, 0x19,
-	0x36, 0x2e, 0x39, 0x82, 0xe9, 0x1b, 0x11, 0x21, 0xae, 0xfa, 0xbe, 0xdf, 0xf7, 0xde, 0xf7, 0xda,
-	0x97, 0xe2, 0xea, 0x48, 0x68, 0xcd, 0xa5, 0x68, 0x8c, 0x63, 0x95, 0x28, 0x52, 0x80, 0xc7, 0xd1,
-	0xb9, 0x0c, 0x93, 0x20, 0xf5, 0x1a, 0x43, 0x35, 0x6a, 0x4a, 0x25, 0x55, 0x13, 0xb0, 0x97, 0x3e,
-	0x80, 0x02, 0x01, 0x95, 0x9d, 0x3a, 0x79, 0xcf, 0xe1, 0x52, 0xd7, 0xe6, 0x90, 0x3a, 0x3e, 0x70,
-	0x9f, 0xd4, 0xf0, 0xb1, 0x2d, 0xb8, 0x2f, 0xe2, 0x36, 0xd7, 0x01, 0x45, 0x35, 0x54, 0xaf, 0xf4,
-	0xb7, 0x31, 0x39, 0xc3, 0xfb, 0x83, 0x50, 0x46, 0x3c, 0x49, 0x63, 0x31, 0x08, 0x78, 0x2c, 0xe8,
-	0x0e, 0x34, 0x6e, 0x51, 0x42, 0x70, 0xde, 0x55, 0xfe, 0x84, 0xe6, 0xc0, 0x85, 0x9a, 0x1c, 0xe2,
-	0xa2, 0x4d, 0xa2, 0x79, 0xa0, 0x3f, 0x2a, 0xe3, 0xbd, 0xd4, 0xbb, 0x16, 0x13, 0x5a, 0xb0, 0xdc,
-	0x2a, 0x72, 0x8c, 0xcb, 0xbf, 0xa9, 0xb4, 0x08, 0xd6, 0x1a, 0x10, 0x8a, 0x4b, 0x5d, 0x2d, 0xef,
-	0x26, 0x63, 0x41, 0x4b, 0x35, 0x54, 0xcf, 0xf5, 0x57, 0x92, 0x30, 0x8c, 0xfb, 0x2a, 0x8d, 0xfc,
-	0x4e, 0xe4, 0x8b, 0x67, 0xba, 0x0b, 0xe6, 0x1f, 0x92, 0x4d, 0xb6, 0x02, 0x1e, 0x46, 0x9d, 0x2b,
-	0x5a, 0x86, 0xd4, 0x95, 0x24, 0xa7, 0xb8, 0x6a, 0x77, 0x6b, 0x37, 0x4c, 0x46, 0x7c, 0x4c, 0x31,
-	0xf8, 0x9b, 0x90, 0x34, 0x30, 0xb9, 0x94, 0x32, 0x16, 0x92, 0x27, 0x62, 0xfd, 0x82, 0x7b, 0xd0,
-	0xfa, 0x8f, 0x93, 0x5d, 0xf7, 0x06, 0xbe, 0x74, 0xdd, 0x5c, 0xb1, 0xd7, 0xdd, 0xc2, 0xd9, 0xfe,
-	0xdb, 0x38, 0x94, 0x61, 0xc4, 0x13, 0x15, 0xf7, 0x42, 0x9f, 0x56, 0xed, 0xfe, 0x0d, 0xe8, 0xb6,
-	0xa6, 0x73, 0xe6, 0xcc, 0xe6, 0xcc, 0x59, 0xce, 0x19, 0x7a, 0x31, 0x0c, 0xbd, 0x19, 0x86, 0x3e,
-	0x0c, 0x43, 0x53, 0xc3, 0xd0, 0xcc, 0x30, 0xf4, 0x69, 0x18, 0xfa, 0x32, 0xcc, 0x59, 0x1a, 0x86,
-	0x5e, 0x17, 0xcc, 0x99, 0x2e, 0x98, 0x33, 0x5b, 0x30, 0xe7, 0xbe, 0x3c, 0x54, 0x91, 0x16, 0x91,
-	0x4e, 0xb5, 0x57, 0x84, 0xbf, 0xe0, 0xe2, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xe1, 0xac, 0xee, 0x5e,
-	0x4c, 0x02, 0x00, 0x00,
+	// 387 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xbf, 0x8e, 0xd3, 0x40,
+	0x10, 0xc6, 0xbd, 0xe4, 0x1f, 0x59, 0xe2, 0x20, 0x6d, 0x81, 0x56, 0x08, 0xad, 0x22, 0x84, 0x90,
+	0x1b, 0x92, 0x82, 0x27, 0xc0, 0xa1, 0x48, 0x04, 0x11, 0x91, 0x83, 0x28, 0xe8, 0xd6, 0xf1, 0xb2,
+	0x5e, 0x41, 0xbc, 0x91, 0xd7, 0x16, 0xa4, 0xe3, 0x11, 0xee, 0x31, 0xee, 0x51, 0x4e, 0xba, 0x26,
+	0x65, 0xca, 0xcb, 0xa6, 0xb9, 0x32, 0x8f, 0x70, 0xf2, 0xec, 0xe5, 0x72, 0x89, 0xae, 0xf2, 0x7c,
+	0xbf, 0x6f, 0xe6, 0x1b, 0x7b, 0x64, 0xec, 0x2f, 0x84, 0x31, 0x5c, 0x8a, 0xfe, 0x32, 0xd7, 0x85,
+	0x26, 0x0d, 0x78, 0xbc, 0xfe, 0x20, 0x55, 0x91, 0x96, 0x71, 0x7f, 0xae, 0x17, 0x03, 0xa9, 0xa5,
+	0x1e, 0x00, 0x8e, 0xcb, 0x5f, 0xa0, 0x40, 0x40, 0xe5, 0xa6, 0xde, 0x5e, 0xd7, 0x70, 0x6b, 0xe2,
+	0x72, 0x48, 0x80, 0x5f, 0x86, 0x7f, 0xf4, 0xfc, 0xf7, 0x48, 0xf0, 0x44, 0xe4, 0x23, 0x6e, 0x52,
+	0x8a, 0x7a, 0x28, 0xe8, 0x44, 0xe7, 0x98, 0xbc, 0xc7, 0xdd, 0x99, 0x92, 0x19, 0x2f, 0xca, 0x5c,
+	0xcc, 0x52, 0x9e, 0x0b, 0xfa, 0x0c, 0x1a, 0xcf, 0x28, 0x21, 0xb8, 0x1e, 0xea, 0x64, 0x45, 0x6b,
+	0xe0, 0x42, 0x4d, 0x5e, 0xe1, 0xa6, 0x4b, 0xa2, 0x75, 0xa0, 0xf7, 0xaa, 0xe2, 0xd3, 0x32, 0xfe,
+	0x22, 0x56, 0xb4, 0xe1, 0xb8, 0x53, 0xe4, 0x0d, 0x6e, 0x3f, 0xa4, 0xd2, 0x26, 0x58, 0x47, 0x40,
+	0x28, 0x6e, 0x4d, 0x8c, 0xfc, 0xbe, 0x5a, 0x0a, 0xda, 0xea, 0xa1, 0xa0, 0x16, 0x1d, 0x24, 0x61,
+	0x18, 0x47, 0xba, 0xcc, 0x92, 0x71, 0x96, 0x88, 0x7f, 0xf4, 0x39, 0x98, 0x8f, 0x48, 0x35, 0x39,
+	0x4c, 0xb9, 0xca, 0xc6, 0x9f, 0x69, 0x1b, 0x52, 0x0f, 0x92, 0xbc, 0xc3, 0xbe, 0xdb, 0x6d, 0x42,
+	0x55, 0x2c, 0xf8, 0x92, 0x62, 0xf0, 0x4f, 0x21, 0xe9, 0x63, 0xf2, 0x49, 0xca, 0x5c, 0x48, 0x5e,
+	0x88, 0xe3, 0x0b, 0xbe, 0x80, 0xd6, 0x27, 0x9c, 0xea, 0xba, 0x5f, 0xe1, 0x4b, 0x8f, 0xcd, 0x1d,
+	0x77, 0xdd, 0x33, 0x5c, 0xed, 0xff, 0x96, 0x2b, 0xa9, 0x32, 0x5e, 0xe8, 0x7c, 0xaa, 0x12, 0xea,
+	0xbb, 0xfd, 0x27, 0xb0, 0xba, 0xed, 0x0f, 0x25, 0xfe, 0xd2, 0x6e, 0x0f, 0x05, 0x7e, 0x04, 0x75,
+	0x38, 0x5c, 0x6f, 0x99, 0xb7, 0xd9, 0x32, 0x6f, 0xbf, 0x65, 0xe8, 0xbf, 0x65, 0xe8, 0xd2, 0x32,
+	0x74, 0x65, 0x19, 0x5a, 0x5b, 0x86, 0x36, 0x96, 0xa1, 0x1b, 0xcb, 0xd0, 0xad, 0x65, 0xde, 0xde,
+	0x32, 0x74, 0xb1, 0x63, 0xde, 0x7a, 0xc7, 0xbc, 0xcd, 0x8e, 0x79, 0x3f, 0xdb, 0x73, 0x9d, 0x19,
+	0x91, 0x99, 0xd2, 0xc4, 0x4d, 0xf8, 0x33, 0x3e, 0xde, 0x0d, 0x00, 0x87, 0xaa, 0x9e, 0x24, 0x60,
+	0x02, 0x00, 0x00,
 }
 
 func (this *Message) Equal(that interface{}) bool {
@@ -254,13 +263,16 @@ func (this *Message) Equal(that interface{}) bool {
 	if !bytes.Equal(this.OriginatorPid, that1.OriginatorPid) {
 		return false
 	}
+	if this.View != that1.View {
+		return false
+	}
 	return true
 }
 func (this *Message) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 17)
+	s := make([]string, 0, 18)
 	s = append(s, "&consensus.Message{")
 	s = append(s, "BlockHeaderHash: "+fmt.Sprintf("%#v", this.BlockHeaderHash)+",\n")
 	s = append(s, "SignatureShare: "+fmt.Sprintf("%#v", this.SignatureShare)+",\n")
@@ -275,6 +287,7 @@ func (this *Message) GoString() string {
 	s = append(s, "AggregateSignature: "+fmt.Sprintf("%#v", this.AggregateSignature)+",\n")
 	s = append(s, "LeaderSignature: "+fmt.Sprintf("%#v", this.LeaderSignature)+",\n")
 	s = append(s, "OriginatorPid: "+fmt.Sprintf("%#v", this.OriginatorPid)+",\n")
+	s = append(s, "View: "+fmt.Sprintf("%#v", this.View)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -306,6 +319,11 @@ func (m *Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.View != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.View))
+		i--
+		dAtA[i] = 0x70
+	}
 	if len(m.OriginatorPid) > 0 {
 		i -= len(m.OriginatorPid)
 		copy(dAtA[i:], m.OriginatorPid)
@@ -463,6 +481,9 @@ func (m *Message) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMessage(uint64(l))
 	}
+	if m.View != 0 {
+		n += 1 + sovMessage(uint64(m.View))
+	}
 	return n
 }
 
@@ -490,6 +511,7 @@ func (this *Message) String() string {
 		`AggregateSignature:` + fmt.Sprintf("%v", this.AggregateSignature) + `,`,
 		`LeaderSignature:` + fmt.Sprintf("%v", this.LeaderSignature) + `,`,
 		`OriginatorPid:` + fmt.Sprintf("%v", this.OriginatorPid) + `,`,
+		`View:` + fmt.Sprintf("%v", this.View) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -943,6 +965,25 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 				m.OriginatorPid = []byte{}
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field View", wireType)
+			}
+			m.View = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.View |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
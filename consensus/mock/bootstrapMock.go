@@ -10,6 +10,7 @@ import (
 type BootstrapperMock struct {
 	CreateAndCommitEmptyBlockCalled func(uint32) (data.BodyHandler, data.HeaderHandler, error)
 	AddSyncStateListenerCalled      func(func(bool))
+	AddBeforeSyncBlockHandlerCalled func(func())
 	GetNodeStateCalled              func() core.NodeState
 	StartSyncingBlocksCalled        func()
 	SetStatusHandlerCalled          func(handler core.AppStatusHandler) error
@@ -31,6 +32,13 @@ func (boot *BootstrapperMock) AddSyncStateListener(syncStateNotifier func(isSync
 	}
 }
 
+// AddBeforeSyncBlockHandler -
+func (boot *BootstrapperMock) AddBeforeSyncBlockHandler(handler func()) {
+	if boot.AddBeforeSyncBlockHandlerCalled != nil {
+		boot.AddBeforeSyncBlockHandlerCalled(handler)
+	}
+}
+
 // GetNodeState -
 func (boot *BootstrapperMock) GetNodeState() core.NodeState {
 	if boot.GetNodeStateCalled != nil {
@@ -34,6 +34,7 @@ type ConsensusCoreMock struct {
 	peerHonestyHandler      consensus.PeerHonestyHandler
 	headerSigVerifier       consensus.HeaderSigVerifier
 	fallbackHeaderValidator consensus.FallbackHeaderValidator
+	signingHandler          crypto.SigningHandler
 }
 
 // GetAntiFloodHandler -
@@ -211,6 +212,16 @@ func (ccm *ConsensusCoreMock) SetFallbackHeaderValidator(fallbackHeaderValidator
 	ccm.fallbackHeaderValidator = fallbackHeaderValidator
 }
 
+// SigningHandler -
+func (ccm *ConsensusCoreMock) SigningHandler() crypto.SigningHandler {
+	return ccm.signingHandler
+}
+
+// SetSigningHandler -
+func (ccm *ConsensusCoreMock) SetSigningHandler(signingHandler crypto.SigningHandler) {
+	ccm.signingHandler = signingHandler
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ccm *ConsensusCoreMock) IsInterfaceNil() bool {
 	return ccm == nil
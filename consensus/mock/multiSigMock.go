@@ -14,19 +14,20 @@ type BelNevMock struct {
 	pubkeys     []string
 	selfId      uint16
 
-	VerifyMock               func(msg []byte, bitmap []byte) error
-	CommitmentHashMock       func(index uint16) ([]byte, error)
-	CreateCommitmentMock     func() ([]byte, []byte)
-	AggregateCommitmentsMock func(bitmap []byte) error
-	CreateSignatureShareMock func(msg []byte, bitmap []byte) ([]byte, error)
-	VerifySignatureShareMock func(index uint16, sig []byte, msg []byte, bitmap []byte) error
-	AggregateSigsMock        func(bitmap []byte) ([]byte, error)
-	SignatureShareMock       func(index uint16) ([]byte, error)
-	StoreCommitmentMock      func(index uint16, value []byte) error
-	StoreCommitmentHashMock  func(uint16, []byte) error
-	CommitmentMock           func(uint16) ([]byte, error)
-	CreateCalled             func(pubKeys []string, index uint16) (crypto.MultiSigner, error)
-	ResetCalled              func(pubKeys []string, index uint16) error
+	VerifyMock                             func(msg []byte, bitmap []byte) error
+	CommitmentHashMock                     func(index uint16) ([]byte, error)
+	CreateCommitmentMock                   func() ([]byte, []byte)
+	AggregateCommitmentsMock               func(bitmap []byte) error
+	CreateSignatureShareMock               func(msg []byte, bitmap []byte) ([]byte, error)
+	CreateSignatureShareWithPrivateKeyMock func(msg []byte, privateKey crypto.PrivateKey) ([]byte, error)
+	VerifySignatureShareMock               func(index uint16, sig []byte, msg []byte, bitmap []byte) error
+	AggregateSigsMock                      func(bitmap []byte) ([]byte, error)
+	SignatureShareMock                     func(index uint16) ([]byte, error)
+	StoreCommitmentMock                    func(index uint16, value []byte) error
+	StoreCommitmentHashMock                func(uint16, []byte) error
+	CommitmentMock                         func(uint16) ([]byte, error)
+	CreateCalled                           func(pubKeys []string, index uint16) (crypto.MultiSigner, error)
+	ResetCalled                            func(pubKeys []string, index uint16) error
 }
 
 // NewMultiSigner -
@@ -151,6 +152,15 @@ func (bnm *BelNevMock) CreateSignatureShare(msg []byte, bitmap []byte) ([]byte,
 	return bnm.CreateSignatureShareMock(msg, bitmap)
 }
 
+// CreateSignatureShareWithPrivateKey creates a partial signature with the provided private key
+func (bnm *BelNevMock) CreateSignatureShareWithPrivateKey(msg []byte, privateKey crypto.PrivateKey) ([]byte, error) {
+	if bnm.CreateSignatureShareWithPrivateKeyMock != nil {
+		return bnm.CreateSignatureShareWithPrivateKeyMock(msg, privateKey)
+	}
+
+	return []byte("signature share"), nil
+}
+
 // StoreSignatureShare adds the partial signature of the signer with specified position
 func (bnm *BelNevMock) StoreSignatureShare(index uint16, sig []byte) error {
 	if index >= uint16(len(bnm.pubkeys)) {
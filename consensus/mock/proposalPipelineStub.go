@@ -0,0 +1,56 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// ProposalPipelineStub -
+type ProposalPipelineStub struct {
+	RoundStartedCalled     func(round int64)
+	LeaderSelectedCalled   func(round int64, leader string)
+	GetPreparedBlockCalled func(round int64, leader string) (data.HeaderHandler, data.BodyHandler, bool)
+	BlockProcessedCalled   func(header data.HeaderHandler)
+	BlockCommittedCalled   func(header data.HeaderHandler)
+}
+
+// RoundStarted -
+func (pps *ProposalPipelineStub) RoundStarted(round int64) {
+	if pps.RoundStartedCalled != nil {
+		pps.RoundStartedCalled(round)
+	}
+}
+
+// LeaderSelected -
+func (pps *ProposalPipelineStub) LeaderSelected(round int64, leader string) {
+	if pps.LeaderSelectedCalled != nil {
+		pps.LeaderSelectedCalled(round, leader)
+	}
+}
+
+// GetPreparedBlock -
+func (pps *ProposalPipelineStub) GetPreparedBlock(round int64, leader string) (data.HeaderHandler, data.BodyHandler, bool) {
+	if pps.GetPreparedBlockCalled != nil {
+		return pps.GetPreparedBlockCalled(round, leader)
+	}
+
+	return nil, nil, false
+}
+
+// BlockProcessed -
+func (pps *ProposalPipelineStub) BlockProcessed(header data.HeaderHandler) {
+	if pps.BlockProcessedCalled != nil {
+		pps.BlockProcessedCalled(header)
+	}
+}
+
+// BlockCommitted -
+func (pps *ProposalPipelineStub) BlockCommitted(header data.HeaderHandler) {
+	if pps.BlockCommittedCalled != nil {
+		pps.BlockCommittedCalled(header)
+	}
+}
+
+// IsInterfaceNil -
+func (pps *ProposalPipelineStub) IsInterfaceNil() bool {
+	return pps == nil
+}
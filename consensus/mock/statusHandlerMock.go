@@ -1,5 +1,11 @@
 package mock
 
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
 // AppStatusHandlerMock is an empty implementation of AppStatusHandler in order to be used in constructors
 type AppStatusHandlerMock struct {
 }
@@ -33,6 +39,14 @@ func (ashs *AppStatusHandlerMock) SetUInt64Value(_ string, _ uint64) {
 func (ashs *AppStatusHandlerMock) SetStringValue(_ string, _ string) {
 }
 
+// AddToCounter -
+func (ashs *AppStatusHandlerMock) AddToCounter(_ string, _ uint64, _ ...core.MetricLabel) {
+}
+
+// ObserveDuration -
+func (ashs *AppStatusHandlerMock) ObserveDuration(_ string, _ time.Duration, _ ...core.MetricLabel) {
+}
+
 // Close won't do anything
 func (ashs *AppStatusHandlerMock) Close() {
 }
@@ -0,0 +1,56 @@
+package mock
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// ViewHandlerStub -
+type ViewHandlerStub struct {
+	BlockJobStartedCalled         func(round int64, proposeBlock func() bool)
+	GetBlockCreationEndTimeCalled func(view uint32, subroundEndTime time.Duration) time.Duration
+	ExecuteInViewCalled           func(round int64, view uint32, action func()) bool
+	AcceptProposalCalled          func(view uint32, header data.HeaderHandler, setProposal func()) bool
+}
+
+// BlockJobStarted -
+func (vhs *ViewHandlerStub) BlockJobStarted(round int64, proposeBlock func() bool) {
+	if vhs.BlockJobStartedCalled != nil {
+		vhs.BlockJobStartedCalled(round, proposeBlock)
+	}
+}
+
+// GetBlockCreationEndTime -
+func (vhs *ViewHandlerStub) GetBlockCreationEndTime(view uint32, subroundEndTime time.Duration) time.Duration {
+	if vhs.GetBlockCreationEndTimeCalled != nil {
+		return vhs.GetBlockCreationEndTimeCalled(view, subroundEndTime)
+	}
+
+	return subroundEndTime
+}
+
+// ExecuteInView -
+func (vhs *ViewHandlerStub) ExecuteInView(round int64, view uint32, action func()) bool {
+	if vhs.ExecuteInViewCalled != nil {
+		return vhs.ExecuteInViewCalled(round, view, action)
+	}
+
+	action()
+	return true
+}
+
+// AcceptProposal -
+func (vhs *ViewHandlerStub) AcceptProposal(view uint32, header data.HeaderHandler, setProposal func()) bool {
+	if vhs.AcceptProposalCalled != nil {
+		return vhs.AcceptProposalCalled(view, header, setProposal)
+	}
+
+	setProposal()
+	return true
+}
+
+// IsInterfaceNil -
+func (vhs *ViewHandlerStub) IsInterfaceNil() bool {
+	return vhs == nil
+}
@@ -21,6 +21,10 @@ type factory struct {
 	indexer          indexer.Indexer
 	chainID          []byte
 	currentPid       core.PeerID
+
+	proposalPipeline     ProposalPipeline
+	viewHandler          ViewHandler
+	blockSubroundEndTime float64
 }
 
 // NewSubroundsFactory creates a new consensusState object
@@ -48,6 +52,10 @@ func NewSubroundsFactory(
 		appStatusHandler: statusHandler.NewNilStatusHandler(),
 		chainID:          chainID,
 		currentPid:       currentPid,
+
+		proposalPipeline:     &disabledProposalPipeline{},
+		viewHandler:          &disabledViewHandler{},
+		blockSubroundEndTime: srBlockEndTime,
 	}
 
 	return &fct, nil
@@ -91,6 +99,38 @@ func (fct *factory) SetIndexer(indexer indexer.Indexer) {
 	fct.indexer = indexer
 }
 
+// SetProposalPipeline method will update the value of the pipeline which prepares the blocks ahead of their rounds
+func (fct *factory) SetProposalPipeline(pipeline ProposalPipeline) error {
+	if check.IfNil(pipeline) {
+		return ErrNilProposalPipeline
+	}
+	fct.proposalPipeline = pipeline
+
+	return nil
+}
+
+// SetViewHandler method will update the value of the handler which replaces the leader of the round when no block
+// is received from it
+func (fct *factory) SetViewHandler(handler ViewHandler) error {
+	if check.IfNil(handler) {
+		return ErrNilViewHandler
+	}
+	fct.viewHandler = handler
+
+	return nil
+}
+
+// SetBlockSubroundEndTime method will update the end time, from the total time of the round, of Subround Block,
+// which is also the start time of Subround Signature
+func (fct *factory) SetBlockSubroundEndTime(endTime float64) error {
+	if endTime <= srBlockStartTime || endTime >= srSignatureEndTime {
+		return ErrInvalidBlockSubroundEndTime
+	}
+	fct.blockSubroundEndTime = endTime
+
+	return nil
+}
+
 // GenerateSubrounds will generate the subrounds used in BLS Cns
 func (fct *factory) GenerateSubrounds() error {
 	fct.initConsensusThreshold()
@@ -161,6 +201,11 @@ func (fct *factory) generateStartRoundSubround() error {
 
 	subroundStartRound.SetIndexer(fct.indexer)
 
+	err = subroundStartRound.SetProposalPipeline(fct.proposalPipeline)
+	if err != nil {
+		return err
+	}
+
 	fct.consensusCore.Chronology().AddSubround(subroundStartRound)
 
 	return nil
@@ -172,7 +217,7 @@ func (fct *factory) generateBlockSubround() error {
 		SrBlock,
 		SrSignature,
 		int64(float64(fct.getTimeDuration())*srBlockStartTime),
-		int64(float64(fct.getTimeDuration())*srBlockEndTime),
+		int64(float64(fct.getTimeDuration())*fct.blockSubroundEndTime),
 		getSubroundName(SrBlock),
 		fct.consensusState,
 		fct.worker.GetConsensusStateChangedChannel(),
@@ -199,6 +244,16 @@ func (fct *factory) generateBlockSubround() error {
 		return err
 	}
 
+	err = subroundBlock.SetProposalPipeline(fct.proposalPipeline)
+	if err != nil {
+		return err
+	}
+
+	err = subroundBlock.SetViewHandler(fct.viewHandler)
+	if err != nil {
+		return err
+	}
+
 	fct.worker.AddReceivedMessageCall(MtBlockBodyAndHeader, subroundBlock.receivedBlockBodyAndHeader)
 	fct.worker.AddReceivedMessageCall(MtBlockBody, subroundBlock.receivedBlockBody)
 	fct.worker.AddReceivedMessageCall(MtBlockHeader, subroundBlock.receivedBlockHeader)
@@ -212,7 +267,7 @@ func (fct *factory) generateSignatureSubround() error {
 		SrBlock,
 		SrSignature,
 		SrEndRound,
-		int64(float64(fct.getTimeDuration())*srSignatureStartTime),
+		int64(float64(fct.getTimeDuration())*fct.blockSubroundEndTime),
 		int64(float64(fct.getTimeDuration())*srSignatureEndTime),
 		getSubroundName(SrSignature),
 		fct.consensusState,
@@ -279,6 +334,11 @@ func (fct *factory) generateEndRoundSubround() error {
 		return err
 	}
 
+	err = subroundEndRoundObject.SetProposalPipeline(fct.proposalPipeline)
+	if err != nil {
+		return err
+	}
+
 	fct.worker.AddReceivedMessageCall(MtBlockHeaderFinalInfo, subroundEndRoundObject.receivedBlockHeaderFinalInfo)
 	fct.worker.AddReceivedHeaderHandler(subroundEndRoundObject.receivedHeader)
 	fct.consensusCore.Chronology().AddSubround(subroundEndRoundObject)
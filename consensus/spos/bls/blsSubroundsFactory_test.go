@@ -558,3 +558,62 @@ func TestFactory_SetIndexerShouldWork(t *testing.T) {
 
 	assert.Equal(t, indexer, fct.Indexer())
 }
+
+func TestFactory_SetProposalPipelineNilPipelineShouldErr(t *testing.T) {
+	t.Parallel()
+
+	fct := *initFactory()
+
+	err := fct.SetProposalPipeline(nil)
+	assert.Equal(t, bls.ErrNilProposalPipeline, err)
+
+	err = fct.SetProposalPipeline(&mock.ProposalPipelineStub{})
+	assert.Nil(t, err)
+}
+
+func TestFactory_SetViewHandlerNilHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	fct := *initFactory()
+
+	err := fct.SetViewHandler(nil)
+	assert.Equal(t, bls.ErrNilViewHandler, err)
+
+	err = fct.SetViewHandler(&mock.ViewHandlerStub{})
+	assert.Nil(t, err)
+}
+
+func TestFactory_SetBlockSubroundEndTimeOutOfTheSubroundsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	fct := *initFactory()
+
+	err := fct.SetBlockSubroundEndTime(0.05)
+	assert.Equal(t, bls.ErrInvalidBlockSubroundEndTime, err)
+
+	err = fct.SetBlockSubroundEndTime(0.85)
+	assert.Equal(t, bls.ErrInvalidBlockSubroundEndTime, err)
+}
+
+func TestFactory_SetBlockSubroundEndTimeShouldMoveTheStartOfTheSignatureSubround(t *testing.T) {
+	t.Parallel()
+
+	var subroundHandlers []consensus.SubroundHandler
+	chrm := &mock.ChronologyHandlerMock{}
+	chrm.AddSubroundCalled = func(subroundHandler consensus.SubroundHandler) {
+		subroundHandlers = append(subroundHandlers, subroundHandler)
+	}
+	container := mock.InitConsensusCore()
+	container.SetChronology(chrm)
+	fct := *initFactoryWithContainer(container)
+
+	err := fct.SetBlockSubroundEndTime(0.65)
+	assert.Nil(t, err)
+
+	err = fct.GenerateSubrounds()
+	assert.Nil(t, err)
+
+	expectedEndTime := int64(float64(container.Rounder().TimeDuration()) * 0.65)
+	assert.Equal(t, expectedEndTime, subroundHandlers[1].EndTime())
+	assert.Equal(t, expectedEndTime, subroundHandlers[2].StartTime())
+}
@@ -45,6 +45,12 @@ func (wrk *worker) GetMaxMessagesInARoundPerPeer() uint32 {
 	return peerMaxMessagesPerSec
 }
 
+// GetMaxView returns the maximum number of leader view changes allowed in a round, BLS not changing the leader
+// during a round
+func (wrk *worker) GetMaxView() uint32 {
+	return 0
+}
+
 //GetStringValue gets the name of the messageType
 func (wrk *worker) GetStringValue(messageType consensus.MessageType) string {
 	return getStringValue(messageType)
@@ -80,6 +86,11 @@ func (wrk *worker) IsMessageWithFinalInfo(msgType consensus.MessageType) bool {
 	return msgType == MtBlockHeaderFinalInfo
 }
 
+//IsMessageWithViewChange returns if the current messageType is about a leader view change
+func (wrk *worker) IsMessageWithViewChange(_ consensus.MessageType) bool {
+	return false
+}
+
 //IsMessageTypeValid returns if the current messageType is valid
 func (wrk *worker) IsMessageTypeValid(msgType consensus.MessageType) bool {
 	isMessageTypeValid := msgType == MtBlockBodyAndHeader ||
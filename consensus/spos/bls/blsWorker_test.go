@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -19,6 +20,10 @@ func createEligibleList(size int) []string {
 }
 
 func initConsensusState() *spos.ConsensusState {
+	return initConsensusStateWithKeysHandler(&testscommon.KeysHandlerStub{})
+}
+
+func initConsensusStateWithKeysHandler(keysHandler consensus.KeysHandler) *spos.ConsensusState {
 	consensusGroupSize := 9
 	eligibleList := createEligibleList(consensusGroupSize)
 
@@ -31,7 +36,9 @@ func initConsensusState() *spos.ConsensusState {
 	rcns := spos.NewRoundConsensus(
 		eligibleNodesPubKeys,
 		consensusGroupSize,
-		eligibleList[indexLeader])
+		eligibleList[indexLeader],
+		keysHandler,
+	)
 
 	rcns.SetConsensusGroup(eligibleList)
 	rcns.ResetRoundState()
@@ -338,6 +345,26 @@ func TestWorker_IsMessageWithFinalInfo(t *testing.T) {
 	assert.True(t, ret)
 }
 
+func TestWorker_IsMessageWithViewChange(t *testing.T) {
+	t.Parallel()
+
+	service, _ := bls.NewConsensusService()
+
+	ret := service.IsMessageWithViewChange(bls.MtBlockBodyAndHeader)
+	assert.False(t, ret)
+
+	ret = service.IsMessageWithViewChange(bls.MtBlockHeaderFinalInfo)
+	assert.False(t, ret)
+}
+
+func TestWorker_GetMaxView(t *testing.T) {
+	t.Parallel()
+
+	service, _ := bls.NewConsensusService()
+
+	assert.Equal(t, uint32(0), service.GetMaxView())
+}
+
 func TestWorker_IsSubroundSignature(t *testing.T) {
 	t.Parallel()
 
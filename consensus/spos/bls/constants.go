@@ -49,12 +49,10 @@ const srStartEndTime = 0.05
 // srBlockStartTime specifies the start time, from the total time of the round, of Subround Block
 const srBlockStartTime = 0.05
 
-// srBlockEndTime specifies the end time, from the total time of the round, of Subround Block
+// srBlockEndTime specifies the end time, from the total time of the round, of Subround Block, which is also the start
+// time of Subround Signature
 const srBlockEndTime = 0.25
 
-// srSignatureStartTime specifies the start time, from the total time of the round, of Subround Signature
-const srSignatureStartTime = 0.25
-
 // srSignatureEndTime specifies the end time, from the total time of the round, of Subround Signature
 const srSignatureEndTime = 0.85
 
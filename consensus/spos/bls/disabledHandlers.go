@@ -0,0 +1,76 @@
+package bls
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// disabledProposalPipeline represents a proposal pipeline which never prepares a block ahead
+type disabledProposalPipeline struct {
+}
+
+// RoundStarted does nothing
+func (dpp *disabledProposalPipeline) RoundStarted(_ int64) {
+}
+
+// LeaderSelected does nothing
+func (dpp *disabledProposalPipeline) LeaderSelected(_ int64, _ string) {
+}
+
+// GetPreparedBlock returns false as no block is prepared ahead
+func (dpp *disabledProposalPipeline) GetPreparedBlock(_ int64, _ string) (data.HeaderHandler, data.BodyHandler, bool) {
+	return nil, nil, false
+}
+
+// BlockProcessed does nothing
+func (dpp *disabledProposalPipeline) BlockProcessed(_ data.HeaderHandler) {
+}
+
+// BlockCommitted does nothing
+func (dpp *disabledProposalPipeline) BlockCommitted(_ data.HeaderHandler) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dpp *disabledProposalPipeline) IsInterfaceNil() bool {
+	return dpp == nil
+}
+
+// disabledViewHandler represents a view handler which never replaces the leader of the round
+type disabledViewHandler struct {
+}
+
+// BlockJobStarted does nothing
+func (dvh *disabledViewHandler) BlockJobStarted(_ int64, _ func() bool) {
+}
+
+// GetBlockCreationEndTime returns the end time of the subround Block
+func (dvh *disabledViewHandler) GetBlockCreationEndTime(_ uint32, subroundEndTime time.Duration) time.Duration {
+	return subroundEndTime
+}
+
+// ExecuteInView executes the provided action, as the view never changes
+func (dvh *disabledViewHandler) ExecuteInView(_ int64, _ uint32, action func()) bool {
+	action()
+	return true
+}
+
+// AcceptProposal sets the proposal of the first view, as the view never changes. Proposals of other views and
+// headers holding a view in their reserved field are rejected
+func (dvh *disabledViewHandler) AcceptProposal(view uint32, header data.HeaderHandler, setProposal func()) bool {
+	if view != 0 {
+		return false
+	}
+	if !check.IfNil(header) && len(header.GetReserved()) > 0 {
+		return false
+	}
+
+	setProposal()
+	return true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dvh *disabledViewHandler) IsInterfaceNil() bool {
+	return dvh == nil
+}
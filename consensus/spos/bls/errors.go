@@ -0,0 +1,14 @@
+package bls
+
+import (
+	"errors"
+)
+
+// ErrNilProposalPipeline signals that a nil proposal pipeline has been provided
+var ErrNilProposalPipeline = errors.New("nil proposal pipeline")
+
+// ErrNilViewHandler signals that a nil view handler has been provided
+var ErrNilViewHandler = errors.New("nil view handler")
+
+// ErrInvalidBlockSubroundEndTime signals that an invalid end time of the subround Block has been provided
+var ErrInvalidBlockSubroundEndTime = errors.New("invalid end time of the subround block")
@@ -185,7 +185,7 @@ func (sr *subroundBlock) CreateHeader() (data.HeaderHandler, error) {
 
 // CreateBody method creates the proposed block body in the subround Block
 func (sr *subroundBlock) CreateBlock(hdr data.HeaderHandler) (data.HeaderHandler, data.BodyHandler, error) {
-	return sr.createBlock(hdr)
+	return sr.createBlock(hdr, time.Duration(sr.EndTime()))
 }
 
 // SendBlockBody method sends the proposed block body in the subround Block
@@ -213,6 +213,11 @@ func (sr *subroundBlock) ReceivedBlockHeader(cnsDta *consensus.Message) bool {
 	return sr.receivedBlockHeader(cnsDta)
 }
 
+// ReceivedBlockBodyAndHeader method is called when a block body and a block header is received
+func (sr *subroundBlock) ReceivedBlockBodyAndHeader(cnsDta *consensus.Message) bool {
+	return sr.receivedBlockBodyAndHeader(cnsDta)
+}
+
 // subroundSignature
 
 // SubroundSignature defines a type for the subroundSignature structure
@@ -0,0 +1,26 @@
+package bls
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// ProposalPipeline defines the hooks through which the block of a round can be prepared ahead of its subround Block
+type ProposalPipeline interface {
+	RoundStarted(round int64)
+	LeaderSelected(round int64, leader string)
+	GetPreparedBlock(round int64, leader string) (data.HeaderHandler, data.BodyHandler, bool)
+	BlockProcessed(header data.HeaderHandler)
+	BlockCommitted(header data.HeaderHandler)
+	IsInterfaceNil() bool
+}
+
+// ViewHandler defines the hooks through which the leader of a round can be replaced during the subround Block
+type ViewHandler interface {
+	BlockJobStarted(round int64, proposeBlock func() bool)
+	GetBlockCreationEndTime(view uint32, subroundEndTime time.Duration) time.Duration
+	ExecuteInView(round int64, view uint32, action func()) bool
+	AcceptProposal(view uint32, header data.HeaderHandler, setProposal func()) bool
+	IsInterfaceNil() bool
+}
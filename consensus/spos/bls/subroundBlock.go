@@ -18,6 +18,8 @@ type subroundBlock struct {
 	*spos.Subround
 
 	processingThresholdPercentage int
+	proposalPipeline              ProposalPipeline
+	viewHandler                   ViewHandler
 }
 
 // NewSubroundBlock creates a subroundBlock object
@@ -34,6 +36,8 @@ func NewSubroundBlock(
 	srBlock := subroundBlock{
 		Subround:                      baseSubround,
 		processingThresholdPercentage: processingThresholdPercentage,
+		proposalPipeline:              &disabledProposalPipeline{},
+		viewHandler:                   &disabledViewHandler{},
 	}
 
 	srBlock.Job = srBlock.doBlockJob
@@ -59,13 +63,42 @@ func checkNewSubroundBlockParams(
 	return err
 }
 
+// SetProposalPipeline method sets the pipeline from which the leader takes the block prepared ahead of the round
+func (sr *subroundBlock) SetProposalPipeline(pipeline ProposalPipeline) error {
+	if check.IfNil(pipeline) {
+		return ErrNilProposalPipeline
+	}
+
+	sr.proposalPipeline = pipeline
+
+	return nil
+}
+
+// SetViewHandler method sets the handler which replaces the leader of the round when no block is received from it
+func (sr *subroundBlock) SetViewHandler(handler ViewHandler) error {
+	if check.IfNil(handler) {
+		return ErrNilViewHandler
+	}
+
+	sr.viewHandler = handler
+
+	return nil
+}
+
 // doBlockJob method does the job of the subround Block
 func (sr *subroundBlock) doBlockJob() bool {
-	if !sr.IsSelfLeaderInCurrentRound() { // is NOT self leader in this round?
+	if sr.Rounder().Index() <= sr.getRoundInLastCommittedBlock() {
 		return false
 	}
 
-	if sr.Rounder().Index() <= sr.getRoundInLastCommittedBlock() {
+	sr.viewHandler.BlockJobStarted(sr.Rounder().Index(), sr.proposeBlock)
+
+	return sr.proposeBlock()
+}
+
+// proposeBlock creates and sends the block of the current round, if the node is the leader of the current view
+func (sr *subroundBlock) proposeBlock() bool {
+	if !sr.IsSelfLeaderInCurrentRound() { // is NOT self leader in this round?
 		return false
 	}
 
@@ -80,32 +113,66 @@ func (sr *subroundBlock) doBlockJob() bool {
 	metricStatTime := time.Now()
 	defer sr.computeSubroundProcessingMetric(metricStatTime, core.MetricCreatedProposedBlock)
 
-	header, err := sr.createHeader()
+	round := sr.Rounder().Index()
+	view := sr.View()
+	header, body, err := sr.getProposal(round, view)
 	if err != nil {
-		log.Debug("doBlockJob.createHeader", "error", err.Error())
+		log.Debug("proposeBlock.getProposal", "error", err.Error())
 		return false
 	}
 
-	header, body, err := sr.createBlock(header)
-	if err != nil {
-		log.Debug("doBlockJob.createBlock", "error", err.Error())
+	sentWithSuccess := false
+	isViewUnchanged := sr.viewHandler.ExecuteInView(round, view, func() {
+		sentWithSuccess = sr.sendBlock(body, header)
+	})
+	if !isViewUnchanged {
+		log.Debug("proposeBlock: view has been changed during the block creation",
+			"round", round,
+			"view", view)
+		sr.BlockProcessor().RevertAccountState(header)
 		return false
 	}
 
-	sentWithSuccess := sr.sendBlock(body, header)
 	if !sentWithSuccess {
 		return false
 	}
 
 	err = sr.SetSelfJobDone(sr.Current(), true)
 	if err != nil {
-		log.Debug("doBlockJob.SetSelfJobDone", "error", err.Error())
+		log.Debug("proposeBlock.SetSelfJobDone", "error", err.Error())
 		return false
 	}
 
+	sr.proposalPipeline.BlockProcessed(header)
+
 	return true
 }
 
+// getProposal returns the block prepared ahead for the first view of the provided round, if any, or creates a new one
+func (sr *subroundBlock) getProposal(round int64, view uint32) (data.HeaderHandler, data.BodyHandler, error) {
+	if view == 0 {
+		header, body, ok := sr.proposalPipeline.GetPreparedBlock(round, sr.SelfPubKey())
+		if ok {
+			log.Debug("step 1: using the block prepared ahead of the round",
+				"nonce", header.GetNonce())
+			return header, body, nil
+		}
+	}
+
+	header, err := sr.createHeader()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if view > 0 {
+		header.SetReserved(core.EncodeConsensusView(view))
+	}
+
+	maxTime := sr.viewHandler.GetBlockCreationEndTime(view, time.Duration(sr.EndTime()))
+
+	return sr.createBlock(header, maxTime)
+}
+
 func (sr *subroundBlock) sendBlock(body data.BodyHandler, header data.HeaderHandler) bool {
 	marshalizedBody, err := sr.Marshalizer().Marshal(body)
 	if err != nil {
@@ -140,9 +207,11 @@ func (sr *subroundBlock) couldBeSentTogether(marshalizedBody []byte, marshalized
 	return bodyAndHeaderSize <= maxAllowedSizeInBytes
 }
 
-func (sr *subroundBlock) createBlock(header data.HeaderHandler) (data.HeaderHandler, data.BodyHandler, error) {
+func (sr *subroundBlock) createBlock(
+	header data.HeaderHandler,
+	maxTime time.Duration,
+) (data.HeaderHandler, data.BodyHandler, error) {
 	startTime := sr.RoundTimeStamp
-	maxTime := time.Duration(sr.EndTime())
 	haveTimeInCurrentSubround := func() bool {
 		return sr.Rounder().RemainingTime(startTime, maxTime) > 0
 	}
@@ -182,6 +251,7 @@ func (sr *subroundBlock) sendBlockBodyAndHeader(
 		nil,
 		sr.CurrentPid(),
 	)
+	cnsMsg.View = sr.View()
 
 	err := sr.BroadcastMessenger().BroadcastConsensusMessage(cnsMsg)
 	if err != nil {
@@ -217,6 +287,7 @@ func (sr *subroundBlock) sendBlockBody(bodyHandler data.BodyHandler, marshalized
 		nil,
 		sr.CurrentPid(),
 	)
+	cnsMsg.View = sr.View()
 
 	err := sr.BroadcastMessenger().BroadcastConsensusMessage(cnsMsg)
 	if err != nil {
@@ -250,6 +321,7 @@ func (sr *subroundBlock) sendBlockHeader(headerHandler data.HeaderHandler, marsh
 		nil,
 		sr.CurrentPid(),
 	)
+	cnsMsg.View = sr.View()
 
 	err := sr.BroadcastMessenger().BroadcastConsensusMessage(cnsMsg)
 	if err != nil {
@@ -268,35 +340,75 @@ func (sr *subroundBlock) sendBlockHeader(headerHandler data.HeaderHandler, marsh
 }
 
 func (sr *subroundBlock) createHeader() (data.HeaderHandler, error) {
+	return CreateHeaderForRound(
+		sr.ConsensusCoreHandler,
+		sr.ChainID(),
+		sr.Rounder().Index(),
+		sr.Rounder().TimeStamp(),
+		sr.SelfPubKey(),
+	)
+}
+
+// CreateHeaderForRound creates the header proposed by the provided leader in the provided round, on top of the last
+// committed block
+func CreateHeaderForRound(
+	consensusCore spos.ConsensusCoreHandler,
+	chainID []byte,
+	roundIndex int64,
+	roundTimeStamp time.Time,
+	leader string,
+) (data.HeaderHandler, error) {
 	var nonce uint64
 	var prevHash []byte
 	var prevRandSeed []byte
 
-	currentHeader := sr.Blockchain().GetCurrentBlockHeader()
+	currentHeader := consensusCore.Blockchain().GetCurrentBlockHeader()
 	if check.IfNil(currentHeader) {
-		nonce = sr.Blockchain().GetGenesisHeader().GetNonce() + 1
-		prevHash = sr.Blockchain().GetGenesisHeaderHash()
-		prevRandSeed = sr.Blockchain().GetGenesisHeader().GetRandSeed()
+		nonce = consensusCore.Blockchain().GetGenesisHeader().GetNonce() + 1
+		prevHash = consensusCore.Blockchain().GetGenesisHeaderHash()
+		prevRandSeed = consensusCore.Blockchain().GetGenesisHeader().GetRandSeed()
 	} else {
 		nonce = currentHeader.GetNonce() + 1
-		prevHash = sr.Blockchain().GetCurrentBlockHeaderHash()
+		prevHash = consensusCore.Blockchain().GetCurrentBlockHeaderHash()
 		prevRandSeed = currentHeader.GetRandSeed()
 	}
 
-	round := uint64(sr.Rounder().Index())
-	hdr := sr.BlockProcessor().CreateNewHeader(round, nonce)
+	return CreateHeaderOnTopOf(consensusCore, chainID, roundIndex, roundTimeStamp, leader, nonce, prevHash, prevRandSeed)
+}
+
+// CreateHeaderOnTopOf creates the header with the provided nonce, proposed by the provided leader in the provided
+// round, on top of the block with the provided hash and random seed. The previous hash is not covered by the random
+// seed signature, so it can be set later, once the previous block is committed
+func CreateHeaderOnTopOf(
+	consensusCore spos.ConsensusCoreHandler,
+	chainID []byte,
+	roundIndex int64,
+	roundTimeStamp time.Time,
+	leader string,
+	nonce uint64,
+	prevHash []byte,
+	prevRandSeed []byte,
+) (data.HeaderHandler, error) {
+	round := uint64(roundIndex)
+	hdr := consensusCore.BlockProcessor().CreateNewHeader(round, nonce)
 	hdr.SetPrevHash(prevHash)
+	hdr.SetShardID(consensusCore.ShardCoordinator().SelfId())
+	hdr.SetTimeStamp(uint64(roundTimeStamp.Unix()))
+	hdr.SetPrevRandSeed(prevRandSeed)
+	hdr.SetChainID(chainID)
 
-	randSeed, err := sr.SingleSigner().Sign(sr.PrivateKey(), prevRandSeed)
+	// the header is provided along with the previous random seed, so that a signing service can check its round
+	marshalizedHdr, err := consensusCore.Marshalizer().Marshal(hdr)
+	if err != nil {
+		return nil, err
+	}
+
+	randSeed, err := consensusCore.SigningHandler().SignRandSeed([]byte(leader), prevRandSeed, marshalizedHdr)
 	if err != nil {
 		return nil, err
 	}
 
-	hdr.SetShardID(sr.ShardCoordinator().SelfId())
-	hdr.SetTimeStamp(uint64(sr.Rounder().TimeStamp().Unix()))
-	hdr.SetPrevRandSeed(prevRandSeed)
 	hdr.SetRandSeed(randSeed)
-	hdr.SetChainID(sr.ChainID())
 
 	return hdr, nil
 }
@@ -317,7 +429,7 @@ func (sr *subroundBlock) receivedBlockBodyAndHeader(cnsDta *consensus.Message) b
 		return false
 	}
 
-	if !sr.IsNodeLeaderInCurrentRound(node) { // is NOT this node leader in current round?
+	if !sr.isNodeLeaderInView(node, cnsDta.View) { // is NOT this node leader in the view of the proposal?
 		sr.PeerHonestyHandler().ChangeScore(
 			node,
 			spos.GetConsensusTopicID(sr.ShardCoordinator()),
@@ -339,9 +451,15 @@ func (sr *subroundBlock) receivedBlockBodyAndHeader(cnsDta *consensus.Message) b
 		return false
 	}
 
-	sr.Data = cnsDta.BlockHeaderHash
-	sr.Body = sr.BlockProcessor().DecodeBlockBody(cnsDta.Body)
-	sr.Header = sr.BlockProcessor().DecodeBlockHeader(cnsDta.Header)
+	header := sr.BlockProcessor().DecodeBlockHeader(cnsDta.Header)
+	isProposalAccepted := sr.viewHandler.AcceptProposal(cnsDta.View, header, func() {
+		sr.Data = cnsDta.BlockHeaderHash
+		sr.Body = sr.BlockProcessor().DecodeBlockBody(cnsDta.Body)
+		sr.Header = header
+	})
+	if !isProposalAccepted {
+		return false
+	}
 
 	if sr.Data == nil || check.IfNil(sr.Body) || check.IfNil(sr.Header) {
 		return false
@@ -349,7 +467,8 @@ func (sr *subroundBlock) receivedBlockBodyAndHeader(cnsDta *consensus.Message) b
 
 	log.Debug("step 1: block body and header have been received",
 		"nonce", sr.Header.GetNonce(),
-		"hash", cnsDta.BlockHeaderHash)
+		"hash", cnsDta.BlockHeaderHash,
+		"view", cnsDta.View)
 
 	sw.Start("processReceivedBlock")
 	blockProcessedWithSuccess := sr.processReceivedBlock(cnsDta)
@@ -368,7 +487,7 @@ func (sr *subroundBlock) receivedBlockBodyAndHeader(cnsDta *consensus.Message) b
 func (sr *subroundBlock) receivedBlockBody(cnsDta *consensus.Message) bool {
 	node := string(cnsDta.PubKey)
 
-	if !sr.IsNodeLeaderInCurrentRound(node) { // is NOT this node leader in current round?
+	if !sr.isNodeLeaderInView(node, cnsDta.View) { // is NOT this node leader in the view of the proposal?
 		sr.PeerHonestyHandler().ChangeScore(
 			node,
 			spos.GetConsensusTopicID(sr.ShardCoordinator()),
@@ -386,13 +505,19 @@ func (sr *subroundBlock) receivedBlockBody(cnsDta *consensus.Message) bool {
 		return false
 	}
 
-	sr.Body = sr.BlockProcessor().DecodeBlockBody(cnsDta.Body)
+	isProposalAccepted := sr.viewHandler.AcceptProposal(cnsDta.View, nil, func() {
+		sr.Body = sr.BlockProcessor().DecodeBlockBody(cnsDta.Body)
+	})
+	if !isProposalAccepted {
+		return false
+	}
 
 	if check.IfNil(sr.Body) {
 		return false
 	}
 
-	log.Debug("step 1: block body has been received")
+	log.Debug("step 1: block body has been received",
+		"view", cnsDta.View)
 
 	blockProcessedWithSuccess := sr.processReceivedBlock(cnsDta)
 
@@ -415,7 +540,7 @@ func (sr *subroundBlock) receivedBlockHeader(cnsDta *consensus.Message) bool {
 		return false
 	}
 
-	if !sr.IsNodeLeaderInCurrentRound(node) { // is NOT this node leader in current round?
+	if !sr.isNodeLeaderInView(node, cnsDta.View) { // is NOT this node leader in the view of the proposal?
 		sr.PeerHonestyHandler().ChangeScore(
 			node,
 			spos.GetConsensusTopicID(sr.ShardCoordinator()),
@@ -433,8 +558,14 @@ func (sr *subroundBlock) receivedBlockHeader(cnsDta *consensus.Message) bool {
 		return false
 	}
 
-	sr.Data = cnsDta.BlockHeaderHash
-	sr.Header = sr.BlockProcessor().DecodeBlockHeader(cnsDta.Header)
+	header := sr.BlockProcessor().DecodeBlockHeader(cnsDta.Header)
+	isProposalAccepted := sr.viewHandler.AcceptProposal(cnsDta.View, header, func() {
+		sr.Data = cnsDta.BlockHeaderHash
+		sr.Header = header
+	})
+	if !isProposalAccepted {
+		return false
+	}
 
 	if sr.Data == nil || check.IfNil(sr.Header) {
 		return false
@@ -442,7 +573,8 @@ func (sr *subroundBlock) receivedBlockHeader(cnsDta *consensus.Message) bool {
 
 	log.Debug("step 1: block header has been received",
 		"nonce", sr.Header.GetNonce(),
-		"hash", cnsDta.BlockHeaderHash)
+		"hash", cnsDta.BlockHeaderHash,
+		"view", cnsDta.View)
 	blockProcessedWithSuccess := sr.processReceivedBlock(cnsDta)
 
 	sr.PeerHonestyHandler().ChangeScore(
@@ -454,6 +586,16 @@ func (sr *subroundBlock) receivedBlockHeader(cnsDta *consensus.Message) bool {
 	return blockProcessedWithSuccess
 }
 
+// isNodeLeaderInView returns true if the provided node is the leader of the provided view in the current round
+func (sr *subroundBlock) isNodeLeaderInView(node string, view uint32) bool {
+	consensusGroup := sr.ConsensusGroup()
+	if len(consensusGroup) == 0 {
+		return false
+	}
+
+	return consensusGroup[core.GetConsensusLeaderIndex(view, len(consensusGroup))] == node
+}
+
 func (sr *subroundBlock) processReceivedBlock(cnsDta *consensus.Message) bool {
 	if check.IfNil(sr.Body) {
 		return false
@@ -525,6 +667,8 @@ func (sr *subroundBlock) processReceivedBlock(cnsDta *consensus.Message) bool {
 		return false
 	}
 
+	sr.proposalPipeline.BlockProcessed(sr.Header)
+
 	return true
 }
 
@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/stretchr/testify/assert"
@@ -520,6 +521,43 @@ func TestSubroundBlock_ProcessReceivedBlockShouldReturnTrue(t *testing.T) {
 	assert.True(t, sr.ProcessReceivedBlock(cnsMsg))
 }
 
+func TestSubroundBlock_ProcessReceivedBlockShouldNotifyTheProcessedBlock(t *testing.T) {
+	t.Parallel()
+	container := mock.InitConsensusCore()
+	sr := *initSubroundBlock(nil, container)
+	var processedHeader data.HeaderHandler
+	_ = sr.SetProposalPipeline(&mock.ProposalPipelineStub{
+		BlockProcessedCalled: func(header data.HeaderHandler) {
+			processedHeader = header
+		},
+	})
+	hdr := &block.Header{}
+	blkBody := &block.Body{
+		MiniBlocks: []*block.MiniBlock{},
+	}
+	blkBodyStr, _ := mock.MarshalizerMock{}.Marshal(blkBody)
+	cnsMsg := consensus.NewConsensusMessage(
+		nil,
+		nil,
+		blkBodyStr,
+		nil,
+		[]byte(sr.ConsensusGroup()[0]),
+		[]byte("sig"),
+		int(bls.MtBlockBody),
+		0,
+		chainID,
+		nil,
+		nil,
+		nil,
+		currentPid,
+	)
+	sr.Header = hdr
+	sr.Body = blkBody
+
+	assert.True(t, sr.ProcessReceivedBlock(cnsMsg))
+	assert.True(t, hdr == processedHeader)
+}
+
 func TestSubroundBlock_RemainingTimeShouldReturnNegativeValue(t *testing.T) {
 	t.Parallel()
 	container := mock.InitConsensusCore()
@@ -919,3 +957,255 @@ func TestSubroundBlock_ReceivedBlockComputeProcessDurationWithZeroDurationShould
 
 	srBlock.ComputeSubroundProcessingMetric(time.Now(), "dummy")
 }
+
+func initSubroundBlockForProposal(container *mock.ConsensusCoreMock) bls.SubroundBlock {
+	container.SetBlockProcessor(mock.InitBlockProcessorMock())
+	container.SetBroadcastMessenger(&mock.BroadcastMessengerMock{
+		BroadcastConsensusMessageCalled: func(message *consensus.Message) error {
+			return nil
+		},
+	})
+	container.SetRounder(&mock.RounderMock{
+		RoundIndex: 1,
+	})
+
+	return initSubroundBlock(nil, container)
+}
+
+func TestSubroundBlock_SetProposalPipelineNilPipelineShouldErr(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	sr := *initSubroundBlock(nil, container)
+
+	err := sr.SetProposalPipeline(nil)
+	assert.Equal(t, bls.ErrNilProposalPipeline, err)
+
+	err = sr.SetProposalPipeline(&mock.ProposalPipelineStub{})
+	assert.Nil(t, err)
+}
+
+func TestSubroundBlock_SetViewHandlerNilHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	sr := *initSubroundBlock(nil, container)
+
+	err := sr.SetViewHandler(nil)
+	assert.Equal(t, bls.ErrNilViewHandler, err)
+
+	err = sr.SetViewHandler(&mock.ViewHandlerStub{})
+	assert.Nil(t, err)
+}
+
+func TestSubroundBlock_DoBlockJobShouldStartTheViewsOfTheRound(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	sr := *initSubroundBlockForProposal(container)
+	startedRound := int64(-1)
+	_ = sr.SetViewHandler(&mock.ViewHandlerStub{
+		BlockJobStartedCalled: func(round int64, proposeBlock func() bool) {
+			startedRound = round
+			assert.NotNil(t, proposeBlock)
+		},
+	})
+
+	r := sr.DoBlockJob()
+
+	assert.False(t, r)
+	assert.Equal(t, int64(1), startedRound)
+}
+
+func TestSubroundBlock_DoBlockJobShouldUseThePreparedBlock(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	sr := *initSubroundBlockForProposal(container)
+	sr.SetSelfPubKey(sr.ConsensusGroup()[0])
+	container.BlockProcessor().(*mock.BlockProcessorMock).CreateBlockCalled = func(
+		header data.HeaderHandler,
+		haveTime func() bool,
+	) (data.HeaderHandler, data.BodyHandler, error) {
+		assert.Fail(t, "should have not created a new block")
+		return nil, nil, errors.New("unexpected call")
+	}
+
+	preparedHeader := &block.Header{Nonce: 1, Round: 1}
+	_ = sr.SetProposalPipeline(&mock.ProposalPipelineStub{
+		GetPreparedBlockCalled: func(round int64, leader string) (data.HeaderHandler, data.BodyHandler, bool) {
+			assert.Equal(t, int64(1), round)
+			assert.Equal(t, sr.SelfPubKey(), leader)
+			return preparedHeader, &block.Body{}, true
+		},
+	})
+
+	r := sr.DoBlockJob()
+
+	assert.True(t, r)
+	assert.True(t, preparedHeader == sr.Header)
+}
+
+func TestSubroundBlock_DoBlockJobShouldNotifyTheProposedBlock(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	sr := *initSubroundBlockForProposal(container)
+	sr.SetSelfPubKey(sr.ConsensusGroup()[0])
+	var processedHeader data.HeaderHandler
+	_ = sr.SetProposalPipeline(&mock.ProposalPipelineStub{
+		BlockProcessedCalled: func(header data.HeaderHandler) {
+			processedHeader = header
+		},
+	})
+
+	r := sr.DoBlockJob()
+
+	assert.True(t, r)
+	assert.NotNil(t, processedHeader)
+	assert.True(t, sr.Header == processedHeader)
+}
+
+func TestSubroundBlock_DoBlockJobInLaterViewShouldProposeTheBlockOfTheView(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	sr := *initSubroundBlockForProposal(container)
+	var sentMessage *consensus.Message
+	container.SetBroadcastMessenger(&mock.BroadcastMessengerMock{
+		BroadcastConsensusMessageCalled: func(message *consensus.Message) error {
+			sentMessage = message
+			return nil
+		},
+	})
+	_ = sr.SetProposalPipeline(&mock.ProposalPipelineStub{
+		GetPreparedBlockCalled: func(round int64, leader string) (data.HeaderHandler, data.BodyHandler, bool) {
+			assert.Fail(t, "the prepared block is only proposed in the first view")
+			return nil, nil, false
+		},
+	})
+	sr.SetView(1)
+	sr.SetSelfPubKey(sr.ConsensusGroup()[1])
+
+	r := sr.DoBlockJob()
+
+	assert.True(t, r)
+	assert.Equal(t, uint32(1), sentMessage.View)
+	view, _ := core.DecodeConsensusView(sr.Header.GetReserved())
+	assert.Equal(t, uint32(1), view)
+}
+
+func TestSubroundBlock_DoBlockJobShouldRevertTheBlockIfTheViewChanged(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	sr := *initSubroundBlockForProposal(container)
+	sr.SetSelfPubKey(sr.ConsensusGroup()[0])
+	sr.Data = nil
+	revertCalled := false
+	container.BlockProcessor().(*mock.BlockProcessorMock).RevertAccountStateCalled = func(header data.HeaderHandler) {
+		revertCalled = true
+	}
+	_ = sr.SetViewHandler(&mock.ViewHandlerStub{
+		ExecuteInViewCalled: func(round int64, view uint32, action func()) bool {
+			return false
+		},
+	})
+
+	r := sr.DoBlockJob()
+
+	assert.False(t, r)
+	assert.True(t, revertCalled)
+	assert.Nil(t, sr.Data)
+	assert.False(t, sr.IsSelfJobDone(bls.SrBlock))
+}
+
+func TestSubroundBlock_ReceivedBlockFromTheLeaderOfAnotherViewShouldNotProcess(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	sr := *initSubroundBlock(nil, container)
+	sr.Data = nil
+	_ = sr.SetViewHandler(&mock.ViewHandlerStub{
+		AcceptProposalCalled: func(view uint32, header data.HeaderHandler, setProposal func()) bool {
+			assert.Fail(t, "should have not been called")
+			return false
+		},
+	})
+
+	cnsMsg := createProposalMessage(sr.ConsensusGroup()[2], 0)
+	r := sr.ReceivedBlockBodyAndHeader(cnsMsg)
+
+	assert.False(t, r)
+	assert.Nil(t, sr.Data)
+}
+
+func TestSubroundBlock_ReceivedBlockNotAcceptedInTheViewShouldNotProcess(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	sr := *initSubroundBlock(nil, container)
+	sr.Data = nil
+	sr.Header = nil
+	sr.Body = nil
+	acceptedView := uint32(0)
+	_ = sr.SetViewHandler(&mock.ViewHandlerStub{
+		AcceptProposalCalled: func(view uint32, header data.HeaderHandler, setProposal func()) bool {
+			acceptedView = view
+			assert.NotNil(t, header)
+			return false
+		},
+	})
+
+	cnsMsg := createProposalMessage(sr.ConsensusGroup()[2], 2)
+	r := sr.ReceivedBlockBodyAndHeader(cnsMsg)
+
+	assert.False(t, r)
+	assert.Equal(t, uint32(2), acceptedView)
+	assert.Nil(t, sr.Data)
+}
+
+func createProposalMessage(pubKey string, view uint32) *consensus.Message {
+	hdr := &block.Header{Nonce: 1, Reserved: core.EncodeConsensusView(view)}
+	hdrStr, _ := mock.MarshalizerMock{}.Marshal(hdr)
+	blkBodyStr, _ := mock.MarshalizerMock{}.Marshal(&block.Body{})
+
+	cnsMsg := consensus.NewConsensusMessage(
+		mock.HasherMock{}.Compute(string(hdrStr)),
+		nil,
+		blkBodyStr,
+		hdrStr,
+		[]byte(pubKey),
+		[]byte("sig"),
+		int(bls.MtBlockBodyAndHeader),
+		0,
+		chainID,
+		nil,
+		nil,
+		nil,
+		currentPid,
+	)
+	cnsMsg.View = view
+
+	return cnsMsg
+}
+
+func TestSubroundBlock_ReceivedBlockBodyAndHeaderWithConsensusViewOnBlsShouldBeRejected(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	sr := *initSubroundBlock(nil, container)
+
+	cnsMsg := createProposalMessage(sr.ConsensusGroup()[0], 0)
+	hdr := &block.Header{Nonce: 1, Reserved: core.EncodeConsensusView(1)}
+	cnsMsg.Header, _ = mock.MarshalizerMock{}.Marshal(hdr)
+	cnsMsg.BlockHeaderHash = mock.HasherMock{}.Compute(string(cnsMsg.Header))
+	r := sr.ReceivedBlockBodyAndHeader(cnsMsg)
+	assert.False(t, r)
+	assert.Nil(t, sr.Header)
+
+	cnsMsg = createProposalMessage(sr.ConsensusGroup()[1], 1)
+	r = sr.ReceivedBlockBodyAndHeader(cnsMsg)
+	assert.False(t, r)
+	assert.Nil(t, sr.Header)
+}
@@ -21,6 +21,7 @@ type subroundEndRound struct {
 	displayStatistics             func()
 	appStatusHandler              core.AppStatusHandler
 	mutProcessingEndRound         sync.Mutex
+	proposalPipeline              ProposalPipeline
 }
 
 // SetAppStatusHandler method set appStatusHandler
@@ -33,6 +34,17 @@ func (sr *subroundEndRound) SetAppStatusHandler(ash core.AppStatusHandler) error
 	return nil
 }
 
+// SetProposalPipeline method sets the pipeline which is notified about the committed blocks
+func (sr *subroundEndRound) SetProposalPipeline(pipeline ProposalPipeline) error {
+	if check.IfNil(pipeline) {
+		return ErrNilProposalPipeline
+	}
+
+	sr.proposalPipeline = pipeline
+
+	return nil
+}
+
 // NewSubroundEndRound creates a subroundEndRound object
 func NewSubroundEndRound(
 	baseSubround *spos.Subround,
@@ -53,6 +65,7 @@ func NewSubroundEndRound(
 		displayStatistics,
 		statusHandler.NewNilStatusHandler(),
 		sync.Mutex{},
+		&disabledProposalPipeline{},
 	}
 	srEndRound.Job = srEndRound.doEndRoundJob
 	srEndRound.Check = srEndRound.doEndRoundConsensusCheck
@@ -244,6 +257,8 @@ func (sr *subroundEndRound) doEndRoundJobByLeader() bool {
 
 	sr.updateMetricsForLeader()
 
+	sr.proposalPipeline.BlockCommitted(sr.Header)
+
 	return true
 }
 
@@ -263,6 +278,7 @@ func (sr *subroundEndRound) createAndBroadcastHeaderFinalInfo() {
 		sr.Header.GetLeaderSignature(),
 		sr.CurrentPid(),
 	)
+	cnsMsg.View = sr.View()
 
 	err := sr.BroadcastMessenger().BroadcastConsensusMessage(cnsMsg)
 	if err != nil {
@@ -354,6 +370,9 @@ func (sr *subroundEndRound) doEndRoundJobByParticipant(cnsDta *consensus.Message
 
 	msg := fmt.Sprintf("Added %s block with nonce  %d  in blockchain", headerTypeMsg, header.GetNonce())
 	log.Debug(display.Headline(msg, sr.SyncTimer().FormattedCurrentTime(), "-"))
+
+	sr.proposalPipeline.BlockCommitted(header)
+
 	return true
 }
 
@@ -417,7 +436,7 @@ func (sr *subroundEndRound) signBlockHeader() ([]byte, error) {
 		return nil, err
 	}
 
-	return sr.SingleSigner().Sign(sr.PrivateKey(), marshalizedHdr)
+	return sr.SigningHandler().SignBlockHeader([]byte(sr.SelfPubKey()), marshalizedHdr)
 }
 
 func (sr *subroundEndRound) updateMetricsForLeader() {
@@ -13,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/blockchain"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -504,14 +505,16 @@ func TestSubroundEndRound_CheckIfSignatureIsFilled(t *testing.T) {
 
 	expectedSignature := []byte("signature")
 	container := mock.InitConsensusCore()
-	singleSigner := &mock.SingleSignerMock{
-		SignStub: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+	signingHandler := &testscommon.SigningHandlerStub{
+		SignBlockHeaderCalled: func(pubKey []byte, marshalizedHeader []byte) ([]byte, error) {
 			var receivedHdr block.Header
-			_ = container.Marshalizer().Unmarshal(&receivedHdr, msg)
+			_ = container.Marshalizer().Unmarshal(&receivedHdr, marshalizedHeader)
+			assert.Equal(t, uint64(5), receivedHdr.Nonce)
+			assert.Equal(t, []byte("A"), pubKey)
 			return expectedSignature, nil
 		},
 	}
-	container.SetSingleSigner(singleSigner)
+	container.SetSigningHandler(signingHandler)
 	bm := &mock.BroadcastMessengerMock{
 		BroadcastBlockCalled: func(handler data.BodyHandler, handler2 data.HeaderHandler) error {
 			return errors.New("error")
@@ -942,3 +945,38 @@ func TestSubroundEndRound_IsBlockHeaderFinalInfoValidShouldReturnTrue(t *testing
 	isValid := sr.IsBlockHeaderFinalInfoValid(cnsDta)
 	assert.True(t, isValid)
 }
+
+func TestSubroundEndRound_SetProposalPipelineNilPipelineShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sr := *initSubroundEndRound()
+
+	err := sr.SetProposalPipeline(nil)
+	assert.Equal(t, bls.ErrNilProposalPipeline, err)
+
+	err = sr.SetProposalPipeline(&mock.ProposalPipelineStub{})
+	assert.Nil(t, err)
+}
+
+func TestSubroundEndRound_DoEndRoundJobByParticipantShouldNotifyTheCommittedBlock(t *testing.T) {
+	t.Parallel()
+
+	hdr := &block.Header{Nonce: 37}
+	sr := *initSubroundEndRound()
+	sr.Header = hdr
+	sr.AddReceivedHeader(hdr)
+	var committedHeader data.HeaderHandler
+	_ = sr.SetProposalPipeline(&mock.ProposalPipelineStub{
+		BlockCommittedCalled: func(header data.HeaderHandler) {
+			committedHeader = header
+		},
+	})
+
+	sr.SetStatus(2, spos.SsFinished)
+	sr.SetStatus(3, spos.SsNotFinished)
+
+	cnsData := consensus.Message{}
+	res := sr.DoEndRoundJobByParticipant(&cnsData)
+	assert.True(t, res)
+	assert.Equal(t, hdr.GetNonce(), committedHeader.GetNonce())
+}
@@ -1,11 +1,13 @@
 package bls
 
 import (
+	"encoding/hex"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 )
 
@@ -72,7 +74,7 @@ func (sr *subroundSignature) doSignatureJob() bool {
 		return false
 	}
 
-	signatureShare, err := sr.MultiSigner().CreateSignatureShare(sr.GetData(), nil)
+	signatureShare, err := sr.createSelfSignatureShare()
 	if err != nil {
 		log.Debug("doSignatureJob.CreateSignatureShare", "error", err.Error())
 		return false
@@ -81,24 +83,7 @@ func (sr *subroundSignature) doSignatureJob() bool {
 	isSelfLeader := sr.IsSelfLeaderInCurrentRound()
 
 	if !isSelfLeader {
-		//TODO: Analyze it is possible to send message only to leader with O(1) instead of O(n)
-		cnsMsg := consensus.NewConsensusMessage(
-			sr.GetData(),
-			signatureShare,
-			nil,
-			nil,
-			[]byte(sr.SelfPubKey()),
-			nil,
-			int(MtSignature),
-			sr.Rounder().Index(),
-			sr.ChainID(),
-			nil,
-			nil,
-			nil,
-			sr.CurrentPid(),
-		)
-
-		err = sr.BroadcastMessenger().BroadcastConsensusMessage(cnsMsg)
+		err = sr.sendSignature(sr.SelfPubKey(), signatureShare)
 		if err != nil {
 			log.Debug("doSignatureJob.BroadcastConsensusMessage", "error", err.Error())
 			return false
@@ -115,6 +100,8 @@ func (sr *subroundSignature) doSignatureJob() bool {
 		return false
 	}
 
+	sr.doManagedKeysSignatureJob(isSelfLeader)
+
 	if isSelfLeader {
 		go sr.waitAllSignatures()
 	}
@@ -122,6 +109,102 @@ func (sr *subroundSignature) doSignatureJob() bool {
 	return true
 }
 
+// createSelfSignatureShare creates the signature share of the public key the node acts for and stores it in the
+// multi signer
+func (sr *subroundSignature) createSelfSignatureShare() ([]byte, error) {
+	selfIndex, err := sr.SelfConsensusGroupIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	signatureShare, err := sr.createSignatureShare(sr.SelfPubKey())
+	if err != nil {
+		return nil, err
+	}
+
+	err = sr.MultiSigner().StoreSignatureShare(uint16(selfIndex), signatureShare)
+	if err != nil {
+		return nil, err
+	}
+
+	return signatureShare, nil
+}
+
+// doManagedKeysSignatureJob signs the consensus data with all the other keys handled by the node which are part of
+// the current consensus group. If the node is the leader, the signature shares are stored directly, otherwise they
+// are sent to the leader
+func (sr *subroundSignature) doManagedKeysSignatureJob(isSelfLeader bool) {
+	for _, pubKey := range sr.ManagedKeysInConsensusGroup() {
+		err := sr.doManagedKeySignatureJob(pubKey, isSelfLeader)
+		if err != nil {
+			log.Debug("doManagedKeysSignatureJob",
+				"public key", core.GetTrimmedPk(hex.EncodeToString([]byte(pubKey))),
+				"error", err.Error())
+		}
+	}
+}
+
+func (sr *subroundSignature) doManagedKeySignatureJob(pubKey string, isSelfLeader bool) error {
+	index, err := sr.ConsensusGroupIndex(pubKey)
+	if err != nil {
+		return err
+	}
+
+	signatureShare, err := sr.createSignatureShare(pubKey)
+	if err != nil {
+		return err
+	}
+
+	if isSelfLeader {
+		err = sr.MultiSigner().StoreSignatureShare(uint16(index), signatureShare)
+	} else {
+		err = sr.sendSignature(pubKey, signatureShare)
+	}
+	if err != nil {
+		return err
+	}
+
+	return sr.SetJobDone(pubKey, sr.Current(), true)
+}
+
+// createSignatureShare creates, through the signing handler, the signature share of the provided public key over the
+// block proposed in the current round
+func (sr *subroundSignature) createSignatureShare(pubKey string) ([]byte, error) {
+	if check.IfNil(sr.Header) {
+		return nil, spos.ErrNilHeader
+	}
+
+	// the header hash is signed along with the header, so that a signing service can check the header's round
+	marshalizedHdr, err := sr.Marshalizer().Marshal(sr.Header)
+	if err != nil {
+		return nil, err
+	}
+
+	return sr.SigningHandler().CreateSignatureShare([]byte(pubKey), sr.GetData(), marshalizedHdr)
+}
+
+func (sr *subroundSignature) sendSignature(pubKey string, signatureShare []byte) error {
+	//TODO: Analyze it is possible to send message only to leader with O(1) instead of O(n)
+	cnsMsg := consensus.NewConsensusMessage(
+		sr.GetData(),
+		signatureShare,
+		nil,
+		nil,
+		[]byte(pubKey),
+		nil,
+		int(MtSignature),
+		sr.Rounder().Index(),
+		sr.ChainID(),
+		nil,
+		nil,
+		nil,
+		sr.CurrentPid(),
+	)
+	cnsMsg.View = sr.View()
+
+	return sr.BroadcastMessenger().BroadcastConsensusMessage(cnsMsg)
+}
+
 // receivedSignature method is called when a signature is received through the signature channel.
 // If the signature is valid, than the jobDone map corresponding to the node which sent it,
 // is set on true for the subround Signature
@@ -7,14 +7,23 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
 func initSubroundSignatureWithContainer(container *mock.ConsensusCoreMock) bls.SubroundSignature {
-	consensusState := initConsensusState()
+	return initSubroundSignatureWithKeysHandler(container, &testscommon.KeysHandlerStub{})
+}
+
+func initSubroundSignatureWithKeysHandler(
+	container *mock.ConsensusCoreMock,
+	keysHandler consensus.KeysHandler,
+) bls.SubroundSignature {
+	consensusState := initConsensusStateWithKeysHandler(keysHandler)
 	ch := make(chan bool, 1)
 
 	sr, _ := spos.NewSubround(
@@ -257,28 +266,37 @@ func TestSubroundSignature_DoSignatureJob(t *testing.T) {
 
 	sr.Data = []byte("X")
 
-	multiSignerMock := mock.InitMultiSignerMock()
+	r = sr.DoSignatureJob()
+	assert.False(t, r)
 
-	err := errors.New("create signature share error")
-	multiSignerMock.CreateSignatureShareMock = func(msg []byte, bitmap []byte) ([]byte, error) {
-		return nil, err
-	}
+	sr.Header = &block.Header{Epoch: 2}
 
-	container.SetMultiSigner(multiSignerMock)
+	err := errors.New("create signature share error")
+	container.SetSigningHandler(&testscommon.SigningHandlerStub{
+		CreateSignatureShareCalled: func(pubKey []byte, headerHash []byte, marshalizedHeader []byte) ([]byte, error) {
+			return nil, err
+		},
+	})
 
 	r = sr.DoSignatureJob()
 	assert.False(t, r)
 
-	multiSignerMock = mock.InitMultiSignerMock()
-
-	multiSignerMock.CreateSignatureShareMock = func(msg []byte, bitmap []byte) ([]byte, error) {
-		return []byte("SIG"), nil
-	}
-	container.SetMultiSigner(multiSignerMock)
+	selfPubKey := sr.SelfPubKey()
+	container.SetSigningHandler(&testscommon.SigningHandlerStub{
+		CreateSignatureShareCalled: func(pubKey []byte, headerHash []byte, marshalizedHeader []byte) ([]byte, error) {
+			receivedHdr := &block.Header{}
+			_ = container.Marshalizer().Unmarshal(receivedHdr, marshalizedHeader)
+			assert.Equal(t, []byte(selfPubKey), pubKey)
+			assert.Equal(t, []byte("X"), headerHash)
+			assert.Equal(t, uint32(2), receivedHdr.Epoch)
+			return []byte("SIG"), nil
+		},
+	})
 
 	r = sr.DoSignatureJob()
 	assert.True(t, r)
 
+	container.SetSigningHandler(&testscommon.SigningHandlerStub{})
 	_ = sr.SetJobDone(sr.SelfPubKey(), bls.SrSignature, false)
 	sr.RoundCanceled = false
 	sr.SetSelfPubKey(sr.ConsensusGroup()[0])
@@ -287,6 +305,91 @@ func TestSubroundSignature_DoSignatureJob(t *testing.T) {
 	assert.False(t, sr.RoundCanceled)
 }
 
+func createManagedKeysHandlerStub(managedKeys ...string) *testscommon.KeysHandlerStub {
+	return &testscommon.KeysHandlerStub{
+		IsKeyManagedCalled: func(pkBytes []byte) bool {
+			for _, pk := range managedKeys {
+				if pk == string(pkBytes) {
+					return true
+				}
+			}
+
+			return false
+		},
+		GetPrivateKeyCalled: func(pkBytes []byte) (crypto.PrivateKey, error) {
+			return &mock.PrivateKeyMock{}, nil
+		},
+	}
+}
+
+func TestSubroundSignature_DoSignatureJobShouldSendSignaturesOfManagedKeys(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	sentPubKeys := make([]string, 0)
+	container.SetBroadcastMessenger(&mock.BroadcastMessengerMock{
+		BroadcastConsensusMessageCalled: func(message *consensus.Message) error {
+			sentPubKeys = append(sentPubKeys, string(message.PubKey))
+			return nil
+		},
+	})
+	container.SetSigningHandler(&testscommon.SigningHandlerStub{
+		CreateSignatureShareCalled: func(pubKey []byte, headerHash []byte, marshalizedHeader []byte) ([]byte, error) {
+			return append([]byte("signature of "), pubKey...), nil
+		},
+	})
+
+	sr := *initSubroundSignatureWithKeysHandler(container, createManagedKeysHandlerStub("C", "D"))
+	sr.Data = []byte("X")
+	sr.Header = &block.Header{}
+
+	r := sr.DoSignatureJob()
+	assert.True(t, r)
+	assert.Equal(t, []string{"B", "C", "D"}, sentPubKeys)
+	for _, pk := range []string{"B", "C", "D"} {
+		isJobDone, _ := sr.JobDone(pk, bls.SrSignature)
+		assert.True(t, isJobDone, pk)
+	}
+}
+
+func TestSubroundSignature_DoSignatureJobAsManagedLeaderShouldStoreSignatures(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	wasBroadcast := false
+	container.SetBroadcastMessenger(&mock.BroadcastMessengerMock{
+		BroadcastConsensusMessageCalled: func(message *consensus.Message) error {
+			wasBroadcast = true
+			return nil
+		},
+	})
+	multiSignerMock := mock.InitMultiSignerMock()
+	container.SetMultiSigner(multiSignerMock)
+	container.SetSigningHandler(&testscommon.SigningHandlerStub{
+		CreateSignatureShareCalled: func(pubKey []byte, headerHash []byte, marshalizedHeader []byte) ([]byte, error) {
+			return append([]byte("signature of "), pubKey...), nil
+		},
+	})
+
+	sr := *initSubroundSignatureWithKeysHandler(container, createManagedKeysHandlerStub("A", "C"))
+	sr.Data = []byte("X")
+	sr.Header = &block.Header{}
+	sr.SetSelfPubKey("A")
+
+	r := sr.DoSignatureJob()
+	assert.True(t, r)
+	assert.False(t, wasBroadcast)
+	for i, pk := range []string{"A", "B", "C"} {
+		isJobDone, _ := sr.JobDone(pk, bls.SrSignature)
+		assert.True(t, isJobDone, pk)
+
+		signatureShare, _ := multiSignerMock.SignatureShare(uint16(i))
+		assert.Equal(t, []byte("signature of "+pk), signatureShare, pk)
+	}
+	isJobDone, _ := sr.JobDone("D", bls.SrSignature)
+	assert.False(t, isJobDone)
+}
+
 func TestSubroundSignature_ReceivedSignature(t *testing.T) {
 	t.Parallel()
 
@@ -20,7 +20,8 @@ type subroundStartRound struct {
 	executeStoredMessages         func()
 	resetConsensusMessages        func()
 
-	indexer indexer.Indexer
+	indexer          indexer.Indexer
+	proposalPipeline ProposalPipeline
 }
 
 // NewSubroundStartRound creates a subroundStartRound object
@@ -44,6 +45,7 @@ func NewSubroundStartRound(
 		executeStoredMessages:         executeStoredMessages,
 		resetConsensusMessages:        resetConsensusMessages,
 		indexer:                       indexer.NewNilIndexer(),
+		proposalPipeline:              &disabledProposalPipeline{},
 	}
 	srStartRound.Job = srStartRound.doStartRoundJob
 	srStartRound.Check = srStartRound.doStartRoundConsensusCheck
@@ -73,6 +75,17 @@ func (sr *subroundStartRound) SetIndexer(indexer indexer.Indexer) {
 	sr.indexer = indexer
 }
 
+// SetProposalPipeline method sets the pipeline which prepares the blocks ahead of their rounds
+func (sr *subroundStartRound) SetProposalPipeline(pipeline ProposalPipeline) error {
+	if check.IfNil(pipeline) {
+		return ErrNilProposalPipeline
+	}
+
+	sr.proposalPipeline = pipeline
+
+	return nil
+}
+
 // doStartRoundJob method does the job of the subround StartRound
 func (sr *subroundStartRound) doStartRoundJob() bool {
 	sr.ResetConsensusState()
@@ -81,6 +94,7 @@ func (sr *subroundStartRound) doStartRoundJob() bool {
 	topic := spos.GetConsensusTopicID(sr.ShardCoordinator())
 	sr.GetAntiFloodHandler().ResetForTopic(topic)
 	sr.resetConsensusMessages()
+	sr.proposalPipeline.RoundStarted(sr.RoundIndex)
 	return true
 }
 
@@ -129,6 +143,10 @@ func (sr *subroundStartRound) initCurrentRound() bool {
 		return false
 	}
 
+	sr.selectSelfPubKey(leader)
+	sr.KeysHandler().ResetRoundBroadcasts()
+	sr.proposalPipeline.LeaderSelected(sr.RoundIndex, leader)
+
 	msg := ""
 	if leader == sr.SelfPubKey() {
 		sr.AppStatusHandler().Increment(core.MetricCountLeader)
@@ -185,6 +203,24 @@ func (sr *subroundStartRound) initCurrentRound() bool {
 	return true
 }
 
+// selectSelfPubKey sets the public key the node acts for in the current round. The leader key has priority if it is
+// handled by the node, followed by the node's own key and then by the first managed key found in the consensus group
+func (sr *subroundStartRound) selectSelfPubKey(leader string) {
+	selfPubKey := sr.NodePubKey()
+	if sr.IsKeyManagedByCurrentNode(leader) {
+		selfPubKey = leader
+	} else if !sr.IsNodeInConsensusGroup(selfPubKey) {
+		for _, pubKey := range sr.ConsensusGroup() {
+			if sr.IsKeyManagedByCurrentNode(pubKey) {
+				selfPubKey = pubKey
+				break
+			}
+		}
+	}
+
+	sr.SetSelfPubKey(selfPubKey)
+}
+
 func (sr *subroundStartRound) indexRoundIfNeeded(pubKeys []string) {
 	if check.IfNil(sr.indexer) {
 		return
@@ -442,3 +442,65 @@ func TestSubroundStartRound_GenerateNextConsensusGroupShouldReturnErr(t *testing
 
 	assert.Equal(t, err, err2)
 }
+
+func TestSubroundStartRound_InitCurrentRoundShouldSelectManagedLeaderAsSelf(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	consensusState := initConsensusStateWithKeysHandler(createManagedKeysHandlerStub("A"))
+	ch := make(chan bool, 1)
+
+	sr, _ := defaultSubround(consensusState, ch, container)
+
+	srStartRound := *defaultWithoutErrorSubroundStartRoundFromSubround(sr)
+
+	r := srStartRound.InitCurrentRound()
+	assert.True(t, r)
+	assert.Equal(t, "A", srStartRound.SelfPubKey())
+	assert.True(t, srStartRound.IsSelfLeaderInCurrentRound())
+}
+
+func TestSubroundStartRound_SetProposalPipelineNilPipelineShouldErr(t *testing.T) {
+	t.Parallel()
+
+	srStartRound := *initSubroundStartRound()
+
+	err := srStartRound.SetProposalPipeline(nil)
+	assert.Equal(t, bls.ErrNilProposalPipeline, err)
+
+	err = srStartRound.SetProposalPipeline(&mock.ProposalPipelineStub{})
+	assert.Nil(t, err)
+}
+
+func TestSubroundStartRound_InitCurrentRoundShouldNotifyTheRoundAndItsLeader(t *testing.T) {
+	t.Parallel()
+
+	bootstrapperMock := &mock.BootstrapperMock{}
+	bootstrapperMock.GetNodeStateCalled = func() core.NodeState {
+		return core.NsSynchronized
+	}
+
+	container := mock.InitConsensusCore()
+	container.SetBootStrapper(bootstrapperMock)
+
+	srStartRound := *initSubroundStartRoundWithContainer(container)
+	startedRound := int64(-1)
+	selectedLeader := ""
+	_ = srStartRound.SetProposalPipeline(&mock.ProposalPipelineStub{
+		RoundStartedCalled: func(round int64) {
+			startedRound = round
+		},
+		LeaderSelectedCalled: func(round int64, leader string) {
+			assert.Equal(t, srStartRound.RoundIndex, round)
+			selectedLeader = leader
+		},
+	})
+
+	r := srStartRound.DoStartRoundJob()
+	assert.True(t, r)
+	assert.Equal(t, srStartRound.RoundIndex, startedRound)
+
+	r = srStartRound.InitCurrentRound()
+	assert.True(t, r)
+	assert.Equal(t, srStartRound.ConsensusGroup()[0], selectedLeader)
+}
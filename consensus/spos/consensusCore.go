@@ -34,6 +34,7 @@ type ConsensusCore struct {
 	peerHonestyHandler            consensus.PeerHonestyHandler
 	headerSigVerifier             consensus.HeaderSigVerifier
 	fallbackHeaderValidator       consensus.FallbackHeaderValidator
+	signingHandler                crypto.SigningHandler
 }
 
 // ConsensusCoreArgs store all arguments that are needed to create a ConsensusCore object
@@ -57,6 +58,7 @@ type ConsensusCoreArgs struct {
 	PeerHonestyHandler            consensus.PeerHonestyHandler
 	HeaderSigVerifier             consensus.HeaderSigVerifier
 	FallbackHeaderValidator       consensus.FallbackHeaderValidator
+	SigningHandler                crypto.SigningHandler
 }
 
 // NewConsensusCore creates a new ConsensusCore instance
@@ -83,6 +85,7 @@ func NewConsensusCore(
 		peerHonestyHandler:            args.PeerHonestyHandler,
 		headerSigVerifier:             args.HeaderSigVerifier,
 		fallbackHeaderValidator:       args.FallbackHeaderValidator,
+		signingHandler:                args.SigningHandler,
 	}
 
 	err := ValidateConsensusCore(consensusCore)
@@ -188,6 +191,11 @@ func (cc *ConsensusCore) FallbackHeaderValidator() consensus.FallbackHeaderValid
 	return cc.fallbackHeaderValidator
 }
 
+// SigningHandler returns the signing handler used for the randomness, the leader's signature and the signature shares
+func (cc *ConsensusCore) SigningHandler() crypto.SigningHandler {
+	return cc.signingHandler
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (cc *ConsensusCore) IsInterfaceNil() bool {
 	return cc == nil
@@ -31,6 +31,7 @@ func createDefaultConsensusCoreArgs() *spos.ConsensusCoreArgs {
 		PeerHonestyHandler:            consensusCoreMock.PeerHonestyHandler(),
 		HeaderSigVerifier:             consensusCoreMock.HeaderSigVerifier(),
 		FallbackHeaderValidator:       consensusCoreMock.FallbackHeaderValidator(),
+		SigningHandler:                consensusCoreMock.SigningHandler(),
 	}
 	return args
 }
@@ -159,6 +160,20 @@ func TestConsensusCore_WithNilBlsSingleSignerShouldFail(t *testing.T) {
 	assert.Equal(t, spos.ErrNilBlsSingleSigner, err)
 }
 
+func TestConsensusCore_WithNilSigningHandlerShouldFail(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultConsensusCoreArgs()
+	args.SigningHandler = nil
+
+	consensusCore, err := spos.NewConsensusCore(
+		args,
+	)
+
+	assert.Nil(t, consensusCore)
+	assert.Equal(t, spos.ErrNilSigningHandler, err)
+}
+
 func TestConsensusCore_WithNilMultiSignerShouldFail(t *testing.T) {
 	t.Parallel()
 
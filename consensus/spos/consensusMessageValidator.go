@@ -73,6 +73,12 @@ func (cmv *consensusMessageValidator) checkConsensusMessageValidity(cnsMsg *cons
 			logger.DisplayByteSlice(cnsMsg.ChainID))
 	}
 
+	if cnsMsg.View > cmv.consensusService.GetMaxView() {
+		return fmt.Errorf("%w : received view from consensus topic is too high: %d",
+			ErrInvalidConsensusView,
+			cnsMsg.View)
+	}
+
 	err := cmv.checkConsensusMessageValidityForMessageType(cnsMsg)
 	if err != nil {
 		return err
@@ -133,7 +139,7 @@ func (cmv *consensusMessageValidator) checkConsensusMessageValidity(cnsMsg *cons
 			cnsMsg.RoundIndex)
 	}
 
-	if cmv.isMessageTypeLimitReached(cnsMsg.PubKey, cnsMsg.RoundIndex, msgType) {
+	if cmv.isMessageTypeLimitReached(cnsMsg.PubKey, cnsMsg.RoundIndex, cnsMsg.View, msgType) {
 		log.Trace("received message type from consensus topic reached the limit",
 			"msg type", cmv.consensusService.GetStringValue(msgType),
 			"public key", cnsMsg.PubKey,
@@ -158,7 +164,7 @@ func (cmv *consensusMessageValidator) checkConsensusMessageValidity(cnsMsg *cons
 			ErrOriginatorMismatch, p2p.PeerIdToShortString(originator), p2p.PeerIdToShortString(cnsMsgOriginator))
 	}
 
-	cmv.addMessageTypeToPublicKey(cnsMsg.PubKey, cnsMsg.RoundIndex, msgType)
+	cmv.addMessageTypeToPublicKey(cnsMsg.PubKey, cnsMsg.RoundIndex, cnsMsg.View, msgType)
 
 	return nil
 }
@@ -197,6 +203,10 @@ func (cmv *consensusMessageValidator) checkConsensusMessageValidityForMessageTyp
 		return cmv.checkMessageWithFinalInfoValidity(cnsMsg)
 	}
 
+	if cmv.consensusService.IsMessageWithViewChange(msgType) {
+		return cmv.checkMessageWithViewChangeValidity(cnsMsg)
+	}
+
 	return fmt.Errorf("%w : received message type from consensus topic is invalid: %d",
 		ErrInvalidMessageType,
 		msgType)
@@ -361,11 +371,46 @@ func (cmv *consensusMessageValidator) checkMessageWithFinalInfoValidity(cnsMsg *
 	return nil
 }
 
-func (cmv *consensusMessageValidator) isMessageTypeLimitReached(pk []byte, round int64, msgType consensus.MessageType) bool {
+func (cmv *consensusMessageValidator) checkMessageWithViewChangeValidity(cnsMsg *consensus.Message) error {
+	isMessageInvalid := cnsMsg.Body != nil ||
+		cnsMsg.Header != nil ||
+		cnsMsg.SignatureShare != nil ||
+		cnsMsg.PubKeysBitmap != nil ||
+		cnsMsg.AggregateSignature != nil ||
+		cnsMsg.LeaderSignature != nil
+
+	if isMessageInvalid {
+		log.Trace("received message from consensus topic is invalid",
+			"body len", len(cnsMsg.Body),
+			"header len", len(cnsMsg.Header),
+			"SignatureShare", cnsMsg.SignatureShare,
+			"PubKeysBitmap", cnsMsg.PubKeysBitmap,
+			"AggregateSignature", cnsMsg.AggregateSignature,
+			"LeaderSignature", cnsMsg.LeaderSignature)
+
+		return fmt.Errorf("%w : received message from public key: %s from consensus topic is invalid",
+			ErrInvalidMessage,
+			logger.DisplayByteSlice(cnsMsg.PubKey))
+	}
+
+	if cnsMsg.View == 0 {
+		return fmt.Errorf("%w : received view change from consensus topic for the first view",
+			ErrInvalidConsensusView)
+	}
+
+	return nil
+}
+
+// the messages are counted for each view of the round, as each view change brings a new leader proposal
+func getMessageTypeLimitKey(pk []byte, round int64, view uint32) string {
+	return fmt.Sprintf("%s_%d_%d", string(pk), round, view)
+}
+
+func (cmv *consensusMessageValidator) isMessageTypeLimitReached(pk []byte, round int64, view uint32, msgType consensus.MessageType) bool {
 	cmv.mutPkConsensusMessages.RLock()
 	defer cmv.mutPkConsensusMessages.RUnlock()
 
-	key := fmt.Sprintf("%s_%d", string(pk), round)
+	key := getMessageTypeLimitKey(pk, round, view)
 
 	mapMsgType, ok := cmv.mapPkConsensusMessages[key]
 	if !ok {
@@ -380,11 +425,11 @@ func (cmv *consensusMessageValidator) isMessageTypeLimitReached(pk []byte, round
 	return numMsgType >= MaxNumOfMessageTypeAccepted
 }
 
-func (cmv *consensusMessageValidator) addMessageTypeToPublicKey(pk []byte, round int64, msgType consensus.MessageType) {
+func (cmv *consensusMessageValidator) addMessageTypeToPublicKey(pk []byte, round int64, view uint32, msgType consensus.MessageType) {
 	cmv.mutPkConsensusMessages.Lock()
 	defer cmv.mutPkConsensusMessages.Unlock()
 
-	key := fmt.Sprintf("%s_%d", string(pk), round)
+	key := getMessageTypeLimitKey(pk, round, view)
 
 	mapMsgType, ok := cmv.mapPkConsensusMessages[key]
 	if !ok {
@@ -607,6 +607,67 @@ func TestIsMessageTypeLimitReached_ShouldWork(t *testing.T) {
 	assert.False(t, cmv.IsMessageTypeLimitReached([]byte("pk1"), 2, bls.MtBlockHeader))
 }
 
+func TestIsMessageTypeLimitReached_ShouldCountEachView(t *testing.T) {
+	t.Parallel()
+
+	consensusMessageValidatorArgs := createDefaultConsensusMessageValidatorArgs()
+	cmv, _ := spos.NewConsensusMessageValidator(consensusMessageValidatorArgs)
+
+	cmv.AddMessageTypeToPublicKeyInView([]byte("pk1"), 1, 0, bls.MtBlockHeader)
+
+	assert.True(t, cmv.IsMessageTypeLimitReachedInView([]byte("pk1"), 1, 0, bls.MtBlockHeader))
+	assert.False(t, cmv.IsMessageTypeLimitReachedInView([]byte("pk1"), 1, 1, bls.MtBlockHeader))
+}
+
+func TestCheckConsensusMessageValidity_ViewNotSupportedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	consensusMessageValidatorArgs := createDefaultConsensusMessageValidatorArgs()
+	cmv, _ := spos.NewConsensusMessageValidator(consensusMessageValidatorArgs)
+
+	cnsMsg := &consensus.Message{
+		ChainID: chainID,
+		MsgType: int64(bls.MtBlockHeader),
+		View:    1,
+	}
+	err := cmv.CheckConsensusMessageValidity(cnsMsg, "")
+	assert.True(t, errors.Is(err, spos.ErrInvalidConsensusView))
+}
+
+func TestCheckMessageWithViewChangeValidity_InvalidMessage(t *testing.T) {
+	t.Parallel()
+
+	consensusMessageValidatorArgs := createDefaultConsensusMessageValidatorArgs()
+	cmv, _ := spos.NewConsensusMessageValidator(consensusMessageValidatorArgs)
+
+	cnsMsg := &consensus.Message{
+		Header: []byte("header"),
+		View:   1,
+	}
+	err := cmv.CheckMessageWithViewChangeValidity(cnsMsg)
+	assert.True(t, errors.Is(err, spos.ErrInvalidMessage))
+}
+
+func TestCheckMessageWithViewChangeValidity_FirstViewShouldErr(t *testing.T) {
+	t.Parallel()
+
+	consensusMessageValidatorArgs := createDefaultConsensusMessageValidatorArgs()
+	cmv, _ := spos.NewConsensusMessageValidator(consensusMessageValidatorArgs)
+
+	err := cmv.CheckMessageWithViewChangeValidity(&consensus.Message{})
+	assert.True(t, errors.Is(err, spos.ErrInvalidConsensusView))
+}
+
+func TestCheckMessageWithViewChangeValidity_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	consensusMessageValidatorArgs := createDefaultConsensusMessageValidatorArgs()
+	cmv, _ := spos.NewConsensusMessageValidator(consensusMessageValidatorArgs)
+
+	err := cmv.CheckMessageWithViewChangeValidity(&consensus.Message{View: 1})
+	assert.Nil(t, err)
+}
+
 func TestAddMessageTypeToPublicKey_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -7,6 +7,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
@@ -33,6 +34,9 @@ type ConsensusState struct {
 	processingBlock    bool
 	mutProcessingBlock sync.RWMutex
 
+	view    uint32
+	mutView sync.RWMutex
+
 	*roundConsensus
 	*roundThreshold
 	*roundStatus
@@ -63,6 +67,7 @@ func (cns *ConsensusState) ResetConsensusState() {
 	cns.Data = nil
 
 	cns.initReceivedHeaders()
+	cns.SetView(0)
 
 	cns.RoundCanceled = false
 	cns.ExtendedCalled = false
@@ -110,7 +115,7 @@ func (cns *ConsensusState) IsSelfLeaderInCurrentRound() bool {
 	return cns.IsNodeLeaderInCurrentRound(cns.selfPubKey)
 }
 
-// GetLeader method gets the leader of the current round
+// GetLeader method gets the leader of the current round, as given by the current view of the round
 func (cns *ConsensusState) GetLeader() (string, error) {
 	if cns.consensusGroup == nil {
 		return "", ErrNilConsensusGroup
@@ -120,7 +125,22 @@ func (cns *ConsensusState) GetLeader() (string, error) {
 		return "", ErrEmptyConsensusGroup
 	}
 
-	return cns.consensusGroup[0], nil
+	return cns.consensusGroup[core.GetConsensusLeaderIndex(cns.View(), len(cns.consensusGroup))], nil
+}
+
+// View returns the number of leader view changes which took place in the current round
+func (cns *ConsensusState) View() uint32 {
+	cns.mutView.RLock()
+	defer cns.mutView.RUnlock()
+
+	return cns.view
+}
+
+// SetView sets the number of leader view changes which took place in the current round
+func (cns *ConsensusState) SetView(view uint32) {
+	cns.mutView.Lock()
+	cns.view = view
+	cns.mutView.Unlock()
 }
 
 // GetNextConsensusGroup gets the new consensus group for the current round based on current eligible list and a random
@@ -155,6 +175,22 @@ func (cns *ConsensusState) GetNextConsensusGroup(
 	return newConsensusGroup, nil
 }
 
+// ManagedKeysInConsensusGroup returns the public keys from the current consensus group, besides the self public key,
+// which are managed by the node
+func (cns *ConsensusState) ManagedKeysInConsensusGroup() []string {
+	managedKeys := make([]string, 0)
+	for _, pubKey := range cns.ConsensusGroup() {
+		if pubKey == cns.SelfPubKey() {
+			continue
+		}
+		if cns.IsKeyManagedByCurrentNode(pubKey) {
+			managedKeys = append(managedKeys, pubKey)
+		}
+	}
+
+	return managedKeys
+}
+
 // IsConsensusDataSet method returns true if the consensus data for the current round is set and false otherwise
 func (cns *ConsensusState) IsConsensusDataSet() bool {
 	isConsensusDataSet := cns.Data != nil
@@ -193,9 +229,10 @@ func (cns *ConsensusState) IsSubroundFinished(subroundID int) bool {
 	return isSubroundFinished
 }
 
-// IsNodeSelf method returns true if the message is received from itself and false otherwise
+// IsNodeSelf method returns true if the message is received from itself, or from one of its managed keys, and false
+// otherwise
 func (cns *ConsensusState) IsNodeSelf(node string) bool {
-	isNodeSelf := node == cns.SelfPubKey()
+	isNodeSelf := node == cns.SelfPubKey() || cns.keysHandler.IsKeyManaged([]byte(node))
 
 	return isNodeSelf
 }
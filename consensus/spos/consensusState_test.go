@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -24,7 +25,9 @@ func internalInitConsensusState() *spos.ConsensusState {
 	rcns := spos.NewRoundConsensus(
 		eligibleNodesPubKeys,
 		3,
-		"2")
+		"2",
+		&testscommon.KeysHandlerStub{},
+	)
 
 	rcns.SetConsensusGroup(eligibleList)
 	rcns.ResetRoundState()
@@ -141,6 +144,34 @@ func TestConsensusState_GetLeaderShouldWork(t *testing.T) {
 	assert.Equal(t, cns.ConsensusGroup()[0], leader)
 }
 
+func TestConsensusState_GetLeaderAfterViewChangeShouldWork(t *testing.T) {
+	t.Parallel()
+
+	cns := internalInitConsensusState()
+	consensusSize := uint32(len(cns.ConsensusGroup()))
+
+	cns.SetView(1)
+	leader, err := cns.GetLeader()
+	assert.Nil(t, err)
+	assert.Equal(t, cns.ConsensusGroup()[1], leader)
+	assert.True(t, cns.IsNodeLeaderInCurrentRound(cns.ConsensusGroup()[1]))
+
+	cns.SetView(consensusSize + 2)
+	leader, err = cns.GetLeader()
+	assert.Nil(t, err)
+	assert.Equal(t, cns.ConsensusGroup()[2], leader)
+}
+
+func TestConsensusState_ResetConsensusStateShouldResetView(t *testing.T) {
+	t.Parallel()
+
+	cns := internalInitConsensusState()
+
+	cns.SetView(2)
+	cns.ResetConsensusState()
+	assert.Equal(t, uint32(0), cns.View())
+}
+
 func TestConsensusState_GetNextConsensusGroupShouldFailWhenComputeValidatorsGroupErr(t *testing.T) {
 	t.Parallel()
 
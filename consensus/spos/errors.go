@@ -208,8 +208,23 @@ var ErrOriginatorMismatch = errors.New("consensus message originator mismatch")
 // ErrNilPeerSignatureHandler signals that a nil peerSignatureHandler object has been provided
 var ErrNilPeerSignatureHandler = errors.New("trying to set nil peerSignatureHandler")
 
+// ErrNilKeysHandler signals that a nil keys handler has been provided
+var ErrNilKeysHandler = errors.New("nil keys handler")
+
+// ErrBroadcastLimitReached signals that the broadcast limit for a public key has been reached in the current round
+var ErrBroadcastLimitReached = errors.New("broadcast limit reached for the public key in the current round")
+
 // ErrMessageTypeLimitReached signals that a consensus message type limit has been reached for a public key
 var ErrMessageTypeLimitReached = errors.New("consensus message type limit has been reached")
 
 // ErrNilFallbackHeaderValidator signals that a nil fallback header validator has been provided
 var ErrNilFallbackHeaderValidator = errors.New("nil fallback header validator")
+
+// ErrNilEquivocationDetector signals that a nil equivocation detector has been provided
+var ErrNilEquivocationDetector = errors.New("nil equivocation detector")
+
+// ErrNilSigningHandler signals that a nil signing handler has been provided
+var ErrNilSigningHandler = errors.New("nil signing handler")
+
+// ErrInvalidConsensusView signals that a consensus message carries an invalid leader view
+var ErrInvalidConsensusView = errors.New("invalid consensus view")
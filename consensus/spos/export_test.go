@@ -1,8 +1,6 @@
 package spos
 
 import (
-	"fmt"
-
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/marshal"
@@ -184,18 +182,30 @@ func (cmv *consensusMessageValidator) IsBlockHeaderHashSizeValid(cnsMsg *consens
 }
 
 func (cmv *consensusMessageValidator) AddMessageTypeToPublicKey(pk []byte, round int64, msgType consensus.MessageType) {
-	cmv.addMessageTypeToPublicKey(pk, round, msgType)
+	cmv.addMessageTypeToPublicKey(pk, round, 0, msgType)
+}
+
+func (cmv *consensusMessageValidator) AddMessageTypeToPublicKeyInView(pk []byte, round int64, view uint32, msgType consensus.MessageType) {
+	cmv.addMessageTypeToPublicKey(pk, round, view, msgType)
 }
 
 func (cmv *consensusMessageValidator) IsMessageTypeLimitReached(pk []byte, round int64, msgType consensus.MessageType) bool {
-	return cmv.isMessageTypeLimitReached(pk, round, msgType)
+	return cmv.isMessageTypeLimitReached(pk, round, 0, msgType)
+}
+
+func (cmv *consensusMessageValidator) IsMessageTypeLimitReachedInView(pk []byte, round int64, view uint32, msgType consensus.MessageType) bool {
+	return cmv.isMessageTypeLimitReached(pk, round, view, msgType)
+}
+
+func (cmv *consensusMessageValidator) CheckMessageWithViewChangeValidity(cnsMsg *consensus.Message) error {
+	return cmv.checkMessageWithViewChangeValidity(cnsMsg)
 }
 
 func (cmv *consensusMessageValidator) GetNumOfMessageTypeForPublicKey(pk []byte, round int64, msgType consensus.MessageType) uint32 {
 	cmv.mutPkConsensusMessages.RLock()
 	defer cmv.mutPkConsensusMessages.RUnlock()
 
-	key := fmt.Sprintf("%s_%d", string(pk), round)
+	key := getMessageTypeLimitKey(pk, round, 0)
 
 	mapMsgType, ok := cmv.mapPkConsensusMessages[key]
 	if !ok {
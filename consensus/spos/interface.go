@@ -54,6 +54,8 @@ type ConsensusCoreHandler interface {
 	HeaderSigVerifier() consensus.HeaderSigVerifier
 	// FallbackHeaderValidator returns the fallback header validator handler which will be used in subrounds
 	FallbackHeaderValidator() consensus.FallbackHeaderValidator
+	// SigningHandler returns the signing handler used for the randomness, the leader's signature and the signature shares
+	SigningHandler() crypto.SigningHandler
 	// IsInterfaceNil returns true if there is no value under the interface
 	IsInterfaceNil() bool
 }
@@ -81,6 +83,8 @@ type ConsensusService interface {
 	IsMessageWithSignature(consensus.MessageType) bool
 	//IsMessageWithFinalInfo returns if the current messageType is about header final info
 	IsMessageWithFinalInfo(consensus.MessageType) bool
+	//IsMessageWithViewChange returns if the current messageType is about a leader view change
+	IsMessageWithViewChange(consensus.MessageType) bool
 	//IsMessageTypeValid returns if the current messageType is valid
 	IsMessageTypeValid(consensus.MessageType) bool
 	//IsSubroundSignature returns if the current subround is about signature
@@ -89,6 +93,8 @@ type ConsensusService interface {
 	IsSubroundStartRound(int) bool
 	// GetMaxMessagesInARoundPerPeer returns the maximum number of messages a peer can send per round
 	GetMaxMessagesInARoundPerPeer() uint32
+	// GetMaxView returns the maximum number of leader view changes allowed in a round
+	GetMaxView() uint32
 	// IsInterfaceNil returns true if there is no value under the interface
 	IsInterfaceNil() bool
 }
@@ -136,6 +142,12 @@ type PoolAdder interface {
 	IsInterfaceNil() bool
 }
 
+// EquivocationDetector defines the component able to detect the consensus messages signed twice by the same key
+type EquivocationDetector interface {
+	ProcessConsensusMessage(cnsMsg *consensus.Message)
+	IsInterfaceNil() bool
+}
+
 // RandSeedVerifier encapsulates methods that are check if header rand seed is correct
 type RandSeedVerifier interface {
 	VerifyRandSeed(header data.HeaderHandler) error
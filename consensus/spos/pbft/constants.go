@@ -0,0 +1,43 @@
+package pbft
+
+import (
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+)
+
+var log = logger.GetOrCreate("consensus/spos/pbft")
+
+const (
+	// SrStartRound defines ID of Subround "Start round"
+	SrStartRound = bls.SrStartRound
+	// SrBlock defines ID of Subround "block"
+	SrBlock = bls.SrBlock
+	// SrSignature defines ID of Subround "signature"
+	SrSignature = bls.SrSignature
+	// SrEndRound defines ID of Subround "End round"
+	SrEndRound = bls.SrEndRound
+)
+
+// MtViewChange defines ID of a message that asks for the leader of the round to be replaced by the leader of the next
+// view, as no block was received from the current one. The other messages are the ones defined by BLS
+const MtViewChange = bls.MtBlockHeaderFinalInfo + 1
+
+// maxView specifies the maximum number of leader view changes allowed in a round
+const maxView = 2
+
+// viewDuration specifies the time, from the total time of the round, each view has to propose a block before the
+// validators ask for a view change
+const viewDuration = 0.2
+
+// blockCreationViewThreshold specifies the max allocated time for creating the block, as a percentage of the view
+// duration, leaving the rest of the view for the block propagation
+const blockCreationViewThreshold = 0.75
+
+// srBlockStartTime specifies the start time, from the total time of the round, of Subround Block
+const srBlockStartTime = 0.05
+
+// srBlockEndTime specifies the end time, from the total time of the round, of Subround Block, holding all the views
+const srBlockEndTime = srBlockStartTime + viewDuration*(maxView+1)
+
+// ViewChangeStringValue represents the string to be used to identify a view change request
+const ViewChangeStringValue = "(VIEW_CHANGE)"
@@ -0,0 +1,147 @@
+package pbft
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+)
+
+const MaxView = maxView
+
+const SrBlockEndTime = srBlockEndTime
+
+// factory
+
+// Factory defines a type for the factory structure
+type Factory *factory
+
+// viewHandler
+
+// NewViewHandler creates the handler of the view changes of the subround Block
+func NewViewHandler(
+	consensusCore spos.ConsensusCoreHandler,
+	consensusState *spos.ConsensusState,
+	consensusStateChangedChannel chan bool,
+	chainID []byte,
+	currentPid core.PeerID,
+) *viewHandler {
+	vh := newViewHandler(consensusCore, consensusState, chainID, currentPid)
+	vh.setConsensusStateChangedChannel(consensusStateChangedChannel)
+
+	return vh
+}
+
+// SetProposeBlock sets the function which proposes the block of the node, without waiting for the views of the round
+func (vh *viewHandler) SetProposeBlock(proposeBlock func() bool) {
+	vh.mutProposeBlock.Lock()
+	vh.proposeBlock = proposeBlock
+	vh.mutProposeBlock.Unlock()
+}
+
+// SetViewChangedHandler sets the function called each time the view of a round changes
+func (vh *viewHandler) SetViewChangedHandler(handler func(round int64)) {
+	vh.setViewChangedHandler(handler)
+}
+
+// RequestViewChange asks for the provided view in the name of the keys handled by the node
+func (vh *viewHandler) RequestViewChange(round int64, view uint32) {
+	vh.requestViewChange(round, view)
+}
+
+// ReceivedViewChange method is called when a view change message is received
+func (vh *viewHandler) ReceivedViewChange(cnsDta *consensus.Message) bool {
+	return vh.receivedViewChange(cnsDta)
+}
+
+// NumViewChanges returns the number of the distinct validators which asked for the provided view in the provided round
+func (vh *viewHandler) NumViewChanges(round int64, view uint32) int {
+	return vh.viewChanges.numViewChanges(round, view)
+}
+
+// GetViewEndTime returns the time, measured from the round start, at which the provided view ends
+func GetViewEndTime(roundDuration time.Duration, view uint32) time.Duration {
+	return getViewEndTime(roundDuration, view)
+}
+
+// GetBlockCreationEndTime returns the time, measured from the round start, until which the leader of the provided
+// view can create its block
+func GetBlockCreationEndTime(roundDuration time.Duration, view uint32) time.Duration {
+	return getBlockCreationEndTime(roundDuration, view)
+}
+
+// proposalPipeline
+
+// NewProposalPipeline creates the pipeline which hands over the block prepared for the next round
+func NewProposalPipeline(
+	consensusCore spos.ConsensusCoreHandler,
+	consensusState *spos.ConsensusState,
+	chainID []byte,
+) *proposalPipeline {
+	return newProposalPipeline(consensusCore, consensusState, chainID)
+}
+
+// PendingProposalRound returns the round of the pending prepared block and false if there is none
+func PendingProposalRound(proposals *proposalPipeline) (int64, bool) {
+	proposals.mut.Lock()
+	defer proposals.mut.Unlock()
+
+	if proposals.pending == nil {
+		return 0, false
+	}
+
+	return proposals.pending.round, true
+}
+
+// AddPreparedProposal adds a block prepared on top of the committed block with the provided hash to the provided
+// pipeline
+func AddPreparedProposal(
+	proposals *proposalPipeline,
+	round int64,
+	prevHash []byte,
+	leader string,
+	header data.HeaderHandler,
+	body data.BodyHandler,
+) bool {
+	proposal := newPreparedProposal(round, time.Time{}, leader, &block.Header{})
+	proposal.setHeader(header)
+	proposal.startBlockCreation(prevHash)
+	proposal.setBlock(header, body)
+
+	return proposals.addInRound(proposal, proposals.consensusCore.Rounder().Index())
+}
+
+// AddTentativeProposal adds a header prepared on top of the provided tentative block to the provided pipeline
+func AddTentativeProposal(
+	proposals *proposalPipeline,
+	round int64,
+	leader string,
+	tentativeHeader data.HeaderHandler,
+	header data.HeaderHandler,
+) bool {
+	proposal := newPreparedProposal(round, time.Time{}, leader, tentativeHeader)
+	proposal.setHeader(header)
+
+	return proposals.addInRound(proposal, proposals.consensusCore.Rounder().Index())
+}
+
+// IsPendingBlockCreationStarted returns true if the creation of the pending prepared block was started
+func IsPendingBlockCreationStarted(proposals *proposalPipeline) bool {
+	proposals.mut.Lock()
+	defer proposals.mut.Unlock()
+
+	return proposals.pending != nil && proposals.pending.isBlockCreationStarted()
+}
+
+// DiscardPending discards the prepared block of the provided pipeline, as done before the bootstrapper syncs a block
+func DiscardPending(proposals *proposalPipeline) {
+	proposals.discardPending()
+}
+
+// ViewChanged discards the block prepared on a tentative block of the provided round, as done on a view change
+func ViewChanged(proposals *proposalPipeline, round int64) {
+	proposals.viewChanged(round)
+}
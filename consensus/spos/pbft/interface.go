@@ -0,0 +1,14 @@
+package pbft
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+)
+
+// subroundsFactory defines the BLS subrounds factory behaviour extended by the PBFT one
+type subroundsFactory interface {
+	SetAppStatusHandler(ash core.AppStatusHandler) error
+	SetIndexer(indexer indexer.Indexer)
+	GenerateSubrounds() error
+	IsInterfaceNil() bool
+}
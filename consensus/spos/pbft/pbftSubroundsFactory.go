@@ -0,0 +1,95 @@
+package pbft
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+// factory creates the BLS subrounds, extended with the block prepared ahead of the round by its leader and with the
+// explicit view change messages which replace an absent leader within the round.
+// The proposal of the next round is prepared on the tentative block of the current round while its signatures are
+// aggregated, and its block body is created as soon as the tentative block is committed
+type factory struct {
+	subroundsFactory
+
+	worker      spos.WorkerHandler
+	viewHandler *viewHandler
+}
+
+// NewSubroundsFactory creates a new consensusState object
+func NewSubroundsFactory(
+	consensusDataContainer spos.ConsensusCoreHandler,
+	consensusState *spos.ConsensusState,
+	worker spos.WorkerHandler,
+	chainID []byte,
+	currentPid core.PeerID,
+) (*factory, error) {
+	blsFactory, err := bls.NewSubroundsFactory(
+		consensusDataContainer,
+		consensusState,
+		worker,
+		chainID,
+		currentPid,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = blsFactory.SetBlockSubroundEndTime(srBlockEndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	pipeline := newProposalPipeline(consensusDataContainer, consensusState, chainID)
+	err = blsFactory.SetProposalPipeline(pipeline)
+	if err != nil {
+		return nil, err
+	}
+	consensusDataContainer.BootStrapper().AddBeforeSyncBlockHandler(pipeline.discardPending)
+
+	views := newViewHandler(consensusDataContainer, consensusState, chainID, currentPid)
+	views.setViewChangedHandler(pipeline.viewChanged)
+	err = blsFactory.SetViewHandler(views)
+	if err != nil {
+		return nil, err
+	}
+
+	fct := factory{
+		subroundsFactory: blsFactory,
+		worker:           worker,
+		viewHandler:      views,
+	}
+
+	return &fct, nil
+}
+
+// SetAppStatusHandler method will update the value of the factory's appStatusHandler
+func (fct *factory) SetAppStatusHandler(ash core.AppStatusHandler) error {
+	err := fct.subroundsFactory.SetAppStatusHandler(ash)
+	if err != nil {
+		return err
+	}
+
+	fct.viewHandler.setAppStatusHandler(ash)
+
+	return nil
+}
+
+// GenerateSubrounds will generate the subrounds used in PBFT Cns
+func (fct *factory) GenerateSubrounds() error {
+	err := fct.subroundsFactory.GenerateSubrounds()
+	if err != nil {
+		return err
+	}
+
+	fct.viewHandler.setConsensusStateChangedChannel(fct.worker.GetConsensusStateChangedChannel())
+	fct.worker.AddReceivedMessageCall(MtViewChange, fct.viewHandler.receivedViewChange)
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fct *factory) IsInterfaceNil() bool {
+	return fct == nil
+}
@@ -0,0 +1,192 @@
+package pbft_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/pbft"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var chainID = []byte("chain ID")
+
+const currentPid = core.PeerID("pid")
+
+const roundTimeDuration = 100 * time.Millisecond
+
+func initRounderMock() *mock.RounderMock {
+	return &mock.RounderMock{
+		RoundIndex: 0,
+		TimeStampCalled: func() time.Time {
+			return time.Unix(0, 0)
+		},
+		TimeDurationCalled: func() time.Duration {
+			return roundTimeDuration
+		},
+	}
+}
+
+func initWorker() spos.WorkerHandler {
+	sposWorker := &mock.SposWorkerMock{}
+	sposWorker.GetConsensusStateChangedChannelsCalled = func() chan bool {
+		return make(chan bool)
+	}
+	sposWorker.RemoveAllReceivedMessagesCallsCalled = func() {}
+
+	sposWorker.AddReceivedMessageCallCalled =
+		func(messageType consensus.MessageType, receivedMessageCall func(cnsDta *consensus.Message) bool) {}
+
+	return sposWorker
+}
+
+func initFactoryWithContainerAndWorker(container *mock.ConsensusCoreMock, worker spos.WorkerHandler) pbft.Factory {
+	consensusState := initConsensusState()
+
+	fct, _ := pbft.NewSubroundsFactory(
+		container,
+		consensusState,
+		worker,
+		chainID,
+		currentPid,
+	)
+
+	return fct
+}
+
+func initFactoryWithContainer(container *mock.ConsensusCoreMock) pbft.Factory {
+	return initFactoryWithContainerAndWorker(container, initWorker())
+}
+
+func initFactory() pbft.Factory {
+	container := mock.InitConsensusCore()
+	return initFactoryWithContainer(container)
+}
+
+func TestFactory_NewFactoryNilContainerShouldFail(t *testing.T) {
+	t.Parallel()
+
+	consensusState := initConsensusState()
+	worker := initWorker()
+
+	fct, err := pbft.NewSubroundsFactory(
+		nil,
+		consensusState,
+		worker,
+		chainID,
+		currentPid,
+	)
+
+	assert.Nil(t, fct)
+	assert.Equal(t, spos.ErrNilConsensusCore, err)
+}
+
+func TestFactory_NewFactoryNilWorkerShouldFail(t *testing.T) {
+	t.Parallel()
+
+	consensusState := initConsensusState()
+	container := mock.InitConsensusCore()
+
+	fct, err := pbft.NewSubroundsFactory(
+		container,
+		consensusState,
+		nil,
+		chainID,
+		currentPid,
+	)
+
+	assert.Nil(t, fct)
+	assert.Equal(t, spos.ErrNilWorker, err)
+}
+
+func TestFactory_NewFactoryShouldWork(t *testing.T) {
+	t.Parallel()
+
+	fct := *initFactory()
+
+	assert.False(t, check.IfNil(&fct))
+}
+
+func TestFactory_NewFactoryShouldDiscardThePreparedBlockBeforeSyncingBlocks(t *testing.T) {
+	t.Parallel()
+
+	var beforeSyncBlockHandler func()
+	container := mock.InitConsensusCore()
+	container.SetBootStrapper(&mock.BootstrapperMock{
+		AddBeforeSyncBlockHandlerCalled: func(handler func()) {
+			beforeSyncBlockHandler = handler
+		},
+	})
+	revertCalled := false
+	container.SetBlockProcessor(&mock.BlockProcessorMock{
+		RevertAccountStateCalled: func(header data.HeaderHandler) {
+			revertCalled = true
+		},
+	})
+
+	_ = initFactoryWithContainer(container)
+	require.NotNil(t, beforeSyncBlockHandler)
+
+	beforeSyncBlockHandler()
+	assert.False(t, revertCalled)
+}
+
+func TestFactory_GenerateSubroundsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	subroundHandlers := make(map[int]consensus.SubroundHandler)
+	chrm := &mock.ChronologyHandlerMock{}
+	chrm.AddSubroundCalled = func(subroundHandler consensus.SubroundHandler) {
+		subroundHandlers[subroundHandler.Current()] = subroundHandler
+	}
+	container := mock.InitConsensusCore()
+	container.SetChronology(chrm)
+	container.SetRounder(initRounderMock())
+
+	messageTypes := make([]consensus.MessageType, 0)
+	worker := initWorker()
+	worker.(*mock.SposWorkerMock).AddReceivedMessageCallCalled =
+		func(messageType consensus.MessageType, receivedMessageCall func(cnsDta *consensus.Message) bool) {
+			messageTypes = append(messageTypes, messageType)
+		}
+	fct := *initFactoryWithContainerAndWorker(container, worker)
+
+	err := fct.GenerateSubrounds()
+
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(subroundHandlers))
+	assert.Contains(t, messageTypes, pbft.MtViewChange)
+
+	// the subround Block holds all the views of the round
+	blockEndTime := int64(float64(roundTimeDuration) * pbft.SrBlockEndTime)
+	assert.Equal(t, blockEndTime, subroundHandlers[pbft.SrBlock].EndTime())
+	assert.Equal(t, blockEndTime, subroundHandlers[pbft.SrSignature].StartTime())
+}
+
+func TestFactory_SetAppStatusHandlerNilStatusHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	fct := *initFactoryWithContainer(container)
+
+	err := fct.SetAppStatusHandler(nil)
+	assert.Equal(t, spos.ErrNilAppStatusHandler, err)
+}
+
+func TestFactory_SetAppStatusHandlerOkStatusHandlerShouldWork(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	fct := *initFactoryWithContainer(container)
+
+	ash := &mock.AppStatusHandlerMock{}
+	err := fct.SetAppStatusHandler(ash)
+
+	assert.Nil(t, err)
+}
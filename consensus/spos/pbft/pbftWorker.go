@@ -0,0 +1,96 @@
+package pbft
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+)
+
+// peerMaxMessagesPerSec defines how many messages can be propagated by a pid in a round. The value was chosen by
+// following the next premises:
+// 1. a leader can propagate as maximum as 3 messages per round: proposed header block + proposed body + final info;
+// 2. due to the fact that a delayed signature of the proposer (from previous round) can be received in the current round
+//    adds an extra 1 to the total value, reaching value 4;
+// 3. Because the leader might be selected in the next round and might have an empty data pool, it can send the newly
+//    empty proposed block at the very beginning of the next round. One extra message here, yielding to a total of 5.
+// 4. If we consider the forks that can appear on the system wee need to add one more to the value.
+// 5. Each validator can ask once for each view change and the leader of a later view proposes its own block, adding
+//    two messages for each view change allowed in a round.
+// Validators only send one signature message in a round, treating the edge case of a delayed message, will need at most
+// 2 messages per round, plus the view changes (which is ok as it is below the set value)
+const peerMaxMessagesPerSec = uint32(6 + 2*maxView)
+
+// worker extends the BLS consensus service with the view change messages
+type worker struct {
+	spos.ConsensusService
+}
+
+// NewConsensusService creates a new worker object
+func NewConsensusService() (*worker, error) {
+	blsWorker, err := bls.NewConsensusService()
+	if err != nil {
+		return nil, err
+	}
+
+	wrk := worker{
+		ConsensusService: blsWorker,
+	}
+
+	return &wrk, nil
+}
+
+//InitReceivedMessages initializes the MessagesType map for all messages for the current ConsensusService
+func (wrk *worker) InitReceivedMessages() map[consensus.MessageType][]*consensus.Message {
+	receivedMessages := wrk.ConsensusService.InitReceivedMessages()
+	receivedMessages[MtViewChange] = make([]*consensus.Message, 0)
+
+	return receivedMessages
+}
+
+// GetMaxMessagesInARoundPerPeer returns the maximum number of messages a peer can send per round for PBFT
+func (wrk *worker) GetMaxMessagesInARoundPerPeer() uint32 {
+	return peerMaxMessagesPerSec
+}
+
+// GetMaxView returns the maximum number of leader view changes allowed in a round
+func (wrk *worker) GetMaxView() uint32 {
+	return maxView
+}
+
+//GetStringValue gets the name of the messageType
+func (wrk *worker) GetStringValue(messageType consensus.MessageType) string {
+	if messageType == MtViewChange {
+		return ViewChangeStringValue
+	}
+
+	return wrk.ConsensusService.GetStringValue(messageType)
+}
+
+//IsMessageWithViewChange returns if the current messageType is about a leader view change
+func (wrk *worker) IsMessageWithViewChange(msgType consensus.MessageType) bool {
+	return msgType == MtViewChange
+}
+
+//IsMessageTypeValid returns if the current messageType is valid
+func (wrk *worker) IsMessageTypeValid(msgType consensus.MessageType) bool {
+	return wrk.ConsensusService.IsMessageTypeValid(msgType) || msgType == MtViewChange
+}
+
+//GetMessageRange provides the MessageType range used in checks by the consensus
+func (wrk *worker) GetMessageRange() []consensus.MessageType {
+	return append(wrk.ConsensusService.GetMessageRange(), MtViewChange)
+}
+
+//CanProceed returns if the current messageType can proceed further if previous subrounds finished
+func (wrk *worker) CanProceed(consensusState *spos.ConsensusState, msgType consensus.MessageType) bool {
+	if msgType == MtViewChange {
+		return consensusState.Status(SrStartRound) == spos.SsFinished
+	}
+
+	return wrk.ConsensusService.CanProceed(consensusState, msgType)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (wrk *worker) IsInterfaceNil() bool {
+	return wrk == nil
+}
@@ -0,0 +1,196 @@
+package pbft_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/pbft"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+)
+
+func createEligibleList(size int) []string {
+	eligibleList := make([]string, 0)
+	for i := 0; i < size; i++ {
+		eligibleList = append(eligibleList, string([]byte{byte(i + 65)}))
+	}
+	return eligibleList
+}
+
+func initConsensusState() *spos.ConsensusState {
+	return initConsensusStateWithKeysHandler(&testscommon.KeysHandlerStub{})
+}
+
+func initConsensusStateWithKeysHandler(keysHandler consensus.KeysHandler) *spos.ConsensusState {
+	consensusGroupSize := 9
+	eligibleList := createEligibleList(consensusGroupSize)
+
+	eligibleNodesPubKeys := make(map[string]struct{})
+	for _, key := range eligibleList {
+		eligibleNodesPubKeys[key] = struct{}{}
+	}
+
+	indexLeader := 1
+	rcns := spos.NewRoundConsensus(
+		eligibleNodesPubKeys,
+		consensusGroupSize,
+		eligibleList[indexLeader],
+		keysHandler,
+	)
+
+	rcns.SetConsensusGroup(eligibleList)
+	rcns.ResetRoundState()
+
+	pBFTThreshold := consensusGroupSize*2/3 + 1
+	pBFTFallbackThreshold := consensusGroupSize*1/2 + 1
+
+	rthr := spos.NewRoundThreshold()
+	rthr.SetThreshold(1, 1)
+	rthr.SetThreshold(2, pBFTThreshold)
+	rthr.SetFallbackThreshold(1, 1)
+	rthr.SetFallbackThreshold(2, pBFTFallbackThreshold)
+
+	rstatus := spos.NewRoundStatus()
+	rstatus.ResetRoundStatus()
+
+	cns := spos.NewConsensusState(
+		rcns,
+		rthr,
+		rstatus,
+	)
+
+	cns.Data = []byte("X")
+	cns.RoundIndex = 0
+	return cns
+}
+
+func TestWorker_NewConsensusServiceShouldWork(t *testing.T) {
+	t.Parallel()
+
+	service, err := pbft.NewConsensusService()
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(service))
+}
+
+func TestWorker_InitReceivedMessagesShouldAddViewChanges(t *testing.T) {
+	t.Parallel()
+
+	blsService, _ := bls.NewConsensusService()
+	pbftService, _ := pbft.NewConsensusService()
+	messages := pbftService.InitReceivedMessages()
+
+	assert.Equal(t, len(blsService.InitReceivedMessages())+1, len(messages))
+	assert.NotNil(t, messages[bls.MtBlockBodyAndHeader])
+	assert.NotNil(t, messages[pbft.MtViewChange])
+}
+
+func TestWorker_GetMessageRangeShouldAddViewChanges(t *testing.T) {
+	t.Parallel()
+
+	pbftService, _ := pbft.NewConsensusService()
+
+	v := make([]consensus.MessageType, 0)
+	for i := bls.MtBlockBodyAndHeader; i <= pbft.MtViewChange; i++ {
+		v = append(v, i)
+	}
+
+	assert.Equal(t, v, pbftService.GetMessageRange())
+}
+
+func TestWorker_CanProceedWithSrStartRoundFinishedForMtViewChangeShouldWork(t *testing.T) {
+	t.Parallel()
+
+	pbftService, _ := pbft.NewConsensusService()
+
+	consensusState := initConsensusState()
+	consensusState.SetStatus(pbft.SrStartRound, spos.SsFinished)
+
+	canProceed := pbftService.CanProceed(consensusState, pbft.MtViewChange)
+	assert.True(t, canProceed)
+}
+
+func TestWorker_CanProceedWithSrStartRoundNotFinishedForMtViewChangeShouldNotWork(t *testing.T) {
+	t.Parallel()
+
+	pbftService, _ := pbft.NewConsensusService()
+
+	consensusState := initConsensusState()
+	consensusState.SetStatus(pbft.SrStartRound, spos.SsNotFinished)
+
+	canProceed := pbftService.CanProceed(consensusState, pbft.MtViewChange)
+	assert.False(t, canProceed)
+}
+
+func TestWorker_CanProceedForBlsMessagesShouldUseTheBlsRules(t *testing.T) {
+	t.Parallel()
+
+	pbftService, _ := pbft.NewConsensusService()
+
+	consensusState := initConsensusState()
+	consensusState.SetStatus(pbft.SrBlock, spos.SsFinished)
+	assert.True(t, pbftService.CanProceed(consensusState, bls.MtSignature))
+
+	consensusState.SetStatus(pbft.SrBlock, spos.SsNotFinished)
+	assert.False(t, pbftService.CanProceed(consensusState, bls.MtSignature))
+	assert.False(t, pbftService.CanProceed(consensusState, -1))
+}
+
+func TestWorker_GetStringValue(t *testing.T) {
+	t.Parallel()
+
+	service, _ := pbft.NewConsensusService()
+
+	r := service.GetStringValue(pbft.MtViewChange)
+	assert.Equal(t, pbft.ViewChangeStringValue, r)
+	r = service.GetStringValue(bls.MtBlockBodyAndHeader)
+	assert.Equal(t, bls.BlockBodyAndHeaderStringValue, r)
+	r = service.GetStringValue(-1)
+	assert.Equal(t, bls.BlockDefaultStringValue, r)
+}
+
+func TestWorker_IsMessageWithViewChange(t *testing.T) {
+	t.Parallel()
+
+	service, _ := pbft.NewConsensusService()
+
+	ret := service.IsMessageWithViewChange(bls.MtBlockBodyAndHeader)
+	assert.False(t, ret)
+
+	ret = service.IsMessageWithViewChange(pbft.MtViewChange)
+	assert.True(t, ret)
+}
+
+func TestWorker_GetMaxView(t *testing.T) {
+	t.Parallel()
+
+	service, _ := pbft.NewConsensusService()
+
+	assert.Equal(t, uint32(pbft.MaxView), service.GetMaxView())
+}
+
+func TestWorker_GetMaxMessagesInARoundPerPeerShouldCountTheViewChanges(t *testing.T) {
+	t.Parallel()
+
+	blsService, _ := bls.NewConsensusService()
+	pbftService, _ := pbft.NewConsensusService()
+
+	assert.Equal(t, blsService.GetMaxMessagesInARoundPerPeer()+2*pbft.MaxView, pbftService.GetMaxMessagesInARoundPerPeer())
+}
+
+func TestWorker_IsMessageTypeValid(t *testing.T) {
+	t.Parallel()
+
+	service, _ := pbft.NewConsensusService()
+
+	ret := service.IsMessageTypeValid(bls.MtBlockBody)
+	assert.True(t, ret)
+
+	ret = service.IsMessageTypeValid(pbft.MtViewChange)
+	assert.True(t, ret)
+
+	ret = service.IsMessageTypeValid(666)
+	assert.False(t, ret)
+}
@@ -0,0 +1,430 @@
+package pbft
+
+import (
+	"bytes"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// preparedProposal holds a block prepared by the node ahead of the round in which it will be proposed. The header is
+// prepared on top of the tentative block of the current round, while the signatures of that block are aggregated, and
+// the block is created once the tentative block is committed
+type preparedProposal struct {
+	round          int64
+	roundTimeStamp time.Time
+	leader         string
+	parentNonce    uint64
+	parentRound    uint64
+	parentRandSeed []byte
+	parentPrevHash []byte
+	prevHash       []byte
+	header         data.HeaderHandler
+	body           data.BodyHandler
+	headerPrepared chan struct{}
+	blockCreated   chan struct{}
+}
+
+func newPreparedProposal(
+	round int64,
+	roundTimeStamp time.Time,
+	leader string,
+	parent data.HeaderHandler,
+) *preparedProposal {
+	return &preparedProposal{
+		round:          round,
+		roundTimeStamp: roundTimeStamp,
+		leader:         leader,
+		parentNonce:    parent.GetNonce(),
+		parentRound:    parent.GetRound(),
+		parentRandSeed: parent.GetRandSeed(),
+		parentPrevHash: parent.GetPrevHash(),
+		headerPrepared: make(chan struct{}),
+	}
+}
+
+// setHeader sets the prepared header, if any, and marks the header preparation as finished
+func (pp *preparedProposal) setHeader(header data.HeaderHandler) {
+	pp.header = header
+	close(pp.headerPrepared)
+}
+
+// waitHeaderPreparation blocks until the header preparation is finished
+func (pp *preparedProposal) waitHeaderPreparation() {
+	<-pp.headerPrepared
+}
+
+// startBlockCreation records the hash of the committed parent block. It should be called under the pipeline mutex
+func (pp *preparedProposal) startBlockCreation(prevHash []byte) {
+	pp.prevHash = prevHash
+	pp.blockCreated = make(chan struct{})
+}
+
+// isBlockCreationStarted returns true if the parent block was committed, so the block creation has started
+func (pp *preparedProposal) isBlockCreationStarted() bool {
+	return pp.blockCreated != nil
+}
+
+// setBlock sets the created block, if any, and marks the block creation as finished
+func (pp *preparedProposal) setBlock(header data.HeaderHandler, body data.BodyHandler) {
+	pp.header = header
+	pp.body = body
+	close(pp.blockCreated)
+}
+
+// waitBlockCreation blocks until the block creation is finished
+func (pp *preparedProposal) waitBlockCreation() {
+	<-pp.blockCreated
+}
+
+// discard waits for the preparation to finish and reverts the accounts state changed by the block creation, if it
+// was started. A proposal whose parent block was not committed only holds its header, and must not revert the
+// accounts state, which might hold the changes of the tentative parent block
+func (pp *preparedProposal) discard(blockProcessor process.BlockProcessor) {
+	if pp == nil {
+		return
+	}
+
+	pp.waitHeaderPreparation()
+	if !pp.isBlockCreationStarted() {
+		log.Debug("the block prepared on the tentative block has been discarded",
+			"round", pp.round,
+			"tentative block round", pp.parentRound)
+		return
+	}
+
+	pp.waitBlockCreation()
+	blockProcessor.RevertAccountState(pp.header)
+
+	log.Debug("the block prepared in the previous round has been discarded",
+		"round", pp.round)
+}
+
+// isCreated returns true if the block was created successfully
+func (pp *preparedProposal) isCreated() bool {
+	return pp.header != nil && pp.body != nil
+}
+
+// isPreparedOn returns true if the proposal was prepared on top of the provided block
+func (pp *preparedProposal) isPreparedOn(header data.HeaderHandler) bool {
+	return pp.parentNonce == header.GetNonce() &&
+		pp.parentRound == header.GetRound() &&
+		bytes.Equal(pp.parentRandSeed, header.GetRandSeed()) &&
+		bytes.Equal(pp.parentPrevHash, header.GetPrevHash())
+}
+
+// isPreparedFor returns true if the block was prepared for the provided round, by the provided leader, on top of the
+// block with the provided hash
+func (pp *preparedProposal) isPreparedFor(round int64, prevHash []byte, leader string) bool {
+	return pp.round == round &&
+		pp.leader == leader &&
+		bytes.Equal(pp.prevHash, prevHash)
+}
+
+// isUsableFor returns true if the block was created successfully and it can be proposed in the provided round, by
+// the provided leader, on top of the block with the provided hash
+func (pp *preparedProposal) isUsableFor(round int64, prevHash []byte, leader string) bool {
+	return pp.isCreated() && pp.isPreparedFor(round, prevHash, leader)
+}
+
+// proposalPipeline prepares the proposal of the next round while the signatures of the block of the current round are
+// aggregated, if the first leader of the next round is handled by the node, and hands it over to the subround Block of
+// the next round. As soon as the block of the current round is processed, the next leader is selected and the next
+// header is prepared and signed on top of this tentative block. Once the tentative block is committed, the previous
+// hash is set and the block body is created, as the block processor holds a single accounts state, which can not hold
+// the changes of two uncommitted blocks. At most one prepared block can be pending. It is discarded on a view change,
+// when its tentative block is reverted or not committed, and before the bootstrapper processes any block
+type proposalPipeline struct {
+	consensusCore  spos.ConsensusCoreHandler
+	consensusState *spos.ConsensusState
+	chainID        []byte
+
+	mut     sync.Mutex
+	pending *preparedProposal
+}
+
+func newProposalPipeline(
+	consensusCore spos.ConsensusCoreHandler,
+	consensusState *spos.ConsensusState,
+	chainID []byte,
+) *proposalPipeline {
+	return &proposalPipeline{
+		consensusCore:  consensusCore,
+		consensusState: consensusState,
+		chainID:        chainID,
+	}
+}
+
+// RoundStarted discards the prepared block if it was not prepared for the provided round or if its tentative parent
+// block was not committed, as the tentative block is reverted at the end of the round in this case
+func (pp *proposalPipeline) RoundStarted(round int64) {
+	pp.extractIfNotForRound(round).discard(pp.consensusCore.BlockProcessor())
+}
+
+// LeaderSelected discards the prepared block if it was not created by the provided leader on top of the last committed
+// block. It is called before any received block is processed, as the accounts state is changed by the prepared block
+func (pp *proposalPipeline) LeaderSelected(round int64, leader string) {
+	prevHash := getCurrentBlockHash(pp.consensusCore.Blockchain())
+	pp.extractIfNotPreparedFor(round, prevHash, leader).discard(pp.consensusCore.BlockProcessor())
+}
+
+// discardPending discards the prepared block, waiting for its creation to finish. It is called by the bootstrapper
+// before it processes any block, as the node is not synchronized and the prepared block can not be proposed anymore
+func (pp *proposalPipeline) discardPending() {
+	pp.extract().discard(pp.consensusCore.BlockProcessor())
+}
+
+// viewChanged discards the block prepared on a tentative block of the provided round, as the tentative block was
+// proposed in a previous view
+func (pp *proposalPipeline) viewChanged(round int64) {
+	pp.extractIfPreparedInRound(round).discard(pp.consensusCore.BlockProcessor())
+}
+
+// GetPreparedBlock returns the block prepared for the provided round and leader, on top of the last committed block.
+// A prepared block which can not be used is discarded
+func (pp *proposalPipeline) GetPreparedBlock(round int64, leader string) (data.HeaderHandler, data.BodyHandler, bool) {
+	proposal := pp.extract()
+	if proposal == nil {
+		return nil, nil, false
+	}
+	if !proposal.isBlockCreationStarted() {
+		proposal.discard(pp.consensusCore.BlockProcessor())
+		return nil, nil, false
+	}
+
+	proposal.waitBlockCreation()
+
+	prevHash := getCurrentBlockHash(pp.consensusCore.Blockchain())
+	if !proposal.isUsableFor(round, prevHash, leader) {
+		proposal.discard(pp.consensusCore.BlockProcessor())
+		return nil, nil, false
+	}
+
+	return proposal.header, proposal.body, true
+}
+
+// BlockProcessed starts preparing the proposal of the next round on top of the provided tentative block, if the first
+// leader of the next round is handled by the node. It is called as soon as the block of the current round is processed,
+// so the header of the next round is prepared while the signatures of the tentative block are aggregated
+func (pp *proposalPipeline) BlockProcessed(tentativeHeader data.HeaderHandler) {
+	// the consensus group of the next round can not be known before the start of epoch block is fully processed
+	if tentativeHeader.IsStartOfEpochBlock() {
+		return
+	}
+
+	rounder := pp.consensusCore.Rounder()
+	currentRound := rounder.Index()
+	if currentRound != int64(tentativeHeader.GetRound()) {
+		return
+	}
+
+	nextRound := currentRound + 1
+	nextConsensusGroup, err := pp.consensusState.GetNextConsensusGroup(
+		tentativeHeader.GetRandSeed(),
+		uint64(nextRound),
+		pp.consensusCore.ShardCoordinator().SelfId(),
+		pp.consensusCore.NodesCoordinator(),
+		tentativeHeader.GetEpoch(),
+	)
+	if err != nil {
+		log.Debug("BlockProcessed.GetNextConsensusGroup", "error", err.Error())
+		return
+	}
+	if len(nextConsensusGroup) == 0 {
+		return
+	}
+
+	leader := nextConsensusGroup[core.GetConsensusLeaderIndex(0, len(nextConsensusGroup))]
+	if !pp.consensusState.IsKeyManagedByCurrentNode(leader) {
+		return
+	}
+
+	nextRoundTimeStamp := rounder.TimeStamp().Add(rounder.TimeDuration())
+	proposal := newPreparedProposal(nextRound, nextRoundTimeStamp, leader, tentativeHeader)
+	if !pp.addInRound(proposal, currentRound) {
+		return
+	}
+
+	go pp.prepareNextHeader(proposal)
+}
+
+func (pp *proposalPipeline) prepareNextHeader(proposal *preparedProposal) {
+	var header data.HeaderHandler
+	defer func() {
+		proposal.setHeader(header)
+	}()
+
+	newHeader, err := bls.CreateHeaderOnTopOf(
+		pp.consensusCore,
+		pp.chainID,
+		proposal.round,
+		proposal.roundTimeStamp,
+		proposal.leader,
+		proposal.parentNonce+1,
+		nil,
+		proposal.parentRandSeed,
+	)
+	if err != nil {
+		log.Debug("prepareNextHeader.CreateHeaderOnTopOf", "error", err.Error())
+		return
+	}
+
+	header = newHeader
+
+	log.Debug("step 2: the header of the next round has been prepared on the tentative block",
+		"round", proposal.round,
+		"nonce", header.GetNonce())
+}
+
+// BlockCommitted starts creating the block prepared on top of the provided block, as soon as it is committed, so that
+// the block can be proposed as soon as the next round starts, instead of being created during the subround Block.
+// A block prepared on top of another tentative block is discarded
+func (pp *proposalPipeline) BlockCommitted(committedHeader data.HeaderHandler) {
+	prevHash := pp.consensusCore.Blockchain().GetCurrentBlockHeaderHash()
+	proposal, isPreparedOnCommitted := pp.startBlockCreation(committedHeader, prevHash)
+	if !isPreparedOnCommitted {
+		proposal.discard(pp.consensusCore.BlockProcessor())
+		return
+	}
+
+	go pp.createNextProposal(proposal)
+}
+
+func (pp *proposalPipeline) createNextProposal(proposal *preparedProposal) {
+	var header data.HeaderHandler
+	var body data.BodyHandler
+	defer func() {
+		proposal.setBlock(header, body)
+	}()
+
+	proposal.waitHeaderPreparation()
+	if check.IfNil(proposal.header) {
+		return
+	}
+
+	proposal.header.SetPrevHash(proposal.prevHash)
+
+	rounder := pp.consensusCore.Rounder()
+	maxTime := getBlockCreationEndTime(rounder.TimeDuration(), 0)
+	haveTimeInNextRoundFirstView := func() bool {
+		return rounder.RemainingTime(proposal.roundTimeStamp, maxTime) > 0
+	}
+
+	var err error
+	header, body, err = pp.consensusCore.BlockProcessor().CreateBlock(proposal.header, haveTimeInNextRoundFirstView)
+	if err != nil {
+		log.Debug("createNextProposal.CreateBlock", "error", err.Error())
+		header, body = nil, nil
+		return
+	}
+
+	log.Debug("step 3: the block of the next round has been prepared",
+		"round", proposal.round,
+		"nonce", header.GetNonce())
+}
+
+// startBlockCreation marks the start of the block creation of the pending proposal, if it was prepared on top of the
+// provided committed block, and returns it. Otherwise, the pending proposal is removed and returned along with false
+func (pp *proposalPipeline) startBlockCreation(committedHeader data.HeaderHandler, prevHash []byte) (*preparedProposal, bool) {
+	pp.mut.Lock()
+	defer pp.mut.Unlock()
+
+	proposal := pp.pending
+	if proposal == nil {
+		return nil, false
+	}
+	if proposal.isBlockCreationStarted() || !proposal.isPreparedOn(committedHeader) {
+		pp.pending = nil
+		return proposal, false
+	}
+
+	proposal.startBlockCreation(prevHash)
+
+	return proposal, true
+}
+
+// addInRound sets the provided proposal as the pending one and returns false if another one is already pending or if
+// the provided round has ended. The round is checked under the same lock as the one taken by discardPending, so that
+// a bootstrapper which started syncing blocks in the next round either finds the proposal, or prevents it
+func (pp *proposalPipeline) addInRound(proposal *preparedProposal, round int64) bool {
+	pp.mut.Lock()
+	defer pp.mut.Unlock()
+
+	if pp.pending != nil || pp.consensusCore.Rounder().Index() != round {
+		return false
+	}
+
+	pp.pending = proposal
+
+	return true
+}
+
+// extract removes and returns the pending proposal, or nil if there is none
+func (pp *proposalPipeline) extract() *preparedProposal {
+	pp.mut.Lock()
+	defer pp.mut.Unlock()
+
+	proposal := pp.pending
+	pp.pending = nil
+
+	return proposal
+}
+
+// extractIfNotForRound removes and returns the pending proposal only if it was prepared for another round or if the
+// creation of its block was not started
+func (pp *proposalPipeline) extractIfNotForRound(round int64) *preparedProposal {
+	pp.mut.Lock()
+	defer pp.mut.Unlock()
+
+	proposal := pp.pending
+	if proposal == nil || (proposal.round == round && proposal.isBlockCreationStarted()) {
+		return nil
+	}
+
+	pp.pending = nil
+
+	return proposal
+}
+
+// extractIfNotPreparedFor removes and returns the pending proposal only if it was not prepared for the provided
+// round, by the provided leader, on top of the block with the provided hash
+func (pp *proposalPipeline) extractIfNotPreparedFor(round int64, prevHash []byte, leader string) *preparedProposal {
+	pp.mut.Lock()
+	defer pp.mut.Unlock()
+
+	proposal := pp.pending
+	if proposal == nil || proposal.isPreparedFor(round, prevHash, leader) {
+		return nil
+	}
+
+	pp.pending = nil
+
+	return proposal
+}
+
+// extractIfPreparedInRound removes and returns the pending proposal only if it was prepared on top of a tentative
+// block of the provided round
+func (pp *proposalPipeline) extractIfPreparedInRound(round int64) *preparedProposal {
+	pp.mut.Lock()
+	defer pp.mut.Unlock()
+
+	proposal := pp.pending
+	if proposal == nil || int64(proposal.parentRound) != round {
+		return nil
+	}
+
+	pp.pending = nil
+
+	return proposal
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pp *proposalPipeline) IsInterfaceNil() bool {
+	return pp == nil
+}
@@ -0,0 +1,427 @@
+package pbft_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/pbft"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func initBlockchainForProposalPipeline(container *mock.ConsensusCoreMock) {
+	container.SetBlockchain(&mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{Nonce: 1, Round: 1}
+		},
+		GetCurrentBlockHeaderHashCalled: func() []byte {
+			return []byte("current block hash")
+		},
+	})
+}
+
+func TestProposalPipeline_AddShouldKeepOnlyOnePendingProposal(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	initBlockchainForProposalPipeline(container)
+	pp := pbft.NewProposalPipeline(container, initConsensusState(), chainID)
+
+	assert.True(t, pbft.AddPreparedProposal(pp, 1, []byte("hash"), "A", &block.Header{}, &block.Body{}))
+	assert.False(t, pbft.AddPreparedProposal(pp, 2, []byte("hash"), "A", &block.Header{}, &block.Body{}))
+
+	round, isPending := pbft.PendingProposalRound(pp)
+	assert.True(t, isPending)
+	assert.Equal(t, int64(1), round)
+}
+
+func TestProposalPipeline_RoundStartedShouldDiscardTheProposalOfAnotherRound(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	revertCalled := false
+	container.SetBlockProcessor(&mock.BlockProcessorMock{
+		RevertAccountStateCalled: func(header data.HeaderHandler) {
+			revertCalled = true
+		},
+	})
+	initBlockchainForProposalPipeline(container)
+	pp := pbft.NewProposalPipeline(container, initConsensusState(), chainID)
+	_ = pbft.AddPreparedProposal(pp, 2, []byte("current block hash"), "A", &block.Header{}, &block.Body{})
+
+	pp.RoundStarted(2)
+	_, isPending := pbft.PendingProposalRound(pp)
+	assert.True(t, isPending)
+	assert.False(t, revertCalled)
+
+	pp.RoundStarted(3)
+	_, isPending = pbft.PendingProposalRound(pp)
+	assert.False(t, isPending)
+	assert.True(t, revertCalled)
+}
+
+func TestProposalPipeline_LeaderSelectedShouldDiscardTheProposalOfAnotherLeader(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	revertCalled := false
+	container.SetBlockProcessor(&mock.BlockProcessorMock{
+		RevertAccountStateCalled: func(header data.HeaderHandler) {
+			revertCalled = true
+		},
+	})
+	initBlockchainForProposalPipeline(container)
+	pp := pbft.NewProposalPipeline(container, initConsensusState(), chainID)
+	_ = pbft.AddPreparedProposal(pp, 2, []byte("current block hash"), "A", &block.Header{}, &block.Body{})
+
+	pp.LeaderSelected(2, "A")
+	_, isPending := pbft.PendingProposalRound(pp)
+	assert.True(t, isPending)
+	assert.False(t, revertCalled)
+
+	pp.LeaderSelected(2, "B")
+	_, isPending = pbft.PendingProposalRound(pp)
+	assert.False(t, isPending)
+	assert.True(t, revertCalled)
+}
+
+func TestProposalPipeline_GetPreparedBlockShouldReturnTheUsableProposal(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	initBlockchainForProposalPipeline(container)
+	pp := pbft.NewProposalPipeline(container, initConsensusState(), chainID)
+	preparedHeader := &block.Header{Nonce: 2, Round: 2}
+	_ = pbft.AddPreparedProposal(pp, 2, []byte("current block hash"), "A", preparedHeader, &block.Body{})
+
+	header, body, ok := pp.GetPreparedBlock(2, "A")
+	assert.True(t, ok)
+	assert.True(t, preparedHeader == header)
+	assert.NotNil(t, body)
+
+	_, isPending := pbft.PendingProposalRound(pp)
+	assert.False(t, isPending)
+}
+
+func TestProposalPipeline_GetPreparedBlockShouldDiscardTheUnusableProposal(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	revertCalled := false
+	container.SetBlockProcessor(&mock.BlockProcessorMock{
+		RevertAccountStateCalled: func(header data.HeaderHandler) {
+			revertCalled = true
+		},
+	})
+	initBlockchainForProposalPipeline(container)
+	pp := pbft.NewProposalPipeline(container, initConsensusState(), chainID)
+	_ = pbft.AddPreparedProposal(pp, 2, []byte("other block hash"), "A", &block.Header{}, &block.Body{})
+
+	header, body, ok := pp.GetPreparedBlock(2, "A")
+	assert.False(t, ok)
+	assert.Nil(t, header)
+	assert.Nil(t, body)
+	assert.True(t, revertCalled)
+
+	_, isPending := pbft.PendingProposalRound(pp)
+	assert.False(t, isPending)
+}
+
+func TestProposalPipeline_GetPreparedBlockNotCreatedShouldNotBeUsed(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	initBlockchainForProposalPipeline(container)
+	pp := pbft.NewProposalPipeline(container, initConsensusState(), chainID)
+	_ = pbft.AddPreparedProposal(pp, 2, []byte("current block hash"), "A", nil, nil)
+
+	_, _, ok := pp.GetPreparedBlock(2, "A")
+	assert.False(t, ok)
+}
+
+func TestProposalPipeline_RoundStartedShouldDiscardTheProposalOfATentativeBlockNotCommitted(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	container.SetRounder(&mock.RounderMock{RoundIndex: 5})
+	container.SetBlockProcessor(&mock.BlockProcessorMock{
+		RevertAccountStateCalled: func(header data.HeaderHandler) {
+			assert.Fail(t, "the accounts state should have not been reverted")
+		},
+	})
+	initBlockchainForProposalPipeline(container)
+	pp := pbft.NewProposalPipeline(container, initConsensusState(), chainID)
+	_ = pbft.AddTentativeProposal(pp, 6, "B", &block.Header{Round: 5}, &block.Header{Round: 6})
+
+	pp.RoundStarted(6)
+
+	_, isPending := pbft.PendingProposalRound(pp)
+	assert.False(t, isPending)
+}
+
+func TestProposalPipeline_GetPreparedBlockOfATentativeBlockNotCommittedShouldNotBeUsed(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	container.SetRounder(&mock.RounderMock{RoundIndex: 5})
+	initBlockchainForProposalPipeline(container)
+	pp := pbft.NewProposalPipeline(container, initConsensusState(), chainID)
+	_ = pbft.AddTentativeProposal(pp, 6, "B", &block.Header{Round: 5}, &block.Header{Round: 6})
+
+	_, _, ok := pp.GetPreparedBlock(6, "B")
+	assert.False(t, ok)
+
+	_, isPending := pbft.PendingProposalRound(pp)
+	assert.False(t, isPending)
+}
+
+func TestProposalPipeline_BlockProcessedNotLeaderInNextRoundShouldNotPrepare(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	container.SetRounder(&mock.RounderMock{RoundIndex: 5})
+	initBlockchainForProposalPipeline(container)
+	pp := pbft.NewProposalPipeline(container, initConsensusState(), chainID)
+
+	pp.BlockProcessed(&block.Header{Round: 5, RandSeed: []byte("rand seed")})
+
+	_, isPending := pbft.PendingProposalRound(pp)
+	assert.False(t, isPending)
+}
+
+func TestProposalPipeline_BlockProcessedStartOfEpochBlockShouldNotPrepare(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	container.SetRounder(&mock.RounderMock{RoundIndex: 5})
+	container.SetValidatorGroupSelector(&mock.NodesCoordinatorMock{
+		ComputeValidatorsGroupCalled: func(_ []byte, _ uint64, _ uint32, _ uint32) ([]sharding.Validator, error) {
+			return []sharding.Validator{mock.NewValidator([]byte("B"), 1, 0)}, nil
+		},
+	})
+	initBlockchainForProposalPipeline(container)
+	pp := pbft.NewProposalPipeline(container, initConsensusState(), chainID)
+
+	pp.BlockProcessed(&block.Header{Round: 5, EpochStartMetaHash: []byte("epoch start meta hash")})
+
+	_, isPending := pbft.PendingProposalRound(pp)
+	assert.False(t, isPending)
+}
+
+func TestProposalPipeline_BlockProcessedAfterTheRoundEndedShouldNotPrepare(t *testing.T) {
+	t.Parallel()
+
+	numIndexCalls := 0
+	container := mock.InitConsensusCore()
+	container.SetRounder(&mock.RounderMock{
+		IndexCalled: func() int64 {
+			// the round ends while the consensus group of the next round is computed
+			numIndexCalls++
+			if numIndexCalls == 1 {
+				return 5
+			}
+			return 6
+		},
+	})
+	container.SetValidatorGroupSelector(&mock.NodesCoordinatorMock{
+		ComputeValidatorsGroupCalled: func(_ []byte, _ uint64, _ uint32, _ uint32) ([]sharding.Validator, error) {
+			return []sharding.Validator{mock.NewValidator([]byte("B"), 1, 0)}, nil
+		},
+	})
+	container.SetSigningHandler(&testscommon.SigningHandlerStub{
+		SignRandSeedCalled: func(_ []byte, _ []byte, _ []byte) ([]byte, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	})
+	initBlockchainForProposalPipeline(container)
+	pp := pbft.NewProposalPipeline(container, initConsensusState(), chainID)
+
+	pp.BlockProcessed(&block.Header{Round: 5, RandSeed: []byte("rand seed")})
+
+	_, isPending := pbft.PendingProposalRound(pp)
+	assert.False(t, isPending)
+}
+
+func TestProposalPipeline_BlockProcessedShouldPrepareTheNextBlockOnTheTentativeBlock(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	container.SetRounder(&mock.RounderMock{RoundIndex: 5})
+	container.SetValidatorGroupSelector(&mock.NodesCoordinatorMock{
+		ComputeValidatorsGroupCalled: func(randomness []byte, round uint64, _ uint32, _ uint32) ([]sharding.Validator, error) {
+			assert.Equal(t, []byte("tentative rand seed"), randomness)
+			assert.Equal(t, uint64(6), round)
+			return []sharding.Validator{
+				mock.NewValidator([]byte("B"), 1, 0),
+				mock.NewValidator([]byte("A"), 1, 1),
+			}, nil
+		},
+	})
+	chHeaderPrepared := make(chan struct{})
+	container.SetSigningHandler(&testscommon.SigningHandlerStub{
+		SignRandSeedCalled: func(pubKey []byte, prevRandSeed []byte, _ []byte) ([]byte, error) {
+			assert.Equal(t, []byte("B"), pubKey)
+			assert.Equal(t, []byte("tentative rand seed"), prevRandSeed)
+			close(chHeaderPrepared)
+			return []byte("next rand seed"), nil
+		},
+	})
+	chCreatedHeader := make(chan data.HeaderHandler, 1)
+	bpm := mock.InitBlockProcessorMock()
+	bpm.CreateBlockCalled = func(header data.HeaderHandler, haveTime func() bool) (data.HeaderHandler, data.BodyHandler, error) {
+		chCreatedHeader <- header
+		return header, &block.Body{}, nil
+	}
+	container.SetBlockProcessor(bpm)
+	initBlockchainForProposalPipeline(container)
+	pp := pbft.NewProposalPipeline(container, initConsensusState(), chainID)
+
+	tentativeHeader := &block.Header{Nonce: 2, Round: 5, PrevHash: []byte("prev hash"), RandSeed: []byte("tentative rand seed")}
+	pp.BlockProcessed(tentativeHeader)
+
+	round, isPending := pbft.PendingProposalRound(pp)
+	assert.True(t, isPending)
+	assert.Equal(t, int64(6), round)
+
+	select {
+	case <-chHeaderPrepared:
+	case <-time.After(time.Second):
+		assert.Fail(t, "the header of the next round was not prepared")
+	}
+	select {
+	case <-chCreatedHeader:
+		assert.Fail(t, "the block should have been created only after the tentative block is committed")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// the committed header holds the aggregated signature of the tentative block
+	committedHeader := *tentativeHeader
+	committedHeader.Signature = []byte("aggregated signature")
+	pp.BlockCommitted(&committedHeader)
+
+	assert.True(t, pbft.IsPendingBlockCreationStarted(pp))
+	select {
+	case header := <-chCreatedHeader:
+		assert.Equal(t, uint64(6), header.GetRound())
+		assert.Equal(t, uint64(3), header.GetNonce())
+		assert.Equal(t, []byte("current block hash"), header.GetPrevHash())
+		assert.Equal(t, []byte("tentative rand seed"), header.GetPrevRandSeed())
+		assert.Equal(t, []byte("next rand seed"), header.GetRandSeed())
+	case <-time.After(time.Second):
+		assert.Fail(t, "the block of the next round was not created")
+	}
+}
+
+func TestProposalPipeline_BlockCommittedOfAnotherBlockShouldDiscardTheTentativeProposal(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	container.SetRounder(&mock.RounderMock{RoundIndex: 5})
+	bpm := mock.InitBlockProcessorMock()
+	bpm.CreateBlockCalled = func(header data.HeaderHandler, haveTime func() bool) (data.HeaderHandler, data.BodyHandler, error) {
+		assert.Fail(t, "should have not been called")
+		return header, &block.Body{}, nil
+	}
+	bpm.RevertAccountStateCalled = func(header data.HeaderHandler) {
+		assert.Fail(t, "the accounts state should have not been reverted")
+	}
+	container.SetBlockProcessor(bpm)
+	initBlockchainForProposalPipeline(container)
+	pp := pbft.NewProposalPipeline(container, initConsensusState(), chainID)
+	tentativeHeader := &block.Header{Nonce: 2, Round: 5, RandSeed: []byte("rand seed of view 0")}
+	_ = pbft.AddTentativeProposal(pp, 6, "B", tentativeHeader, &block.Header{Round: 6})
+
+	pp.BlockCommitted(&block.Header{Nonce: 2, Round: 5, RandSeed: []byte("rand seed of view 1")})
+
+	_, isPending := pbft.PendingProposalRound(pp)
+	assert.False(t, isPending)
+}
+
+func TestProposalPipeline_ViewChangedShouldDiscardTheProposalOfATentativeBlockOfTheRound(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	container.SetRounder(&mock.RounderMock{RoundIndex: 5})
+	container.SetBlockProcessor(&mock.BlockProcessorMock{
+		RevertAccountStateCalled: func(header data.HeaderHandler) {
+			assert.Fail(t, "the accounts state should have not been reverted")
+		},
+	})
+	initBlockchainForProposalPipeline(container)
+	pp := pbft.NewProposalPipeline(container, initConsensusState(), chainID)
+	_ = pbft.AddTentativeProposal(pp, 6, "B", &block.Header{Round: 5}, &block.Header{Round: 6})
+
+	pbft.ViewChanged(pp, 4)
+	_, isPending := pbft.PendingProposalRound(pp)
+	assert.True(t, isPending)
+
+	pbft.ViewChanged(pp, 5)
+	_, isPending = pbft.PendingProposalRound(pp)
+	assert.False(t, isPending)
+}
+
+func TestProposalPipeline_DiscardPendingShouldWaitForTheCreationAndRevert(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	container.SetRounder(&mock.RounderMock{RoundIndex: 5})
+	container.SetValidatorGroupSelector(&mock.NodesCoordinatorMock{
+		ComputeValidatorsGroupCalled: func(_ []byte, _ uint64, _ uint32, _ uint32) ([]sharding.Validator, error) {
+			return []sharding.Validator{mock.NewValidator([]byte("B"), 1, 0)}, nil
+		},
+	})
+	chCreationStarted := make(chan struct{})
+	chFinishCreation := make(chan struct{})
+	isCreationFinished := atomic.Flag{}
+	bpm := mock.InitBlockProcessorMock()
+	bpm.CreateBlockCalled = func(header data.HeaderHandler, haveTime func() bool) (data.HeaderHandler, data.BodyHandler, error) {
+		close(chCreationStarted)
+		<-chFinishCreation
+		isCreationFinished.Set()
+		return header, &block.Body{}, nil
+	}
+	var revertedHeader data.HeaderHandler
+	bpm.RevertAccountStateCalled = func(header data.HeaderHandler) {
+		assert.True(t, isCreationFinished.IsSet())
+		revertedHeader = header
+	}
+	container.SetBlockProcessor(bpm)
+	initBlockchainForProposalPipeline(container)
+	pp := pbft.NewProposalPipeline(container, initConsensusState(), chainID)
+
+	tentativeHeader := &block.Header{Round: 5, RandSeed: []byte("rand seed")}
+	pp.BlockProcessed(tentativeHeader)
+	pp.BlockCommitted(tentativeHeader)
+	<-chCreationStarted
+
+	chDiscarded := make(chan struct{})
+	go func() {
+		pbft.DiscardPending(pp)
+		close(chDiscarded)
+	}()
+
+	select {
+	case <-chDiscarded:
+		assert.Fail(t, "the block should have been discarded only after its creation finished")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	close(chFinishCreation)
+	select {
+	case <-chDiscarded:
+	case <-time.After(time.Second):
+		assert.Fail(t, "the block was not discarded")
+	}
+
+	_, isPending := pbft.PendingProposalRound(pp)
+	assert.False(t, isPending)
+	require.NotNil(t, revertedHeader)
+	assert.Equal(t, uint64(6), revertedHeader.GetRound())
+}
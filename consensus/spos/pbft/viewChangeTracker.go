@@ -0,0 +1,84 @@
+package pbft
+
+import (
+	"sync"
+)
+
+// viewChangeTracker keeps, for the current round, the public keys which asked for each view change and the highest
+// view change requested by the node itself
+type viewChangeTracker struct {
+	mut           sync.RWMutex
+	round         int64
+	senders       map[uint32]map[string]struct{}
+	requestedView uint32
+}
+
+func newViewChangeTracker() *viewChangeTracker {
+	return &viewChangeTracker{
+		senders: make(map[uint32]map[string]struct{}),
+	}
+}
+
+// addViewChange records the view change asked by the provided public key and returns the number of distinct public
+// keys which asked for the same view in the provided round
+func (vct *viewChangeTracker) addViewChange(round int64, view uint32, pubKey string) int {
+	vct.mut.Lock()
+	defer vct.mut.Unlock()
+
+	vct.resetIfNeeded(round)
+
+	viewSenders, ok := vct.senders[view]
+	if !ok {
+		viewSenders = make(map[string]struct{})
+		vct.senders[view] = viewSenders
+	}
+	viewSenders[pubKey] = struct{}{}
+
+	return len(viewSenders)
+}
+
+// numViewChanges returns the number of distinct public keys which asked for the provided view in the provided round
+func (vct *viewChangeTracker) numViewChanges(round int64, view uint32) int {
+	vct.mut.RLock()
+	defer vct.mut.RUnlock()
+
+	if vct.round != round {
+		return 0
+	}
+
+	return len(vct.senders[view])
+}
+
+// setRequestedView records that the node itself asked for the provided view in the provided round
+func (vct *viewChangeTracker) setRequestedView(round int64, view uint32) {
+	vct.mut.Lock()
+	defer vct.mut.Unlock()
+
+	vct.resetIfNeeded(round)
+
+	if view > vct.requestedView {
+		vct.requestedView = view
+	}
+}
+
+// requestedViewInRound returns the highest view the node itself asked for in the provided round
+func (vct *viewChangeTracker) requestedViewInRound(round int64) uint32 {
+	vct.mut.RLock()
+	defer vct.mut.RUnlock()
+
+	if vct.round != round {
+		return 0
+	}
+
+	return vct.requestedView
+}
+
+func (vct *viewChangeTracker) resetIfNeeded(round int64) {
+	if vct.round == round {
+		return
+	}
+
+	vct.round = round
+	vct.senders = make(map[uint32]map[string]struct{})
+	vct.requestedView = 0
+}
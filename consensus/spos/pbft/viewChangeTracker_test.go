@@ -0,0 +1,45 @@
+package pbft
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestViewChangeTracker_AddViewChangeShouldCountDistinctPublicKeys(t *testing.T) {
+	t.Parallel()
+
+	vct := newViewChangeTracker()
+
+	assert.Equal(t, 1, vct.addViewChange(3, 1, "A"))
+	assert.Equal(t, 1, vct.addViewChange(3, 1, "A"))
+	assert.Equal(t, 2, vct.addViewChange(3, 1, "B"))
+	assert.Equal(t, 1, vct.addViewChange(3, 2, "A"))
+
+	assert.Equal(t, 2, vct.numViewChanges(3, 1))
+	assert.Equal(t, 1, vct.numViewChanges(3, 2))
+	assert.Equal(t, 0, vct.numViewChanges(4, 1))
+}
+
+func TestViewChangeTracker_NewRoundShouldReset(t *testing.T) {
+	t.Parallel()
+
+	vct := newViewChangeTracker()
+	_ = vct.addViewChange(3, 1, "A")
+	vct.setRequestedView(3, 1)
+
+	assert.Equal(t, 1, vct.addViewChange(4, 1, "B"))
+	assert.Equal(t, 0, vct.numViewChanges(3, 1))
+	assert.Equal(t, uint32(0), vct.requestedViewInRound(4))
+}
+
+func TestViewChangeTracker_SetRequestedViewShouldKeepTheHighestView(t *testing.T) {
+	t.Parallel()
+
+	vct := newViewChangeTracker()
+	vct.setRequestedView(3, 2)
+	vct.setRequestedView(3, 1)
+
+	assert.Equal(t, uint32(2), vct.requestedViewInRound(3))
+	assert.Equal(t, uint32(0), vct.requestedViewInRound(2))
+}
@@ -0,0 +1,349 @@
+package pbft
+
+import (
+	"bytes"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
+)
+
+// viewHandler asks for a view change at the end of each view in which no block was received and moves the subround
+// Block to the next view, replacing the leader of the round, when enough validators from the consensus group agree
+type viewHandler struct {
+	consensusCore                spos.ConsensusCoreHandler
+	consensusState               *spos.ConsensusState
+	consensusStateChangedChannel chan bool
+	chainID                      []byte
+	currentPid                   core.PeerID
+
+	appStatusHandler   core.AppStatusHandler
+	viewChanges        *viewChangeTracker
+	viewChangedHandler func(round int64)
+	mutViewChange      sync.Mutex
+
+	mutProposeBlock sync.RWMutex
+	proposeBlock    func() bool
+}
+
+func newViewHandler(
+	consensusCore spos.ConsensusCoreHandler,
+	consensusState *spos.ConsensusState,
+	chainID []byte,
+	currentPid core.PeerID,
+) *viewHandler {
+	return &viewHandler{
+		consensusCore:      consensusCore,
+		consensusState:     consensusState,
+		chainID:            chainID,
+		currentPid:         currentPid,
+		appStatusHandler:   statusHandler.NewNilStatusHandler(),
+		viewChanges:        newViewChangeTracker(),
+		viewChangedHandler: func(_ int64) {},
+	}
+}
+
+func (vh *viewHandler) setAppStatusHandler(ash core.AppStatusHandler) {
+	vh.appStatusHandler = ash
+}
+
+// setViewChangedHandler sets the function called, under the view change mutex, each time the view of a round changes
+func (vh *viewHandler) setViewChangedHandler(handler func(round int64)) {
+	vh.viewChangedHandler = handler
+}
+
+func (vh *viewHandler) setConsensusStateChangedChannel(consensusStateChangedChannel chan bool) {
+	vh.consensusStateChangedChannel = consensusStateChangedChannel
+}
+
+// BlockJobStarted starts waiting for the block of each view of the provided round, asking for a view change if it is
+// not received in time. The provided function proposes the block of the node when it becomes the leader
+func (vh *viewHandler) BlockJobStarted(round int64, proposeBlock func() bool) {
+	vh.mutProposeBlock.Lock()
+	vh.proposeBlock = proposeBlock
+	vh.mutProposeBlock.Unlock()
+
+	if vh.consensusState.IsNodeInConsensusGroup(vh.consensusState.SelfPubKey()) {
+		go vh.waitViewChanges(round)
+	}
+}
+
+// GetBlockCreationEndTime returns the time, measured from the round start, until which the leader of the provided
+// view can create its block
+func (vh *viewHandler) GetBlockCreationEndTime(view uint32, _ time.Duration) time.Duration {
+	return getBlockCreationEndTime(vh.consensusCore.Rounder().TimeDuration(), view)
+}
+
+// ExecuteInView executes the provided action only if the consensus is still in the provided round and view. The
+// action is executed under the same lock which guards the view changes
+func (vh *viewHandler) ExecuteInView(round int64, view uint32, action func()) bool {
+	vh.mutViewChange.Lock()
+	defer vh.mutViewChange.Unlock()
+
+	isViewChanged := vh.consensusCore.Rounder().Index() != round || vh.consensusState.View() != view
+	if isViewChanged || vh.consensusState.RoundCanceled {
+		return false
+	}
+
+	action()
+
+	return true
+}
+
+// AcceptProposal sets the proposal created in the provided view, if it can be processed. The header is nil for the
+// proposals carrying only the block body
+func (vh *viewHandler) AcceptProposal(view uint32, header data.HeaderHandler, setProposal func()) bool {
+	if !check.IfNil(header) && !isHeaderInView(header, view) {
+		return false
+	}
+
+	vh.mutViewChange.Lock()
+	defer vh.mutViewChange.Unlock()
+
+	if !vh.acceptProposalView(view) {
+		return false
+	}
+
+	setProposal()
+
+	return true
+}
+
+// acceptProposalView returns true if a proposal created in the provided view can be processed. The current view is
+// always accepted, while a later one is accepted only if the node itself asked for it or enough validators did it,
+// in which case the node moves to that view, as the proposal can be received before the view change messages.
+// It should be called under the view change mutex
+func (vh *viewHandler) acceptProposalView(view uint32) bool {
+	round := vh.consensusCore.Rounder().Index()
+	currentView := vh.consensusState.View()
+	if view < currentView || view < vh.viewChanges.requestedViewInRound(round) {
+		return false
+	}
+	if view == currentView {
+		return true
+	}
+
+	isViewChangeAgreed := vh.viewChanges.requestedViewInRound(round) >= view ||
+		vh.viewChanges.numViewChanges(round, view) >= vh.consensusState.Threshold(SrSignature)
+	if !isViewChangeAgreed {
+		return false
+	}
+
+	vh.consensusState.SetView(view)
+	vh.viewChangedHandler(round)
+	log.Debug("step 1: view has been changed by the proposal of the new leader",
+		"round", round,
+		"view", view)
+
+	return true
+}
+
+// isHeaderInView returns true if the provided header holds the provided view in its reserved field
+func isHeaderInView(header data.HeaderHandler, view uint32) bool {
+	headerView, err := core.DecodeConsensusView(header.GetReserved())
+	if err != nil {
+		return false
+	}
+
+	return headerView == view
+}
+
+// waitViewChanges asks for a view change, in the name of all the keys handled by the node in the consensus group, at
+// the end of each view in which no block was received
+func (vh *viewHandler) waitViewChanges(round int64) {
+	rounder := vh.consensusCore.Rounder()
+	for view := uint32(1); view <= maxView; view++ {
+		time.Sleep(rounder.RemainingTime(vh.consensusState.RoundTimeStamp, getViewEndTime(rounder.TimeDuration(), view-1)))
+
+		if vh.isRoundChanged(round) || vh.consensusState.RoundCanceled || vh.consensusState.IsConsensusDataSet() {
+			return
+		}
+		if vh.consensusState.IsSubroundFinished(SrBlock) {
+			return
+		}
+		if vh.consensusState.View() >= view {
+			continue
+		}
+
+		vh.requestViewChange(round, view)
+	}
+}
+
+func (vh *viewHandler) requestViewChange(round int64, view uint32) {
+	vh.viewChanges.setRequestedView(round, view)
+
+	selfPubKey := vh.consensusState.SelfPubKey()
+	pubKeys := vh.consensusState.ManagedKeysInConsensusGroup()
+	if vh.consensusState.IsNodeInConsensusGroup(selfPubKey) {
+		pubKeys = append(pubKeys, selfPubKey)
+	}
+
+	for _, pubKey := range pubKeys {
+		err := vh.sendViewChange(pubKey, view)
+		if err != nil {
+			log.Debug("requestViewChange.sendViewChange",
+				"public key", core.GetTrimmedPk(hex.EncodeToString([]byte(pubKey))),
+				"error", err.Error())
+			continue
+		}
+
+		vh.addViewChange(round, view, pubKey)
+	}
+
+	log.Debug("step 1: view change has been asked, no block was received",
+		"round", round,
+		"view", view)
+}
+
+func (vh *viewHandler) sendViewChange(pubKey string, view uint32) error {
+	cnsMsg := consensus.NewConsensusMessage(
+		getCurrentBlockHash(vh.consensusCore.Blockchain()),
+		nil,
+		nil,
+		nil,
+		[]byte(pubKey),
+		nil,
+		int(MtViewChange),
+		vh.consensusCore.Rounder().Index(),
+		vh.chainID,
+		nil,
+		nil,
+		nil,
+		vh.currentPid,
+	)
+	cnsMsg.View = view
+
+	return vh.consensusCore.BroadcastMessenger().BroadcastConsensusMessage(cnsMsg)
+}
+
+// receivedViewChange method is called when a view change message is received. When enough validators from the
+// consensus group ask for the same view, the leader of that view replaces the current one
+func (vh *viewHandler) receivedViewChange(cnsDta *consensus.Message) bool {
+	node := string(cnsDta.PubKey)
+
+	if !vh.consensusState.IsNodeInConsensusGroup(node) {
+		vh.consensusCore.PeerHonestyHandler().ChangeScore(
+			node,
+			spos.GetConsensusTopicID(vh.consensusCore.ShardCoordinator()),
+			spos.ValidatorPeerHonestyDecreaseFactor,
+		)
+
+		return false
+	}
+
+	if !vh.consensusState.CanProcessReceivedMessage(cnsDta, vh.consensusCore.Rounder().Index(), SrBlock) {
+		return false
+	}
+
+	// only the validators building on the same block can agree on the next leader
+	if !bytes.Equal(cnsDta.BlockHeaderHash, getCurrentBlockHash(vh.consensusCore.Blockchain())) {
+		return false
+	}
+
+	log.Debug("step 1: view change has been received",
+		"from", core.GetTrimmedPk(hex.EncodeToString(cnsDta.PubKey)),
+		"view", cnsDta.View)
+
+	return vh.addViewChange(cnsDta.RoundIndex, cnsDta.View, node)
+}
+
+// addViewChange records the view change asked by the provided public key and changes the view if the threshold was
+// reached
+func (vh *viewHandler) addViewChange(round int64, view uint32, pubKey string) bool {
+	numViewChanges := vh.viewChanges.addViewChange(round, view, pubKey)
+	if numViewChanges < vh.consensusState.Threshold(SrSignature) {
+		return false
+	}
+
+	return vh.changeView(round, view)
+}
+
+// changeView moves the consensus to the provided view, if no block was accepted yet in the round. If the new leader
+// is handled by the node, it starts creating its block
+func (vh *viewHandler) changeView(round int64, view uint32) bool {
+	vh.mutViewChange.Lock()
+	defer vh.mutViewChange.Unlock()
+
+	state := vh.consensusState
+	if vh.isRoundChanged(round) || state.IsConsensusDataSet() || state.IsBlockBodyAlreadyReceived() || state.View() >= view {
+		return false
+	}
+
+	state.SetView(view)
+	vh.viewChangedHandler(round)
+
+	leader, err := state.GetLeader()
+	if err != nil {
+		log.Debug("changeView.GetLeader", "error", err.Error())
+		return false
+	}
+
+	log.Debug("step 1: view has been changed",
+		"round", round,
+		"view", view,
+		"leader", core.GetTrimmedPk(hex.EncodeToString([]byte(leader))))
+
+	if !state.IsKeyManagedByCurrentNode(leader) {
+		return true
+	}
+
+	state.SetSelfPubKey(leader)
+	vh.appStatusHandler.SetStringValue(core.MetricConsensusState, "proposer")
+
+	go vh.proposeBlockInView()
+
+	return true
+}
+
+// proposeBlockInView proposes the block of the node which became the leader of the current view and signals the
+// consensus state change, as the subround Block job was already done
+func (vh *viewHandler) proposeBlockInView() {
+	vh.mutProposeBlock.RLock()
+	proposeBlock := vh.proposeBlock
+	vh.mutProposeBlock.RUnlock()
+
+	if proposeBlock == nil || !proposeBlock() {
+		return
+	}
+
+	select {
+	case vh.consensusStateChangedChannel <- true:
+	default:
+	}
+}
+
+func (vh *viewHandler) isRoundChanged(round int64) bool {
+	return vh.consensusCore.Rounder().Index() != round || vh.consensusState.RoundIndex != round
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (vh *viewHandler) IsInterfaceNil() bool {
+	return vh == nil
+}
+
+// getCurrentBlockHash returns the hash of the last committed block, or of the genesis block if none was committed
+func getCurrentBlockHash(blockchain data.ChainHandler) []byte {
+	if check.IfNil(blockchain.GetCurrentBlockHeader()) {
+		return blockchain.GetGenesisHeaderHash()
+	}
+
+	return blockchain.GetCurrentBlockHeaderHash()
+}
+
+// getViewEndTime returns the time, measured from the round start, at which the provided view ends
+func getViewEndTime(roundDuration time.Duration, view uint32) time.Duration {
+	return time.Duration(float64(roundDuration) * (srBlockStartTime + viewDuration*float64(view+1)))
+}
+
+// getBlockCreationEndTime returns the time, measured from the round start, until which the leader of the provided
+// view can create its block
+func getBlockCreationEndTime(roundDuration time.Duration, view uint32) time.Duration {
+	viewStartTime := srBlockStartTime + viewDuration*float64(view)
+	return time.Duration(float64(roundDuration) * (viewStartTime + viewDuration*blockCreationViewThreshold))
+}
@@ -0,0 +1,309 @@
+package pbft_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/pbft"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/stretchr/testify/assert"
+)
+
+func initViewHandlerConsensusState(container *mock.ConsensusCoreMock) (*spos.ConsensusState, chan bool) {
+	container.SetBlockchain(&mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{Nonce: 1, Round: 1}
+		},
+		GetCurrentBlockHeaderHashCalled: func() []byte {
+			return []byte("current block hash")
+		},
+	})
+	container.SetRounder(&mock.RounderMock{RoundIndex: 2})
+
+	consensusState := initConsensusState()
+	consensusState.RoundIndex = 2
+	consensusState.Data = nil
+
+	return consensusState, make(chan bool, 1)
+}
+
+func createViewChangeMessage(pubKey string, view uint32) *consensus.Message {
+	cnsMsg := consensus.NewConsensusMessage(
+		[]byte("current block hash"),
+		nil,
+		nil,
+		nil,
+		[]byte(pubKey),
+		[]byte("sig"),
+		int(pbft.MtViewChange),
+		2,
+		chainID,
+		nil,
+		nil,
+		nil,
+		currentPid,
+	)
+	cnsMsg.View = view
+
+	return cnsMsg
+}
+
+func createHeaderInView(view uint32) data.HeaderHandler {
+	return &block.Header{Nonce: 2, Round: 2, Reserved: core.EncodeConsensusView(view)}
+}
+
+func TestViewHandler_RequestViewChangeShouldBroadcastAndCountSelf(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	var sentMessage *consensus.Message
+	container.SetBroadcastMessenger(&mock.BroadcastMessengerMock{
+		BroadcastConsensusMessageCalled: func(message *consensus.Message) error {
+			sentMessage = message
+			return nil
+		},
+	})
+	consensusState, ch := initViewHandlerConsensusState(container)
+	vh := pbft.NewViewHandler(container, consensusState, ch, chainID, currentPid)
+
+	vh.RequestViewChange(2, 1)
+
+	assert.NotNil(t, sentMessage)
+	assert.Equal(t, int64(pbft.MtViewChange), sentMessage.MsgType)
+	assert.Equal(t, uint32(1), sentMessage.View)
+	assert.Equal(t, []byte(consensusState.SelfPubKey()), sentMessage.PubKey)
+	assert.Equal(t, []byte("current block hash"), sentMessage.BlockHeaderHash)
+	assert.Equal(t, 1, vh.NumViewChanges(2, 1))
+	assert.Equal(t, uint32(0), consensusState.View())
+}
+
+func TestViewHandler_ReceivedViewChangeShouldChangeViewWhenThresholdIsReached(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	consensusState, ch := initViewHandlerConsensusState(container)
+	vh := pbft.NewViewHandler(container, consensusState, ch, chainID, currentPid)
+	changedViewRounds := make([]int64, 0)
+	vh.SetViewChangedHandler(func(round int64) {
+		changedViewRounds = append(changedViewRounds, round)
+	})
+	threshold := consensusState.Threshold(pbft.SrSignature)
+
+	senders := make([]string, 0)
+	for _, pubKey := range consensusState.ConsensusGroup() {
+		if pubKey != consensusState.SelfPubKey() {
+			senders = append(senders, pubKey)
+		}
+	}
+
+	for i := 0; i < threshold-1; i++ {
+		r := vh.ReceivedViewChange(createViewChangeMessage(senders[i], 2))
+		assert.False(t, r)
+	}
+	assert.Equal(t, uint32(0), consensusState.View())
+	assert.Equal(t, 0, len(changedViewRounds))
+
+	r := vh.ReceivedViewChange(createViewChangeMessage(senders[threshold-1], 2))
+	assert.True(t, r)
+	assert.Equal(t, uint32(2), consensusState.View())
+	assert.Equal(t, []int64{container.Rounder().Index()}, changedViewRounds)
+
+	leader, _ := consensusState.GetLeader()
+	assert.Equal(t, consensusState.ConsensusGroup()[2], leader)
+}
+
+func TestViewHandler_ReceivedViewChangeFromAnotherChainShouldNotCount(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	consensusState, ch := initViewHandlerConsensusState(container)
+	vh := pbft.NewViewHandler(container, consensusState, ch, chainID, currentPid)
+
+	cnsMsg := createViewChangeMessage(consensusState.ConsensusGroup()[0], 1)
+	cnsMsg.BlockHeaderHash = []byte("other block hash")
+	r := vh.ReceivedViewChange(cnsMsg)
+
+	assert.False(t, r)
+	assert.Equal(t, 0, vh.NumViewChanges(2, 1))
+}
+
+func TestViewHandler_ReceivedViewChangeAfterBlockReceivedShouldNotChangeView(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	consensusState, ch := initViewHandlerConsensusState(container)
+	vh := pbft.NewViewHandler(container, consensusState, ch, chainID, currentPid)
+	consensusState.Data = []byte("block hash")
+
+	for _, pubKey := range consensusState.ConsensusGroup() {
+		if pubKey != consensusState.SelfPubKey() {
+			_ = vh.ReceivedViewChange(createViewChangeMessage(pubKey, 2))
+		}
+	}
+
+	assert.Equal(t, uint32(0), consensusState.View())
+}
+
+func TestViewHandler_ViewChangeToSelfShouldProposeBlockInNewView(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	consensusState, ch := initViewHandlerConsensusState(container)
+	vh := pbft.NewViewHandler(container, consensusState, ch, chainID, currentPid)
+	chProposedView := make(chan uint32, 1)
+	vh.SetProposeBlock(func() bool {
+		chProposedView <- consensusState.View()
+		return true
+	})
+	// the self public key is the second one in the consensus group, being the leader of the first view change
+	assert.Equal(t, consensusState.ConsensusGroup()[1], consensusState.SelfPubKey())
+
+	for _, pubKey := range consensusState.ConsensusGroup() {
+		if pubKey != consensusState.SelfPubKey() {
+			_ = vh.ReceivedViewChange(createViewChangeMessage(pubKey, 1))
+		}
+	}
+
+	select {
+	case view := <-chProposedView:
+		assert.Equal(t, uint32(1), view)
+	case <-time.After(time.Second):
+		assert.Fail(t, "block was not proposed in the new view")
+	}
+
+	select {
+	case <-ch:
+	case <-time.After(time.Second):
+		assert.Fail(t, "consensus state change was not signaled")
+	}
+}
+
+func TestViewHandler_AcceptProposalInLaterViewShouldNeedViewChangeAgreement(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	consensusState, ch := initViewHandlerConsensusState(container)
+	vh := pbft.NewViewHandler(container, consensusState, ch, chainID, currentPid)
+	numSetProposal := 0
+	setProposal := func() {
+		numSetProposal++
+	}
+
+	r := vh.AcceptProposal(2, createHeaderInView(2), setProposal)
+	assert.False(t, r)
+	assert.Equal(t, 0, numSetProposal)
+
+	vh.RequestViewChange(2, 2)
+	r = vh.AcceptProposal(2, createHeaderInView(2), setProposal)
+	assert.True(t, r)
+	assert.Equal(t, 1, numSetProposal)
+	assert.Equal(t, uint32(2), consensusState.View())
+}
+
+func TestViewHandler_AcceptProposalFromPreviousViewShouldNotAccept(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	consensusState, ch := initViewHandlerConsensusState(container)
+	vh := pbft.NewViewHandler(container, consensusState, ch, chainID, currentPid)
+	vh.RequestViewChange(2, 1)
+
+	r := vh.AcceptProposal(0, createHeaderInView(0), func() {
+		assert.Fail(t, "should have not been called")
+	})
+	assert.False(t, r)
+}
+
+func TestViewHandler_AcceptProposalWithHeaderFromAnotherViewShouldNotAccept(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	consensusState, ch := initViewHandlerConsensusState(container)
+	vh := pbft.NewViewHandler(container, consensusState, ch, chainID, currentPid)
+	numSetProposal := 0
+	setProposal := func() {
+		numSetProposal++
+	}
+
+	r := vh.AcceptProposal(0, createHeaderInView(1), setProposal)
+	assert.False(t, r)
+	assert.Equal(t, 0, numSetProposal)
+
+	r = vh.AcceptProposal(0, createHeaderInView(0), setProposal)
+	assert.True(t, r)
+	assert.Equal(t, 1, numSetProposal)
+}
+
+func TestViewHandler_AcceptProposalWithoutHeaderShouldCheckOnlyTheView(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	consensusState, ch := initViewHandlerConsensusState(container)
+	vh := pbft.NewViewHandler(container, consensusState, ch, chainID, currentPid)
+	numSetProposal := 0
+	setProposal := func() {
+		numSetProposal++
+	}
+
+	r := vh.AcceptProposal(0, nil, setProposal)
+	assert.True(t, r)
+	assert.Equal(t, 1, numSetProposal)
+
+	r = vh.AcceptProposal(1, nil, setProposal)
+	assert.False(t, r)
+	assert.Equal(t, 1, numSetProposal)
+}
+
+func TestViewHandler_ExecuteInViewShouldExecuteOnlyInTheSameRoundAndView(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	consensusState, ch := initViewHandlerConsensusState(container)
+	vh := pbft.NewViewHandler(container, consensusState, ch, chainID, currentPid)
+	numExecuted := 0
+	action := func() {
+		numExecuted++
+	}
+
+	assert.True(t, vh.ExecuteInView(2, 0, action))
+	assert.Equal(t, 1, numExecuted)
+
+	assert.False(t, vh.ExecuteInView(3, 0, action))
+	assert.Equal(t, 1, numExecuted)
+
+	consensusState.SetView(1)
+	assert.False(t, vh.ExecuteInView(2, 0, action))
+	assert.Equal(t, 1, numExecuted)
+
+	consensusState.RoundCanceled = true
+	assert.False(t, vh.ExecuteInView(2, 1, action))
+	assert.Equal(t, 1, numExecuted)
+}
+
+func TestViewHandler_GetBlockCreationEndTimeShouldDependOnTheView(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	consensusState, ch := initViewHandlerConsensusState(container)
+	vh := pbft.NewViewHandler(container, consensusState, ch, chainID, currentPid)
+	roundDuration := container.Rounder().TimeDuration()
+
+	assert.Equal(t, pbft.GetBlockCreationEndTime(roundDuration, 0), vh.GetBlockCreationEndTime(0, 0))
+	assert.Equal(t, pbft.GetBlockCreationEndTime(roundDuration, 1), vh.GetBlockCreationEndTime(1, 0))
+}
+
+func TestViewHandler_TimeIntervalsOfViews(t *testing.T) {
+	t.Parallel()
+
+	roundDuration := 1000 * time.Millisecond
+
+	assert.Equal(t, 250*time.Millisecond, pbft.GetViewEndTime(roundDuration, 0))
+	assert.Equal(t, 650*time.Millisecond, pbft.GetViewEndTime(roundDuration, pbft.MaxView))
+	assert.Equal(t, 200*time.Millisecond, pbft.GetBlockCreationEndTime(roundDuration, 0))
+	assert.Equal(t, 400*time.Millisecond, pbft.GetBlockCreationEndTime(roundDuration, 1))
+}
@@ -2,6 +2,8 @@ package spos
 
 import (
 	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
 )
 
 // roundConsensus defines the data needed by spos to do the consensus in each round
@@ -11,6 +13,8 @@ type roundConsensus struct {
 	consensusGroup       []string
 	consensusGroupSize   int
 	selfPubKey           string
+	nodePubKey           string
+	keysHandler          consensus.KeysHandler
 	validatorRoundStates map[string]*roundState
 	mut                  sync.RWMutex
 }
@@ -20,12 +24,15 @@ func NewRoundConsensus(
 	eligibleNodes map[string]struct{},
 	consensusGroupSize int,
 	selfId string,
+	keysHandler consensus.KeysHandler,
 ) *roundConsensus {
 
 	rcns := roundConsensus{
 		eligibleNodes:      eligibleNodes,
 		consensusGroupSize: consensusGroupSize,
 		selfPubKey:         selfId,
+		nodePubKey:         selfId,
+		keysHandler:        keysHandler,
 		mutEligible:        sync.RWMutex{},
 	}
 
@@ -96,6 +103,26 @@ func (rcns *roundConsensus) SetSelfPubKey(selfPubKey string) {
 	rcns.selfPubKey = selfPubKey
 }
 
+// NodePubKey returns the public key of the node's own validator key, which does not change when the node acts for
+// one of its managed keys
+func (rcns *roundConsensus) NodePubKey() string {
+	return rcns.nodePubKey
+}
+
+// KeysHandler returns the handler of the additional validator keys managed by the node
+func (rcns *roundConsensus) KeysHandler() consensus.KeysHandler {
+	return rcns.keysHandler
+}
+
+// IsKeyManagedByCurrentNode returns true if the provided public key is the node's own key or one of its managed keys
+func (rcns *roundConsensus) IsKeyManagedByCurrentNode(pubKey string) bool {
+	if pubKey == rcns.nodePubKey {
+		return true
+	}
+
+	return rcns.keysHandler.IsKeyManaged([]byte(pubKey))
+}
+
 // JobDone returns the state of the action done, by the node represented by the key parameter,
 // in subround given by the subroundId parameter
 func (rcns *roundConsensus) JobDone(key string, subroundId int) (bool, error) {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -19,7 +20,9 @@ func initRoundConsensus() *spos.RoundConsensus {
 	rcns := spos.NewRoundConsensus(
 		eligibleNodes,
 		len(eligibleNodes),
-		"2")
+		"2",
+		&testscommon.KeysHandlerStub{},
+	)
 
 	rcns.SetConsensusGroup(pubKeys)
 
@@ -49,7 +52,7 @@ func TestRoundConsensus_ConsensusGroupIndexFound(t *testing.T) {
 		eligibleNodes[pubKeys[i]] = struct{}{}
 	}
 
-	rcns := spos.NewRoundConsensus(eligibleNodes, 3, "key3")
+	rcns := spos.NewRoundConsensus(eligibleNodes, 3, "key3", &testscommon.KeysHandlerStub{})
 	rcns.SetConsensusGroup(pubKeys)
 	index, err := rcns.ConsensusGroupIndex("key3")
 
@@ -67,7 +70,7 @@ func TestRoundConsensus_ConsensusGroupIndexNotFound(t *testing.T) {
 		eligibleNodes[pubKeys[i]] = struct{}{}
 	}
 
-	rcns := spos.NewRoundConsensus(eligibleNodes, 3, "key4")
+	rcns := spos.NewRoundConsensus(eligibleNodes, 3, "key4", &testscommon.KeysHandlerStub{})
 	rcns.SetConsensusGroup(pubKeys)
 	index, err := rcns.ConsensusGroupIndex("key4")
 
@@ -85,7 +88,7 @@ func TestRoundConsensus_IndexSelfConsensusGroupInConsesus(t *testing.T) {
 		eligibleNodes[pubKeys[i]] = struct{}{}
 	}
 
-	rcns := spos.NewRoundConsensus(eligibleNodes, 3, "key2")
+	rcns := spos.NewRoundConsensus(eligibleNodes, 3, "key2", &testscommon.KeysHandlerStub{})
 	rcns.SetConsensusGroup(pubKeys)
 	index, err := rcns.SelfConsensusGroupIndex()
 
@@ -103,7 +106,7 @@ func TestRoundConsensus_IndexSelfConsensusGroupNotFound(t *testing.T) {
 		eligibleNodes[pubKeys[i]] = struct{}{}
 	}
 
-	rcns := spos.NewRoundConsensus(eligibleNodes, 3, "key4")
+	rcns := spos.NewRoundConsensus(eligibleNodes, 3, "key4", &testscommon.KeysHandlerStub{})
 	rcns.SetConsensusGroup(pubKeys)
 	index, err := rcns.SelfConsensusGroupIndex()
 
@@ -1,4 +1,5 @@
 package sposFactory
 
 const blsConsensusType = "bls"
+const pbftConsensusType = "pbft"
 const maxDelayCacheSize = 20
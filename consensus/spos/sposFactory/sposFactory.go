@@ -5,6 +5,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus/broadcast"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/pbft"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/crypto"
@@ -46,6 +47,26 @@ func GetSubroundsFactory(
 		subRoundFactoryBls.SetIndexer(indexer)
 
 		return subRoundFactoryBls, nil
+	case pbftConsensusType:
+		subRoundFactoryPbft, err := pbft.NewSubroundsFactory(
+			consensusDataContainer,
+			consensusState,
+			worker,
+			chainID,
+			currentPid,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		err = subRoundFactoryPbft.SetAppStatusHandler(appStatusHandler)
+		if err != nil {
+			return nil, err
+		}
+
+		subRoundFactoryPbft.SetIndexer(indexer)
+
+		return subRoundFactoryPbft, nil
 	default:
 		return nil, ErrInvalidConsensusType
 	}
@@ -56,6 +77,8 @@ func GetConsensusCoreFactory(consensusType string) (spos.ConsensusService, error
 	switch consensusType {
 	case blsConsensusType:
 		return bls.NewConsensusService()
+	case pbftConsensusType:
+		return pbft.NewConsensusService()
 	default:
 		return nil, ErrInvalidConsensusType
 	}
@@ -69,6 +92,7 @@ func GetBroadcastMessenger(
 	shardCoordinator sharding.Coordinator,
 	privateKey crypto.PrivateKey,
 	peerSignatureHandler crypto.PeerSignatureHandler,
+	keysHandler consensus.KeysHandler,
 	headersSubscriber consensus.HeadersPoolSubscriber,
 	interceptorsContainer process.InterceptorsContainer,
 ) (consensus.BroadcastMessenger, error) {
@@ -80,6 +104,7 @@ func GetBroadcastMessenger(
 		PrivateKey:                 privateKey,
 		ShardCoordinator:           shardCoordinator,
 		PeerSignatureHandler:       peerSignatureHandler,
+		KeysHandler:                keysHandler,
 		HeadersSubscriber:          headersSubscriber,
 		MaxDelayCacheSize:          maxDelayCacheSize,
 		MaxValidatorDelayCacheSize: maxDelayCacheSize,
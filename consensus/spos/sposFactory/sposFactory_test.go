@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -32,6 +33,15 @@ func TestGetConsensusCoreFactory_BlsShouldWork(t *testing.T) {
 	assert.False(t, check.IfNil(csf))
 }
 
+func TestGetConsensusCoreFactory_PbftShouldWork(t *testing.T) {
+	t.Parallel()
+
+	csf, err := sposFactory.GetConsensusCoreFactory(consensus.PbftConsensusType)
+
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(csf))
+}
+
 func TestGetSubroundsFactory_BlsNilConsensusCoreShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -101,6 +111,29 @@ func TestGetSubroundsFactory_BlsShouldWork(t *testing.T) {
 	assert.False(t, check.IfNil(sf))
 }
 
+func TestGetSubroundsFactory_PbftShouldWork(t *testing.T) {
+	t.Parallel()
+
+	consensusCore := mock.InitConsensusCore()
+	worker := &mock.SposWorkerMock{}
+	consensusType := consensus.PbftConsensusType
+	statusHandler := &mock.AppStatusHandlerMock{}
+	chainID := []byte("chain-id")
+	indexer := &mock.IndexerMock{}
+	sf, err := sposFactory.GetSubroundsFactory(
+		consensusCore,
+		&spos.ConsensusState{},
+		worker,
+		consensusType,
+		statusHandler,
+		indexer,
+		chainID,
+		currentPid,
+	)
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(sf))
+}
+
 func TestGetSubroundsFactory_InvalidConsensusTypeShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -141,6 +174,7 @@ func TestGetBroadcastMessenger_ShardShouldWork(t *testing.T) {
 		shardCoord,
 		privateKey,
 		peerSigHandler,
+		&testscommon.KeysHandlerStub{},
 		headersSubscriber,
 		interceptosContainer,
 	)
@@ -170,6 +204,7 @@ func TestGetBroadcastMessenger_MetachainShouldWork(t *testing.T) {
 		shardCoord,
 		privateKey,
 		peerSigHandler,
+		&testscommon.KeysHandlerStub{},
 		headersSubscriber,
 		interceptosContainer,
 	)
@@ -195,6 +230,7 @@ func TestGetBroadcastMessenger_InvalidShardIdShouldErr(t *testing.T) {
 		shardCoord,
 		nil,
 		nil,
+		&testscommon.KeysHandlerStub{},
 		headersSubscriber,
 		interceptosContainer,
 	)
@@ -65,8 +65,9 @@ type Worker struct {
 	receivedHeadersHandlers   []func(headerHandler data.HeaderHandler)
 	mutReceivedHeadersHandler sync.RWMutex
 
-	antifloodHandler consensus.P2PAntifloodHandler
-	poolAdder        PoolAdder
+	antifloodHandler     consensus.P2PAntifloodHandler
+	poolAdder            PoolAdder
+	equivocationDetector EquivocationDetector
 
 	cancelFunc                func()
 	consensusMessageValidator *consensusMessageValidator
@@ -93,6 +94,7 @@ type WorkerArgs struct {
 	NetworkShardingCollector consensus.NetworkShardingCollector
 	AntifloodHandler         consensus.P2PAntifloodHandler
 	PoolAdder                PoolAdder
+	EquivocationDetector     EquivocationDetector
 	SignatureSize            int
 	PublicKeySize            int
 }
@@ -139,6 +141,7 @@ func NewWorker(args *WorkerArgs) (*Worker, error) {
 		networkShardingCollector: args.NetworkShardingCollector,
 		antifloodHandler:         args.AntifloodHandler,
 		poolAdder:                args.PoolAdder,
+		equivocationDetector:     args.EquivocationDetector,
 	}
 
 	wrk.consensusMessageValidator = consensusMessageValidatorObj
@@ -227,6 +230,9 @@ func checkNewWorkerParams(args *WorkerArgs) error {
 	if check.IfNil(args.PoolAdder) {
 		return ErrNilPoolAdder
 	}
+	if check.IfNil(args.EquivocationDetector) {
+		return ErrNilEquivocationDetector
+	}
 
 	return nil
 }
@@ -349,6 +355,10 @@ func (wrk *Worker) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedP
 	)
 
 	err = wrk.consensusMessageValidator.checkConsensusMessageValidity(cnsMsg, message.Peer())
+	if err == nil || errors.Is(err, ErrMessageTypeLimitReached) {
+		// messages over the type limit are the ones that can prove an equivocation, the detector verifies them on its own
+		wrk.equivocationDetector.ProcessConsensusMessage(cnsMsg)
+	}
 	if err != nil {
 		return err
 	}
@@ -489,7 +499,7 @@ func (wrk *Worker) updateNetworkShardingVals(message p2p.MessageP2P, cnsMsg *con
 }
 
 func (wrk *Worker) checkSelfState(cnsDta *consensus.Message) error {
-	if wrk.consensusState.SelfPubKey() == string(cnsDta.PubKey) {
+	if wrk.consensusState.IsNodeSelf(string(cnsDta.PubKey)) {
 		return ErrMessageFromItself
 	}
 
@@ -93,6 +93,7 @@ func createDefaultWorkerArgs() *spos.WorkerArgs {
 		NetworkShardingCollector: createMockNetworkShardingCollector(),
 		AntifloodHandler:         createMockP2PAntifloodHandler(),
 		PoolAdder:                poolAdder,
+		EquivocationDetector:     &testscommon.EquivocationDetectorStub{},
 		SignatureSize:            SignatureSize,
 		PublicKeySize:            PublicKeySize,
 	}
@@ -346,6 +347,17 @@ func TestWorker_NewWorkerPoolAdderNilShouldFail(t *testing.T) {
 	assert.Equal(t, spos.ErrNilPoolAdder, err)
 }
 
+func TestWorker_NewWorkerEquivocationDetectorNilShouldFail(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs()
+	workerArgs.EquivocationDetector = nil
+	wrk, err := spos.NewWorker(workerArgs)
+
+	assert.Nil(t, wrk)
+	assert.Equal(t, spos.ErrNilEquivocationDetector, err)
+}
+
 func TestWorker_NewWorkerShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -785,6 +797,77 @@ func TestWorker_ProcessReceivedMessageTypeLimitReachedShouldErr(t *testing.T) {
 	assert.True(t, errors.Is(err, spos.ErrMessageTypeLimitReached))
 }
 
+func TestWorker_ProcessReceivedMessageShouldCallEquivocationDetectorEvenIfTypeLimitReached(t *testing.T) {
+	t.Parallel()
+
+	numCalls := uint32(0)
+	workerArgs := createDefaultWorkerArgs()
+	workerArgs.EquivocationDetector = &testscommon.EquivocationDetectorStub{
+		ProcessConsensusMessageCalled: func(cnsMsg *consensus.Message) {
+			atomic.AddUint32(&numCalls, 1)
+		},
+	}
+	wrk, _ := spos.NewWorker(workerArgs)
+
+	blk := &block.Body{}
+	blkStr, _ := mock.MarshalizerMock{}.Marshal(blk)
+	cnsMsg := consensus.NewConsensusMessage(
+		nil,
+		nil,
+		blkStr,
+		nil,
+		[]byte(wrk.ConsensusState().ConsensusGroup()[0]),
+		signature,
+		int(bls.MtBlockBody),
+		0,
+		chainID,
+		nil,
+		nil,
+		nil,
+		currentPid,
+	)
+	buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
+
+	err := wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff, PeerField: currentPid}, fromConnectedPeerId)
+	assert.Nil(t, err)
+
+	err = wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff, PeerField: currentPid}, fromConnectedPeerId)
+	assert.True(t, errors.Is(err, spos.ErrMessageTypeLimitReached))
+	assert.Equal(t, uint32(2), atomic.LoadUint32(&numCalls))
+}
+
+func TestWorker_ProcessReceivedMessageInvalidChainIDShouldNotCallEquivocationDetector(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs()
+	workerArgs.EquivocationDetector = &testscommon.EquivocationDetectorStub{
+		ProcessConsensusMessageCalled: func(cnsMsg *consensus.Message) {
+			assert.Fail(t, "should have not called ProcessConsensusMessage")
+		},
+	}
+	wrk, _ := spos.NewWorker(workerArgs)
+
+	cnsMsg := consensus.NewConsensusMessage(
+		blockHeaderHash,
+		nil,
+		nil,
+		nil,
+		[]byte(wrk.ConsensusState().ConsensusGroup()[0]),
+		signature,
+		int(bls.MtBlockBody),
+		0,
+		[]byte("inconsistent chain ID"),
+		nil,
+		nil,
+		nil,
+		currentPid,
+	)
+	buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
+
+	err := wrk.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff}, fromConnectedPeerId)
+	assert.True(t, errors.Is(err, spos.ErrInvalidChainID))
+}
+
 func TestWorker_ProcessReceivedMessageInvalidSignatureShouldErr(t *testing.T) {
 	t.Parallel()
 	wrk := *initWorker()
@@ -0,0 +1,60 @@
+package core
+
+import "encoding/binary"
+
+const consensusViewSize = 4
+
+// EncodeConsensusView returns the content of the header reserved field for a block proposed after the provided number
+// of view changes. Blocks proposed by the first leader of the round keep the reserved field empty
+func EncodeConsensusView(view uint32) []byte {
+	if view == 0 {
+		return nil
+	}
+
+	buff := make([]byte, consensusViewSize)
+	binary.BigEndian.PutUint32(buff, view)
+
+	return buff
+}
+
+// DecodeConsensusView returns the view of the consensus round in which a block was proposed, as stored in the header
+// reserved field
+func DecodeConsensusView(reserved []byte) (uint32, error) {
+	if len(reserved) == 0 {
+		return 0, nil
+	}
+	if len(reserved) != consensusViewSize {
+		return 0, ErrInvalidConsensusView
+	}
+
+	view := binary.BigEndian.Uint32(reserved)
+	if view == 0 {
+		return 0, ErrInvalidConsensusView
+	}
+
+	return view, nil
+}
+
+// GetConsensusLeaderIndex returns the index in the consensus group of the leader of the provided view
+func GetConsensusLeaderIndex(view uint32, consensusSize int) int {
+	if consensusSize <= 0 {
+		return 0
+	}
+
+	return int(view % uint32(consensusSize))
+}
+
+// GetConsensusView returns the view of the consensus round in which a block was proposed. Only the consensus types
+// with leader view changes store the view in the header reserved field, for the other ones the reserved field has to
+// be empty and the block is always proposed by the first validator of the consensus group
+func GetConsensusView(reserved []byte, isConsensusViewEnabled bool) (uint32, error) {
+	if !isConsensusViewEnabled {
+		if len(reserved) > 0 {
+			return 0, ErrInvalidConsensusView
+		}
+
+		return 0, nil
+	}
+
+	return DecodeConsensusView(reserved)
+}
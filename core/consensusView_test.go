@@ -0,0 +1,75 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeConsensusView_FirstViewShouldBeEmpty(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, core.EncodeConsensusView(0))
+}
+
+func TestDecodeConsensusView_EmptyShouldReturnFirstView(t *testing.T) {
+	t.Parallel()
+
+	view, err := core.DecodeConsensusView(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(0), view)
+}
+
+func TestDecodeConsensusView_InvalidSizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, err := core.DecodeConsensusView([]byte{1, 2})
+	assert.Equal(t, core.ErrInvalidConsensusView, err)
+}
+
+func TestDecodeConsensusView_NonCanonicalFirstViewShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, err := core.DecodeConsensusView([]byte{0, 0, 0, 0})
+	assert.Equal(t, core.ErrInvalidConsensusView, err)
+}
+
+func TestEncodeDecodeConsensusView(t *testing.T) {
+	t.Parallel()
+
+	view, err := core.DecodeConsensusView(core.EncodeConsensusView(3))
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(3), view)
+}
+
+func TestGetConsensusLeaderIndex(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 0, core.GetConsensusLeaderIndex(0, 5))
+	assert.Equal(t, 2, core.GetConsensusLeaderIndex(2, 5))
+	assert.Equal(t, 1, core.GetConsensusLeaderIndex(6, 5))
+	assert.Equal(t, 0, core.GetConsensusLeaderIndex(3, 0))
+}
+
+func TestGetConsensusView_DisabledShouldRejectNonEmptyReserved(t *testing.T) {
+	t.Parallel()
+
+	view, err := core.GetConsensusView(nil, false)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(0), view)
+
+	_, err = core.GetConsensusView(core.EncodeConsensusView(3), false)
+	assert.Equal(t, core.ErrInvalidConsensusView, err)
+}
+
+func TestGetConsensusView_EnabledShouldDecodeTheView(t *testing.T) {
+	t.Parallel()
+
+	view, err := core.GetConsensusView(core.EncodeConsensusView(3), true)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(3), view)
+
+	_, err = core.GetConsensusView([]byte{1, 2}, true)
+	assert.Equal(t, core.ErrInvalidConsensusView, err)
+}
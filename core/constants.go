@@ -436,6 +436,45 @@ const MetricP2PUnknownPeers = "erd_p2p_unknown_shard_peers"
 // MetricP2PNumConnectedPeersClassification is the metric for monitoring the number of connected peers split on the connection type
 const MetricP2PNumConnectedPeersClassification = "erd_p2p_num_connected_peers_classification"
 
+// MetricSubroundDuration is the histogram metric for the time spent in each consensus subround
+const MetricSubroundDuration = "erd_consensus_subround_duration_seconds"
+
+// MetricTxCacheSelectionDuration is the histogram metric for the time spent selecting transactions from the transactions cache
+const MetricTxCacheSelectionDuration = "erd_txcache_selection_duration_seconds"
+
+// MetricInterceptorProcessingDuration is the histogram metric for the time spent processing a received message on a topic
+const MetricInterceptorProcessingDuration = "erd_interceptor_processing_duration_seconds"
+
+// MetricTrieCommitDuration is the histogram metric for the time spent committing a state trie
+const MetricTrieCommitDuration = "erd_trie_commit_duration_seconds"
+
+// MetricTrieSnapshotDuration is the histogram metric for the time spent taking a snapshot or a checkpoint of a state trie
+const MetricTrieSnapshotDuration = "erd_trie_snapshot_duration_seconds"
+
+// MetricP2PTopicReceivedMessages is the counter metric for the number of messages received on a p2p topic
+const MetricP2PTopicReceivedMessages = "erd_p2p_topic_received_messages_total"
+
+// MetricP2PTopicReceivedBytes is the counter metric for the number of bytes received on a p2p topic
+const MetricP2PTopicReceivedBytes = "erd_p2p_topic_received_bytes_total"
+
+// MetricLabelShard is the label holding the shard of the node in the typed metrics
+const MetricLabelShard = "shard"
+
+// MetricLabelTopic is the label holding the p2p topic
+const MetricLabelTopic = "topic"
+
+// MetricLabelSubround is the label holding the consensus subround name
+const MetricLabelSubround = "subround"
+
+// MetricLabelCache is the label holding the transactions cache name
+const MetricLabelCache = "cache"
+
+// MetricLabelTrie is the label holding the state trie type
+const MetricLabelTrie = "trie"
+
+// MetricLabelOperation is the label holding the trie storage operation type
+const MetricLabelOperation = "operation"
+
 // HighestRoundFromBootStorage is the key for the highest round that is saved in storage
 const HighestRoundFromBootStorage = "highestRoundFromBootStorage"
 
@@ -481,6 +520,63 @@ const BuiltInFunctionESDTPause = "ESDTPause"
 // BuiltInFunctionESDTUnPause is the key for the elrond standard digital token unpause built-in function
 const BuiltInFunctionESDTUnPause = "ESDTUnPause"
 
+// BuiltInFunctionESDTApprove is the key for the elrond standard digital token approve built-in function
+const BuiltInFunctionESDTApprove = "ESDTApprove"
+
+// BuiltInFunctionESDTTransferFrom is the key for the elrond standard digital token transfer from built-in function
+const BuiltInFunctionESDTTransferFrom = "ESDTTransferFrom"
+
+// BuiltInFunctionESDTSetRole is the key for the elrond standard digital token set special role built-in function
+const BuiltInFunctionESDTSetRole = "ESDTSetRole"
+
+// BuiltInFunctionESDTUnSetRole is the key for the elrond standard digital token unset special role built-in function
+const BuiltInFunctionESDTUnSetRole = "ESDTUnSetRole"
+
+// BuiltInFunctionESDTNFTCreate is the key for the elrond standard digital token NFT create built-in function
+const BuiltInFunctionESDTNFTCreate = "ESDTNFTCreate"
+
+// BuiltInFunctionESDTNFTTransfer is the key for the elrond standard digital token NFT transfer built-in function
+const BuiltInFunctionESDTNFTTransfer = "ESDTNFTTransfer"
+
+// BuiltInFunctionESDTNFTAddQuantity is the key for the elrond standard digital token NFT add quantity built-in function
+const BuiltInFunctionESDTNFTAddQuantity = "ESDTNFTAddQuantity"
+
+// BuiltInFunctionESDTNFTBurn is the key for the elrond standard digital token NFT burn built-in function
+const BuiltInFunctionESDTNFTBurn = "ESDTNFTBurn"
+
+// ESDTRoleNFTCreate is the special role which allows an account to create new nonces of a non-fungible token
+const ESDTRoleNFTCreate = "ESDTRoleNFTCreate"
+
+// ESDTRoleNFTAddQuantity is the special role which allows an account to add quantity to a semi-fungible token nonce
+const ESDTRoleNFTAddQuantity = "ESDTRoleNFTAddQuantity"
+
+// ESDTRoleNFTBurn is the special role which allows an account to burn quantity from a non-fungible token nonce
+const ESDTRoleNFTBurn = "ESDTRoleNFTBurn"
+
+// FungibleESDT defines the token type of fungible elrond standard digital tokens
+const FungibleESDT = "FungibleESDT"
+
+// NonFungibleESDT defines the token type of non-fungible elrond standard digital tokens
+const NonFungibleESDT = "NonFungibleESDT"
+
+// SemiFungibleESDT defines the token type of semi-fungible elrond standard digital tokens
+const SemiFungibleESDT = "SemiFungibleESDT"
+
+// ESDTType defines the possible types of an elrond standard digital token, as saved in the account data trie
+type ESDTType uint32
+
+const (
+	// Fungible defines the token type for fungible ESDT tokens
+	Fungible ESDTType = iota
+	// NonFungible defines the token type for non-fungible ESDT tokens
+	NonFungible
+	// SemiFungible defines the token type for semi-fungible ESDT tokens
+	SemiFungible
+)
+
+// MaxRoyalty defines the maximum royalties value, expressed in basis points, for a non-fungible token nonce
+const MaxRoyalty = uint32(10000)
+
 // RelayedTransaction is the key for the elrond meta/gassless/relayed transaction standard
 const RelayedTransaction = "relayedTx"
 
@@ -508,6 +604,15 @@ const DelegationSystemSCKey = "delegation"
 // ESDTKeyIdentifier is the key prefix for esdt tokens
 const ESDTKeyIdentifier = "esdt"
 
+// ESDTAllowanceKeyIdentifier is the key prefix for esdt allowances, followed by the token name and the spender address
+const ESDTAllowanceKeyIdentifier = "allowance"
+
+// ESDTRoleIdentifier is the key prefix for the special roles an account has for an esdt token
+const ESDTRoleIdentifier = "role"
+
+// ESDTNFTLatestNonceIdentifier is the key prefix for the latest nonce created by an account for a non-fungible token
+const ESDTNFTLatestNonceIdentifier = "nonce"
+
 // MaxSoftwareVersionLengthInBytes represents the maximum length for the software version to be saved in block header
 const MaxSoftwareVersionLengthInBytes = 10
 
@@ -0,0 +1,330 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. chunkedRecords.proto
+
+package dblookupext
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const (
+	sizeOfCursor               = 8
+	maxRecordEntriesPerChunk   = 100
+	recordLatestEpochKeyPrefix = byte('l')
+	recordHeaderKeyPrefix      = byte('h')
+	recordChunkKeyPrefix       = byte('c')
+)
+
+type walkAction int
+
+const (
+	// continueWalk moves the walk to the previous entry
+	continueWalk walkAction = iota
+	// pauseWalk stops the walk and returns a cursor pointing to the visited entry, so that it is visited again
+	// when the walk is resumed
+	pauseWalk
+	// endWalk stops the walk without returning a cursor
+	endWalk
+)
+
+// chunkedRecords stores, per key and per epoch, an append-only list of entries. The entries are split in chunks
+// of bounded size, so that appending the entries of a block only rewrites the last chunk and a small header
+// instead of the whole list.
+type chunkedRecords struct {
+	marshalizer  marshal.Marshalizer
+	storer       storage.Storer
+	maxChunkSize uint32
+}
+
+func newChunkedRecords(storer storage.Storer, marshalizer marshal.Marshalizer) *chunkedRecords {
+	return &chunkedRecords{
+		marshalizer:  marshalizer,
+		storer:       storer,
+		maxChunkSize: maxRecordEntriesPerChunk,
+	}
+}
+
+// appendEntries appends the entries produced by the block having the provided nonce. The entries previously
+// recorded by blocks having the same or a higher nonce are dropped first: they belong either to the same block,
+// which is re-recorded, or to blocks which were reverted and are now replaced.
+func (cr *chunkedRecords) appendEntries(key []byte, epoch uint32, nonce uint64, entries []*RecordEntry) error {
+	header, err := cr.getHeader(key, epoch)
+	isNewHeader := err != nil
+	latestEpoch, errLatest := cr.getLatestEpoch(key)
+	if isNewHeader {
+		header = &RecordHeader{}
+		if errLatest == nil && latestEpoch < epoch {
+			header.PreviousEpoch = latestEpoch
+			header.HasPreviousEpoch = true
+		}
+	}
+
+	var chunk *RecordChunk
+	chunkIndex := uint32(0)
+	loadChunk := func(index uint32) error {
+		if chunk != nil && chunkIndex == index {
+			return nil
+		}
+
+		chunk, err = cr.getChunk(key, epoch, index)
+		chunkIndex = index
+		return err
+	}
+
+	for header.NumEntries > 0 {
+		position := header.NumEntries - 1
+		err = loadChunk(position / cr.maxChunkSize)
+		if err != nil {
+			return err
+		}
+
+		offset := position % cr.maxChunkSize
+		if offset >= uint32(len(chunk.Entries)) {
+			return ErrCorruptedRecord
+		}
+		if chunk.Entries[offset].HeaderNonce < nonce {
+			break
+		}
+
+		header.NumEntries--
+	}
+
+	if header.NumEntries%cr.maxChunkSize == 0 {
+		chunk = &RecordChunk{}
+		chunkIndex = header.NumEntries / cr.maxChunkSize
+	} else {
+		err = loadChunk(header.NumEntries / cr.maxChunkSize)
+		if err != nil {
+			return err
+		}
+		chunk.Entries = chunk.Entries[:header.NumEntries%cr.maxChunkSize]
+	}
+
+	for _, entry := range entries {
+		chunk.Entries = append(chunk.Entries, entry)
+		header.NumEntries++
+		if uint32(len(chunk.Entries)) < cr.maxChunkSize {
+			continue
+		}
+
+		err = cr.saveChunk(key, epoch, chunkIndex, chunk)
+		if err != nil {
+			return err
+		}
+
+		chunk = &RecordChunk{}
+		chunkIndex++
+	}
+
+	if len(chunk.Entries) > 0 {
+		err = cr.saveChunk(key, epoch, chunkIndex, chunk)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = cr.saveHeader(key, epoch, header)
+	if err != nil {
+		return err
+	}
+
+	if errLatest == nil && latestEpoch >= epoch {
+		return nil
+	}
+
+	return cr.saveLatestEpoch(key, epoch)
+}
+
+// walkEntries visits the entries of the key, from the position encoded in the cursor (or from the newest entry, if
+// the cursor is empty) towards the oldest one, following the links between epochs. It returns the cursor from which
+// the walk can be resumed, or nil if there are no more (unpruned) entries to visit.
+func (cr *chunkedRecords) walkEntries(key []byte, cursor []byte, visit func(entry *RecordEntry) walkAction) ([]byte, error) {
+	epoch, position, err := cr.decodeCursor(key, cursor)
+	if err != nil {
+		return nil, err
+	}
+	if epoch == nil {
+		return nil, nil
+	}
+
+	currentEpoch := *epoch
+	for {
+		header, errGet := cr.getHeader(key, currentEpoch)
+		if errGet != nil {
+			return nil, nil
+		}
+
+		if position > header.NumEntries {
+			position = header.NumEntries
+		}
+
+		var chunk *RecordChunk
+		chunkIndex := uint32(0)
+		for position > 0 {
+			index := (position - 1) / cr.maxChunkSize
+			if chunk == nil || chunkIndex != index {
+				chunk, errGet = cr.getChunk(key, currentEpoch, index)
+				if errGet != nil {
+					return nil, nil
+				}
+				chunkIndex = index
+			}
+
+			offset := (position - 1) % cr.maxChunkSize
+			if offset >= uint32(len(chunk.Entries)) {
+				return nil, nil
+			}
+
+			switch visit(chunk.Entries[offset]) {
+			case pauseWalk:
+				return encodeCursor(currentEpoch, position), nil
+			case endWalk:
+				return nil, nil
+			}
+
+			position--
+		}
+
+		if !header.HasPreviousEpoch {
+			return nil, nil
+		}
+
+		currentEpoch = header.PreviousEpoch
+		position = math.MaxUint32
+	}
+}
+
+func (cr *chunkedRecords) getHeader(key []byte, epoch uint32) (*RecordHeader, error) {
+	rawBytes, err := cr.storer.GetFromEpoch(createRecordKey(recordHeaderKeyPrefix, key, epoch), epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	header := &RecordHeader{}
+	err = cr.marshalizer.Unmarshal(header, rawBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return header, nil
+}
+
+func (cr *chunkedRecords) saveHeader(key []byte, epoch uint32, header *RecordHeader) error {
+	rawBytes, err := cr.marshalizer.Marshal(header)
+	if err != nil {
+		return err
+	}
+
+	return cr.storer.PutInEpoch(createRecordKey(recordHeaderKeyPrefix, key, epoch), rawBytes, epoch)
+}
+
+func (cr *chunkedRecords) getChunk(key []byte, epoch uint32, index uint32) (*RecordChunk, error) {
+	rawBytes, err := cr.storer.GetFromEpoch(createRecordChunkKey(key, epoch, index), epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	chunk := &RecordChunk{}
+	err = cr.marshalizer.Unmarshal(chunk, rawBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return chunk, nil
+}
+
+func (cr *chunkedRecords) saveChunk(key []byte, epoch uint32, index uint32, chunk *RecordChunk) error {
+	rawBytes, err := cr.marshalizer.Marshal(chunk)
+	if err != nil {
+		return err
+	}
+
+	return cr.storer.PutInEpoch(createRecordChunkKey(key, epoch, index), rawBytes, epoch)
+}
+
+func (cr *chunkedRecords) getLatestEpoch(key []byte) (uint32, error) {
+	rawBytes, err := cr.storer.SearchFirst(createRecordLatestEpochKey(key))
+	if err != nil {
+		return 0, err
+	}
+
+	record := &EpochByHash{}
+	err = cr.marshalizer.Unmarshal(record, rawBytes)
+	if err != nil {
+		return 0, err
+	}
+
+	return record.Epoch, nil
+}
+
+func (cr *chunkedRecords) saveLatestEpoch(key []byte, epoch uint32) error {
+	record := &EpochByHash{
+		Epoch: epoch,
+	}
+
+	rawBytes, err := cr.marshalizer.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	return cr.storer.PutInEpoch(createRecordLatestEpochKey(key), rawBytes, epoch)
+}
+
+// decodeCursor returns the epoch and the position from where the entries of the key should be read (backwards). An
+// empty cursor points to the end of the latest epoch of the key. A nil epoch signals that the key does not have any
+// recorded entries.
+func (cr *chunkedRecords) decodeCursor(key []byte, cursor []byte) (*uint32, uint32, error) {
+	if len(cursor) == 0 {
+		latestEpoch, err := cr.getLatestEpoch(key)
+		if err != nil {
+			return nil, 0, nil
+		}
+
+		return &latestEpoch, math.MaxUint32, nil
+	}
+
+	if len(cursor) != sizeOfCursor {
+		return nil, 0, ErrInvalidCursor
+	}
+
+	epoch := binary.BigEndian.Uint32(cursor[:4])
+	position := binary.BigEndian.Uint32(cursor[4:])
+
+	return &epoch, position, nil
+}
+
+func encodeCursor(epoch uint32, position uint32) []byte {
+	cursor := make([]byte, sizeOfCursor)
+	binary.BigEndian.PutUint32(cursor[:4], epoch)
+	binary.BigEndian.PutUint32(cursor[4:], position)
+
+	return cursor
+}
+
+func createRecordLatestEpochKey(key []byte) []byte {
+	latestEpochKey := make([]byte, len(key)+1)
+	latestEpochKey[0] = recordLatestEpochKeyPrefix
+	copy(latestEpochKey[1:], key)
+
+	return latestEpochKey
+}
+
+func createRecordKey(prefix byte, key []byte, epoch uint32) []byte {
+	recordKey := make([]byte, len(key)+5)
+	recordKey[0] = prefix
+	copy(recordKey[1:], key)
+	binary.BigEndian.PutUint32(recordKey[len(key)+1:], epoch)
+
+	return recordKey
+}
+
+func createRecordChunkKey(key []byte, epoch uint32, index uint32) []byte {
+	chunkKey := createRecordKey(recordChunkKeyPrefix, key, epoch)
+	indexBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(indexBytes, index)
+
+	return append(chunkKey, indexBytes...)
+}
@@ -0,0 +1,949 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: chunkedRecords.proto
+
+package dblookupext
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RecordEntry is used to store a marshalized entry of a chunked record along with the nonce of the block that
+// produced it
+type RecordEntry struct {
+	HeaderNonce uint64 `protobuf:"varint,1,opt,name=HeaderNonce,proto3" json:"HeaderNonce,omitempty"`
+	Data        []byte `protobuf:"bytes,2,opt,name=Data,proto3" json:"Data,omitempty"`
+}
+
+func (m *RecordEntry) Reset()      { *m = RecordEntry{} }
+func (*RecordEntry) ProtoMessage() {}
+func (*RecordEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_458968cf712a4f67, []int{0}
+}
+func (m *RecordEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RecordEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordEntry.Merge(m, src)
+}
+func (m *RecordEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordEntry proto.InternalMessageInfo
+
+func (m *RecordEntry) GetHeaderNonce() uint64 {
+	if m != nil {
+		return m.HeaderNonce
+	}
+	return 0
+}
+
+func (m *RecordEntry) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// RecordChunk is used to store a bounded slice of the entries of a chunked record
+type RecordChunk struct {
+	Entries []*RecordEntry `protobuf:"bytes,1,rep,name=Entries,proto3" json:"Entries,omitempty"`
+}
+
+func (m *RecordChunk) Reset()      { *m = RecordChunk{} }
+func (*RecordChunk) ProtoMessage() {}
+func (*RecordChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_458968cf712a4f67, []int{1}
+}
+func (m *RecordChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RecordChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordChunk.Merge(m, src)
+}
+func (m *RecordChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordChunk proto.InternalMessageInfo
+
+func (m *RecordChunk) GetEntries() []*RecordEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+// RecordHeader is used to store, per epoch, the number of entries of a chunked record along with a link to the
+// previous epoch in which entries were recorded
+type RecordHeader struct {
+	NumEntries       uint32 `protobuf:"varint,1,opt,name=NumEntries,proto3" json:"NumEntries,omitempty"`
+	PreviousEpoch    uint32 `protobuf:"varint,2,opt,name=PreviousEpoch,proto3" json:"PreviousEpoch,omitempty"`
+	HasPreviousEpoch bool   `protobuf:"varint,3,opt,name=HasPreviousEpoch,proto3" json:"HasPreviousEpoch,omitempty"`
+}
+
+func (m *RecordHeader) Reset()      { *m = RecordHeader{} }
+func (*RecordHeader) ProtoMessage() {}
+func (*RecordHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_458968cf712a4f67, []int{2}
+}
+func (m *RecordHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RecordHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordHeader.Merge(m, src)
+}
+func (m *RecordHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordHeader proto.InternalMessageInfo
+
+func (m *RecordHeader) GetNumEntries() uint32 {
+	if m != nil {
+		return m.NumEntries
+	}
+	return 0
+}
+
+func (m *RecordHeader) GetPreviousEpoch() uint32 {
+	if m != nil {
+		return m.PreviousEpoch
+	}
+	return 0
+}
+
+func (m *RecordHeader) GetHasPreviousEpoch() bool {
+	if m != nil {
+		return m.HasPreviousEpoch
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*RecordEntry)(nil), "proto.RecordEntry")
+	proto.RegisterType((*RecordChunk)(nil), "proto.RecordChunk")
+	proto.RegisterType((*RecordHeader)(nil), "proto.RecordHeader")
+}
+
+func init() { proto.RegisterFile("chunkedRecords.proto", fileDescriptor_458968cf712a4f67) }
+
+var fileDescriptor_458968cf712a4f67 = []byte{
+	// 300 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x8f, 0xbd, 0x4e, 0xc3, 0x30,
+	0x10, 0xc7, 0x7d, 0xb4, 0x7c, 0xc8, 0x69, 0x25, 0x64, 0x31, 0x54, 0x0c, 0xa7, 0xa8, 0x62, 0x88,
+	0x10, 0xb4, 0x12, 0x8c, 0x6c, 0x94, 0x4a, 0x9d, 0x2a, 0xe4, 0x91, 0x2d, 0x1f, 0xa6, 0x89, 0x4a,
+	0xe3, 0x28, 0x89, 0x11, 0x6c, 0x7d, 0x04, 0x1e, 0x83, 0x47, 0x61, 0xcc, 0x98, 0x91, 0x38, 0x0b,
+	0x63, 0x1f, 0x01, 0xd5, 0x51, 0x45, 0x2a, 0x26, 0xdf, 0xfd, 0x7c, 0xfe, 0xfd, 0xcf, 0xf4, 0xcc,
+	0x0f, 0x55, 0xbc, 0x14, 0x01, 0x17, 0xbe, 0x4c, 0x83, 0x6c, 0x94, 0xa4, 0x32, 0x97, 0xec, 0xd0,
+	0x1c, 0xe7, 0xd7, 0x8b, 0x28, 0x0f, 0x95, 0x37, 0xf2, 0xe5, 0x6a, 0xbc, 0x90, 0x0b, 0x39, 0x36,
+	0xd8, 0x53, 0xcf, 0xa6, 0x33, 0x8d, 0xa9, 0x9a, 0x57, 0xc3, 0x09, 0xb5, 0x1a, 0xcd, 0x34, 0xce,
+	0xd3, 0x77, 0x66, 0x53, 0x6b, 0x26, 0xdc, 0x40, 0xa4, 0x73, 0x19, 0xfb, 0x62, 0x00, 0x36, 0x38,
+	0x5d, 0xde, 0x46, 0x8c, 0xd1, 0xee, 0x83, 0x9b, 0xbb, 0x83, 0x03, 0x1b, 0x9c, 0x1e, 0x37, 0xf5,
+	0xf0, 0x6e, 0x27, 0x99, 0x6c, 0x17, 0x63, 0x57, 0xf4, 0x78, 0x6b, 0x8b, 0x44, 0x36, 0x00, 0xbb,
+	0xe3, 0x58, 0x37, 0xac, 0x09, 0x1b, 0xb5, 0x92, 0xf8, 0x6e, 0x64, 0xb8, 0x06, 0xda, 0x6b, 0x2e,
+	0x9a, 0x18, 0x86, 0x94, 0xce, 0xd5, 0xea, 0xcf, 0x00, 0x4e, 0x9f, 0xb7, 0x08, 0xbb, 0xa0, 0xfd,
+	0xc7, 0x54, 0xbc, 0x46, 0x52, 0x65, 0xd3, 0x44, 0xfa, 0xa1, 0x59, 0xa5, 0xcf, 0xf7, 0x21, 0xbb,
+	0xa4, 0xa7, 0x33, 0x37, 0xdb, 0x1f, 0xec, 0xd8, 0xe0, 0x9c, 0xf0, 0x7f, 0xfc, 0x7e, 0x5a, 0x54,
+	0x48, 0xca, 0x0a, 0xc9, 0xa6, 0x42, 0x58, 0x6b, 0x84, 0x4f, 0x8d, 0xf0, 0xa5, 0x11, 0x0a, 0x8d,
+	0x50, 0x6a, 0x84, 0x6f, 0x8d, 0xf0, 0xa3, 0x91, 0x6c, 0x34, 0xc2, 0x47, 0x8d, 0xa4, 0xa8, 0x91,
+	0x94, 0x35, 0x92, 0x27, 0x2b, 0xf0, 0x5e, 0xa4, 0x5c, 0xaa, 0x44, 0xbc, 0xe5, 0xde, 0x91, 0xf9,
+	0xe5, 0xed, 0xef, 0x00, 0xe4, 0x6a, 0x59, 0xa9, 0xa0, 0x01, 0x00, 0x00,
+}
+
+func (this *RecordEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RecordEntry)
+	if !ok {
+		that2, ok := that.(RecordEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.HeaderNonce != that1.HeaderNonce {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	return true
+}
+func (this *RecordChunk) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RecordChunk)
+	if !ok {
+		that2, ok := that.(RecordChunk)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Entries) != len(that1.Entries) {
+		return false
+	}
+	for i := range this.Entries {
+		if !this.Entries[i].Equal(that1.Entries[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *RecordHeader) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RecordHeader)
+	if !ok {
+		that2, ok := that.(RecordHeader)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NumEntries != that1.NumEntries {
+		return false
+	}
+	if this.PreviousEpoch != that1.PreviousEpoch {
+		return false
+	}
+	if this.HasPreviousEpoch != that1.HasPreviousEpoch {
+		return false
+	}
+	return true
+}
+func (this *RecordEntry) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&dblookupext.RecordEntry{")
+	s = append(s, "HeaderNonce: "+fmt.Sprintf("%#v", this.HeaderNonce)+",\n")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RecordChunk) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&dblookupext.RecordChunk{")
+	if this.Entries != nil {
+		s = append(s, "Entries: "+fmt.Sprintf("%#v", this.Entries)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RecordHeader) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&dblookupext.RecordHeader{")
+	s = append(s, "NumEntries: "+fmt.Sprintf("%#v", this.NumEntries)+",\n")
+	s = append(s, "PreviousEpoch: "+fmt.Sprintf("%#v", this.PreviousEpoch)+",\n")
+	s = append(s, "HasPreviousEpoch: "+fmt.Sprintf("%#v", this.HasPreviousEpoch)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringChunkedRecords(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *RecordEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintChunkedRecords(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.HeaderNonce != 0 {
+		i = encodeVarintChunkedRecords(dAtA, i, uint64(m.HeaderNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintChunkedRecords(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HasPreviousEpoch {
+		i--
+		if m.HasPreviousEpoch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PreviousEpoch != 0 {
+		i = encodeVarintChunkedRecords(dAtA, i, uint64(m.PreviousEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NumEntries != 0 {
+		i = encodeVarintChunkedRecords(dAtA, i, uint64(m.NumEntries))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintChunkedRecords(dAtA []byte, offset int, v uint64) int {
+	offset -= sovChunkedRecords(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RecordEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HeaderNonce != 0 {
+		n += 1 + sovChunkedRecords(uint64(m.HeaderNonce))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovChunkedRecords(uint64(l))
+	}
+	return n
+}
+
+func (m *RecordChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovChunkedRecords(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RecordHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumEntries != 0 {
+		n += 1 + sovChunkedRecords(uint64(m.NumEntries))
+	}
+	if m.PreviousEpoch != 0 {
+		n += 1 + sovChunkedRecords(uint64(m.PreviousEpoch))
+	}
+	if m.HasPreviousEpoch {
+		n += 2
+	}
+	return n
+}
+
+func sovChunkedRecords(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozChunkedRecords(x uint64) (n int) {
+	return sovChunkedRecords(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *RecordEntry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RecordEntry{`,
+		`HeaderNonce:` + fmt.Sprintf("%v", this.HeaderNonce) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RecordChunk) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForEntries := "[]*RecordEntry{"
+	for _, f := range this.Entries {
+		repeatedStringForEntries += strings.Replace(f.String(), "RecordEntry", "RecordEntry", 1) + ","
+	}
+	repeatedStringForEntries += "}"
+	s := strings.Join([]string{`&RecordChunk{`,
+		`Entries:` + repeatedStringForEntries + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RecordHeader) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RecordHeader{`,
+		`NumEntries:` + fmt.Sprintf("%v", this.NumEntries) + `,`,
+		`PreviousEpoch:` + fmt.Sprintf("%v", this.PreviousEpoch) + `,`,
+		`HasPreviousEpoch:` + fmt.Sprintf("%v", this.HasPreviousEpoch) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringChunkedRecords(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *RecordEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChunkedRecords
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderNonce", wireType)
+			}
+			m.HeaderNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChunkedRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeaderNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChunkedRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthChunkedRecords
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChunkedRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChunkedRecords(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthChunkedRecords
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthChunkedRecords
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordChunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChunkedRecords
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChunkedRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChunkedRecords
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChunkedRecords
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &RecordEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChunkedRecords(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthChunkedRecords
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthChunkedRecords
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChunkedRecords
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEntries", wireType)
+			}
+			m.NumEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChunkedRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousEpoch", wireType)
+			}
+			m.PreviousEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChunkedRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousEpoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasPreviousEpoch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChunkedRecords
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasPreviousEpoch = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChunkedRecords(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthChunkedRecords
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthChunkedRecords
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipChunkedRecords(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowChunkedRecords
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowChunkedRecords
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowChunkedRecords
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthChunkedRecords
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupChunkedRecords
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthChunkedRecords
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthChunkedRecords        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowChunkedRecords          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupChunkedRecords = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,118 @@
+package dblookupext
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericmocks"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestChunkedRecords(maxChunkSize uint32) (*chunkedRecords, *genericmocks.StorerMock) {
+	storer := genericmocks.NewStorerMock("Records", 0)
+	records := newChunkedRecords(storer, &mock.MarshalizerMock{})
+	records.maxChunkSize = maxChunkSize
+
+	return records, storer
+}
+
+func appendTestEntries(records *chunkedRecords, storer *genericmocks.StorerMock, epoch uint32, nonce uint64, numEntries int) error {
+	storer.SetCurrentEpoch(epoch)
+	entries := make([]*RecordEntry, 0, numEntries)
+	for i := 0; i < numEntries; i++ {
+		entries = append(entries, &RecordEntry{
+			HeaderNonce: nonce,
+			Data:        []byte(fmt.Sprintf("%d-%d", nonce, i)),
+		})
+	}
+
+	return records.appendEntries([]byte("key"), epoch, nonce, entries)
+}
+
+func walkTestEntries(records *chunkedRecords, cursor []byte, limit int) ([]string, []byte, error) {
+	visited := make([]string, 0)
+	nextCursor, err := records.walkEntries([]byte("key"), cursor, func(entry *RecordEntry) walkAction {
+		if len(visited) == limit {
+			return pauseWalk
+		}
+
+		visited = append(visited, string(entry.Data))
+		return continueWalk
+	})
+
+	return visited, nextCursor, err
+}
+
+func TestChunkedRecords_AppendShouldOnlyRewriteTheLastChunk(t *testing.T) {
+	t.Parallel()
+
+	records, storer := createTestChunkedRecords(2)
+
+	require.Nil(t, appendTestEntries(records, storer, 1, 10, 3))
+	chunk, err := records.getChunk([]byte("key"), 1, 0)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(chunk.Entries))
+
+	// the full chunk is not rewritten anymore
+	_ = storer.PutInEpoch(createRecordChunkKey([]byte("key"), 1, 0), []byte("not a chunk"), 1)
+	require.Nil(t, appendTestEntries(records, storer, 1, 11, 2))
+
+	header, err := records.getHeader([]byte("key"), 1)
+	require.Nil(t, err)
+	require.Equal(t, uint32(5), header.NumEntries)
+	chunk, err = records.getChunk([]byte("key"), 1, 1)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(chunk.Entries))
+	chunk, err = records.getChunk([]byte("key"), 1, 2)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(chunk.Entries))
+}
+
+func TestChunkedRecords_WalkAcrossChunksAndEpochs(t *testing.T) {
+	t.Parallel()
+
+	records, storer := createTestChunkedRecords(2)
+
+	require.Nil(t, appendTestEntries(records, storer, 1, 10, 3))
+	require.Nil(t, appendTestEntries(records, storer, 4, 40, 2))
+
+	visited, cursor, err := walkTestEntries(records, nil, 3)
+	require.Nil(t, err)
+	require.Equal(t, []string{"40-1", "40-0", "10-2"}, visited)
+	require.NotNil(t, cursor)
+
+	visited, cursor, err = walkTestEntries(records, cursor, 3)
+	require.Nil(t, err)
+	require.Equal(t, []string{"10-1", "10-0"}, visited)
+	require.Nil(t, cursor)
+
+	_, _, err = walkTestEntries(records, []byte("bad"), 3)
+	require.Equal(t, ErrInvalidCursor, err)
+}
+
+func TestChunkedRecords_AppendShouldDropTheEntriesOfReplacedBlocks(t *testing.T) {
+	t.Parallel()
+
+	records, storer := createTestChunkedRecords(2)
+
+	require.Nil(t, appendTestEntries(records, storer, 1, 10, 1))
+	require.Nil(t, appendTestEntries(records, storer, 1, 11, 3))
+	require.Nil(t, appendTestEntries(records, storer, 1, 12, 2))
+
+	// re-recording the same block replaces its entries
+	require.Nil(t, appendTestEntries(records, storer, 1, 12, 1))
+	visited, _, err := walkTestEntries(records, nil, 10)
+	require.Nil(t, err)
+	require.Equal(t, []string{"12-0", "11-2", "11-1", "11-0", "10-0"}, visited)
+
+	// after a rollback, the entries of the reverted blocks are dropped when a block with the same nonce is recorded
+	require.Nil(t, appendTestEntries(records, storer, 1, 11, 1))
+	visited, _, err = walkTestEntries(records, nil, 10)
+	require.Nil(t, err)
+	require.Equal(t, []string{"11-0", "10-0"}, visited)
+
+	header, err := records.getHeader([]byte("key"), 1)
+	require.Nil(t, err)
+	require.Equal(t, uint32(2), header.NumEntries)
+}
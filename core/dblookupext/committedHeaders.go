@@ -0,0 +1,32 @@
+package dblookupext
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// committedHeaders tells whether a recorded header is still the one committed with its nonce, by looking it up in
+// the nonce to hash storer of the node's own headers. The entry of a reverted header is removed when the node rolls
+// back and it is overwritten when another header is committed with the same nonce.
+type committedHeaders struct {
+	nonceHashStorer storage.Storer
+	uint64Converter typeConverters.Uint64ByteSliceConverter
+}
+
+func newCommittedHeaders(nonceHashStorer storage.Storer, uint64Converter typeConverters.Uint64ByteSliceConverter) *committedHeaders {
+	return &committedHeaders{
+		nonceHashStorer: nonceHashStorer,
+		uint64Converter: uint64Converter,
+	}
+}
+
+func (ch *committedHeaders) isCommitted(nonce uint64, headerHash []byte) bool {
+	committedHash, err := ch.nonceHashStorer.Get(ch.uint64Converter.ToByteSlice(nonce))
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(committedHash, headerHash)
+}
@@ -0,0 +1,42 @@
+package dblookupext
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericmocks"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestCommittedHeaders() *committedHeaders {
+	return newCommittedHeaders(genericmocks.NewStorerMock("NonceHash", 0), uint64ByteSlice.NewBigEndianConverter())
+}
+
+func commitTestHeader(headers *committedHeaders, nonce uint64, headerHash []byte) {
+	_ = headers.nonceHashStorer.Put(headers.uint64Converter.ToByteSlice(nonce), headerHash)
+}
+
+// revertTestHeader removes the nonce to hash entry, as done by the bootstrapper when it rolls back a header
+func revertTestHeader(headers *committedHeaders, nonce uint64) {
+	nonceToByteSlice := headers.uint64Converter.ToByteSlice(nonce)
+	headers.nonceHashStorer.(*genericmocks.StorerMock).GetCurrentEpochData().Remove(string(nonceToByteSlice))
+}
+
+func TestCommittedHeaders_IsCommitted(t *testing.T) {
+	t.Parallel()
+
+	headers := createTestCommittedHeaders()
+	require.False(t, headers.isCommitted(10, []byte("hashA")))
+
+	commitTestHeader(headers, 10, []byte("hashA"))
+	require.True(t, headers.isCommitted(10, []byte("hashA")))
+	require.False(t, headers.isCommitted(10, []byte("hashB")))
+	require.False(t, headers.isCommitted(11, []byte("hashA")))
+
+	commitTestHeader(headers, 10, []byte("hashB"))
+	require.False(t, headers.isCommitted(10, []byte("hashA")))
+	require.True(t, headers.isCommitted(10, []byte("hashB")))
+
+	revertTestHeader(headers, 10)
+	require.False(t, headers.isCommitted(10, []byte("hashB")))
+}
@@ -8,6 +8,21 @@ import (
 
 var errCannotCastToBlockBody = errors.New("cannot cast to block body")
 
+// ErrInvalidCursor signals that an invalid cursor was provided
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// ErrInvalidLimit signals that an invalid limit was provided
+var ErrInvalidLimit = errors.New("invalid limit")
+
+// ErrCorruptedRecord signals that the stored entries of a record do not match its header
+var ErrCorruptedRecord = errors.New("corrupted record")
+
+// ErrInvalidLogEventsQuery signals that a log events query has neither an address nor an identifier
+var ErrInvalidLogEventsQuery = errors.New("invalid log events query: an address or an identifier should be provided")
+
+// ErrNilUint64Converter signals that a nil uint64 converter was provided
+var ErrNilUint64Converter = errors.New("nil uint64 converter")
+
 func newErrCannotSaveEpochByHash(what string, hash []byte, originalErr error) error {
 	return fmt.Errorf("cannot save epoch num for [%s] hash [%s]: %w", what, hex.EncodeToString(hash), originalErr)
 }
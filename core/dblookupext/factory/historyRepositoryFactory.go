@@ -5,6 +5,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
@@ -13,11 +14,12 @@ import (
 // ArgsHistoryRepositoryFactory holds all dependencies required by the history processor factory in order to create
 // new instances
 type ArgsHistoryRepositoryFactory struct {
-	SelfShardID uint32
-	Config      config.DbLookupExtensionsConfig
-	Store       dataRetriever.StorageService
-	Marshalizer marshal.Marshalizer
-	Hasher      hashing.Hasher
+	SelfShardID     uint32
+	Config          config.DbLookupExtensionsConfig
+	Store           dataRetriever.StorageService
+	Marshalizer     marshal.Marshalizer
+	Hasher          hashing.Hasher
+	Uint64Converter typeConverters.Uint64ByteSliceConverter
 }
 
 type historyRepositoryFactory struct {
@@ -26,6 +28,7 @@ type historyRepositoryFactory struct {
 	store                    dataRetriever.StorageService
 	marshalizer              marshal.Marshalizer
 	hasher                   hashing.Hasher
+	uint64Converter          typeConverters.Uint64ByteSliceConverter
 }
 
 // NewHistoryRepositoryFactory creates an instance of historyRepositoryFactory
@@ -39,6 +42,9 @@ func NewHistoryRepositoryFactory(args *ArgsHistoryRepositoryFactory) (dblookupex
 	if check.IfNil(args.Store) {
 		return nil, core.ErrNilStore
 	}
+	if check.IfNil(args.Uint64Converter) {
+		return nil, dblookupext.ErrNilUint64Converter
+	}
 
 	return &historyRepositoryFactory{
 		selfShardID:              args.SelfShardID,
@@ -46,6 +52,7 @@ func NewHistoryRepositoryFactory(args *ArgsHistoryRepositoryFactory) (dblookupex
 		store:                    args.Store,
 		marshalizer:              args.Marshalizer,
 		hasher:                   args.Hasher,
+		uint64Converter:          args.Uint64Converter,
 	}, nil
 }
 
@@ -55,14 +62,24 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		return dblookupext.NewNilHistoryRepository()
 	}
 
+	nonceHashDataUnit := dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(hpf.selfShardID)
+	if hpf.selfShardID == core.MetachainShardId {
+		nonceHashDataUnit = dataRetriever.MetaHdrNonceHashDataUnit
+	}
+
 	historyRepArgs := dblookupext.HistoryRepositoryArguments{
 		SelfShardID:                 hpf.selfShardID,
 		Hasher:                      hpf.hasher,
 		Marshalizer:                 hpf.marshalizer,
+		Uint64Converter:             hpf.uint64Converter,
 		MiniblocksMetadataStorer:    hpf.store.GetStorer(dataRetriever.MiniblocksMetadataUnit),
 		EpochByHashStorer:           hpf.store.GetStorer(dataRetriever.EpochByHashUnit),
 		MiniblockHashByTxHashStorer: hpf.store.GetStorer(dataRetriever.MiniblockHashByTxHashUnit),
 		EventsHashesByTxHashStorer:  hpf.store.GetStorer(dataRetriever.ResultsHashesByTxHashUnit),
+		TxsByAddressStorer:          hpf.store.GetStorer(dataRetriever.TransactionsByAddressUnit),
+		TxLogsStorer:                hpf.store.GetStorer(dataRetriever.TxLogsUnit),
+		LogEventsStorer:             hpf.store.GetStorer(dataRetriever.LogEventsUnit),
+		NonceHashStorer:             hpf.store.GetStorer(nonceHashDataUnit),
 	}
 	return dblookupext.NewHistoryRepository(historyRepArgs)
 }
@@ -6,8 +6,10 @@ import (
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/core/dblookupext/factory"
 	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/stretchr/testify/require"
@@ -34,6 +36,12 @@ func TestNewHistoryRepositoryFactory(t *testing.T) {
 	require.Equal(t, core.ErrNilHasher, err)
 	require.Nil(t, hrf)
 
+	argsNilUint64Converter := getArgs()
+	argsNilUint64Converter.Uint64Converter = nil
+	hrf, err = factory.NewHistoryRepositoryFactory(argsNilUint64Converter)
+	require.Equal(t, dblookupext.ErrNilUint64Converter, err)
+	require.Nil(t, hrf)
+
 	hrf, err = factory.NewHistoryRepositoryFactory(args)
 	require.NoError(t, err)
 	require.False(t, check.IfNil(hrf))
@@ -67,10 +75,11 @@ func TestHistoryRepositoryFactory_CreateShouldCreateRegularRepository(t *testing
 
 func getArgs() *factory.ArgsHistoryRepositoryFactory {
 	return &factory.ArgsHistoryRepositoryFactory{
-		SelfShardID: 0,
-		Config:      config.DbLookupExtensionsConfig{},
-		Store:       &mock.ChainStorerMock{},
-		Marshalizer: &mock.MarshalizerMock{},
-		Hasher:      &mock.HasherMock{},
+		SelfShardID:     0,
+		Config:          config.DbLookupExtensionsConfig{},
+		Store:           &mock.ChainStorerMock{},
+		Marshalizer:     &mock.MarshalizerMock{},
+		Hasher:          &mock.HasherMock{},
+		Uint64Converter: uint64ByteSlice.NewBigEndianConverter(),
 	}
 }
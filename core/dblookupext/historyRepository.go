@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/container"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/storage"
@@ -29,8 +30,13 @@ type HistoryRepositoryArguments struct {
 	MiniblockHashByTxHashStorer storage.Storer
 	EpochByHashStorer           storage.Storer
 	EventsHashesByTxHashStorer  storage.Storer
+	TxsByAddressStorer          storage.Storer
+	TxLogsStorer                storage.Storer
+	LogEventsStorer             storage.Storer
+	NonceHashStorer             storage.Storer
 	Marshalizer                 marshal.Marshalizer
 	Hasher                      hashing.Hasher
+	Uint64Converter             typeConverters.Uint64ByteSliceConverter
 }
 
 type historyRepository struct {
@@ -39,6 +45,8 @@ type historyRepository struct {
 	miniblockHashByTxHashIndex storage.Storer
 	epochByHashIndex           *epochByHashIndex
 	eventsHashesByTxHashIndex  *eventsHashesByTxHash
+	txsByAddressIndex          *transactionsByAddressIndex
+	logEventsIndex             *logEventsIndex
 	marshalizer                marshal.Marshalizer
 	hasher                     hashing.Hasher
 
@@ -81,11 +89,29 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 	if check.IfNil(arguments.EventsHashesByTxHashStorer) {
 		return nil, core.ErrNilStore
 	}
+	if check.IfNil(arguments.TxsByAddressStorer) {
+		return nil, core.ErrNilStore
+	}
+	if check.IfNil(arguments.TxLogsStorer) {
+		return nil, core.ErrNilStore
+	}
+	if check.IfNil(arguments.LogEventsStorer) {
+		return nil, core.ErrNilStore
+	}
+	if check.IfNil(arguments.NonceHashStorer) {
+		return nil, core.ErrNilStore
+	}
+	if check.IfNil(arguments.Uint64Converter) {
+		return nil, ErrNilUint64Converter
+	}
 
 	hashToEpochIndex := newHashToEpochIndex(arguments.EpochByHashStorer, arguments.Marshalizer)
 	deduplicationCacheForInsertMiniblockMetadata, _ := lrucache.NewCache(sizeOfDeduplicationCache)
 
 	eventsHashesToTxHashIndex := newEventsHashesByTxHash(arguments.EventsHashesByTxHashStorer, arguments.Marshalizer)
+	committedHeaders := newCommittedHeaders(arguments.NonceHashStorer, arguments.Uint64Converter)
+	txsByAddressIndex := newTransactionsByAddressIndex(arguments.TxsByAddressStorer, arguments.Marshalizer, committedHeaders)
+	logEventsIndex := newLogEventsIndex(arguments.TxLogsStorer, arguments.LogEventsStorer, arguments.Marshalizer, committedHeaders)
 
 	return &historyRepository{
 		selfShardID:                           arguments.SelfShardID,
@@ -99,6 +125,8 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 		pendingNotarizedAtBothNotifications:          container.NewMutexMap(),
 		deduplicationCacheForInsertMiniblockMetadata: deduplicationCacheForInsertMiniblockMetadata,
 		eventsHashesByTxHashIndex:                    eventsHashesToTxHashIndex,
+		txsByAddressIndex:                            txsByAddressIndex,
+		logEventsIndex:                               logEventsIndex,
 	}, nil
 }
 
@@ -108,6 +136,7 @@ func (hr *historyRepository) RecordBlock(
 	blockHeaderHash []byte,
 	blockHeader data.HeaderHandler,
 	blockBody data.BodyHandler,
+	txsFromPool map[string]data.TransactionHandler,
 	scrResultsFromPool map[string]data.TransactionHandler,
 	receiptsFromPool map[string]data.TransactionHandler,
 ) error {
@@ -128,17 +157,36 @@ func (hr *historyRepository) RecordBlock(
 		return newErrCannotSaveEpochByHash("block header", blockHeaderHash, err)
 	}
 
+	recordedMiniblocks := make([]*block.MiniBlock, 0, len(body.MiniBlocks))
+	recordedMiniblocksHashes := make([][]byte, 0, len(body.MiniBlocks))
 	for _, miniblock := range body.MiniBlocks {
 		if miniblock.Type == block.PeerBlock {
 			continue
 		}
 
-		err = hr.recordMiniblock(blockHeaderHash, blockHeader, miniblock, epoch)
+		miniblockHash, errHash := hr.computeMiniblockHash(miniblock)
+		if errHash != nil {
+			continue
+		}
+
+		err = hr.recordMiniblock(blockHeaderHash, blockHeader, miniblock, miniblockHash, epoch)
 		if err != nil {
 			continue
 		}
+
+		recordedMiniblocks = append(recordedMiniblocks, miniblock)
+		recordedMiniblocksHashes = append(recordedMiniblocksHashes, miniblockHash)
 	}
 
+	hr.txsByAddressIndex.saveTransactions(
+		blockHeaderHash,
+		blockHeader,
+		recordedMiniblocks,
+		recordedMiniblocksHashes,
+		mergeTransactionsMaps(txsFromPool, scrResultsFromPool),
+	)
+	hr.logEventsIndex.saveLogEvents(blockHeaderHash, blockHeader, recordedMiniblocks)
+
 	err = hr.eventsHashesByTxHashIndex.saveResultsHashes(epoch, scrResultsFromPool, receiptsFromPool)
 	if err != nil {
 		return err
@@ -147,17 +195,29 @@ func (hr *historyRepository) RecordBlock(
 	return nil
 }
 
-func (hr *historyRepository) recordMiniblock(blockHeaderHash []byte, blockHeader data.HeaderHandler, miniblock *block.MiniBlock, epoch uint32) error {
-	miniblockHash, err := hr.computeMiniblockHash(miniblock)
-	if err != nil {
-		return err
+func mergeTransactionsMaps(maps ...map[string]data.TransactionHandler) map[string]data.TransactionHandler {
+	merged := make(map[string]data.TransactionHandler)
+	for _, m := range maps {
+		for hash, tx := range m {
+			merged[hash] = tx
+		}
 	}
 
+	return merged
+}
+
+func (hr *historyRepository) recordMiniblock(
+	blockHeaderHash []byte,
+	blockHeader data.HeaderHandler,
+	miniblock *block.MiniBlock,
+	miniblockHash []byte,
+	epoch uint32,
+) error {
 	if hr.hasRecentlyInsertedMiniblockMetadata(miniblockHash, epoch) {
 		return nil
 	}
 
-	err = hr.epochByHashIndex.saveEpochByHash(miniblockHash, epoch)
+	err := hr.epochByHashIndex.saveEpochByHash(miniblockHash, epoch)
 	if err != nil {
 		return newErrCannotSaveEpochByHash("miniblock", miniblockHash, err)
 	}
@@ -427,6 +487,18 @@ func (hr *historyRepository) GetResultsHashesByTxHash(txHash []byte, epoch uint3
 	return hr.eventsHashesByTxHashIndex.getEventsHashesByTxHash(txHash, epoch)
 }
 
+// GetTransactionsByAddress will return a page of transactions sent or received by the given address, starting
+// from the provided cursor. An empty cursor will return the most recent transactions.
+func (hr *historyRepository) GetTransactionsByAddress(address []byte, cursor []byte, limit int) (*TransactionsByAddressPage, error) {
+	return hr.txsByAddressIndex.getTransactionsPage(address, cursor, limit)
+}
+
+// GetLogEvents will return a page of log events matching the provided query, starting from the provided cursor.
+// An empty cursor will return the most recent log events.
+func (hr *historyRepository) GetLogEvents(query *LogEventsQuery, cursor []byte, limit int) (*LogEventsPage, error) {
+	return hr.logEventsIndex.getLogEventsPage(query, cursor, limit)
+}
+
 // IsEnabled will always returns true
 func (hr *historyRepository) IsEnabled() bool {
 	return true
@@ -8,6 +8,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/mock"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
 	"github.com/ElrondNetwork/elrond-go/testscommon/genericmocks"
 	"github.com/stretchr/testify/require"
 )
@@ -19,8 +21,13 @@ func createMockHistoryRepoArgs(epoch uint32) HistoryRepositoryArguments {
 		MiniblockHashByTxHashStorer: genericmocks.NewStorerMock("MiniblockHashByTxHash", epoch),
 		EpochByHashStorer:           genericmocks.NewStorerMock("EpochByHash", epoch),
 		EventsHashesByTxHashStorer:  genericmocks.NewStorerMock("EventsHashesByTxHash", epoch),
+		TxsByAddressStorer:          genericmocks.NewStorerMock("TxsByAddress", epoch),
+		TxLogsStorer:                genericmocks.NewStorerMock("TxLogs", epoch),
+		LogEventsStorer:             genericmocks.NewStorerMock("LogEvents", epoch),
+		NonceHashStorer:             genericmocks.NewStorerMock("NonceHash", epoch),
 		Marshalizer:                 &mock.MarshalizerMock{},
 		Hasher:                      &mock.HasherMock{},
+		Uint64Converter:             uint64ByteSlice.NewBigEndianConverter(),
 	}
 
 	return args
@@ -53,6 +60,36 @@ func TestNewHistoryRepository(t *testing.T) {
 	require.Nil(t, repo)
 	require.Equal(t, core.ErrNilStore, err)
 
+	args = createMockHistoryRepoArgs(0)
+	args.TxsByAddressStorer = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, core.ErrNilStore, err)
+
+	args = createMockHistoryRepoArgs(0)
+	args.TxLogsStorer = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, core.ErrNilStore, err)
+
+	args = createMockHistoryRepoArgs(0)
+	args.LogEventsStorer = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, core.ErrNilStore, err)
+
+	args = createMockHistoryRepoArgs(0)
+	args.NonceHashStorer = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, core.ErrNilStore, err)
+
+	args = createMockHistoryRepoArgs(0)
+	args.Uint64Converter = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, ErrNilUint64Converter, err)
+
 	args = createMockHistoryRepoArgs(0)
 	args.Hasher = nil
 	repo, err = NewHistoryRepository(args)
@@ -97,7 +134,7 @@ func TestHistoryRepository_RecordBlock(t *testing.T) {
 		},
 	}
 
-	err = repo.RecordBlock(headerHash, blockHeader, blockBody, nil, nil)
+	err = repo.RecordBlock(headerHash, blockHeader, blockBody, nil, nil, nil)
 	require.Nil(t, err)
 	// Two miniblocks
 	require.Equal(t, 2, repo.miniblocksMetadataStorer.(*genericmocks.StorerMock).GetCurrentEpochData().Len())
@@ -107,6 +144,42 @@ func TestHistoryRepository_RecordBlock(t *testing.T) {
 	require.Equal(t, 2, repo.miniblockHashByTxHashIndex.(*genericmocks.StorerMock).GetCurrentEpochData().Len())
 }
 
+func TestHistoryRepository_RecordBlockIndexesLogEvents(t *testing.T) {
+	t.Parallel()
+
+	args := createMockHistoryRepoArgs(0)
+	txLog := &transaction.Log{
+		Address: []byte("contract"),
+		Events: []*transaction.Event{
+			{Address: []byte("contract"), Identifier: []byte("transfer"), Topics: [][]byte{[]byte("alice")}},
+		},
+	}
+	txLogBytes, _ := args.Marshalizer.Marshal(txLog)
+	_ = args.TxLogsStorer.Put([]byte("txA"), txLogBytes)
+
+	repo, err := NewHistoryRepository(args)
+	require.Nil(t, err)
+
+	blockBody := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{
+				TxHashes: [][]byte{[]byte("txA"), []byte("txB")},
+			},
+		},
+	}
+	_ = args.NonceHashStorer.Put(args.Uint64Converter.ToByteSlice(7), []byte("headerHash"))
+	err = repo.RecordBlock([]byte("headerHash"), &block.Header{Nonce: 7}, blockBody, nil, nil, nil)
+	require.Nil(t, err)
+
+	page, err := repo.GetLogEvents(&LogEventsQuery{Identifier: []byte("transfer"), ToNonce: 7}, nil, 10)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(page.Events))
+	require.Equal(t, []byte("txA"), page.Events[0].TxHash)
+	require.Equal(t, []byte("headerHash"), page.Events[0].HeaderHash)
+	require.Equal(t, uint64(7), page.Events[0].HeaderNonce)
+	require.Equal(t, [][]byte{[]byte("alice")}, page.Events[0].Topics)
+}
+
 func TestHistoryRepository_GetMiniblockMetadata(t *testing.T) {
 	t.Parallel()
 
@@ -131,7 +204,7 @@ func TestHistoryRepository_GetMiniblockMetadata(t *testing.T) {
 				miniblockB,
 			},
 		},
-		nil, nil,
+		nil, nil, nil,
 	)
 
 	metadata, err := repo.GetMiniblockMetadataByTxHash([]byte("txA"))
@@ -169,7 +242,7 @@ func TestHistoryRepository_GetEpochForHash(t *testing.T) {
 			miniblockA,
 			miniblockB,
 		},
-	}, nil, nil)
+	}, nil, nil, nil)
 
 	// Get epoch by block hash
 	epoch, err := repo.GetEpochByHash([]byte("fooblock"))
@@ -227,7 +300,7 @@ func TestHistoryRepository_OnNotarizedBlocks(t *testing.T) {
 				miniblockB,
 				miniblockC,
 			},
-		}, nil, nil,
+		}, nil, nil, nil,
 	)
 
 	// Check "notarization coordinates"
@@ -427,7 +500,7 @@ func TestHistoryRepository_OnNotarizedBlocksAtSourceBeforeCommittingAtDestinatio
 			MiniBlocks: []*block.MiniBlock{
 				miniblockA,
 			},
-		}, nil, nil,
+		}, nil, nil, nil,
 	)
 	_ = repo.RecordBlock([]byte("barBlock"),
 		&block.Header{Epoch: 42, Round: 4322},
@@ -435,7 +508,7 @@ func TestHistoryRepository_OnNotarizedBlocksAtSourceBeforeCommittingAtDestinatio
 			MiniBlocks: []*block.MiniBlock{
 				miniblockB,
 			},
-		}, nil, nil,
+		}, nil, nil, nil,
 	)
 
 	// Notifications have not been cleared after record block
@@ -482,7 +555,7 @@ func TestHistoryRepository_OnNotarizedBlocksCrossEpoch(t *testing.T) {
 			MiniBlocks: []*block.MiniBlock{
 				miniblockA,
 			},
-		}, nil, nil,
+		}, nil, nil, nil,
 	)
 
 	// Now let's receive a metablock and the "notarized" notification, in the next epoch
@@ -539,7 +612,7 @@ func TestHistoryRepository_CommitOnForkThenNewEpochThenCommit(t *testing.T) {
 			MiniBlocks: []*block.MiniBlock{
 				miniblock,
 			},
-		}, nil, nil,
+		}, nil, nil, nil,
 	)
 
 	// Let's go to next epoch
@@ -552,7 +625,7 @@ func TestHistoryRepository_CommitOnForkThenNewEpochThenCommit(t *testing.T) {
 			MiniBlocks: []*block.MiniBlock{
 				miniblock,
 			},
-		}, nil, nil,
+		}, nil, nil, nil,
 	)
 
 	// Now let's receive a metablock and the "notarized" notification
@@ -641,7 +714,7 @@ func TestHistoryRepository_ConcurrentlyRecordAndNotarizeSameBlockMultipleTimes(t
 					MiniBlocks: []*block.MiniBlock{
 						miniblock,
 					},
-				}, nil, nil,
+				}, nil, nil, nil,
 			)
 		}
 
@@ -15,6 +15,7 @@ type HistoryRepository interface {
 	RecordBlock(blockHeaderHash []byte,
 		blockHeader data.HeaderHandler,
 		blockBody data.BodyHandler,
+		txsFromPool map[string]data.TransactionHandler,
 		scrResultsFromPool map[string]data.TransactionHandler,
 		receiptsFromPool map[string]data.TransactionHandler,
 	) error
@@ -23,6 +24,8 @@ type HistoryRepository interface {
 	GetMiniblockMetadataByTxHash(hash []byte) (*MiniblockMetadata, error)
 	GetEpochByHash(hash []byte) (uint32, error)
 	GetResultsHashesByTxHash(txHash []byte, epoch uint32) (*ResultsHashesByTxHash, error)
+	GetTransactionsByAddress(address []byte, cursor []byte, limit int) (*TransactionsByAddressPage, error)
+	GetLogEvents(query *LogEventsQuery, cursor []byte, limit int) (*LogEventsPage, error)
 	IsEnabled() bool
 	IsInterfaceNil() bool
 }
@@ -0,0 +1,807 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: logEvents.proto
+
+package dblookupext
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// IndexedLogEvent is used to store a smart contract log event along with the coordinates of the transaction
+// and of the block that generated it
+type IndexedLogEvent struct {
+	TxHash      []byte   `protobuf:"bytes,1,opt,name=TxHash,proto3" json:"TxHash,omitempty"`
+	EventIndex  uint32   `protobuf:"varint,2,opt,name=EventIndex,proto3" json:"EventIndex,omitempty"`
+	Address     []byte   `protobuf:"bytes,3,opt,name=Address,proto3" json:"Address,omitempty"`
+	Identifier  []byte   `protobuf:"bytes,4,opt,name=Identifier,proto3" json:"Identifier,omitempty"`
+	Topics      [][]byte `protobuf:"bytes,5,rep,name=Topics,proto3" json:"Topics,omitempty"`
+	Data        []byte   `protobuf:"bytes,6,opt,name=Data,proto3" json:"Data,omitempty"`
+	HeaderHash  []byte   `protobuf:"bytes,7,opt,name=HeaderHash,proto3" json:"HeaderHash,omitempty"`
+	HeaderNonce uint64   `protobuf:"varint,8,opt,name=HeaderNonce,proto3" json:"HeaderNonce,omitempty"`
+	Epoch       uint32   `protobuf:"varint,9,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+}
+
+func (m *IndexedLogEvent) Reset()      { *m = IndexedLogEvent{} }
+func (*IndexedLogEvent) ProtoMessage() {}
+func (*IndexedLogEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a78d3f3e870307f7, []int{0}
+}
+func (m *IndexedLogEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexedLogEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *IndexedLogEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexedLogEvent.Merge(m, src)
+}
+func (m *IndexedLogEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexedLogEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexedLogEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexedLogEvent proto.InternalMessageInfo
+
+func (m *IndexedLogEvent) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *IndexedLogEvent) GetEventIndex() uint32 {
+	if m != nil {
+		return m.EventIndex
+	}
+	return 0
+}
+
+func (m *IndexedLogEvent) GetAddress() []byte {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *IndexedLogEvent) GetIdentifier() []byte {
+	if m != nil {
+		return m.Identifier
+	}
+	return nil
+}
+
+func (m *IndexedLogEvent) GetTopics() [][]byte {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *IndexedLogEvent) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *IndexedLogEvent) GetHeaderHash() []byte {
+	if m != nil {
+		return m.HeaderHash
+	}
+	return nil
+}
+
+func (m *IndexedLogEvent) GetHeaderNonce() uint64 {
+	if m != nil {
+		return m.HeaderNonce
+	}
+	return 0
+}
+
+func (m *IndexedLogEvent) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*IndexedLogEvent)(nil), "proto.IndexedLogEvent")
+}
+
+func init() { proto.RegisterFile("logEvents.proto", fileDescriptor_a78d3f3e870307f7) }
+
+var fileDescriptor_a78d3f3e870307f7 = []byte{
+	// 305 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xbf, 0x4e, 0xc3, 0x30,
+	0x10, 0xc6, 0x7d, 0xfd, 0x0b, 0x2e, 0xa8, 0x92, 0x85, 0x90, 0xc5, 0x70, 0x8a, 0x98, 0xba, 0xd0,
+	0x0e, 0x3c, 0x01, 0x88, 0x4a, 0xad, 0x84, 0x18, 0x2a, 0x26, 0xb6, 0x24, 0x76, 0xd3, 0x88, 0x12,
+	0x47, 0x89, 0x8b, 0x3a, 0xb2, 0xb1, 0xf2, 0x18, 0x3c, 0x0a, 0x63, 0xc7, 0x8e, 0xc4, 0x59, 0x18,
+	0xfb, 0x08, 0xa8, 0x97, 0x22, 0x32, 0xf9, 0x7e, 0x9f, 0xef, 0xfb, 0xce, 0x3e, 0xde, 0x5f, 0x9a,
+	0x68, 0xfc, 0xaa, 0x13, 0x9b, 0x0f, 0xd3, 0xcc, 0x58, 0x23, 0xda, 0x74, 0x5c, 0x5c, 0x45, 0xb1,
+	0x5d, 0xac, 0x82, 0x61, 0x68, 0x5e, 0x46, 0x91, 0x89, 0xcc, 0x88, 0xe4, 0x60, 0x35, 0x27, 0x22,
+	0xa0, 0xaa, 0x72, 0x5d, 0xbe, 0x37, 0x78, 0x7f, 0x9a, 0x28, 0xbd, 0xd6, 0xea, 0xfe, 0x10, 0x28,
+	0xce, 0x79, 0xe7, 0x71, 0x3d, 0xf1, 0xf3, 0x85, 0x04, 0x0f, 0x06, 0x27, 0xb3, 0x03, 0x09, 0xe4,
+	0x9c, 0x1a, 0xa8, 0x5f, 0x36, 0x3c, 0x18, 0x9c, 0xce, 0x6a, 0x8a, 0x90, 0xbc, 0x7b, 0xa3, 0x54,
+	0xa6, 0xf3, 0x5c, 0x36, 0xc9, 0xf8, 0x87, 0x7b, 0xe7, 0x54, 0xe9, 0xc4, 0xc6, 0xf3, 0x58, 0x67,
+	0xb2, 0x45, 0x97, 0x35, 0x85, 0x26, 0x9a, 0x34, 0x0e, 0x73, 0xd9, 0xf6, 0x9a, 0x34, 0x91, 0x48,
+	0x08, 0xde, 0xba, 0xf3, 0xad, 0x2f, 0x3b, 0xe4, 0xa0, 0x7a, 0x9f, 0x35, 0xd1, 0xbe, 0xd2, 0x19,
+	0xbd, 0xb0, 0x5b, 0x65, 0xfd, 0x2b, 0xc2, 0xe3, 0xbd, 0x8a, 0x1e, 0x4c, 0x12, 0x6a, 0x79, 0xe4,
+	0xc1, 0xa0, 0x35, 0xab, 0x4b, 0xe2, 0x8c, 0xb7, 0xc7, 0xa9, 0x09, 0x17, 0xf2, 0x98, 0xbe, 0x50,
+	0xc1, 0xed, 0x78, 0x53, 0x20, 0xdb, 0x16, 0xc8, 0x76, 0x05, 0xc2, 0x9b, 0x43, 0xf8, 0x74, 0x08,
+	0x5f, 0x0e, 0x61, 0xe3, 0x10, 0xb6, 0x0e, 0xe1, 0xdb, 0x21, 0xfc, 0x38, 0x64, 0x3b, 0x87, 0xf0,
+	0x51, 0x22, 0xdb, 0x94, 0xc8, 0xb6, 0x25, 0xb2, 0xa7, 0x9e, 0x0a, 0x96, 0xc6, 0x3c, 0xaf, 0x52,
+	0xbd, 0xb6, 0x41, 0x87, 0xf6, 0x7a, 0xfd, 0x3b, 0x00, 0xce, 0xff, 0x7c, 0xeb, 0xa0, 0x01, 0x00,
+	0x00,
+}
+
+func (this *IndexedLogEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*IndexedLogEvent)
+	if !ok {
+		that2, ok := that.(IndexedLogEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.TxHash, that1.TxHash) {
+		return false
+	}
+	if this.EventIndex != that1.EventIndex {
+		return false
+	}
+	if !bytes.Equal(this.Address, that1.Address) {
+		return false
+	}
+	if !bytes.Equal(this.Identifier, that1.Identifier) {
+		return false
+	}
+	if len(this.Topics) != len(that1.Topics) {
+		return false
+	}
+	for i := range this.Topics {
+		if !bytes.Equal(this.Topics[i], that1.Topics[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	if !bytes.Equal(this.HeaderHash, that1.HeaderHash) {
+		return false
+	}
+	if this.HeaderNonce != that1.HeaderNonce {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	return true
+}
+func (this *IndexedLogEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 13)
+	s = append(s, "&dblookupext.IndexedLogEvent{")
+	s = append(s, "TxHash: "+fmt.Sprintf("%#v", this.TxHash)+",\n")
+	s = append(s, "EventIndex: "+fmt.Sprintf("%#v", this.EventIndex)+",\n")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "Identifier: "+fmt.Sprintf("%#v", this.Identifier)+",\n")
+	s = append(s, "Topics: "+fmt.Sprintf("%#v", this.Topics)+",\n")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "HeaderHash: "+fmt.Sprintf("%#v", this.HeaderHash)+",\n")
+	s = append(s, "HeaderNonce: "+fmt.Sprintf("%#v", this.HeaderNonce)+",\n")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringLogEvents(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *IndexedLogEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexedLogEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexedLogEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintLogEvents(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.HeaderNonce != 0 {
+		i = encodeVarintLogEvents(dAtA, i, uint64(m.HeaderNonce))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.HeaderHash) > 0 {
+		i -= len(m.HeaderHash)
+		copy(dAtA[i:], m.HeaderHash)
+		i = encodeVarintLogEvents(dAtA, i, uint64(len(m.HeaderHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintLogEvents(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Topics[iNdEx])
+			copy(dAtA[i:], m.Topics[iNdEx])
+			i = encodeVarintLogEvents(dAtA, i, uint64(len(m.Topics[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintLogEvents(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintLogEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EventIndex != 0 {
+		i = encodeVarintLogEvents(dAtA, i, uint64(m.EventIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintLogEvents(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLogEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLogEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IndexedLogEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovLogEvents(uint64(l))
+	}
+	if m.EventIndex != 0 {
+		n += 1 + sovLogEvents(uint64(m.EventIndex))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovLogEvents(uint64(l))
+	}
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovLogEvents(uint64(l))
+	}
+	if len(m.Topics) > 0 {
+		for _, b := range m.Topics {
+			l = len(b)
+			n += 1 + l + sovLogEvents(uint64(l))
+		}
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovLogEvents(uint64(l))
+	}
+	l = len(m.HeaderHash)
+	if l > 0 {
+		n += 1 + l + sovLogEvents(uint64(l))
+	}
+	if m.HeaderNonce != 0 {
+		n += 1 + sovLogEvents(uint64(m.HeaderNonce))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovLogEvents(uint64(m.Epoch))
+	}
+	return n
+}
+
+func sovLogEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLogEvents(x uint64) (n int) {
+	return sovLogEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *IndexedLogEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&IndexedLogEvent{`,
+		`TxHash:` + fmt.Sprintf("%v", this.TxHash) + `,`,
+		`EventIndex:` + fmt.Sprintf("%v", this.EventIndex) + `,`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Identifier:` + fmt.Sprintf("%v", this.Identifier) + `,`,
+		`Topics:` + fmt.Sprintf("%v", this.Topics) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`HeaderHash:` + fmt.Sprintf("%v", this.HeaderHash) + `,`,
+		`HeaderNonce:` + fmt.Sprintf("%v", this.HeaderNonce) + `,`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringLogEvents(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *IndexedLogEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLogEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexedLogEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexedLogEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLogEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventIndex", wireType)
+			}
+			m.EventIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLogEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLogEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = append(m.Identifier[:0], dAtA[iNdEx:postIndex]...)
+			if m.Identifier == nil {
+				m.Identifier = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLogEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topics = append(m.Topics, make([]byte, postIndex-iNdEx))
+			copy(m.Topics[len(m.Topics)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLogEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLogEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeaderHash = append(m.HeaderHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.HeaderHash == nil {
+				m.HeaderHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderNonce", wireType)
+			}
+			m.HeaderNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeaderNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLogEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLogEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLogEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLogEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLogEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLogEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLogEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLogEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLogEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLogEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLogEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLogEvents = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,232 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. logEvents.proto
+
+package dblookupext
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// maxScannedLogEventsPerPage bounds the work done for a single page when most of the events of the walked record
+// do not match the query. In this case a page can hold less events than the limit, while still having a cursor.
+const maxScannedLogEventsPerPage = 10000
+
+const (
+	logEventsByAddressKind    = byte('a')
+	logEventsByIdentifierKind = byte('i')
+)
+
+// LogEventsQuery holds the criteria used in order to search the log events. At least one of the address and the
+// identifier has to be provided.
+type LogEventsQuery struct {
+	Address    []byte
+	Identifier []byte
+	Topic0     []byte
+	FromNonce  uint64
+	ToNonce    uint64
+}
+
+// LogEventsPage holds a page of log events, ordered from the newest to the oldest one, along with the cursor that
+// should be used in order to fetch the next page. An empty cursor signals the last page.
+type LogEventsPage struct {
+	Events     []*IndexedLogEvent
+	NextCursor []byte
+}
+
+type logEventsIndex struct {
+	marshalizer      marshal.Marshalizer
+	txLogsStorer     storage.Storer
+	records          *chunkedRecords
+	committedHeaders *committedHeaders
+}
+
+func newLogEventsIndex(
+	txLogsStorer storage.Storer,
+	storer storage.Storer,
+	marshalizer marshal.Marshalizer,
+	committedHeaders *committedHeaders,
+) *logEventsIndex {
+	return &logEventsIndex{
+		marshalizer:      marshalizer,
+		txLogsStorer:     txLogsStorer,
+		records:          newChunkedRecords(storer, marshalizer),
+		committedHeaders: committedHeaders,
+	}
+}
+
+// saveLogEvents indexes, by emitting address and by identifier, the events of the logs generated by the
+// transactions of the provided miniblocks. The blocks are indexed at commit time and there is no hook for reverts:
+// the events of a reverted block are only dropped from the records, for a given address or identifier, when a block
+// with the same or a lower nonce records events for that address or identifier. Until then, the orphaned events are
+// skipped by the queries, as their header is no longer the committed one.
+func (lei *logEventsIndex) saveLogEvents(headerHash []byte, header data.HeaderHandler, miniblocks []*block.MiniBlock) {
+	epoch := header.GetEpoch()
+	keys := make([]string, 0)
+	eventsByKey := make(map[string][]*IndexedLogEvent)
+	addEvent := func(key []byte, event *IndexedLogEvent) {
+		_, exists := eventsByKey[string(key)]
+		if !exists {
+			keys = append(keys, string(key))
+		}
+		eventsByKey[string(key)] = append(eventsByKey[string(key)], event)
+	}
+
+	for _, miniblock := range miniblocks {
+		for _, txHash := range miniblock.TxHashes {
+			txLog, err := lei.getTxLog(txHash)
+			if err != nil {
+				continue
+			}
+
+			for i, event := range txLog.Events {
+				if event == nil {
+					continue
+				}
+
+				indexedEvent := &IndexedLogEvent{
+					TxHash:      txHash,
+					EventIndex:  uint32(i),
+					Address:     event.Address,
+					Identifier:  event.Identifier,
+					Topics:      event.Topics,
+					Data:        event.Data,
+					HeaderHash:  headerHash,
+					HeaderNonce: header.GetNonce(),
+					Epoch:       epoch,
+				}
+
+				if len(event.Address) > 0 {
+					addEvent(createLogEventsKey(logEventsByAddressKind, event.Address), indexedEvent)
+				}
+				if len(event.Identifier) > 0 {
+					addEvent(createLogEventsKey(logEventsByIdentifierKind, event.Identifier), indexedEvent)
+				}
+			}
+		}
+	}
+
+	for _, key := range keys {
+		err := lei.appendLogEvents([]byte(key), epoch, header.GetNonce(), eventsByKey[key])
+		if err != nil {
+			log.Warn("logEventsIndex.appendLogEvents() cannot save log events",
+				"error", err.Error())
+		}
+	}
+}
+
+func (lei *logEventsIndex) getTxLog(txHash []byte) (*transaction.Log, error) {
+	rawBytes, err := lei.txLogsStorer.Get(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	txLog := &transaction.Log{}
+	err = lei.marshalizer.Unmarshal(txLog, rawBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return txLog, nil
+}
+
+func (lei *logEventsIndex) appendLogEvents(key []byte, epoch uint32, nonce uint64, newEvents []*IndexedLogEvent) error {
+	entries := make([]*RecordEntry, 0, len(newEvents))
+	for _, event := range newEvents {
+		rawBytes, err := lei.marshalizer.Marshal(event)
+		if err != nil {
+			return err
+		}
+
+		entries = append(entries, &RecordEntry{
+			HeaderNonce: nonce,
+			Data:        rawBytes,
+		})
+	}
+
+	return lei.records.appendEntries(key, epoch, nonce, entries)
+}
+
+// getLogEventsPage walks the records of the address (or, if the address is not provided, of the identifier) from the
+// newest epoch to the oldest one, keeping the events matching the query and emitted in committed blocks. The walk
+// stops when the limit is reached, when an event older than the nonces range is met or when there are no more
+// (unpruned) records.
+func (lei *logEventsIndex) getLogEventsPage(query *LogEventsQuery, cursor []byte, limit int) (*LogEventsPage, error) {
+	if limit <= 0 {
+		return nil, ErrInvalidLimit
+	}
+
+	var key []byte
+	switch {
+	case len(query.Address) > 0:
+		key = createLogEventsKey(logEventsByAddressKind, query.Address)
+	case len(query.Identifier) > 0:
+		key = createLogEventsKey(logEventsByIdentifierKind, query.Identifier)
+	default:
+		return nil, ErrInvalidLogEventsQuery
+	}
+
+	page := &LogEventsPage{
+		Events: make([]*IndexedLogEvent, 0, limit),
+	}
+
+	numScanned := 0
+	nextCursor, err := lei.records.walkEntries(key, cursor, func(entry *RecordEntry) walkAction {
+		if len(page.Events) == limit || numScanned == maxScannedLogEventsPerPage {
+			return pauseWalk
+		}
+		if entry.HeaderNonce < query.FromNonce {
+			return endWalk
+		}
+
+		numScanned++
+		event := &IndexedLogEvent{}
+		errUnmarshal := lei.marshalizer.Unmarshal(event, entry.Data)
+		if errUnmarshal != nil {
+			return continueWalk
+		}
+		if !isLogEventMatchingQuery(event, query) {
+			return continueWalk
+		}
+		if lei.committedHeaders.isCommitted(event.HeaderNonce, event.HeaderHash) {
+			page.Events = append(page.Events, event)
+		}
+
+		return continueWalk
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	page.NextCursor = nextCursor
+	return page, nil
+}
+
+func isLogEventMatchingQuery(event *IndexedLogEvent, query *LogEventsQuery) bool {
+	if event.HeaderNonce > query.ToNonce {
+		return false
+	}
+	if len(query.Address) > 0 && !bytes.Equal(event.Address, query.Address) {
+		return false
+	}
+	if len(query.Identifier) > 0 && !bytes.Equal(event.Identifier, query.Identifier) {
+		return false
+	}
+	if len(query.Topic0) > 0 && (len(event.Topics) == 0 || !bytes.Equal(event.Topics[0], query.Topic0)) {
+		return false
+	}
+
+	return true
+}
+
+func createLogEventsKey(kind byte, value []byte) []byte {
+	key := make([]byte, len(value)+1)
+	key[0] = kind
+	copy(key[1:], value)
+
+	return key
+}
@@ -0,0 +1,255 @@
+package dblookupext
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericmocks"
+	"github.com/stretchr/testify/require"
+)
+
+func saveTxLog(storer *genericmocks.StorerMock, txHash string, events ...*transaction.Event) {
+	txLog := &transaction.Log{
+		Address: []byte("contract"),
+		Events:  events,
+	}
+	txLogBytes, _ := (&mock.MarshalizerMock{}).Marshal(txLog)
+	_ = storer.Put([]byte(txHash), txLogBytes)
+}
+
+// saveLogEventsInIndex commits a block holding the provided transactions and indexes its log events
+func saveLogEventsInIndex(index *logEventsIndex, storer *genericmocks.StorerMock, epoch uint32, nonce uint64, txHashes ...string) {
+	storer.SetCurrentEpoch(epoch)
+	miniblock := &block.MiniBlock{
+		Type: block.SmartContractResultBlock,
+	}
+	for _, txHash := range txHashes {
+		miniblock.TxHashes = append(miniblock.TxHashes, []byte(txHash))
+	}
+
+	headerHash := []byte(fmt.Sprintf("headerHash-%d-%v", nonce, txHashes))
+	commitTestHeader(index.committedHeaders, nonce, headerHash)
+	index.saveLogEvents(headerHash, &block.Header{Epoch: epoch, Nonce: nonce}, []*block.MiniBlock{miniblock})
+}
+
+func eventsFromPage(page *LogEventsPage) []string {
+	events := make([]string, 0, len(page.Events))
+	for _, event := range page.Events {
+		events = append(events, string(event.TxHash)+"/"+string(event.Identifier))
+	}
+
+	return events
+}
+
+func newEvent(address string, identifier string, topics ...string) *transaction.Event {
+	event := &transaction.Event{
+		Address:    []byte(address),
+		Identifier: []byte(identifier),
+	}
+	for _, topic := range topics {
+		event.Topics = append(event.Topics, []byte(topic))
+	}
+
+	return event
+}
+
+func TestLogEventsIndex_GetLogEventsPageInvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	index := newLogEventsIndex(
+		genericmocks.NewStorerMock("TxLogs", 0),
+		genericmocks.NewStorerMock("LogEvents", 0),
+		&mock.MarshalizerMock{},
+		createTestCommittedHeaders(),
+	)
+
+	page, err := index.getLogEventsPage(&LogEventsQuery{Address: []byte("dex")}, nil, 0)
+	require.Nil(t, page)
+	require.Equal(t, ErrInvalidLimit, err)
+
+	page, err = index.getLogEventsPage(&LogEventsQuery{Address: []byte("dex")}, []byte("bad"), 10)
+	require.Nil(t, page)
+	require.Equal(t, ErrInvalidCursor, err)
+
+	page, err = index.getLogEventsPage(&LogEventsQuery{Topic0: []byte("alice")}, nil, 10)
+	require.Nil(t, page)
+	require.Equal(t, ErrInvalidLogEventsQuery, err)
+}
+
+func TestLogEventsIndex_SaveAndQueryByAddressAndIdentifier(t *testing.T) {
+	t.Parallel()
+
+	txLogsStorer := genericmocks.NewStorerMock("TxLogs", 0)
+	storer := genericmocks.NewStorerMock("LogEvents", 0)
+	index := newLogEventsIndex(txLogsStorer, storer, &mock.MarshalizerMock{}, createTestCommittedHeaders())
+
+	saveTxLog(txLogsStorer, "tx1", newEvent("dex", "swap", "alice"), newEvent("token", "transfer", "alice"))
+	saveTxLog(txLogsStorer, "tx2", newEvent("dex", "addLiquidity", "bob"))
+	saveTxLog(txLogsStorer, "tx3", newEvent("dex", "swap", "bob"), newEvent("other", "swap", "alice"))
+
+	saveLogEventsInIndex(index, storer, 1, 10, "tx1", "txWithoutLogs")
+	saveLogEventsInIndex(index, storer, 1, 11, "tx2")
+	// re-recording the same block (e.g. after a fork) should not duplicate entries
+	saveLogEventsInIndex(index, storer, 1, 11, "tx2")
+	saveLogEventsInIndex(index, storer, 2, 20, "tx3")
+
+	allNonces := &LogEventsQuery{ToNonce: math.MaxUint64}
+
+	query := *allNonces
+	query.Address = []byte("dex")
+	page, err := index.getLogEventsPage(&query, nil, 10)
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx3/swap", "tx2/addLiquidity", "tx1/swap"}, eventsFromPage(page))
+	require.Nil(t, page.NextCursor)
+	require.Equal(t, uint64(20), page.Events[0].HeaderNonce)
+	require.Equal(t, uint32(2), page.Events[0].Epoch)
+	require.Equal(t, uint32(0), page.Events[2].EventIndex)
+
+	query = *allNonces
+	query.Identifier = []byte("swap")
+	page, err = index.getLogEventsPage(&query, nil, 10)
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx3/swap", "tx3/swap", "tx1/swap"}, eventsFromPage(page))
+	require.Equal(t, []byte("other"), page.Events[0].Address)
+	require.Equal(t, uint32(1), page.Events[0].EventIndex)
+
+	query = *allNonces
+	query.Identifier = []byte("swap")
+	query.Topic0 = []byte("alice")
+	page, err = index.getLogEventsPage(&query, nil, 10)
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx3/swap", "tx1/swap"}, eventsFromPage(page))
+
+	query = *allNonces
+	query.Address = []byte("dex")
+	query.Identifier = []byte("swap")
+	page, err = index.getLogEventsPage(&query, nil, 10)
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx3/swap", "tx1/swap"}, eventsFromPage(page))
+
+	query = *allNonces
+	query.Address = []byte("unknown")
+	page, err = index.getLogEventsPage(&query, nil, 10)
+	require.Nil(t, err)
+	require.Equal(t, 0, len(page.Events))
+	require.Nil(t, page.NextCursor)
+}
+
+func TestLogEventsIndex_PagingAndNoncesRange(t *testing.T) {
+	t.Parallel()
+
+	txLogsStorer := genericmocks.NewStorerMock("TxLogs", 0)
+	storer := genericmocks.NewStorerMock("LogEvents", 0)
+	index := newLogEventsIndex(txLogsStorer, storer, &mock.MarshalizerMock{}, createTestCommittedHeaders())
+
+	saveTxLog(txLogsStorer, "tx1", newEvent("dex", "swap"))
+	saveTxLog(txLogsStorer, "tx2", newEvent("dex", "swap"))
+	saveTxLog(txLogsStorer, "tx3", newEvent("dex", "swap"))
+	saveTxLog(txLogsStorer, "tx4", newEvent("dex", "swap"))
+
+	saveLogEventsInIndex(index, storer, 1, 10, "tx1")
+	saveLogEventsInIndex(index, storer, 1, 11, "tx2")
+	saveLogEventsInIndex(index, storer, 3, 30, "tx3")
+	saveLogEventsInIndex(index, storer, 4, 40, "tx4")
+
+	query := &LogEventsQuery{Address: []byte("dex"), ToNonce: math.MaxUint64}
+	page, err := index.getLogEventsPage(query, nil, 1)
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx4/swap"}, eventsFromPage(page))
+	require.NotNil(t, page.NextCursor)
+
+	page, err = index.getLogEventsPage(query, page.NextCursor, 2)
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx3/swap", "tx2/swap"}, eventsFromPage(page))
+	require.NotNil(t, page.NextCursor)
+
+	page, err = index.getLogEventsPage(query, page.NextCursor, 2)
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx1/swap"}, eventsFromPage(page))
+	require.Nil(t, page.NextCursor)
+
+	query = &LogEventsQuery{Address: []byte("dex"), FromNonce: 11, ToNonce: 30}
+	page, err = index.getLogEventsPage(query, nil, 10)
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx3/swap", "tx2/swap"}, eventsFromPage(page))
+	require.Nil(t, page.NextCursor)
+}
+
+func TestLogEventsIndex_ReplacingBlockShouldDropTheEventsOfTheRevertedOnes(t *testing.T) {
+	t.Parallel()
+
+	txLogsStorer := genericmocks.NewStorerMock("TxLogs", 0)
+	storer := genericmocks.NewStorerMock("LogEvents", 0)
+	index := newLogEventsIndex(txLogsStorer, storer, &mock.MarshalizerMock{}, createTestCommittedHeaders())
+
+	saveTxLog(txLogsStorer, "tx1", newEvent("dex", "swap"))
+	saveTxLog(txLogsStorer, "tx2", newEvent("dex", "swap"))
+	saveTxLog(txLogsStorer, "tx3", newEvent("dex", "addLiquidity"))
+	saveTxLog(txLogsStorer, "tx4", newEvent("dex", "swap"))
+
+	saveLogEventsInIndex(index, storer, 1, 10, "tx1")
+	saveLogEventsInIndex(index, storer, 1, 11, "tx2")
+	saveLogEventsInIndex(index, storer, 1, 12, "tx3")
+	// blocks 11 and 12 are reverted, block 11 being replaced by a block including tx4
+	revertTestHeader(index.committedHeaders, 12)
+	saveLogEventsInIndex(index, storer, 1, 11, "tx4")
+
+	query := &LogEventsQuery{Address: []byte("dex"), ToNonce: math.MaxUint64}
+	page, err := index.getLogEventsPage(query, nil, 10)
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx4/swap", "tx1/swap"}, eventsFromPage(page))
+
+	query = &LogEventsQuery{Identifier: []byte("swap"), ToNonce: math.MaxUint64}
+	page, err = index.getLogEventsPage(query, nil, 10)
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx4/swap", "tx1/swap"}, eventsFromPage(page))
+
+	// the replacing block did not emit addLiquidity events, so the orphaned ones are still recorded, but skipped
+	query = &LogEventsQuery{Identifier: []byte("addLiquidity"), ToNonce: math.MaxUint64}
+	page, err = index.getLogEventsPage(query, nil, 10)
+	require.Nil(t, err)
+	require.Equal(t, 0, len(page.Events))
+
+	numRecordedEntries := 0
+	_, err = index.records.walkEntries(createLogEventsKey(logEventsByIdentifierKind, []byte("addLiquidity")), nil, func(_ *RecordEntry) walkAction {
+		numRecordedEntries++
+		return continueWalk
+	})
+	require.Nil(t, err)
+	require.Equal(t, 1, numRecordedEntries)
+}
+
+func TestLogEventsIndex_ShouldSkipTheEventsOfBlocksNotCommittedAnymore(t *testing.T) {
+	t.Parallel()
+
+	txLogsStorer := genericmocks.NewStorerMock("TxLogs", 0)
+	storer := genericmocks.NewStorerMock("LogEvents", 0)
+	index := newLogEventsIndex(txLogsStorer, storer, &mock.MarshalizerMock{}, createTestCommittedHeaders())
+
+	saveTxLog(txLogsStorer, "tx1", newEvent("dex", "swap"))
+	saveTxLog(txLogsStorer, "tx2", newEvent("dex", "swap"))
+	saveTxLog(txLogsStorer, "tx3", newEvent("dex", "swap"))
+	saveTxLog(txLogsStorer, "tx4", newEvent("other", "swap"))
+
+	saveLogEventsInIndex(index, storer, 1, 10, "tx1")
+	saveLogEventsInIndex(index, storer, 1, 11, "tx2")
+	saveLogEventsInIndex(index, storer, 1, 12, "tx3")
+	// block 12 is reverted, then block 11 is replaced by a block which does not emit events for dex
+	revertTestHeader(index.committedHeaders, 12)
+	saveLogEventsInIndex(index, storer, 1, 11, "tx4")
+
+	query := &LogEventsQuery{Address: []byte("dex"), ToNonce: math.MaxUint64}
+	page, err := index.getLogEventsPage(query, nil, 10)
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx1/swap"}, eventsFromPage(page))
+	require.Nil(t, page.NextCursor)
+
+	query = &LogEventsQuery{Identifier: []byte("swap"), ToNonce: math.MaxUint64}
+	page, err = index.getLogEventsPage(query, nil, 10)
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx4/swap", "tx1/swap"}, eventsFromPage(page))
+}
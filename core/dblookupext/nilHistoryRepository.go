@@ -13,7 +13,7 @@ func NewNilHistoryRepository() (*nilHistoryRepository, error) {
 }
 
 // RecordBlock returns a not implemented error
-func (nhr *nilHistoryRepository) RecordBlock(_ []byte, _ data.HeaderHandler, _ data.BodyHandler, _, _ map[string]data.TransactionHandler) error {
+func (nhr *nilHistoryRepository) RecordBlock(_ []byte, _ data.HeaderHandler, _ data.BodyHandler, _, _, _ map[string]data.TransactionHandler) error {
 	return nil
 }
 
@@ -41,6 +41,16 @@ func (nhr *nilHistoryRepository) GetResultsHashesByTxHash(_ []byte, _ uint32) (*
 	return nil, nil
 }
 
+// GetTransactionsByAddress returns an empty page
+func (nhr *nilHistoryRepository) GetTransactionsByAddress(_ []byte, _ []byte, _ int) (*TransactionsByAddressPage, error) {
+	return &TransactionsByAddressPage{}, nil
+}
+
+// GetLogEvents returns an empty page
+func (nhr *nilHistoryRepository) GetLogEvents(_ *LogEventsQuery, _ []byte, _ int) (*LogEventsPage, error) {
+	return &LogEventsPage{}, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nhr *nilHistoryRepository) IsInterfaceNil() bool {
 	return nhr == nil
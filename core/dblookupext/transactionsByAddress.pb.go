@@ -0,0 +1,717 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: transactionsByAddress.proto
+
+package dblookupext
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AddressTransaction is used to store a reference to a transaction sent or received by an address
+type AddressTransaction struct {
+	TxHash        []byte `protobuf:"bytes,1,opt,name=TxHash,proto3" json:"TxHash,omitempty"`
+	MiniblockHash []byte `protobuf:"bytes,2,opt,name=MiniblockHash,proto3" json:"MiniblockHash,omitempty"`
+	MiniblockType int32  `protobuf:"varint,3,opt,name=MiniblockType,proto3" json:"MiniblockType,omitempty"`
+	HeaderHash    []byte `protobuf:"bytes,4,opt,name=HeaderHash,proto3" json:"HeaderHash,omitempty"`
+	HeaderNonce   uint64 `protobuf:"varint,5,opt,name=HeaderNonce,proto3" json:"HeaderNonce,omitempty"`
+	Epoch         uint32 `protobuf:"varint,6,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	IsSender      bool   `protobuf:"varint,7,opt,name=IsSender,proto3" json:"IsSender,omitempty"`
+	IsReceiver    bool   `protobuf:"varint,8,opt,name=IsReceiver,proto3" json:"IsReceiver,omitempty"`
+}
+
+func (m *AddressTransaction) Reset()      { *m = AddressTransaction{} }
+func (*AddressTransaction) ProtoMessage() {}
+func (*AddressTransaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_835191adf6b24158, []int{0}
+}
+func (m *AddressTransaction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressTransaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AddressTransaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressTransaction.Merge(m, src)
+}
+func (m *AddressTransaction) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressTransaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressTransaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressTransaction proto.InternalMessageInfo
+
+func (m *AddressTransaction) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *AddressTransaction) GetMiniblockHash() []byte {
+	if m != nil {
+		return m.MiniblockHash
+	}
+	return nil
+}
+
+func (m *AddressTransaction) GetMiniblockType() int32 {
+	if m != nil {
+		return m.MiniblockType
+	}
+	return 0
+}
+
+func (m *AddressTransaction) GetHeaderHash() []byte {
+	if m != nil {
+		return m.HeaderHash
+	}
+	return nil
+}
+
+func (m *AddressTransaction) GetHeaderNonce() uint64 {
+	if m != nil {
+		return m.HeaderNonce
+	}
+	return 0
+}
+
+func (m *AddressTransaction) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *AddressTransaction) GetIsSender() bool {
+	if m != nil {
+		return m.IsSender
+	}
+	return false
+}
+
+func (m *AddressTransaction) GetIsReceiver() bool {
+	if m != nil {
+		return m.IsReceiver
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*AddressTransaction)(nil), "proto.AddressTransaction")
+}
+
+func init() { proto.RegisterFile("transactionsByAddress.proto", fileDescriptor_835191adf6b24158) }
+
+var fileDescriptor_835191adf6b24158 = []byte{
+	// 312 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0x31, 0x4f, 0x32, 0x31,
+	0x18, 0xc7, 0xef, 0xe1, 0xe5, 0x78, 0x49, 0x91, 0xa5, 0x31, 0xa6, 0xc1, 0xe4, 0x49, 0x63, 0x1c,
+	0x6e, 0x11, 0x06, 0x3f, 0x81, 0x24, 0x24, 0x30, 0xe8, 0x50, 0x99, 0xdc, 0xee, 0x7a, 0x15, 0x2e,
+	0xe0, 0xf5, 0xd2, 0x1e, 0x06, 0x36, 0x3f, 0x80, 0x83, 0x1f, 0xc3, 0x8f, 0xe2, 0xc8, 0xc8, 0x28,
+	0x65, 0x71, 0xe4, 0x23, 0x18, 0x0b, 0x51, 0x98, 0xda, 0xdf, 0xef, 0xdf, 0xfe, 0x9f, 0xe4, 0x21,
+	0xe7, 0xa5, 0x89, 0x73, 0x1b, 0xcb, 0x32, 0xd3, 0xb9, 0xed, 0x2e, 0x6e, 0xd2, 0xd4, 0x28, 0x6b,
+	0xdb, 0x85, 0xd1, 0xa5, 0xa6, 0xa1, 0x3f, 0x5a, 0x57, 0xa3, 0xac, 0x1c, 0xcf, 0x92, 0xb6, 0xd4,
+	0x4f, 0x9d, 0x91, 0x1e, 0xe9, 0x8e, 0xd7, 0xc9, 0xec, 0xd1, 0x93, 0x07, 0x7f, 0xdb, 0xfd, 0xba,
+	0x78, 0xad, 0x10, 0xba, 0xef, 0x19, 0xfe, 0x95, 0xd3, 0x33, 0x52, 0x1b, 0xce, 0xfb, 0xb1, 0x1d,
+	0x33, 0xe0, 0x10, 0x9d, 0x88, 0x3d, 0xd1, 0x4b, 0xd2, 0xbc, 0xcd, 0xf2, 0x2c, 0x99, 0x6a, 0x39,
+	0xf1, 0x71, 0xc5, 0xc7, 0xc7, 0xf2, 0xe8, 0xd5, 0x70, 0x51, 0x28, 0xf6, 0x8f, 0x43, 0x14, 0x8a,
+	0x63, 0x49, 0x91, 0x90, 0xbe, 0x8a, 0x53, 0x65, 0x7c, 0x51, 0xd5, 0x17, 0x1d, 0x18, 0xca, 0x49,
+	0x63, 0x47, 0x77, 0x3a, 0x97, 0x8a, 0x85, 0x1c, 0xa2, 0xaa, 0x38, 0x54, 0xf4, 0x94, 0x84, 0xbd,
+	0x42, 0xcb, 0x31, 0xab, 0x71, 0x88, 0x9a, 0x62, 0x07, 0xb4, 0x45, 0xea, 0x03, 0x7b, 0xaf, 0xf2,
+	0x54, 0x19, 0xf6, 0x9f, 0x43, 0x54, 0x17, 0xbf, 0xfc, 0x33, 0x73, 0x60, 0x85, 0x92, 0x2a, 0x7b,
+	0x56, 0x86, 0xd5, 0x7d, 0x7a, 0x60, 0xba, 0xbd, 0xe5, 0x1a, 0x83, 0xd5, 0x1a, 0x83, 0xed, 0x1a,
+	0xe1, 0xc5, 0x21, 0xbc, 0x3b, 0x84, 0x0f, 0x87, 0xb0, 0x74, 0x08, 0x2b, 0x87, 0xf0, 0xe9, 0x10,
+	0xbe, 0x1c, 0x06, 0x5b, 0x87, 0xf0, 0xb6, 0xc1, 0x60, 0xb9, 0xc1, 0x60, 0xb5, 0xc1, 0xe0, 0xa1,
+	0x91, 0x26, 0x53, 0xad, 0x27, 0xb3, 0x42, 0xcd, 0xcb, 0xa4, 0xe6, 0x97, 0x7b, 0xfd, 0x3d, 0x00,
+	0x9c, 0x76, 0x89, 0x26, 0xb1, 0x01, 0x00, 0x00,
+}
+
+func (this *AddressTransaction) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AddressTransaction)
+	if !ok {
+		that2, ok := that.(AddressTransaction)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.TxHash, that1.TxHash) {
+		return false
+	}
+	if !bytes.Equal(this.MiniblockHash, that1.MiniblockHash) {
+		return false
+	}
+	if this.MiniblockType != that1.MiniblockType {
+		return false
+	}
+	if !bytes.Equal(this.HeaderHash, that1.HeaderHash) {
+		return false
+	}
+	if this.HeaderNonce != that1.HeaderNonce {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if this.IsSender != that1.IsSender {
+		return false
+	}
+	if this.IsReceiver != that1.IsReceiver {
+		return false
+	}
+	return true
+}
+func (this *AddressTransaction) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&dblookupext.AddressTransaction{")
+	s = append(s, "TxHash: "+fmt.Sprintf("%#v", this.TxHash)+",\n")
+	s = append(s, "MiniblockHash: "+fmt.Sprintf("%#v", this.MiniblockHash)+",\n")
+	s = append(s, "MiniblockType: "+fmt.Sprintf("%#v", this.MiniblockType)+",\n")
+	s = append(s, "HeaderHash: "+fmt.Sprintf("%#v", this.HeaderHash)+",\n")
+	s = append(s, "HeaderNonce: "+fmt.Sprintf("%#v", this.HeaderNonce)+",\n")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "IsSender: "+fmt.Sprintf("%#v", this.IsSender)+",\n")
+	s = append(s, "IsReceiver: "+fmt.Sprintf("%#v", this.IsReceiver)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringTransactionsByAddress(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *AddressTransaction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressTransaction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressTransaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsReceiver {
+		i--
+		if m.IsReceiver {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.IsSender {
+		i--
+		if m.IsSender {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintTransactionsByAddress(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.HeaderNonce != 0 {
+		i = encodeVarintTransactionsByAddress(dAtA, i, uint64(m.HeaderNonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.HeaderHash) > 0 {
+		i -= len(m.HeaderHash)
+		copy(dAtA[i:], m.HeaderHash)
+		i = encodeVarintTransactionsByAddress(dAtA, i, uint64(len(m.HeaderHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MiniblockType != 0 {
+		i = encodeVarintTransactionsByAddress(dAtA, i, uint64(m.MiniblockType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MiniblockHash) > 0 {
+		i -= len(m.MiniblockHash)
+		copy(dAtA[i:], m.MiniblockHash)
+		i = encodeVarintTransactionsByAddress(dAtA, i, uint64(len(m.MiniblockHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintTransactionsByAddress(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTransactionsByAddress(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTransactionsByAddress(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AddressTransaction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTransactionsByAddress(uint64(l))
+	}
+	l = len(m.MiniblockHash)
+	if l > 0 {
+		n += 1 + l + sovTransactionsByAddress(uint64(l))
+	}
+	if m.MiniblockType != 0 {
+		n += 1 + sovTransactionsByAddress(uint64(m.MiniblockType))
+	}
+	l = len(m.HeaderHash)
+	if l > 0 {
+		n += 1 + l + sovTransactionsByAddress(uint64(l))
+	}
+	if m.HeaderNonce != 0 {
+		n += 1 + sovTransactionsByAddress(uint64(m.HeaderNonce))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovTransactionsByAddress(uint64(m.Epoch))
+	}
+	if m.IsSender {
+		n += 2
+	}
+	if m.IsReceiver {
+		n += 2
+	}
+	return n
+}
+
+func sovTransactionsByAddress(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTransactionsByAddress(x uint64) (n int) {
+	return sovTransactionsByAddress(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *AddressTransaction) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddressTransaction{`,
+		`TxHash:` + fmt.Sprintf("%v", this.TxHash) + `,`,
+		`MiniblockHash:` + fmt.Sprintf("%v", this.MiniblockHash) + `,`,
+		`MiniblockType:` + fmt.Sprintf("%v", this.MiniblockType) + `,`,
+		`HeaderHash:` + fmt.Sprintf("%v", this.HeaderHash) + `,`,
+		`HeaderNonce:` + fmt.Sprintf("%v", this.HeaderNonce) + `,`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`IsSender:` + fmt.Sprintf("%v", this.IsSender) + `,`,
+		`IsReceiver:` + fmt.Sprintf("%v", this.IsReceiver) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringTransactionsByAddress(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *AddressTransaction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransactionsByAddress
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressTransaction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressTransaction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransactionsByAddress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTransactionsByAddress
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransactionsByAddress
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MiniblockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransactionsByAddress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTransactionsByAddress
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransactionsByAddress
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MiniblockHash = append(m.MiniblockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.MiniblockHash == nil {
+				m.MiniblockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MiniblockType", wireType)
+			}
+			m.MiniblockType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransactionsByAddress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MiniblockType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransactionsByAddress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTransactionsByAddress
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransactionsByAddress
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeaderHash = append(m.HeaderHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.HeaderHash == nil {
+				m.HeaderHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderNonce", wireType)
+			}
+			m.HeaderNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransactionsByAddress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeaderNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransactionsByAddress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsSender", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransactionsByAddress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsSender = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsReceiver", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransactionsByAddress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsReceiver = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransactionsByAddress(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransactionsByAddress
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTransactionsByAddress
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTransactionsByAddress(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTransactionsByAddress
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTransactionsByAddress
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTransactionsByAddress
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTransactionsByAddress
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTransactionsByAddress
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTransactionsByAddress
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTransactionsByAddress        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTransactionsByAddress          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTransactionsByAddress = fmt.Errorf("proto: unexpected end of group")
+)
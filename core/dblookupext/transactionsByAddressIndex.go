@@ -0,0 +1,160 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. transactionsByAddress.proto
+
+package dblookupext
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// TransactionsByAddressPage holds a page of transactions of an address, ordered from the newest to the oldest one,
+// along with the cursor that should be used in order to fetch the next page. An empty cursor signals the last page.
+type TransactionsByAddressPage struct {
+	Transactions []*AddressTransaction
+	NextCursor   []byte
+}
+
+type transactionsByAddressIndex struct {
+	marshalizer      marshal.Marshalizer
+	records          *chunkedRecords
+	committedHeaders *committedHeaders
+}
+
+func newTransactionsByAddressIndex(
+	storer storage.Storer,
+	marshalizer marshal.Marshalizer,
+	committedHeaders *committedHeaders,
+) *transactionsByAddressIndex {
+	return &transactionsByAddressIndex{
+		marshalizer:      marshalizer,
+		records:          newChunkedRecords(storer, marshalizer),
+		committedHeaders: committedHeaders,
+	}
+}
+
+// saveTransactions indexes, by sender and by receiver, the transactions of the provided miniblocks. The blocks are
+// indexed at commit time, so the transactions of a reverted block stay in the records of an address until a block
+// with the same or a lower nonce records transactions for that address. Until then, they are skipped by the reads.
+func (tai *transactionsByAddressIndex) saveTransactions(
+	headerHash []byte,
+	header data.HeaderHandler,
+	miniblocks []*block.MiniBlock,
+	miniblocksHashes [][]byte,
+	transactions map[string]data.TransactionHandler,
+) {
+	epoch := header.GetEpoch()
+	addresses := make([]string, 0)
+	transactionsByAddress := make(map[string][]*AddressTransaction)
+	addTransaction := func(address []byte, addressTransaction *AddressTransaction) {
+		if len(address) == 0 {
+			return
+		}
+
+		_, exists := transactionsByAddress[string(address)]
+		if !exists {
+			addresses = append(addresses, string(address))
+		}
+		transactionsByAddress[string(address)] = append(transactionsByAddress[string(address)], addressTransaction)
+	}
+
+	for i, miniblock := range miniblocks {
+		for _, txHash := range miniblock.TxHashes {
+			tx, ok := transactions[string(txHash)]
+			if !ok {
+				continue
+			}
+
+			sender := tx.GetSndAddr()
+			receiver := tx.GetRcvAddr()
+			isSelfTransaction := bytes.Equal(sender, receiver)
+			newAddressTransaction := func(isSender bool, isReceiver bool) *AddressTransaction {
+				return &AddressTransaction{
+					TxHash:        txHash,
+					MiniblockHash: miniblocksHashes[i],
+					MiniblockType: int32(miniblock.Type),
+					HeaderHash:    headerHash,
+					HeaderNonce:   header.GetNonce(),
+					Epoch:         epoch,
+					IsSender:      isSender,
+					IsReceiver:    isReceiver,
+				}
+			}
+
+			addTransaction(sender, newAddressTransaction(true, isSelfTransaction))
+			if !isSelfTransaction {
+				addTransaction(receiver, newAddressTransaction(false, true))
+			}
+		}
+	}
+
+	for _, address := range addresses {
+		err := tai.appendTransactions([]byte(address), epoch, header.GetNonce(), transactionsByAddress[address])
+		if err != nil {
+			log.Warn("transactionsByAddressIndex.appendTransactions() cannot save transactions",
+				"error", err.Error())
+		}
+	}
+}
+
+func (tai *transactionsByAddressIndex) appendTransactions(
+	address []byte,
+	epoch uint32,
+	nonce uint64,
+	newTransactions []*AddressTransaction,
+) error {
+	entries := make([]*RecordEntry, 0, len(newTransactions))
+	for _, addressTransaction := range newTransactions {
+		rawBytes, err := tai.marshalizer.Marshal(addressTransaction)
+		if err != nil {
+			return err
+		}
+
+		entries = append(entries, &RecordEntry{
+			HeaderNonce: nonce,
+			Data:        rawBytes,
+		})
+	}
+
+	return tai.records.appendEntries(address, epoch, nonce, entries)
+}
+
+// getTransactionsPage walks the records of the address from the newest epoch to the oldest one, keeping the
+// transactions of the committed blocks and stopping when the limit is reached or when there are no more (unpruned)
+// records
+func (tai *transactionsByAddressIndex) getTransactionsPage(address []byte, cursor []byte, limit int) (*TransactionsByAddressPage, error) {
+	if limit <= 0 {
+		return nil, ErrInvalidLimit
+	}
+
+	page := &TransactionsByAddressPage{
+		Transactions: make([]*AddressTransaction, 0, limit),
+	}
+
+	nextCursor, err := tai.records.walkEntries(address, cursor, func(entry *RecordEntry) walkAction {
+		if len(page.Transactions) == limit {
+			return pauseWalk
+		}
+
+		addressTransaction := &AddressTransaction{}
+		errUnmarshal := tai.marshalizer.Unmarshal(addressTransaction, entry.Data)
+		if errUnmarshal != nil {
+			return continueWalk
+		}
+		if !tai.committedHeaders.isCommitted(addressTransaction.HeaderNonce, addressTransaction.HeaderHash) {
+			return continueWalk
+		}
+
+		page.Transactions = append(page.Transactions, addressTransaction)
+		return continueWalk
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	page.NextCursor = nextCursor
+	return page, nil
+}
@@ -0,0 +1,168 @@
+package dblookupext
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericmocks"
+	"github.com/stretchr/testify/require"
+)
+
+// saveTransactionsInIndex commits the block with the provided nonce, holding the provided transactions, and indexes
+// its transactions
+func saveTransactionsInIndex(
+	index *transactionsByAddressIndex,
+	storer *genericmocks.StorerMock,
+	epoch uint32,
+	nonce uint64,
+	txs map[string]data.TransactionHandler,
+	txHashes ...string,
+) {
+	storer.SetCurrentEpoch(epoch)
+	miniblock := &block.MiniBlock{
+		Type: block.TxBlock,
+	}
+	for _, txHash := range txHashes {
+		miniblock.TxHashes = append(miniblock.TxHashes, []byte(txHash))
+	}
+
+	headerHash := []byte(fmt.Sprintf("headerHash-%d", nonce))
+	commitTestHeader(index.committedHeaders, nonce, headerHash)
+	index.saveTransactions(
+		headerHash,
+		&block.Header{Epoch: epoch, Nonce: nonce},
+		[]*block.MiniBlock{miniblock},
+		[][]byte{[]byte("miniblockHash")},
+		txs,
+	)
+}
+
+func txHashesFromPage(page *TransactionsByAddressPage) []string {
+	txHashes := make([]string, 0, len(page.Transactions))
+	for _, addressTransaction := range page.Transactions {
+		txHashes = append(txHashes, string(addressTransaction.TxHash))
+	}
+
+	return txHashes
+}
+
+func TestTransactionsByAddressIndex_GetTransactionsPageInvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	index := newTransactionsByAddressIndex(genericmocks.NewStorerMock("TxsByAddress", 0), &mock.MarshalizerMock{}, createTestCommittedHeaders())
+
+	page, err := index.getTransactionsPage([]byte("alice"), nil, 0)
+	require.Nil(t, page)
+	require.Equal(t, ErrInvalidLimit, err)
+
+	page, err = index.getTransactionsPage([]byte("alice"), []byte("bad"), 10)
+	require.Nil(t, page)
+	require.Equal(t, ErrInvalidCursor, err)
+}
+
+func TestTransactionsByAddressIndex_GetTransactionsPageUnknownAddress(t *testing.T) {
+	t.Parallel()
+
+	index := newTransactionsByAddressIndex(genericmocks.NewStorerMock("TxsByAddress", 0), &mock.MarshalizerMock{}, createTestCommittedHeaders())
+
+	page, err := index.getTransactionsPage([]byte("alice"), nil, 10)
+	require.Nil(t, err)
+	require.Equal(t, 0, len(page.Transactions))
+	require.Nil(t, page.NextCursor)
+}
+
+func TestTransactionsByAddressIndex_SaveAndPageThroughEpochs(t *testing.T) {
+	t.Parallel()
+
+	storer := genericmocks.NewStorerMock("TxsByAddress", 0)
+	index := newTransactionsByAddressIndex(storer, &mock.MarshalizerMock{}, createTestCommittedHeaders())
+
+	txs := map[string]data.TransactionHandler{
+		"tx1": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+		"tx2": &transaction.Transaction{SndAddr: []byte("bob"), RcvAddr: []byte("alice")},
+		"tx3": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("alice")},
+		"tx4": &transaction.Transaction{SndAddr: []byte("carol"), RcvAddr: []byte("bob")},
+		"tx5": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("carol")},
+	}
+
+	saveTransactionsInIndex(index, storer, 1, 10, txs, "tx1", "tx2")
+	saveTransactionsInIndex(index, storer, 1, 11, txs, "tx3", "tx4", "missing")
+	// re-recording the same block (e.g. after a fork) should not duplicate entries
+	saveTransactionsInIndex(index, storer, 1, 11, txs, "tx3")
+	saveTransactionsInIndex(index, storer, 3, 30, txs, "tx5")
+
+	page, err := index.getTransactionsPage([]byte("alice"), nil, 2)
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx5", "tx3"}, txHashesFromPage(page))
+	require.True(t, page.Transactions[0].IsSender)
+	require.False(t, page.Transactions[0].IsReceiver)
+	require.True(t, page.Transactions[1].IsSender)
+	require.True(t, page.Transactions[1].IsReceiver)
+	require.Equal(t, uint32(3), page.Transactions[0].Epoch)
+	require.Equal(t, uint64(11), page.Transactions[1].HeaderNonce)
+	require.NotNil(t, page.NextCursor)
+
+	page, err = index.getTransactionsPage([]byte("alice"), page.NextCursor, 2)
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx2", "tx1"}, txHashesFromPage(page))
+	require.False(t, page.Transactions[0].IsSender)
+	require.True(t, page.Transactions[0].IsReceiver)
+	require.Nil(t, page.NextCursor)
+
+	page, err = index.getTransactionsPage([]byte("bob"), nil, 10)
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx4", "tx2", "tx1"}, txHashesFromPage(page))
+	require.Nil(t, page.NextCursor)
+}
+
+func TestTransactionsByAddressIndex_NextCursorPointsToPreviousEpoch(t *testing.T) {
+	t.Parallel()
+
+	storer := genericmocks.NewStorerMock("TxsByAddress", 0)
+	index := newTransactionsByAddressIndex(storer, &mock.MarshalizerMock{}, createTestCommittedHeaders())
+
+	txs := map[string]data.TransactionHandler{
+		"tx1": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+		"tx2": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+	}
+	saveTransactionsInIndex(index, storer, 2, 20, txs, "tx1")
+	saveTransactionsInIndex(index, storer, 5, 50, txs, "tx2")
+
+	page, err := index.getTransactionsPage([]byte("alice"), nil, 1)
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx2"}, txHashesFromPage(page))
+	require.NotNil(t, page.NextCursor)
+
+	page, err = index.getTransactionsPage([]byte("alice"), page.NextCursor, 1)
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx1"}, txHashesFromPage(page))
+	require.Nil(t, page.NextCursor)
+}
+
+func TestTransactionsByAddressIndex_ShouldSkipTheTransactionsOfBlocksNotCommittedAnymore(t *testing.T) {
+	t.Parallel()
+
+	storer := genericmocks.NewStorerMock("TxsByAddress", 0)
+	index := newTransactionsByAddressIndex(storer, &mock.MarshalizerMock{}, createTestCommittedHeaders())
+
+	txs := map[string]data.TransactionHandler{
+		"tx1": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+		"tx2": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+		"tx3": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+	}
+	saveTransactionsInIndex(index, storer, 1, 10, txs, "tx1")
+	saveTransactionsInIndex(index, storer, 1, 11, txs, "tx2")
+	saveTransactionsInIndex(index, storer, 1, 12, txs, "tx3")
+	// block 12 is reverted, then block 11 is replaced by a block without transactions of alice
+	revertTestHeader(index.committedHeaders, 12)
+	commitTestHeader(index.committedHeaders, 11, []byte("replacingHeaderHash"))
+
+	page, err := index.getTransactionsPage([]byte("alice"), nil, 10)
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx1"}, txHashesFromPage(page))
+	require.Nil(t, page.NextCursor)
+}
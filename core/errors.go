@@ -103,3 +103,6 @@ var ErrSubtractionOverflow = errors.New("uint64 subtraction overflowed")
 
 // ErrNilTransactionFeeCalculator signals that a nil transaction fee calculator has been provided
 var ErrNilTransactionFeeCalculator = errors.New("nil transaction fee calculator")
+
+// ErrInvalidConsensusView signals that the encoded consensus view is invalid
+var ErrInvalidConsensusView = errors.New("invalid consensus view")
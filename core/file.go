@@ -1,6 +1,7 @@
 package core
 
 import (
+	"bytes"
 	"encoding/json"
 	"encoding/pem"
 	"fmt"
@@ -153,8 +154,22 @@ func LoadSkPkFromPemFile(relativePath string, skIndex int) ([]byte, string, erro
 	if err != nil {
 		return nil, "", fmt.Errorf("%w while reading %s file", err, relativePath)
 	}
+
+	skBytes, pkString, err := LoadSkPkFromPemBytes(buff, skIndex)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w while reading %s file", err, relativePath)
+	}
+
+	return skBytes, pkString, nil
+}
+
+// LoadSkPkFromPemBytes loads the secret key and existing public key bytes stored in the provided PEM content
+func LoadSkPkFromPemBytes(buff []byte, skIndex int) ([]byte, string, error) {
+	if skIndex < 0 {
+		return nil, "", ErrInvalidIndex
+	}
 	if len(buff) == 0 {
-		return nil, "", fmt.Errorf("%w while reading %s file", ErrEmptyFile, relativePath)
+		return nil, "", ErrEmptyFile
 	}
 
 	var blkRecovered *pem.Block
@@ -162,15 +177,50 @@ func LoadSkPkFromPemFile(relativePath string, skIndex int) ([]byte, string, erro
 	for i := 0; i <= skIndex; i++ {
 		if len(buff) == 0 {
 			//less private keys present in the file than required
-			return nil, "", fmt.Errorf("%w while reading %s file, invalid index %d", ErrInvalidIndex, relativePath, i)
+			return nil, "", fmt.Errorf("%w %d", ErrInvalidIndex, i)
+		}
+
+		blkRecovered, buff = pem.Decode(buff)
+		if blkRecovered == nil {
+			return nil, "", fmt.Errorf("%w, error decoding", ErrPemFileIsInvalid)
 		}
+	}
+
+	return getSkPkFromPemBlock(blkRecovered)
+}
 
+// LoadAllSkPkFromPemBytes loads all the secret keys and existing public keys stored in the provided PEM content
+func LoadAllSkPkFromPemBytes(buff []byte) ([][]byte, []string, error) {
+	if len(buff) == 0 {
+		return nil, nil, ErrEmptyFile
+	}
+
+	skBytesList := make([][]byte, 0)
+	pkStrings := make([]string, 0)
+	for len(bytes.TrimSpace(buff)) > 0 {
+		var blkRecovered *pem.Block
 		blkRecovered, buff = pem.Decode(buff)
 		if blkRecovered == nil {
-			return nil, "", fmt.Errorf("%w while reading %s file, error decoding", ErrPemFileIsInvalid, relativePath)
+			return nil, nil, fmt.Errorf("%w, error decoding", ErrPemFileIsInvalid)
+		}
+
+		skBytes, pkString, err := getSkPkFromPemBlock(blkRecovered)
+		if err != nil {
+			return nil, nil, err
 		}
+
+		skBytesList = append(skBytesList, skBytes)
+		pkStrings = append(pkStrings, pkString)
 	}
 
+	if len(skBytesList) == 0 {
+		return nil, nil, ErrEmptyFile
+	}
+
+	return skBytesList, pkStrings, nil
+}
+
+func getSkPkFromPemBlock(blkRecovered *pem.Block) ([]byte, string, error) {
 	if blkRecovered == nil {
 		return nil, "", ErrNilPemBLock
 	}
@@ -0,0 +1,50 @@
+package core
+
+// HealthStatus defines the health status of a component or of the whole node
+type HealthStatus string
+
+const (
+	// HealthStatusOK signals that the component works as expected
+	HealthStatusOK HealthStatus = "ok"
+	// HealthStatusDegraded signals that the component works, but something needs attention
+	HealthStatusDegraded HealthStatus = "degraded"
+	// HealthStatusUnavailable signals that the component can not do its job
+	HealthStatusUnavailable HealthStatus = "unavailable"
+)
+
+const (
+	// HealthComponentTxPool is the name under which the transactions pool reports its health
+	HealthComponentTxPool = "txpool"
+	// HealthComponentTrieStoragePrefix prefixes the names under which the trie storage managers report their health
+	HealthComponentTrieStoragePrefix = "trie/"
+	// HealthComponentP2P is the name under which the p2p messenger reports its health
+	HealthComponentP2P = "p2p"
+	// HealthComponentSync is the name under which the blocks bootstrapper reports its health
+	HealthComponentSync = "sync"
+	// HealthComponentIndexer is the name under which the indexer reports its health
+	HealthComponentIndexer = "indexer"
+)
+
+// HealthCheckResult is the outcome of a single health check, as reported by a component
+type HealthCheckResult struct {
+	Status HealthStatus
+	Reason string
+}
+
+// ComponentHealth holds the health of a registered component, along with the last time it was found healthy
+type ComponentHealth struct {
+	Status               HealthStatus `json:"status"`
+	Reason               string       `json:"reason,omitempty"`
+	LastSuccessTimestamp int64        `json:"lastSuccessTimestamp"`
+}
+
+// HealthReport holds the aggregated health of the node
+type HealthReport struct {
+	Status     HealthStatus               `json:"status"`
+	Components map[string]ComponentHealth `json:"components,omitempty"`
+}
+
+// NewHealthCheckResultOK creates a successful health check result
+func NewHealthCheckResultOK() HealthCheckResult {
+	return HealthCheckResult{Status: HealthStatusOK}
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"math"
 	"math/big"
 	"path/filepath"
 	"strconv"
@@ -18,6 +19,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 )
@@ -601,6 +603,47 @@ func getPolicyByIndex(path string, index string) (*bytes.Buffer, error) {
 	return indexPolicy, nil
 }
 
+func loadAlteredAccounts(
+	accounts map[string]struct{},
+	addressPubkeyConverter core.PubkeyConverter,
+	accountsDB state.AccountsAdapter,
+) []state.UserAccountHandler {
+	accountsToIndex := make([]state.UserAccountHandler, 0)
+	for address := range accounts {
+		addressBytes, err := addressPubkeyConverter.Decode(address)
+		if err != nil {
+			log.Warn("cannot decode address", "address", address, "error", err)
+			continue
+		}
+
+		account, err := accountsDB.LoadAccount(addressBytes)
+		if err != nil {
+			log.Warn("cannot load account", "address bytes", addressBytes, "error", err)
+			continue
+		}
+
+		userAccount, ok := account.(state.UserAccountHandler)
+		if !ok {
+			log.Warn("cannot cast AccountHandler to type UserAccountHandler")
+			continue
+		}
+
+		accountsToIndex = append(accountsToIndex, userAccount)
+	}
+
+	return accountsToIndex
+}
+
+func computeBalanceAsFloat(balance *big.Int, dividerForDenomination float64, balancePrecision float64) float64 {
+	balanceBigFloat := big.NewFloat(0).SetInt(balance)
+	balanceFloat64, _ := balanceBigFloat.Float64()
+
+	bal := balanceFloat64 / dividerForDenomination
+	balanceFloatWithDecimals := math.Round(bal*balancePrecision) / balancePrecision
+
+	return core.MaxFloat64(balanceFloatWithDecimals, 0)
+}
+
 func stringValueToBigInt(strValue string) *big.Int {
 	value, ok := big.NewInt(0).SetString(strValue, 10)
 	if !ok {
@@ -2,6 +2,13 @@ package indexer
 
 var headerContentTypeJSON = []string{"application/json"}
 
+const (
+	// ElasticDriver selects the elasticsearch indexer driver
+	ElasticDriver = "elastic"
+	// SQLDriver selects the indexer driver writing to a SQL database
+	SQLDriver = "sql"
+)
+
 const (
 	headerXSRF        = "kbn-xsrf"
 	headerContentType = "Content-Type"
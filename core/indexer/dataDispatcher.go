@@ -3,9 +3,11 @@ package indexer
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
 )
@@ -25,6 +27,9 @@ const (
 	maxBackOff  = time.Minute * 5
 )
 
+// queueDepthPercentToDegrade is the fill percent of the work items queue from which the dispatcher reports itself as degraded
+const queueDepthPercentToDegrade = 80
+
 type dataDispatcher struct {
 	backOffTime   time.Duration
 	chanWorkItems chan workItems.WorkItemHandler
@@ -83,6 +88,30 @@ func (d *dataDispatcher) Add(item workItems.WorkItemHandler) {
 	d.chanWorkItems <- item
 }
 
+// CheckHealth reports the depth of the work items queue. A full queue blocks the callers of Add
+func (d *dataDispatcher) CheckHealth() core.HealthCheckResult {
+	queueDepth := len(d.chanWorkItems)
+	queueCapacity := cap(d.chanWorkItems)
+	if queueCapacity == 0 {
+		return core.NewHealthCheckResultOK()
+	}
+
+	if queueDepth >= queueCapacity {
+		return core.HealthCheckResult{
+			Status: core.HealthStatusUnavailable,
+			Reason: fmt.Sprintf("indexing queue is full: %d items", queueDepth),
+		}
+	}
+	if queueDepth*100 >= queueCapacity*queueDepthPercentToDegrade {
+		return core.HealthCheckResult{
+			Status: core.HealthStatusDegraded,
+			Reason: fmt.Sprintf("indexing queue is almost full: %d/%d items", queueDepth, queueCapacity),
+		}
+	}
+
+	return core.NewHealthCheckResultOK()
+}
+
 func (d *dataDispatcher) doWork(wi workItems.WorkItemHandler) {
 	for {
 		err := wi.Save()
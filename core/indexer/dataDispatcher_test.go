@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
 	"github.com/ElrondNetwork/elrond-go/core/mock"
 	"github.com/stretchr/testify/require"
@@ -127,3 +128,25 @@ func TestDataDispatcher_AddWithErrorShouldRetryTheReprocessing(t *testing.T) {
 	err = dispatcher.Close()
 	require.NoError(t, err)
 }
+
+func TestDataDispatcher_CheckHealth(t *testing.T) {
+	t.Parallel()
+
+	dispatcher, err := NewDataDispatcher(10)
+	require.NoError(t, err)
+
+	require.Equal(t, core.HealthStatusOK, dispatcher.CheckHealth().Status)
+
+	elasticProc := &mock.ElasticProcessorStub{}
+	for i := 0; i < 8; i++ {
+		dispatcher.Add(workItems.NewItemRounds(elasticProc, []workItems.RoundInfo{}))
+	}
+	require.Equal(t, core.HealthStatusDegraded, dispatcher.CheckHealth().Status)
+
+	for i := 0; i < 2; i++ {
+		dispatcher.Add(workItems.NewItemRounds(elasticProc, []workItems.RoundInfo{}))
+	}
+	result := dispatcher.CheckHealth()
+	require.Equal(t, core.HealthStatusUnavailable, result.Status)
+	require.Equal(t, "indexing queue is full: 10 items", result.Reason)
+}
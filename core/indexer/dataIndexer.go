@@ -1,6 +1,8 @@
 package indexer
 
 import (
+	"io"
+
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
@@ -110,7 +112,17 @@ func (di *dataIndexer) SaveBlock(
 
 // Close will stop goroutine that index data in database
 func (di *dataIndexer) Close() error {
-	return di.dispatcher.Close()
+	err := di.dispatcher.Close()
+	if err != nil {
+		return err
+	}
+
+	processorCloser, ok := di.elasticProcessor.(io.Closer)
+	if !ok {
+		return nil
+	}
+
+	return processorCloser.Close()
 }
 
 // RevertIndexedBlock will remove from database block and miniblocks
@@ -171,6 +183,11 @@ func (di *dataIndexer) SetTxLogsProcessor(txLogsProc process.TransactionLogProce
 	di.elasticProcessor.SetTxLogsProcessor(txLogsProc)
 }
 
+// CheckHealth reports the health of the indexing queue
+func (di *dataIndexer) CheckHealth() core.HealthCheckResult {
+	return di.dispatcher.CheckHealth()
+}
+
 // IsNilIndexer will return a bool value that signals if the indexer's implementation is a NilIndexer
 func (di *dataIndexer) IsNilIndexer() bool {
 	return di.isNilIndexer
@@ -2,6 +2,7 @@ package indexer
 
 import (
 	"bytes"
+	"database/sql"
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data/state"
@@ -39,3 +40,18 @@ type ArgElasticProcessor struct {
 	IsInImportDBMode         bool
 	ShardCoordinator         sharding.Coordinator
 }
+
+//ArgSQLProcessor is struct that is used to store all components that are needed to a SQL indexer
+type ArgSQLProcessor struct {
+	DB                       *sql.DB
+	Marshalizer              marshal.Marshalizer
+	Hasher                   hashing.Hasher
+	AddressPubkeyConverter   core.PubkeyConverter
+	ValidatorPubkeyConverter core.PubkeyConverter
+	EnabledIndexes           map[string]struct{}
+	AccountsDB               state.AccountsAdapter
+	Denomination             int
+	TransactionFeeCalculator process.TransactionFeeCalculator
+	IsInImportDBMode         bool
+	ShardCoordinator         sharding.Coordinator
+}
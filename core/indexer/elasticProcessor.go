@@ -497,29 +497,7 @@ func (ei *elasticProcessor) indexAlteredAccounts(accounts map[string]struct{}) e
 		return nil
 	}
 
-	accountsToIndex := make([]state.UserAccountHandler, 0)
-	for address := range accounts {
-		addressBytes, err := ei.addressPubkeyConverter.Decode(address)
-		if err != nil {
-			log.Warn("cannot decode address", "address", address, "error", err)
-			continue
-		}
-
-		account, err := ei.accountsDB.LoadAccount(addressBytes)
-		if err != nil {
-			log.Warn("cannot load account", "address bytes", addressBytes, "error", err)
-			continue
-		}
-
-		userAccount, ok := account.(state.UserAccountHandler)
-		if !ok {
-			log.Warn("cannot cast AccountHandler to type UserAccountHandler")
-			continue
-		}
-
-		accountsToIndex = append(accountsToIndex, userAccount)
-	}
-
+	accountsToIndex := loadAlteredAccounts(accounts, ei.addressPubkeyConverter, ei.accountsDB)
 	if len(accountsToIndex) == 0 {
 		log.Debug("no account to index from provided transactions")
 		return nil
@@ -601,13 +579,7 @@ func (ei *elasticProcessor) isIndexEnabled(index string) bool {
 }
 
 func (ei *elasticProcessor) computeBalanceAsFloat(balance *big.Int) float64 {
-	balanceBigFloat := big.NewFloat(0).SetInt(balance)
-	balanceFloat64, _ := balanceBigFloat.Float64()
-
-	bal := balanceFloat64 / ei.dividerForDenomination
-	balanceFloatWithDecimals := math.Round(bal*ei.balancePrecision) / ei.balancePrecision
-
-	return core.MaxFloat64(balanceFloatWithDecimals, 0)
+	return computeBalanceAsFloat(balance, ei.dividerForDenomination, ei.balancePrecision)
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
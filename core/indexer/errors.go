@@ -48,3 +48,9 @@ var ErrReadPolicyFile = errors.New("error while reading policy file")
 
 // ErrWriteToBuffer signals that a write error occurred
 var ErrWriteToBuffer = errors.New("error while writing to buffer")
+
+// ErrNilSQLDatabase signals that a nil SQL database handle has been provided
+var ErrNilSQLDatabase = errors.New("nil SQL database")
+
+// ErrUnknownIndexerDriver signals that an unknown indexer driver has been provided
+var ErrUnknownIndexerDriver = errors.New("unknown indexer driver")
@@ -1,6 +1,7 @@
 package factory
 
 import (
+	"database/sql"
 	"fmt"
 	"path"
 
@@ -13,6 +14,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/elastic/go-elasticsearch/v7"
+	// registers the "sqlite3" database/sql driver used by the SQL indexer
+	_ "github.com/mattn/go-sqlite3"
 )
 
 const (
@@ -24,11 +27,14 @@ const (
 // new instances
 type ArgsIndexerFactory struct {
 	Enabled                  bool
+	Driver                   string
 	IndexerCacheSize         int
 	ShardCoordinator         sharding.Coordinator
 	Url                      string
 	UserName                 string
 	Password                 string
+	SQLDriverName            string
+	SQLDataSourceName        string
 	Marshalizer              marshal.Marshalizer
 	Hasher                   hashing.Hasher
 	EpochStartNotifier       sharding.EpochStartEventNotifier
@@ -55,7 +61,7 @@ func NewIndexer(args *ArgsIndexerFactory) (indexer.Indexer, error) {
 		return indexer.NewNilIndexer(), nil
 	}
 
-	elasticProcessor, err := createElasticProcessor(args)
+	elasticProcessor, err := createProcessor(args)
 	if err != nil {
 		return nil, err
 	}
@@ -80,6 +86,29 @@ func NewIndexer(args *ArgsIndexerFactory) (indexer.Indexer, error) {
 	return indexer.NewDataIndexer(arguments)
 }
 
+func createProcessor(args *ArgsIndexerFactory) (indexer.ElasticProcessor, error) {
+	switch args.Driver {
+	case indexer.ElasticDriver, "":
+		return createElasticProcessor(args)
+	case indexer.SQLDriver:
+		return createSQLProcessor(args)
+	default:
+		return nil, fmt.Errorf("%w: %s", indexer.ErrUnknownIndexerDriver, args.Driver)
+	}
+}
+
+func createEnabledIndexesMap(enabledIndexes []string) (map[string]struct{}, error) {
+	enabledIndexesMap := make(map[string]struct{})
+	for _, index := range enabledIndexes {
+		enabledIndexesMap[index] = struct{}{}
+	}
+	if len(enabledIndexesMap) == 0 {
+		return nil, indexer.ErrEmptyEnabledIndexes
+	}
+
+	return enabledIndexesMap, nil
+}
+
 func createDatabaseClient(url, userName, password string) (indexer.DatabaseClientHandler, error) {
 	return indexer.NewElasticClient(elasticsearch.Config{
 		Addresses: []string{url},
@@ -89,6 +118,10 @@ func createDatabaseClient(url, userName, password string) (indexer.DatabaseClien
 }
 
 func createElasticProcessor(args *ArgsIndexerFactory) (indexer.ElasticProcessor, error) {
+	if args.Url == "" {
+		return nil, core.ErrNilUrl
+	}
+
 	databaseClient, err := createDatabaseClient(args.Url, args.UserName, args.Password)
 	if err != nil {
 		return nil, err
@@ -106,12 +139,9 @@ func createElasticProcessor(args *ArgsIndexerFactory) (indexer.ElasticProcessor,
 		return nil, err
 	}
 
-	enabledIndexesMap := make(map[string]struct{})
-	for _, index := range args.EnabledIndexes {
-		enabledIndexesMap[index] = struct{}{}
-	}
-	if len(enabledIndexesMap) == 0 {
-		return nil, indexer.ErrEmptyEnabledIndexes
+	enabledIndexesMap, err := createEnabledIndexesMap(args.EnabledIndexes)
+	if err != nil {
+		return nil, err
 	}
 
 	esIndexerArgs := indexer.ArgElasticProcessor{
@@ -134,6 +164,42 @@ func createElasticProcessor(args *ArgsIndexerFactory) (indexer.ElasticProcessor,
 	return indexer.NewElasticProcessor(esIndexerArgs)
 }
 
+// createSQLProcessor opens the configured SQL database. The "sqlite3" driver is registered by this package, other
+// drivers have to be registered in database/sql by the binary, usually through a blank import of the driver package
+func createSQLProcessor(args *ArgsIndexerFactory) (indexer.ElasticProcessor, error) {
+	enabledIndexesMap, err := createEnabledIndexesMap(args.EnabledIndexes)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := sql.Open(args.SQLDriverName, args.SQLDataSourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	sqlIndexerArgs := indexer.ArgSQLProcessor{
+		DB:                       db,
+		Marshalizer:              args.Marshalizer,
+		Hasher:                   args.Hasher,
+		AddressPubkeyConverter:   args.AddressPubkeyConverter,
+		ValidatorPubkeyConverter: args.ValidatorPubkeyConverter,
+		EnabledIndexes:           enabledIndexesMap,
+		AccountsDB:               args.AccountsDB,
+		Denomination:             args.Denomination,
+		TransactionFeeCalculator: args.TransactionFeeCalculator,
+		IsInImportDBMode:         args.IsInImportDBMode,
+		ShardCoordinator:         args.ShardCoordinator,
+	}
+
+	sqlProcessor, err := indexer.NewSQLProcessor(sqlIndexerArgs)
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	return sqlProcessor, nil
+}
+
 func checkDataIndexerParams(arguments *ArgsIndexerFactory) error {
 	if arguments.IndexerCacheSize < 0 {
 		return indexer.ErrNegativeCacheSize
@@ -144,9 +210,6 @@ func checkDataIndexerParams(arguments *ArgsIndexerFactory) error {
 	if check.IfNil(arguments.ValidatorPubkeyConverter) {
 		return fmt.Errorf("%w when setting ValidatorPubkeyConverter in indexer", indexer.ErrNilPubkeyConverter)
 	}
-	if arguments.Url == "" {
-		return core.ErrNilUrl
-	}
 	if check.IfNil(arguments.Marshalizer) {
 		return core.ErrNilMarshalizer
 	}
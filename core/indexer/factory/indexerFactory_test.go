@@ -2,8 +2,11 @@ package factory
 
 import (
 	"errors"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/core"
@@ -163,6 +166,48 @@ func TestIndexerFactoryCreate_NilIndexer(t *testing.T) {
 	require.True(t, ok)
 }
 
+func TestIndexerFactoryCreate_UnknownDriverShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockIndexerFactoryArgs()
+	args.Driver = "unknown"
+	sqlIndexer, err := NewIndexer(args)
+	require.Nil(t, sqlIndexer)
+	require.True(t, errors.Is(err, indexer.ErrUnknownIndexerDriver))
+}
+
+func TestIndexerFactoryCreate_SQLIndexerUnregisteredDatabaseDriverShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockIndexerFactoryArgs()
+	args.Driver = indexer.SQLDriver
+	args.Url = ""
+	args.SQLDriverName = "unregistered"
+	sqlIndexer, err := NewIndexer(args)
+	require.Nil(t, sqlIndexer)
+	require.NotNil(t, err)
+}
+
+func TestIndexerFactoryCreate_SQLIndexerWithSQLiteDriver(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sqlIndexer")
+	require.NoError(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	args := createMockIndexerFactoryArgs()
+	args.Driver = indexer.SQLDriver
+	args.SQLDriverName = "sqlite3"
+	args.SQLDataSourceName = filepath.Join(dir, "indexer.sqlite")
+
+	sqlIndexer, err := NewIndexer(args)
+	require.NoError(t, err)
+	require.False(t, sqlIndexer.IsNilIndexer())
+
+	err = sqlIndexer.Close()
+	require.NoError(t, err)
+}
+
 func TestIndexerFactoryCreate_ElasticIndexer(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
 	args := createMockIndexerFactoryArgs()
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"math/big"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/data"
@@ -42,6 +43,7 @@ type DispatcherHandler interface {
 	StartIndexData()
 	Close() error
 	Add(item workItems.WorkItemHandler)
+	CheckHealth() core.HealthCheckResult
 	IsInterfaceNil() bool
 }
 
@@ -0,0 +1,529 @@
+package indexer
+
+import (
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+type sqlStatement struct {
+	query string
+	args  []interface{}
+}
+
+type sqlProcessor struct {
+	*txDatabaseProcessor
+
+	db                     *sql.DB
+	parser                 *dataParser
+	enabledIndexes         map[string]struct{}
+	accountsDB             state.AccountsAdapter
+	dividerForDenomination float64
+	balancePrecision       float64
+}
+
+// NewSQLProcessor creates an indexer processor that saves the data in a SQL database. It is an alternative to the
+// elasticsearch processor for the nodes that want to query their own history without operating a cluster.
+func NewSQLProcessor(arguments ArgSQLProcessor) (*sqlProcessor, error) {
+	err := checkArgSQLProcessor(arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	sp := &sqlProcessor{
+		db: arguments.DB,
+		parser: &dataParser{
+			hasher:      arguments.Hasher,
+			marshalizer: arguments.Marshalizer,
+		},
+		enabledIndexes:         arguments.EnabledIndexes,
+		accountsDB:             arguments.AccountsDB,
+		balancePrecision:       math.Pow(10, float64(numDecimalsInFloatBalance)),
+		dividerForDenomination: math.Pow(10, float64(core.MaxInt(arguments.Denomination, 0))),
+	}
+
+	sp.txDatabaseProcessor = newTxDatabaseProcessor(
+		arguments.Hasher,
+		arguments.Marshalizer,
+		arguments.AddressPubkeyConverter,
+		arguments.ValidatorPubkeyConverter,
+		arguments.TransactionFeeCalculator,
+		arguments.IsInImportDBMode,
+		arguments.ShardCoordinator,
+	)
+
+	if arguments.IsInImportDBMode {
+		log.Warn("the node is in import mode! Cross shard transactions and rewards where destination shard is " +
+			"not the current node's shard won't be indexed in the SQL database")
+	}
+
+	err = sp.createTables()
+	if err != nil {
+		return nil, err
+	}
+
+	return sp, nil
+}
+
+func checkArgSQLProcessor(arguments ArgSQLProcessor) error {
+	if arguments.DB == nil {
+		return ErrNilSQLDatabase
+	}
+	if check.IfNil(arguments.Marshalizer) {
+		return core.ErrNilMarshalizer
+	}
+	if check.IfNil(arguments.Hasher) {
+		return core.ErrNilHasher
+	}
+	if check.IfNil(arguments.AddressPubkeyConverter) {
+		return ErrNilPubkeyConverter
+	}
+	if check.IfNil(arguments.ValidatorPubkeyConverter) {
+		return ErrNilPubkeyConverter
+	}
+	if check.IfNil(arguments.AccountsDB) {
+		return ErrNilAccountsDB
+	}
+	if check.IfNil(arguments.ShardCoordinator) {
+		return ErrNilShardCoordinator
+	}
+	if len(arguments.EnabledIndexes) == 0 {
+		return ErrEmptyEnabledIndexes
+	}
+
+	return nil
+}
+
+func (sp *sqlProcessor) createTables() error {
+	statements := make([]sqlStatement, 0, len(sp.enabledIndexes))
+	for index := range sp.enabledIndexes {
+		createTableQuery, ok := sqlCreateTablesStatements[index]
+		if !ok {
+			log.Warn("indexer: unknown index, will not be created in the SQL database", "index", index)
+			continue
+		}
+
+		statements = append(statements, sqlStatement{query: createTableQuery})
+	}
+
+	return sp.execStatements(statements)
+}
+
+// execStatements runs all the statements in a single database transaction
+func (sp *sqlProcessor) execStatements(statements []sqlStatement) error {
+	if len(statements) == 0 {
+		return nil
+	}
+
+	dbTx, err := sp.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	for _, statement := range statements {
+		_, err = dbTx.Exec(statement.query, statement.args...)
+		if err != nil {
+			_ = dbTx.Rollback()
+			return err
+		}
+	}
+
+	return dbTx.Commit()
+}
+
+// SaveHeader will prepare and save information about a header in the SQL database
+func (sp *sqlProcessor) SaveHeader(
+	header data.HeaderHandler,
+	signersIndexes []uint64,
+	body *block.Body,
+	notarizedHeadersHashes []string,
+	txsSize int,
+) error {
+	if !sp.isIndexEnabled(blockIndex) {
+		return nil
+	}
+
+	sqlBlock, _, err := sp.parser.buildBlock(header, signersIndexes, body, notarizedHeadersHashes, txsSize)
+	if err != nil {
+		return err
+	}
+
+	miniBlocksHashes, err := json.Marshal(sqlBlock.MiniBlocksHashes)
+	if err != nil {
+		return err
+	}
+	notarizedBlocksHashes, err := json.Marshal(sqlBlock.NotarizedBlocksHashes)
+	if err != nil {
+		return err
+	}
+	validators, err := json.Marshal(sqlBlock.Validators)
+	if err != nil {
+		return err
+	}
+
+	return sp.execStatements([]sqlStatement{{
+		query: sqlInsertBlock,
+		args: []interface{}{
+			sqlBlock.Hash,
+			sqlBlock.Nonce,
+			sqlBlock.Round,
+			sqlBlock.Epoch,
+			sqlBlock.ShardID,
+			string(miniBlocksHashes),
+			string(notarizedBlocksHashes),
+			sqlBlock.Proposer,
+			string(validators),
+			sqlBlock.PubKeyBitmap,
+			sqlBlock.Size,
+			sqlBlock.SizeTxs,
+			int64(sqlBlock.Timestamp),
+			sqlBlock.StateRootHash,
+			sqlBlock.PrevHash,
+			sqlBlock.TxCount,
+			sqlBlock.SearchOrder,
+		},
+	}})
+}
+
+// RemoveHeader will remove a block from the SQL database
+func (sp *sqlProcessor) RemoveHeader(header data.HeaderHandler) error {
+	if !sp.isIndexEnabled(blockIndex) {
+		return nil
+	}
+
+	headerHash, err := core.CalculateHash(sp.marshalizer, sp.hasher, header)
+	if err != nil {
+		return err
+	}
+
+	return sp.execStatements([]sqlStatement{{
+		query: sqlDeleteBlock,
+		args:  []interface{}{hex.EncodeToString(headerHash)},
+	}})
+}
+
+// RemoveMiniblocks will remove all miniblocks that are in header from the SQL database
+func (sp *sqlProcessor) RemoveMiniblocks(header data.HeaderHandler, body *block.Body) error {
+	if !sp.isIndexEnabled(miniblocksIndex) {
+		return nil
+	}
+	if body == nil || len(header.GetMiniBlockHeadersHashes()) == 0 {
+		return nil
+	}
+
+	statements := make([]sqlStatement, 0)
+	selfShardID := header.GetShardID()
+	for _, miniblock := range body.MiniBlocks {
+		if miniblock.Type == block.PeerBlock {
+			continue
+		}
+
+		isDstMe := selfShardID == miniblock.ReceiverShardID
+		isCrossShard := miniblock.ReceiverShardID != miniblock.SenderShardID
+		if isDstMe && isCrossShard {
+			continue
+		}
+
+		miniblockHash, err := core.CalculateHash(sp.marshalizer, sp.hasher, miniblock)
+		if err != nil {
+			log.Debug("indexer.RemoveMiniblocks cannot calculate miniblock hash",
+				"error", err.Error())
+			continue
+		}
+
+		statements = append(statements, sqlStatement{
+			query: sqlDeleteMiniblock,
+			args:  []interface{}{hex.EncodeToString(miniblockHash)},
+		})
+	}
+
+	return sp.execStatements(statements)
+}
+
+// SetTxLogsProcessor will set tx logs processor
+func (sp *sqlProcessor) SetTxLogsProcessor(txLogsProc process.TransactionLogProcessorDatabase) {
+	sp.txLogsProcessor = txLogsProc
+}
+
+// SaveMiniblocks will prepare and save information about miniblocks in the SQL database. The miniblocks already
+// saved by the other shard are updated in place, so the returned map is always empty.
+func (sp *sqlProcessor) SaveMiniblocks(header data.HeaderHandler, body *block.Body) (map[string]bool, error) {
+	if !sp.isIndexEnabled(miniblocksIndex) {
+		return make(map[string]bool), nil
+	}
+
+	miniblocks := sp.parser.getMiniblocks(header, body)
+	statements := make([]sqlStatement, 0, len(miniblocks))
+	for _, mb := range miniblocks {
+		query := sqlUpdateMiniblockReceiverBlockHash
+		if header.GetShardID() == mb.SenderShardID {
+			query = sqlUpdateMiniblockSenderBlockHash
+		}
+
+		statements = append(statements, sqlStatement{
+			query: query,
+			args: []interface{}{
+				mb.Hash,
+				mb.SenderShardID,
+				mb.ReceiverShardID,
+				mb.SenderBlockHash,
+				mb.ReceiverBlockHash,
+				mb.Type,
+			},
+		})
+	}
+
+	return make(map[string]bool), sp.execStatements(statements)
+}
+
+// SaveTransactions will prepare and save information about transactions in the SQL database
+func (sp *sqlProcessor) SaveTransactions(
+	body *block.Body,
+	header data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+	selfShardID uint32,
+	_ map[string]bool,
+) error {
+	if !sp.isIndexEnabled(txIndex) {
+		return nil
+	}
+
+	txs, alteredAccounts := sp.prepareTransactionsForDatabase(body, header, txPool, selfShardID)
+	statements := make([]sqlStatement, 0, len(txs))
+	for _, tx := range txs {
+		scResults, err := json.Marshal(tx.SmartContractResults)
+		if err != nil {
+			log.Debug("indexer: marshal",
+				"error", "could not serialize smart contract results, will skip indexing",
+				"tx hash", tx.Hash)
+			return err
+		}
+
+		statements = append(statements, sqlStatement{
+			query: getTransactionQuery(tx, selfShardID),
+			args: []interface{}{
+				tx.Hash,
+				tx.MBHash,
+				tx.Nonce,
+				tx.Round,
+				tx.Value,
+				tx.Receiver,
+				tx.Sender,
+				tx.ReceiverShard,
+				tx.SenderShard,
+				tx.GasPrice,
+				tx.GasLimit,
+				tx.GasUsed,
+				tx.Fee,
+				tx.Data,
+				tx.Signature,
+				int64(tx.Timestamp),
+				tx.Status,
+				tx.SearchOrder,
+				string(scResults),
+			},
+		})
+	}
+
+	err := sp.execStatements(statements)
+	if err != nil {
+		log.Warn("indexer indexing transactions", "error", err.Error())
+		return err
+	}
+
+	return sp.indexAlteredAccounts(alteredAccounts)
+}
+
+// getTransactionQuery mirrors the insert or update decisions made for the elasticsearch transactions index
+func getTransactionQuery(tx *Transaction, selfShardID uint32) string {
+	if isIntraShardOrInvalid(tx, selfShardID) {
+		return sqlReplaceTransaction
+	}
+	if !isCrossShardDstMe(tx, selfShardID) {
+		return sqlInsertTransactionIfMissing
+	}
+	if tx.GasUsed == tx.GasLimit && !hasScrWithRefund(tx) && !isRelayedTx(tx) {
+		return sqlUpsertTransactionResults
+	}
+
+	return sqlUpsertTransactionResultsAndFee
+}
+
+// SaveShardStatistics will prepare and save information about a shard statistics in the SQL database
+func (sp *sqlProcessor) SaveShardStatistics(tpsBenchmark statistics.TPSBenchmark) error {
+	if !sp.isIndexEnabled(tpsIndex) {
+		return nil
+	}
+
+	generalInfo, err := json.Marshal(TPS{
+		LiveTPS:               tpsBenchmark.LiveTPS(),
+		PeakTPS:               tpsBenchmark.PeakTPS(),
+		NrOfShards:            tpsBenchmark.NrOfShards(),
+		BlockNumber:           tpsBenchmark.BlockNumber(),
+		RoundNumber:           tpsBenchmark.RoundNumber(),
+		RoundTime:             tpsBenchmark.RoundTime(),
+		AverageBlockTxCount:   tpsBenchmark.AverageBlockTxCount(),
+		LastBlockTxCount:      tpsBenchmark.LastBlockTxCount(),
+		TotalProcessedTxCount: tpsBenchmark.TotalProcessedTxCount(),
+	})
+	if err != nil {
+		log.Debug("indexer: could not serialize tps info, will skip indexing tps this round")
+		return err
+	}
+
+	statements := []sqlStatement{{
+		query: sqlInsertTps,
+		args:  []interface{}{metachainTpsDocID, string(generalInfo)},
+	}}
+	for _, shardInfo := range tpsBenchmark.ShardStatistics() {
+		serializedShardInfo, _ := serializeShardInfo(shardInfo)
+		if serializedShardInfo == nil {
+			continue
+		}
+
+		statements = append(statements, sqlStatement{
+			query: sqlInsertTps,
+			args:  []interface{}{fmt.Sprintf("%s%d", shardTpsDocIDPrefix, shardInfo.ShardID()), string(serializedShardInfo)},
+		})
+	}
+
+	return sp.execStatements(statements)
+}
+
+// SaveValidatorsRating will save validators rating
+func (sp *sqlProcessor) SaveValidatorsRating(index string, validatorsRatingInfo []workItems.ValidatorRatingInfo) error {
+	if !sp.isIndexEnabled(ratingIndex) {
+		return nil
+	}
+
+	statements := make([]sqlStatement, 0, len(validatorsRatingInfo))
+	for _, ratingInfo := range validatorsRatingInfo {
+		statements = append(statements, sqlStatement{
+			query: sqlInsertRating,
+			args:  []interface{}{index, ratingInfo.PublicKey, float64(ratingInfo.Rating)},
+		})
+	}
+
+	return sp.execStatements(statements)
+}
+
+// SaveShardValidatorsPubKeys will prepare and save information about a shard validators public keys in the SQL database
+func (sp *sqlProcessor) SaveShardValidatorsPubKeys(shardID, epoch uint32, shardValidatorsPubKeys [][]byte) error {
+	if !sp.isIndexEnabled(validatorsIndex) {
+		return nil
+	}
+
+	publicKeys := make([]string, 0, len(shardValidatorsPubKeys))
+	for _, validatorPk := range shardValidatorsPubKeys {
+		publicKeys = append(publicKeys, sp.validatorPubkeyConverter.Encode(validatorPk))
+	}
+
+	marshalizedValidatorPubKeys, err := json.Marshal(publicKeys)
+	if err != nil {
+		log.Debug("indexer: marshal", "error", "could not marshal validators public keys")
+		return err
+	}
+
+	return sp.execStatements([]sqlStatement{{
+		query: sqlInsertValidators,
+		args:  []interface{}{shardID, epoch, string(marshalizedValidatorPubKeys)},
+	}})
+}
+
+// SaveRoundsInfo will prepare and save information about a slice of rounds in the SQL database
+func (sp *sqlProcessor) SaveRoundsInfo(infos []workItems.RoundInfo) error {
+	if !sp.isIndexEnabled(roundIndex) {
+		return nil
+	}
+
+	statements := make([]sqlStatement, 0, len(infos))
+	for _, info := range infos {
+		signersIndexes, err := json.Marshal(info.SignersIndexes)
+		if err != nil {
+			log.Warn("indexer: could not serialize round info, will skip indexing this round info",
+				"error", err.Error())
+			continue
+		}
+
+		statements = append(statements, sqlStatement{
+			query: sqlInsertRound,
+			args:  []interface{}{info.ShardId, info.Index, string(signersIndexes), info.BlockWasProposed, int64(info.Timestamp)},
+		})
+	}
+
+	return sp.execStatements(statements)
+}
+
+func (sp *sqlProcessor) indexAlteredAccounts(accounts map[string]struct{}) error {
+	if !sp.isIndexEnabled(accountsIndex) {
+		return nil
+	}
+
+	accountsToIndex := loadAlteredAccounts(accounts, sp.addressPubkeyConverter, sp.accountsDB)
+	if len(accountsToIndex) == 0 {
+		log.Debug("no account to index from provided transactions")
+		return nil
+	}
+
+	return sp.SaveAccounts(accountsToIndex)
+}
+
+// SaveAccounts will prepare and save information about provided accounts in the SQL database
+func (sp *sqlProcessor) SaveAccounts(accounts []state.UserAccountHandler) error {
+	if !sp.isIndexEnabled(accountsIndex) {
+		return nil
+	}
+
+	saveHistory := sp.isIndexEnabled(accountsHistoryIndex)
+	currentTimestamp := time.Now().Unix()
+	statements := make([]sqlStatement, 0, len(accounts))
+	for _, userAccount := range accounts {
+		address := sp.addressPubkeyConverter.Encode(userAccount.AddressBytes())
+		balance := userAccount.GetBalance()
+		statements = append(statements, sqlStatement{
+			query: sqlInsertAccount,
+			args: []interface{}{
+				address,
+				userAccount.GetNonce(),
+				balance.String(),
+				computeBalanceAsFloat(balance, sp.dividerForDenomination, sp.balancePrecision),
+			},
+		})
+
+		if saveHistory {
+			statements = append(statements, sqlStatement{
+				query: sqlInsertAccountHistory,
+				args:  []interface{}{address, currentTimestamp, balance.String()},
+			})
+		}
+	}
+
+	return sp.execStatements(statements)
+}
+
+func (sp *sqlProcessor) isIndexEnabled(index string) bool {
+	_, isEnabled := sp.enabledIndexes[index]
+	return isEnabled
+}
+
+// Close closes the underlying SQL database
+func (sp *sqlProcessor) Close() error {
+	return sp.db.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sp *sqlProcessor) IsInterfaceNil() bool {
+	return sp == nil
+}
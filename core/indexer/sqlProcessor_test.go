@@ -0,0 +1,381 @@
+package indexer
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	dataBlock "github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon/economicsmocks"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const recordingDriverName = "indexerRecordingDriver"
+
+// recordingDriver is a database/sql driver that stores the executed statements, one recorder per data source name
+type recordingDriver struct {
+	mut       sync.Mutex
+	recorders map[string]*statementsRecorder
+}
+
+type statementsRecorder struct {
+	mut        sync.Mutex
+	statements []sqlStatement
+	failQuery  string
+	commits    int
+	rollbacks  int
+}
+
+var testRecordingDriver = &recordingDriver{recorders: make(map[string]*statementsRecorder)}
+
+func init() {
+	sql.Register(recordingDriverName, testRecordingDriver)
+}
+
+func (rd *recordingDriver) Open(name string) (driver.Conn, error) {
+	rd.mut.Lock()
+	defer rd.mut.Unlock()
+
+	return &recordingConn{recorder: rd.recorders[name]}, nil
+}
+
+type recordingConn struct {
+	recorder *statementsRecorder
+}
+
+func (rc *recordingConn) Prepare(query string) (driver.Stmt, error) {
+	return &recordingStmt{recorder: rc.recorder, query: query}, nil
+}
+
+func (rc *recordingConn) Close() error {
+	return nil
+}
+
+func (rc *recordingConn) Begin() (driver.Tx, error) {
+	return &recordingTx{recorder: rc.recorder}, nil
+}
+
+type recordingTx struct {
+	recorder *statementsRecorder
+}
+
+func (rt *recordingTx) Commit() error {
+	rt.recorder.mut.Lock()
+	rt.recorder.commits++
+	rt.recorder.mut.Unlock()
+
+	return nil
+}
+
+func (rt *recordingTx) Rollback() error {
+	rt.recorder.mut.Lock()
+	rt.recorder.rollbacks++
+	rt.recorder.mut.Unlock()
+
+	return nil
+}
+
+type recordingStmt struct {
+	recorder *statementsRecorder
+	query    string
+}
+
+func (rs *recordingStmt) Close() error {
+	return nil
+}
+
+func (rs *recordingStmt) NumInput() int {
+	return -1
+}
+
+func (rs *recordingStmt) Exec(args []driver.Value) (driver.Result, error) {
+	rs.recorder.mut.Lock()
+	defer rs.recorder.mut.Unlock()
+
+	if rs.recorder.failQuery != "" && strings.HasPrefix(rs.query, rs.recorder.failQuery) {
+		return nil, errors.New("exec error")
+	}
+
+	statementArgs := make([]interface{}, 0, len(args))
+	for _, arg := range args {
+		statementArgs = append(statementArgs, arg)
+	}
+	rs.recorder.statements = append(rs.recorder.statements, sqlStatement{query: rs.query, args: statementArgs})
+
+	return driver.RowsAffected(1), nil
+}
+
+func (rs *recordingStmt) Query(_ []driver.Value) (driver.Rows, error) {
+	return nil, errors.New("not supported")
+}
+
+func (sr *statementsRecorder) getStatements(queryPrefix string) []sqlStatement {
+	sr.mut.Lock()
+	defer sr.mut.Unlock()
+
+	statements := make([]sqlStatement, 0)
+	for _, statement := range sr.statements {
+		if strings.HasPrefix(statement.query, queryPrefix) {
+			statements = append(statements, statement)
+		}
+	}
+
+	return statements
+}
+
+func openRecordingDB(t *testing.T) (*sql.DB, *statementsRecorder) {
+	recorder := &statementsRecorder{}
+	testRecordingDriver.mut.Lock()
+	testRecordingDriver.recorders[t.Name()] = recorder
+	testRecordingDriver.mut.Unlock()
+
+	db, err := sql.Open(recordingDriverName, t.Name())
+	require.Nil(t, err)
+
+	return db, recorder
+}
+
+func createMockSQLProcessorArgs(db *sql.DB) ArgSQLProcessor {
+	return ArgSQLProcessor{
+		DB:                       db,
+		AddressPubkeyConverter:   mock.NewPubkeyConverterMock(32),
+		ValidatorPubkeyConverter: mock.NewPubkeyConverterMock(32),
+		Hasher:                   &mock.HasherMock{},
+		Marshalizer:              &mock.MarshalizerMock{},
+		EnabledIndexes: map[string]struct{}{
+			blockIndex: {}, txIndex: {}, miniblocksIndex: {}, tpsIndex: {}, validatorsIndex: {}, roundIndex: {}, accountsIndex: {}, ratingIndex: {}, accountsHistoryIndex: {},
+		},
+		AccountsDB:               &mock.AccountsStub{},
+		TransactionFeeCalculator: &economicsmocks.EconomicsHandlerStub{},
+		ShardCoordinator:         &mock.ShardCoordinatorMock{},
+	}
+}
+
+func TestNewSQLProcessor_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	db, _ := openRecordingDB(t)
+
+	args := createMockSQLProcessorArgs(nil)
+	sp, err := NewSQLProcessor(args)
+	assert.Nil(t, sp)
+	assert.Equal(t, ErrNilSQLDatabase, err)
+
+	args = createMockSQLProcessorArgs(db)
+	args.AccountsDB = nil
+	sp, err = NewSQLProcessor(args)
+	assert.Nil(t, sp)
+	assert.Equal(t, ErrNilAccountsDB, err)
+
+	args = createMockSQLProcessorArgs(db)
+	args.EnabledIndexes = nil
+	sp, err = NewSQLProcessor(args)
+	assert.Nil(t, sp)
+	assert.Equal(t, ErrEmptyEnabledIndexes, err)
+}
+
+func TestNewSQLProcessor_ShouldCreateTablesOfEnabledIndexes(t *testing.T) {
+	t.Parallel()
+
+	db, recorder := openRecordingDB(t)
+	args := createMockSQLProcessorArgs(db)
+	args.EnabledIndexes = map[string]struct{}{blockIndex: {}, roundIndex: {}, "unknown": {}}
+
+	sp, err := NewSQLProcessor(args)
+	require.Nil(t, err)
+	assert.False(t, sp.IsInterfaceNil())
+
+	statements := recorder.getStatements("CREATE TABLE")
+	require.Equal(t, 2, len(statements))
+	assert.Equal(t, 1, recorder.commits)
+}
+
+func TestSQLProcessor_SaveHeaderAndMiniblocks(t *testing.T) {
+	t.Parallel()
+
+	db, recorder := openRecordingDB(t)
+	sp, _ := NewSQLProcessor(createMockSQLProcessorArgs(db))
+
+	header := &dataBlock.Header{Nonce: 10, ShardID: 0}
+	body := &dataBlock.Body{
+		MiniBlocks: []*dataBlock.MiniBlock{
+			{SenderShardID: 0, ReceiverShardID: 1},
+			{SenderShardID: 1, ReceiverShardID: 0},
+		},
+	}
+
+	err := sp.SaveHeader(header, []uint64{3, 4}, body, nil, 0)
+	require.Nil(t, err)
+	blocks := recorder.getStatements("INSERT OR REPLACE INTO " + blockIndex)
+	require.Equal(t, 1, len(blocks))
+	assert.Equal(t, int64(10), blocks[0].args[1])
+	assert.Equal(t, int64(3), blocks[0].args[7])
+	assert.Equal(t, "[3,4]", blocks[0].args[8])
+
+	mbsInDb, err := sp.SaveMiniblocks(header, body)
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(mbsInDb))
+	miniblocks := recorder.getStatements("INSERT INTO " + miniblocksIndex)
+	require.Equal(t, 2, len(miniblocks))
+	assert.Equal(t, sqlUpdateMiniblockSenderBlockHash, miniblocks[0].query)
+	assert.Equal(t, sqlUpdateMiniblockReceiverBlockHash, miniblocks[1].query)
+}
+
+func TestSQLProcessor_SaveTransactions(t *testing.T) {
+	t.Parallel()
+
+	db, recorder := openRecordingDB(t)
+	args := createMockSQLProcessorArgs(db)
+	args.EnabledIndexes = map[string]struct{}{txIndex: {}}
+	sp, _ := NewSQLProcessor(args)
+
+	header := &dataBlock.Header{Nonce: 10, ShardID: 2}
+	body := newTestBlockBody()
+	body.MiniBlocks[1].ReceiverShardID = header.ShardID
+	err := sp.SaveTransactions(body, header, newTestTxPool(), header.ShardID, nil)
+	require.Nil(t, err)
+
+	assert.Equal(t, 2, len(recorder.getStatements(sqlReplaceTransaction)))
+	assert.Equal(t, 1, len(recorder.getStatements(sqlUpsertTransactionResults)))
+}
+
+func TestSQLProcessor_SaveRoundsInfoAndRating(t *testing.T) {
+	t.Parallel()
+
+	db, recorder := openRecordingDB(t)
+	sp, _ := NewSQLProcessor(createMockSQLProcessorArgs(db))
+
+	err := sp.SaveRoundsInfo([]workItems.RoundInfo{{Index: 7, ShardId: 1, SignersIndexes: []uint64{0, 1}, BlockWasProposed: true}})
+	require.Nil(t, err)
+	rounds := recorder.getStatements(sqlInsertRound)
+	require.Equal(t, 1, len(rounds))
+	assert.Equal(t, []interface{}{int64(1), int64(7), "[0,1]", true, int64(0)}, rounds[0].args)
+
+	err = sp.SaveValidatorsRating("0_1", []workItems.ValidatorRatingInfo{{PublicKey: "pk", Rating: 50}})
+	require.Nil(t, err)
+	ratings := recorder.getStatements(sqlInsertRating)
+	require.Equal(t, 1, len(ratings))
+	assert.Equal(t, []interface{}{"0_1", "pk", float64(50)}, ratings[0].args)
+}
+
+func TestSQLProcessor_ExecErrorShouldRollback(t *testing.T) {
+	t.Parallel()
+
+	db, recorder := openRecordingDB(t)
+	sp, _ := NewSQLProcessor(createMockSQLProcessorArgs(db))
+	recorder.failQuery = sqlInsertRound
+
+	err := sp.SaveRoundsInfo([]workItems.RoundInfo{{Index: 7}})
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, recorder.rollbacks)
+}
+
+func TestSQLProcessor_DisabledIndexShouldNotSave(t *testing.T) {
+	t.Parallel()
+
+	db, recorder := openRecordingDB(t)
+	args := createMockSQLProcessorArgs(db)
+	args.EnabledIndexes = map[string]struct{}{blockIndex: {}}
+	sp, _ := NewSQLProcessor(args)
+
+	err := sp.SaveRoundsInfo([]workItems.RoundInfo{{Index: 7}})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(recorder.getStatements(sqlInsertRound)))
+}
+
+func TestSQLProcessor_DisabledIndexShouldNotRemove(t *testing.T) {
+	t.Parallel()
+
+	db, recorder := openRecordingDB(t)
+	args := createMockSQLProcessorArgs(db)
+	args.EnabledIndexes = map[string]struct{}{roundIndex: {}}
+	sp, _ := NewSQLProcessor(args)
+
+	header := &dataBlock.Header{Nonce: 10, MiniBlockHeaders: []dataBlock.MiniBlockHeader{{Hash: []byte("mb")}}}
+	err := sp.RemoveHeader(header)
+	assert.Nil(t, err)
+	err = sp.RemoveMiniblocks(header, newTestBlockBody())
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(recorder.getStatements("DELETE")))
+}
+
+func countRows(t *testing.T, db *sql.DB, table string) int {
+	numRows := 0
+	err := db.QueryRow("SELECT COUNT(*) FROM " + table).Scan(&numRows)
+	require.Nil(t, err)
+
+	return numRows
+}
+
+func TestSQLProcessor_SaveAndRemoveWithSQLiteDriver(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "sqlIndexer")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	db, err := sql.Open("sqlite3", filepath.Join(dir, "indexer.sqlite"))
+	require.Nil(t, err)
+	sp, err := NewSQLProcessor(createMockSQLProcessorArgs(db))
+	require.Nil(t, err)
+	defer func() {
+		_ = sp.Close()
+	}()
+
+	// creating the processor again on an existing database should not fail
+	_, err = NewSQLProcessor(createMockSQLProcessorArgs(db))
+	require.Nil(t, err)
+
+	header := &dataBlock.Header{
+		Nonce:            10,
+		ShardID:          2,
+		MiniBlockHeaders: []dataBlock.MiniBlockHeader{{Hash: []byte("mb")}},
+	}
+	body := newTestBlockBody()
+	require.Nil(t, sp.SaveHeader(header, []uint64{3, 4}, body, nil, 0))
+	_, err = sp.SaveMiniblocks(header, body)
+	require.Nil(t, err)
+	// saving the miniblocks again updates the existing rows
+	_, err = sp.SaveMiniblocks(header, body)
+	require.Nil(t, err)
+	require.Nil(t, sp.SaveTransactions(body, header, newTestTxPool(), header.ShardID, nil))
+	require.Nil(t, sp.SaveRoundsInfo([]workItems.RoundInfo{{Index: 7, ShardId: 2, SignersIndexes: []uint64{0, 1}}}))
+	require.Nil(t, sp.SaveValidatorsRating("2_1", []workItems.ValidatorRatingInfo{{PublicKey: "pk", Rating: 50}}))
+	require.Nil(t, sp.SaveShardValidatorsPubKeys(2, 1, [][]byte{[]byte("pk1"), []byte("pk2")}))
+	account, _ := state.NewUserAccount([]byte("address"))
+	_ = account.AddToBalance(big.NewInt(1000))
+	require.Nil(t, sp.SaveAccounts([]state.UserAccountHandler{account}))
+
+	assert.Equal(t, 1, countRows(t, db, blockIndex))
+	assert.Equal(t, 2, countRows(t, db, miniblocksIndex))
+	assert.Equal(t, 3, countRows(t, db, txIndex))
+	assert.Equal(t, 1, countRows(t, db, roundIndex))
+	assert.Equal(t, 1, countRows(t, db, ratingIndex))
+	assert.Equal(t, 1, countRows(t, db, validatorsIndex))
+	assert.Equal(t, 1, countRows(t, db, accountsIndex))
+	assert.Equal(t, 1, countRows(t, db, accountsHistoryIndex))
+
+	balance := ""
+	err = db.QueryRow("SELECT balance FROM " + accountsIndex).Scan(&balance)
+	require.Nil(t, err)
+	assert.Equal(t, "1000", balance)
+
+	require.Nil(t, sp.RemoveHeader(header))
+	require.Nil(t, sp.RemoveMiniblocks(header, body))
+	assert.Equal(t, 0, countRows(t, db, blockIndex))
+	assert.Equal(t, 0, countRows(t, db, miniblocksIndex))
+}
@@ -0,0 +1,137 @@
+package indexer
+
+// the SQL statements are written for the SQLite dialect (upsert clauses require SQLite 3.24 or newer) and each table
+// is named after the elasticsearch index it replaces so that the same enabled indexes configuration applies
+
+var sqlCreateTablesStatements = map[string]string{
+	blockIndex: `CREATE TABLE IF NOT EXISTS ` + blockIndex + ` (
+	hash TEXT PRIMARY KEY,
+	nonce INTEGER NOT NULL,
+	round INTEGER NOT NULL,
+	epoch INTEGER NOT NULL,
+	shard_id INTEGER NOT NULL,
+	mini_blocks_hashes TEXT,
+	notarized_blocks_hashes TEXT,
+	proposer INTEGER,
+	validators TEXT,
+	pub_key_bitmap TEXT,
+	size INTEGER,
+	size_txs INTEGER,
+	timestamp INTEGER,
+	state_root_hash TEXT,
+	prev_hash TEXT,
+	tx_count INTEGER,
+	search_order INTEGER
+)`,
+	miniblocksIndex: `CREATE TABLE IF NOT EXISTS ` + miniblocksIndex + ` (
+	hash TEXT PRIMARY KEY,
+	sender_shard INTEGER NOT NULL,
+	receiver_shard INTEGER NOT NULL,
+	sender_block_hash TEXT,
+	receiver_block_hash TEXT,
+	type TEXT
+)`,
+	txIndex: `CREATE TABLE IF NOT EXISTS ` + txIndex + ` (
+	hash TEXT PRIMARY KEY,
+	mini_block_hash TEXT,
+	nonce INTEGER,
+	round INTEGER,
+	value TEXT,
+	receiver TEXT,
+	sender TEXT,
+	receiver_shard INTEGER,
+	sender_shard INTEGER,
+	gas_price INTEGER,
+	gas_limit INTEGER,
+	gas_used INTEGER,
+	fee TEXT,
+	data BLOB,
+	signature TEXT,
+	timestamp INTEGER,
+	status TEXT,
+	search_order INTEGER,
+	sc_results TEXT
+)`,
+	tpsIndex: `CREATE TABLE IF NOT EXISTS ` + tpsIndex + ` (
+	id TEXT PRIMARY KEY,
+	document TEXT
+)`,
+	validatorsIndex: `CREATE TABLE IF NOT EXISTS ` + validatorsIndex + ` (
+	shard_id INTEGER NOT NULL,
+	epoch INTEGER NOT NULL,
+	public_keys TEXT,
+	PRIMARY KEY (shard_id, epoch)
+)`,
+	roundIndex: `CREATE TABLE IF NOT EXISTS ` + roundIndex + ` (
+	shard_id INTEGER NOT NULL,
+	round INTEGER NOT NULL,
+	signers_indexes TEXT,
+	block_was_proposed INTEGER,
+	timestamp INTEGER,
+	PRIMARY KEY (shard_id, round)
+)`,
+	ratingIndex: `CREATE TABLE IF NOT EXISTS ` + ratingIndex + ` (
+	id TEXT NOT NULL,
+	public_key TEXT NOT NULL,
+	rating REAL,
+	PRIMARY KEY (id, public_key)
+)`,
+	accountsIndex: `CREATE TABLE IF NOT EXISTS ` + accountsIndex + ` (
+	address TEXT PRIMARY KEY,
+	nonce INTEGER,
+	balance TEXT,
+	balance_num REAL
+)`,
+	accountsHistoryIndex: `CREATE TABLE IF NOT EXISTS ` + accountsHistoryIndex + ` (
+	address TEXT NOT NULL,
+	timestamp INTEGER NOT NULL,
+	balance TEXT,
+	PRIMARY KEY (address, timestamp)
+)`,
+}
+
+const sqlInsertBlock = `INSERT OR REPLACE INTO ` + blockIndex + ` (hash, nonce, round, epoch, shard_id, ` +
+	`mini_blocks_hashes, notarized_blocks_hashes, proposer, validators, pub_key_bitmap, size, size_txs, timestamp, ` +
+	`state_root_hash, prev_hash, tx_count, search_order) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+const sqlDeleteBlock = `DELETE FROM ` + blockIndex + ` WHERE hash = ?`
+
+const sqlInsertMiniblock = `INSERT INTO ` + miniblocksIndex + ` (hash, sender_shard, receiver_shard, ` +
+	`sender_block_hash, receiver_block_hash, type) VALUES (?, ?, ?, ?, ?, ?) ON CONFLICT(hash) DO UPDATE SET `
+
+// the miniblock already saved by the other shard only gets its own block hash updated
+const sqlUpdateMiniblockSenderBlockHash = sqlInsertMiniblock + `sender_block_hash = excluded.sender_block_hash`
+const sqlUpdateMiniblockReceiverBlockHash = sqlInsertMiniblock + `receiver_block_hash = excluded.receiver_block_hash`
+
+const sqlDeleteMiniblock = `DELETE FROM ` + miniblocksIndex + ` WHERE hash = ?`
+
+const sqlInsertTransactionPrefix = `INTO ` + txIndex + ` (hash, mini_block_hash, nonce, round, value, receiver, ` +
+	`sender, receiver_shard, sender_shard, gas_price, gas_limit, gas_used, fee, data, signature, timestamp, status, ` +
+	`search_order, sc_results) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+// intra-shard transactions are rewritten entirely as the data can change at forks
+const sqlReplaceTransaction = `INSERT OR REPLACE ` + sqlInsertTransactionPrefix
+
+// cross-shard transactions seen on the source shard do not overwrite the data saved by the destination shard
+const sqlInsertTransactionIfMissing = `INSERT ` + sqlInsertTransactionPrefix + ` ON CONFLICT(hash) DO NOTHING`
+
+// cross-shard transactions seen on the destination shard update the execution results
+const sqlUpsertTransactionResults = `INSERT ` + sqlInsertTransactionPrefix + ` ON CONFLICT(hash) DO UPDATE SET ` +
+	`status = excluded.status, mini_block_hash = excluded.mini_block_hash, sc_results = excluded.sc_results, ` +
+	`timestamp = excluded.timestamp`
+
+const sqlUpsertTransactionResultsAndFee = sqlUpsertTransactionResults +
+	`, gas_used = excluded.gas_used, fee = excluded.fee`
+
+const sqlInsertTps = `INSERT OR REPLACE INTO ` + tpsIndex + ` (id, document) VALUES (?, ?)`
+
+const sqlInsertValidators = `INSERT OR REPLACE INTO ` + validatorsIndex + ` (shard_id, epoch, public_keys) VALUES (?, ?, ?)`
+
+const sqlInsertRound = `INSERT OR REPLACE INTO ` + roundIndex + ` (shard_id, round, signers_indexes, ` +
+	`block_was_proposed, timestamp) VALUES (?, ?, ?, ?, ?)`
+
+const sqlInsertRating = `INSERT OR REPLACE INTO ` + ratingIndex + ` (id, public_key, rating) VALUES (?, ?, ?)`
+
+const sqlInsertAccount = `INSERT OR REPLACE INTO ` + accountsIndex + ` (address, nonce, balance, balance_num) VALUES (?, ?, ?, ?)`
+
+const sqlInsertAccountHistory = `INSERT OR REPLACE INTO ` + accountsHistoryIndex + ` (address, timestamp, balance) VALUES (?, ?, ?)`
@@ -11,6 +11,8 @@ type AppStatusHandler interface {
 	SetInt64Value(key string, value int64)
 	SetUInt64Value(key string, value uint64)
 	SetStringValue(key string, value string)
+	AddToCounter(key string, value uint64, labels ...MetricLabel)
+	ObserveDuration(key string, duration time.Duration, labels ...MetricLabel)
 	Close()
 }
 
@@ -0,0 +1,30 @@
+package keystore
+
+import "errors"
+
+// ErrMissingPassword signals that the password needed to decrypt a keystore was not provided
+var ErrMissingPassword = errors.New("missing keystore password")
+
+// ErrEmptyPassword signals that an empty password was provided
+var ErrEmptyPassword = errors.New("empty keystore password")
+
+// ErrInvalidPassword signals that the keystore could not be decrypted with the provided password
+var ErrInvalidPassword = errors.New("invalid keystore password or corrupted keystore")
+
+// ErrInvalidKeystore signals that the provided content is not a valid keystore
+var ErrInvalidKeystore = errors.New("invalid keystore")
+
+// ErrUnknownKdf signals that an unknown key derivation function was requested
+var ErrUnknownKdf = errors.New("unknown key derivation function")
+
+// ErrUnknownCipher signals that an unknown cipher was found in the keystore
+var ErrUnknownCipher = errors.New("unknown cipher")
+
+// ErrUnsupportedVersion signals that the keystore version is not supported
+var ErrUnsupportedVersion = errors.New("unsupported keystore version")
+
+// ErrInvalidKdfParams signals that the key derivation function parameters are invalid
+var ErrInvalidKdfParams = errors.New("invalid key derivation function parameters")
+
+// ErrAlreadyEncrypted signals that the provided content is already an encrypted keystore
+var ErrAlreadyEncrypted = errors.New("content is already an encrypted keystore")
@@ -0,0 +1,266 @@
+package keystore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// ScryptKdf is the name of the scrypt key derivation function
+	ScryptKdf = "scrypt"
+	// Argon2idKdf is the name of the argon2id key derivation function
+	Argon2idKdf = "argon2id"
+
+	currentVersion = 1
+	aesGcmCipher   = "aes-256-gcm"
+	derivedKeyLen  = 32
+	saltLen        = 32
+
+	defaultScryptN       = 1 << 18
+	defaultScryptR       = 8
+	defaultScryptP       = 1
+	defaultArgon2Time    = 3
+	defaultArgon2Memory  = 64 * 1024
+	defaultArgon2Threads = 4
+
+	// the maximum values accepted from a key file, so that a crafted file can not make the node allocate huge
+	// amounts of memory or spend a very long time deriving the key
+	maxScryptN           = 1 << 20
+	maxScryptR           = 32
+	maxScryptP           = 16
+	maxScryptMemoryBytes = 1 << 30
+	maxArgon2Time        = 16
+	maxArgon2MemoryInKiB = 1 << 20
+)
+
+// KdfParams holds the key derivation function used to obtain the encryption key from the password, along with its
+// parameters
+type KdfParams struct {
+	Name    string `json:"name"`
+	Salt    string `json:"salt"`
+	KeyLen  uint32 `json:"dklen"`
+	N       int    `json:"n,omitempty"`
+	R       int    `json:"r,omitempty"`
+	P       int    `json:"p,omitempty"`
+	Time    uint32 `json:"time,omitempty"`
+	Memory  uint32 `json:"memory,omitempty"`
+	Threads uint8  `json:"threads,omitempty"`
+}
+
+// CryptoParams holds the encrypted content along with the parameters needed to decrypt it
+type CryptoParams struct {
+	Cipher     string    `json:"cipher"`
+	Nonce      string    `json:"nonce"`
+	CipherText string    `json:"ciphertext"`
+	Kdf        KdfParams `json:"kdf"`
+}
+
+// Keystore is the serializable form of a password encrypted key file
+type Keystore struct {
+	Version int          `json:"version"`
+	Crypto  CryptoParams `json:"crypto"`
+}
+
+// NewKdfParams returns the default parameters of the key derivation function with the provided name
+func NewKdfParams(name string) (KdfParams, error) {
+	switch name {
+	case ScryptKdf:
+		return KdfParams{
+			Name:   ScryptKdf,
+			KeyLen: derivedKeyLen,
+			N:      defaultScryptN,
+			R:      defaultScryptR,
+			P:      defaultScryptP,
+		}, nil
+	case Argon2idKdf:
+		return KdfParams{
+			Name:    Argon2idKdf,
+			KeyLen:  derivedKeyLen,
+			Time:    defaultArgon2Time,
+			Memory:  defaultArgon2Memory,
+			Threads: defaultArgon2Threads,
+		}, nil
+	default:
+		return KdfParams{}, fmt.Errorf("%w: %s", ErrUnknownKdf, name)
+	}
+}
+
+// IsKeystore returns true if the provided content is a serialized keystore
+func IsKeystore(buff []byte) bool {
+	ks := &Keystore{}
+	err := json.Unmarshal(buff, ks)
+
+	return err == nil && ks.Version > 0 && len(ks.Crypto.CipherText) > 0
+}
+
+// Encrypt encrypts the provided content with a key derived from the password, using a fresh salt, and returns the
+// serialized keystore
+func Encrypt(plainText []byte, password []byte, kdfParams KdfParams) ([]byte, error) {
+	if len(password) == 0 {
+		return nil, ErrEmptyPassword
+	}
+	if IsKeystore(plainText) {
+		return nil, ErrAlreadyEncrypted
+	}
+
+	salt, err := randomBytes(saltLen)
+	if err != nil {
+		return nil, err
+	}
+	kdfParams.Salt = hex.EncodeToString(salt)
+
+	derivedKey, err := deriveKey(password, kdfParams)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := createAead(derivedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := randomBytes(aead.NonceSize())
+	if err != nil {
+		return nil, err
+	}
+
+	ks := &Keystore{
+		Version: currentVersion,
+		Crypto: CryptoParams{
+			Cipher:     aesGcmCipher,
+			Nonce:      hex.EncodeToString(nonce),
+			CipherText: hex.EncodeToString(aead.Seal(nil, nonce, plainText, nil)),
+			Kdf:        kdfParams,
+		},
+	}
+
+	return json.MarshalIndent(ks, "", "  ")
+}
+
+// Decrypt decrypts the serialized keystore using the provided password
+func Decrypt(buff []byte, password []byte) ([]byte, error) {
+	if len(password) == 0 {
+		return nil, ErrMissingPassword
+	}
+
+	ks := &Keystore{}
+	err := json.Unmarshal(buff, ks)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidKeystore, err.Error())
+	}
+	if ks.Version != currentVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, ks.Version)
+	}
+	if ks.Crypto.Cipher != aesGcmCipher {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownCipher, ks.Crypto.Cipher)
+	}
+
+	nonce, err := hex.DecodeString(ks.Crypto.Nonce)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s for nonce", ErrInvalidKeystore, err.Error())
+	}
+	cipherText, err := hex.DecodeString(ks.Crypto.CipherText)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s for cipher text", ErrInvalidKeystore, err.Error())
+	}
+
+	derivedKey, err := deriveKey(password, ks.Crypto.Kdf)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := createAead(derivedKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != aead.NonceSize() {
+		return nil, fmt.Errorf("%w: invalid nonce length", ErrInvalidKeystore)
+	}
+
+	plainText, err := aead.Open(nil, nonce, cipherText, nil)
+	if err != nil {
+		return nil, ErrInvalidPassword
+	}
+
+	return plainText, nil
+}
+
+// ChangePassword re-encrypts the serialized keystore with a new password, keeping its key derivation function
+func ChangePassword(buff []byte, oldPassword []byte, newPassword []byte) ([]byte, error) {
+	ks := &Keystore{}
+	err := json.Unmarshal(buff, ks)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidKeystore, err.Error())
+	}
+
+	plainText, err := Decrypt(buff, oldPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	return Encrypt(plainText, newPassword, ks.Crypto.Kdf)
+}
+
+func deriveKey(password []byte, kdfParams KdfParams) ([]byte, error) {
+	salt, err := hex.DecodeString(kdfParams.Salt)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s for salt", ErrInvalidKdfParams, err.Error())
+	}
+	if len(salt) < saltLen/2 {
+		return nil, fmt.Errorf("%w: salt too short", ErrInvalidKdfParams)
+	}
+	if kdfParams.KeyLen != derivedKeyLen {
+		return nil, fmt.Errorf("%w: derived key length should be %d", ErrInvalidKdfParams, derivedKeyLen)
+	}
+
+	switch kdfParams.Name {
+	case ScryptKdf:
+		isPowerOfTwo := kdfParams.N > 1 && kdfParams.N&(kdfParams.N-1) == 0
+		if !isPowerOfTwo || kdfParams.R <= 0 || kdfParams.P <= 0 {
+			return nil, fmt.Errorf("%w for %s", ErrInvalidKdfParams, ScryptKdf)
+		}
+		isCostTooHigh := kdfParams.N > maxScryptN || kdfParams.R > maxScryptR || kdfParams.P > maxScryptP
+		if isCostTooHigh || 128*int64(kdfParams.N)*int64(kdfParams.R) > maxScryptMemoryBytes {
+			return nil, fmt.Errorf("%w: %s cost parameters too high", ErrInvalidKdfParams, ScryptKdf)
+		}
+
+		return scrypt.Key(password, salt, kdfParams.N, kdfParams.R, kdfParams.P, int(kdfParams.KeyLen))
+	case Argon2idKdf:
+		if kdfParams.Time == 0 || kdfParams.Memory == 0 || kdfParams.Threads == 0 {
+			return nil, fmt.Errorf("%w for %s", ErrInvalidKdfParams, Argon2idKdf)
+		}
+		if kdfParams.Time > maxArgon2Time || kdfParams.Memory > maxArgon2MemoryInKiB {
+			return nil, fmt.Errorf("%w: %s cost parameters too high", ErrInvalidKdfParams, Argon2idKdf)
+		}
+
+		return argon2.IDKey(password, salt, kdfParams.Time, kdfParams.Memory, kdfParams.Threads, kdfParams.KeyLen), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKdf, kdfParams.Name)
+	}
+}
+
+func createAead(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func randomBytes(length int) ([]byte, error) {
+	buff := make([]byte, length)
+	_, err := rand.Read(buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return buff, nil
+}
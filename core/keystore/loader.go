@@ -0,0 +1,100 @@
+package keystore
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+var log = logger.GetOrCreate("core/keystore")
+
+// ReadPassword returns the password stored in the provided file or, if no file is provided, the password held by
+// the provided environment variable. It returns nil if none of them is set
+func ReadPassword(passwordFile string, envVarName string) ([]byte, error) {
+	if len(passwordFile) > 0 {
+		buff, err := ioutil.ReadFile(passwordFile)
+		if err != nil {
+			return nil, fmt.Errorf("%w while reading the password file", err)
+		}
+
+		password := bytes.TrimRight(buff, "\r\n")
+		if len(password) == 0 {
+			return nil, fmt.Errorf("%w in file %s", ErrEmptyPassword, passwordFile)
+		}
+
+		return password, nil
+	}
+
+	if len(envVarName) == 0 {
+		return nil, nil
+	}
+
+	password, isSet := os.LookupEnv(envVarName)
+	if !isSet {
+		return nil, nil
+	}
+	if len(password) == 0 {
+		return nil, fmt.Errorf("%w in environment variable %s", ErrEmptyPassword, envVarName)
+	}
+
+	return []byte(password), nil
+}
+
+// ReadPemContent reads the provided key file, decrypting it with the provided password if it is a keystore, and
+// returns the PEM content
+func ReadPemContent(fileName string, password []byte) ([]byte, error) {
+	buff, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	if !IsKeystore(buff) {
+		log.Warn("the key file is not encrypted, consider converting it into a keystore", "file", fileName)
+		return buff, nil
+	}
+
+	pemContent, err := Decrypt(buff, password)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decrypting %s file", err, fileName)
+	}
+
+	return pemContent, nil
+}
+
+// LoadSkPkFromFile loads the secret key and existing public key bytes stored in the provided PEM or keystore file
+func LoadSkPkFromFile(fileName string, skIndex int, password []byte) ([]byte, string, error) {
+	if skIndex < 0 {
+		return nil, "", core.ErrInvalidIndex
+	}
+
+	pemContent, err := ReadPemContent(fileName, password)
+	if err != nil {
+		return nil, "", err
+	}
+
+	skBytes, pkString, err := core.LoadSkPkFromPemBytes(pemContent, skIndex)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w while reading %s file", err, fileName)
+	}
+
+	return skBytes, pkString, nil
+}
+
+// LoadAllSkPkFromFile loads all the secret keys and existing public keys stored in the provided PEM or keystore file
+func LoadAllSkPkFromFile(fileName string, password []byte) ([][]byte, []string, error) {
+	pemContent, err := ReadPemContent(fileName, password)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	skBytesList, pkStrings, err := core.LoadAllSkPkFromPemBytes(pemContent)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w while reading %s file", err, fileName)
+	}
+
+	return skBytesList, pkStrings, nil
+}
@@ -0,0 +1,7 @@
+package core
+
+// MetricLabel is a name-value pair identifying one of the series of a labeled metric
+type MetricLabel struct {
+	Name  string
+	Value string
+}
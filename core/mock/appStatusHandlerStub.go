@@ -1,14 +1,22 @@
 package mock
 
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
 // AppStatusHandlerStub is a stub implementation of AppStatusHandler
 type AppStatusHandlerStub struct {
-	AddUint64Handler      func(key string, value uint64)
-	IncrementHandler      func(key string)
-	DecrementHandler      func(key string)
-	SetUInt64ValueHandler func(key string, value uint64)
-	SetInt64ValueHandler  func(key string, value int64)
-	SetStringValueHandler func(key string, value string)
-	CloseHandler          func()
+	AddUint64Handler       func(key string, value uint64)
+	IncrementHandler       func(key string)
+	DecrementHandler       func(key string)
+	SetUInt64ValueHandler  func(key string, value uint64)
+	SetInt64ValueHandler   func(key string, value int64)
+	SetStringValueHandler  func(key string, value string)
+	AddToCounterHandler    func(key string, value uint64, labels ...core.MetricLabel)
+	ObserveDurationHandler func(key string, duration time.Duration, labels ...core.MetricLabel)
+	CloseHandler           func()
 }
 
 // IsInterfaceNil -
@@ -46,6 +54,20 @@ func (ashs *AppStatusHandlerStub) SetStringValue(key string, value string) {
 	ashs.SetStringValueHandler(key, value)
 }
 
+// AddToCounter will call the handler of the stub for increasing a labeled counter
+func (ashs *AppStatusHandlerStub) AddToCounter(key string, value uint64, labels ...core.MetricLabel) {
+	if ashs.AddToCounterHandler != nil {
+		ashs.AddToCounterHandler(key, value, labels...)
+	}
+}
+
+// ObserveDuration will call the handler of the stub for recording a duration
+func (ashs *AppStatusHandlerStub) ObserveDuration(key string, duration time.Duration, labels ...core.MetricLabel) {
+	if ashs.ObserveDurationHandler != nil {
+		ashs.ObserveDurationHandler(key, duration, labels...)
+	}
+}
+
 // Close will call the handler of the stub for closing
 func (ashs *AppStatusHandlerStub) Close() {
 	ashs.CloseHandler()
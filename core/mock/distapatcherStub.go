@@ -1,12 +1,16 @@
 package mock
 
-import "github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+)
 
 // DispatcherMock -
 type DispatcherMock struct {
 	StartIndexDataCalled func()
 	CloseCalled          func() error
 	AddCalled            func(item workItems.WorkItemHandler)
+	CheckHealthCalled    func() core.HealthCheckResult
 }
 
 // StartIndexData -
@@ -31,6 +35,14 @@ func (dm *DispatcherMock) Add(item workItems.WorkItemHandler) {
 	}
 }
 
+// CheckHealth -
+func (dm *DispatcherMock) CheckHealth() core.HealthCheckResult {
+	if dm.CheckHealthCalled != nil {
+		return dm.CheckHealthCalled()
+	}
+	return core.NewHealthCheckResultOK()
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (dm *DispatcherMock) IsInterfaceNil() bool {
 	return dm == nil
@@ -0,0 +1,30 @@
+package subscription
+
+import "errors"
+
+// ErrNilPubkeyConverter signals that a nil public key converter has been provided
+var ErrNilPubkeyConverter = errors.New("nil pubkey converter")
+
+// ErrInvalidReplayBufferSize signals that an invalid replay buffer size has been provided
+var ErrInvalidReplayBufferSize = errors.New("invalid replay buffer size")
+
+// ErrInvalidSubscriberQueueSize signals that an invalid subscriber queue size has been provided
+var ErrInvalidSubscriberQueueSize = errors.New("invalid subscriber queue size")
+
+// ErrInvalidMaxSubscribers signals that an invalid maximum number of subscribers has been provided
+var ErrInvalidMaxSubscribers = errors.New("invalid maximum number of subscribers")
+
+// ErrInvalidTopic signals that the subscription filter holds an unknown topic
+var ErrInvalidTopic = errors.New("invalid subscription topic")
+
+// ErrTooManySubscribers signals that the maximum number of subscribers has been reached
+var ErrTooManySubscribers = errors.New("too many subscribers")
+
+// ErrReplayTooLarge signals that the events to be replayed do not fit in the subscriber's queue
+var ErrReplayTooLarge = errors.New("too many events to replay, try a more recent nonce")
+
+// ErrSubscriptionsDisabled signals that the subscriptions hub is not enabled
+var ErrSubscriptionsDisabled = errors.New("subscriptions are disabled")
+
+// ErrHubClosed signals that the subscriptions hub has been closed
+var ErrHubClosed = errors.New("subscriptions hub is closed")
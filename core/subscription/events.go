@@ -0,0 +1,51 @@
+package subscription
+
+import "github.com/ElrondNetwork/elrond-go/data/transaction"
+
+const (
+	// HeadersTopic is the topic of the events generated for each new block header
+	HeadersTopic = "headers"
+	// TransactionStatusTopic is the topic of the events generated when a transaction's status changes
+	TransactionStatusTopic = "txStatus"
+	// LogsTopic is the topic of the events generated for each smart contract log event
+	LogsTopic = "logs"
+)
+
+// Event is the message sent to subscribers. Only the field corresponding to the event's topic is set.
+// BlockNonce is the nonce of the header for headers events and the nonce of the committed block holding the
+// transaction for the other topics.
+type Event struct {
+	Topic       string                  `json:"topic"`
+	ShardID     uint32                  `json:"shardID"`
+	BlockNonce  uint64                  `json:"blockNonce"`
+	BlockHash   string                  `json:"blockHash"`
+	Header      *HeaderEvent            `json:"header,omitempty"`
+	Transaction *TransactionStatusEvent `json:"transaction,omitempty"`
+	Log         *LogEvent               `json:"log,omitempty"`
+}
+
+// HeaderEvent holds the details of a new block header
+type HeaderEvent struct {
+	Hash     string `json:"hash"`
+	Nonce    uint64 `json:"nonce"`
+	Round    uint64 `json:"round"`
+	Epoch    uint32 `json:"epoch"`
+	ShardID  uint32 `json:"shardID"`
+	RootHash string `json:"rootHash,omitempty"`
+	TxCount  uint32 `json:"txCount"`
+}
+
+// TransactionStatusEvent holds the status of a transaction included in a committed block
+type TransactionStatusEvent struct {
+	Hash   string               `json:"hash"`
+	Status transaction.TxStatus `json:"status"`
+}
+
+// LogEvent holds a smart contract log event generated by a transaction included in a committed block
+type LogEvent struct {
+	TxHash     string   `json:"txHash"`
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     []string `json:"topics"`
+	Data       string   `json:"data"`
+}
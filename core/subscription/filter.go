@@ -0,0 +1,60 @@
+package subscription
+
+// Filter selects the events a subscriber is interested in. Empty fields match any value.
+type Filter struct {
+	Topic      string
+	ShardID    *uint32
+	TxHash     string
+	Address    string
+	Identifier string
+	LogTopic   string
+}
+
+// Check returns an error if the filter is not valid
+func (f *Filter) Check() error {
+	switch f.Topic {
+	case HeadersTopic, TransactionStatusTopic, LogsTopic:
+		return nil
+	default:
+		return ErrInvalidTopic
+	}
+}
+
+func (f *Filter) matches(event *Event) bool {
+	if f.Topic != event.Topic {
+		return false
+	}
+	if f.ShardID != nil && *f.ShardID != event.ShardID {
+		return false
+	}
+
+	switch event.Topic {
+	case TransactionStatusTopic:
+		return matchesIfSet(f.TxHash, event.Transaction.Hash)
+	case LogsTopic:
+		return matchesIfSet(f.TxHash, event.Log.TxHash) &&
+			matchesIfSet(f.Address, event.Log.Address) &&
+			matchesIfSet(f.Identifier, event.Log.Identifier) &&
+			f.matchesLogTopic(event.Log.Topics)
+	default:
+		return true
+	}
+}
+
+func (f *Filter) matchesLogTopic(topics []string) bool {
+	if len(f.LogTopic) == 0 {
+		return true
+	}
+
+	for _, topic := range topics {
+		if topic == f.LogTopic {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchesIfSet(expected string, actual string) bool {
+	return len(expected) == 0 || expected == actual
+}
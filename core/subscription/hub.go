@@ -0,0 +1,326 @@
+package subscription
+
+import (
+	"encoding/hex"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
+var _ Hub = (*hub)(nil)
+
+var log = logger.GetOrCreate("core/subscription")
+
+// ArgsHub holds the arguments needed for creating a subscriptions hub
+type ArgsHub struct {
+	SelfShardID            uint32
+	AddressPubkeyConverter core.PubkeyConverter
+	ReplayBufferSize       uint32
+	SubscriberQueueSize    uint32
+	MaxSubscribers         uint32
+}
+
+type hub struct {
+	selfShardID            uint32
+	addressPubkeyConverter core.PubkeyConverter
+	subscriberQueueSize    uint32
+	maxSubscribers         uint32
+
+	mutPendingLogs sync.Mutex
+	pendingLogs    map[string]*transaction.Log
+
+	mutSubscriptions   sync.Mutex
+	subscriptions      map[uint64]*Subscription
+	lastSubscriptionID uint64
+	replayBuffer       []*Event
+	replayBufferSize   uint32
+	closed             bool
+}
+
+// NewHub creates a subscriptions hub. The logs notified while processing transactions are held until the block
+// containing those transactions is committed, so only the logs of committed transactions reach the subscribers.
+// The last ReplayBufferSize events are kept in memory so that reconnecting subscribers can resume from a nonce.
+func NewHub(args ArgsHub) (*hub, error) {
+	if check.IfNil(args.AddressPubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+	if args.ReplayBufferSize == 0 {
+		return nil, ErrInvalidReplayBufferSize
+	}
+	if args.SubscriberQueueSize == 0 {
+		return nil, ErrInvalidSubscriberQueueSize
+	}
+	if args.MaxSubscribers == 0 {
+		return nil, ErrInvalidMaxSubscribers
+	}
+
+	return &hub{
+		selfShardID:            args.SelfShardID,
+		addressPubkeyConverter: args.AddressPubkeyConverter,
+		subscriberQueueSize:    args.SubscriberQueueSize,
+		maxSubscribers:         args.MaxSubscribers,
+		pendingLogs:            make(map[string]*transaction.Log),
+		subscriptions:          make(map[uint64]*Subscription),
+		replayBuffer:           make([]*Event, 0, args.ReplayBufferSize),
+		replayBufferSize:       args.ReplayBufferSize,
+	}, nil
+}
+
+// NotifyLog holds the log generated by the given transaction until the block containing it is committed
+func (h *hub) NotifyLog(txHash []byte, txLog *transaction.Log) {
+	if txLog == nil {
+		return
+	}
+
+	h.mutPendingLogs.Lock()
+	h.pendingLogs[string(txHash)] = txLog
+	h.mutPendingLogs.Unlock()
+}
+
+// NotifyCommittedBlock generates the header, transaction status and log events for the committed block and
+// dispatches them to the subscribers
+func (h *hub) NotifyCommittedBlock(headerHash []byte, header data.HeaderHandler, body data.BodyHandler) {
+	if check.IfNil(header) {
+		return
+	}
+
+	events := h.createHeaderEvents(headerHash, header)
+
+	h.mutPendingLogs.Lock()
+	blockBody, ok := body.(*block.Body)
+	if ok {
+		events = append(events, h.createTransactionsEvents(headerHash, header, blockBody)...)
+	}
+	h.pendingLogs = make(map[string]*transaction.Log)
+	h.mutPendingLogs.Unlock()
+
+	h.publish(events)
+}
+
+func (h *hub) createHeaderEvents(headerHash []byte, header data.HeaderHandler) []*Event {
+	events := []*Event{
+		newHeaderEvent(&HeaderEvent{
+			Hash:     hex.EncodeToString(headerHash),
+			Nonce:    header.GetNonce(),
+			Round:    header.GetRound(),
+			Epoch:    header.GetEpoch(),
+			ShardID:  header.GetShardID(),
+			RootHash: hex.EncodeToString(header.GetRootHash()),
+			TxCount:  header.GetTxCount(),
+		}),
+	}
+
+	metaBlock, ok := header.(*block.MetaBlock)
+	if !ok {
+		return events
+	}
+
+	for _, shardData := range metaBlock.ShardInfo {
+		events = append(events, newHeaderEvent(&HeaderEvent{
+			Hash:    hex.EncodeToString(shardData.HeaderHash),
+			Nonce:   shardData.Nonce,
+			Round:   shardData.Round,
+			Epoch:   header.GetEpoch(),
+			ShardID: shardData.ShardID,
+			TxCount: shardData.TxCount,
+		}))
+	}
+
+	return events
+}
+
+func newHeaderEvent(headerEvent *HeaderEvent) *Event {
+	return &Event{
+		Topic:      HeadersTopic,
+		ShardID:    headerEvent.ShardID,
+		BlockNonce: headerEvent.Nonce,
+		BlockHash:  headerEvent.Hash,
+		Header:     headerEvent,
+	}
+}
+
+func (h *hub) createTransactionsEvents(headerHash []byte, header data.HeaderHandler, body *block.Body) []*Event {
+	events := make([]*Event, 0)
+	blockHash := hex.EncodeToString(headerHash)
+	for _, miniBlock := range body.MiniBlocks {
+		if miniBlock == nil || miniBlock.Type == block.PeerBlock {
+			continue
+		}
+
+		statusComputer := &transaction.StatusComputer{
+			MiniblockType:    miniBlock.Type,
+			SourceShard:      miniBlock.SenderShardID,
+			DestinationShard: miniBlock.ReceiverShardID,
+			SelfShard:        h.selfShardID,
+		}
+		status := statusComputer.ComputeStatusWhenInStorageKnowingMiniblock()
+
+		for _, txHash := range miniBlock.TxHashes {
+			events = append(events, &Event{
+				Topic:      TransactionStatusTopic,
+				ShardID:    h.selfShardID,
+				BlockNonce: header.GetNonce(),
+				BlockHash:  blockHash,
+				Transaction: &TransactionStatusEvent{
+					Hash:   hex.EncodeToString(txHash),
+					Status: status,
+				},
+			})
+
+			events = append(events, h.createLogEvents(txHash, header.GetNonce(), blockHash)...)
+		}
+	}
+
+	return events
+}
+
+func (h *hub) createLogEvents(txHash []byte, blockNonce uint64, blockHash string) []*Event {
+	txLog, ok := h.pendingLogs[string(txHash)]
+	if !ok {
+		return nil
+	}
+
+	events := make([]*Event, 0, len(txLog.Events))
+	for _, logEvent := range txLog.Events {
+		if logEvent == nil {
+			continue
+		}
+
+		topics := make([]string, 0, len(logEvent.Topics))
+		for _, topic := range logEvent.Topics {
+			topics = append(topics, hex.EncodeToString(topic))
+		}
+
+		events = append(events, &Event{
+			Topic:      LogsTopic,
+			ShardID:    h.selfShardID,
+			BlockNonce: blockNonce,
+			BlockHash:  blockHash,
+			Log: &LogEvent{
+				TxHash:     hex.EncodeToString(txHash),
+				Address:    h.addressPubkeyConverter.Encode(logEvent.Address),
+				Identifier: string(logEvent.Identifier),
+				Topics:     topics,
+				Data:       hex.EncodeToString(logEvent.Data),
+			},
+		})
+	}
+
+	return events
+}
+
+func (h *hub) publish(events []*Event) {
+	h.mutSubscriptions.Lock()
+	defer h.mutSubscriptions.Unlock()
+
+	if h.closed {
+		return
+	}
+
+	for _, event := range events {
+		h.addToReplayBuffer(event)
+
+		for id, subscription := range h.subscriptions {
+			if !subscription.filter.matches(event) {
+				continue
+			}
+			if subscription.trySend(event) {
+				continue
+			}
+
+			log.Debug("subscriber is not keeping up, closing subscription", "id", id)
+			h.removeSubscription(id)
+		}
+	}
+}
+
+func (h *hub) addToReplayBuffer(event *Event) {
+	if uint32(len(h.replayBuffer)) == h.replayBufferSize {
+		copy(h.replayBuffer, h.replayBuffer[1:])
+		h.replayBuffer = h.replayBuffer[:len(h.replayBuffer)-1]
+	}
+
+	h.replayBuffer = append(h.replayBuffer, event)
+}
+
+// Subscribe creates a new subscription for the events matching the filter. If fromNonce is provided, the buffered
+// events with a block nonce greater or equal to it are delivered first.
+func (h *hub) Subscribe(filter Filter, fromNonce *uint64) (*Subscription, error) {
+	err := filter.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	h.mutSubscriptions.Lock()
+	defer h.mutSubscriptions.Unlock()
+
+	if h.closed {
+		return nil, ErrHubClosed
+	}
+	if uint32(len(h.subscriptions)) >= h.maxSubscribers {
+		return nil, ErrTooManySubscribers
+	}
+
+	h.lastSubscriptionID++
+	subscription := &Subscription{
+		id:     h.lastSubscriptionID,
+		filter: filter,
+		events: make(chan *Event, h.subscriberQueueSize),
+	}
+
+	if fromNonce != nil {
+		for _, event := range h.replayBuffer {
+			if event.BlockNonce < *fromNonce || !filter.matches(event) {
+				continue
+			}
+			if !subscription.trySend(event) {
+				close(subscription.events)
+				return nil, ErrReplayTooLarge
+			}
+		}
+	}
+
+	h.subscriptions[subscription.id] = subscription
+
+	return subscription, nil
+}
+
+// Unsubscribe cancels the subscription with the given identifier
+func (h *hub) Unsubscribe(subscriptionID uint64) {
+	h.mutSubscriptions.Lock()
+	h.removeSubscription(subscriptionID)
+	h.mutSubscriptions.Unlock()
+}
+
+func (h *hub) removeSubscription(subscriptionID uint64) {
+	subscription, ok := h.subscriptions[subscriptionID]
+	if !ok {
+		return
+	}
+
+	close(subscription.events)
+	delete(h.subscriptions, subscriptionID)
+}
+
+// Close cancels all the subscriptions and stops accepting new ones
+func (h *hub) Close() error {
+	h.mutSubscriptions.Lock()
+	defer h.mutSubscriptions.Unlock()
+
+	for id := range h.subscriptions {
+		h.removeSubscription(id)
+	}
+	h.closed = true
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (h *hub) IsInterfaceNil() bool {
+	return h == nil
+}
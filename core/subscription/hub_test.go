@@ -0,0 +1,246 @@
+package subscription_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/core/subscription"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsHub() subscription.ArgsHub {
+	return subscription.ArgsHub{
+		SelfShardID:            0,
+		AddressPubkeyConverter: mock.NewPubkeyConverterMock(32),
+		ReplayBufferSize:       100,
+		SubscriberQueueSize:    10,
+		MaxSubscribers:         2,
+	}
+}
+
+func createCommittedBlock(nonce uint64, txHashes ...[]byte) (*block.Header, *block.Body) {
+	header := &block.Header{Nonce: nonce, Round: nonce, ShardID: 0}
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{TxHashes: txHashes, SenderShardID: 0, ReceiverShardID: 0, Type: block.TxBlock},
+		},
+	}
+
+	return header, body
+}
+
+func TestNewHub_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHub()
+	args.AddressPubkeyConverter = nil
+	h, err := subscription.NewHub(args)
+	assert.True(t, check.IfNil(h))
+	assert.Equal(t, subscription.ErrNilPubkeyConverter, err)
+
+	args = createMockArgsHub()
+	args.ReplayBufferSize = 0
+	h, err = subscription.NewHub(args)
+	assert.True(t, check.IfNil(h))
+	assert.Equal(t, subscription.ErrInvalidReplayBufferSize, err)
+
+	args = createMockArgsHub()
+	args.SubscriberQueueSize = 0
+	h, err = subscription.NewHub(args)
+	assert.True(t, check.IfNil(h))
+	assert.Equal(t, subscription.ErrInvalidSubscriberQueueSize, err)
+
+	args = createMockArgsHub()
+	args.MaxSubscribers = 0
+	h, err = subscription.NewHub(args)
+	assert.True(t, check.IfNil(h))
+	assert.Equal(t, subscription.ErrInvalidMaxSubscribers, err)
+
+	h, err = subscription.NewHub(createMockArgsHub())
+	assert.False(t, check.IfNil(h))
+	assert.Nil(t, err)
+}
+
+func TestHub_SubscribeInvalidFilterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	h, _ := subscription.NewHub(createMockArgsHub())
+
+	s, err := h.Subscribe(subscription.Filter{Topic: "unknown"}, nil)
+	assert.Nil(t, s)
+	assert.Equal(t, subscription.ErrInvalidTopic, err)
+}
+
+func TestHub_SubscribeTooManySubscribersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	h, _ := subscription.NewHub(createMockArgsHub())
+
+	_, _ = h.Subscribe(subscription.Filter{Topic: subscription.HeadersTopic}, nil)
+	_, _ = h.Subscribe(subscription.Filter{Topic: subscription.HeadersTopic}, nil)
+	s, err := h.Subscribe(subscription.Filter{Topic: subscription.HeadersTopic}, nil)
+	assert.Nil(t, s)
+	assert.Equal(t, subscription.ErrTooManySubscribers, err)
+}
+
+func TestHub_NotifyCommittedBlockShouldDispatchHeadersAndTransactionStatus(t *testing.T) {
+	t.Parallel()
+
+	h, _ := subscription.NewHub(createMockArgsHub())
+	txHash := []byte("tx hash")
+
+	headersSubscription, err := h.Subscribe(subscription.Filter{Topic: subscription.HeadersTopic}, nil)
+	require.Nil(t, err)
+	txSubscription, err := h.Subscribe(subscription.Filter{
+		Topic:  subscription.TransactionStatusTopic,
+		TxHash: hex.EncodeToString(txHash),
+	}, nil)
+	require.Nil(t, err)
+
+	header, body := createCommittedBlock(7, []byte("other tx"), txHash)
+	h.NotifyCommittedBlock([]byte("header hash"), header, body)
+
+	headerEvent := <-headersSubscription.Events()
+	assert.Equal(t, uint64(7), headerEvent.Header.Nonce)
+	assert.Equal(t, hex.EncodeToString([]byte("header hash")), headerEvent.Header.Hash)
+
+	txEvent := <-txSubscription.Events()
+	assert.Equal(t, hex.EncodeToString(txHash), txEvent.Transaction.Hash)
+	assert.Equal(t, transaction.TxStatusSuccess, txEvent.Transaction.Status)
+	assert.Equal(t, 0, len(txSubscription.Events()))
+}
+
+func TestHub_LogsShouldBeDispatchedOnlyForCommittedTransactions(t *testing.T) {
+	t.Parallel()
+
+	h, _ := subscription.NewHub(createMockArgsHub())
+	committedTxHash := []byte("committed tx")
+	address := []byte("contract address")
+
+	logsSubscription, err := h.Subscribe(subscription.Filter{
+		Topic:      subscription.LogsTopic,
+		Address:    hex.EncodeToString(address),
+		Identifier: "transfer",
+	}, nil)
+	require.Nil(t, err)
+
+	txLog := &transaction.Log{
+		Events: []*transaction.Event{
+			{Address: address, Identifier: []byte("transfer"), Topics: [][]byte{[]byte("topic")}},
+			{Address: address, Identifier: []byte("other")},
+		},
+	}
+	h.NotifyLog(committedTxHash, txLog)
+	h.NotifyLog([]byte("not committed tx"), txLog)
+
+	header, body := createCommittedBlock(7, committedTxHash)
+	h.NotifyCommittedBlock([]byte("header hash"), header, body)
+
+	logEvent := <-logsSubscription.Events()
+	assert.Equal(t, hex.EncodeToString(committedTxHash), logEvent.Log.TxHash)
+	assert.Equal(t, []string{hex.EncodeToString([]byte("topic"))}, logEvent.Log.Topics)
+	assert.Equal(t, 0, len(logsSubscription.Events()))
+}
+
+func TestHub_SubscribeFromNonceShouldReplayBufferedEvents(t *testing.T) {
+	t.Parallel()
+
+	h, _ := subscription.NewHub(createMockArgsHub())
+	for nonce := uint64(1); nonce <= 5; nonce++ {
+		header, body := createCommittedBlock(nonce)
+		h.NotifyCommittedBlock([]byte("header hash"), header, body)
+	}
+
+	fromNonce := uint64(4)
+	s, err := h.Subscribe(subscription.Filter{Topic: subscription.HeadersTopic}, &fromNonce)
+	require.Nil(t, err)
+
+	require.Equal(t, 2, len(s.Events()))
+	assert.Equal(t, uint64(4), (<-s.Events()).BlockNonce)
+	assert.Equal(t, uint64(5), (<-s.Events()).BlockNonce)
+}
+
+func TestHub_SubscribeFromNonceReplayTooLargeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHub()
+	args.SubscriberQueueSize = 1
+	h, _ := subscription.NewHub(args)
+	for nonce := uint64(1); nonce <= 5; nonce++ {
+		header, body := createCommittedBlock(nonce)
+		h.NotifyCommittedBlock([]byte("header hash"), header, body)
+	}
+
+	fromNonce := uint64(1)
+	s, err := h.Subscribe(subscription.Filter{Topic: subscription.HeadersTopic}, &fromNonce)
+	assert.Nil(t, s)
+	assert.Equal(t, subscription.ErrReplayTooLarge, err)
+}
+
+func TestHub_SlowSubscriberShouldBeClosed(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHub()
+	args.SubscriberQueueSize = 1
+	h, _ := subscription.NewHub(args)
+	s, _ := h.Subscribe(subscription.Filter{Topic: subscription.HeadersTopic}, nil)
+
+	for nonce := uint64(1); nonce <= 2; nonce++ {
+		header, body := createCommittedBlock(nonce)
+		h.NotifyCommittedBlock([]byte("header hash"), header, body)
+	}
+
+	event, ok := <-s.Events()
+	assert.True(t, ok)
+	assert.Equal(t, uint64(1), event.BlockNonce)
+	_, ok = <-s.Events()
+	assert.False(t, ok)
+}
+
+func TestHub_UnsubscribeAndCloseShouldCloseSubscriptions(t *testing.T) {
+	t.Parallel()
+
+	h, _ := subscription.NewHub(createMockArgsHub())
+	first, _ := h.Subscribe(subscription.Filter{Topic: subscription.HeadersTopic}, nil)
+	second, _ := h.Subscribe(subscription.Filter{Topic: subscription.LogsTopic}, nil)
+
+	h.Unsubscribe(first.ID())
+	_, ok := <-first.Events()
+	assert.False(t, ok)
+
+	err := h.Close()
+	assert.Nil(t, err)
+	_, ok = <-second.Events()
+	assert.False(t, ok)
+
+	s, err := h.Subscribe(subscription.Filter{Topic: subscription.HeadersTopic}, nil)
+	assert.Nil(t, s)
+	assert.Equal(t, subscription.ErrHubClosed, err)
+}
+
+func TestHub_MetaBlockShouldDispatchNotarizedShardHeaders(t *testing.T) {
+	t.Parallel()
+
+	h, _ := subscription.NewHub(createMockArgsHub())
+	shardID := uint32(1)
+	s, _ := h.Subscribe(subscription.Filter{Topic: subscription.HeadersTopic, ShardID: &shardID}, nil)
+
+	metaBlock := &block.MetaBlock{
+		Nonce: 10,
+		ShardInfo: []block.ShardData{
+			{ShardID: 0, Nonce: 20, HeaderHash: []byte("shard 0 header")},
+			{ShardID: 1, Nonce: 30, HeaderHash: []byte("shard 1 header")},
+		},
+	}
+	h.NotifyCommittedBlock([]byte("meta header"), metaBlock, &block.Body{})
+
+	require.Equal(t, 1, len(s.Events()))
+	event := <-s.Events()
+	assert.Equal(t, uint64(30), event.Header.Nonce)
+	assert.Equal(t, hex.EncodeToString([]byte("shard 1 header")), event.Header.Hash)
+}
@@ -0,0 +1,21 @@
+package subscription
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
+// Notifier is fed with the committed blocks and with the logs generated while processing transactions
+type Notifier interface {
+	NotifyCommittedBlock(headerHash []byte, header data.HeaderHandler, body data.BodyHandler)
+	NotifyLog(txHash []byte, txLog *transaction.Log)
+	IsInterfaceNil() bool
+}
+
+// Hub dispatches the notified events towards the subscribers whose filters match them
+type Hub interface {
+	Notifier
+	Subscribe(filter Filter, fromNonce *uint64) (*Subscription, error)
+	Unsubscribe(subscriptionID uint64)
+	Close() error
+}
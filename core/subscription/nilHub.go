@@ -0,0 +1,43 @@
+package subscription
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
+var _ Hub = (*nilHub)(nil)
+
+type nilHub struct {
+}
+
+// NewNilHub returns a subscriptions hub that ignores all notifications, used when subscriptions are disabled
+func NewNilHub() *nilHub {
+	return new(nilHub)
+}
+
+// NotifyCommittedBlock does nothing
+func (nh *nilHub) NotifyCommittedBlock(_ []byte, _ data.HeaderHandler, _ data.BodyHandler) {
+}
+
+// NotifyLog does nothing
+func (nh *nilHub) NotifyLog(_ []byte, _ *transaction.Log) {
+}
+
+// Subscribe returns ErrSubscriptionsDisabled
+func (nh *nilHub) Subscribe(_ Filter, _ *uint64) (*Subscription, error) {
+	return nil, ErrSubscriptionsDisabled
+}
+
+// Unsubscribe does nothing
+func (nh *nilHub) Unsubscribe(_ uint64) {
+}
+
+// Close does nothing
+func (nh *nilHub) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nh *nilHub) IsInterfaceNil() bool {
+	return nh == nil
+}
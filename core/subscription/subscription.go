@@ -0,0 +1,28 @@
+package subscription
+
+// Subscription receives the events matching its filter on the channel returned by Events. The channel is closed
+// when the subscription ends, either because it was cancelled or because the subscriber could not keep up.
+type Subscription struct {
+	id     uint64
+	filter Filter
+	events chan *Event
+}
+
+// ID returns the subscription identifier, used for unsubscribing
+func (s *Subscription) ID() uint64 {
+	return s.id
+}
+
+// Events returns the channel on which the matching events are delivered
+func (s *Subscription) Events() <-chan *Event {
+	return s.events
+}
+
+func (s *Subscription) trySend(event *Event) bool {
+	select {
+	case s.events <- event:
+		return true
+	default:
+		return false
+	}
+}
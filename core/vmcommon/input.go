@@ -18,6 +18,10 @@ const (
 	// AsynchronousCallBack means that an AsynchronousCall was performed
 	// previously, and now the control returns to the caller SmartContract's callBack method
 	AsynchronousCallBack
+
+	// ESDTTransferFromBuiltInFunction means that the call was created on the sender shard by an ESDT built-in function,
+	// which already debited the transferred tokens. Only the protocol creates calls of this type, never a SmartContract
+	ESDTTransferFromBuiltInFunction
 )
 
 // VMInput contains the common fields between the 2 types of SC call.
@@ -132,3 +132,15 @@ var ErrInvalidSignature = errors.New("invalid signature")
 
 // ErrWrongTypeAssertion signals wrong type assertion
 var ErrWrongTypeAssertion = errors.New("wrong type assertion")
+
+// ErrNilSigningHandler signals that a nil signing handler has been provided
+var ErrNilSigningHandler = errors.New("nil signing handler")
+
+// ErrNilLowLevelSigner signals that a nil low level signer has been provided
+var ErrNilLowLevelSigner = errors.New("nil low level signer")
+
+// ErrNilKeysHandler signals that a nil keys handler has been provided
+var ErrNilKeysHandler = errors.New("nil keys handler")
+
+// ErrPrivateKeyNotAvailable signals that the private key is not available in-process, as it is held by a remote signer
+var ErrPrivateKeyNotAvailable = errors.New("private key not available")
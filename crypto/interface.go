@@ -180,6 +180,8 @@ type MultiSigner interface {
 	Reset(pubKeys []string, index uint16) error
 	// CreateSignatureShare creates a partial signature
 	CreateSignatureShare(msg []byte, bitmap []byte) ([]byte, error)
+	// CreateSignatureShareWithPrivateKey creates a partial signature with the provided private key, without storing it
+	CreateSignatureShareWithPrivateKey(msg []byte, privateKey PrivateKey) ([]byte, error)
 	// StoreSignatureShare adds the partial signature of the signer with specified position
 	StoreSignatureShare(index uint16, sig []byte) error
 	// SignatureShare returns the partial signature set for given index
@@ -225,3 +227,19 @@ type PeerSignatureHandler interface {
 	GetPeerSignature(key PrivateKey, pid []byte) ([]byte, error)
 	IsInterfaceNil() bool
 }
+
+// SigningHandler signs the consensus data on behalf of the public keys the node acts for. The private keys might be
+// held in-process or by a separate signing service, in which case they never reach the node's memory. The block related
+// data is signed along with the marshalized header it belongs to, from which a signing service reads the epoch and round
+type SigningHandler interface {
+	// SignRandSeed signs the previous random seed when proposing the provided marshalized header
+	SignRandSeed(pubKey []byte, prevRandSeed []byte, marshalizedHeader []byte) ([]byte, error)
+	// SignBlockHeader creates the leader's signature over the marshalized header
+	SignBlockHeader(pubKey []byte, marshalizedHeader []byte) ([]byte, error)
+	// CreateSignatureShare creates the signature share over the hash of the provided marshalized header
+	CreateSignatureShare(pubKey []byte, headerHash []byte, marshalizedHeader []byte) ([]byte, error)
+	// SignPeerMessage signs a message which is not bound to a round, such as a peer ID
+	SignPeerMessage(pubKey []byte, message []byte) ([]byte, error)
+	// IsInterfaceNil returns true if there is no value under the interface
+	IsInterfaceNil() bool
+}
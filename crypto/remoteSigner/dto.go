@@ -0,0 +1,30 @@
+package remoteSigner
+
+// SignPath is the path on which the signing service handles the sign requests
+const SignPath = "/sign"
+
+const (
+	// RandSeedSignType is the sign type of the random seed of a proposed block
+	RandSeedSignType = "randSeed"
+	// BlockHeaderSignType is the sign type of the leader's signature over a block header
+	BlockHeaderSignType = "blockHeader"
+	// SignatureShareSignType is the sign type of the signature share over a block
+	SignatureShareSignType = "signatureShare"
+	// PeerMessageSignType is the sign type of the messages which are not bound to a round, such as peer IDs
+	PeerMessageSignType = "peerMessage"
+)
+
+// SignRequest represents the request sent to the signing service. The header is the marshalized header the random
+// seed or the signature share belong to, the signing service reading the epoch and the round from it
+type SignRequest struct {
+	Type    string `json:"type"`
+	PubKey  []byte `json:"pubKey"`
+	Message []byte `json:"message"`
+	Header  []byte `json:"header,omitempty"`
+}
+
+// SignResponse represents the response of the signing service
+type SignResponse struct {
+	Signature []byte `json:"signature,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
@@ -0,0 +1,45 @@
+package remoteSigner
+
+import "errors"
+
+// ErrEmptyURL signals that an empty URL has been provided
+var ErrEmptyURL = errors.New("empty URL")
+
+// ErrNilTLSConfig signals that a nil TLS config has been provided
+var ErrNilTLSConfig = errors.New("nil TLS config")
+
+// ErrInvalidValue signals that an invalid value has been provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrNilWatermarksHandler signals that a nil watermarks handler has been provided
+var ErrNilWatermarksHandler = errors.New("nil watermarks handler")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilPeerSignatureHandler signals that a nil peer signature handler has been provided
+var ErrNilPeerSignatureHandler = errors.New("nil peer signature handler")
+
+// ErrNilPublicKey signals that a nil public key has been provided
+var ErrNilPublicKey = errors.New("nil public key")
+
+// ErrInvalidCACertificate signals that the CA certificate file does not contain any valid certificate
+var ErrInvalidCACertificate = errors.New("invalid CA certificate")
+
+// ErrUnknownSignType signals that the sign request has an unknown type
+var ErrUnknownSignType = errors.New("unknown sign type")
+
+// ErrInvalidSignPayload signals that the message of the sign request does not match its sign type
+var ErrInvalidSignPayload = errors.New("invalid sign payload")
+
+// ErrDoubleSigning signals that a different message was already signed for the same public key, epoch and round
+var ErrDoubleSigning = errors.New("double signing attempt")
+
+// ErrBelowWatermark signals that the sign request is for an epoch and round lower than the highest already signed ones
+var ErrBelowWatermark = errors.New("sign request below the high watermark")
+
+// ErrRemoteSigning signals that the remote signer rejected the sign request
+var ErrRemoteSigning = errors.New("remote signing failed")
@@ -0,0 +1,8 @@
+package remoteSigner
+
+// WatermarksHandler defines the component which keeps the highest signed epoch and round of each public key and
+// sign type, rejecting the requests which would lead to double signing
+type WatermarksHandler interface {
+	CheckAndUpdate(pubKey []byte, signType string, epoch uint32, round uint64, message []byte) error
+	IsInterfaceNil() bool
+}
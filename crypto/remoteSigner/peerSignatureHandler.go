@@ -0,0 +1,90 @@
+package remoteSigner
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+)
+
+var _ crypto.PeerSignatureHandler = (*peerSignatureHandler)(nil)
+
+// ArgsPeerSignatureHandler represents the arguments for the remote peer signature handler
+type ArgsPeerSignatureHandler struct {
+	SigningHandler       crypto.SigningHandler
+	PeerSignatureHandler crypto.PeerSignatureHandler
+}
+
+type pidSignature struct {
+	pid       string
+	signature []byte
+}
+
+type peerSignatureHandler struct {
+	localPeerSignatureHandler crypto.PeerSignatureHandler
+	signingHandler            crypto.SigningHandler
+	mutSignatures             sync.RWMutex
+	signatures                map[string]*pidSignature
+}
+
+// NewPeerSignatureHandler creates a peer signature handler which obtains the peer signatures from the signing
+// handler, identifying the keys by their public keys. The verification is done by the provided peer signature handler
+func NewPeerSignatureHandler(args ArgsPeerSignatureHandler) (*peerSignatureHandler, error) {
+	if check.IfNil(args.SigningHandler) {
+		return nil, crypto.ErrNilSigningHandler
+	}
+	if check.IfNil(args.PeerSignatureHandler) {
+		return nil, ErrNilPeerSignatureHandler
+	}
+
+	return &peerSignatureHandler{
+		localPeerSignatureHandler: args.PeerSignatureHandler,
+		signingHandler:            args.SigningHandler,
+		signatures:                make(map[string]*pidSignature),
+	}, nil
+}
+
+// GetPeerSignature returns the signature of the peer ID, requesting it from the signing handler only if the peer ID
+// changed since the last request for the same key
+func (psh *peerSignatureHandler) GetPeerSignature(key crypto.PrivateKey, pid []byte) ([]byte, error) {
+	if check.IfNil(key) {
+		return nil, crypto.ErrNilPrivateKey
+	}
+
+	pkBytes, err := key.GeneratePublic().ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	psh.mutSignatures.RLock()
+	cached, exists := psh.signatures[string(pkBytes)]
+	psh.mutSignatures.RUnlock()
+	if exists && cached.pid == string(pid) {
+		return cached.signature, nil
+	}
+
+	signature, err := psh.signingHandler.SignPeerMessage(pkBytes, pid)
+	if err != nil {
+		return nil, err
+	}
+
+	psh.mutSignatures.Lock()
+	psh.signatures[string(pkBytes)] = &pidSignature{
+		pid:       string(pid),
+		signature: signature,
+	}
+	psh.mutSignatures.Unlock()
+
+	return signature, nil
+}
+
+// VerifyPeerSignature verifies the signature of the peer ID
+func (psh *peerSignatureHandler) VerifyPeerSignature(pk []byte, pid core.PeerID, signature []byte) error {
+	return psh.localPeerSignatureHandler.VerifyPeerSignature(pk, pid, signature)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (psh *peerSignatureHandler) IsInterfaceNil() bool {
+	return psh == nil
+}
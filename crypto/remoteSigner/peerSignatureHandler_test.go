@@ -0,0 +1,130 @@
+package remoteSigner_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/remoteSigner"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type peerSignatureHandlerStub struct {
+	VerifyPeerSignatureCalled func(pk []byte, pid core.PeerID, signature []byte) error
+}
+
+func (pshs *peerSignatureHandlerStub) VerifyPeerSignature(pk []byte, pid core.PeerID, signature []byte) error {
+	if pshs.VerifyPeerSignatureCalled != nil {
+		return pshs.VerifyPeerSignatureCalled(pk, pid, signature)
+	}
+
+	return nil
+}
+
+func (pshs *peerSignatureHandlerStub) GetPeerSignature(_ crypto.PrivateKey, _ []byte) ([]byte, error) {
+	return nil, nil
+}
+
+func (pshs *peerSignatureHandlerStub) IsInterfaceNil() bool {
+	return pshs == nil
+}
+
+func TestNewPeerSignatureHandler_NilSigningHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	psh, err := remoteSigner.NewPeerSignatureHandler(remoteSigner.ArgsPeerSignatureHandler{
+		PeerSignatureHandler: &peerSignatureHandlerStub{},
+	})
+
+	assert.True(t, check.IfNil(psh))
+	assert.Equal(t, crypto.ErrNilSigningHandler, err)
+}
+
+func TestNewPeerSignatureHandler_NilPeerSignatureHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	psh, err := remoteSigner.NewPeerSignatureHandler(remoteSigner.ArgsPeerSignatureHandler{
+		SigningHandler: &testscommon.SigningHandlerStub{},
+	})
+
+	assert.True(t, check.IfNil(psh))
+	assert.Equal(t, remoteSigner.ErrNilPeerSignatureHandler, err)
+}
+
+func TestPeerSignatureHandler_GetPeerSignatureShouldSignOnlyWhenThePidChanges(t *testing.T) {
+	t.Parallel()
+
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	_, publicKey := keyGen.GeneratePair()
+	pkBytes, _ := publicKey.ToByteArray()
+	privateKey, err := remoteSigner.NewRemotePrivateKey(publicKey)
+	require.Nil(t, err)
+
+	numSignCalls := 0
+	psh, _ := remoteSigner.NewPeerSignatureHandler(remoteSigner.ArgsPeerSignatureHandler{
+		SigningHandler: &testscommon.SigningHandlerStub{
+			SignPeerMessageCalled: func(pubKey []byte, message []byte) ([]byte, error) {
+				assert.Equal(t, pkBytes, pubKey)
+				numSignCalls++
+				return append([]byte("signed "), message...), nil
+			},
+		},
+		PeerSignatureHandler: &peerSignatureHandlerStub{},
+	})
+
+	sig, err := psh.GetPeerSignature(privateKey, []byte("pid1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("signed pid1"), sig)
+
+	sig, _ = psh.GetPeerSignature(privateKey, []byte("pid1"))
+	assert.Equal(t, []byte("signed pid1"), sig)
+	assert.Equal(t, 1, numSignCalls)
+
+	sig, _ = psh.GetPeerSignature(privateKey, []byte("pid2"))
+	assert.Equal(t, []byte("signed pid2"), sig)
+	assert.Equal(t, 2, numSignCalls)
+}
+
+func TestPeerSignatureHandler_VerifyPeerSignatureShouldUseTheLocalHandler(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	psh, _ := remoteSigner.NewPeerSignatureHandler(remoteSigner.ArgsPeerSignatureHandler{
+		SigningHandler: &testscommon.SigningHandlerStub{},
+		PeerSignatureHandler: &peerSignatureHandlerStub{
+			VerifyPeerSignatureCalled: func(pk []byte, pid core.PeerID, signature []byte) error {
+				wasCalled = true
+				return nil
+			},
+		},
+	})
+
+	err := psh.VerifyPeerSignature([]byte("pk"), core.PeerID("pid"), []byte("sig"))
+	assert.Nil(t, err)
+	assert.True(t, wasCalled)
+}
+
+func TestRemotePrivateKey(t *testing.T) {
+	t.Parallel()
+
+	privateKey, err := remoteSigner.NewRemotePrivateKey(nil)
+	assert.True(t, check.IfNil(privateKey))
+	assert.Equal(t, remoteSigner.ErrNilPublicKey, err)
+
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	_, publicKey := keyGen.GeneratePair()
+	privateKey, _ = remoteSigner.NewRemotePrivateKey(publicKey)
+
+	assert.Equal(t, publicKey, privateKey.GeneratePublic())
+	assert.Equal(t, publicKey.Suite(), privateKey.Suite())
+	assert.Nil(t, privateKey.Scalar())
+
+	skBytes, err := privateKey.ToByteArray()
+	assert.Nil(t, skBytes)
+	assert.Equal(t, crypto.ErrPrivateKeyNotAvailable, err)
+}
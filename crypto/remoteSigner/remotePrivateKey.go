@@ -0,0 +1,49 @@
+package remoteSigner
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+)
+
+var _ crypto.PrivateKey = (*remotePrivateKey)(nil)
+
+type remotePrivateKey struct {
+	publicKey crypto.PublicKey
+}
+
+// NewRemotePrivateKey creates a placeholder for a private key held by a signing service. It only exposes the
+// public key, so it can identify the key in the components requiring a private key
+func NewRemotePrivateKey(publicKey crypto.PublicKey) (*remotePrivateKey, error) {
+	if check.IfNil(publicKey) {
+		return nil, ErrNilPublicKey
+	}
+
+	return &remotePrivateKey{
+		publicKey: publicKey,
+	}, nil
+}
+
+// ToByteArray returns an error, as the private key is not available in-process
+func (rpk *remotePrivateKey) ToByteArray() ([]byte, error) {
+	return nil, crypto.ErrPrivateKeyNotAvailable
+}
+
+// GeneratePublic returns the public key of the remote private key
+func (rpk *remotePrivateKey) GeneratePublic() crypto.PublicKey {
+	return rpk.publicKey
+}
+
+// Suite returns the suite of the public key
+func (rpk *remotePrivateKey) Suite() crypto.Suite {
+	return rpk.publicKey.Suite()
+}
+
+// Scalar returns nil, as the private key is not available in-process
+func (rpk *remotePrivateKey) Scalar() crypto.Scalar {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rpk *remotePrivateKey) IsInterfaceNil() bool {
+	return rpk == nil
+}
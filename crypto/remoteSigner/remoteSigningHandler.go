@@ -0,0 +1,119 @@
+package remoteSigner
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/crypto"
+)
+
+var _ crypto.SigningHandler = (*remoteSigningHandler)(nil)
+
+// ArgsRemoteSigningHandler represents the arguments for the remote signing handler
+type ArgsRemoteSigningHandler struct {
+	URL            string
+	TLSConfig      *tls.Config
+	RequestTimeout time.Duration
+}
+
+type remoteSigningHandler struct {
+	signURL    string
+	httpClient *http.Client
+}
+
+// NewRemoteSigningHandler creates a signing handler which forwards all the sign requests to a signing service,
+// over a mutually authenticated TLS connection
+func NewRemoteSigningHandler(args ArgsRemoteSigningHandler) (*remoteSigningHandler, error) {
+	if len(args.URL) == 0 {
+		return nil, ErrEmptyURL
+	}
+	if args.TLSConfig == nil {
+		return nil, ErrNilTLSConfig
+	}
+	if args.RequestTimeout <= 0 {
+		return nil, fmt.Errorf("%w for RequestTimeout", ErrInvalidValue)
+	}
+
+	return &remoteSigningHandler{
+		signURL: strings.TrimSuffix(args.URL, "/") + SignPath,
+		httpClient: &http.Client{
+			Transport: &http.Transport{TLSClientConfig: args.TLSConfig},
+			Timeout:   args.RequestTimeout,
+		},
+	}, nil
+}
+
+// SignRandSeed requests the signature of the previous random seed from the signing service
+func (rsh *remoteSigningHandler) SignRandSeed(pubKey []byte, prevRandSeed []byte, marshalizedHeader []byte) ([]byte, error) {
+	return rsh.sign(&SignRequest{
+		Type:    RandSeedSignType,
+		PubKey:  pubKey,
+		Message: prevRandSeed,
+		Header:  marshalizedHeader,
+	})
+}
+
+// SignBlockHeader requests the leader's signature over the marshalized header from the signing service
+func (rsh *remoteSigningHandler) SignBlockHeader(pubKey []byte, marshalizedHeader []byte) ([]byte, error) {
+	return rsh.sign(&SignRequest{
+		Type:    BlockHeaderSignType,
+		PubKey:  pubKey,
+		Message: marshalizedHeader,
+	})
+}
+
+// CreateSignatureShare requests the signature share over the header hash from the signing service
+func (rsh *remoteSigningHandler) CreateSignatureShare(pubKey []byte, headerHash []byte, marshalizedHeader []byte) ([]byte, error) {
+	return rsh.sign(&SignRequest{
+		Type:    SignatureShareSignType,
+		PubKey:  pubKey,
+		Message: headerHash,
+		Header:  marshalizedHeader,
+	})
+}
+
+// SignPeerMessage requests the signature of a message which is not bound to a round from the signing service
+func (rsh *remoteSigningHandler) SignPeerMessage(pubKey []byte, message []byte) ([]byte, error) {
+	return rsh.sign(&SignRequest{
+		Type:    PeerMessageSignType,
+		PubKey:  pubKey,
+		Message: message,
+	})
+}
+
+func (rsh *remoteSigningHandler) sign(request *SignRequest) ([]byte, error) {
+	requestBytes, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	httpResponse, err := rsh.httpClient.Post(rsh.signURL, "application/json", bytes.NewReader(requestBytes))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		errClose := httpResponse.Body.Close()
+		log.LogIfError(errClose)
+	}()
+
+	response := &SignResponse{}
+	err = json.NewDecoder(httpResponse.Body).Decode(response)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding the response, status code %d", err, httpResponse.StatusCode)
+	}
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: %s", ErrRemoteSigning, response.Error)
+	}
+
+	return response.Signature, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rsh *remoteSigningHandler) IsInterfaceNil() bool {
+	return rsh == nil
+}
@@ -0,0 +1,293 @@
+package remoteSigner_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/mock"
+	"github.com/ElrondNetwork/elrond-go/crypto/remoteSigner"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl"
+	llsig "github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/multisig"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/singlesig"
+	"github.com/ElrondNetwork/elrond-go/crypto/signingHandler"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type certificateFiles struct {
+	caCertificate     string
+	serverCertificate string
+	serverKey         string
+	clientCertificate string
+	clientKey         string
+}
+
+func writePemFile(t *testing.T, filePath string, blockType string, bytes []byte) {
+	err := ioutil.WriteFile(filePath, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: bytes}), 0600)
+	require.Nil(t, err)
+}
+
+func createCertificate(
+	t *testing.T,
+	template *x509.Certificate,
+	parent *x509.Certificate,
+	parentKey *ecdsa.PrivateKey,
+	certificateFile string,
+	keyFile string,
+) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+
+	if parent == nil {
+		parent = template
+		parentKey = key
+	}
+
+	certificateBytes, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.Nil(t, err)
+	writePemFile(t, certificateFile, "CERTIFICATE", certificateBytes)
+
+	if len(keyFile) > 0 {
+		keyBytes, errMarshal := x509.MarshalECPrivateKey(key)
+		require.Nil(t, errMarshal)
+		writePemFile(t, keyFile, "EC PRIVATE KEY", keyBytes)
+	}
+
+	certificate, err := x509.ParseCertificate(certificateBytes)
+	require.Nil(t, err)
+
+	return certificate, key
+}
+
+func createCertificateTemplate(serialNumber int64, commonName string) *x509.Certificate {
+	return &x509.Certificate{
+		SerialNumber: big.NewInt(serialNumber),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+}
+
+func createCertificateFiles(t *testing.T, dir string) certificateFiles {
+	files := certificateFiles{
+		caCertificate:     filepath.Join(dir, "ca.pem"),
+		serverCertificate: filepath.Join(dir, "server.pem"),
+		serverKey:         filepath.Join(dir, "server.key"),
+		clientCertificate: filepath.Join(dir, "client.pem"),
+		clientKey:         filepath.Join(dir, "client.key"),
+	}
+
+	caTemplate := createCertificateTemplate(1, "signer CA")
+	caTemplate.IsCA = true
+	caTemplate.BasicConstraintsValid = true
+	caTemplate.KeyUsage |= x509.KeyUsageCertSign
+	caCertificate, caKey := createCertificate(t, caTemplate, nil, nil, files.caCertificate, "")
+
+	serverTemplate := createCertificateTemplate(2, "signer")
+	serverTemplate.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+	serverTemplate.IPAddresses = []net.IP{net.ParseIP("127.0.0.1")}
+	_, _ = createCertificate(t, serverTemplate, caCertificate, caKey, files.serverCertificate, files.serverKey)
+
+	clientTemplate := createCertificateTemplate(3, "node")
+	clientTemplate.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+	_, _ = createCertificate(t, clientTemplate, caCertificate, caKey, files.clientCertificate, files.clientKey)
+
+	return files
+}
+
+func startSigningServer(t *testing.T, files certificateFiles, privateKey crypto.PrivateKey) *httptest.Server {
+	localSigningHandler, err := signingHandler.NewSigningHandler(signingHandler.ArgsSigningHandler{
+		PrivateKey:     privateKey,
+		KeysHandler:    &testscommon.KeysHandlerStub{},
+		SingleSigner:   &singlesig.BlsSingleSigner{},
+		LowLevelSigner: &llsig.BlsMultiSigner{Hasher: &mock.HasherSpongeMock{}},
+	})
+	require.Nil(t, err)
+
+	wt, _ := remoteSigner.NewWatermarksTracker(createMockArgsWatermarksTracker())
+	ss, err := remoteSigner.NewSigningServer(remoteSigner.ArgsSigningServer{
+		SigningHandler:    localSigningHandler,
+		WatermarksHandler: wt,
+		Marshalizer:       testMarshalizer,
+		Hasher:            testHasher,
+	})
+	require.Nil(t, err)
+
+	serverTLSConfig, err := remoteSigner.CreateServerTLSConfig(files.serverCertificate, files.serverKey, files.caCertificate)
+	require.Nil(t, err)
+
+	server := httptest.NewUnstartedServer(ss)
+	server.TLS = serverTLSConfig
+	server.StartTLS()
+
+	return server
+}
+
+func createMockArgsRemoteSigningHandler() remoteSigner.ArgsRemoteSigningHandler {
+	return remoteSigner.ArgsRemoteSigningHandler{
+		URL:            "https://127.0.0.1:8090",
+		TLSConfig:      &tls.Config{},
+		RequestTimeout: time.Second,
+	}
+}
+
+func TestNewRemoteSigningHandler_EmptyURLShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsRemoteSigningHandler()
+	args.URL = ""
+	rsh, err := remoteSigner.NewRemoteSigningHandler(args)
+
+	assert.True(t, check.IfNil(rsh))
+	assert.Equal(t, remoteSigner.ErrEmptyURL, err)
+}
+
+func TestNewRemoteSigningHandler_NilTLSConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsRemoteSigningHandler()
+	args.TLSConfig = nil
+	rsh, err := remoteSigner.NewRemoteSigningHandler(args)
+
+	assert.True(t, check.IfNil(rsh))
+	assert.Equal(t, remoteSigner.ErrNilTLSConfig, err)
+}
+
+func TestNewRemoteSigningHandler_InvalidTimeoutShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsRemoteSigningHandler()
+	args.RequestTimeout = 0
+	rsh, err := remoteSigner.NewRemoteSigningHandler(args)
+
+	assert.True(t, check.IfNil(rsh))
+	assert.True(t, errors.Is(err, remoteSigner.ErrInvalidValue))
+}
+
+func TestNewRemoteSigningHandler_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	rsh, err := remoteSigner.NewRemoteSigningHandler(createMockArgsRemoteSigningHandler())
+
+	assert.False(t, check.IfNil(rsh))
+	assert.Nil(t, err)
+}
+
+func TestRemoteSigningHandler_SignWithLocalSigningServer(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "remotesigner")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	files := createCertificateFiles(t, dir)
+
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	privateKey, publicKey := keyGen.GeneratePair()
+	pkBytes, _ := publicKey.ToByteArray()
+	server := startSigningServer(t, files, privateKey)
+	defer server.Close()
+
+	clientTLSConfig, err := remoteSigner.CreateClientTLSConfig(files.clientCertificate, files.clientKey, files.caCertificate)
+	require.Nil(t, err)
+	args := createMockArgsRemoteSigningHandler()
+	args.URL = server.URL
+	args.TLSConfig = clientTLSConfig
+	rsh, _ := remoteSigner.NewRemoteSigningHandler(args)
+
+	singleSigner := &singlesig.BlsSingleSigner{}
+	prevRandSeed := []byte("previous rand seed")
+	header := marshalHeader(&block.Header{Epoch: 1, Round: 10, PrevRandSeed: prevRandSeed})
+	headerHash := testHasher.Compute(string(header))
+	pid := createPeerID()
+
+	sig, err := rsh.SignRandSeed(pkBytes, prevRandSeed, header)
+	require.Nil(t, err)
+	assert.Nil(t, singleSigner.Verify(publicKey, prevRandSeed, sig))
+
+	sig, err = rsh.CreateSignatureShare(pkBytes, headerHash, header)
+	require.Nil(t, err)
+	assert.Nil(t, singleSigner.Verify(publicKey, headerHash, sig))
+
+	sig, err = rsh.SignBlockHeader(pkBytes, header)
+	require.Nil(t, err)
+	assert.Nil(t, singleSigner.Verify(publicKey, header, sig))
+
+	sig, err = rsh.SignPeerMessage(pkBytes, pid)
+	require.Nil(t, err)
+	assert.Nil(t, singleSigner.Verify(publicKey, pid, sig))
+
+	otherHeader := marshalHeader(&block.Header{Epoch: 1, Round: 10, Nonce: 2, PrevRandSeed: prevRandSeed})
+	sig, err = rsh.CreateSignatureShare(pkBytes, testHasher.Compute(string(otherHeader)), otherHeader)
+	assert.Nil(t, sig)
+	assert.True(t, errors.Is(err, remoteSigner.ErrRemoteSigning))
+
+	_, otherPublicKey := keyGen.GeneratePair()
+	otherPkBytes, _ := otherPublicKey.ToByteArray()
+	sig, err = rsh.SignPeerMessage(otherPkBytes, pid)
+	assert.Nil(t, sig)
+	assert.True(t, errors.Is(err, remoteSigner.ErrRemoteSigning))
+}
+
+func TestRemoteSigningHandler_ClientWithoutCertificateShouldBeRejected(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "remotesigner")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	files := createCertificateFiles(t, dir)
+
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	privateKey, publicKey := keyGen.GeneratePair()
+	pkBytes, _ := publicKey.ToByteArray()
+	server := startSigningServer(t, files, privateKey)
+	defer server.Close()
+
+	clientTLSConfig, err := remoteSigner.CreateClientTLSConfig(files.clientCertificate, files.clientKey, files.caCertificate)
+	require.Nil(t, err)
+	clientTLSConfig.Certificates = nil
+	args := createMockArgsRemoteSigningHandler()
+	args.URL = server.URL
+	args.TLSConfig = clientTLSConfig
+	rsh, _ := remoteSigner.NewRemoteSigningHandler(args)
+
+	sig, err := rsh.SignPeerMessage(pkBytes, []byte("pid"))
+	assert.Nil(t, sig)
+	assert.NotNil(t, err)
+}
+
+func TestCreateServerTLSConfig_InvalidCACertificateShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "remotesigner")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	files := createCertificateFiles(t, dir)
+	_ = ioutil.WriteFile(files.caCertificate, []byte("not a certificate"), 0600)
+
+	tlsConfig, err := remoteSigner.CreateServerTLSConfig(files.serverCertificate, files.serverKey, files.caCertificate)
+	assert.Nil(t, tlsConfig)
+	assert.Equal(t, remoteSigner.ErrInvalidCACertificate, err)
+}
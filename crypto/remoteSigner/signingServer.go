@@ -0,0 +1,217 @@
+package remoteSigner
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/libp2p/go-libp2p-core/peer"
+)
+
+var log = logger.GetOrCreate("crypto/remotesigner")
+
+const maxRequestSizeInBytes = 1 << 20
+
+// ArgsSigningServer represents the arguments for the signing server
+type ArgsSigningServer struct {
+	SigningHandler    crypto.SigningHandler
+	WatermarksHandler WatermarksHandler
+	Marshalizer       marshal.Marshalizer
+	Hasher            hashing.Hasher
+}
+
+type signingServer struct {
+	signingHandler    crypto.SigningHandler
+	watermarksHandler WatermarksHandler
+	marshalizer       marshal.Marshalizer
+	hasher            hashing.Hasher
+}
+
+// NewSigningServer creates the HTTP handler of a signing service. The block related sign requests are checked
+// against the high watermarks of the epoch and round read from the signed header, so that the served keys never
+// double sign. The peer messages are only signed if they are well-formed peer IDs, so that this path can not be
+// used in order to obtain the signature of a header or of a random seed
+func NewSigningServer(args ArgsSigningServer) (*signingServer, error) {
+	if check.IfNil(args.SigningHandler) {
+		return nil, crypto.ErrNilSigningHandler
+	}
+	if check.IfNil(args.WatermarksHandler) {
+		return nil, ErrNilWatermarksHandler
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	return &signingServer{
+		signingHandler:    args.SigningHandler,
+		watermarksHandler: args.WatermarksHandler,
+		marshalizer:       args.Marshalizer,
+		hasher:            args.Hasher,
+	}, nil
+}
+
+// ServeHTTP handles the sign requests
+func (ss *signingServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != SignPath {
+		ss.writeResponse(w, http.StatusNotFound, &SignResponse{Error: "unknown path"})
+		return
+	}
+	if r.Method != http.MethodPost {
+		ss.writeResponse(w, http.StatusMethodNotAllowed, &SignResponse{Error: "method not allowed"})
+		return
+	}
+
+	request := &SignRequest{}
+	err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestSizeInBytes)).Decode(request)
+	if err != nil {
+		ss.writeResponse(w, http.StatusBadRequest, &SignResponse{Error: err.Error()})
+		return
+	}
+
+	signature, err := ss.sign(request)
+	if err != nil {
+		log.Debug("sign request rejected",
+			"type", request.Type,
+			"public key", core.GetTrimmedPk(fmt.Sprintf("%x", request.PubKey)),
+			"error", err.Error())
+		ss.writeResponse(w, getStatusCode(err), &SignResponse{Error: err.Error()})
+		return
+	}
+
+	ss.writeResponse(w, http.StatusOK, &SignResponse{Signature: signature})
+}
+
+func (ss *signingServer) sign(request *SignRequest) ([]byte, error) {
+	if request.Type == PeerMessageSignType {
+		err := checkPeerID(request.Message)
+		if err != nil {
+			return nil, err
+		}
+
+		return ss.signingHandler.SignPeerMessage(request.PubKey, request.Message)
+	}
+
+	header, err := ss.getSignedHeader(request)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ss.watermarksHandler.CheckAndUpdate(request.PubKey, request.Type, header.GetEpoch(), header.GetRound(), request.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	switch request.Type {
+	case RandSeedSignType:
+		return ss.signingHandler.SignRandSeed(request.PubKey, request.Message, request.Header)
+	case BlockHeaderSignType:
+		return ss.signingHandler.SignBlockHeader(request.PubKey, request.Message)
+	default:
+		return ss.signingHandler.CreateSignatureShare(request.PubKey, request.Message, request.Header)
+	}
+}
+
+// getSignedHeader decodes the header the sign request refers to and checks that the message to be signed belongs to it
+func (ss *signingServer) getSignedHeader(request *SignRequest) (data.HeaderHandler, error) {
+	switch request.Type {
+	case RandSeedSignType:
+		header, err := ss.decodeHeader(request.Header)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(header.GetPrevRandSeed(), request.Message) {
+			return nil, fmt.Errorf("%w: the random seed is not the previous random seed of the header", ErrInvalidSignPayload)
+		}
+
+		return header, nil
+	case BlockHeaderSignType:
+		return ss.decodeHeader(request.Message)
+	case SignatureShareSignType:
+		header, err := ss.decodeHeader(request.Header)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(ss.hasher.Compute(string(request.Header)), request.Message) {
+			return nil, fmt.Errorf("%w: the message is not the hash of the header", ErrInvalidSignPayload)
+		}
+
+		return header, nil
+	default:
+		return nil, fmt.Errorf("%w %s", ErrUnknownSignType, request.Type)
+	}
+}
+
+// decodeHeader decodes the provided buffer as a shard header or as a metachain header. As the fields of the two
+// headers have different wire types, a buffer decoding as both of them is ambiguous and gets rejected
+func (ss *signingServer) decodeHeader(buff []byte) (data.HeaderHandler, error) {
+	if len(buff) == 0 {
+		return nil, fmt.Errorf("%w: empty header", ErrInvalidSignPayload)
+	}
+
+	shardHeader := &block.Header{}
+	errShard := ss.marshalizer.Unmarshal(shardHeader, buff)
+	metaHeader := &block.MetaBlock{}
+	errMeta := ss.marshalizer.Unmarshal(metaHeader, buff)
+
+	switch {
+	case errShard == nil && errMeta != nil:
+		return shardHeader, nil
+	case errShard != nil && errMeta == nil:
+		return metaHeader, nil
+	default:
+		return nil, fmt.Errorf("%w: the header can not be decoded", ErrInvalidSignPayload)
+	}
+}
+
+// checkPeerID checks that the message is a peer ID having the public key inlined, as the ones used by the nodes are.
+// Such an ID starts with the zero byte of the identity multihash, so it can not be decoded as a header
+func checkPeerID(message []byte) error {
+	pid, err := peer.IDFromBytes(message)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidSignPayload, err.Error())
+	}
+
+	_, err = pid.ExtractPublicKey()
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidSignPayload, err.Error())
+	}
+
+	return nil
+}
+
+func getStatusCode(err error) int {
+	switch {
+	case errors.Is(err, ErrUnknownSignType), errors.Is(err, ErrInvalidSignPayload):
+		return http.StatusBadRequest
+	case errors.Is(err, ErrDoubleSigning), errors.Is(err, ErrBelowWatermark):
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func (ss *signingServer) writeResponse(w http.ResponseWriter, statusCode int, response *SignResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	err := json.NewEncoder(w).Encode(response)
+	log.LogIfError(err)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ss *signingServer) IsInterfaceNil() bool {
+	return ss == nil
+}
@@ -0,0 +1,301 @@
+package remoteSigner_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/remoteSigner"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	libp2pCrypto "github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+var testMarshalizer = &marshal.GogoProtoMarshalizer{}
+var testHasher = sha256.Sha256{}
+
+type watermarksHandlerStub struct {
+	CheckAndUpdateCalled func(pubKey []byte, signType string, epoch uint32, round uint64, message []byte) error
+}
+
+func (whs *watermarksHandlerStub) CheckAndUpdate(pubKey []byte, signType string, epoch uint32, round uint64, message []byte) error {
+	if whs.CheckAndUpdateCalled != nil {
+		return whs.CheckAndUpdateCalled(pubKey, signType, epoch, round, message)
+	}
+
+	return nil
+}
+
+func (whs *watermarksHandlerStub) IsInterfaceNil() bool {
+	return whs == nil
+}
+
+func createMockArgsSigningServer() remoteSigner.ArgsSigningServer {
+	return remoteSigner.ArgsSigningServer{
+		SigningHandler:    &testscommon.SigningHandlerStub{},
+		WatermarksHandler: &watermarksHandlerStub{},
+		Marshalizer:       testMarshalizer,
+		Hasher:            testHasher,
+	}
+}
+
+func marshalHeader(header data.HeaderHandler) []byte {
+	buff, _ := testMarshalizer.Marshal(header)
+	return buff
+}
+
+func createPeerID() []byte {
+	_, publicKey, _ := libp2pCrypto.GenerateSecp256k1Key(rand.Reader)
+	pid, _ := peer.IDFromPublicKey(publicKey)
+	return []byte(pid)
+}
+
+func sendSignRequest(handler http.Handler, method string, path string, body []byte) (*httptest.ResponseRecorder, *remoteSigner.SignResponse) {
+	request := httptest.NewRequest(method, path, bytes.NewReader(body))
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+
+	response := &remoteSigner.SignResponse{}
+	_ = json.Unmarshal(recorder.Body.Bytes(), response)
+
+	return recorder, response
+}
+
+func marshalSignRequest(request *remoteSigner.SignRequest) []byte {
+	buff, _ := json.Marshal(request)
+	return buff
+}
+
+func TestNewSigningServer_NilSigningHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSigningServer()
+	args.SigningHandler = nil
+	ss, err := remoteSigner.NewSigningServer(args)
+
+	assert.True(t, check.IfNil(ss))
+	assert.Equal(t, crypto.ErrNilSigningHandler, err)
+}
+
+func TestNewSigningServer_NilWatermarksHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSigningServer()
+	args.WatermarksHandler = nil
+	ss, err := remoteSigner.NewSigningServer(args)
+
+	assert.True(t, check.IfNil(ss))
+	assert.Equal(t, remoteSigner.ErrNilWatermarksHandler, err)
+}
+
+func TestNewSigningServer_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSigningServer()
+	args.Marshalizer = nil
+	ss, err := remoteSigner.NewSigningServer(args)
+
+	assert.True(t, check.IfNil(ss))
+	assert.Equal(t, remoteSigner.ErrNilMarshalizer, err)
+}
+
+func TestNewSigningServer_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSigningServer()
+	args.Hasher = nil
+	ss, err := remoteSigner.NewSigningServer(args)
+
+	assert.True(t, check.IfNil(ss))
+	assert.Equal(t, remoteSigner.ErrNilHasher, err)
+}
+
+func TestSigningServer_ServeHTTPInvalidRequestsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ss, _ := remoteSigner.NewSigningServer(createMockArgsSigningServer())
+	validRequest := marshalSignRequest(&remoteSigner.SignRequest{Type: remoteSigner.PeerMessageSignType})
+
+	recorder, _ := sendSignRequest(ss, http.MethodPost, "/unknown", validRequest)
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+
+	recorder, _ = sendSignRequest(ss, http.MethodGet, remoteSigner.SignPath, validRequest)
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+
+	recorder, _ = sendSignRequest(ss, http.MethodPost, remoteSigner.SignPath, []byte("not a json"))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	unknownTypeRequest := marshalSignRequest(&remoteSigner.SignRequest{Type: "unknown"})
+	recorder, response := sendSignRequest(ss, http.MethodPost, remoteSigner.SignPath, unknownTypeRequest)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Contains(t, response.Error, remoteSigner.ErrUnknownSignType.Error())
+}
+
+func TestSigningServer_ServeHTTPPeerMessageShouldNotCheckWatermarks(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSigningServer()
+	args.WatermarksHandler = &watermarksHandlerStub{
+		CheckAndUpdateCalled: func(pubKey []byte, signType string, epoch uint32, round uint64, message []byte) error {
+			assert.Fail(t, "should have not checked the watermarks")
+			return nil
+		},
+	}
+	args.SigningHandler = &testscommon.SigningHandlerStub{
+		SignPeerMessageCalled: func(pubKey []byte, message []byte) ([]byte, error) {
+			return append([]byte("signed "), message...), nil
+		},
+	}
+	ss, _ := remoteSigner.NewSigningServer(args)
+
+	pid := createPeerID()
+	request := marshalSignRequest(&remoteSigner.SignRequest{
+		Type:    remoteSigner.PeerMessageSignType,
+		PubKey:  []byte("pk"),
+		Message: pid,
+	})
+	recorder, response := sendSignRequest(ss, http.MethodPost, remoteSigner.SignPath, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, append([]byte("signed "), pid...), response.Signature)
+}
+
+func TestSigningServer_ServeHTTPPeerMessageNotBeingAPeerIDShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSigningServer()
+	args.SigningHandler = &testscommon.SigningHandlerStub{
+		SignPeerMessageCalled: func(pubKey []byte, message []byte) ([]byte, error) {
+			assert.Fail(t, "should have not signed the message")
+			return nil, nil
+		},
+	}
+	ss, _ := remoteSigner.NewSigningServer(args)
+
+	messages := [][]byte{
+		[]byte("pid"),
+		marshalHeader(&block.Header{Round: 10, PrevRandSeed: []byte("seed")}),
+		[]byte("previous rand seed"),
+	}
+	for _, message := range messages {
+		request := marshalSignRequest(&remoteSigner.SignRequest{
+			Type:    remoteSigner.PeerMessageSignType,
+			PubKey:  []byte("pk"),
+			Message: message,
+		})
+		recorder, response := sendSignRequest(ss, http.MethodPost, remoteSigner.SignPath, request)
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.Contains(t, response.Error, remoteSigner.ErrInvalidSignPayload.Error())
+	}
+}
+
+func TestSigningServer_ServeHTTPShouldTakeTheEpochAndRoundFromTheHeader(t *testing.T) {
+	t.Parallel()
+
+	shardHeader := marshalHeader(&block.Header{Epoch: 2, Round: 20, PrevRandSeed: []byte("seed")})
+	metaHeader := marshalHeader(&block.MetaBlock{Epoch: 3, Round: 30, PrevRandSeed: []byte("meta seed")})
+
+	type checkedWatermark struct {
+		epoch uint32
+		round uint64
+	}
+	checked := make([]checkedWatermark, 0)
+	args := createMockArgsSigningServer()
+	args.WatermarksHandler = &watermarksHandlerStub{
+		CheckAndUpdateCalled: func(pubKey []byte, signType string, epoch uint32, round uint64, message []byte) error {
+			checked = append(checked, checkedWatermark{epoch: epoch, round: round})
+			return nil
+		},
+	}
+	ss, _ := remoteSigner.NewSigningServer(args)
+
+	requests := []*remoteSigner.SignRequest{
+		{Type: remoteSigner.BlockHeaderSignType, Message: shardHeader},
+		{Type: remoteSigner.RandSeedSignType, Message: []byte("meta seed"), Header: metaHeader},
+		{Type: remoteSigner.SignatureShareSignType, Message: testHasher.Compute(string(shardHeader)), Header: shardHeader},
+	}
+	for _, request := range requests {
+		request.PubKey = []byte("pk")
+		recorder, _ := sendSignRequest(ss, http.MethodPost, remoteSigner.SignPath, marshalSignRequest(request))
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	}
+
+	expected := []checkedWatermark{{epoch: 2, round: 20}, {epoch: 3, round: 30}, {epoch: 2, round: 20}}
+	assert.Equal(t, expected, checked)
+}
+
+func TestSigningServer_ServeHTTPMessageNotMatchingTheHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSigningServer()
+	args.WatermarksHandler = &watermarksHandlerStub{
+		CheckAndUpdateCalled: func(pubKey []byte, signType string, epoch uint32, round uint64, message []byte) error {
+			assert.Fail(t, "should have not checked the watermarks")
+			return nil
+		},
+	}
+	ss, _ := remoteSigner.NewSigningServer(args)
+
+	header := marshalHeader(&block.Header{Epoch: 2, Round: 20, PrevRandSeed: []byte("seed")})
+	requests := []*remoteSigner.SignRequest{
+		{Type: remoteSigner.BlockHeaderSignType, Message: []byte("not a header")},
+		{Type: remoteSigner.BlockHeaderSignType},
+		{Type: remoteSigner.RandSeedSignType, Message: []byte("other seed"), Header: header},
+		{Type: remoteSigner.RandSeedSignType, Message: []byte("seed")},
+		{Type: remoteSigner.SignatureShareSignType, Message: []byte("other block"), Header: header},
+	}
+	for _, request := range requests {
+		request.PubKey = []byte("pk")
+		recorder, response := sendSignRequest(ss, http.MethodPost, remoteSigner.SignPath, marshalSignRequest(request))
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.Contains(t, response.Error, remoteSigner.ErrInvalidSignPayload.Error())
+	}
+}
+
+func TestSigningServer_ServeHTTPShouldRejectDoubleSigning(t *testing.T) {
+	t.Parallel()
+
+	wt, _ := remoteSigner.NewWatermarksTracker(createMockArgsWatermarksTracker())
+	numSignatures := 0
+	args := createMockArgsSigningServer()
+	args.WatermarksHandler = wt
+	args.SigningHandler = &testscommon.SigningHandlerStub{
+		CreateSignatureShareCalled: func(pubKey []byte, headerHash []byte, marshalizedHeader []byte) ([]byte, error) {
+			numSignatures++
+			return []byte("signature share"), nil
+		},
+	}
+	ss, _ := remoteSigner.NewSigningServer(args)
+
+	createRequest := func(header *block.Header) []byte {
+		headerBytes := marshalHeader(header)
+		return marshalSignRequest(&remoteSigner.SignRequest{
+			Type:    remoteSigner.SignatureShareSignType,
+			PubKey:  []byte("pk"),
+			Message: testHasher.Compute(string(headerBytes)),
+			Header:  headerBytes,
+		})
+	}
+
+	recorder, response := sendSignRequest(ss, http.MethodPost, remoteSigner.SignPath, createRequest(&block.Header{Epoch: 1, Round: 10, Nonce: 1}))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, []byte("signature share"), response.Signature)
+
+	recorder, response = sendSignRequest(ss, http.MethodPost, remoteSigner.SignPath, createRequest(&block.Header{Epoch: 1, Round: 10, Nonce: 2}))
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+	assert.Nil(t, response.Signature)
+	assert.Contains(t, response.Error, remoteSigner.ErrDoubleSigning.Error())
+
+	recorder, _ = sendSignRequest(ss, http.MethodPost, remoteSigner.SignPath, createRequest(&block.Header{Epoch: 1, Round: 9, Nonce: 2}))
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+	assert.Equal(t, 1, numSignatures)
+}
@@ -0,0 +1,57 @@
+package remoteSigner
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+)
+
+// CreateServerTLSConfig creates the TLS config of a signing service, which requires the clients to present a
+// certificate signed by the provided CA
+func CreateServerTLSConfig(certificateFile string, keyFile string, caCertificateFile string) (*tls.Config, error) {
+	certificate, caPool, err := loadCertificates(certificateFile, keyFile, caCertificateFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		ClientCAs:    caPool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// CreateClientTLSConfig creates the TLS config of a signing service client, which authenticates with the provided
+// certificate and accepts only the signing services with a certificate signed by the provided CA
+func CreateClientTLSConfig(certificateFile string, keyFile string, caCertificateFile string) (*tls.Config, error) {
+	certificate, caPool, err := loadCertificates(certificateFile, keyFile, caCertificateFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		RootCAs:      caPool,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+func loadCertificates(certificateFile string, keyFile string, caCertificateFile string) (tls.Certificate, *x509.CertPool, error) {
+	certificate, err := tls.LoadX509KeyPair(certificateFile, keyFile)
+	if err != nil {
+		return tls.Certificate{}, nil, err
+	}
+
+	caCertificate, err := ioutil.ReadFile(caCertificateFile)
+	if err != nil {
+		return tls.Certificate{}, nil, err
+	}
+
+	caPool := x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM(caCertificate) {
+		return tls.Certificate{}, nil, ErrInvalidCACertificate
+	}
+
+	return certificate, caPool, nil
+}
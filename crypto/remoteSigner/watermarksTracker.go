@@ -0,0 +1,149 @@
+package remoteSigner
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+)
+
+// ArgsWatermarksTracker represents the arguments for the watermarks tracker
+type ArgsWatermarksTracker struct {
+	FilePath string
+	Hasher   hashing.Hasher
+}
+
+type watermark struct {
+	Epoch       uint32 `json:"epoch"`
+	Round       uint64 `json:"round"`
+	MessageHash []byte `json:"messageHash"`
+}
+
+type watermarksTracker struct {
+	mut        sync.Mutex
+	filePath   string
+	hasher     hashing.Hasher
+	watermarks map[string]*watermark
+}
+
+// NewWatermarksTracker creates a new watermarks tracker. The watermarks are persisted in the provided file after each
+// update and loaded from it at creation, so they survive restarts. An empty file path keeps the watermarks in memory
+func NewWatermarksTracker(args ArgsWatermarksTracker) (*watermarksTracker, error) {
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	wt := &watermarksTracker{
+		filePath:   args.FilePath,
+		hasher:     args.Hasher,
+		watermarks: make(map[string]*watermark),
+	}
+
+	err := wt.load()
+	if err != nil {
+		return nil, err
+	}
+
+	return wt, nil
+}
+
+// CheckAndUpdate raises the watermark of the public key and sign type to the provided epoch and round. It errors if
+// the epoch and round are lower than the watermark or if a different message was already signed for them
+func (wt *watermarksTracker) CheckAndUpdate(pubKey []byte, signType string, epoch uint32, round uint64, message []byte) error {
+	key := signType + "_" + hex.EncodeToString(pubKey)
+	messageHash := wt.hasher.Compute(string(message))
+
+	wt.mut.Lock()
+	defer wt.mut.Unlock()
+
+	current, exists := wt.watermarks[key]
+	if exists {
+		isBelowWatermark := epoch < current.Epoch || (epoch == current.Epoch && round < current.Round)
+		if isBelowWatermark {
+			return fmt.Errorf("%w, requested epoch %d round %d, watermark epoch %d round %d",
+				ErrBelowWatermark, epoch, round, current.Epoch, current.Round)
+		}
+
+		isSameRound := epoch == current.Epoch && round == current.Round
+		if isSameRound {
+			if !bytes.Equal(messageHash, current.MessageHash) {
+				return fmt.Errorf("%w, epoch %d round %d", ErrDoubleSigning, epoch, round)
+			}
+
+			return nil
+		}
+	}
+
+	wt.watermarks[key] = &watermark{
+		Epoch:       epoch,
+		Round:       round,
+		MessageHash: messageHash,
+	}
+
+	err := wt.save()
+	if err != nil {
+		// the sign request is refused, as the new watermark would not survive a restart
+		wt.revert(key, current)
+		return err
+	}
+
+	return nil
+}
+
+func (wt *watermarksTracker) revert(key string, previous *watermark) {
+	if previous == nil {
+		delete(wt.watermarks, key)
+		return
+	}
+
+	wt.watermarks[key] = previous
+}
+
+func (wt *watermarksTracker) load() error {
+	if len(wt.filePath) == 0 {
+		return nil
+	}
+
+	buff, err := ioutil.ReadFile(wt.filePath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(buff, &wt.watermarks)
+}
+
+// save writes the watermarks in a temporary file which then replaces the watermarks file, so that the file is never
+// left partially written
+func (wt *watermarksTracker) save() error {
+	if len(wt.filePath) == 0 {
+		return nil
+	}
+
+	buff, err := json.Marshal(wt.watermarks)
+	if err != nil {
+		return err
+	}
+
+	tempFilePath := wt.filePath + ".tmp"
+	err = ioutil.WriteFile(tempFilePath, buff, core.FileModeUserReadWrite)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tempFilePath, wt.filePath)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (wt *watermarksTracker) IsInterfaceNil() bool {
+	return wt == nil
+}
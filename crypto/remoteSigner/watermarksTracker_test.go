@@ -0,0 +1,135 @@
+package remoteSigner_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto/remoteSigner"
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsWatermarksTracker() remoteSigner.ArgsWatermarksTracker {
+	return remoteSigner.ArgsWatermarksTracker{
+		FilePath: "",
+		Hasher:   sha256.Sha256{},
+	}
+}
+
+func TestNewWatermarksTracker_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsWatermarksTracker()
+	args.Hasher = nil
+	wt, err := remoteSigner.NewWatermarksTracker(args)
+
+	assert.True(t, check.IfNil(wt))
+	assert.Equal(t, remoteSigner.ErrNilHasher, err)
+}
+
+func TestNewWatermarksTracker_InvalidFileShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "watermarks")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	filePath := filepath.Join(dir, "watermarks.json")
+	_ = ioutil.WriteFile(filePath, []byte("not a json"), 0600)
+
+	args := createMockArgsWatermarksTracker()
+	args.FilePath = filePath
+	wt, err := remoteSigner.NewWatermarksTracker(args)
+
+	assert.True(t, check.IfNil(wt))
+	assert.NotNil(t, err)
+}
+
+func TestNewWatermarksTracker_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	wt, err := remoteSigner.NewWatermarksTracker(createMockArgsWatermarksTracker())
+
+	assert.False(t, check.IfNil(wt))
+	assert.Nil(t, err)
+}
+
+func TestWatermarksTracker_CheckAndUpdateSameRoundShouldAllowOnlyTheSameMessage(t *testing.T) {
+	t.Parallel()
+
+	wt, _ := remoteSigner.NewWatermarksTracker(createMockArgsWatermarksTracker())
+
+	err := wt.CheckAndUpdate([]byte("pk"), remoteSigner.SignatureShareSignType, 1, 10, []byte("block A"))
+	assert.Nil(t, err)
+
+	err = wt.CheckAndUpdate([]byte("pk"), remoteSigner.SignatureShareSignType, 1, 10, []byte("block A"))
+	assert.Nil(t, err)
+
+	err = wt.CheckAndUpdate([]byte("pk"), remoteSigner.SignatureShareSignType, 1, 10, []byte("block B"))
+	assert.True(t, errors.Is(err, remoteSigner.ErrDoubleSigning))
+}
+
+func TestWatermarksTracker_CheckAndUpdateBelowWatermarkShouldErr(t *testing.T) {
+	t.Parallel()
+
+	wt, _ := remoteSigner.NewWatermarksTracker(createMockArgsWatermarksTracker())
+
+	err := wt.CheckAndUpdate([]byte("pk"), remoteSigner.BlockHeaderSignType, 2, 10, []byte("block A"))
+	assert.Nil(t, err)
+
+	err = wt.CheckAndUpdate([]byte("pk"), remoteSigner.BlockHeaderSignType, 2, 9, []byte("block A"))
+	assert.True(t, errors.Is(err, remoteSigner.ErrBelowWatermark))
+
+	err = wt.CheckAndUpdate([]byte("pk"), remoteSigner.BlockHeaderSignType, 1, 11, []byte("block B"))
+	assert.True(t, errors.Is(err, remoteSigner.ErrBelowWatermark))
+
+	err = wt.CheckAndUpdate([]byte("pk"), remoteSigner.BlockHeaderSignType, 2, 11, []byte("block B"))
+	assert.Nil(t, err)
+
+	err = wt.CheckAndUpdate([]byte("pk"), remoteSigner.BlockHeaderSignType, 3, 1, []byte("block C"))
+	assert.Nil(t, err)
+}
+
+func TestWatermarksTracker_CheckAndUpdateShouldTrackKeysAndTypesSeparately(t *testing.T) {
+	t.Parallel()
+
+	wt, _ := remoteSigner.NewWatermarksTracker(createMockArgsWatermarksTracker())
+
+	err := wt.CheckAndUpdate([]byte("pk1"), remoteSigner.RandSeedSignType, 1, 10, []byte("seed"))
+	assert.Nil(t, err)
+
+	err = wt.CheckAndUpdate([]byte("pk1"), remoteSigner.BlockHeaderSignType, 1, 10, []byte("block"))
+	assert.Nil(t, err)
+
+	err = wt.CheckAndUpdate([]byte("pk2"), remoteSigner.RandSeedSignType, 1, 10, []byte("other seed"))
+	assert.Nil(t, err)
+}
+
+func TestWatermarksTracker_WatermarksShouldSurviveRestarts(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "watermarks")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	args := createMockArgsWatermarksTracker()
+	args.FilePath = filepath.Join(dir, "watermarks.json")
+	wt, _ := remoteSigner.NewWatermarksTracker(args)
+	err := wt.CheckAndUpdate([]byte("pk"), remoteSigner.SignatureShareSignType, 1, 10, []byte("block A"))
+	require.Nil(t, err)
+
+	wt, err = remoteSigner.NewWatermarksTracker(args)
+	require.Nil(t, err)
+
+	err = wt.CheckAndUpdate([]byte("pk"), remoteSigner.SignatureShareSignType, 1, 10, []byte("block B"))
+	assert.True(t, errors.Is(err, remoteSigner.ErrDoubleSigning))
+
+	err = wt.CheckAndUpdate([]byte("pk"), remoteSigner.SignatureShareSignType, 1, 9, []byte("block C"))
+	assert.True(t, errors.Is(err, remoteSigner.ErrBelowWatermark))
+}
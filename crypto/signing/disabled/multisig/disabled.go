@@ -38,6 +38,11 @@ func (dms *DisabledMultiSig) CreateSignatureShare(_ []byte, _ []byte) ([]byte, e
 	return []byte(signature), nil
 }
 
+// CreateSignatureShareWithPrivateKey returns a mock signature value
+func (dms *DisabledMultiSig) CreateSignatureShareWithPrivateKey(_ []byte, _ crypto.PrivateKey) ([]byte, error) {
+	return []byte(signature), nil
+}
+
 // StoreSignatureShare returns nil
 func (dms *DisabledMultiSig) StoreSignatureShare(_ uint16, _ []byte) error {
 	return nil
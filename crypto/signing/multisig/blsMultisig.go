@@ -148,6 +148,16 @@ func (bms *blsMultiSigner) CreateSignatureShare(message []byte, _ []byte) ([]byt
 	return sigShareBytes, nil
 }
 
+// CreateSignatureShareWithPrivateKey returns a BLS single signature over the message, created with the provided
+// private key. The signature is not stored as the own signature share
+func (bms *blsMultiSigner) CreateSignatureShareWithPrivateKey(message []byte, privateKey crypto.PrivateKey) ([]byte, error) {
+	if check.IfNil(privateKey) {
+		return nil, crypto.ErrNilPrivateKey
+	}
+
+	return bms.llSigner.SignShare(privateKey, message)
+}
+
 // not concurrent safe, should be used under RLock mutex
 func (bms *blsMultiSigner) isIndexInBitmap(index uint16, bitmap []byte) error {
 	indexOutOfBounds := index >= uint16(len(bms.data.pubKeys))
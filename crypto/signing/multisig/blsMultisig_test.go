@@ -375,6 +375,41 @@ func TestBLSMultiSigner_CreateSignatureShareOK(t *testing.T) {
 	assert.Nil(t, verifErr)
 }
 
+func TestBLSMultiSigner_CreateSignatureShareWithPrivateKeyNilKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ownIndex := uint16(3)
+	privKey, _, pubKeys, kg, llSigner := genMultiSigParamsBLS(4, ownIndex)
+
+	multiSig, _ := multisig.NewBLSMultisig(llSigner, pubKeys, privKey, kg, ownIndex)
+	sigShare, err := multiSig.CreateSignatureShareWithPrivateKey([]byte("message"), nil)
+
+	assert.Nil(t, sigShare)
+	assert.Equal(t, crypto.ErrNilPrivateKey, err)
+}
+
+func TestBLSMultiSigner_CreateSignatureShareWithPrivateKeyOK(t *testing.T) {
+	t.Parallel()
+
+	ownIndex := uint16(3)
+	privKey, _, pubKeys, kg, llSigner := genMultiSigParamsBLS(4, ownIndex)
+	otherPrivKey, otherPubKey := kg.GeneratePair()
+	otherPubKeyBytes, _ := otherPubKey.ToByteArray()
+	pubKeys[0] = string(otherPubKeyBytes)
+
+	multiSig, _ := multisig.NewBLSMultisig(llSigner, pubKeys, privKey, kg, ownIndex)
+	msg := []byte("message")
+	sigShare, err := multiSig.CreateSignatureShareWithPrivateKey(msg, otherPrivKey)
+	assert.Nil(t, err)
+	assert.NotNil(t, sigShare)
+
+	verifErr := multiSig.VerifySignatureShare(0, sigShare, msg, []byte(""))
+	assert.Nil(t, verifErr)
+
+	_, err = multiSig.SignatureShare(ownIndex)
+	assert.Equal(t, crypto.ErrNilElement, err)
+}
+
 func TestBLSMultiSigner_VerifySignatureShareNilSigShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,9 @@
+package signingHandler
+
+import "github.com/ElrondNetwork/elrond-go/crypto"
+
+// KeysHandler defines the component able to provide the private keys of the additional public keys the node acts for
+type KeysHandler interface {
+	GetPrivateKey(pkBytes []byte) (crypto.PrivateKey, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,103 @@
+package signingHandler
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+)
+
+var _ crypto.SigningHandler = (*signingHandler)(nil)
+
+// ArgsSigningHandler represents the arguments for the in-process signing handler
+type ArgsSigningHandler struct {
+	PrivateKey     crypto.PrivateKey
+	KeysHandler    KeysHandler
+	SingleSigner   crypto.SingleSigner
+	LowLevelSigner crypto.LowLevelSignerBLS
+}
+
+type signingHandler struct {
+	privateKey     crypto.PrivateKey
+	publicKey      []byte
+	keysHandler    KeysHandler
+	singleSigner   crypto.SingleSigner
+	lowLevelSigner crypto.LowLevelSignerBLS
+}
+
+// NewSigningHandler creates a signing handler which signs in-process with the node's private key or with the private
+// keys of the managed public keys
+func NewSigningHandler(args ArgsSigningHandler) (*signingHandler, error) {
+	if check.IfNil(args.PrivateKey) {
+		return nil, crypto.ErrNilPrivateKey
+	}
+	if check.IfNil(args.KeysHandler) {
+		return nil, crypto.ErrNilKeysHandler
+	}
+	if check.IfNil(args.SingleSigner) {
+		return nil, crypto.ErrNilSingleSigner
+	}
+	if args.LowLevelSigner == nil {
+		return nil, crypto.ErrNilLowLevelSigner
+	}
+
+	publicKey, err := args.PrivateKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	return &signingHandler{
+		privateKey:     args.PrivateKey,
+		publicKey:      publicKey,
+		keysHandler:    args.KeysHandler,
+		singleSigner:   args.SingleSigner,
+		lowLevelSigner: args.LowLevelSigner,
+	}, nil
+}
+
+// SignRandSeed signs the previous random seed with the private key of the provided public key
+func (sh *signingHandler) SignRandSeed(pubKey []byte, prevRandSeed []byte, _ []byte) ([]byte, error) {
+	return sh.sign(pubKey, prevRandSeed)
+}
+
+// SignBlockHeader signs the marshalized header with the private key of the provided public key
+func (sh *signingHandler) SignBlockHeader(pubKey []byte, marshalizedHeader []byte) ([]byte, error) {
+	return sh.sign(pubKey, marshalizedHeader)
+}
+
+// CreateSignatureShare creates the signature share with the private key of the provided public key
+func (sh *signingHandler) CreateSignatureShare(pubKey []byte, headerHash []byte, _ []byte) ([]byte, error) {
+	privateKey, err := sh.getPrivateKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return sh.lowLevelSigner.SignShare(privateKey, headerHash)
+}
+
+// SignPeerMessage signs the message with the private key of the provided public key
+func (sh *signingHandler) SignPeerMessage(pubKey []byte, message []byte) ([]byte, error) {
+	return sh.sign(pubKey, message)
+}
+
+func (sh *signingHandler) sign(pubKey []byte, message []byte) ([]byte, error) {
+	privateKey, err := sh.getPrivateKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return sh.singleSigner.Sign(privateKey, message)
+}
+
+func (sh *signingHandler) getPrivateKey(pubKey []byte) (crypto.PrivateKey, error) {
+	if bytes.Equal(pubKey, sh.publicKey) {
+		return sh.privateKey, nil
+	}
+
+	return sh.keysHandler.GetPrivateKey(pubKey)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sh *signingHandler) IsInterfaceNil() bool {
+	return sh == nil
+}
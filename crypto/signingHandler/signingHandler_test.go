@@ -0,0 +1,161 @@
+package signingHandler_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/mock"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl"
+	llsig "github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/multisig"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/singlesig"
+	"github.com/ElrondNetwork/elrond-go/crypto/signingHandler"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgs() signingHandler.ArgsSigningHandler {
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	privateKey, _ := keyGen.GeneratePair()
+
+	return signingHandler.ArgsSigningHandler{
+		PrivateKey:     privateKey,
+		KeysHandler:    &testscommon.KeysHandlerStub{},
+		SingleSigner:   &singlesig.BlsSingleSigner{},
+		LowLevelSigner: &llsig.BlsMultiSigner{Hasher: &mock.HasherSpongeMock{}},
+	}
+}
+
+func TestNewSigningHandler_NilPrivateKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.PrivateKey = nil
+	sh, err := signingHandler.NewSigningHandler(args)
+
+	assert.True(t, check.IfNil(sh))
+	assert.Equal(t, crypto.ErrNilPrivateKey, err)
+}
+
+func TestNewSigningHandler_NilKeysHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.KeysHandler = nil
+	sh, err := signingHandler.NewSigningHandler(args)
+
+	assert.True(t, check.IfNil(sh))
+	assert.Equal(t, crypto.ErrNilKeysHandler, err)
+}
+
+func TestNewSigningHandler_NilSingleSignerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.SingleSigner = nil
+	sh, err := signingHandler.NewSigningHandler(args)
+
+	assert.True(t, check.IfNil(sh))
+	assert.Equal(t, crypto.ErrNilSingleSigner, err)
+}
+
+func TestNewSigningHandler_NilLowLevelSignerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.LowLevelSigner = nil
+	sh, err := signingHandler.NewSigningHandler(args)
+
+	assert.True(t, check.IfNil(sh))
+	assert.Equal(t, crypto.ErrNilLowLevelSigner, err)
+}
+
+func TestNewSigningHandler_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	sh, err := signingHandler.NewSigningHandler(createMockArgs())
+
+	assert.False(t, check.IfNil(sh))
+	assert.Nil(t, err)
+}
+
+func TestSigningHandler_SignWithNodeKey(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.KeysHandler = &testscommon.KeysHandlerStub{
+		GetPrivateKeyCalled: func(pkBytes []byte) (crypto.PrivateKey, error) {
+			assert.Fail(t, "should have not called GetPrivateKey for the node's key")
+			return nil, nil
+		},
+	}
+	sh, _ := signingHandler.NewSigningHandler(args)
+	publicKey := args.PrivateKey.GeneratePublic()
+	pkBytes, _ := publicKey.ToByteArray()
+	message := []byte("message")
+
+	sig, err := sh.SignRandSeed(pkBytes, message, []byte("header"))
+	require.Nil(t, err)
+	assert.Nil(t, args.SingleSigner.Verify(publicKey, message, sig))
+
+	sig, err = sh.SignBlockHeader(pkBytes, message)
+	require.Nil(t, err)
+	assert.Nil(t, args.SingleSigner.Verify(publicKey, message, sig))
+
+	sig, err = sh.SignPeerMessage(pkBytes, message)
+	require.Nil(t, err)
+	assert.Nil(t, args.SingleSigner.Verify(publicKey, message, sig))
+
+	sig, err = sh.CreateSignatureShare(pkBytes, message, []byte("header"))
+	require.Nil(t, err)
+	assert.Nil(t, args.LowLevelSigner.VerifySigShare(publicKey, message, sig))
+}
+
+func TestSigningHandler_SignWithManagedKey(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	managedPrivateKey, managedPublicKey := keyGen.GeneratePair()
+	managedPkBytes, _ := managedPublicKey.ToByteArray()
+	args.KeysHandler = &testscommon.KeysHandlerStub{
+		GetPrivateKeyCalled: func(pkBytes []byte) (crypto.PrivateKey, error) {
+			assert.Equal(t, managedPkBytes, pkBytes)
+			return managedPrivateKey, nil
+		},
+	}
+	sh, _ := signingHandler.NewSigningHandler(args)
+	message := []byte("message")
+
+	sig, err := sh.SignBlockHeader(managedPkBytes, message)
+	require.Nil(t, err)
+	assert.Nil(t, args.SingleSigner.Verify(managedPublicKey, message, sig))
+
+	sig, err = sh.CreateSignatureShare(managedPkBytes, message, []byte("header"))
+	require.Nil(t, err)
+	assert.Nil(t, args.LowLevelSigner.VerifySigShare(managedPublicKey, message, sig))
+}
+
+func TestSigningHandler_SignWithUnknownKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createMockArgs()
+	args.KeysHandler = &testscommon.KeysHandlerStub{
+		GetPrivateKeyCalled: func(pkBytes []byte) (crypto.PrivateKey, error) {
+			return nil, expectedErr
+		},
+	}
+	sh, _ := signingHandler.NewSigningHandler(args)
+
+	sig, err := sh.SignPeerMessage([]byte("unknown"), []byte("message"))
+	assert.Nil(t, sig)
+	assert.Equal(t, expectedErr, err)
+
+	sig, err = sh.CreateSignatureShare([]byte("unknown"), []byte("message"), []byte("header"))
+	assert.Nil(t, sig)
+	assert.Equal(t, expectedErr, err)
+}
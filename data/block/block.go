@@ -75,6 +75,11 @@ func (h *Header) SetChainID(chainID []byte) {
 	h.ChainID = chainID
 }
 
+// SetReserved sets the reserved field of the header
+func (h *Header) SetReserved(reserved []byte) {
+	h.Reserved = reserved
+}
+
 // SetSoftwareVersion sets the software version of the header
 func (h *Header) SetSoftwareVersion(version []byte) {
 	h.SoftwareVersion = version
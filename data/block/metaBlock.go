@@ -77,6 +77,11 @@ func (m *MetaBlock) SetChainID(chainID []byte) {
 	m.ChainID = chainID
 }
 
+// SetReserved sets the reserved field of the block
+func (m *MetaBlock) SetReserved(reserved []byte) {
+	m.Reserved = reserved
+}
+
 // SetSoftwareVersion sets the software version of the block
 func (m *MetaBlock) SetSoftwareVersion(version []byte) {
 	m.SoftwareVersion = version
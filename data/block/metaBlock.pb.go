@@ -69,6 +69,28 @@ func (PeerAction) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_87b91ab531130b2b, []int{0}
 }
 
+// SlashingEvidenceType represents the kinds of equivocation that a validator can be slashed for
+type SlashingEvidenceType int32
+
+const (
+	DoubleSignedHeaders   SlashingEvidenceType = 0
+	DoubleSignatureShares SlashingEvidenceType = 1
+)
+
+var SlashingEvidenceType_name = map[int32]string{
+	0: "DoubleSignedHeaders",
+	1: "DoubleSignatureShares",
+}
+
+var SlashingEvidenceType_value = map[string]int32{
+	"DoubleSignedHeaders":   0,
+	"DoubleSignatureShares": 1,
+}
+
+func (SlashingEvidenceType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_87b91ab531130b2b, []int{1}
+}
+
 // PeerData holds information about actions taken by a peer:
 //  - a peer can register with an amount to become a validator
 //  - a peer can choose to deregister and get back the deposited value
@@ -535,39 +557,138 @@ func (m *EpochStart) GetEconomics() Economics {
 	return Economics{}
 }
 
+// SlashingEvidence holds the proof that a BLS key signed two different headers for the same shard and round:
+//  - for DoubleSignedHeaders the signatures are leader signatures over the marshalled headers
+//  - for DoubleSignatureShares the signatures are consensus signature shares over the hashes of the marshalled headers
+type SlashingEvidence struct {
+	Type            SlashingEvidenceType `protobuf:"varint,1,opt,name=Type,proto3,enum=proto.SlashingEvidenceType" json:"Type,omitempty"`
+	PubKey          []byte               `protobuf:"bytes,2,opt,name=PubKey,proto3" json:"PubKey,omitempty"`
+	ShardID         uint32               `protobuf:"varint,3,opt,name=ShardID,proto3" json:"ShardID,omitempty"`
+	Round           uint64               `protobuf:"varint,4,opt,name=Round,proto3" json:"Round,omitempty"`
+	FirstHeader     []byte               `protobuf:"bytes,5,opt,name=FirstHeader,proto3" json:"FirstHeader,omitempty"`
+	FirstSignature  []byte               `protobuf:"bytes,6,opt,name=FirstSignature,proto3" json:"FirstSignature,omitempty"`
+	SecondHeader    []byte               `protobuf:"bytes,7,opt,name=SecondHeader,proto3" json:"SecondHeader,omitempty"`
+	SecondSignature []byte               `protobuf:"bytes,8,opt,name=SecondSignature,proto3" json:"SecondSignature,omitempty"`
+}
+
+func (m *SlashingEvidence) Reset()      { *m = SlashingEvidence{} }
+func (*SlashingEvidence) ProtoMessage() {}
+func (*SlashingEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87b91ab531130b2b, []int{5}
+}
+func (m *SlashingEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashingEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SlashingEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashingEvidence.Merge(m, src)
+}
+func (m *SlashingEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashingEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashingEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashingEvidence proto.InternalMessageInfo
+
+func (m *SlashingEvidence) GetType() SlashingEvidenceType {
+	if m != nil {
+		return m.Type
+	}
+	return DoubleSignedHeaders
+}
+
+func (m *SlashingEvidence) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *SlashingEvidence) GetShardID() uint32 {
+	if m != nil {
+		return m.ShardID
+	}
+	return 0
+}
+
+func (m *SlashingEvidence) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *SlashingEvidence) GetFirstHeader() []byte {
+	if m != nil {
+		return m.FirstHeader
+	}
+	return nil
+}
+
+func (m *SlashingEvidence) GetFirstSignature() []byte {
+	if m != nil {
+		return m.FirstSignature
+	}
+	return nil
+}
+
+func (m *SlashingEvidence) GetSecondHeader() []byte {
+	if m != nil {
+		return m.SecondHeader
+	}
+	return nil
+}
+
+func (m *SlashingEvidence) GetSecondSignature() []byte {
+	if m != nil {
+		return m.SecondSignature
+	}
+	return nil
+}
+
 // MetaBlock holds the data that will be saved to the metachain each round
 type MetaBlock struct {
-	Nonce                  uint64            `protobuf:"varint,1,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
-	Epoch                  uint32            `protobuf:"varint,2,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
-	Round                  uint64            `protobuf:"varint,3,opt,name=Round,proto3" json:"Round,omitempty"`
-	TimeStamp              uint64            `protobuf:"varint,4,opt,name=TimeStamp,proto3" json:"TimeStamp,omitempty"`
-	ShardInfo              []ShardData       `protobuf:"bytes,5,rep,name=ShardInfo,proto3" json:"ShardInfo"`
-	PeerInfo               []PeerData        `protobuf:"bytes,6,rep,name=PeerInfo,proto3" json:"PeerInfo"`
-	Signature              []byte            `protobuf:"bytes,7,opt,name=Signature,proto3" json:"Signature,omitempty"`
-	LeaderSignature        []byte            `protobuf:"bytes,8,opt,name=LeaderSignature,proto3" json:"LeaderSignature,omitempty"`
-	PubKeysBitmap          []byte            `protobuf:"bytes,9,opt,name=PubKeysBitmap,proto3" json:"PubKeysBitmap,omitempty"`
-	PrevHash               []byte            `protobuf:"bytes,10,opt,name=PrevHash,proto3" json:"PrevHash,omitempty"`
-	PrevRandSeed           []byte            `protobuf:"bytes,11,opt,name=PrevRandSeed,proto3" json:"PrevRandSeed,omitempty"`
-	RandSeed               []byte            `protobuf:"bytes,12,opt,name=RandSeed,proto3" json:"RandSeed,omitempty"`
-	RootHash               []byte            `protobuf:"bytes,13,opt,name=RootHash,proto3" json:"RootHash,omitempty"`
-	ValidatorStatsRootHash []byte            `protobuf:"bytes,14,opt,name=ValidatorStatsRootHash,proto3" json:"ValidatorStatsRootHash,omitempty"`
-	MiniBlockHeaders       []MiniBlockHeader `protobuf:"bytes,16,rep,name=MiniBlockHeaders,proto3" json:"MiniBlockHeaders"`
-	ReceiptsHash           []byte            `protobuf:"bytes,17,opt,name=ReceiptsHash,proto3" json:"ReceiptsHash,omitempty"`
-	EpochStart             EpochStart        `protobuf:"bytes,18,opt,name=EpochStart,proto3" json:"EpochStart"`
-	ChainID                []byte            `protobuf:"bytes,19,opt,name=ChainID,proto3" json:"ChainID,omitempty"`
-	SoftwareVersion        []byte            `protobuf:"bytes,20,opt,name=SoftwareVersion,proto3" json:"SoftwareVersion,omitempty"`
-	AccumulatedFees        *math_big.Int     `protobuf:"bytes,21,opt,name=AccumulatedFees,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"AccumulatedFees,omitempty"`
-	AccumulatedFeesInEpoch *math_big.Int     `protobuf:"bytes,22,opt,name=AccumulatedFeesInEpoch,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"AccumulatedFeesInEpoch,omitempty"`
-	DeveloperFees          *math_big.Int     `protobuf:"bytes,23,opt,name=DeveloperFees,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"DeveloperFees,omitempty"`
-	DevFeesInEpoch         *math_big.Int     `protobuf:"bytes,24,opt,name=DevFeesInEpoch,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"DevFeesInEpoch,omitempty"`
-	TxCount                uint32            `protobuf:"varint,25,opt,name=TxCount,proto3" json:"TxCount,omitempty"`
-	Reserved               []byte            `protobuf:"bytes,26,opt,name=Reserved,proto3" json:"Reserved,omitempty"`
+	Nonce                  uint64             `protobuf:"varint,1,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Epoch                  uint32             `protobuf:"varint,2,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	Round                  uint64             `protobuf:"varint,3,opt,name=Round,proto3" json:"Round,omitempty"`
+	TimeStamp              uint64             `protobuf:"varint,4,opt,name=TimeStamp,proto3" json:"TimeStamp,omitempty"`
+	ShardInfo              []ShardData        `protobuf:"bytes,5,rep,name=ShardInfo,proto3" json:"ShardInfo"`
+	PeerInfo               []PeerData         `protobuf:"bytes,6,rep,name=PeerInfo,proto3" json:"PeerInfo"`
+	Signature              []byte             `protobuf:"bytes,7,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	LeaderSignature        []byte             `protobuf:"bytes,8,opt,name=LeaderSignature,proto3" json:"LeaderSignature,omitempty"`
+	PubKeysBitmap          []byte             `protobuf:"bytes,9,opt,name=PubKeysBitmap,proto3" json:"PubKeysBitmap,omitempty"`
+	PrevHash               []byte             `protobuf:"bytes,10,opt,name=PrevHash,proto3" json:"PrevHash,omitempty"`
+	PrevRandSeed           []byte             `protobuf:"bytes,11,opt,name=PrevRandSeed,proto3" json:"PrevRandSeed,omitempty"`
+	RandSeed               []byte             `protobuf:"bytes,12,opt,name=RandSeed,proto3" json:"RandSeed,omitempty"`
+	RootHash               []byte             `protobuf:"bytes,13,opt,name=RootHash,proto3" json:"RootHash,omitempty"`
+	ValidatorStatsRootHash []byte             `protobuf:"bytes,14,opt,name=ValidatorStatsRootHash,proto3" json:"ValidatorStatsRootHash,omitempty"`
+	MiniBlockHeaders       []MiniBlockHeader  `protobuf:"bytes,16,rep,name=MiniBlockHeaders,proto3" json:"MiniBlockHeaders"`
+	ReceiptsHash           []byte             `protobuf:"bytes,17,opt,name=ReceiptsHash,proto3" json:"ReceiptsHash,omitempty"`
+	EpochStart             EpochStart         `protobuf:"bytes,18,opt,name=EpochStart,proto3" json:"EpochStart"`
+	ChainID                []byte             `protobuf:"bytes,19,opt,name=ChainID,proto3" json:"ChainID,omitempty"`
+	SoftwareVersion        []byte             `protobuf:"bytes,20,opt,name=SoftwareVersion,proto3" json:"SoftwareVersion,omitempty"`
+	AccumulatedFees        *math_big.Int      `protobuf:"bytes,21,opt,name=AccumulatedFees,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"AccumulatedFees,omitempty"`
+	AccumulatedFeesInEpoch *math_big.Int      `protobuf:"bytes,22,opt,name=AccumulatedFeesInEpoch,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"AccumulatedFeesInEpoch,omitempty"`
+	DeveloperFees          *math_big.Int      `protobuf:"bytes,23,opt,name=DeveloperFees,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"DeveloperFees,omitempty"`
+	DevFeesInEpoch         *math_big.Int      `protobuf:"bytes,24,opt,name=DevFeesInEpoch,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"DevFeesInEpoch,omitempty"`
+	TxCount                uint32             `protobuf:"varint,25,opt,name=TxCount,proto3" json:"TxCount,omitempty"`
+	Reserved               []byte             `protobuf:"bytes,26,opt,name=Reserved,proto3" json:"Reserved,omitempty"`
+	SlashingEvidence       []SlashingEvidence `protobuf:"bytes,27,rep,name=SlashingEvidence,proto3" json:"SlashingEvidence"`
 }
 
 func (m *MetaBlock) Reset()      { *m = MetaBlock{} }
 func (*MetaBlock) ProtoMessage() {}
 func (*MetaBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_87b91ab531130b2b, []int{5}
+	return fileDescriptor_87b91ab531130b2b, []int{6}
 }
 func (m *MetaBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -767,99 +888,117 @@ func (m *MetaBlock) GetReserved() []byte {
 	return nil
 }
 
+func (m *MetaBlock) GetSlashingEvidence() []SlashingEvidence {
+	if m != nil {
+		return m.SlashingEvidence
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("proto.PeerAction", PeerAction_name, PeerAction_value)
+	proto.RegisterEnum("proto.SlashingEvidenceType", SlashingEvidenceType_name, SlashingEvidenceType_value)
 	proto.RegisterType((*PeerData)(nil), "proto.PeerData")
 	proto.RegisterType((*ShardData)(nil), "proto.ShardData")
 	proto.RegisterType((*EpochStartShardData)(nil), "proto.EpochStartShardData")
 	proto.RegisterType((*Economics)(nil), "proto.Economics")
 	proto.RegisterType((*EpochStart)(nil), "proto.EpochStart")
+	proto.RegisterType((*SlashingEvidence)(nil), "proto.SlashingEvidence")
 	proto.RegisterType((*MetaBlock)(nil), "proto.MetaBlock")
 }
 
 func init() { proto.RegisterFile("metaBlock.proto", fileDescriptor_87b91ab531130b2b) }
 
 var fileDescriptor_87b91ab531130b2b = []byte{
-	// 1257 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6e, 0xdb, 0x46,
-	0x13, 0x17, 0x6d, 0xcb, 0xb6, 0x46, 0x96, 0x4d, 0x6f, 0x1c, 0x87, 0x9f, 0xf1, 0x81, 0x31, 0x84,
-	0x1e, 0xdc, 0x02, 0xb1, 0x5b, 0x37, 0x68, 0x0f, 0x3d, 0x14, 0xb6, 0x65, 0x23, 0x6a, 0x12, 0x43,
-	0xa0, 0x5c, 0x1f, 0x7a, 0x5b, 0x91, 0x1b, 0x6a, 0x61, 0x6a, 0x57, 0x25, 0x97, 0x76, 0x5d, 0xa0,
-	0x40, 0x1f, 0xa1, 0xbd, 0xf5, 0x01, 0x7a, 0x08, 0xda, 0x17, 0xc9, 0x31, 0xc7, 0x9c, 0x9a, 0x46,
-	0xb9, 0xf4, 0x98, 0x02, 0x7d, 0x80, 0x62, 0x77, 0x49, 0x91, 0xa2, 0xe8, 0x26, 0x07, 0xe5, 0x64,
-	0xcf, 0x6f, 0x76, 0x66, 0xb4, 0xf3, 0x6f, 0x7f, 0x84, 0xb5, 0x01, 0x11, 0xf8, 0x30, 0xe0, 0xee,
-	0xc5, 0xee, 0x30, 0xe4, 0x82, 0xa3, 0xaa, 0xfa, 0xb3, 0x75, 0xcf, 0xa7, 0xa2, 0x1f, 0xf7, 0x76,
-	0x5d, 0x3e, 0xd8, 0xf3, 0xb9, 0xcf, 0xf7, 0x14, 0xdc, 0x8b, 0x9f, 0x28, 0x49, 0x09, 0xea, 0x3f,
-	0x6d, 0xb5, 0x55, 0xef, 0x65, 0x2e, 0x9a, 0xff, 0x18, 0xb0, 0xdc, 0x21, 0x24, 0x6c, 0x61, 0x81,
-	0x91, 0x05, 0x4b, 0x07, 0x9e, 0x17, 0x92, 0x28, 0xb2, 0x8c, 0x6d, 0x63, 0x67, 0xc5, 0x49, 0x45,
-	0xf4, 0x7f, 0xa8, 0x75, 0xe2, 0x5e, 0x40, 0xdd, 0x87, 0xe4, 0xda, 0x9a, 0x53, 0xba, 0x0c, 0x40,
-	0x1f, 0xc2, 0xe2, 0x81, 0x2b, 0x28, 0x67, 0xd6, 0xfc, 0xb6, 0xb1, 0xb3, 0xba, 0xbf, 0xae, 0x9d,
-	0xef, 0x4a, 0xc7, 0x5a, 0xe1, 0x24, 0x07, 0xa4, 0xa3, 0x33, 0x3a, 0x20, 0x5d, 0x81, 0x07, 0x43,
-	0x6b, 0x61, 0xdb, 0xd8, 0x59, 0x70, 0x32, 0x00, 0xf9, 0x50, 0x3f, 0xc7, 0x41, 0x4c, 0x8e, 0xfa,
-	0x98, 0xf9, 0xc4, 0xaa, 0xca, 0x40, 0x87, 0xc7, 0xbf, 0xbd, 0xbc, 0x7b, 0x30, 0xc0, 0xa2, 0xbf,
-	0xd7, 0xa3, 0xfe, 0x6e, 0x9b, 0x89, 0x2f, 0x72, 0xf7, 0x3d, 0x0e, 0x42, 0xce, 0xbc, 0x53, 0x22,
-	0xae, 0x78, 0x78, 0xb1, 0x47, 0x94, 0x74, 0xcf, 0xe7, 0x7b, 0x1e, 0x16, 0x78, 0xf7, 0x90, 0xfa,
-	0x6d, 0x26, 0x8e, 0x70, 0x24, 0x48, 0xe8, 0xe4, 0x3d, 0x37, 0x7f, 0xaf, 0x42, 0xad, 0xdb, 0xc7,
-	0xa1, 0xa7, 0xee, 0x6d, 0x03, 0x3c, 0x20, 0xd8, 0x23, 0xe1, 0x03, 0x1c, 0xf5, 0x93, 0xeb, 0xe5,
-	0x10, 0xe4, 0xc0, 0x6d, 0x75, 0xf8, 0x31, 0x65, 0x54, 0xe5, 0x5f, 0xeb, 0x22, 0x6b, 0x7e, 0x7b,
-	0x7e, 0xa7, 0xbe, 0xbf, 0x99, 0x5c, 0xb7, 0xa0, 0x3e, 0x5c, 0x78, 0xf6, 0xc7, 0xdd, 0x8a, 0x53,
-	0x6e, 0x8a, 0x9a, 0xb0, 0xd2, 0x09, 0xc9, 0xa5, 0x83, 0x99, 0xd7, 0x25, 0xc4, 0x53, 0xb9, 0x58,
-	0x71, 0x26, 0x30, 0xf4, 0x01, 0x34, 0x3a, 0x71, 0xef, 0x21, 0xb9, 0x8e, 0x0e, 0xa9, 0x18, 0xe0,
-	0xa1, 0x4e, 0x88, 0x33, 0x09, 0xca, 0x94, 0x76, 0xa9, 0xcf, 0xb0, 0x88, 0x43, 0x62, 0x2d, 0xea,
-	0xda, 0x8c, 0x01, 0xb4, 0x01, 0x55, 0x87, 0xc7, 0xcc, 0xb3, 0x96, 0x55, 0xb2, 0xb5, 0x80, 0xb6,
-	0x60, 0x59, 0x46, 0x52, 0xf7, 0xad, 0x29, 0x93, 0xb1, 0x2c, 0x2d, 0x4e, 0x39, 0x73, 0x89, 0x05,
-	0xda, 0x42, 0x09, 0x88, 0xc3, 0xda, 0x81, 0xeb, 0xc6, 0x83, 0x38, 0xc0, 0x82, 0x78, 0x27, 0x84,
-	0x44, 0xd6, 0xca, 0x2c, 0xcb, 0x53, 0xf4, 0x8e, 0x2e, 0xa0, 0xd1, 0x22, 0x97, 0x24, 0xe0, 0x43,
-	0x12, 0xaa, 0x70, 0xab, 0xb3, 0x0c, 0x37, 0xe9, 0x1b, 0xed, 0xc3, 0xc6, 0x69, 0x3c, 0xe8, 0x10,
-	0xe6, 0x51, 0xe6, 0x8f, 0x6b, 0x15, 0x59, 0xf5, 0x6d, 0x63, 0xa7, 0xe1, 0x94, 0xea, 0xd0, 0x7d,
-	0xb8, 0xfd, 0x08, 0x47, 0xa2, 0xcd, 0xdc, 0x20, 0xf6, 0x88, 0xf7, 0x98, 0x08, 0xac, 0xf3, 0xd6,
-	0x50, 0x79, 0x2b, 0x57, 0xca, 0x19, 0x53, 0x0d, 0xd1, 0x6e, 0xa9, 0x19, 0x6b, 0x38, 0xa9, 0x28,
-	0x35, 0x67, 0xdf, 0x1d, 0xf1, 0x98, 0x09, 0x6b, 0x49, 0x6b, 0x12, 0xb1, 0xf9, 0xf7, 0x1c, 0xdc,
-	0x3a, 0x1e, 0x72, 0xb7, 0xdf, 0x15, 0x38, 0x14, 0x59, 0xdf, 0xde, 0xec, 0x6b, 0x03, 0xaa, 0xca,
-	0x40, 0x15, 0xb7, 0xe1, 0x68, 0x21, 0xeb, 0x85, 0xa5, 0x7c, 0x2f, 0x8c, 0xeb, 0xbd, 0x9c, 0xaf,
-	0xf7, 0xdb, 0x66, 0x62, 0x0b, 0x96, 0x1d, 0xce, 0x85, 0xd2, 0xce, 0xeb, 0x0e, 0x4a, 0x65, 0x99,
-	0x99, 0x13, 0x1a, 0x46, 0x22, 0xcd, 0x59, 0xba, 0xb6, 0x92, 0x26, 0x2f, 0x57, 0xa6, 0xf9, 0x3c,
-	0xa1, 0x8c, 0x46, 0x7d, 0x9d, 0x32, 0x6d, 0xa5, 0xbb, 0xbe, 0x5c, 0x89, 0xce, 0xe1, 0x4e, 0xb1,
-	0x34, 0xe9, 0x74, 0x2e, 0xbe, 0xc3, 0x74, 0xde, 0x64, 0xdc, 0x7c, 0xba, 0x08, 0xb5, 0x63, 0x97,
-	0x33, 0x3e, 0xa0, 0x6e, 0x24, 0x17, 0xd3, 0x19, 0x17, 0x38, 0xe8, 0xc6, 0xc3, 0x61, 0x70, 0xad,
-	0xb7, 0xe3, 0xcc, 0x16, 0x53, 0xce, 0x33, 0x8a, 0x60, 0x5d, 0x89, 0x67, 0xbc, 0x45, 0x23, 0x11,
-	0xd2, 0x5e, 0x2c, 0x88, 0xce, 0xfe, 0xac, 0xc2, 0x4d, 0xfb, 0x47, 0xdf, 0x82, 0xa9, 0xc0, 0x53,
-	0x72, 0x15, 0x5c, 0x3f, 0xa6, 0x4c, 0x10, 0x4f, 0xd7, 0x74, 0x56, 0x31, 0xa7, 0xdc, 0xcb, 0x75,
-	0xe2, 0x90, 0x2b, 0x1c, 0x7a, 0x51, 0x87, 0x84, 0xb9, 0xe6, 0x98, 0xd9, 0x3a, 0x29, 0x78, 0x47,
-	0x3f, 0x1b, 0xb0, 0x9d, 0x60, 0x27, 0x3c, 0xec, 0xc8, 0x96, 0x70, 0x79, 0xd0, 0x8d, 0x23, 0x81,
-	0x29, 0xc3, 0x3d, 0x1a, 0x50, 0x71, 0x3d, 0xdb, 0x07, 0xe7, 0xad, 0xe1, 0x90, 0x0b, 0xb5, 0x53,
-	0xee, 0x91, 0x4e, 0x48, 0xdd, 0x64, 0x73, 0xcf, 0x2a, 0x76, 0xe6, 0x17, 0x7d, 0x0c, 0xb7, 0xe4,
-	0x6a, 0xcf, 0xf6, 0x47, 0x7e, 0x05, 0x94, 0xa9, 0xd0, 0x2e, 0xa0, 0x49, 0x58, 0x0d, 0xf9, 0xb2,
-	0x9a, 0xc2, 0x12, 0x4d, 0xf3, 0x17, 0x03, 0x20, 0x83, 0xd0, 0x19, 0x6c, 0x24, 0xa3, 0x8a, 0x03,
-	0xfa, 0x3d, 0xf1, 0xd2, 0x71, 0x34, 0xd4, 0x38, 0x6e, 0x25, 0xe3, 0x58, 0xb2, 0xcf, 0x92, 0x91,
-	0x2c, 0xb5, 0x46, 0xf7, 0x73, 0xe3, 0xa8, 0x06, 0xa2, 0xbe, 0x6f, 0xa6, 0xae, 0x52, 0x3c, 0x71,
-	0x90, 0x1d, 0x6c, 0xbe, 0xac, 0x41, 0x2d, 0xdb, 0x15, 0xe3, 0x4d, 0x67, 0xe4, 0x37, 0xdd, 0x78,
-	0x57, 0xce, 0x95, 0xee, 0xca, 0xf9, 0xfc, 0xae, 0xfc, 0x6f, 0xfa, 0x72, 0x3f, 0x21, 0x15, 0x6d,
-	0xf6, 0x84, 0x5b, 0x55, 0x75, 0xdd, 0xf4, 0x37, 0x16, 0x2f, 0x99, 0x1d, 0x44, 0x9f, 0x68, 0x06,
-	0xa6, 0x8c, 0xf4, 0xca, 0x5a, 0xcb, 0xf1, 0xa7, 0x9c, 0xcd, 0xf8, 0xd8, 0xe4, 0x93, 0xbf, 0x54,
-	0x7c, 0xf2, 0x77, 0x60, 0xed, 0x91, 0xca, 0x5a, 0x76, 0x46, 0x17, 0xaf, 0x08, 0x4f, 0x13, 0x8c,
-	0x5a, 0x19, 0xc1, 0xc8, 0x93, 0x05, 0x28, 0x90, 0x85, 0x22, 0x8d, 0xa9, 0x97, 0xd0, 0x18, 0xf9,
-	0x54, 0xa4, 0xfa, 0x95, 0xe4, 0xa9, 0xc8, 0xeb, 0xd2, 0x67, 0xa4, 0x51, 0x78, 0x46, 0x3e, 0x83,
-	0xcd, 0x73, 0x1c, 0x50, 0x0f, 0x0b, 0x1e, 0x76, 0x05, 0x16, 0xd1, 0xf8, 0xa4, 0xa2, 0x02, 0xce,
-	0x0d, 0x5a, 0xf4, 0x00, 0xcc, 0xa9, 0xb7, 0xc0, 0x7c, 0x87, 0xb7, 0xc0, 0x2c, 0x23, 0x69, 0x0e,
-	0x71, 0x09, 0x1d, 0x8a, 0x48, 0xc5, 0x5d, 0xd7, 0xb7, 0xcb, 0x63, 0xe8, 0xf3, 0x7c, 0xf3, 0x5b,
-	0x48, 0x75, 0xe6, 0xfa, 0x54, 0x93, 0x27, 0x21, 0xf2, 0x73, 0x62, 0xc1, 0xd2, 0x51, 0x1f, 0x53,
-	0xd6, 0x6e, 0x59, 0xb7, 0x34, 0xdb, 0x4e, 0x44, 0x59, 0xc0, 0x2e, 0x7f, 0x22, 0xae, 0x70, 0x48,
-	0xce, 0x49, 0x18, 0x49, 0x62, 0xbd, 0xa1, 0x0b, 0x58, 0x80, 0xcb, 0x58, 0xd9, 0xed, 0xf7, 0xca,
-	0xca, 0x7e, 0x80, 0xcd, 0x02, 0xd4, 0x66, 0x7a, 0x7a, 0x36, 0x67, 0x19, 0xf7, 0x86, 0x20, 0xd3,
-	0xa4, 0xf0, 0xce, 0x7b, 0x24, 0x85, 0x03, 0x58, 0x6d, 0x91, 0xcb, 0xfc, 0x1d, 0xad, 0x59, 0x46,
-	0x2b, 0x38, 0xcf, 0xf3, 0xbf, 0xff, 0x4d, 0xf0, 0x3f, 0x35, 0x24, 0x24, 0x22, 0xe1, 0x25, 0xf1,
-	0xac, 0xad, 0x64, 0x48, 0x12, 0xf9, 0xa3, 0x5f, 0x0d, 0x80, 0xec, 0x3b, 0x0b, 0xad, 0x43, 0xa3,
-	0xcd, 0x2e, 0xe5, 0x5c, 0x68, 0xc0, 0xac, 0xa0, 0x0d, 0x30, 0xe5, 0x01, 0x87, 0xf8, 0xf2, 0xc5,
-	0xc7, 0x0a, 0x35, 0xe4, 0x41, 0x89, 0x7e, 0xcd, 0x22, 0x81, 0x2f, 0x28, 0xf3, 0xcd, 0x39, 0xb4,
-	0x09, 0x48, 0x6d, 0x1c, 0x12, 0xe6, 0x8f, 0xce, 0xa3, 0x55, 0x1d, 0xe1, 0x2b, 0x4c, 0x03, 0xe2,
-	0x99, 0x0b, 0xc8, 0x84, 0x15, 0x6d, 0x9a, 0x20, 0x55, 0xb4, 0x06, 0x75, 0x89, 0x74, 0x03, 0x2c,
-	0xc9, 0x99, 0xb9, 0x98, 0x02, 0x8e, 0x5c, 0x8c, 0x17, 0xc4, 0x5c, 0x3a, 0xfc, 0xf2, 0xf9, 0x2b,
-	0xbb, 0xf2, 0xe2, 0x95, 0x5d, 0x79, 0xf3, 0xca, 0x36, 0x7e, 0x1c, 0xd9, 0xc6, 0xd3, 0x91, 0x6d,
-	0x3c, 0x1b, 0xd9, 0xc6, 0xf3, 0x91, 0x6d, 0xbc, 0x18, 0xd9, 0xc6, 0x9f, 0x23, 0xdb, 0xf8, 0x6b,
-	0x64, 0x57, 0xde, 0x8c, 0x6c, 0xe3, 0xa7, 0xd7, 0x76, 0xe5, 0xf9, 0x6b, 0xbb, 0xf2, 0xe2, 0xb5,
-	0x5d, 0xf9, 0xa6, 0xaa, 0x3e, 0x57, 0x7b, 0x8b, 0x6a, 0xa2, 0x3e, 0xfd, 0x37, 0x00, 0x00, 0xff,
-	0xff, 0xd0, 0xf8, 0x08, 0x32, 0x05, 0x0f, 0x00, 0x00,
+	// 1398 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x16, 0x2d, 0x4b, 0xb6, 0x46, 0x92, 0x4d, 0xaf, 0x65, 0x9b, 0x71, 0x0a, 0x46, 0x10, 0x8a,
+	0x42, 0x0d, 0x10, 0xbb, 0x75, 0x83, 0xf6, 0xd0, 0x43, 0x61, 0x5b, 0x36, 0xa2, 0xfc, 0x18, 0x02,
+	0xe5, 0xfa, 0xd0, 0xdb, 0x8a, 0xdc, 0x48, 0x0b, 0x53, 0x5c, 0x95, 0x5c, 0xda, 0x75, 0x81, 0x02,
+	0x7d, 0x84, 0xf6, 0xd6, 0x7b, 0x7b, 0x08, 0xd2, 0x17, 0xc9, 0x31, 0xc7, 0x9c, 0xda, 0x46, 0xbe,
+	0xf4, 0x98, 0x02, 0x7d, 0x80, 0x62, 0x97, 0xa4, 0x48, 0x51, 0x74, 0x93, 0x83, 0x72, 0xb2, 0xe7,
+	0x9b, 0xd9, 0x19, 0xef, 0xec, 0xcc, 0x37, 0x43, 0xc3, 0xea, 0x90, 0x70, 0x7c, 0x60, 0x33, 0xf3,
+	0x7c, 0x67, 0xe4, 0x32, 0xce, 0x50, 0x41, 0xfe, 0xd8, 0xbe, 0xd7, 0xa7, 0x7c, 0xe0, 0xf7, 0x76,
+	0x4c, 0x36, 0xdc, 0xed, 0xb3, 0x3e, 0xdb, 0x95, 0x70, 0xcf, 0x7f, 0x2a, 0x25, 0x29, 0xc8, 0xdf,
+	0x82, 0x53, 0xdb, 0xe5, 0x5e, 0xec, 0xa2, 0xf1, 0xaf, 0x02, 0xcb, 0x1d, 0x42, 0xdc, 0x16, 0xe6,
+	0x18, 0x69, 0xb0, 0xb4, 0x6f, 0x59, 0x2e, 0xf1, 0x3c, 0x4d, 0xa9, 0x2b, 0xcd, 0x8a, 0x11, 0x89,
+	0xe8, 0x03, 0x28, 0x75, 0xfc, 0x9e, 0x4d, 0xcd, 0x47, 0xe4, 0x4a, 0x5b, 0x90, 0xba, 0x18, 0x40,
+	0x1f, 0x43, 0x71, 0xdf, 0xe4, 0x94, 0x39, 0x5a, 0xbe, 0xae, 0x34, 0x57, 0xf6, 0xd6, 0x02, 0xe7,
+	0x3b, 0xc2, 0x71, 0xa0, 0x30, 0x42, 0x03, 0xe1, 0xe8, 0x94, 0x0e, 0x49, 0x97, 0xe3, 0xe1, 0x48,
+	0x5b, 0xac, 0x2b, 0xcd, 0x45, 0x23, 0x06, 0x50, 0x1f, 0xca, 0x67, 0xd8, 0xf6, 0xc9, 0xe1, 0x00,
+	0x3b, 0x7d, 0xa2, 0x15, 0x44, 0xa0, 0x83, 0xa3, 0xe7, 0x7f, 0xde, 0xd9, 0x1f, 0x62, 0x3e, 0xd8,
+	0xed, 0xd1, 0xfe, 0x4e, 0xdb, 0xe1, 0x5f, 0x26, 0xee, 0x7b, 0x64, 0xbb, 0xcc, 0xb1, 0x4e, 0x08,
+	0xbf, 0x64, 0xee, 0xf9, 0x2e, 0x91, 0xd2, 0xbd, 0x3e, 0xdb, 0xb5, 0x30, 0xc7, 0x3b, 0x07, 0xb4,
+	0xdf, 0x76, 0xf8, 0x21, 0xf6, 0x38, 0x71, 0x8d, 0xa4, 0xe7, 0xc6, 0xef, 0x05, 0x28, 0x75, 0x07,
+	0xd8, 0xb5, 0xe4, 0xbd, 0x75, 0x80, 0x07, 0x04, 0x5b, 0xc4, 0x7d, 0x80, 0xbd, 0x41, 0x78, 0xbd,
+	0x04, 0x82, 0x0c, 0xd8, 0x90, 0xc6, 0x4f, 0xa8, 0x43, 0x65, 0xfe, 0x03, 0x9d, 0xa7, 0xe5, 0xeb,
+	0xf9, 0x66, 0x79, 0x6f, 0x33, 0xbc, 0x6e, 0x4a, 0x7d, 0xb0, 0xf8, 0xe2, 0x8f, 0x3b, 0x39, 0x23,
+	0xfb, 0x28, 0x6a, 0x40, 0xa5, 0xe3, 0x92, 0x0b, 0x03, 0x3b, 0x56, 0x97, 0x10, 0x4b, 0xe6, 0xa2,
+	0x62, 0x4c, 0x61, 0xe8, 0x43, 0xa8, 0x76, 0xfc, 0xde, 0x23, 0x72, 0xe5, 0x1d, 0x50, 0x3e, 0xc4,
+	0xa3, 0x20, 0x21, 0xc6, 0x34, 0x28, 0x52, 0xda, 0xa5, 0x7d, 0x07, 0x73, 0xdf, 0x25, 0x5a, 0x31,
+	0x78, 0x9b, 0x09, 0x80, 0x6a, 0x50, 0x30, 0x98, 0xef, 0x58, 0xda, 0xb2, 0x4c, 0x76, 0x20, 0xa0,
+	0x6d, 0x58, 0x16, 0x91, 0xe4, 0x7d, 0x4b, 0xf2, 0xc8, 0x44, 0x16, 0x27, 0x4e, 0x98, 0x63, 0x12,
+	0x0d, 0x82, 0x13, 0x52, 0x40, 0x0c, 0x56, 0xf7, 0x4d, 0xd3, 0x1f, 0xfa, 0x36, 0xe6, 0xc4, 0x3a,
+	0x26, 0xc4, 0xd3, 0x2a, 0xf3, 0x7c, 0x9e, 0xb4, 0x77, 0x74, 0x0e, 0xd5, 0x16, 0xb9, 0x20, 0x36,
+	0x1b, 0x11, 0x57, 0x86, 0x5b, 0x99, 0x67, 0xb8, 0x69, 0xdf, 0x68, 0x0f, 0x6a, 0x27, 0xfe, 0xb0,
+	0x43, 0x1c, 0x8b, 0x3a, 0xfd, 0xc9, 0x5b, 0x79, 0x5a, 0xb9, 0xae, 0x34, 0xab, 0x46, 0xa6, 0x0e,
+	0xdd, 0x87, 0x8d, 0xc7, 0xd8, 0xe3, 0x6d, 0xc7, 0xb4, 0x7d, 0x8b, 0x58, 0x4f, 0x08, 0xc7, 0x41,
+	0xde, 0xaa, 0x32, 0x6f, 0xd9, 0x4a, 0xd1, 0x63, 0xb2, 0x20, 0xda, 0x2d, 0xd9, 0x63, 0x55, 0x23,
+	0x12, 0x85, 0xe6, 0xf4, 0xbb, 0x43, 0xe6, 0x3b, 0x5c, 0x5b, 0x0a, 0x34, 0xa1, 0xd8, 0xf8, 0x67,
+	0x01, 0xd6, 0x8f, 0x46, 0xcc, 0x1c, 0x74, 0x39, 0x76, 0x79, 0x5c, 0xb7, 0x37, 0xfb, 0xaa, 0x41,
+	0x41, 0x1e, 0x90, 0x8f, 0x5b, 0x35, 0x02, 0x21, 0xae, 0x85, 0xa5, 0x64, 0x2d, 0x4c, 0xde, 0x7b,
+	0x39, 0xf9, 0xde, 0x6f, 0xeb, 0x89, 0x6d, 0x58, 0x36, 0x18, 0xe3, 0x52, 0x9b, 0x0f, 0x2a, 0x28,
+	0x92, 0x45, 0x66, 0x8e, 0xa9, 0xeb, 0xf1, 0x28, 0x67, 0x11, 0x6d, 0x85, 0x45, 0x9e, 0xad, 0x8c,
+	0xf2, 0x79, 0x4c, 0x1d, 0xea, 0x0d, 0x88, 0x35, 0x51, 0x84, 0x55, 0x9f, 0xad, 0x44, 0x67, 0xb0,
+	0x95, 0x7e, 0x9a, 0xa8, 0x3b, 0x8b, 0xef, 0xd0, 0x9d, 0x37, 0x1d, 0x6e, 0x3c, 0x2b, 0x42, 0xe9,
+	0xc8, 0x64, 0x0e, 0x1b, 0x52, 0xd3, 0x13, 0xc4, 0x74, 0xca, 0x38, 0xb6, 0xbb, 0xfe, 0x68, 0x64,
+	0x5f, 0x69, 0xca, 0x3c, 0x4b, 0x31, 0xe9, 0x19, 0x79, 0xb0, 0x26, 0xc5, 0x53, 0xd6, 0xa2, 0x1e,
+	0x77, 0x69, 0xcf, 0xe7, 0x44, 0x5b, 0x98, 0x67, 0xb8, 0x59, 0xff, 0xe8, 0x5b, 0x50, 0x25, 0x78,
+	0x42, 0x2e, 0xed, 0xab, 0x27, 0xd4, 0xe1, 0xc4, 0xd2, 0xf2, 0xf3, 0x8c, 0x39, 0xe3, 0x5e, 0xd0,
+	0x89, 0x41, 0x2e, 0xb1, 0x6b, 0x79, 0x1d, 0xe2, 0x26, 0x8a, 0x63, 0x6e, 0x74, 0x92, 0xf2, 0x8e,
+	0x7e, 0x56, 0xa0, 0x1e, 0x62, 0xc7, 0xcc, 0xed, 0x88, 0x92, 0x30, 0x99, 0xdd, 0xf5, 0x3d, 0x8e,
+	0xa9, 0x83, 0x7b, 0xd4, 0xa6, 0xfc, 0x6a, 0xbe, 0x03, 0xe7, 0xad, 0xe1, 0x90, 0x09, 0xa5, 0x13,
+	0x66, 0x91, 0x8e, 0x4b, 0xcd, 0x90, 0xb9, 0xe7, 0x15, 0x3b, 0xf6, 0x8b, 0x3e, 0x81, 0x75, 0x41,
+	0xed, 0x31, 0x7f, 0x24, 0x29, 0x20, 0x4b, 0x85, 0x76, 0x00, 0x4d, 0xc3, 0xb2, 0xc9, 0x97, 0x65,
+	0x17, 0x66, 0x68, 0x1a, 0xbf, 0x28, 0x00, 0x31, 0x84, 0x4e, 0xa1, 0x16, 0xb6, 0x2a, 0xb6, 0xe9,
+	0xf7, 0xc4, 0x8a, 0xda, 0x51, 0x91, 0xed, 0xb8, 0x1d, 0xb6, 0x63, 0x06, 0x9f, 0x85, 0x2d, 0x99,
+	0x79, 0x1a, 0xdd, 0x4f, 0xb4, 0xa3, 0x6c, 0x88, 0xf2, 0x9e, 0x1a, 0xb9, 0x8a, 0xf0, 0xd0, 0x41,
+	0x6c, 0xd8, 0xf8, 0x75, 0x01, 0xd4, 0xae, 0x8d, 0xbd, 0x01, 0x75, 0xfa, 0x47, 0x17, 0xd4, 0x22,
+	0x82, 0xda, 0x76, 0x61, 0xf1, 0xf4, 0x6a, 0x44, 0x64, 0x17, 0xaf, 0xec, 0xdd, 0x0e, 0xbd, 0xa4,
+	0xcd, 0x84, 0x89, 0x21, 0x0d, 0xd1, 0x26, 0x14, 0x83, 0x91, 0x1b, 0xf2, 0x60, 0x28, 0x25, 0xf9,
+	0x37, 0x3f, 0xc3, 0xbf, 0x41, 0x9a, 0x17, 0x93, 0x4c, 0x5b, 0x87, 0xb2, 0xa4, 0xbe, 0xe0, 0x4e,
+	0x21, 0xaf, 0x25, 0x21, 0xf4, 0x11, 0xac, 0x48, 0x31, 0x3d, 0xd0, 0x53, 0xa8, 0xd8, 0x1e, 0xba,
+	0xc4, 0x64, 0x4e, 0x98, 0x1e, 0xf9, 0x9a, 0x15, 0x63, 0x0a, 0x43, 0x4d, 0x58, 0x0d, 0xe4, 0xd8,
+	0x59, 0xf0, 0x86, 0x69, 0xb8, 0xf1, 0x1c, 0xa0, 0x14, 0x33, 0xea, 0x64, 0x1e, 0x28, 0xc9, 0x79,
+	0x30, 0x99, 0x28, 0x0b, 0x99, 0x13, 0x25, 0x9f, 0xbc, 0xe7, 0xff, 0x2f, 0x79, 0xf7, 0xc3, 0xd5,
+	0xab, 0xed, 0x3c, 0x65, 0x5a, 0xa1, 0x9e, 0x4f, 0xbc, 0x64, 0xba, 0x14, 0x62, 0x43, 0xf4, 0x69,
+	0xb0, 0xa7, 0xca, 0x43, 0x01, 0xb1, 0xaf, 0x26, 0xb6, 0xcc, 0xc4, 0x99, 0x89, 0xd9, 0xf4, 0x62,
+	0xb4, 0x94, 0x5e, 0x8c, 0x9a, 0xb0, 0xfa, 0x58, 0x26, 0x6a, 0x26, 0x3d, 0x29, 0x78, 0x76, 0x0d,
+	0x2b, 0x65, 0xad, 0x61, 0xc9, 0x95, 0x0a, 0x52, 0x2b, 0x55, 0x7a, 0xd9, 0x2b, 0x67, 0x2c, 0x7b,
+	0x62, 0xa0, 0x46, 0xfa, 0x4a, 0x38, 0x50, 0x93, 0xba, 0x68, 0xd8, 0x56, 0x53, 0xc3, 0xf6, 0x73,
+	0xd8, 0x3c, 0xc3, 0x36, 0xb5, 0x30, 0x67, 0x6e, 0x97, 0x63, 0xee, 0x4d, 0x2c, 0xe5, 0xc2, 0x64,
+	0xdc, 0xa0, 0x45, 0x0f, 0x40, 0x9d, 0x99, 0x98, 0xea, 0x3b, 0x4c, 0x4c, 0x35, 0x6b, 0x95, 0x35,
+	0x88, 0x49, 0xe8, 0x88, 0x7b, 0x32, 0xee, 0x5a, 0x70, 0xbb, 0x24, 0x86, 0xbe, 0x48, 0x52, 0x84,
+	0x86, 0x64, 0xff, 0xae, 0xcd, 0x50, 0x41, 0x18, 0x22, 0xc9, 0x26, 0x1a, 0x2c, 0x1d, 0x0e, 0x30,
+	0x75, 0xda, 0x2d, 0x6d, 0x3d, 0xf8, 0x26, 0x09, 0x45, 0x59, 0xdf, 0xec, 0x29, 0xbf, 0xc4, 0x2e,
+	0x39, 0x23, 0xae, 0x27, 0x3e, 0x3f, 0x6a, 0x61, 0x7d, 0x4f, 0xc3, 0x59, 0xbb, 0xeb, 0xc6, 0x7b,
+	0xdd, 0x5d, 0x7f, 0x80, 0xcd, 0x14, 0xd4, 0x76, 0x82, 0xee, 0xd9, 0x9c, 0x67, 0xdc, 0x1b, 0x82,
+	0xcc, 0xae, 0xce, 0x5b, 0xef, 0x71, 0x75, 0x1e, 0xc2, 0x4a, 0x8b, 0x5c, 0x24, 0xef, 0xa8, 0xcd,
+	0x33, 0x5a, 0xca, 0x79, 0x72, 0x4b, 0xbe, 0x35, 0xb5, 0x25, 0xcb, 0x26, 0x21, 0x1e, 0x71, 0x2f,
+	0x88, 0xa5, 0x6d, 0x87, 0x4d, 0x12, 0xca, 0xa8, 0x3d, 0x3b, 0x06, 0xb4, 0xdb, 0xb2, 0xd8, 0xb7,
+	0x6e, 0xa0, 0xff, 0xa8, 0xda, 0xd3, 0xf8, 0xdd, 0xdf, 0x14, 0x80, 0xf8, 0xc3, 0x16, 0xad, 0x41,
+	0xb5, 0xed, 0x5c, 0x88, 0x16, 0x0b, 0x00, 0x35, 0x87, 0x6a, 0xa0, 0x0a, 0x03, 0x83, 0xf4, 0xc5,
+	0x8a, 0x85, 0x25, 0xaa, 0x08, 0x43, 0x81, 0x7e, 0xed, 0x78, 0x1c, 0x9f, 0x53, 0xa7, 0xaf, 0x2e,
+	0xa0, 0x4d, 0x40, 0x92, 0xbc, 0x88, 0x9b, 0x34, 0xcd, 0xa3, 0x95, 0x20, 0xc2, 0x43, 0x4c, 0x6d,
+	0x62, 0xa9, 0x8b, 0x48, 0x85, 0x4a, 0x70, 0x34, 0x44, 0x0a, 0x68, 0x15, 0xca, 0x02, 0x91, 0x7f,
+	0x1c, 0xb1, 0xd4, 0x62, 0x04, 0x18, 0x82, 0x63, 0xcf, 0x89, 0xba, 0x74, 0xf7, 0x21, 0xd4, 0xb2,
+	0x26, 0x1a, 0xda, 0x82, 0xf5, 0x16, 0xf3, 0x7b, 0x36, 0x11, 0xfc, 0x36, 0x19, 0xaf, 0x6a, 0x0e,
+	0xdd, 0x82, 0x8d, 0x58, 0x21, 0x89, 0x4f, 0x90, 0x2f, 0xf1, 0x54, 0xe5, 0xe0, 0xab, 0x97, 0xaf,
+	0xf5, 0xdc, 0xab, 0xd7, 0x7a, 0xee, 0xcd, 0x6b, 0x5d, 0xf9, 0x71, 0xac, 0x2b, 0xcf, 0xc6, 0xba,
+	0xf2, 0x62, 0xac, 0x2b, 0x2f, 0xc7, 0xba, 0xf2, 0x6a, 0xac, 0x2b, 0x7f, 0x8d, 0x75, 0xe5, 0xef,
+	0xb1, 0x9e, 0x7b, 0x33, 0xd6, 0x95, 0x9f, 0xae, 0xf5, 0xdc, 0xcb, 0x6b, 0x3d, 0xf7, 0xea, 0x5a,
+	0xcf, 0x7d, 0x53, 0x90, 0xff, 0x6b, 0xe8, 0x15, 0x65, 0x8e, 0x3f, 0xfb, 0x6f, 0x00, 0xab, 0xee,
+	0x6b, 0xfc, 0xc2, 0x10, 0x00, 0x00,
 }
 
 func (x PeerAction) String() string {
@@ -869,6 +1008,13 @@ func (x PeerAction) String() string {
 	}
 	return strconv.Itoa(int(x))
 }
+func (x SlashingEvidenceType) String() string {
+	s, ok := SlashingEvidenceType_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
 func (this *PeerData) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1130,6 +1276,51 @@ func (this *EpochStart) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *SlashingEvidence) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SlashingEvidence)
+	if !ok {
+		that2, ok := that.(SlashingEvidence)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if !bytes.Equal(this.PubKey, that1.PubKey) {
+		return false
+	}
+	if this.ShardID != that1.ShardID {
+		return false
+	}
+	if this.Round != that1.Round {
+		return false
+	}
+	if !bytes.Equal(this.FirstHeader, that1.FirstHeader) {
+		return false
+	}
+	if !bytes.Equal(this.FirstSignature, that1.FirstSignature) {
+		return false
+	}
+	if !bytes.Equal(this.SecondHeader, that1.SecondHeader) {
+		return false
+	}
+	if !bytes.Equal(this.SecondSignature, that1.SecondSignature) {
+		return false
+	}
+	return true
+}
 func (this *MetaBlock) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1251,6 +1442,14 @@ func (this *MetaBlock) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Reserved, that1.Reserved) {
 		return false
 	}
+	if len(this.SlashingEvidence) != len(that1.SlashingEvidence) {
+		return false
+	}
+	for i := range this.SlashingEvidence {
+		if !this.SlashingEvidence[i].Equal(&that1.SlashingEvidence[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *PeerData) GoString() string {
@@ -1354,11 +1553,28 @@ func (this *EpochStart) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *SlashingEvidence) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&block.SlashingEvidence{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "PubKey: "+fmt.Sprintf("%#v", this.PubKey)+",\n")
+	s = append(s, "ShardID: "+fmt.Sprintf("%#v", this.ShardID)+",\n")
+	s = append(s, "Round: "+fmt.Sprintf("%#v", this.Round)+",\n")
+	s = append(s, "FirstHeader: "+fmt.Sprintf("%#v", this.FirstHeader)+",\n")
+	s = append(s, "FirstSignature: "+fmt.Sprintf("%#v", this.FirstSignature)+",\n")
+	s = append(s, "SecondHeader: "+fmt.Sprintf("%#v", this.SecondHeader)+",\n")
+	s = append(s, "SecondSignature: "+fmt.Sprintf("%#v", this.SecondSignature)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *MetaBlock) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 29)
+	s := make([]string, 0, 30)
 	s = append(s, "&block.MetaBlock{")
 	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
 	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
@@ -1403,6 +1619,13 @@ func (this *MetaBlock) GoString() string {
 	s = append(s, "DevFeesInEpoch: "+fmt.Sprintf("%#v", this.DevFeesInEpoch)+",\n")
 	s = append(s, "TxCount: "+fmt.Sprintf("%#v", this.TxCount)+",\n")
 	s = append(s, "Reserved: "+fmt.Sprintf("%#v", this.Reserved)+",\n")
+	if this.SlashingEvidence != nil {
+		vs := make([]SlashingEvidence, len(this.SlashingEvidence))
+		for i := range vs {
+			vs[i] = this.SlashingEvidence[i]
+		}
+		s = append(s, "SlashingEvidence: "+fmt.Sprintf("%#v", vs)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1829,6 +2052,79 @@ func (m *EpochStart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SlashingEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashingEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashingEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SecondSignature) > 0 {
+		i -= len(m.SecondSignature)
+		copy(dAtA[i:], m.SecondSignature)
+		i = encodeVarintMetaBlock(dAtA, i, uint64(len(m.SecondSignature)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.SecondHeader) > 0 {
+		i -= len(m.SecondHeader)
+		copy(dAtA[i:], m.SecondHeader)
+		i = encodeVarintMetaBlock(dAtA, i, uint64(len(m.SecondHeader)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.FirstSignature) > 0 {
+		i -= len(m.FirstSignature)
+		copy(dAtA[i:], m.FirstSignature)
+		i = encodeVarintMetaBlock(dAtA, i, uint64(len(m.FirstSignature)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.FirstHeader) > 0 {
+		i -= len(m.FirstHeader)
+		copy(dAtA[i:], m.FirstHeader)
+		i = encodeVarintMetaBlock(dAtA, i, uint64(len(m.FirstHeader)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Round != 0 {
+		i = encodeVarintMetaBlock(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ShardID != 0 {
+		i = encodeVarintMetaBlock(dAtA, i, uint64(m.ShardID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintMetaBlock(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintMetaBlock(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MetaBlock) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1849,6 +2145,22 @@ func (m *MetaBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SlashingEvidence) > 0 {
+		for iNdEx := len(m.SlashingEvidence) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashingEvidence[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMetaBlock(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+	}
 	if len(m.Reserved) > 0 {
 		i -= len(m.Reserved)
 		copy(dAtA[i:], m.Reserved)
@@ -2287,6 +2599,44 @@ func (m *EpochStart) Size() (n int) {
 	return n
 }
 
+func (m *SlashingEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovMetaBlock(uint64(m.Type))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovMetaBlock(uint64(l))
+	}
+	if m.ShardID != 0 {
+		n += 1 + sovMetaBlock(uint64(m.ShardID))
+	}
+	if m.Round != 0 {
+		n += 1 + sovMetaBlock(uint64(m.Round))
+	}
+	l = len(m.FirstHeader)
+	if l > 0 {
+		n += 1 + l + sovMetaBlock(uint64(l))
+	}
+	l = len(m.FirstSignature)
+	if l > 0 {
+		n += 1 + l + sovMetaBlock(uint64(l))
+	}
+	l = len(m.SecondHeader)
+	if l > 0 {
+		n += 1 + l + sovMetaBlock(uint64(l))
+	}
+	l = len(m.SecondSignature)
+	if l > 0 {
+		n += 1 + l + sovMetaBlock(uint64(l))
+	}
+	return n
+}
+
 func (m *MetaBlock) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2396,6 +2746,12 @@ func (m *MetaBlock) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovMetaBlock(uint64(l))
 	}
+	if len(m.SlashingEvidence) > 0 {
+		for _, e := range m.SlashingEvidence {
+			l = e.Size()
+			n += 2 + l + sovMetaBlock(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2503,6 +2859,23 @@ func (this *EpochStart) String() string {
 	}, "")
 	return s
 }
+func (this *SlashingEvidence) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SlashingEvidence{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`PubKey:` + fmt.Sprintf("%v", this.PubKey) + `,`,
+		`ShardID:` + fmt.Sprintf("%v", this.ShardID) + `,`,
+		`Round:` + fmt.Sprintf("%v", this.Round) + `,`,
+		`FirstHeader:` + fmt.Sprintf("%v", this.FirstHeader) + `,`,
+		`FirstSignature:` + fmt.Sprintf("%v", this.FirstSignature) + `,`,
+		`SecondHeader:` + fmt.Sprintf("%v", this.SecondHeader) + `,`,
+		`SecondSignature:` + fmt.Sprintf("%v", this.SecondSignature) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *MetaBlock) String() string {
 	if this == nil {
 		return "nil"
@@ -2522,6 +2895,11 @@ func (this *MetaBlock) String() string {
 		repeatedStringForMiniBlockHeaders += fmt.Sprintf("%v", f) + ","
 	}
 	repeatedStringForMiniBlockHeaders += "}"
+	repeatedStringForSlashingEvidence := "[]SlashingEvidence{"
+	for _, f := range this.SlashingEvidence {
+		repeatedStringForSlashingEvidence += strings.Replace(strings.Replace(f.String(), "SlashingEvidence", "SlashingEvidence", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSlashingEvidence += "}"
 	s := strings.Join([]string{`&MetaBlock{`,
 		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
 		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
@@ -2548,6 +2926,7 @@ func (this *MetaBlock) String() string {
 		`DevFeesInEpoch:` + fmt.Sprintf("%v", this.DevFeesInEpoch) + `,`,
 		`TxCount:` + fmt.Sprintf("%v", this.TxCount) + `,`,
 		`Reserved:` + fmt.Sprintf("%v", this.Reserved) + `,`,
+		`SlashingEvidence:` + repeatedStringForSlashingEvidence + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3957,7 +4336,7 @@ func (m *EpochStart) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MetaBlock) Unmarshal(dAtA []byte) error {
+func (m *SlashingEvidence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3980,17 +4359,17 @@ func (m *MetaBlock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MetaBlock: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlashingEvidence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MetaBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlashingEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.Nonce = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetaBlock
@@ -4000,16 +4379,16 @@ func (m *MetaBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				m.Type |= SlashingEvidenceType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 			}
-			m.Epoch = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetaBlock
@@ -4019,15 +4398,295 @@ func (m *MetaBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
-			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardID", wireType)
+			}
+			m.ShardID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetaBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetaBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstHeader", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetaBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FirstHeader = append(m.FirstHeader[:0], dAtA[iNdEx:postIndex]...)
+			if m.FirstHeader == nil {
+				m.FirstHeader = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetaBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FirstSignature = append(m.FirstSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.FirstSignature == nil {
+				m.FirstSignature = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondHeader", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetaBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecondHeader = append(m.SecondHeader[:0], dAtA[iNdEx:postIndex]...)
+			if m.SecondHeader == nil {
+				m.SecondHeader = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetaBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecondSignature = append(m.SecondSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.SecondSignature == nil {
+				m.SecondSignature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetaBlock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetaBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetaBlock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetaBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetaBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetaBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetaBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
 			m.Round = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
@@ -4776,6 +5435,40 @@ func (m *MetaBlock) Unmarshal(dAtA []byte) error {
 				m.Reserved = []byte{}
 			}
 			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingEvidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetaBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashingEvidence = append(m.SlashingEvidence, SlashingEvidence{})
+			if err := m.SlashingEvidence[len(m.SlashingEvidence)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetaBlock(dAtA[iNdEx:])
@@ -0,0 +1,16 @@
+package esdt
+
+// ApiNFTTokenData is the data transfer object which will be returned on the get non-fungible tokens endpoints
+type ApiNFTTokenData struct {
+	TokenIdentifier string   `json:"tokenIdentifier"`
+	Nonce           uint64   `json:"nonce"`
+	Type            string   `json:"type"`
+	Balance         string   `json:"balance"`
+	Properties      string   `json:"properties"`
+	Name            string   `json:"name"`
+	Creator         string   `json:"creator"`
+	Royalties       uint32   `json:"royalties"`
+	Hash            []byte   `json:"hash"`
+	URIs            [][]byte `json:"uris"`
+	Attributes      []byte   `json:"attributes"`
+}
@@ -62,6 +62,7 @@ type HeaderHandler interface {
 	SetLeaderSignature(sg []byte)
 	SetChainID(chainID []byte)
 	SetSoftwareVersion(version []byte)
+	SetReserved(reserved []byte)
 	SetTxCount(txCount uint32)
 
 	IsStartOfEpochBlock() bool
@@ -166,6 +167,7 @@ type Trie interface {
 	GetSerializedNodes([]byte, uint64) ([][]byte, uint64, error)
 	GetAllLeavesOnChannel(rootHash []byte, ctx context.Context) (chan core.KeyValueHolder, error)
 	GetAllHashes() ([][]byte, error)
+	GetProof(key []byte) ([][]byte, error)
 	IsPruningEnabled() bool
 	EnterPruningBufferingMode()
 	ExitPruningBufferingMode()
@@ -211,6 +213,7 @@ type StorageManager interface {
 	EnterPruningBufferingMode()
 	ExitPruningBufferingMode()
 	GetSnapshotDbBatchDelay() int
+	SetAppStatusHandler(handler core.AppStatusHandler) error
 	IsInterfaceNil() bool
 }
 
@@ -28,6 +28,7 @@ type TrieStub struct {
 	DatabaseCalled              func() data.DBWriteCacher
 	GetAllLeavesOnChannelCalled func(rootHash []byte) (chan core.KeyValueHolder, error)
 	GetAllHashesCalled          func() ([][]byte, error)
+	GetProofCalled              func(key []byte) ([][]byte, error)
 	IsPruningEnabledCalled      func() bool
 	ClosePersisterCalled        func() error
 }
@@ -212,6 +213,15 @@ func (ts *TrieStub) GetAllHashes() ([][]byte, error) {
 	return nil, nil
 }
 
+// GetProof -
+func (ts *TrieStub) GetProof(key []byte) ([][]byte, error) {
+	if ts.GetProofCalled != nil {
+		return ts.GetProofCalled(key)
+	}
+
+	return nil, nil
+}
+
 // GetSnapshotDbBatchDelay -
 func (ts *TrieStub) GetSnapshotDbBatchDelay() int {
 	return 0
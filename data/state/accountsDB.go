@@ -767,6 +767,48 @@ func (adb *AccountsDB) recreateTrie(rootHash []byte) error {
 	return nil
 }
 
+// RecreateAccountsAdapter creates a new accounts adapter over the state found at the provided root hash. The
+// current instance is not altered, so the returned adapter can be used concurrently for read operations
+func (adb *AccountsDB) RecreateAccountsAdapter(rootHash []byte) (AccountsAdapter, error) {
+	adb.mutOp.RLock()
+	mainTrie := adb.mainTrie
+	adb.mutOp.RUnlock()
+
+	recreatedTrie, err := mainTrie.Recreate(rootHash)
+	if err != nil || check.IfNil(recreatedTrie) {
+		return nil, NewErrMissingTrie(rootHash)
+	}
+
+	return NewAccountsDB(recreatedTrie, adb.hasher, adb.marshalizer, adb.accountFactory)
+}
+
+// GetAccountProof returns the Merkle proof of the account found at the provided address, generated against the
+// current root hash of the main trie. The main trie can hold uncommitted changes, so proofs of published state
+// should be requested from an adapter recreated at a committed root hash.
+func (adb *AccountsDB) GetAccountProof(address []byte) (*TrieProof, error) {
+	if len(address) == 0 {
+		return nil, fmt.Errorf("%w in GetAccountProof", ErrNilAddress)
+	}
+
+	adb.mutOp.Lock()
+	defer adb.mutOp.Unlock()
+
+	rootHash, err := adb.mainTrie.Root()
+	if err != nil {
+		return nil, err
+	}
+
+	proof, err := adb.mainTrie.GetProof(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TrieProof{
+		RootHash: rootHash,
+		Proof:    proof,
+	}, nil
+}
+
 // RecreateAllTries recreates all the tries from the accounts DB
 func (adb *AccountsDB) RecreateAllTries(rootHash []byte, ctx context.Context) (map[string]data.Trie, error) {
 	leavesChannel, err := adb.mainTrie.GetAllLeavesOnChannel(rootHash, ctx)
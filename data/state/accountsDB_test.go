@@ -6,6 +6,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"math/big"
 	"sync"
 	"testing"
 	"time"
@@ -783,6 +784,74 @@ func TestAccountsDB_RecreateTrieOkValsShouldWork(t *testing.T) {
 
 }
 
+func TestAccountsDB_RecreateAccountsAdapterMissingRootShouldErr(t *testing.T) {
+	t.Parallel()
+
+	trieStub := mock.TrieStub{}
+	trieStub.RecreateCalled = func(root []byte) (tree data.Trie, e error) {
+		return nil, errors.New("missing root")
+	}
+
+	adb := generateAccountDBFromTrie(&trieStub)
+	accounts, err := adb.RecreateAccountsAdapter([]byte("root"))
+
+	assert.True(t, check.IfNil(accounts))
+	assert.IsType(t, &state.ErrMissingTrie{}, err)
+}
+
+func TestAccountsDB_RecreateAccountsAdapterShouldNotAlterCurrentState(t *testing.T) {
+	t.Parallel()
+
+	adb, _ := getTestAccountsDbAndTrie(&mock.MarshalizerMock{}, mock.HasherMock{})
+	addr := make([]byte, 32)
+
+	acc, _ := adb.LoadAccount(addr)
+	_ = acc.(state.UserAccountHandler).AddToBalance(big.NewInt(10))
+	_ = adb.SaveAccount(acc)
+	oldRootHash, _ := adb.Commit()
+
+	acc, _ = adb.LoadAccount(addr)
+	_ = acc.(state.UserAccountHandler).AddToBalance(big.NewInt(5))
+	_ = adb.SaveAccount(acc)
+	_, _ = adb.Commit()
+
+	oldAccounts, err := adb.RecreateAccountsAdapter(oldRootHash)
+	assert.Nil(t, err)
+
+	oldAcc, err := oldAccounts.GetExistingAccount(addr)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(10), oldAcc.(state.UserAccountHandler).GetBalance())
+
+	currentAcc, err := adb.GetExistingAccount(addr)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(15), currentAcc.(state.UserAccountHandler).GetBalance())
+}
+
+func TestAccountsDB_GetAccountProofShouldBeVerifiable(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	hasher := mock.HasherMock{}
+	adb, _ := getTestAccountsDbAndTrie(marshalizer, hasher)
+	addr := make([]byte, 32)
+
+	acc, _ := adb.LoadAccount(addr)
+	_ = acc.(state.UserAccountHandler).AddToBalance(big.NewInt(10))
+	_ = adb.SaveAccount(acc)
+	rootHash, _ := adb.Commit()
+
+	accountProof, err := adb.GetAccountProof(addr)
+	assert.Nil(t, err)
+	assert.Equal(t, rootHash, accountProof.RootHash)
+
+	value, err := trie.VerifyProof(accountProof.RootHash, addr, accountProof.Proof, marshalizer, hasher)
+	assert.Nil(t, err)
+	assert.NotNil(t, value)
+
+	_, err = adb.GetAccountProof(nil)
+	assert.True(t, errors.Is(err, state.ErrNilAddress))
+}
+
 func TestAccountsDB_CancelPrune(t *testing.T) {
 	t.Parallel()
 
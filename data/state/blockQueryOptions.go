@@ -0,0 +1,14 @@
+package state
+
+// BlockQueryOptions holds the block (identified either by nonce or by hash) at which the accounts state should be
+// read. The zero value selects the current state.
+type BlockQueryOptions struct {
+	BlockNonce    uint64
+	HasBlockNonce bool
+	BlockHash     []byte
+}
+
+// IsCurrentState returns true if no block was selected, meaning that the current state should be used
+func (options BlockQueryOptions) IsCurrentState() bool {
+	return !options.HasBlockNonce && len(options.BlockHash) == 0
+}
@@ -115,3 +115,6 @@ var ErrNilMapOfHashes = errors.New("nil map of hashes")
 
 // ErrInvalidRootHash signals that the provided root hash is invalid
 var ErrInvalidRootHash = errors.New("invalid root hash")
+
+// ErrNilAccountsAdapterRecreator signals that a nil accounts adapter recreator has been provided
+var ErrNilAccountsAdapterRecreator = errors.New("nil accounts adapter recreator")
@@ -144,6 +144,19 @@ type AccountsAdapter interface {
 	IsInterfaceNil() bool
 }
 
+// AccountsAdapterRecreator is able to create accounts adapters over the state found at a given root hash, without
+// altering the original adapter
+type AccountsAdapterRecreator interface {
+	RecreateAccountsAdapter(rootHash []byte) (AccountsAdapter, error)
+	IsInterfaceNil() bool
+}
+
+// AccountsProofProvider is able to generate Merkle proofs for the accounts stored in the main trie
+type AccountsProofProvider interface {
+	GetAccountProof(address []byte) (*TrieProof, error)
+	IsInterfaceNil() bool
+}
+
 // JournalEntry will be used to implement different state changes to be able to easily revert them
 type JournalEntry interface {
 	Revert() (AccountHandler, error)
@@ -0,0 +1,154 @@
+package state
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// switchableAccountsDB is a wrapper over an accounts adapter which can temporarily serve the state found at another
+// root hash. While switched, the original accounts adapter is neither read nor altered.
+type switchableAccountsDB struct {
+	currentAccounts AccountsAdapter
+	recreator       AccountsAdapterRecreator
+
+	mutActive      sync.RWMutex
+	activeAccounts AccountsAdapter
+}
+
+// NewSwitchableAccountsDB returns a new instance of switchableAccountsDB
+func NewSwitchableAccountsDB(currentAccounts AccountsAdapter, recreator AccountsAdapterRecreator) (*switchableAccountsDB, error) {
+	if check.IfNil(currentAccounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+	if check.IfNil(recreator) {
+		return nil, ErrNilAccountsAdapterRecreator
+	}
+
+	return &switchableAccountsDB{
+		currentAccounts: currentAccounts,
+		recreator:       recreator,
+		activeAccounts:  currentAccounts,
+	}, nil
+}
+
+// SwitchToRootHash will make all subsequent calls be served from the state found at the provided root hash
+func (sadb *switchableAccountsDB) SwitchToRootHash(rootHash []byte) error {
+	accounts, err := sadb.recreator.RecreateAccountsAdapter(rootHash)
+	if err != nil {
+		return err
+	}
+
+	sadb.mutActive.Lock()
+	sadb.activeAccounts = accounts
+	sadb.mutActive.Unlock()
+
+	return nil
+}
+
+// SwitchToCurrentState will make all subsequent calls be served by the original accounts adapter
+func (sadb *switchableAccountsDB) SwitchToCurrentState() {
+	sadb.mutActive.Lock()
+	sadb.activeAccounts = sadb.currentAccounts
+	sadb.mutActive.Unlock()
+}
+
+func (sadb *switchableAccountsDB) getActiveAccounts() AccountsAdapter {
+	sadb.mutActive.RLock()
+	defer sadb.mutActive.RUnlock()
+
+	return sadb.activeAccounts
+}
+
+// GetExistingAccount will call the active accounts' function with the same name
+func (sadb *switchableAccountsDB) GetExistingAccount(address []byte) (AccountHandler, error) {
+	return sadb.getActiveAccounts().GetExistingAccount(address)
+}
+
+// LoadAccount will call the active accounts' function with the same name
+func (sadb *switchableAccountsDB) LoadAccount(address []byte) (AccountHandler, error) {
+	return sadb.getActiveAccounts().LoadAccount(address)
+}
+
+// SaveAccount will call the active accounts' function with the same name
+func (sadb *switchableAccountsDB) SaveAccount(account AccountHandler) error {
+	return sadb.getActiveAccounts().SaveAccount(account)
+}
+
+// RemoveAccount will call the active accounts' function with the same name
+func (sadb *switchableAccountsDB) RemoveAccount(address []byte) error {
+	return sadb.getActiveAccounts().RemoveAccount(address)
+}
+
+// Commit will call the active accounts' function with the same name
+func (sadb *switchableAccountsDB) Commit() ([]byte, error) {
+	return sadb.getActiveAccounts().Commit()
+}
+
+// JournalLen will call the active accounts' function with the same name
+func (sadb *switchableAccountsDB) JournalLen() int {
+	return sadb.getActiveAccounts().JournalLen()
+}
+
+// RevertToSnapshot will call the active accounts' function with the same name
+func (sadb *switchableAccountsDB) RevertToSnapshot(snapshot int) error {
+	return sadb.getActiveAccounts().RevertToSnapshot(snapshot)
+}
+
+// GetNumCheckpoints will call the active accounts' function with the same name
+func (sadb *switchableAccountsDB) GetNumCheckpoints() uint32 {
+	return sadb.getActiveAccounts().GetNumCheckpoints()
+}
+
+// RootHash will call the active accounts' function with the same name
+func (sadb *switchableAccountsDB) RootHash() ([]byte, error) {
+	return sadb.getActiveAccounts().RootHash()
+}
+
+// RecreateTrie will call the active accounts' function with the same name
+func (sadb *switchableAccountsDB) RecreateTrie(rootHash []byte) error {
+	return sadb.getActiveAccounts().RecreateTrie(rootHash)
+}
+
+// PruneTrie will call the active accounts' function with the same name
+func (sadb *switchableAccountsDB) PruneTrie(rootHash []byte, identifier data.TriePruningIdentifier) {
+	sadb.getActiveAccounts().PruneTrie(rootHash, identifier)
+}
+
+// CancelPrune will call the active accounts' function with the same name
+func (sadb *switchableAccountsDB) CancelPrune(rootHash []byte, identifier data.TriePruningIdentifier) {
+	sadb.getActiveAccounts().CancelPrune(rootHash, identifier)
+}
+
+// SnapshotState will call the active accounts' function with the same name
+func (sadb *switchableAccountsDB) SnapshotState(rootHash []byte, ctx context.Context) {
+	sadb.getActiveAccounts().SnapshotState(rootHash, ctx)
+}
+
+// SetStateCheckpoint will call the active accounts' function with the same name
+func (sadb *switchableAccountsDB) SetStateCheckpoint(rootHash []byte, ctx context.Context) {
+	sadb.getActiveAccounts().SetStateCheckpoint(rootHash, ctx)
+}
+
+// IsPruningEnabled will call the active accounts' function with the same name
+func (sadb *switchableAccountsDB) IsPruningEnabled() bool {
+	return sadb.getActiveAccounts().IsPruningEnabled()
+}
+
+// GetAllLeaves will call the active accounts' function with the same name
+func (sadb *switchableAccountsDB) GetAllLeaves(rootHash []byte, ctx context.Context) (chan core.KeyValueHolder, error) {
+	return sadb.getActiveAccounts().GetAllLeaves(rootHash, ctx)
+}
+
+// RecreateAllTries will call the active accounts' function with the same name
+func (sadb *switchableAccountsDB) RecreateAllTries(rootHash []byte, ctx context.Context) (map[string]data.Trie, error) {
+	return sadb.getActiveAccounts().RecreateAllTries(rootHash, ctx)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sadb *switchableAccountsDB) IsInterfaceNil() bool {
+	return sadb == nil
+}
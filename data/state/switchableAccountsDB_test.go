@@ -0,0 +1,72 @@
+package state_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSwitchableAccountsDB_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	adb, _ := getTestAccountsDbAndTrie(&mock.MarshalizerMock{}, mock.HasherMock{})
+
+	sadb, err := state.NewSwitchableAccountsDB(nil, adb)
+	assert.True(t, check.IfNil(sadb))
+	assert.Equal(t, state.ErrNilAccountsAdapter, err)
+
+	sadb, err = state.NewSwitchableAccountsDB(adb, nil)
+	assert.True(t, check.IfNil(sadb))
+	assert.Equal(t, state.ErrNilAccountsAdapterRecreator, err)
+
+	sadb, err = state.NewSwitchableAccountsDB(adb, adb)
+	assert.False(t, check.IfNil(sadb))
+	assert.Nil(t, err)
+}
+
+func TestSwitchableAccountsDB_SwitchToRootHashMissingRootShouldErr(t *testing.T) {
+	t.Parallel()
+
+	adb, _ := getTestAccountsDbAndTrie(&mock.MarshalizerMock{}, mock.HasherMock{})
+	sadb, _ := state.NewSwitchableAccountsDB(adb, adb)
+
+	err := sadb.SwitchToRootHash([]byte("missing root hash"))
+	assert.True(t, errors.As(err, new(*state.ErrMissingTrie)))
+}
+
+func TestSwitchableAccountsDB_SwitchToRootHashAndBack(t *testing.T) {
+	t.Parallel()
+
+	adb, _ := getTestAccountsDbAndTrie(&mock.MarshalizerMock{}, mock.HasherMock{})
+	addr := make([]byte, 32)
+
+	acc, _ := adb.LoadAccount(addr)
+	_ = acc.(state.UserAccountHandler).AddToBalance(big.NewInt(10))
+	_ = adb.SaveAccount(acc)
+	oldRootHash, _ := adb.Commit()
+
+	acc, _ = adb.LoadAccount(addr)
+	_ = acc.(state.UserAccountHandler).AddToBalance(big.NewInt(5))
+	_ = adb.SaveAccount(acc)
+	_, _ = adb.Commit()
+
+	sadb, _ := state.NewSwitchableAccountsDB(adb, adb)
+
+	err := sadb.SwitchToRootHash(oldRootHash)
+	assert.Nil(t, err)
+	acc, err = sadb.GetExistingAccount(addr)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(10), acc.(state.UserAccountHandler).GetBalance())
+	rootHash, _ := sadb.RootHash()
+	assert.Equal(t, oldRootHash, rootHash)
+
+	sadb.SwitchToCurrentState()
+	acc, err = sadb.GetExistingAccount(addr)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(15), acc.(state.UserAccountHandler).GetBalance())
+}
@@ -0,0 +1,8 @@
+package state
+
+// TrieProof holds the encoded trie nodes that prove the presence or the absence of a key, together with the root
+// hash the proof was generated against
+type TrieProof struct {
+	RootHash []byte
+	Proof    [][]byte
+}
@@ -41,6 +41,43 @@ type ApiTransactionResult struct {
 	Receipt                           *ReceiptApi               `json:"receipt,omitempty"`
 	SmartContractResults              []*ApiSmartContractResult `json:"smartContractResults,omitempty"`
 	Status                            TxStatus                  `json:"status,omitempty"`
+	FinalityLevel                     TxFinalityLevel           `json:"finalityLevel,omitempty"`
+}
+
+// ApiTransactionsByAddress is the data transfer object which will be returned on the get transactions by address endpoint
+type ApiTransactionsByAddress struct {
+	Transactions []*ApiTransactionResult `json:"transactions"`
+	NextCursor   string                  `json:"nextCursor,omitempty"`
+}
+
+// ApiLogEventsQuery holds the criteria of a search on the indexed log events. The topic0 and the cursor are hex encoded
+type ApiLogEventsQuery struct {
+	Address    string
+	Identifier string
+	Topic0     string
+	FromNonce  uint64
+	ToNonce    uint64
+	Cursor     string
+	Limit      int
+}
+
+// ApiLogEvent represents a smart contract log event along with the transaction and the block that generated it
+type ApiLogEvent struct {
+	TxHash     string   `json:"txHash"`
+	EventIndex uint32   `json:"eventIndex"`
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     []string `json:"topics,omitempty"`
+	Data       string   `json:"data,omitempty"`
+	BlockNonce uint64   `json:"blockNonce"`
+	BlockHash  string   `json:"blockHash"`
+	Epoch      uint32   `json:"epoch"`
+}
+
+// ApiLogEvents is the data transfer object which will be returned on the log events search endpoint
+type ApiLogEvents struct {
+	Events     []*ApiLogEvent `json:"events"`
+	NextCursor string         `json:"nextCursor,omitempty"`
 }
 
 // SimulationResults is the data transfer object which will hold results for simulation a transaction's execution
@@ -50,6 +87,49 @@ type SimulationResults struct {
 	ScResults  map[string]*ApiSmartContractResult `json:"scResults,omitempty"`
 	Receipts   map[string]*ReceiptApi             `json:"receipts,omitempty"`
 	Hash       string                             `json:"hash,omitempty"`
+	Logs       []*SimulationLogEvent              `json:"logs,omitempty"`
+	CallTrace  []*SimulationCallFrame             `json:"callTrace,omitempty"`
+}
+
+// BatchSimulationResults is the data transfer object which will hold the results for simulating the execution of
+// several transactions, one after another
+type BatchSimulationResults struct {
+	Results       []*SimulationResults `json:"results"`
+	BalanceDeltas map[string]*big.Int  `json:"balanceDeltas,omitempty"`
+}
+
+// SimulationCallFrame represents a call executed while simulating a transaction, along with the calls it made
+type SimulationCallFrame struct {
+	CallType      string                    `json:"callType"`
+	Sender        string                    `json:"sender"`
+	Receiver      string                    `json:"receiver,omitempty"`
+	Function      string                    `json:"function,omitempty"`
+	Value         *big.Int                  `json:"value,omitempty"`
+	GasProvided   uint64                    `json:"gasProvided"`
+	GasUsed       uint64                    `json:"gasUsed"`
+	ReturnCode    string                    `json:"returnCode,omitempty"`
+	ReturnMessage string                    `json:"returnMessage,omitempty"`
+	Error         string                    `json:"error,omitempty"`
+	Scheduled     bool                      `json:"scheduled,omitempty"`
+	StorageWrites []*SimulationStorageWrite `json:"storageWrites,omitempty"`
+	Logs          []*SimulationLogEvent     `json:"logs,omitempty"`
+	Calls         []*SimulationCallFrame    `json:"calls,omitempty"`
+}
+
+// SimulationStorageWrite represents a storage update applied while simulating a transaction
+type SimulationStorageWrite struct {
+	Address  string `json:"address"`
+	Key      string `json:"key"`
+	OldValue string `json:"oldValue"`
+	NewValue string `json:"newValue"`
+}
+
+// SimulationLogEvent represents a log event emitted while simulating a transaction
+type SimulationLogEvent struct {
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     []string `json:"topics,omitempty"`
+	Data       string   `json:"data,omitempty"`
 }
 
 // ApiSmartContractResult represents a smart contract result with changed fields' types in order to make it friendly for API's json
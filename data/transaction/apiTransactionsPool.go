@@ -0,0 +1,56 @@
+package transaction
+
+// ApiTransactionsPoolForSender is the data transfer object which will be returned on the get pending transactions of a
+// sender endpoint
+type ApiTransactionsPoolForSender struct {
+	Sender       string                  `json:"sender"`
+	AccountNonce uint64                  `json:"accountNonce"`
+	Transactions []*ApiTransactionResult `json:"transactions"`
+	NonceGaps    []*ApiNonceGap          `json:"nonceGaps"`
+}
+
+// ApiNonceGap holds an interval of nonces, both ends included, missing from the pending transactions of a sender
+type ApiNonceGap struct {
+	From uint64 `json:"from"`
+	To   uint64 `json:"to"`
+}
+
+// ApiTransactionsPoolSenderInfo holds the nonce and score details the transactions pool keeps about a sender
+type ApiTransactionsPoolSenderInfo struct {
+	Sender              string `json:"sender"`
+	AccountNonce        uint64 `json:"accountNonce"`
+	AccountNonceKnown   bool   `json:"accountNonceKnown"`
+	NumTxs              uint64 `json:"numTxs"`
+	NumBytes            int64  `json:"numBytes"`
+	TotalGas            uint64 `json:"totalGas"`
+	Score               uint32 `json:"score"`
+	NumFailedSelections int64  `json:"numFailedSelections"`
+	HasInitialGap       bool   `json:"hasInitialGap"`
+	IsInGracePeriod     bool   `json:"isInGracePeriod"`
+}
+
+// ApiTransactionsPoolCacheStats holds the statistics of one of the caches of the transactions pool
+type ApiTransactionsPoolCacheStats struct {
+	CacheID                    string `json:"cacheId"`
+	NumTxs                     uint64 `json:"numTxs"`
+	NumBytes                   uint64 `json:"numBytes"`
+	NumSenders                 uint64 `json:"numSenders"`
+	NumEvictionPasses          uint64 `json:"numEvictionPasses"`
+	LastEvictionNumTxs         uint32 `json:"lastEvictionNumTxs"`
+	LastEvictionNumSenders     uint32 `json:"lastEvictionNumSenders"`
+	LastEvictionNumSteps       uint32 `json:"lastEvictionNumSteps"`
+	IsEvictionInProgress       bool   `json:"isEvictionInProgress"`
+	NumSendersWithInitialGap   uint64 `json:"numSendersWithInitialGap"`
+	NumSendersInGracePeriod    uint64 `json:"numSendersInGracePeriod"`
+	NumSendersSelectedLastTime uint64 `json:"numSendersSelectedLastTime"`
+}
+
+// ApiLastPendingNonce holds the nonce of the last pending transaction of a sender which follows the account nonce
+// without gaps, together with the nonce the next transaction of the sender should use
+type ApiLastPendingNonce struct {
+	Sender                 string `json:"sender"`
+	AccountNonce           uint64 `json:"accountNonce"`
+	HasPendingTransactions bool   `json:"hasPendingTransactions"`
+	LastPendingNonce       uint64 `json:"lastPendingNonce"`
+	NextNonce              uint64 `json:"nextNonce"`
+}
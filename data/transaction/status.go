@@ -24,6 +24,32 @@ func (tx TxStatus) String() string {
 	return string(tx)
 }
 
+// TxFinalityLevel is the stage reached by a transaction in its lifecycle
+type TxFinalityLevel string
+
+const (
+	// TxFinalityPendingInPool = received in the pool, not yet included in a block
+	TxFinalityPendingInPool TxFinalityLevel = "pendingInPool"
+	// TxFinalityIncludedInSource = included in a block of the source shard
+	TxFinalityIncludedInSource TxFinalityLevel = "includedInSource"
+	// TxFinalityNotarizedByMetachain = the source shard block has been notarized by a final metachain block
+	TxFinalityNotarizedByMetachain TxFinalityLevel = "notarizedByMetachain"
+	// TxFinalityExecutedAtDestination = executed in a block of the destination shard
+	TxFinalityExecutedAtDestination TxFinalityLevel = "executedAtDestination"
+	// TxFinalityFinal = the destination shard block has been notarized by a final metachain block
+	TxFinalityFinal TxFinalityLevel = "final"
+)
+
+// String returns the string representation of the finality level
+func (level TxFinalityLevel) String() string {
+	return string(level)
+}
+
+// IsExecutedAtDestination returns true if the transaction has been executed by the destination shard
+func (level TxFinalityLevel) IsExecutedAtDestination() bool {
+	return level == TxFinalityExecutedAtDestination || level == TxFinalityFinal
+}
+
 // StatusComputer computes a transaction status
 type StatusComputer struct {
 	MiniblockType        block.Type
@@ -96,3 +96,9 @@ var ErrContextClosing = errors.New("context closing")
 
 // ErrInvalidTimeout signals that an invalid timeout period has been provided
 var ErrInvalidTimeout = errors.New("invalid timeout value")
+
+// ErrInvalidProof signals that the provided proof does not match the root hash or the key
+var ErrInvalidProof = errors.New("invalid proof")
+
+// ErrNilStatusHandler signals that a nil status handler has been provided
+var ErrNilStatusHandler = errors.New("nil status handler")
@@ -92,6 +92,15 @@ func encodeNodeAndCommitToDB(n node, db data.DBWriteCacher) error {
 	return err
 }
 
+func getEncodedCollapsedNode(n node) ([]byte, error) {
+	collapsed, err := n.getCollapsed()
+	if err != nil {
+		return nil, err
+	}
+
+	return collapsed.getEncodedNode()
+}
+
 func getNodeFromDBAndDecode(n []byte, db data.DBWriteCacher, marshalizer marshal.Marshalizer, hasher hashing.Hasher) (node, error) {
 	encChild, err := db.Get(n)
 	if err != nil {
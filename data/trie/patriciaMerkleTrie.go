@@ -653,6 +653,44 @@ func (tr *patriciaMerkleTrie) GetAllHashes() ([][]byte, error) {
 	return hashes, nil
 }
 
+// GetProof returns the encoded nodes found on the path from the root to the given key. If the key is not present
+// in the trie, the returned nodes prove its absence. The proof can be checked with VerifyProof.
+func (tr *patriciaMerkleTrie) GetProof(key []byte) ([][]byte, error) {
+	tr.mutOperation.Lock()
+	defer tr.mutOperation.Unlock()
+
+	proof := make([][]byte, 0)
+	if tr.root == nil {
+		return proof, nil
+	}
+
+	err := tr.root.setRootHash()
+	if err != nil {
+		return nil, err
+	}
+
+	hexKey := keyBytesToHex(key)
+	currentNode := tr.root
+	for currentNode != nil {
+		var encodedNode []byte
+		encodedNode, err = getEncodedCollapsedNode(currentNode)
+		if err != nil {
+			return nil, err
+		}
+		proof = append(proof, encodedNode)
+
+		currentNode, hexKey, err = currentNode.getNext(hexKey, tr.Database())
+		if err == ErrNodeNotFound {
+			return proof, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return proof, nil
+}
+
 // IsPruningEnabled returns true if state pruning is enabled
 func (tr *patriciaMerkleTrie) IsPruningEnabled() bool {
 	return tr.trieStorage.IsPruningEnabled()
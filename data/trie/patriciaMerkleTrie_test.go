@@ -853,3 +853,83 @@ func BenchmarkPatriciaMerkleTrie_RootHashAfterChanging30000NodesInBatchesOf200(b
 		}
 	}
 }
+
+func TestPatriciaMerkleTrie_GetProofAndVerifyProof(t *testing.T) {
+	t.Parallel()
+
+	tr, values := initTrieMultipleValues(100)
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+
+	for _, key := range values {
+		proof, err := tr.GetProof(key)
+		assert.Nil(t, err)
+
+		value, err := trie.VerifyProof(rootHash, key, proof, &mock.ProtobufMarshalizerMock{}, &mock.KeccakMock{})
+		assert.Nil(t, err)
+		assert.Equal(t, key, value)
+	}
+}
+
+func TestPatriciaMerkleTrie_GetProofForMissingKeyShouldProveAbsence(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	rootHash, _ := tr.Root()
+	missingKey := []byte("missing key")
+
+	proof, err := tr.GetProof(missingKey)
+	assert.Nil(t, err)
+	assert.NotEqual(t, 0, len(proof))
+
+	value, err := trie.VerifyProof(rootHash, missingKey, proof, &mock.ProtobufMarshalizerMock{}, &mock.KeccakMock{})
+	assert.Nil(t, err)
+	assert.Nil(t, value)
+}
+
+func TestPatriciaMerkleTrie_GetProofOnEmptyTrie(t *testing.T) {
+	t.Parallel()
+
+	tr := emptyTrie()
+	rootHash, _ := tr.Root()
+
+	proof, err := tr.GetProof([]byte("dog"))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(proof))
+
+	value, err := trie.VerifyProof(rootHash, []byte("dog"), proof, &mock.ProtobufMarshalizerMock{}, &mock.KeccakMock{})
+	assert.Nil(t, err)
+	assert.Nil(t, value)
+}
+
+func TestVerifyProof_TamperedProofShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	rootHash, _ := tr.Root()
+	key := []byte("dog")
+
+	proof, _ := tr.GetProof(key)
+	lastNode := proof[len(proof)-1]
+	proof[len(proof)-1] = append([]byte{lastNode[0] + 1}, lastNode[1:]...)
+
+	value, err := trie.VerifyProof(rootHash, key, proof, &mock.ProtobufMarshalizerMock{}, &mock.KeccakMock{})
+	assert.Equal(t, trie.ErrInvalidProof, err)
+	assert.Nil(t, value)
+}
+
+func TestVerifyProof_WrongRootHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	key := []byte("dog")
+	proof, _ := tr.GetProof(key)
+
+	value, err := trie.VerifyProof([]byte("wrong root hash"), key, proof, &mock.ProtobufMarshalizerMock{}, &mock.KeccakMock{})
+	assert.Equal(t, trie.ErrInvalidProof, err)
+	assert.Nil(t, value)
+
+	value, err = trie.VerifyProof([]byte("wrong root hash"), key, nil, &mock.ProtobufMarshalizerMock{}, &mock.KeccakMock{})
+	assert.Equal(t, trie.ErrInvalidProof, err)
+	assert.Nil(t, value)
+}
@@ -0,0 +1,92 @@
+package trie
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+// VerifyProof checks the proof generated by GetProof for the given key against the provided root hash. It does not
+// need access to any storage. If the proof is valid, the value stored at the key is returned, or nil if the proof
+// shows that the key is not present in the trie.
+func VerifyProof(
+	rootHash []byte,
+	key []byte,
+	proof [][]byte,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+) ([]byte, error) {
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
+	if len(proof) == 0 {
+		if len(rootHash) == 0 || bytes.Equal(rootHash, EmptyTrieHash) {
+			return nil, nil
+		}
+
+		return nil, ErrInvalidProof
+	}
+
+	hexKey := keyBytesToHex(key)
+	expectedHash := rootHash
+	for i, encodedNode := range proof {
+		isLastNode := i == len(proof)-1
+		if !bytes.Equal(hasher.Compute(string(encodedNode)), expectedHash) {
+			return nil, ErrInvalidProof
+		}
+
+		n, err := decodeNode(encodedNode, marshalizer, hasher)
+		if err != nil {
+			return nil, err
+		}
+
+		switch currentNode := n.(type) {
+		case *leafNode:
+			if !isLastNode {
+				return nil, ErrInvalidProof
+			}
+			if bytes.Equal(currentNode.Key, hexKey) {
+				return currentNode.Value, nil
+			}
+
+			return nil, nil
+		case *extensionNode:
+			keyMatches := len(hexKey) >= len(currentNode.Key) && bytes.Equal(currentNode.Key, hexKey[:len(currentNode.Key)])
+			if !keyMatches {
+				return provenAbsent(isLastNode)
+			}
+
+			hexKey = hexKey[len(currentNode.Key):]
+			expectedHash = currentNode.EncodedChild
+		case *branchNode:
+			if len(hexKey) == 0 || childPosOutOfRange(hexKey[firstByte]) {
+				return nil, ErrInvalidProof
+			}
+
+			childPos := int(hexKey[firstByte])
+			if childPos >= len(currentNode.EncodedChildren) || len(currentNode.EncodedChildren[childPos]) == 0 {
+				return provenAbsent(isLastNode)
+			}
+
+			hexKey = hexKey[1:]
+			expectedHash = currentNode.EncodedChildren[childPos]
+		default:
+			return nil, ErrInvalidNode
+		}
+	}
+
+	return nil, ErrInvalidProof
+}
+
+func provenAbsent(isLastNode bool) ([]byte, error) {
+	if !isLastNode {
+		return nil, ErrInvalidProof
+	}
+
+	return nil, nil
+}
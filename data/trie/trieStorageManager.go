@@ -2,12 +2,14 @@ package trie
 
 import (
 	"encoding/hex"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path"
 	"sort"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
@@ -15,6 +17,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 )
@@ -40,6 +43,12 @@ type trieStorageManager struct {
 
 	dbEvictionWaitingList data.DBRemoveCacher
 	storageOperationMutex sync.RWMutex
+
+	mutAppStatusHandler sync.RWMutex
+	appStatusHandler    core.AppStatusHandler
+
+	mutLastSnapshotErr sync.RWMutex
+	lastSnapshotErr    error
 }
 
 type snapshotsQueueEntry struct {
@@ -84,6 +93,7 @@ func NewTrieStorageManager(
 		snapshotReq:           make(chan *snapshotsQueueEntry, generalConfig.SnapshotsBufferLen),
 		pruningBlockingOps:    0,
 		maxSnapshots:          generalConfig.MaxSnapshots,
+		appStatusHandler:      statusHandler.NewNilStatusHandler(),
 	}
 
 	go tsm.storageProcessLoop(marshalizer, hasher)
@@ -392,23 +402,87 @@ func (tsm *trieStorageManager) takeSnapshot(snapshot *snapshotsQueueEntry, msh m
 	newRoot, err := newSnapshotNode(tsm.db, msh, hsh, snapshot.rootHash)
 	if err != nil {
 		log.Error("trie storage manager: newSnapshotTrie", "error", err.Error())
+		tsm.setLastSnapshotErr(err)
 		return
 	}
 	db := tsm.getSnapshotDb(snapshot.newDb)
 	if check.IfNil(db) {
+		tsm.setLastSnapshotErr(ErrNilDatabase)
 		return
 	}
 
+	startTime := time.Now()
 	maxTrieLevelInMemory := uint(5)
 	err = newRoot.commit(true, 0, maxTrieLevelInMemory, tsm.db, db)
 	if err != nil {
 		log.Error("trie storage manager: commit", "error", err.Error())
+		tsm.setLastSnapshotErr(err)
 		return
 	}
+	tsm.observeSnapshotDuration(snapshot.newDb, time.Since(startTime))
+	tsm.setLastSnapshotErr(nil)
 
 	log.Trace("trie snapshot finished", "rootHash", snapshot.rootHash)
 }
 
+func (tsm *trieStorageManager) observeSnapshotDuration(newDb bool, duration time.Duration) {
+	operation := "checkpoint"
+	if newDb {
+		operation = "snapshot"
+	}
+
+	tsm.mutAppStatusHandler.RLock()
+	tsm.appStatusHandler.ObserveDuration(
+		core.MetricTrieSnapshotDuration,
+		duration,
+		core.MetricLabel{Name: core.MetricLabelOperation, Value: operation},
+	)
+	tsm.mutAppStatusHandler.RUnlock()
+}
+
+func (tsm *trieStorageManager) setLastSnapshotErr(err error) {
+	tsm.mutLastSnapshotErr.Lock()
+	tsm.lastSnapshotErr = err
+	tsm.mutLastSnapshotErr.Unlock()
+}
+
+// CheckHealth reports whether the last snapshot succeeded and whether the snapshots queue still has room
+func (tsm *trieStorageManager) CheckHealth() core.HealthCheckResult {
+	tsm.mutLastSnapshotErr.RLock()
+	lastSnapshotErr := tsm.lastSnapshotErr
+	tsm.mutLastSnapshotErr.RUnlock()
+
+	if lastSnapshotErr != nil {
+		return core.HealthCheckResult{
+			Status: core.HealthStatusDegraded,
+			Reason: fmt.Sprintf("last snapshot failed: %s", lastSnapshotErr.Error()),
+		}
+	}
+
+	isSnapshotsQueueFull := cap(tsm.snapshotReq) > 0 && len(tsm.snapshotReq) == cap(tsm.snapshotReq)
+	if isSnapshotsQueueFull {
+		return core.HealthCheckResult{
+			Status: core.HealthStatusDegraded,
+			Reason: fmt.Sprintf("snapshots queue is full: %d pending snapshots", len(tsm.snapshotReq)),
+		}
+	}
+
+	return core.NewHealthCheckResultOK()
+}
+
+// SetAppStatusHandler sets the status handler used to report the snapshot and checkpoint durations
+func (tsm *trieStorageManager) SetAppStatusHandler(handler core.AppStatusHandler) error {
+	if check.IfNil(handler) {
+		return ErrNilStatusHandler
+	}
+
+	tsm.mutAppStatusHandler.Lock()
+	tsm.appStatusHandler = handler
+	tsm.mutAppStatusHandler.Unlock()
+
+	return nil
+}
+
 func (tsm *trieStorageManager) isPresentInLastSnapshotDb(rootHash []byte) bool {
 	tsm.storageOperationMutex.Lock()
 	defer tsm.storageOperationMutex.Unlock()
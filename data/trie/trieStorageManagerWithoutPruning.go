@@ -3,6 +3,7 @@ package trie
 import (
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
 )
 
 // trieStorageManagerWithoutPruning manages the storage operations of the trie, but does not prune old values
@@ -16,7 +17,12 @@ func NewTrieStorageManagerWithoutPruning(db data.DBWriteCacher) (*trieStorageMan
 		return nil, ErrNilDatabase
 	}
 
-	return &trieStorageManagerWithoutPruning{&trieStorageManager{db: db}}, nil
+	tsm := &trieStorageManager{
+		db:               db,
+		appStatusHandler: statusHandler.NewNilStatusHandler(),
+	}
+
+	return &trieStorageManagerWithoutPruning{tsm}, nil
 }
 
 // TakeSnapshot does nothing if pruning is disabled
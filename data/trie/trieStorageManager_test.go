@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/mock"
 	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
@@ -398,6 +399,63 @@ func TestTrieCheckpointWithNoSnapshotCreatesSnapshot(t *testing.T) {
 	trieStorage.storageOperationMutex.Unlock()
 }
 
+func TestTrieStorageManager_SetAppStatusHandlerNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts, _ := NewTrieStorageManager(mock.NewMemDbMock(), &mock.MarshalizerMock{}, &mock.HasherMock{}, config.DBConfig{}, &mock.EvictionWaitingList{}, config.TrieStorageManagerConfig{})
+
+	err := ts.SetAppStatusHandler(nil)
+	assert.Equal(t, ErrNilStatusHandler, err)
+}
+
+func TestTrieStorageManager_CheckHealth(t *testing.T) {
+	t.Parallel()
+
+	ts, _ := NewTrieStorageManager(mock.NewMemDbMock(), &mock.MarshalizerMock{}, &mock.HasherMock{}, config.DBConfig{}, &mock.EvictionWaitingList{}, config.TrieStorageManagerConfig{})
+	assert.Equal(t, core.HealthStatusOK, ts.CheckHealth().Status)
+
+	ts.setLastSnapshotErr(ErrNilDatabase)
+	result := ts.CheckHealth()
+	assert.Equal(t, core.HealthStatusDegraded, result.Status)
+	assert.Equal(t, "last snapshot failed: "+ErrNilDatabase.Error(), result.Reason)
+
+	ts.setLastSnapshotErr(nil)
+	assert.Equal(t, core.HealthStatusOK, ts.CheckHealth().Status)
+}
+
+func TestTrieStorageManager_SnapshotAndCheckpointShouldObserveDuration(t *testing.T) {
+	t.Parallel()
+
+	mutOperations := sync.Mutex{}
+	operations := make([]string, 0)
+	tr, trieStorage, _ := newEmptyTrie()
+	err := trieStorage.SetAppStatusHandler(&mock.AppStatusHandlerStub{
+		ObserveDurationHandler: func(key string, _ time.Duration, labels ...core.MetricLabel) {
+			assert.Equal(t, core.MetricTrieSnapshotDuration, key)
+			assert.Equal(t, 1, len(labels))
+
+			mutOperations.Lock()
+			operations = append(operations, labels[0].Value)
+			mutOperations.Unlock()
+		},
+	})
+	assert.Nil(t, err)
+
+	_ = tr.Update([]byte("doe"), []byte("reindeer"))
+	_ = tr.Commit()
+	tr.TakeSnapshot(tr.root.getHash())
+	time.Sleep(snapshotDelay)
+
+	_ = tr.Update([]byte("dog"), []byte("puppy"))
+	_ = tr.Commit()
+	tr.SetCheckpoint(tr.root.getHash())
+	time.Sleep(snapshotDelay)
+
+	mutOperations.Lock()
+	assert.Equal(t, []string{"snapshot", "checkpoint"}, operations)
+	mutOperations.Unlock()
+}
+
 func TestTrieSnapshottingAndCheckpointConcurrently(t *testing.T) {
 	t.Parallel()
 
@@ -196,3 +196,6 @@ var ErrNilGracefullyCloseChannel = errors.New("nil gracefully close channel")
 
 // ErrNilSmartContractsPool signals that a nil smart contracts pool has been provided
 var ErrNilSmartContractsPool = errors.New("nil smart contracts pool")
+
+// ErrNilStatusHandler signals that a nil status handler has been provided
+var ErrNilStatusHandler = errors.New("nil status handler")
@@ -3,6 +3,7 @@ package factory
 import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/dataPool"
@@ -22,6 +23,7 @@ type ArgsDataPool struct {
 	Config           *config.Config
 	EconomicsData    process.EconomicsDataHandler
 	ShardCoordinator sharding.Coordinator
+	StatusHandler    core.AppStatusHandler
 }
 
 // NewDataPoolFromConfig will return a new instance of a PoolsHolder
@@ -37,6 +39,9 @@ func NewDataPoolFromConfig(args ArgsDataPool) (dataRetriever.PoolsHolder, error)
 	if check.IfNil(args.ShardCoordinator) {
 		return nil, dataRetriever.ErrNilShardCoordinator
 	}
+	if check.IfNil(args.StatusHandler) {
+		return nil, dataRetriever.ErrNilStatusHandler
+	}
 
 	mainConfig := args.Config
 
@@ -45,6 +50,7 @@ func NewDataPoolFromConfig(args ArgsDataPool) (dataRetriever.PoolsHolder, error)
 		NumberOfShards: args.ShardCoordinator.NumberOfShards(),
 		SelfShardID:    args.ShardCoordinator.SelfId(),
 		TxGasHandler:   args.EconomicsData,
+		StatusHandler:  args.StatusHandler,
 	})
 	if err != nil {
 		log.Error("error creating txpool")
@@ -6,6 +6,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/mock"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/ElrondNetwork/elrond-go/testscommon/economicsmocks"
 	"github.com/stretchr/testify/require"
@@ -36,6 +37,12 @@ func TestNewDataPoolFromConfig_MissingDependencyShouldErr(t *testing.T) {
 	holder, err = NewDataPoolFromConfig(args)
 	require.Nil(t, holder)
 	require.Equal(t, dataRetriever.ErrNilShardCoordinator, err)
+
+	args = getGoodArgs()
+	args.StatusHandler = nil
+	holder, err = NewDataPoolFromConfig(args)
+	require.Nil(t, holder)
+	require.Equal(t, dataRetriever.ErrNilStatusHandler, err)
 }
 
 func TestNewDataPoolFromConfig_BadConfigShouldErr(t *testing.T) {
@@ -100,5 +107,6 @@ func getGoodArgs() ArgsDataPool {
 		Config:           &config,
 		EconomicsData:    testEconomics,
 		ShardCoordinator: mock.NewMultipleShardsCoordinatorMock(),
+		StatusHandler:    statusHandler.NewNilStatusHandler(),
 	}
 }
@@ -85,6 +85,10 @@ const (
 	ReceiptsUnit UnitType = 15
 	// ResultsHashesByTxHashUnit is the results hashes by transaction storage unit identifier
 	ResultsHashesByTxHashUnit UnitType = 16
+	// TransactionsByAddressUnit is the transactions by address storage unit identifier
+	TransactionsByAddressUnit UnitType = 17
+	// LogEventsUnit is the log events by address and by identifier storage unit identifier
+	LogEventsUnit UnitType = 18
 
 	// ShardHdrNonceHashDataUnit is the header nonce-hash pair data unit identifier
 	//TODO: Add only unit types lower than 100
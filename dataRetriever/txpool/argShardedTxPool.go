@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
@@ -16,6 +17,7 @@ type ArgShardedTxPool struct {
 	TxGasHandler   txcache.TxGasHandler
 	NumberOfShards uint32
 	SelfShardID    uint32
+	StatusHandler  core.AppStatusHandler
 }
 
 // TODO: Upon further analysis and brainstorming, add some sensible minimum accepted values for the appropriate fields.
@@ -46,6 +48,9 @@ func (args *ArgShardedTxPool) verify() error {
 	if args.NumberOfShards == 0 {
 		return fmt.Errorf("%w: NumberOfShards is not valid", dataRetriever.ErrCacheConfigInvalidSharding)
 	}
+	if check.IfNil(args.StatusHandler) {
+		return fmt.Errorf("%w: StatusHandler is not valid", dataRetriever.ErrNilStatusHandler)
+	}
 
 	return nil
 }
@@ -15,4 +15,7 @@ type txCache interface {
 	ForEachTransaction(function txcache.ForEachTransaction)
 	NumBytes() int
 	Diagnose(deep bool)
+	GetTransactionsForSender(sender []byte) []*txcache.WrappedTransaction
+	GetSenderInfo(sender []byte) (*txcache.SenderInfo, bool)
+	GetStats() txcache.CacheStats
 }
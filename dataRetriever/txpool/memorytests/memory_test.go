@@ -14,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/txpool"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/testscommon/txcachemocks"
 	"github.com/stretchr/testify/require"
@@ -118,6 +119,7 @@ func newPool() dataRetriever.ShardedDataCacherNotifier {
 		},
 		NumberOfShards: 2,
 		SelfShardID:    0,
+		StatusHandler:  statusHandler.NewNilStatusHandler(),
 	}
 	pool, err := txpool.NewShardedTxPool(args)
 	if err != nil {
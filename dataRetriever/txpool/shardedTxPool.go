@@ -1,6 +1,8 @@
 package txpool
 
 import (
+	"fmt"
+	"sort"
 	"strconv"
 	"sync"
 
@@ -28,6 +30,7 @@ type shardedTxPool struct {
 	configPrototypeSourceMe      txcache.ConfigSourceMe
 	selfShardID                  uint32
 	txGasHandler                 txcache.TxGasHandler
+	statusHandler                core.AppStatusHandler
 }
 
 type txPoolShard struct {
@@ -56,6 +59,8 @@ func NewShardedTxPool(args ArgShardedTxPool) (*shardedTxPool, error) {
 		NumBytesPerSenderThreshold:    args.Config.SizeInBytesPerSender,
 		CountPerSenderThreshold:       args.Config.SizePerSender,
 		NumSendersToPreemptivelyEvict: dataRetriever.TxPoolNumSendersToPreemptivelyEvict,
+
+		MinGasPriceIncreasePercentForReplacement: args.Config.MinGasPriceIncreasePercentForReplacement,
 	}
 
 	// We do not reserve cross tx cache capacity for [metachain] -> [me] (no transactions), [me] -> me (already reserved above).
@@ -78,6 +83,7 @@ func NewShardedTxPool(args ArgShardedTxPool) (*shardedTxPool, error) {
 		configPrototypeSourceMe:      configPrototypeSourceMe,
 		selfShardID:                  args.SelfShardID,
 		txGasHandler:                 args.TxGasHandler,
+		statusHandler:                args.StatusHandler,
 	}
 
 	return shardedTxPoolObject, nil
@@ -140,6 +146,11 @@ func (txPool *shardedTxPool) createTxCache(cacheID string) txCache {
 			return txcache.NewDisabledCache()
 		}
 
+		err = cache.SetAppStatusHandler(txPool.statusHandler)
+		if err != nil {
+			log.Error("shardedTxPool.createTxCache()", "err", err)
+		}
+
 		return cache
 	}
 
@@ -327,6 +338,51 @@ func (txPool *shardedTxPool) GetCounts() counting.CountsWithSize {
 	return counts
 }
 
+// GetTransactionsForSender returns the transactions of the given sender held by all the caches, sorted by nonce
+func (txPool *shardedTxPool) GetTransactionsForSender(sender []byte) []*txcache.WrappedTransaction {
+	txPool.mutexBackingMap.RLock()
+	defer txPool.mutexBackingMap.RUnlock()
+
+	result := make([]*txcache.WrappedTransaction, 0)
+	for _, shard := range txPool.backingMap {
+		result = append(result, shard.Cache.GetTransactionsForSender(sender)...)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Tx.GetNonce() < result[j].Tx.GetNonce()
+	})
+
+	return result
+}
+
+// GetSenderInfo returns the nonce and score details held about the given sender by the cache of its transactions
+func (txPool *shardedTxPool) GetSenderInfo(sender []byte) (*txcache.SenderInfo, bool) {
+	txPool.mutexBackingMap.RLock()
+	defer txPool.mutexBackingMap.RUnlock()
+
+	for _, shard := range txPool.backingMap {
+		senderInfo, ok := shard.Cache.GetSenderInfo(sender)
+		if ok {
+			return senderInfo, true
+		}
+	}
+
+	return nil, false
+}
+
+// GetStats returns the statistics of each cache, by cache ID
+func (txPool *shardedTxPool) GetStats() map[string]txcache.CacheStats {
+	txPool.mutexBackingMap.RLock()
+	defer txPool.mutexBackingMap.RUnlock()
+
+	stats := make(map[string]txcache.CacheStats, len(txPool.backingMap))
+	for cacheID, shard := range txPool.backingMap {
+		stats[cacheID] = shard.Cache.GetStats()
+	}
+
+	return stats
+}
+
 // Diagnose diagnoses the internal caches
 func (txPool *shardedTxPool) Diagnose(deep bool) {
 	log.Debug("shardedTxPool.Diagnose()", "counts", txPool.GetCounts().String())
@@ -339,6 +395,46 @@ func (txPool *shardedTxPool) Diagnose(deep bool) {
 	}
 }
 
+// CheckHealth reports whether the pool is able to accept new transactions
+func (txPool *shardedTxPool) CheckHealth() core.HealthCheckResult {
+	txPool.mutexBackingMap.RLock()
+	defer txPool.mutexBackingMap.RUnlock()
+
+	cacheIDs := make([]string, 0, len(txPool.backingMap))
+	for cacheID := range txPool.backingMap {
+		cacheIDs = append(cacheIDs, cacheID)
+	}
+	sort.Strings(cacheIDs)
+
+	for _, cacheID := range cacheIDs {
+		cache := txPool.backingMap[cacheID].Cache
+		_, isDisabled := cache.(*txcache.DisabledCache)
+		if isDisabled {
+			return core.HealthCheckResult{
+				Status: core.HealthStatusDegraded,
+				Reason: fmt.Sprintf("cache %s could not be created, its transactions are dropped", cacheID),
+			}
+		}
+
+		_, isSourceMe := cache.(*txcache.TxCache)
+		if !isSourceMe {
+			continue
+		}
+
+		stats := cache.GetStats()
+		isFull := stats.NumTxs >= uint64(txPool.configPrototypeSourceMe.CountThreshold) ||
+			stats.NumBytes >= uint64(txPool.configPrototypeSourceMe.NumBytesThreshold)
+		if isFull {
+			return core.HealthCheckResult{
+				Status: core.HealthStatusDegraded,
+				Reason: fmt.Sprintf("cache %s is full: %d transactions, %d bytes", cacheID, stats.NumTxs, stats.NumBytes),
+			}
+		}
+	}
+
+	return core.NewHealthCheckResultOK()
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (txPool *shardedTxPool) IsInterfaceNil() bool {
 	return txPool == nil
@@ -1,16 +1,19 @@
 package txpool
 
 import (
+	"errors"
 	"fmt"
 	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/testscommon/txcachemocks"
 	"github.com/stretchr/testify/require"
@@ -39,6 +42,7 @@ func Test_NewShardedTxPool_WhenBadConfig(t *testing.T) {
 			GasProcessingDivisor: 100,
 		},
 		NumberOfShards: 1,
+		StatusHandler:  statusHandler.NewNilStatusHandler(),
 	}
 
 	args := goodArgs
@@ -93,10 +97,16 @@ func Test_NewShardedTxPool_WhenBadConfig(t *testing.T) {
 	require.Nil(t, pool)
 	require.NotNil(t, err)
 	require.Errorf(t, err, dataRetriever.ErrCacheConfigInvalidSharding.Error())
+
+	args = goodArgs
+	args.StatusHandler = nil
+	pool, err = NewShardedTxPool(args)
+	require.Nil(t, pool)
+	require.True(t, errors.Is(err, dataRetriever.ErrNilStatusHandler))
 }
 
 func Test_NewShardedTxPool_ComputesCacheConfig(t *testing.T) {
-	config := storageUnit.CacheConfig{SizeInBytes: 419430400, SizeInBytesPerSender: 614400, Capacity: 600000, SizePerSender: 1000, Shards: 1}
+	config := storageUnit.CacheConfig{SizeInBytes: 419430400, SizeInBytesPerSender: 614400, Capacity: 600000, SizePerSender: 1000, Shards: 1, MinGasPriceIncreasePercentForReplacement: 10}
 	args := ArgShardedTxPool{
 		Config: config,
 		TxGasHandler: &txcachemocks.TxGasHandlerMock{
@@ -105,6 +115,7 @@ func Test_NewShardedTxPool_ComputesCacheConfig(t *testing.T) {
 			GasProcessingDivisor: 1,
 		},
 		NumberOfShards: 2,
+		StatusHandler:  statusHandler.NewNilStatusHandler(),
 	}
 
 	pool, err := NewShardedTxPool(args)
@@ -116,6 +127,7 @@ func Test_NewShardedTxPool_ComputesCacheConfig(t *testing.T) {
 	require.Equal(t, 1000, int(pool.configPrototypeSourceMe.CountPerSenderThreshold))
 	require.Equal(t, 100, int(pool.configPrototypeSourceMe.NumSendersToPreemptivelyEvict))
 	require.Equal(t, 300000, int(pool.configPrototypeSourceMe.CountThreshold))
+	require.Equal(t, 10, int(pool.configPrototypeSourceMe.MinGasPriceIncreasePercentForReplacement))
 
 	require.Equal(t, 300000, int(pool.configPrototypeDestinationMe.MaxNumItems))
 	require.Equal(t, 209715200, int(pool.configPrototypeDestinationMe.MaxNumBytes))
@@ -333,6 +345,81 @@ func Test_GetCounts(t *testing.T) {
 	require.Equal(t, int64(0), pool.GetCounts().GetTotal())
 }
 
+func Test_GetTransactionsForSender(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+
+	pool.AddData([]byte("hash-x"), createTx("alice", 43), 0, "0")
+	pool.AddData([]byte("hash-y"), createTx("alice", 42), 0, "0")
+	pool.AddData([]byte("hash-z"), createTx("alice", 44), 0, "0_1")
+	pool.AddData([]byte("hash-w"), createTx("bob", 15), 0, "1_0")
+
+	txs := pool.GetTransactionsForSender([]byte("alice"))
+	require.Len(t, txs, 3)
+	require.Equal(t, []byte("hash-y"), txs[0].TxHash)
+	require.Equal(t, []byte("hash-x"), txs[1].TxHash)
+	require.Equal(t, []byte("hash-z"), txs[2].TxHash)
+
+	txs = pool.GetTransactionsForSender([]byte("bob"))
+	require.Len(t, txs, 1)
+	require.Equal(t, []byte("hash-w"), txs[0].TxHash)
+
+	require.Len(t, pool.GetTransactionsForSender([]byte("carol")), 0)
+}
+
+func Test_GetSenderInfo(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+
+	pool.AddData([]byte("hash-x"), createTx("alice", 42), 0, "0")
+	pool.AddData([]byte("hash-y"), createTx("alice", 43), 0, "0")
+	pool.AddData([]byte("hash-w"), createTx("bob", 15), 0, "1_0")
+
+	senderInfo, ok := pool.GetSenderInfo([]byte("alice"))
+	require.True(t, ok)
+	require.Equal(t, uint64(2), senderInfo.NumTxs)
+
+	senderInfo, ok = pool.GetSenderInfo([]byte("bob"))
+	require.False(t, ok)
+	require.Nil(t, senderInfo)
+}
+
+func Test_GetStats(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+
+	require.Len(t, pool.GetStats(), 0)
+	pool.AddData([]byte("hash-x"), createTx("alice", 42), 0, "0")
+	pool.AddData([]byte("hash-y"), createTx("alice", 43), 0, "0_1")
+	pool.AddData([]byte("hash-z"), createTx("bob", 15), 0, "1_0")
+
+	stats := pool.GetStats()
+	require.Len(t, stats, 2)
+	require.Equal(t, uint64(2), stats["0"].NumTxs)
+	require.Equal(t, uint64(1), stats["0"].NumSenders)
+	require.Equal(t, uint64(1), stats["1_0"].NumTxs)
+}
+
+func Test_CheckHealth(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+
+	require.Equal(t, core.HealthStatusOK, pool.CheckHealth().Status)
+
+	pool.AddData([]byte("hash-x"), createTx("alice", 42), 0, "0")
+	require.Equal(t, core.HealthStatusOK, pool.CheckHealth().Status)
+
+	countThreshold := int(pool.configPrototypeSourceMe.CountThreshold)
+	for i := 0; i < countThreshold; i++ {
+		sender := fmt.Sprintf("sender-%d", i)
+		pool.AddData([]byte(sender), createTx(sender, 0), 0, "0")
+	}
+
+	result := pool.CheckHealth()
+	require.Equal(t, core.HealthStatusDegraded, result.Status)
+	require.Contains(t, result.Reason, "cache 0 is full")
+}
+
 func Test_IsInterfaceNil(t *testing.T) {
 	poolAsInterface, _ := newTxPoolToTest()
 	require.False(t, check.IfNil(poolAsInterface))
@@ -362,6 +449,7 @@ func Test_routeToCacheUnions(t *testing.T) {
 		},
 		NumberOfShards: 4,
 		SelfShardID:    42,
+		StatusHandler:  statusHandler.NewNilStatusHandler(),
 	}
 	pool, _ := NewShardedTxPool(args)
 
@@ -405,6 +493,7 @@ func newTxPoolToTest() (dataRetriever.ShardedDataCacherNotifier, error) {
 		},
 		NumberOfShards: 4,
 		SelfShardID:    0,
+		StatusHandler:  statusHandler.NewNilStatusHandler(),
 	}
 	return NewShardedTxPool(args)
 }
@@ -37,6 +37,11 @@ func (m *multiSigner) CreateSignatureShare(_ []byte, _ []byte) ([]byte, error) {
 	return nil, nil
 }
 
+// CreateSignatureShareWithPrivateKey -
+func (m *multiSigner) CreateSignatureShareWithPrivateKey(_ []byte, _ crypto.PrivateKey) ([]byte, error) {
+	return nil, nil
+}
+
 // StoreSignatureShare -
 func (m *multiSigner) StoreSignatureShare(_ uint16, _ []byte) error {
 	return nil
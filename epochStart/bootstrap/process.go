@@ -379,6 +379,7 @@ func (e *epochStartBootstrap) Bootstrap() (Parameters, error) {
 			Config:           &e.generalConfig,
 			EconomicsData:    e.economicsData,
 			ShardCoordinator: e.shardCoordinator,
+			StatusHandler:    e.statusHandler,
 		},
 	)
 	if err != nil {
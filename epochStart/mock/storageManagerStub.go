@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data"
 )
 
@@ -92,6 +93,11 @@ func (sms *StorageManagerStub) GetSnapshotDbBatchDelay() int {
 	return 0
 }
 
+// SetAppStatusHandler -
+func (sms *StorageManagerStub) SetAppStatusHandler(_ core.AppStatusHandler) error {
+	return nil
+}
+
 // IsInterfaceNil --
 func (sms *StorageManagerStub) IsInterfaceNil() bool {
 	return sms == nil
@@ -25,3 +25,9 @@ var ErrNilAccountState = errors.New("nil account state")
 
 // ErrNilTransactionSimulatorProcessor signals that a nil transaction simulator processor has been provided
 var ErrNilTransactionSimulatorProcessor = errors.New("nil transaction simulator processor")
+
+// ErrNilSubscriptionHub signals that a nil subscription hub has been provided
+var ErrNilSubscriptionHub = errors.New("nil subscription hub")
+
+// ErrNilHealthService signals that a nil health service has been provided
+var ErrNilHealthService = errors.New("nil health service")
@@ -4,8 +4,10 @@ import (
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/api/hyperblock"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/debug"
@@ -19,14 +21,14 @@ type NodeHandler interface {
 	// StartConsensus will start the consesus service for the current node
 	StartConsensus() error
 
-	// GetBalance returns the balance for a specific address
-	GetBalance(address string) (*big.Int, error)
+	// GetBalance returns the balance for a specific address, as it was at the block selected by the options
+	GetBalance(address string, options state.BlockQueryOptions) (*big.Int, error)
 
 	// GetUsername returns the username for a specific address
 	GetUsername(address string) (string, error)
 
-	// GetValueForKey returns the value of a key from a given account
-	GetValueForKey(address string, key string) (string, error)
+	// GetValueForKey returns the value of a key from a given account, as it was at the block selected by the options
+	GetValueForKey(address string, key string, options state.BlockQueryOptions) (string, error)
 
 	// GetESDTBalance returns the esdt balance and properties from a given account
 	GetESDTBalance(address string, key string) (string, string, error)
@@ -34,6 +36,15 @@ type NodeHandler interface {
 	// GetAllESDTTokens returns the value of a key from a given account
 	GetAllESDTTokens(address string) ([]string, error)
 
+	// GetESDTAllowance returns the value a spender is allowed to transfer on behalf of the owner for an esdt token
+	GetESDTAllowance(owner string, spender string, tokenName string) (string, error)
+
+	// GetNFT returns the non-fungible or semi-fungible token with the given identifier and nonce held by an address
+	GetNFT(address string, tokenIdentifier string, nonce uint64) (*esdt.ApiNFTTokenData, error)
+
+	// GetAllNFTs returns all the non-fungible and semi-fungible tokens held by an address
+	GetAllNFTs(address string) ([]*esdt.ApiNFTTokenData, error)
+
 	//CreateTransaction will return a transaction from all needed fields
 	CreateTransaction(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64,
 		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*transaction.Transaction, []byte, error)
@@ -48,9 +59,36 @@ type NodeHandler interface {
 	//GetTransaction will return a transaction based on the hash
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 
+	// GetTransactionsByAddress will return a page of the transactions sent or received by an address
+	GetTransactionsByAddress(address string, cursor string, limit int) (*transaction.ApiTransactionsByAddress, error)
+
+	// GetLogEvents will return a page of the smart contract log events matching the provided query
+	GetLogEvents(query *transaction.ApiLogEventsQuery) (*transaction.ApiLogEvents, error)
+
+	// GetTransactionsPoolForSender will return the pending transactions of a sender together with their nonce gaps
+	GetTransactionsPoolForSender(sender string) (*transaction.ApiTransactionsPoolForSender, error)
+
+	// GetTransactionsPoolSenderInfo will return the nonce and score details the transactions pool keeps about a sender
+	GetTransactionsPoolSenderInfo(sender string) (*transaction.ApiTransactionsPoolSenderInfo, error)
+
+	// GetTransactionsPoolStats will return the statistics of each cache of the transactions pool
+	GetTransactionsPoolStats() ([]*transaction.ApiTransactionsPoolCacheStats, error)
+
+	// GetLastPendingNonceForSender will return the last pending nonce of a sender and the nonce its next transaction should use
+	GetLastPendingNonceForSender(sender string) (*transaction.ApiLastPendingNonce, error)
+
 	// GetAccount returns an accountResponse containing information
-	//  about the account corelated with provided address
-	GetAccount(address string) (state.UserAccountHandler, error)
+	//  about the account corelated with provided address, as it was at the block selected by the options
+	GetAccount(address string, options state.BlockQueryOptions) (state.UserAccountHandler, error)
+
+	// GetStateRootHashAtBlock returns the state root hash of the block selected by the options
+	GetStateRootHashAtBlock(options state.BlockQueryOptions) ([]byte, error)
+
+	// GetAccountProof returns the Merkle proof of the account found at the given address
+	GetAccountProof(address string, options state.BlockQueryOptions) (*state.TrieProof, error)
+
+	// GetKeyProof returns the Merkle proofs of the account found at the given address and of the given key in its data trie
+	GetKeyProof(address string, key string, options state.BlockQueryOptions) (*state.TrieProof, *state.TrieProof, error)
 
 	// GetHeartbeats returns the heartbeat status for each public key defined in genesis.json
 	GetHeartbeats() []data.PubKeyHeartbeat
@@ -71,11 +109,14 @@ type NodeHandler interface {
 
 	GetBlockByHash(hash string, withTxs bool) (*block.APIBlock, error)
 	GetBlockByNonce(nonce uint64, withTxs bool) (*block.APIBlock, error)
+	GetHyperblockByHash(hash string) (*hyperblock.APIHyperblock, error)
+	GetHyperblockByNonce(nonce uint64) (*hyperblock.APIHyperblock, error)
 }
 
 // TransactionSimulatorProcessor defines the actions which a transaction simulator processor has to implement
 type TransactionSimulatorProcessor interface {
-	ProcessTx(tx *transaction.Transaction) (*transaction.SimulationResults, error)
+	ProcessTx(tx *transaction.Transaction, withTrace bool) (*transaction.SimulationResults, error)
+	ProcessTxs(txs []*transaction.Transaction, withTrace bool) (*transaction.BatchSimulationResults, error)
 	IsInterfaceNil() bool
 }
 
@@ -88,6 +129,13 @@ type ApiResolver interface {
 	IsInterfaceNil() bool
 }
 
+// HealthService defines the health service methods used to answer the liveness and readiness probes
+type HealthService interface {
+	GetLiveness() core.HealthReport
+	GetReadiness() core.HealthReport
+	IsInterfaceNil() bool
+}
+
 // HardforkTrigger defines the structure used to trigger hardforks
 type HardforkTrigger interface {
 	Trigger(epoch uint32, withEarlyEndOfEpoch bool) error
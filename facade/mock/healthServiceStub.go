@@ -0,0 +1,34 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+// HealthServiceStub -
+type HealthServiceStub struct {
+	GetLivenessCalled  func() core.HealthReport
+	GetReadinessCalled func() core.HealthReport
+}
+
+// GetLiveness -
+func (hss *HealthServiceStub) GetLiveness() core.HealthReport {
+	if hss.GetLivenessCalled != nil {
+		return hss.GetLivenessCalled()
+	}
+
+	return core.HealthReport{Status: core.HealthStatusOK}
+}
+
+// GetReadiness -
+func (hss *HealthServiceStub) GetReadiness() core.HealthReport {
+	if hss.GetReadinessCalled != nil {
+		return hss.GetReadinessCalled()
+	}
+
+	return core.HealthReport{Status: core.HealthStatusOK}
+}
+
+// IsInterfaceNil -
+func (hss *HealthServiceStub) IsInterfaceNil() bool {
+	return hss == nil
+}
@@ -5,7 +5,9 @@ import (
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/api/hyperblock"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/debug"
@@ -17,7 +19,7 @@ type NodeStub struct {
 	AddressHandler             func() (string, error)
 	ConnectToAddressesHandler  func([]string) error
 	StartConsensusHandler      func() error
-	GetBalanceHandler          func(address string) (*big.Int, error)
+	GetBalanceHandler          func(address string, options state.BlockQueryOptions) (*big.Int, error)
 	GenerateTransactionHandler func(sender string, receiver string, amount string, code string) (*transaction.Transaction, error)
 	CreateTransactionHandler   func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64,
 		gasLimit uint64, data []byte, signatureHex string, chainID string, version, options uint32) (*transaction.Transaction, []byte, error)
@@ -25,7 +27,7 @@ type NodeStub struct {
 	ValidateTransactionForSimulationCalled         func(tx *transaction.Transaction) error
 	GetTransactionHandler                          func(hash string, withEvents bool) (*transaction.ApiTransactionResult, error)
 	SendBulkTransactionsHandler                    func(txs []*transaction.Transaction) (uint64, error)
-	GetAccountHandler                              func(address string) (state.UserAccountHandler, error)
+	GetAccountHandler                              func(address string, options state.BlockQueryOptions) (state.UserAccountHandler, error)
 	GetCurrentPublicKeyHandler                     func() string
 	GenerateAndSendBulkTransactionsHandler         func(destination string, value *big.Int, nrTransactions uint64) error
 	GenerateAndSendBulkTransactionsOneByOneHandler func(destination string, value *big.Int, nrTransactions uint64) error
@@ -34,13 +36,27 @@ type NodeStub struct {
 	DirectTriggerCalled                            func(epoch uint32, withEarlyEndOfEpoch bool) error
 	IsSelfTriggerCalled                            func() bool
 	GetQueryHandlerCalled                          func(name string) (debug.QueryHandler, error)
-	GetValueForKeyCalled                           func(address string, key string) (string, error)
+	GetValueForKeyCalled                           func(address string, key string, options state.BlockQueryOptions) (string, error)
 	GetPeerInfoCalled                              func(pid string) ([]core.QueryP2PPeerInfo, error)
 	GetBlockByHashCalled                           func(hash string, withTxs bool) (*block.APIBlock, error)
 	GetBlockByNonceCalled                          func(nonce uint64, withTxs bool) (*block.APIBlock, error)
+	GetHyperblockByHashCalled                      func(hash string) (*hyperblock.APIHyperblock, error)
+	GetHyperblockByNonceCalled                     func(nonce uint64) (*hyperblock.APIHyperblock, error)
 	GetUsernameCalled                              func(address string) (string, error)
 	GetESDTBalanceCalled                           func(address string, key string) (string, string, error)
 	GetAllESDTTokensCalled                         func(address string) ([]string, error)
+	GetESDTAllowanceCalled                         func(owner string, spender string, tokenName string) (string, error)
+	GetNFTCalled                                   func(address string, tokenIdentifier string, nonce uint64) (*esdt.ApiNFTTokenData, error)
+	GetAllNFTsCalled                               func(address string) ([]*esdt.ApiNFTTokenData, error)
+	GetTransactionsByAddressCalled                 func(address string, cursor string, limit int) (*transaction.ApiTransactionsByAddress, error)
+	GetLogEventsCalled                             func(query *transaction.ApiLogEventsQuery) (*transaction.ApiLogEvents, error)
+	GetTransactionsPoolForSenderCalled             func(sender string) (*transaction.ApiTransactionsPoolForSender, error)
+	GetTransactionsPoolSenderInfoCalled            func(sender string) (*transaction.ApiTransactionsPoolSenderInfo, error)
+	GetTransactionsPoolStatsCalled                 func() ([]*transaction.ApiTransactionsPoolCacheStats, error)
+	GetLastPendingNonceForSenderCalled             func(sender string) (*transaction.ApiLastPendingNonce, error)
+	GetStateRootHashAtBlockCalled                  func(options state.BlockQueryOptions) ([]byte, error)
+	GetAccountProofCalled                          func(address string, options state.BlockQueryOptions) (*state.TrieProof, error)
+	GetKeyProofCalled                              func(address string, key string, options state.BlockQueryOptions) (*state.TrieProof, *state.TrieProof, error)
 }
 
 // GetUsername -
@@ -53,9 +69,9 @@ func (ns *NodeStub) GetUsername(address string) (string, error) {
 }
 
 // GetValueForKey -
-func (ns *NodeStub) GetValueForKey(address string, key string) (string, error) {
+func (ns *NodeStub) GetValueForKey(address string, key string, options state.BlockQueryOptions) (string, error) {
 	if ns.GetValueForKeyCalled != nil {
-		return ns.GetValueForKeyCalled(address, key)
+		return ns.GetValueForKeyCalled(address, key, options)
 	}
 
 	return "", nil
@@ -76,6 +92,16 @@ func (ns *NodeStub) GetBlockByNonce(nonce uint64, withTxs bool) (*block.APIBlock
 	return ns.GetBlockByNonceCalled(nonce, withTxs)
 }
 
+// GetHyperblockByHash -
+func (ns *NodeStub) GetHyperblockByHash(hash string) (*hyperblock.APIHyperblock, error) {
+	return ns.GetHyperblockByHashCalled(hash)
+}
+
+// GetHyperblockByNonce -
+func (ns *NodeStub) GetHyperblockByNonce(nonce uint64) (*hyperblock.APIHyperblock, error) {
+	return ns.GetHyperblockByNonceCalled(nonce)
+}
+
 // DecodeAddressPubkey -
 func (ns *NodeStub) DecodeAddressPubkey(pk string) ([]byte, error) {
 	return hex.DecodeString(pk)
@@ -87,8 +113,8 @@ func (ns *NodeStub) StartConsensus() error {
 }
 
 // GetBalance -
-func (ns *NodeStub) GetBalance(address string) (*big.Int, error) {
-	return ns.GetBalanceHandler(address)
+func (ns *NodeStub) GetBalance(address string, options state.BlockQueryOptions) (*big.Int, error) {
+	return ns.GetBalanceHandler(address, options)
 }
 
 // CreateTransaction -
@@ -119,8 +145,17 @@ func (ns *NodeStub) SendBulkTransactions(txs []*transaction.Transaction) (uint64
 }
 
 // GetAccount -
-func (ns *NodeStub) GetAccount(address string) (state.UserAccountHandler, error) {
-	return ns.GetAccountHandler(address)
+func (ns *NodeStub) GetAccount(address string, options state.BlockQueryOptions) (state.UserAccountHandler, error) {
+	return ns.GetAccountHandler(address, options)
+}
+
+// GetStateRootHashAtBlock -
+func (ns *NodeStub) GetStateRootHashAtBlock(options state.BlockQueryOptions) ([]byte, error) {
+	if ns.GetStateRootHashAtBlockCalled != nil {
+		return ns.GetStateRootHashAtBlockCalled(options)
+	}
+
+	return nil, nil
 }
 
 // GetHeartbeats -
@@ -179,6 +214,105 @@ func (ns *NodeStub) GetAllESDTTokens(address string) ([]string, error) {
 	return []string{""}, nil
 }
 
+// GetESDTAllowance -
+func (ns *NodeStub) GetESDTAllowance(owner string, spender string, tokenName string) (string, error) {
+	if ns.GetESDTAllowanceCalled != nil {
+		return ns.GetESDTAllowanceCalled(owner, spender, tokenName)
+	}
+
+	return "", nil
+}
+
+// GetNFT -
+func (ns *NodeStub) GetNFT(address string, tokenIdentifier string, nonce uint64) (*esdt.ApiNFTTokenData, error) {
+	if ns.GetNFTCalled != nil {
+		return ns.GetNFTCalled(address, tokenIdentifier, nonce)
+	}
+
+	return nil, nil
+}
+
+// GetAllNFTs -
+func (ns *NodeStub) GetAllNFTs(address string) ([]*esdt.ApiNFTTokenData, error) {
+	if ns.GetAllNFTsCalled != nil {
+		return ns.GetAllNFTsCalled(address)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsByAddress -
+func (ns *NodeStub) GetTransactionsByAddress(address string, cursor string, limit int) (*transaction.ApiTransactionsByAddress, error) {
+	if ns.GetTransactionsByAddressCalled != nil {
+		return ns.GetTransactionsByAddressCalled(address, cursor, limit)
+	}
+
+	return &transaction.ApiTransactionsByAddress{}, nil
+}
+
+// GetLogEvents -
+func (ns *NodeStub) GetLogEvents(query *transaction.ApiLogEventsQuery) (*transaction.ApiLogEvents, error) {
+	if ns.GetLogEventsCalled != nil {
+		return ns.GetLogEventsCalled(query)
+	}
+
+	return &transaction.ApiLogEvents{}, nil
+}
+
+// GetTransactionsPoolForSender -
+func (ns *NodeStub) GetTransactionsPoolForSender(sender string) (*transaction.ApiTransactionsPoolForSender, error) {
+	if ns.GetTransactionsPoolForSenderCalled != nil {
+		return ns.GetTransactionsPoolForSenderCalled(sender)
+	}
+
+	return &transaction.ApiTransactionsPoolForSender{}, nil
+}
+
+// GetTransactionsPoolSenderInfo -
+func (ns *NodeStub) GetTransactionsPoolSenderInfo(sender string) (*transaction.ApiTransactionsPoolSenderInfo, error) {
+	if ns.GetTransactionsPoolSenderInfoCalled != nil {
+		return ns.GetTransactionsPoolSenderInfoCalled(sender)
+	}
+
+	return &transaction.ApiTransactionsPoolSenderInfo{}, nil
+}
+
+// GetTransactionsPoolStats -
+func (ns *NodeStub) GetTransactionsPoolStats() ([]*transaction.ApiTransactionsPoolCacheStats, error) {
+	if ns.GetTransactionsPoolStatsCalled != nil {
+		return ns.GetTransactionsPoolStatsCalled()
+	}
+
+	return make([]*transaction.ApiTransactionsPoolCacheStats, 0), nil
+}
+
+// GetLastPendingNonceForSender -
+func (ns *NodeStub) GetLastPendingNonceForSender(sender string) (*transaction.ApiLastPendingNonce, error) {
+	if ns.GetLastPendingNonceForSenderCalled != nil {
+		return ns.GetLastPendingNonceForSenderCalled(sender)
+	}
+
+	return &transaction.ApiLastPendingNonce{}, nil
+}
+
+// GetAccountProof -
+func (ns *NodeStub) GetAccountProof(address string, options state.BlockQueryOptions) (*state.TrieProof, error) {
+	if ns.GetAccountProofCalled != nil {
+		return ns.GetAccountProofCalled(address, options)
+	}
+
+	return &state.TrieProof{}, nil
+}
+
+// GetKeyProof -
+func (ns *NodeStub) GetKeyProof(address string, key string, options state.BlockQueryOptions) (*state.TrieProof, *state.TrieProof, error) {
+	if ns.GetKeyProofCalled != nil {
+		return ns.GetKeyProofCalled(address, key, options)
+	}
+
+	return &state.TrieProof{}, &state.TrieProof{}, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ns *NodeStub) IsInterfaceNil() bool {
 	return ns == nil
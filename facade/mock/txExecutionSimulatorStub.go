@@ -6,18 +6,28 @@ import (
 
 // TxExecutionSimulatorStub -
 type TxExecutionSimulatorStub struct {
-	ProcessTxCalled func(tx *transaction.Transaction) (*transaction.SimulationResults, error)
+	ProcessTxCalled  func(tx *transaction.Transaction, withTrace bool) (*transaction.SimulationResults, error)
+	ProcessTxsCalled func(txs []*transaction.Transaction, withTrace bool) (*transaction.BatchSimulationResults, error)
 }
 
 // ProcessTx -
-func (t *TxExecutionSimulatorStub) ProcessTx(tx *transaction.Transaction) (*transaction.SimulationResults, error) {
+func (t *TxExecutionSimulatorStub) ProcessTx(tx *transaction.Transaction, withTrace bool) (*transaction.SimulationResults, error) {
 	if t.ProcessTxCalled != nil {
-		return t.ProcessTxCalled(tx)
+		return t.ProcessTxCalled(tx, withTrace)
 	}
 
 	return &transaction.SimulationResults{}, nil
 }
 
+// ProcessTxs -
+func (t *TxExecutionSimulatorStub) ProcessTxs(txs []*transaction.Transaction, withTrace bool) (*transaction.BatchSimulationResults, error) {
+	if t.ProcessTxsCalled != nil {
+		return t.ProcessTxsCalled(txs, withTrace)
+	}
+
+	return &transaction.BatchSimulationResults{}, nil
+}
+
 // IsInterfaceNil -
 func (t *TxExecutionSimulatorStub) IsInterfaceNil() bool {
 	return t == nil
@@ -11,9 +11,12 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api"
 	"github.com/ElrondNetwork/elrond-go/api/address"
 	"github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/api/grpcApi"
 	"github.com/ElrondNetwork/elrond-go/api/hardfork"
+	"github.com/ElrondNetwork/elrond-go/api/hyperblock"
 	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/node"
+	subscriptionApi "github.com/ElrondNetwork/elrond-go/api/subscription"
 	transactionApi "github.com/ElrondNetwork/elrond-go/api/transaction"
 	"github.com/ElrondNetwork/elrond-go/api/validator"
 	"github.com/ElrondNetwork/elrond-go/api/vmValues"
@@ -21,8 +24,10 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/core/subscription"
 	"github.com/ElrondNetwork/elrond-go/core/throttler"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/data/vm"
@@ -43,6 +48,7 @@ const DefaultRestPortOff = "off"
 var _ = address.FacadeHandler(&nodeFacade{})
 var _ = hardfork.FacadeHandler(&nodeFacade{})
 var _ = node.FacadeHandler(&nodeFacade{})
+var _ = subscriptionApi.FacadeHandler(&nodeFacade{})
 var _ = transactionApi.FacadeHandler(&nodeFacade{})
 var _ = validator.FacadeHandler(&nodeFacade{})
 var _ = vmValues.FacadeHandler(&nodeFacade{})
@@ -54,6 +60,20 @@ type resetHandler interface {
 	IsInterfaceNil() bool
 }
 
+// sourceThrottler limits the requests originating from the same source, on both the REST API and the gRPC server
+type sourceThrottler interface {
+	api.MiddlewareProcessor
+	CanProcessSource(source string) bool
+	Reset()
+}
+
+// globalThrottler limits the simultaneous requests, on both the REST API and the gRPC server
+type globalThrottler interface {
+	api.MiddlewareProcessor
+	StartProcessing(path string) bool
+	EndProcessing(path string)
+}
+
 // ArgNodeFacade represents the argument for the nodeFacade
 type ArgNodeFacade struct {
 	Node                   NodeHandler
@@ -65,6 +85,8 @@ type ArgNodeFacade struct {
 	ApiRoutesConfig        config.ApiRoutesConfig
 	AccountsState          state.AccountsAdapter
 	PeerState              state.AccountsAdapter
+	SubscriptionHub        subscription.Hub
+	HealthService          HealthService
 }
 
 // nodeFacade represents a facade for grouping the functionality for the node
@@ -78,9 +100,13 @@ type nodeFacade struct {
 	apiRoutesConfig        config.ApiRoutesConfig
 	endpointsThrottlers    map[string]core.Throttler
 	wsAntifloodConfig      config.WebServerAntifloodConfig
+	sourceLimiter          sourceThrottler
+	globalLimiter          globalThrottler
 	restAPIServerDebugMode bool
 	accountsState          state.AccountsAdapter
 	peerState              state.AccountsAdapter
+	subscriptionHub        subscription.Hub
+	healthService          HealthService
 	ctx                    context.Context
 	cancelFunc             func()
 }
@@ -114,8 +140,22 @@ func NewNodeFacade(arg ArgNodeFacade) (*nodeFacade, error) {
 	if check.IfNil(arg.PeerState) {
 		return nil, ErrNilPeerState
 	}
+	if check.IfNil(arg.SubscriptionHub) {
+		return nil, ErrNilSubscriptionHub
+	}
+	if check.IfNil(arg.HealthService) {
+		return nil, ErrNilHealthService
+	}
 
 	throttlersMap := computeEndpointsNumGoRoutinesThrottlers(arg.WsAntifloodConfig)
+	sourceLimiter, err := middleware.NewSourceThrottler(arg.WsAntifloodConfig.SameSourceRequests)
+	if err != nil {
+		return nil, err
+	}
+	globalLimiter, err := middleware.NewGlobalThrottler(arg.WsAntifloodConfig.SimultaneousRequests)
+	if err != nil {
+		return nil, err
+	}
 
 	nf := &nodeFacade{
 		node:                   arg.Node,
@@ -126,8 +166,12 @@ func NewNodeFacade(arg ArgNodeFacade) (*nodeFacade, error) {
 		config:                 arg.FacadeConfig,
 		apiRoutesConfig:        arg.ApiRoutesConfig,
 		endpointsThrottlers:    throttlersMap,
+		sourceLimiter:          sourceLimiter,
+		globalLimiter:          globalLimiter,
 		accountsState:          arg.AccountsState,
 		peerState:              arg.PeerState,
+		subscriptionHub:        arg.SubscriptionHub,
+		healthService:          arg.HealthService,
 	}
 	nf.ctx, nf.cancelFunc = context.WithCancel(context.Background())
 
@@ -174,7 +218,11 @@ func (nf *nodeFacade) StartNode() error {
 
 // StartBackgroundServices starts all background services needed for the correct functionality of the node
 func (nf *nodeFacade) StartBackgroundServices() {
+	// the source and global limiters are shared, so that the requests received by the REST API and by the gRPC
+	// server are counted against the same limits
+	go nf.limiterReset(nf.sourceLimiter, nf.wsAntifloodConfig.SameSourceResetIntervalInSec)
 	go nf.startRest()
+	nf.startGrpc()
 }
 
 // RestAPIServerDebugMode return true is debug mode for Rest API is enabled
@@ -225,38 +273,78 @@ func (nf *nodeFacade) startRest() {
 	}
 }
 
-func (nf *nodeFacade) createMiddlewareLimiters() ([]api.MiddlewareProcessor, error) {
-	sourceLimiter, err := middleware.NewSourceThrottler(nf.wsAntifloodConfig.SameSourceRequests)
-	if err != nil {
-		return nil, err
+func (nf *nodeFacade) startGrpc() {
+	grpcConfig := nf.apiRoutesConfig.GrpcServer
+	if !grpcConfig.Enabled {
+		log.Debug("gRPC server is off")
+		return
 	}
-	go nf.sourceLimiterReset(sourceLimiter)
 
-	globalLimiter, err := middleware.NewGlobalThrottler(nf.wsAntifloodConfig.SimultaneousRequests)
+	server, err := grpcApi.NewServer(grpcApi.ArgsServer{
+		Facade:               nf,
+		SourceThrottler:      nf.sourceLimiter,
+		GlobalThrottler:      nf.globalLimiter,
+		RoutesConfig:         nf.apiRoutesConfig,
+		ListenAddress:        grpcConfig.ListenAddress,
+		MaxConcurrentStreams: grpcConfig.MaxConcurrentStreams,
+		MaxBlockStreams:      grpcConfig.MaxBlockStreams,
+	})
+	if err == nil {
+		err = server.Start()
+	}
 	if err != nil {
-		return nil, err
+		log.Error("could not start gRPC server",
+			"error", err.Error(),
+		)
+		return
+	}
+
+	go func() {
+		<-nf.ctx.Done()
+		log.Debug("closing gRPC server")
+		_ = server.Close()
+	}()
+}
+
+func (nf *nodeFacade) createMiddlewareLimiters() ([]api.MiddlewareProcessor, error) {
+	limiters := make([]api.MiddlewareProcessor, 0, 3)
+
+	// the authenticator should run first as the source limiter skips the requests authenticated by an API key
+	authConfig := nf.apiRoutesConfig.Authentication
+	if authConfig.Enabled {
+		if authConfig.QuotaResetIntervalInSec == 0 {
+			return nil, fmt.Errorf("%w, QuotaResetIntervalInSec should not be 0", ErrInvalidValue)
+		}
+
+		authenticator, err := middleware.NewApiKeyAuthenticator(nf.apiRoutesConfig)
+		if err != nil {
+			return nil, err
+		}
+		go nf.limiterReset(authenticator, authConfig.QuotaResetIntervalInSec)
+
+		limiters = append(limiters, authenticator)
 	}
 
-	return []api.MiddlewareProcessor{sourceLimiter, globalLimiter}, nil
+	return append(limiters, nf.sourceLimiter, nf.globalLimiter), nil
 }
 
-func (nf *nodeFacade) sourceLimiterReset(reset resetHandler) {
-	betweenResetDuration := time.Second * time.Duration(nf.wsAntifloodConfig.SameSourceResetIntervalInSec)
+func (nf *nodeFacade) limiterReset(reset resetHandler, resetIntervalInSec uint32) {
+	betweenResetDuration := time.Second * time.Duration(resetIntervalInSec)
 	for {
 		select {
 		case <-time.After(betweenResetDuration):
-			log.Trace("calling reset on WS source limiter")
+			log.Trace("calling reset on WS limiter")
 			reset.Reset()
 		case <-nf.ctx.Done():
-			log.Debug("closing nodeFacade.sourceLimiterReset go routine")
+			log.Debug("closing nodeFacade.limiterReset go routine")
 			return
 		}
 	}
 }
 
 // GetBalance gets the current balance for a specified address
-func (nf *nodeFacade) GetBalance(address string) (*big.Int, error) {
-	return nf.node.GetBalance(address)
+func (nf *nodeFacade) GetBalance(address string, options state.BlockQueryOptions) (*big.Int, error) {
+	return nf.node.GetBalance(address, options)
 }
 
 // GetUsername gets the username for a specified address
@@ -265,8 +353,8 @@ func (nf *nodeFacade) GetUsername(address string) (string, error) {
 }
 
 // GetValueForKey gets the value for a key in a given address
-func (nf *nodeFacade) GetValueForKey(address string, key string) (string, error) {
-	return nf.node.GetValueForKey(address, key)
+func (nf *nodeFacade) GetValueForKey(address string, key string, options state.BlockQueryOptions) (string, error) {
+	return nf.node.GetValueForKey(address, key, options)
 }
 
 // GetESDTBalance returns the ESDT balance and if it is frozen
@@ -279,6 +367,21 @@ func (nf *nodeFacade) GetAllESDTTokens(address string) ([]string, error) {
 	return nf.node.GetAllESDTTokens(address)
 }
 
+// GetESDTAllowance returns the value a spender is allowed to transfer on behalf of the owner for an esdt token
+func (nf *nodeFacade) GetESDTAllowance(owner string, spender string, tokenName string) (string, error) {
+	return nf.node.GetESDTAllowance(owner, spender, tokenName)
+}
+
+// GetNFT returns the non-fungible or semi-fungible token with the given identifier and nonce held by an address
+func (nf *nodeFacade) GetNFT(address string, tokenIdentifier string, nonce uint64) (*esdt.ApiNFTTokenData, error) {
+	return nf.node.GetNFT(address, tokenIdentifier, nonce)
+}
+
+// GetAllNFTs returns all the non-fungible and semi-fungible tokens held by an address
+func (nf *nodeFacade) GetAllNFTs(address string) ([]*esdt.ApiNFTTokenData, error) {
+	return nf.node.GetAllNFTs(address)
+}
+
 // CreateTransaction creates a transaction from all needed fields
 func (nf *nodeFacade) CreateTransaction(
 	nonce uint64,
@@ -317,9 +420,16 @@ func (nf *nodeFacade) SendBulkTransactions(txs []*transaction.Transaction) (uint
 	return nf.node.SendBulkTransactions(txs)
 }
 
-// SimulateTransactionExecution will simulate a transaction's execution and will return the results
-func (nf *nodeFacade) SimulateTransactionExecution(tx *transaction.Transaction) (*transaction.SimulationResults, error) {
-	return nf.txSimulatorProc.ProcessTx(tx)
+// SimulateTransactionExecution will simulate a transaction's execution and will return the results, optionally
+// along with the trace of the calls executed on behalf of the transaction
+func (nf *nodeFacade) SimulateTransactionExecution(tx *transaction.Transaction, withTrace bool) (*transaction.SimulationResults, error) {
+	return nf.txSimulatorProc.ProcessTx(tx, withTrace)
+}
+
+// SimulateTransactionsExecution will simulate the execution of the provided transactions, one after another, so that
+// each transaction sees the state changes done by the previous ones
+func (nf *nodeFacade) SimulateTransactionsExecution(txs []*transaction.Transaction, withTrace bool) (*transaction.BatchSimulationResults, error) {
+	return nf.txSimulatorProc.ProcessTxs(txs, withTrace)
 }
 
 // GetTransaction gets the transaction with a specified hash
@@ -327,6 +437,56 @@ func (nf *nodeFacade) GetTransaction(hash string, withResults bool) (*transactio
 	return nf.node.GetTransaction(hash, withResults)
 }
 
+// GetTransactionsByAddress gets a page of the transactions sent or received by the given address
+func (nf *nodeFacade) GetTransactionsByAddress(address string, cursor string, limit int) (*transaction.ApiTransactionsByAddress, error) {
+	return nf.node.GetTransactionsByAddress(address, cursor, limit)
+}
+
+// GetLogEvents gets a page of the smart contract log events matching the provided query
+func (nf *nodeFacade) GetLogEvents(query *transaction.ApiLogEventsQuery) (*transaction.ApiLogEvents, error) {
+	return nf.node.GetLogEvents(query)
+}
+
+// GetTransactionsPoolForSender gets the pending transactions of the given sender together with their nonce gaps
+func (nf *nodeFacade) GetTransactionsPoolForSender(sender string) (*transaction.ApiTransactionsPoolForSender, error) {
+	return nf.node.GetTransactionsPoolForSender(sender)
+}
+
+// GetTransactionsPoolSenderInfo gets the nonce and score details the transactions pool keeps about the given sender
+func (nf *nodeFacade) GetTransactionsPoolSenderInfo(sender string) (*transaction.ApiTransactionsPoolSenderInfo, error) {
+	return nf.node.GetTransactionsPoolSenderInfo(sender)
+}
+
+// GetTransactionsPoolStats gets the statistics of each cache of the transactions pool
+func (nf *nodeFacade) GetTransactionsPoolStats() ([]*transaction.ApiTransactionsPoolCacheStats, error) {
+	return nf.node.GetTransactionsPoolStats()
+}
+
+// GetLastPendingNonceForSender gets the last pending nonce of the given sender and the nonce its next transaction should use
+func (nf *nodeFacade) GetLastPendingNonceForSender(sender string) (*transaction.ApiLastPendingNonce, error) {
+	return nf.node.GetLastPendingNonceForSender(sender)
+}
+
+// GetAccountProof returns the Merkle proof of the account found at the given address
+func (nf *nodeFacade) GetAccountProof(address string, options state.BlockQueryOptions) (*state.TrieProof, error) {
+	return nf.node.GetAccountProof(address, options)
+}
+
+// GetKeyProof returns the Merkle proofs of the account found at the given address and of the given key in its data trie
+func (nf *nodeFacade) GetKeyProof(address string, key string, options state.BlockQueryOptions) (*state.TrieProof, *state.TrieProof, error) {
+	return nf.node.GetKeyProof(address, key, options)
+}
+
+// Subscribe creates a new subscription for the committed blocks events matching the provided filter
+func (nf *nodeFacade) Subscribe(filter subscription.Filter, fromNonce *uint64) (*subscription.Subscription, error) {
+	return nf.subscriptionHub.Subscribe(filter, fromNonce)
+}
+
+// Unsubscribe cancels the subscription with the given identifier
+func (nf *nodeFacade) Unsubscribe(subscriptionID uint64) {
+	nf.subscriptionHub.Unsubscribe(subscriptionID)
+}
+
 // ComputeTransactionGasLimit will estimate how many gas a transaction will consume
 func (nf *nodeFacade) ComputeTransactionGasLimit(tx *transaction.Transaction) (uint64, error) {
 	return nf.apiResolver.ComputeTransactionGasLimit(tx)
@@ -334,8 +494,8 @@ func (nf *nodeFacade) ComputeTransactionGasLimit(tx *transaction.Transaction) (u
 
 // GetAccount returns an accountResponse containing information
 // about the account correlated with provided address
-func (nf *nodeFacade) GetAccount(address string) (state.UserAccountHandler, error) {
-	return nf.node.GetAccount(address)
+func (nf *nodeFacade) GetAccount(address string, options state.BlockQueryOptions) (state.UserAccountHandler, error) {
+	return nf.node.GetAccount(address, options)
 }
 
 // GetHeartbeats returns the heartbeat status for each public key from initial list or later joined to the network
@@ -358,8 +518,17 @@ func (nf *nodeFacade) GetTotalStakedValue() (*big.Int, error) {
 	return nf.apiResolver.GetTotalStakedValue()
 }
 
-// ExecuteSCQuery retrieves data from existing SC trie
+// ExecuteSCQuery retrieves data from existing SC trie, as it was at the block selected by the query options
 func (nf *nodeFacade) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, error) {
+	if query != nil && !query.BlockQueryOptions.IsCurrentState() {
+		rootHash, err := nf.node.GetStateRootHashAtBlock(query.BlockQueryOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		query.BlockRootHash = rootHash
+	}
+
 	vmOutput, err := nf.apiResolver.ExecuteSCQuery(query)
 	if err != nil {
 		return nil, err
@@ -403,6 +572,16 @@ func (nf *nodeFacade) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return nf.node.GetPeerInfo(pid)
 }
 
+// GetLiveness returns the liveness report of the node
+func (nf *nodeFacade) GetLiveness() core.HealthReport {
+	return nf.healthService.GetLiveness()
+}
+
+// GetReadiness returns the readiness report of the node, built from the health of its components
+func (nf *nodeFacade) GetReadiness() core.HealthReport {
+	return nf.healthService.GetReadiness()
+}
+
 // GetThrottlerForEndpoint returns the throttler for a given endpoint if found
 func (nf *nodeFacade) GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool) {
 	throttlerForEndpoint, ok := nf.endpointsThrottlers[endpoint]
@@ -421,6 +600,16 @@ func (nf *nodeFacade) GetBlockByNonce(nonce uint64, withTxs bool) (*block.APIBlo
 	return nf.node.GetBlockByNonce(nonce, withTxs)
 }
 
+// GetHyperblockByHash returns the hyperblock of the meta block with the given hash
+func (nf *nodeFacade) GetHyperblockByHash(hash string) (*hyperblock.APIHyperblock, error) {
+	return nf.node.GetHyperblockByHash(hash)
+}
+
+// GetHyperblockByNonce returns the hyperblock of the meta block with the given nonce
+func (nf *nodeFacade) GetHyperblockByNonce(nonce uint64) (*hyperblock.APIHyperblock, error) {
+	return nf.node.GetHyperblockByNonce(nonce)
+}
+
 // Close will cleanup started go routines
 // TODO use this close method
 func (nf *nodeFacade) Close() error {
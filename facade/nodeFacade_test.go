@@ -13,6 +13,7 @@ import (
 	atomicCore "github.com/ElrondNetwork/elrond-go/core/atomic"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/core/subscription"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
@@ -49,8 +50,10 @@ func createMockArguments() ArgNodeFacade {
 				},
 			},
 		}},
-		AccountsState: &mock.AccountsStub{},
-		PeerState:     &mock.AccountsStub{},
+		AccountsState:   &mock.AccountsStub{},
+		PeerState:       &mock.AccountsStub{},
+		SubscriptionHub: subscription.NewNilHub(),
+		HealthService:   &mock.HealthServiceStub{},
 	}
 }
 
@@ -122,6 +125,49 @@ func TestNewNodeFacade_WithInvalidApiRoutesConfigShouldErr(t *testing.T) {
 	assert.True(t, errors.Is(err, ErrNoApiRoutesConfig))
 }
 
+func TestNewNodeFacade_WithNilSubscriptionHubShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.SubscriptionHub = nil
+	nf, err := NewNodeFacade(arg)
+
+	assert.True(t, check.IfNil(nf))
+	assert.Equal(t, ErrNilSubscriptionHub, err)
+}
+
+func TestNewNodeFacade_WithNilHealthServiceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.HealthService = nil
+	nf, err := NewNodeFacade(arg)
+
+	assert.True(t, check.IfNil(nf))
+	assert.Equal(t, ErrNilHealthService, err)
+}
+
+func TestNodeFacade_GetReadinessShouldReturnTheHealthServiceReport(t *testing.T) {
+	t.Parallel()
+
+	report := core.HealthReport{
+		Status: core.HealthStatusUnavailable,
+		Components: map[string]core.ComponentHealth{
+			"sync": {Status: core.HealthStatusUnavailable, Reason: "node is syncing"},
+		},
+	}
+	arg := createMockArguments()
+	arg.HealthService = &mock.HealthServiceStub{
+		GetReadinessCalled: func() core.HealthReport {
+			return report
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	assert.Equal(t, report, nf.GetReadiness())
+	assert.Equal(t, core.HealthStatusOK, nf.GetLiveness().Status)
+}
+
 func TestNewNodeFacade_WithValidNodeShouldReturnNotNil(t *testing.T) {
 	t.Parallel()
 
@@ -134,13 +180,63 @@ func TestNewNodeFacade_WithValidNodeShouldReturnNotNil(t *testing.T) {
 
 //------- Methods
 
+func TestNodeFacade_CreateMiddlewareLimitersWithoutAuthentication(t *testing.T) {
+	t.Parallel()
+
+	nf, _ := NewNodeFacade(createMockArguments())
+	defer func() {
+		_ = nf.Close()
+	}()
+
+	limiters, err := nf.createMiddlewareLimiters()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(limiters))
+}
+
+func TestNodeFacade_CreateMiddlewareLimitersWithAuthentication(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.ApiRoutesConfig.Authentication = config.ApiAuthenticationConfig{
+		Enabled:                 true,
+		KeyHeader:               "X-Api-Key",
+		QuotaResetIntervalInSec: 1,
+	}
+	nf, _ := NewNodeFacade(arg)
+	defer func() {
+		_ = nf.Close()
+	}()
+
+	limiters, err := nf.createMiddlewareLimiters()
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(limiters))
+}
+
+func TestNodeFacade_CreateMiddlewareLimitersWithInvalidAuthenticationShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.ApiRoutesConfig.Authentication = config.ApiAuthenticationConfig{
+		Enabled:   true,
+		KeyHeader: "X-Api-Key",
+	}
+	nf, _ := NewNodeFacade(arg)
+	defer func() {
+		_ = nf.Close()
+	}()
+
+	limiters, err := nf.createMiddlewareLimiters()
+	assert.Nil(t, limiters)
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+}
+
 func TestNodeFacade_GetBalanceWithValidAddressShouldReturnBalance(t *testing.T) {
 	t.Parallel()
 
 	balance := big.NewInt(10)
 	addr := "testAddress"
 	node := &mock.NodeStub{
-		GetBalanceHandler: func(address string) (*big.Int, error) {
+		GetBalanceHandler: func(address string, _ state.BlockQueryOptions) (*big.Int, error) {
 			if addr == address {
 				return balance, nil
 			}
@@ -152,7 +248,7 @@ func TestNodeFacade_GetBalanceWithValidAddressShouldReturnBalance(t *testing.T)
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	amount, err := nf.GetBalance(addr)
+	amount, err := nf.GetBalance(addr, state.BlockQueryOptions{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, balance, amount)
@@ -167,7 +263,7 @@ func TestNodeFacade_GetBalanceWithUnknownAddressShouldReturnZeroBalance(t *testi
 	zeroBalance := big.NewInt(0)
 
 	node := &mock.NodeStub{
-		GetBalanceHandler: func(address string) (*big.Int, error) {
+		GetBalanceHandler: func(address string, _ state.BlockQueryOptions) (*big.Int, error) {
 			if addr == address {
 				return balance, nil
 			}
@@ -179,7 +275,7 @@ func TestNodeFacade_GetBalanceWithUnknownAddressShouldReturnZeroBalance(t *testi
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	amount, err := nf.GetBalance(unknownAddr)
+	amount, err := nf.GetBalance(unknownAddr, state.BlockQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, zeroBalance, amount)
 }
@@ -191,7 +287,7 @@ func TestNodeFacade_GetBalanceWithErrorOnNodeShouldReturnZeroBalanceAndError(t *
 	zeroBalance := big.NewInt(0)
 
 	node := &mock.NodeStub{
-		GetBalanceHandler: func(address string) (*big.Int, error) {
+		GetBalanceHandler: func(address string, _ state.BlockQueryOptions) (*big.Int, error) {
 			return big.NewInt(0), errors.New("error on getBalance on node")
 		},
 	}
@@ -200,7 +296,7 @@ func TestNodeFacade_GetBalanceWithErrorOnNodeShouldReturnZeroBalanceAndError(t *
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	amount, err := nf.GetBalance(addr)
+	amount, err := nf.GetBalance(addr, state.BlockQueryOptions{})
 	assert.NotNil(t, err)
 	assert.Equal(t, zeroBalance, amount)
 }
@@ -279,7 +375,7 @@ func TestNodeFacade_GetAccount(t *testing.T) {
 
 	called := 0
 	node := &mock.NodeStub{}
-	node.GetAccountHandler = func(address string) (state.UserAccountHandler, error) {
+	node.GetAccountHandler = func(address string, _ state.BlockQueryOptions) (state.UserAccountHandler, error) {
 		called++
 		return nil, nil
 	}
@@ -288,7 +384,7 @@ func TestNodeFacade_GetAccount(t *testing.T) {
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	_, _ = nf.GetAccount("test")
+	_, _ = nf.GetAccount("test", state.BlockQueryOptions{})
 	assert.Equal(t, called, 1)
 }
 
@@ -379,6 +475,53 @@ func TestNodeFacade_GetDataValue(t *testing.T) {
 	assert.True(t, wasCalled)
 }
 
+func TestNodeFacade_ExecuteSCQueryAtBlockShouldSetRootHash(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	options := state.BlockQueryOptions{BlockNonce: 7, HasBlockNonce: true}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetStateRootHashAtBlockCalled: func(providedOptions state.BlockQueryOptions) ([]byte, error) {
+			assert.Equal(t, options, providedOptions)
+			return rootHash, nil
+		},
+	}
+	arg.ApiResolver = &mock.ApiResolverStub{
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			assert.Equal(t, rootHash, query.BlockRootHash)
+			return &vmcommon.VMOutput{}, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	_, err := nf.ExecuteSCQuery(&process.SCQuery{BlockQueryOptions: options})
+	assert.Nil(t, err)
+}
+
+func TestNodeFacade_ExecuteSCQueryAtMissingBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("block not found")
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetStateRootHashAtBlockCalled: func(_ state.BlockQueryOptions) ([]byte, error) {
+			return nil, expectedErr
+		},
+	}
+	arg.ApiResolver = &mock.ApiResolverStub{
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	output, err := nf.ExecuteSCQuery(&process.SCQuery{BlockQueryOptions: state.BlockQueryOptions{BlockHash: []byte("hash")}})
+	assert.Nil(t, output)
+	assert.Equal(t, expectedErr, err)
+}
+
 func TestNodeFacade_EmptyRestInterface(t *testing.T) {
 	t.Parallel()
 
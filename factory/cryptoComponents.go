@@ -1,13 +1,18 @@
 package factory
 
 import (
+	"bytes"
+	"encoding/hex"
 	"fmt"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/keystore"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/crypto/peerSignatureHandler"
+	"github.com/ElrondNetwork/elrond-go/crypto/remoteSigner"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing"
 	disabledMultiSig "github.com/ElrondNetwork/elrond-go/crypto/signing/disabled/multisig"
 	disabledSig "github.com/ElrondNetwork/elrond-go/crypto/signing/disabled/singlesig"
@@ -16,10 +21,12 @@ import (
 	mclMultiSig "github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/multisig"
 	mclSig "github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/singlesig"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing/multisig"
+	"github.com/ElrondNetwork/elrond-go/crypto/signingHandler"
 	"github.com/ElrondNetwork/elrond-go/genesis/process/disabled"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
 	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go/keysManagement"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
@@ -37,6 +44,8 @@ type CryptoComponentsFactoryArgs struct {
 	KeyGen                               crypto.KeyGenerator
 	PrivKey                              crypto.PrivateKey
 	ActivateBLSPubKeyMessageVerification bool
+	ManagedKeysPemFileName               string
+	ManagedKeysPassword                  []byte
 }
 
 type cryptoComponentsFactory struct {
@@ -48,6 +57,8 @@ type cryptoComponentsFactory struct {
 	privKey                              crypto.PrivateKey
 	activateBLSPubKeyMessageVerification bool
 	importDbNoSigCheckFlag               bool
+	managedKeysPemFileName               string
+	managedKeysPassword                  []byte
 }
 
 // NewCryptoComponentsFactory returns a new crypto components factory
@@ -74,6 +85,8 @@ func NewCryptoComponentsFactory(args CryptoComponentsFactoryArgs, importDbNoSigC
 		privKey:                              args.PrivKey,
 		activateBLSPubKeyMessageVerification: args.ActivateBLSPubKeyMessageVerification,
 		importDbNoSigCheckFlag:               importDbNoSigCheckFlag,
+		managedKeysPemFileName:               args.ManagedKeysPemFileName,
+		managedKeysPassword:                  args.ManagedKeysPassword,
 	}
 
 	return ccf, nil
@@ -129,11 +142,32 @@ func (ccf *cryptoComponentsFactory) Create() (*CryptoComponents, error) {
 		return nil, err
 	}
 
-	peerSigHandler, err := peerSignatureHandler.NewPeerSignatureHandler(cachePkPIDSignature, interceptSingleSigner, ccf.keyGen)
+	var peerSigHandler crypto.PeerSignatureHandler
+	peerSigHandler, err = peerSignatureHandler.NewPeerSignatureHandler(cachePkPIDSignature, interceptSingleSigner, ccf.keyGen)
 	if err != nil {
 		return nil, err
 	}
 
+	managedKeysHolder, err := ccf.createManagedKeysHolder()
+	if err != nil {
+		return nil, err
+	}
+
+	sigHandler, err := ccf.createSigningHandler(processingSingleSigner, multisigHasher, managedKeysHolder)
+	if err != nil {
+		return nil, err
+	}
+
+	if ccf.config.RemoteSigner.Enabled {
+		peerSigHandler, err = remoteSigner.NewPeerSignatureHandler(remoteSigner.ArgsPeerSignatureHandler{
+			SigningHandler:       sigHandler,
+			PeerSignatureHandler: peerSigHandler,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &CryptoComponents{
 		TxSingleSigner:       txSingleSigner,
 		SingleSigner:         interceptSingleSigner,
@@ -143,9 +177,95 @@ func (ccf *cryptoComponentsFactory) Create() (*CryptoComponents, error) {
 		InitialPubKeys:       initialPubKeys,
 		MessageSignVerifier:  messageSignVerifier,
 		PeerSignatureHandler: peerSigHandler,
+		ManagedKeysHolder:    managedKeysHolder,
+		SigningHandler:       sigHandler,
 	}, nil
 }
 
+// createSigningHandler creates the component signing the consensus data, which either signs in-process or forwards
+// the sign requests to the configured remote signer
+func (ccf *cryptoComponentsFactory) createSigningHandler(
+	singleSigner crypto.SingleSigner,
+	hasher hashing.Hasher,
+	managedKeysHolder ManagedKeysHolder,
+) (crypto.SigningHandler, error) {
+	remoteSignerConfig := ccf.config.RemoteSigner
+	if !remoteSignerConfig.Enabled {
+		return signingHandler.NewSigningHandler(signingHandler.ArgsSigningHandler{
+			PrivateKey:     ccf.privKey,
+			KeysHandler:    managedKeysHolder,
+			SingleSigner:   singleSigner,
+			LowLevelSigner: &mclMultiSig.BlsMultiSigner{Hasher: hasher},
+		})
+	}
+
+	tlsConfig, err := remoteSigner.CreateClientTLSConfig(
+		remoteSignerConfig.CertificateFile,
+		remoteSignerConfig.KeyFile,
+		remoteSignerConfig.CACertificateFile,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("using remote signer", "URL", remoteSignerConfig.URL)
+
+	return remoteSigner.NewRemoteSigningHandler(remoteSigner.ArgsRemoteSigningHandler{
+		URL:            remoteSignerConfig.URL,
+		TLSConfig:      tlsConfig,
+		RequestTimeout: time.Duration(remoteSignerConfig.RequestTimeoutInSeconds) * time.Second,
+	})
+}
+
+// createManagedKeysHolder creates the holder of the additional validator keys the node acts for. All the keys stored
+// in the managed keys file are loaded, except the node's own key
+func (ccf *cryptoComponentsFactory) createManagedKeysHolder() (ManagedKeysHolder, error) {
+	args := keysManagement.ArgsManagedKeysHolder{
+		KeyGenerator:               ccf.keyGen,
+		MaxBroadcastsPerKeyInRound: ccf.config.Consensus.MaxBroadcastsPerKeyInRound,
+	}
+	managedKeysHolder, err := keysManagement.NewManagedKeysHolder(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ccf.managedKeysPemFileName) == 0 {
+		return managedKeysHolder, nil
+	}
+	if ccf.config.RemoteSigner.Enabled {
+		return nil, ErrManagedKeysWithRemoteSigner
+	}
+
+	encodedSks, _, err := keystore.LoadAllSkPkFromFile(ccf.managedKeysPemFileName, ccf.managedKeysPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	ownSkBytes, err := ccf.privKey.ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, encodedSk := range encodedSks {
+		skBytes, errDecode := hex.DecodeString(string(encodedSk))
+		if errDecode != nil {
+			return nil, fmt.Errorf("%w for encoded managed secret key", errDecode)
+		}
+		if bytes.Equal(skBytes, ownSkBytes) {
+			continue
+		}
+
+		err = managedKeysHolder.AddManagedKey(skBytes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	log.Info("loaded managed validator keys", "num keys", len(managedKeysHolder.ManagedKeys()))
+
+	return managedKeysHolder, nil
+}
+
 func (ccf *cryptoComponentsFactory) createSingleSigner(importDbNoSigCheckFlag bool) (crypto.SingleSigner, error) {
 	if importDbNoSigCheckFlag {
 		log.Warn("using disabled single signer because the node is running in import-db 'turbo mode'")
@@ -153,7 +273,7 @@ func (ccf *cryptoComponentsFactory) createSingleSigner(importDbNoSigCheckFlag bo
 	}
 
 	switch ccf.consensusType {
-	case consensus.BlsConsensusType:
+	case consensus.BlsConsensusType, consensus.PbftConsensusType:
 		return &mclSig.BlsSingleSigner{}, nil
 	case disabledSigChecking:
 		log.Warn("using disabled single signer")
@@ -164,7 +284,7 @@ func (ccf *cryptoComponentsFactory) createSingleSigner(importDbNoSigCheckFlag bo
 }
 
 func (ccf *cryptoComponentsFactory) getMultisigHasherFromConfig() (hashing.Hasher, error) {
-	if ccf.consensusType == consensus.BlsConsensusType && ccf.config.MultisigHasher.Type != "blake2b" {
+	if usesBlsSignatures(ccf.consensusType) && ccf.config.MultisigHasher.Type != "blake2b" {
 		return nil, ErrMultiSigHasherMissmatch
 	}
 
@@ -172,7 +292,7 @@ func (ccf *cryptoComponentsFactory) getMultisigHasherFromConfig() (hashing.Hashe
 	case "sha256":
 		return sha256.Sha256{}, nil
 	case "blake2b":
-		if ccf.consensusType == consensus.BlsConsensusType {
+		if usesBlsSignatures(ccf.consensusType) {
 			return &blake2b.Blake2b{HashSize: multisig.BlsHashSize}, nil
 		}
 		return &blake2b.Blake2b{}, nil
@@ -181,6 +301,10 @@ func (ccf *cryptoComponentsFactory) getMultisigHasherFromConfig() (hashing.Hashe
 	return nil, ErrMissingMultiHasherConfig
 }
 
+func usesBlsSignatures(consensusType string) bool {
+	return consensusType == consensus.BlsConsensusType || consensusType == consensus.PbftConsensusType
+}
+
 func (ccf *cryptoComponentsFactory) createMultiSigner(
 	hasher hashing.Hasher,
 	pubKeys []string,
@@ -198,7 +322,7 @@ func (ccf *cryptoComponentsFactory) createMultiSigner(
 	// public keys in their initial order.
 
 	switch ccf.consensusType {
-	case consensus.BlsConsensusType:
+	case consensus.BlsConsensusType, consensus.PbftConsensusType:
 		blsSigner := &mclMultiSig.BlsMultiSigner{Hasher: hasher}
 		return multisig.NewBLSMultisig(blsSigner, pubKeys, ccf.privKey, ccf.keyGen, uint16(0))
 	case disabledSigChecking:
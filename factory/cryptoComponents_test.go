@@ -1,9 +1,17 @@
 package factory_test
 
 import (
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl"
 	"github.com/ElrondNetwork/elrond-go/factory"
 	"github.com/ElrondNetwork/elrond-go/factory/mock"
 	"github.com/stretchr/testify/require"
@@ -88,6 +96,94 @@ func TestCryptoComponentsFactory_Create(t *testing.T) {
 	cc, err := ccf.Create()
 	require.NoError(t, err)
 	require.NotNil(t, cc)
+	require.NotNil(t, cc.SigningHandler)
+}
+
+func TestCryptoComponentsFactory_CreateShouldLoadManagedKeys(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "cryptoComponents")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	fileName := filepath.Join(dir, "allValidatorsKeys.pem")
+	file, _ := os.Create(fileName)
+	args := getCryptoArgs()
+	args.KeyGen = keyGen
+	args.ManagedKeysPemFileName = fileName
+	expectedManagedKeys := make([][]byte, 0)
+	eligibleNodesPubKeys := make([]string, 0)
+	for i := 0; i < 3; i++ {
+		sk, pk := keyGen.GeneratePair()
+		skBytes, _ := sk.ToByteArray()
+		pkBytes, _ := pk.ToByteArray()
+		err := core.SaveSkToPemFile(file, hex.EncodeToString(pkBytes), []byte(hex.EncodeToString(skBytes)))
+		require.Nil(t, err)
+
+		eligibleNodesPubKeys = append(eligibleNodesPubKeys, string(pkBytes))
+		if i == 0 {
+			args.PrivKey = sk
+			continue
+		}
+		expectedManagedKeys = append(expectedManagedKeys, pkBytes)
+	}
+	_ = file.Close()
+	args.NodesConfig = &mock.NodesSetupStub{
+		InitialEligibleNodesPubKeysForShardCalled: func(shardId uint32) ([]string, error) {
+			return eligibleNodesPubKeys, nil
+		},
+	}
+
+	ccf, _ := factory.NewCryptoComponentsFactory(args, false)
+	cc, err := ccf.Create()
+	require.NoError(t, err)
+	require.Equal(t, expectedManagedKeys, cc.ManagedKeysHolder.ManagedKeys())
+}
+
+func TestCryptoComponentsFactory_CreateShouldErrForMissingManagedKeysFile(t *testing.T) {
+	t.Parallel()
+
+	args := getCryptoArgs()
+	args.ManagedKeysPemFileName = "missing file"
+	ccf, _ := factory.NewCryptoComponentsFactory(args, false)
+
+	cc, err := ccf.Create()
+	require.Error(t, err)
+	require.Nil(t, cc)
+}
+
+func TestCryptoComponentsFactory_CreateShouldErrForMissingRemoteSignerCertificates(t *testing.T) {
+	t.Parallel()
+
+	args := getCryptoArgs()
+	args.Config.RemoteSigner = config.RemoteSignerConfig{
+		Enabled:                 true,
+		URL:                     "https://localhost:8090",
+		CertificateFile:         "missing certificate",
+		KeyFile:                 "missing key",
+		CACertificateFile:       "missing CA certificate",
+		RequestTimeoutInSeconds: 1,
+	}
+	ccf, _ := factory.NewCryptoComponentsFactory(args, false)
+
+	cc, err := ccf.Create()
+	require.Error(t, err)
+	require.Nil(t, cc)
+}
+
+func TestCryptoComponentsFactory_CreateShouldErrForManagedKeysWithRemoteSigner(t *testing.T) {
+	t.Parallel()
+
+	args := getCryptoArgs()
+	args.Config.RemoteSigner.Enabled = true
+	args.ManagedKeysPemFileName = "allValidatorsKeys.pem"
+	ccf, _ := factory.NewCryptoComponentsFactory(args, false)
+
+	cc, err := ccf.Create()
+	require.Equal(t, factory.ErrManagedKeysWithRemoteSigner, err)
+	require.Nil(t, cc)
 }
 
 func getCryptoArgs() factory.CryptoComponentsFactoryArgs {
@@ -99,11 +195,15 @@ func getCryptoArgs() factory.CryptoComponentsFactoryArgs {
 				Capacity: 1000,
 				Type:     "LRU",
 			},
-			Consensus: config.TypeConfig{Type: "bls"},
+			Consensus: config.ConsensusConfig{Type: "bls", MaxBroadcastsPerKeyInRound: 10},
 		},
 		NodesConfig:      &mock.NodesSetupStub{},
 		ShardCoordinator: mock.NewMultiShardsCoordinatorMock(2),
 		KeyGen:           &mock.KeyGenMock{},
-		PrivKey:          &mock.PrivateKeyMock{},
+		PrivKey: &mock.PrivateKeyMock{
+			GeneratePublicMock: func() crypto.PublicKey {
+				return &mock.PublicKeyMock{}
+			},
+		},
 	}
 }
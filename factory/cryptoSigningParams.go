@@ -8,7 +8,9 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/keystore"
 	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/remoteSigner"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing"
 )
 
@@ -19,6 +21,7 @@ type cryptoSigningParamsLoader struct {
 	pubkeyConverter     core.PubkeyConverter
 	skIndex             int
 	skPemFileName       string
+	skPassword          []byte
 	suite               crypto.Suite
 	skPkProviderHandler func() ([]byte, []byte, error)
 	isInImportMode      bool
@@ -29,6 +32,7 @@ func NewCryptoSigningParamsLoader(
 	pubkeyConverter core.PubkeyConverter,
 	skIndex int,
 	skPemFileName string,
+	skPassword []byte,
 	suite crypto.Suite,
 	isInImportMode bool,
 ) (*cryptoSigningParamsLoader, error) {
@@ -43,6 +47,7 @@ func NewCryptoSigningParamsLoader(
 		pubkeyConverter: pubkeyConverter,
 		skIndex:         skIndex,
 		skPemFileName:   skPemFileName,
+		skPassword:      skPassword,
 		suite:           suite,
 		isInImportMode:  isInImportMode,
 	}
@@ -106,9 +111,46 @@ func (cspf *cryptoSigningParamsLoader) generateCryptoParams(cryptoParams *Crypto
 	return cryptoParams, nil
 }
 
+// CreateRemoteSignerCryptoParams returns the crypto params for a node whose private key is held by a remote signer.
+// Only the configured public key is known locally, the private key being a placeholder that cannot sign
+func CreateRemoteSignerCryptoParams(
+	pubkeyConverter core.PubkeyConverter,
+	publicKeyString string,
+	suite crypto.Suite,
+) (*CryptoParams, error) {
+	if check.IfNil(pubkeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+	if check.IfNil(suite) {
+		return nil, ErrNilSuite
+	}
+
+	pkBytes, err := pubkeyConverter.Decode(publicKeyString)
+	if err != nil {
+		return nil, fmt.Errorf("%w for remote signer public key %s", err, publicKeyString)
+	}
+
+	cryptoParams := &CryptoParams{}
+	cryptoParams.KeyGenerator = signing.NewKeyGenerator(suite)
+	cryptoParams.PublicKey, err = cryptoParams.KeyGenerator.PublicKeyFromByteArray(pkBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	cryptoParams.PrivateKey, err = remoteSigner.NewRemotePrivateKey(cryptoParams.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	cryptoParams.PublicKeyBytes = pkBytes
+	cryptoParams.PublicKeyString = pubkeyConverter.Encode(pkBytes)
+
+	return cryptoParams, nil
+}
+
 func (cspf *cryptoSigningParamsLoader) getSkPk() ([]byte, []byte, error) {
 	skIndex := cspf.skIndex
-	encodedSk, pkString, err := core.LoadSkPkFromPemFile(cspf.skPemFileName, skIndex)
+	encodedSk, pkString, err := keystore.LoadSkPkFromFile(cspf.skPemFileName, skIndex, cspf.skPassword)
 	if err != nil {
 		return nil, nil, err
 	}
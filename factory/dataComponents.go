@@ -83,6 +83,7 @@ func (dcf *dataComponentsFactory) Create() (*DataComponents, error) {
 		Config:           &dcf.config,
 		EconomicsData:    dcf.economicsData,
 		ShardCoordinator: dcf.shardCoordinator,
+		StatusHandler:    dcf.core.StatusHandler,
 	}
 	datapool, err = dataRetrieverFactory.NewDataPoolFromConfig(dataPoolArgs)
 	if err != nil {
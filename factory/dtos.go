@@ -22,6 +22,7 @@ type StateComponents struct {
 	ValidatorPubkeyConverter core.PubkeyConverter
 	PeerAccounts             state.AccountsAdapter
 	AccountsAdapter          state.AccountsAdapter
+	AccountsRecreator        state.AccountsAdapterRecreator
 	InBalanceForShard        map[string]*big.Int
 }
 
@@ -70,6 +71,8 @@ type CryptoComponents struct {
 	InitialPubKeys       map[uint32][]string
 	MessageSignVerifier  vm.MessageSignVerifier
 	PeerSignatureHandler crypto.PeerSignatureHandler
+	ManagedKeysHolder    ManagedKeysHolder
+	SigningHandler       crypto.SigningHandler
 }
 
 // NetworkComponents struct holds the network components
@@ -82,3 +82,7 @@ var ErrNilStatusHandler = errors.New("nil status handler provided")
 
 // ErrWrongTypeAssertion signals that a wrong type assertion occurred
 var ErrWrongTypeAssertion = errors.New("wrong type assertion")
+
+// ErrManagedKeysWithRemoteSigner signals that the managed keys file was provided while the validator key is held by a
+// remote signer
+var ErrManagedKeysWithRemoteSigner = errors.New("managed keys are not supported when using a remote signer")
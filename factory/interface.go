@@ -4,6 +4,7 @@ import (
 	"math/big"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
@@ -63,3 +64,9 @@ type EconomicsHandler interface {
 	ComputeFeeForProcessing(tx process.TransactionWithFeeHandler, gasToUse uint64) *big.Int
 	IsInterfaceNil() bool
 }
+
+// ManagedKeysHolder defines the operations of the component holding the additional validator keys a node acts for
+type ManagedKeysHolder interface {
+	consensus.KeysHandler
+	AddManagedKey(privateKeyBytes []byte) error
+}
@@ -1,5 +1,11 @@
 package mock
 
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
 // AppStatusHandlerMock -
 type AppStatusHandlerMock struct {
 }
@@ -28,6 +34,14 @@ func (a *AppStatusHandlerMock) SetUInt64Value(key string, value uint64) {
 func (a *AppStatusHandlerMock) SetStringValue(key string, value string) {
 }
 
+// AddToCounter -
+func (a *AppStatusHandlerMock) AddToCounter(_ string, _ uint64, _ ...core.MetricLabel) {
+}
+
+// ObserveDuration -
+func (a *AppStatusHandlerMock) ObserveDuration(_ string, _ time.Duration, _ ...core.MetricLabel) {
+}
+
 // Close -
 func (a *AppStatusHandlerMock) Close() {
 }
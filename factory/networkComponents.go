@@ -62,6 +62,11 @@ func (ncf *networkComponentsFactory) Create() (*NetworkComponents, error) {
 		return nil, err
 	}
 
+	err = netMessenger.SetAppStatusHandler(ncf.statusHandler)
+	if err != nil {
+		return nil, err
+	}
+
 	inAntifloodHandler, peerIdBlackList, pkTimeCache, errNewAntiflood := antifloodFactory.NewP2PAntiFloodAndBlackList(
 		ncf.mainConfig,
 		ncf.statusHandler,
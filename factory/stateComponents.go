@@ -86,5 +86,6 @@ func (scf *stateComponentsFactory) Create() (*StateComponents, error) {
 		AddressPubkeyConverter:   processPubkeyConverter,
 		ValidatorPubkeyConverter: validatorPubkeyConverter,
 		AccountsAdapter:          accountsAdapter,
+		AccountsRecreator:        accountsAdapter,
 	}, nil
 }
@@ -53,6 +53,7 @@ func createGenesisConfig() config.GeneralSettingsConfig {
 		TransactionSignedWithTxHashEnableEpoch: unreachableEpoch,
 		SwitchHysteresisForMinNodesEnableEpoch: unreachableEpoch,
 		SwitchJailWaitingEnableEpoch:           unreachableEpoch,
+		ESDTAllowanceEnableEpoch:               unreachableEpoch,
 	}
 }
 
@@ -253,12 +254,19 @@ func setBalanceToTrie(arg ArgsGenesisBlockCreator, accnt genesis.InitialAccountH
 }
 
 func createProcessorsForShardGenesisBlock(arg ArgsGenesisBlockCreator, generalConfig config.GeneralSettingsConfig) (*genesisProcessors, error) {
+	epochNotifier := forking.NewGenericEpochNotifier()
+	epochNotifier.CheckEpoch(arg.StartEpochNum)
+
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasSchedule:          arg.GasSchedule,
-		MapDNSAddresses:      make(map[string]struct{}),
-		EnableUserNameChange: false,
-		Marshalizer:          arg.Marshalizer,
-		Accounts:             arg.Accounts,
+		GasSchedule:              arg.GasSchedule,
+		MapDNSAddresses:          make(map[string]struct{}),
+		EnableUserNameChange:     false,
+		Marshalizer:              arg.Marshalizer,
+		Accounts:                 arg.Accounts,
+		ShardCoordinator:         arg.ShardCoordinator,
+		EpochNotifier:            epochNotifier,
+		ESDTAllowanceEnableEpoch: generalConfig.ESDTAllowanceEnableEpoch,
+		ESDTNFTEnableEpoch:       arg.SystemSCConfig.ESDTSystemSCConfig.NFTEnableEpoch,
 	}
 	builtInFuncFactory, err := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	if err != nil {
@@ -339,7 +347,7 @@ func createProcessorsForShardGenesisBlock(arg ArgsGenesisBlockCreator, generalCo
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
 		PubkeyConverter:  arg.PubkeyConv,
 		ShardCoordinator: arg.ShardCoordinator,
-		BuiltInFuncNames: builtInFuncs.Keys(),
+		BuiltInFunctions: builtInFuncs,
 		ArgumentParser:   parsers.NewCallArgsParser(),
 	}
 	txTypeHandler, err := coordinator.NewTxTypeHandler(argsTxTypeHandler)
@@ -352,9 +360,6 @@ func createProcessorsForShardGenesisBlock(arg ArgsGenesisBlockCreator, generalCo
 		return nil, err
 	}
 
-	epochNotifier := forking.NewGenericEpochNotifier()
-	epochNotifier.CheckEpoch(arg.StartEpochNum)
-
 	genesisFeeHandler := &disabled.FeeHandler{}
 	argsNewScProcessor := smartContract.ArgsNewSmartContractProcessor{
 		VmContainer:                    vmContainer,
@@ -6,3 +6,7 @@ import (
 
 var errNilComponent = errors.New("component is nil")
 var errNotDiagnosableComponent = errors.New("component is not diagnosable")
+var errNotHealthCheckerComponent = errors.New("component does not report its health")
+var errNotHeartbeatSourceComponent = errors.New("component does not report a heartbeat")
+var errEmptyComponentName = errors.New("empty component name")
+var errComponentAlreadyRegistered = errors.New("component already registered")
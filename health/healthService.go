@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"sort"
 	"sync"
 	"time"
 
@@ -12,10 +13,18 @@ import (
 	"github.com/ElrondNetwork/elrond-go-logger/check"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
 )
 
 var log = logger.GetOrCreate("health")
 
+const defaultLivenessStaleThresholdInSeconds = 120
+
+type registeredHeartbeatSource struct {
+	source       heartbeatSource
+	registeredAt time.Time
+}
+
 type healthService struct {
 	config                              config.HealthServiceConfig
 	folder                              string
@@ -23,6 +32,13 @@ type healthService struct {
 	records                             *records
 	diagnosableComponents               []diagnosable
 	diagnosableComponentsMutex          sync.RWMutex
+	healthCheckers                      map[string]healthChecker
+	componentsHealth                    map[string]core.ComponentHealth
+	healthCheckersMutex                 sync.Mutex
+	heartbeatSources                    map[string]*registeredHeartbeatSource
+	heartbeatSourcesMutex               sync.RWMutex
+	livenessStaleThreshold              time.Duration
+	isClosed                            atomic.Flag
 	clock                               clock
 	memory                              memory
 	onMonitorContinuouslyBeginIteration func()
@@ -35,6 +51,10 @@ func NewHealthService(config config.HealthServiceConfig, workingDir string) *hea
 
 	folder := path.Join(workingDir, config.FolderPath)
 	recordsObj := newRecords(config.NumMemoryUsageRecordsToKeep)
+	livenessStaleThresholdInSeconds := config.LivenessStaleThresholdInSeconds
+	if livenessStaleThresholdInSeconds <= 0 {
+		livenessStaleThresholdInSeconds = defaultLivenessStaleThresholdInSeconds
+	}
 
 	return &healthService{
 		config:                              config,
@@ -42,6 +62,10 @@ func NewHealthService(config config.HealthServiceConfig, workingDir string) *hea
 		cancelFunction:                      func() {},
 		records:                             recordsObj,
 		diagnosableComponents:               make([]diagnosable, 0),
+		healthCheckers:                      make(map[string]healthChecker),
+		componentsHealth:                    make(map[string]core.ComponentHealth),
+		heartbeatSources:                    make(map[string]*registeredHeartbeatSource),
+		livenessStaleThreshold:              time.Duration(livenessStaleThresholdInSeconds) * time.Second,
 		clock:                               &realClock{},
 		memory:                              &realMemory{},
 		onMonitorContinuouslyBeginIteration: func() {},
@@ -72,6 +96,74 @@ func (h *healthService) doRegisterComponent(component interface{}) error {
 	return nil
 }
 
+// RegisterHealthChecker registers a component which is able to report its health, under the provided name
+func (h *healthService) RegisterHealthChecker(name string, component interface{}) {
+	err := h.doRegisterHealthChecker(name, component)
+	if err != nil {
+		log.Error("healthService.RegisterHealthChecker()", "err", err, "name", name, "component", fmt.Sprintf("%T", component))
+	}
+}
+
+func (h *healthService) doRegisterHealthChecker(name string, component interface{}) error {
+	if len(name) == 0 {
+		return errEmptyComponentName
+	}
+	asHealthChecker, ok := component.(healthChecker)
+	if !ok {
+		return errNotHealthCheckerComponent
+	}
+	if check.IfNil(asHealthChecker) {
+		return errNilComponent
+	}
+
+	h.healthCheckersMutex.Lock()
+	defer h.healthCheckersMutex.Unlock()
+
+	_, exists := h.healthCheckers[name]
+	if exists {
+		return errComponentAlreadyRegistered
+	}
+	h.healthCheckers[name] = asHealthChecker
+
+	return nil
+}
+
+// RegisterHeartbeatSource registers a component which periodically reports a heartbeat, under the provided name.
+// The node is considered alive only while all the registered components keep beating
+func (h *healthService) RegisterHeartbeatSource(name string, component interface{}) {
+	err := h.doRegisterHeartbeatSource(name, component)
+	if err != nil {
+		log.Error("healthService.RegisterHeartbeatSource()", "err", err, "name", name, "component", fmt.Sprintf("%T", component))
+	}
+}
+
+func (h *healthService) doRegisterHeartbeatSource(name string, component interface{}) error {
+	if len(name) == 0 {
+		return errEmptyComponentName
+	}
+	asHeartbeatSource, ok := component.(heartbeatSource)
+	if !ok {
+		return errNotHeartbeatSourceComponent
+	}
+	if check.IfNil(asHeartbeatSource) {
+		return errNilComponent
+	}
+
+	h.heartbeatSourcesMutex.Lock()
+	defer h.heartbeatSourcesMutex.Unlock()
+
+	_, exists := h.heartbeatSources[name]
+	if exists {
+		return errComponentAlreadyRegistered
+	}
+	h.heartbeatSources[name] = &registeredHeartbeatSource{
+		source:       asHeartbeatSource,
+		registeredAt: h.clock.now(),
+	}
+
+	return nil
+}
+
 // Start starts the health service
 func (h *healthService) Start() {
 	log.Info("healthService.Start()")
@@ -112,6 +204,7 @@ func (h *healthService) monitorContinuously(ctx context.Context) {
 			chanMonitorMemory = h.clock.after(intervalVerifyMemoryInSeconds)
 		case <-chanDiagnoseComponents:
 			h.diagnoseComponents(false)
+			h.checkComponentsHealth()
 			chanDiagnoseComponents = h.clock.after(intervalDiagnoseComponentsInSeconds)
 		case <-chanDiagnoseComponentsDeeply:
 			h.diagnoseComponents(true)
@@ -147,8 +240,123 @@ func (h *healthService) diagnoseComponents(deep bool) {
 	}
 }
 
+// checkComponentsHealth calls all registered health checkers, remembering the last time each component was healthy
+func (h *healthService) checkComponentsHealth() map[string]core.ComponentHealth {
+	h.healthCheckersMutex.Lock()
+	defer h.healthCheckersMutex.Unlock()
+
+	names := make([]string, 0, len(h.healthCheckers))
+	for name := range h.healthCheckers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := make(map[string]core.ComponentHealth, len(names))
+	for _, name := range names {
+		checkResult := h.healthCheckers[name].CheckHealth()
+		previous := h.componentsHealth[name]
+		if previous.Status != checkResult.Status {
+			log.Debug("healthService: component health changed",
+				"component", name,
+				"status", checkResult.Status,
+				"reason", checkResult.Reason,
+			)
+		}
+
+		componentHealth := core.ComponentHealth{
+			Status:               checkResult.Status,
+			Reason:               checkResult.Reason,
+			LastSuccessTimestamp: previous.LastSuccessTimestamp,
+		}
+		if checkResult.Status == core.HealthStatusOK {
+			componentHealth.LastSuccessTimestamp = h.clock.now().Unix()
+		}
+
+		h.componentsHealth[name] = componentHealth
+		result[name] = componentHealth
+	}
+
+	return result
+}
+
+// checkHeartbeats reports, for each registered heartbeat source, whether its last heartbeat is recent enough. A source
+// which did not beat yet is given the staleness threshold, counted from its registration, to start beating
+func (h *healthService) checkHeartbeats() map[string]core.ComponentHealth {
+	h.heartbeatSourcesMutex.RLock()
+	defer h.heartbeatSourcesMutex.RUnlock()
+
+	now := h.clock.now()
+	result := make(map[string]core.ComponentHealth, len(h.heartbeatSources))
+	for name, registered := range h.heartbeatSources {
+		lastHeartbeat := registered.source.GetLastHeartbeat()
+		if lastHeartbeat.Before(registered.registeredAt) {
+			lastHeartbeat = registered.registeredAt
+		}
+
+		componentHealth := core.ComponentHealth{
+			Status:               core.HealthStatusOK,
+			LastSuccessTimestamp: lastHeartbeat.Unix(),
+		}
+		elapsed := now.Sub(lastHeartbeat)
+		if elapsed > h.livenessStaleThreshold {
+			componentHealth.Status = core.HealthStatusUnavailable
+			componentHealth.Reason = fmt.Sprintf("no heartbeat for %v", elapsed.Truncate(time.Second))
+		}
+
+		result[name] = componentHealth
+	}
+
+	return result
+}
+
+// GetLiveness returns the liveness report of the node. The node is alive as long as the health service is not closed
+// and all the registered heartbeat sources did beat within the configured staleness threshold
+func (h *healthService) GetLiveness() core.HealthReport {
+	if h.isClosed.IsSet() {
+		return core.HealthReport{Status: core.HealthStatusUnavailable}
+	}
+
+	components := h.checkHeartbeats()
+
+	return core.HealthReport{
+		Status:     aggregateHealthStatus(components),
+		Components: components,
+	}
+}
+
+// GetReadiness checks all the registered components and returns the readiness report of the node.
+// The node is ready if none of its components is unavailable
+func (h *healthService) GetReadiness() core.HealthReport {
+	if h.isClosed.IsSet() {
+		return core.HealthReport{Status: core.HealthStatusUnavailable}
+	}
+
+	components := h.checkComponentsHealth()
+
+	return core.HealthReport{
+		Status:     aggregateHealthStatus(components),
+		Components: components,
+	}
+}
+
+func aggregateHealthStatus(components map[string]core.ComponentHealth) core.HealthStatus {
+	status := core.HealthStatusOK
+	for _, componentHealth := range components {
+		switch componentHealth.Status {
+		case core.HealthStatusOK:
+		case core.HealthStatusDegraded:
+			status = core.HealthStatusDegraded
+		default:
+			return core.HealthStatusUnavailable
+		}
+	}
+
+	return status
+}
+
 // Close stops the service
 func (h *healthService) Close() error {
+	h.isClosed.Set()
 	h.cancelFunction()
 	return nil
 }
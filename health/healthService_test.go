@@ -3,8 +3,10 @@ package health
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/stretchr/testify/require"
 )
 
@@ -36,6 +38,146 @@ func TestHealthService_DiagnoseComponents(t *testing.T) {
 	require.Equal(t, 1, int(b.numShallowDiagnoses.Get()))
 }
 
+func TestHealthService_RegisterHealthChecker_BadComponentsShouldErr(t *testing.T) {
+	h := newHealthServiceToTest(42, 1)
+
+	err := h.doRegisterHealthChecker("", newDummyHealthChecker(core.HealthStatusOK, ""))
+	require.Equal(t, errEmptyComponentName, err)
+
+	err = h.doRegisterHealthChecker("a", &dummyNotDiagnosable{})
+	require.Equal(t, errNotHealthCheckerComponent, err)
+
+	err = h.doRegisterHealthChecker("a", (*dummyHealthChecker)(nil))
+	require.Equal(t, errNilComponent, err)
+
+	err = h.doRegisterHealthChecker("a", newDummyHealthChecker(core.HealthStatusOK, ""))
+	require.Nil(t, err)
+
+	err = h.doRegisterHealthChecker("a", newDummyHealthChecker(core.HealthStatusOK, ""))
+	require.Equal(t, errComponentAlreadyRegistered, err)
+}
+
+func TestHealthService_GetReadinessAggregatesComponents(t *testing.T) {
+	h := newHealthServiceToTest(42, 1)
+
+	report := h.GetReadiness()
+	require.Equal(t, core.HealthStatusOK, report.Status)
+	require.Len(t, report.Components, 0)
+
+	a := newDummyHealthChecker(core.HealthStatusOK, "")
+	b := newDummyHealthChecker(core.HealthStatusDegraded, "b is slow")
+	h.RegisterHealthChecker("a", a)
+	h.RegisterHealthChecker("b", b)
+
+	report = h.GetReadiness()
+	require.Equal(t, core.HealthStatusDegraded, report.Status)
+	require.Equal(t, core.HealthStatusOK, report.Components["a"].Status)
+	require.Equal(t, "b is slow", report.Components["b"].Reason)
+
+	a.setResult(core.HealthStatusUnavailable, "a is down")
+	report = h.GetReadiness()
+	require.Equal(t, core.HealthStatusUnavailable, report.Status)
+	require.Equal(t, "a is down", report.Components["a"].Reason)
+
+	a.setResult(core.HealthStatusOK, "")
+	b.setResult(core.HealthStatusOK, "")
+	report = h.GetReadiness()
+	require.Equal(t, core.HealthStatusOK, report.Status)
+}
+
+func TestHealthService_GetReadinessKeepsLastSuccessTimestamp(t *testing.T) {
+	h := newHealthServiceToTest(42, 1)
+	clock := newDummyClock()
+	h.clock = clock
+
+	a := newDummyHealthChecker(core.HealthStatusUnavailable, "a is down")
+	h.RegisterHealthChecker("a", a)
+
+	report := h.GetReadiness()
+	require.Equal(t, int64(0), report.Components["a"].LastSuccessTimestamp)
+
+	a.setResult(core.HealthStatusOK, "")
+	clock.tick()
+	report = h.GetReadiness()
+	lastSuccess := clock.now().Unix()
+	require.Equal(t, lastSuccess, report.Components["a"].LastSuccessTimestamp)
+
+	a.setResult(core.HealthStatusUnavailable, "a is down again")
+	clock.tick()
+	report = h.GetReadiness()
+	require.Equal(t, lastSuccess, report.Components["a"].LastSuccessTimestamp)
+	require.Equal(t, "a is down again", report.Components["a"].Reason)
+}
+
+func TestHealthService_GetLivenessAfterClose(t *testing.T) {
+	h := newHealthServiceToTest(42, 1)
+	h.RegisterHealthChecker("a", newDummyHealthChecker(core.HealthStatusOK, ""))
+
+	require.Equal(t, core.HealthStatusOK, h.GetLiveness().Status)
+	require.Equal(t, core.HealthStatusOK, h.GetReadiness().Status)
+
+	err := h.Close()
+	require.Nil(t, err)
+
+	require.Equal(t, core.HealthStatusUnavailable, h.GetLiveness().Status)
+	require.Equal(t, core.HealthStatusUnavailable, h.GetReadiness().Status)
+}
+
+func TestHealthService_RegisterHeartbeatSource_BadComponentsShouldErr(t *testing.T) {
+	h := newHealthServiceToTest(42, 1)
+
+	err := h.doRegisterHeartbeatSource("", &dummyHeartbeatSource{})
+	require.Equal(t, errEmptyComponentName, err)
+
+	err = h.doRegisterHeartbeatSource("a", newDummyHealthChecker(core.HealthStatusOK, ""))
+	require.Equal(t, errNotHeartbeatSourceComponent, err)
+
+	err = h.doRegisterHeartbeatSource("a", (*dummyHeartbeatSource)(nil))
+	require.Equal(t, errNilComponent, err)
+
+	err = h.doRegisterHeartbeatSource("a", &dummyHeartbeatSource{})
+	require.Nil(t, err)
+
+	err = h.doRegisterHeartbeatSource("a", &dummyHeartbeatSource{})
+	require.Equal(t, errComponentAlreadyRegistered, err)
+}
+
+func TestHealthService_GetLivenessWithStaleHeartbeatShouldBeUnavailable(t *testing.T) {
+	h := newHealthServiceToTest(42, 1)
+	h.livenessStaleThreshold = 2 * time.Second
+	clock := newDummyClock()
+	h.clock = clock
+
+	clock.tick()
+	source := &dummyHeartbeatSource{}
+	h.RegisterHeartbeatSource("sync", source)
+
+	// a source which did not beat yet is given the threshold, counted from its registration
+	clock.tick()
+	clock.tick()
+	report := h.GetLiveness()
+	require.Equal(t, core.HealthStatusOK, report.Status)
+
+	clock.tick()
+	report = h.GetLiveness()
+	require.Equal(t, core.HealthStatusUnavailable, report.Status)
+
+	source.beat(clock.now())
+	clock.tick()
+	report = h.GetLiveness()
+	require.Equal(t, core.HealthStatusOK, report.Status)
+	require.Equal(t, source.GetLastHeartbeat().Unix(), report.Components["sync"].LastSuccessTimestamp)
+
+	// the source stalls
+	clock.tick()
+	clock.tick()
+	report = h.GetLiveness()
+	require.Equal(t, core.HealthStatusUnavailable, report.Status)
+	require.Equal(t, core.HealthStatusUnavailable, report.Components["sync"].Status)
+	require.Equal(t, "no heartbeat for 3s", report.Components["sync"].Reason)
+	require.Equal(t, source.GetLastHeartbeat().Unix(), report.Components["sync"].LastSuccessTimestamp)
+}
+
 func TestHealthService_MonitorMemory(t *testing.T) {
 	h := newHealthServiceToTest(42, 1)
 
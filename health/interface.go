@@ -3,6 +3,8 @@ package health
 import (
 	"runtime"
 	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
 )
 
 // diagnosable is an internal interface, which external components can implement in order to be "diagnosed" by the health service
@@ -11,6 +13,19 @@ type diagnosable interface {
 	IsInterfaceNil() bool
 }
 
+// healthChecker is an internal interface, which external components can implement in order to report their health
+type healthChecker interface {
+	CheckHealth() core.HealthCheckResult
+	IsInterfaceNil() bool
+}
+
+// heartbeatSource is an internal interface, which external components running a periodic loop can implement in order
+// to prove that the node is still making progress
+type heartbeatSource interface {
+	GetLastHeartbeat() time.Time
+	IsInterfaceNil() bool
+}
+
 // record in an internal interface, implemented by various health records (e.g. "memoryUsageRecord")
 type record interface {
 	save() error
@@ -6,11 +6,14 @@ import (
 	"sync"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/atomic"
 )
 
 var _ record = (*dummyRecord)(nil)
 var _ diagnosable = (*dummyDiagnosable)(nil)
+var _ healthChecker = (*dummyHealthChecker)(nil)
+var _ heartbeatSource = (*dummyHeartbeatSource)(nil)
 var _ memory = (*dummyMemory)(nil)
 var _ clock = (*dummyClock)(nil)
 
@@ -62,6 +65,58 @@ func (dummy *dummyDiagnosable) IsInterfaceNil() bool {
 type dummyNotDiagnosable struct {
 }
 
+type dummyHealthChecker struct {
+	mutex  sync.Mutex
+	result core.HealthCheckResult
+}
+
+func newDummyHealthChecker(status core.HealthStatus, reason string) *dummyHealthChecker {
+	dummy := &dummyHealthChecker{}
+	dummy.setResult(status, reason)
+	return dummy
+}
+
+func (dummy *dummyHealthChecker) setResult(status core.HealthStatus, reason string) {
+	dummy.mutex.Lock()
+	dummy.result = core.HealthCheckResult{Status: status, Reason: reason}
+	dummy.mutex.Unlock()
+}
+
+// CheckHealth -
+func (dummy *dummyHealthChecker) CheckHealth() core.HealthCheckResult {
+	dummy.mutex.Lock()
+	defer dummy.mutex.Unlock()
+	return dummy.result
+}
+
+// IsInterfaceNil -
+func (dummy *dummyHealthChecker) IsInterfaceNil() bool {
+	return dummy == nil
+}
+
+type dummyHeartbeatSource struct {
+	mutex         sync.Mutex
+	lastHeartbeat time.Time
+}
+
+func (dummy *dummyHeartbeatSource) beat(now time.Time) {
+	dummy.mutex.Lock()
+	dummy.lastHeartbeat = now
+	dummy.mutex.Unlock()
+}
+
+// GetLastHeartbeat -
+func (dummy *dummyHeartbeatSource) GetLastHeartbeat() time.Time {
+	dummy.mutex.Lock()
+	defer dummy.mutex.Unlock()
+	return dummy.lastHeartbeat
+}
+
+// IsInterfaceNil -
+func (dummy *dummyHeartbeatSource) IsInterfaceNil() bool {
+	return dummy == nil
+}
+
 type dummyMemory struct {
 	inUse             int
 	numGetStatsCalled atomic.Counter
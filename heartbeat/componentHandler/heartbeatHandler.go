@@ -37,6 +37,7 @@ type ArgHeartbeat struct {
 	ValidatorStatistics      heartbeat.ValidatorStatisticsProcessor
 	PeerSignatureHandler     crypto.PeerSignatureHandler
 	PrivKey                  crypto.PrivateKey
+	KeysHandler              heartbeat.KeysHandler
 	HardforkTrigger          heartbeat.HardforkTrigger
 	AntifloodHandler         heartbeat.P2PAntifloodHandler
 	ValidatorPubkeyConverter core.PubkeyConverter
@@ -114,6 +115,7 @@ func (hbh *HeartbeatHandler) create() error {
 		PeerMessenger:        arg.Messenger,
 		PeerSignatureHandler: arg.PeerSignatureHandler,
 		PrivKey:              arg.PrivKey,
+		KeysHandler:          arg.KeysHandler,
 		Marshalizer:          arg.Marshalizer,
 		Topic:                core.HeartbeatTopic,
 		ShardCoordinator:     arg.ShardCoordinator,
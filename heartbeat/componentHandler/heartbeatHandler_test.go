@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/heartbeat"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -36,6 +37,7 @@ func createMockArgument() ArgHeartbeat {
 		ValidatorStatistics:      &mock.ValidatorStatisticsStub{},
 		PeerSignatureHandler:     &mock.PeerSignatureHandler{},
 		PrivKey:                  &mock.PrivateKeyStub{},
+		KeysHandler:              &testscommon.KeysHandlerStub{},
 		HardforkTrigger:          &mock.HardforkTriggerStub{},
 		AntifloodHandler:         &mock.P2PAntifloodHandlerStub{},
 		ValidatorPubkeyConverter: mock.NewPubkeyConverterMock(32),
@@ -101,3 +101,6 @@ var ErrNilPeerSignatureHandler = errors.New("trying to set nil peerSignatureHand
 
 // ErrNilCurrentBlockProvider signals that a nil current block provider
 var ErrNilCurrentBlockProvider = errors.New("nil current block provider")
+
+// ErrNilKeysHandler signals that a nil keys handler has been provided
+var ErrNilKeysHandler = errors.New("nil keys handler")
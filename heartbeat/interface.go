@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	heartbeatData "github.com/ElrondNetwork/elrond-go/heartbeat/data"
@@ -112,3 +113,10 @@ type CurrentBlockProvider interface {
 	GetCurrentBlockHeader() data.HeaderHandler
 	IsInterfaceNil() bool
 }
+
+// KeysHandler defines the behaviour of a component able to provide the additional validator keys a node acts for
+type KeysHandler interface {
+	ManagedKeys() [][]byte
+	GetPrivateKey(pkBytes []byte) (crypto.PrivateKey, error)
+	IsInterfaceNil() bool
+}
@@ -9,6 +9,12 @@ import (
 
 const MaxSizeInBytes = maxSizeInBytes
 
+// MaxHeartbeatsPerBatch -
+const MaxHeartbeatsPerBatch = maxHeartbeatsPerBatch
+
+// DelayBetweenHeartbeatBatches -
+const DelayBetweenHeartbeatBatches = delayBetweenHeartbeatBatches
+
 // GetNumHearbeatMessages -
 func (m *Monitor) GetNumHearbeatMessages() int {
 	m.mutHeartbeatMessages.RLock()
@@ -15,11 +15,17 @@ import (
 
 const delayAfterHardforkMessageBroadcast = time.Second * 5
 
+// maxHeartbeatsPerBatch is kept below the antiflood limit of the heartbeat topic (30 messages/s for each peer) so that
+// the heartbeats of the managed keys, all being sent from the same peer, are not dropped
+const maxHeartbeatsPerBatch = 20
+const delayBetweenHeartbeatBatches = time.Second
+
 // ArgHeartbeatSender represents the arguments for the heartbeat sender
 type ArgHeartbeatSender struct {
 	PeerMessenger        heartbeat.P2PMessenger
 	PeerSignatureHandler crypto.PeerSignatureHandler
 	PrivKey              crypto.PrivateKey
+	KeysHandler          heartbeat.KeysHandler
 	Marshalizer          marshal.Marshalizer
 	Topic                string
 	ShardCoordinator     sharding.Coordinator
@@ -37,6 +43,7 @@ type Sender struct {
 	peerMessenger        heartbeat.P2PMessenger
 	peerSignatureHandler crypto.PeerSignatureHandler
 	privKey              crypto.PrivateKey
+	keysHandler          heartbeat.KeysHandler
 	marshalizer          marshal.Marshalizer
 	shardCoordinator     sharding.Coordinator
 	peerTypeProvider     heartbeat.PeerTypeProviderHandler
@@ -60,6 +67,9 @@ func NewSender(arg ArgHeartbeatSender) (*Sender, error) {
 	if check.IfNil(arg.PrivKey) {
 		return nil, heartbeat.ErrNilPrivateKey
 	}
+	if check.IfNil(arg.KeysHandler) {
+		return nil, heartbeat.ErrNilKeysHandler
+	}
 	if check.IfNil(arg.Marshalizer) {
 		return nil, heartbeat.ErrNilMarshalizer
 	}
@@ -87,6 +97,7 @@ func NewSender(arg ArgHeartbeatSender) (*Sender, error) {
 		peerMessenger:        arg.PeerMessenger,
 		peerSignatureHandler: arg.PeerSignatureHandler,
 		privKey:              arg.PrivKey,
+		keysHandler:          arg.KeysHandler,
 		marshalizer:          arg.Marshalizer,
 		topic:                arg.Topic,
 		shardCoordinator:     arg.ShardCoordinator,
@@ -135,26 +146,64 @@ func (s *Sender) SendHeartbeat() error {
 	}
 
 	log.Debug("broadcasting message", "is hardfork triggered", isHardforkTriggered)
+	err := s.sendHeartbeatForKey(*hb, s.privKey, true)
+	if err != nil {
+		return err
+	}
+
+	s.sendHeartbeatsForManagedKeys(*hb)
+
+	return nil
+}
+
+// sendHeartbeatsForManagedKeys sends a heartbeat message, signed by the corresponding key, for each of the
+// additional validator keys the node acts for. The messages are sent in batches, the first one also containing the
+// heartbeat of the node's own key, with a delay between the batches
+func (s *Sender) sendHeartbeatsForManagedKeys(hb heartbeatData.Heartbeat) {
+	numSentInBatch := 1
+	for _, pkBytes := range s.keysHandler.ManagedKeys() {
+		if numSentInBatch == maxHeartbeatsPerBatch {
+			time.Sleep(delayBetweenHeartbeatBatches)
+			numSentInBatch = 0
+		}
+		numSentInBatch++
+
+		privKey, err := s.keysHandler.GetPrivateKey(pkBytes)
+		if err != nil {
+			log.Warn("sender: managed key not found", "error", err.Error())
+			continue
+		}
+
+		err = s.sendHeartbeatForKey(hb, privKey, false)
+		if err != nil {
+			log.Warn("sender: heartbeat for managed key", "error", err.Error())
+		}
+	}
+}
+
+func (s *Sender) sendHeartbeatForKey(hb heartbeatData.Heartbeat, privKey crypto.PrivateKey, shouldUpdateMetrics bool) error {
 	var err error
-	hb.Pubkey, err = s.privKey.GeneratePublic().ToByteArray()
+	hb.Pubkey, err = privKey.GeneratePublic().ToByteArray()
 	if err != nil {
 		return err
 	}
 
-	s.updateMetrics(hb)
+	if shouldUpdateMetrics {
+		s.updateMetrics(&hb)
+	}
 
-	err = verifyLengths(hb)
+	err = verifyLengths(&hb)
 	if err != nil {
 		log.Warn("verify hb length", "error", err.Error())
-		trimLengths(hb)
+		trimLengths(&hb)
 	}
 
-	hb.Signature, err = s.peerSignatureHandler.GetPeerSignature(s.privKey, hb.Pid)
+	hb.Signature, err = s.peerSignatureHandler.GetPeerSignature(privKey, hb.Pid)
 	if err != nil {
 		return err
 	}
 
-	buffToSend, err := s.marshalizer.Marshal(hb)
+	buffToSend, err := s.marshalizer.Marshal(&hb)
 	if err != nil {
 		return err
 	}
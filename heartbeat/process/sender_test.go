@@ -3,15 +3,19 @@ package process_test
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/heartbeat"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/mock"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/process"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 //------- NewSender
@@ -23,6 +27,7 @@ func createMockArgHeartbeatSender() process.ArgHeartbeatSender {
 		},
 		PeerSignatureHandler: &mock.PeerSignatureHandler{},
 		PrivKey:              &mock.PrivateKeyStub{},
+		KeysHandler:          &testscommon.KeysHandlerStub{},
 		Marshalizer: &mock.MarshalizerStub{
 			MarshalHandler: func(obj interface{}) (i []byte, e error) {
 				return nil, nil
@@ -83,6 +88,17 @@ func TestNewSender_NilPrivateKeyShouldErr(t *testing.T) {
 	assert.Equal(t, heartbeat.ErrNilPrivateKey, err)
 }
 
+func TestNewSender_NilKeysHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatSender()
+	arg.KeysHandler = nil
+	sender, err := process.NewSender(arg)
+
+	assert.Nil(t, sender)
+	assert.Equal(t, heartbeat.ErrNilKeysHandler, err)
+}
+
 func TestNewSender_NilMarshalizerShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -292,6 +308,106 @@ func TestSender_SendHeartbeatShouldWork(t *testing.T) {
 	assert.True(t, marshalCalled)
 }
 
+func TestSender_SendHeartbeatShouldSendForManagedKeys(t *testing.T) {
+	t.Parallel()
+
+	createPrivateKey := func(pkBytes []byte) crypto.PrivateKey {
+		return &mock.PrivateKeyStub{
+			GeneratePublicHandler: func() crypto.PublicKey {
+				return &mock.PublicKeyMock{
+					ToByteArrayHandler: func() (i []byte, e error) {
+						return pkBytes, nil
+					},
+				}
+			},
+		}
+	}
+	managedKeys := [][]byte{[]byte("managed key 1"), []byte("managed key 2")}
+	signedPubKeys := make([]string, 0)
+	numMetricsUpdates := 0
+
+	arg := createMockArgHeartbeatSender()
+	arg.PrivKey = createPrivateKey([]byte("node key"))
+	arg.KeysHandler = &testscommon.KeysHandlerStub{
+		ManagedKeysCalled: func() [][]byte {
+			return managedKeys
+		},
+		GetPrivateKeyCalled: func(pkBytes []byte) (crypto.PrivateKey, error) {
+			return createPrivateKey(pkBytes), nil
+		},
+	}
+	arg.Marshalizer = &mock.MarshalizerStub{
+		MarshalHandler: func(obj interface{}) (i []byte, e error) {
+			hb := obj.(*data.Heartbeat)
+			signedPubKeys = append(signedPubKeys, string(hb.Pubkey))
+			return nil, nil
+		},
+	}
+	arg.StatusHandler = &mock.AppStatusHandlerStub{
+		SetStringValueHandler: func(key string, value string) {
+			numMetricsUpdates++
+		},
+	}
+	sender, _ := process.NewSender(arg)
+
+	err := sender.SendHeartbeat()
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"node key", "managed key 1", "managed key 2"}, signedPubKeys)
+	assert.Equal(t, 2, numMetricsUpdates)
+}
+
+func TestSender_SendHeartbeatForManyManagedKeysShouldSendInBatches(t *testing.T) {
+	t.Parallel()
+
+	numManagedKeys := 45
+	managedKeys := make([][]byte, 0, numManagedKeys)
+	for i := 0; i < numManagedKeys; i++ {
+		managedKeys = append(managedKeys, []byte(fmt.Sprintf("managed key %d", i)))
+	}
+	broadcastTimes := make([]time.Time, 0)
+	privateKey := &mock.PrivateKeyStub{
+		GeneratePublicHandler: func() crypto.PublicKey {
+			return &mock.PublicKeyMock{
+				ToByteArrayHandler: func() (i []byte, e error) {
+					return []byte("pub key"), nil
+				},
+			}
+		},
+	}
+
+	arg := createMockArgHeartbeatSender()
+	arg.PrivKey = privateKey
+	arg.PeerMessenger = &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			broadcastTimes = append(broadcastTimes, time.Now())
+		},
+	}
+	arg.KeysHandler = &testscommon.KeysHandlerStub{
+		ManagedKeysCalled: func() [][]byte {
+			return managedKeys
+		},
+		GetPrivateKeyCalled: func(pkBytes []byte) (crypto.PrivateKey, error) {
+			return privateKey, nil
+		},
+	}
+	sender, _ := process.NewSender(arg)
+
+	err := sender.SendHeartbeat()
+
+	assert.Nil(t, err)
+	require.Equal(t, numManagedKeys+1, len(broadcastTimes))
+	for i, broadcastTime := range broadcastTimes {
+		numInInterval := 0
+		for _, otherBroadcastTime := range broadcastTimes[i:] {
+			if otherBroadcastTime.Sub(broadcastTime) < process.DelayBetweenHeartbeatBatches {
+				numInInterval++
+			}
+		}
+		assert.True(t, numInInterval <= process.MaxHeartbeatsPerBatch)
+	}
+}
+
 func TestSender_SendHeartbeatAfterTriggerShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -87,10 +87,9 @@ func initNodesAndTest(
 
 func startNodesWithCommitBlock(nodes []*testNode, mutex *sync.Mutex, nonceForRoundMap map[uint64]uint64, totalCalled *int) error {
 	for _, n := range nodes {
-		nCopy := n
+		commitBlock := n.blkProcessor.CommitBlockCalled
 		n.blkProcessor.CommitBlockCalled = func(header data.HeaderHandler, body data.BodyHandler) error {
-			nCopy.blkProcessor.NrCommitBlockCalled++
-			_ = nCopy.blkc.SetCurrentBlockHeader(header)
+			_ = commitBlock(header, body)
 
 			mutex.Lock()
 			nonceForRoundMap[header.GetRound()] = header.GetNonce()
@@ -194,6 +193,14 @@ func TestConsensusBLSFullTest(t *testing.T) {
 	runFullConsensusTest(t, blsConsensusType)
 }
 
+func TestConsensusPBFTFullTest(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	runFullConsensusTest(t, pbftConsensusType)
+}
+
 func runConsensusWithNotEnoughValidators(t *testing.T, consensusType string) {
 	numNodes := uint32(4)
 	consensusSize := uint32(4)
@@ -234,3 +241,11 @@ func TestConsensusBLSNotEnoughValidators(t *testing.T) {
 
 	runConsensusWithNotEnoughValidators(t, blsConsensusType)
 }
+
+func TestConsensusPBFTNotEnoughValidators(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	runConsensusWithNotEnoughValidators(t, pbftConsensusType)
+}
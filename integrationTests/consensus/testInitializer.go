@@ -18,7 +18,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/crypto/signing"
 	ed25519SingleSig "github.com/ElrondNetwork/elrond-go/crypto/signing/ed25519/singlesig"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl"
+	mclmultisig "github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/multisig"
 	mclsinglesig "github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/singlesig"
+	"github.com/ElrondNetwork/elrond-go/crypto/signingHandler"
 	"github.com/ElrondNetwork/elrond-go/data"
 	dataBlock "github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/blockchain"
@@ -50,6 +52,7 @@ import (
 )
 
 const blsConsensusType = "bls"
+const pbftConsensusType = "pbft"
 const signatureSize = 48
 const publicKeySize = 96
 
@@ -228,7 +231,7 @@ func createCryptoParams(nodesPerShard int, nbMetaNodes int, nbShards int) *crypt
 }
 
 func createHasher(consensusType string) hashing.Hasher {
-	if consensusType == blsConsensusType {
+	if consensusType == blsConsensusType || consensusType == pbftConsensusType {
 		return &blake2b.Blake2b{HashSize: 32}
 	}
 	return &blake2b.Blake2b{}
@@ -287,6 +290,8 @@ func createConsensusOnlyNode(
 	blockProcessor.CommitBlockCalled = func(header data.HeaderHandler, body data.BodyHandler) error {
 		blockProcessor.NrCommitBlockCalled++
 		_ = blockChain.SetCurrentBlockHeader(header)
+		headerHash, _ := core.CalculateHash(testMarshalizer, testHasher, header)
+		blockChain.SetCurrentBlockHeaderHash(headerHash)
 		return nil
 	}
 	blockProcessor.Marshalizer = testMarshalizer
@@ -370,6 +375,14 @@ func createConsensusOnlyNode(
 	peerSigCache, _ := storageUnit.NewCache(storageUnit.CacheConfig{Type: storageUnit.LRUCache, Capacity: 1000})
 	peerSigHandler, _ := peerSignatureHandler.NewPeerSignatureHandler(peerSigCache, singleBlsSigner, testKeyGen)
 
+	keysHandler := &testscommon.KeysHandlerStub{}
+	sigHandler, _ := signingHandler.NewSigningHandler(signingHandler.ArgsSigningHandler{
+		PrivateKey:     privKey,
+		KeysHandler:    keysHandler,
+		SingleSigner:   singleBlsSigner,
+		LowLevelSigner: &mclmultisig.BlsMultiSigner{Hasher: testHasher},
+	})
+
 	accntAdapter := createAccountsDB(testMarshalizer)
 	n, err := node.NewNode(
 		node.WithInitialNodesPubKeys(inPubKeys),
@@ -409,6 +422,7 @@ func createConsensusOnlyNode(
 		node.WithRequestedItemsHandler(&mock.RequestedItemsHandlerStub{}),
 		node.WithHeaderSigVerifier(&mock.HeaderSigVerifierStub{}),
 		node.WithHeaderIntegrityVerifier(&mock.HeaderIntegrityVerifierStub{}),
+		node.WithEquivocationDetector(&testscommon.EquivocationDetectorStub{}),
 		node.WithChainID(integrationTests.ChainID),
 		node.WithRequestHandler(&mock.RequestHandlerStub{}),
 		node.WithUint64ByteSliceConverter(&mock.Uint64ByteSliceConverterMock{}),
@@ -422,6 +436,8 @@ func createConsensusOnlyNode(
 		node.WithHardforkTrigger(&mock.HardforkTriggerStub{}),
 		node.WithWatchdogTimer(&mock.WatchdogMock{}),
 		node.WithPeerSignatureHandler(peerSigHandler),
+		node.WithKeysHandler(keysHandler),
+		node.WithSigningHandler(sigHandler),
 		node.WithIndexer(indexer.NewNilIndexer()),
 	)
 
@@ -0,0 +1,48 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/block"
+)
+
+// SlashingProcessorStub -
+type SlashingProcessorStub struct {
+	CreateSlashingEvidenceCalled          func() ([]block.SlashingEvidence, error)
+	ProcessSlashingEvidenceCalled         func(evidence []block.SlashingEvidence) error
+	RemoveSlashingEvidenceFromPoolCalled  func(evidence []block.SlashingEvidence)
+	RestoreSlashingEvidenceIntoPoolCalled func(evidence []block.SlashingEvidence)
+}
+
+// CreateSlashingEvidence -
+func (sps *SlashingProcessorStub) CreateSlashingEvidence() ([]block.SlashingEvidence, error) {
+	if sps.CreateSlashingEvidenceCalled != nil {
+		return sps.CreateSlashingEvidenceCalled()
+	}
+	return nil, nil
+}
+
+// ProcessSlashingEvidence -
+func (sps *SlashingProcessorStub) ProcessSlashingEvidence(evidence []block.SlashingEvidence) error {
+	if sps.ProcessSlashingEvidenceCalled != nil {
+		return sps.ProcessSlashingEvidenceCalled(evidence)
+	}
+	return nil
+}
+
+// RemoveSlashingEvidenceFromPool -
+func (sps *SlashingProcessorStub) RemoveSlashingEvidenceFromPool(evidence []block.SlashingEvidence) {
+	if sps.RemoveSlashingEvidenceFromPoolCalled != nil {
+		sps.RemoveSlashingEvidenceFromPoolCalled(evidence)
+	}
+}
+
+// RestoreSlashingEvidenceIntoPool -
+func (sps *SlashingProcessorStub) RestoreSlashingEvidenceIntoPool(evidence []block.SlashingEvidence) {
+	if sps.RestoreSlashingEvidenceIntoPoolCalled != nil {
+		sps.RestoreSlashingEvidenceIntoPoolCalled(evidence)
+	}
+}
+
+// IsInterfaceNil -
+func (sps *SlashingProcessorStub) IsInterfaceNil() bool {
+	return sps == nil
+}
@@ -4,6 +4,7 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/integrationTests"
 	"github.com/ElrondNetwork/elrond-go/node"
 	"github.com/stretchr/testify/assert"
@@ -21,7 +22,7 @@ func TestNode_GetAccountAccountDoesNotExistsShouldRetEmpty(t *testing.T) {
 	)
 
 	encodedAddress := integrationTests.TestAddressPubkeyConverter.Encode(integrationTests.CreateRandomBytes(32))
-	recovAccnt, err := n.GetAccount(encodedAddress)
+	recovAccnt, err := n.GetAccount(encodedAddress, state.BlockQueryOptions{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, uint64(0), recovAccnt.GetNonce())
@@ -49,7 +50,7 @@ func TestNode_GetAccountAccountExistsShouldReturn(t *testing.T) {
 	)
 
 	encodedAddress := integrationTests.TestAddressPubkeyConverter.Encode(addressBytes)
-	recovAccnt, err := n.GetAccount(encodedAddress)
+	recovAccnt, err := n.GetAccount(encodedAddress, state.BlockQueryOptions{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, nonce, recovAccnt.GetNonce())
@@ -21,6 +21,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -216,6 +217,7 @@ func createSenderWithName(messenger p2p.Messenger, topic string, nodeName string
 		PeerMessenger:        messenger,
 		PeerSignatureHandler: &mock2.PeerSignatureHandler{Signer: signer},
 		PrivKey:              sk,
+		KeysHandler:          &testscommon.KeysHandlerStub{},
 		Marshalizer:          integrationTests.TestMarshalizer,
 		Topic:                topic,
 		ShardCoordinator:     &sharding.OneShardCoordinator{},
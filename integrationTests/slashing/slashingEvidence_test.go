@@ -0,0 +1,275 @@
+package slashing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/core/throttler"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl"
+	llsig "github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/multisig"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/singlesig"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/multisig"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/integrationTests/mock"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/process/interceptors"
+	interceptorFactory "github.com/ElrondNetwork/elrond-go/process/interceptors/factory"
+	"github.com/ElrondNetwork/elrond-go/process/interceptors/processor"
+	"github.com/ElrondNetwork/elrond-go/process/slash"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const numNodes = 4
+const durationToPropagate = time.Second
+
+var testMarshalizer = &marshal.GogoProtoMarshalizer{}
+var testHasher = &blake2b.Blake2b{}
+var testKeyGen = signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+
+type testNode struct {
+	messenger    *memp2p.Messenger
+	evidencePool process.SlashingEvidencePool
+	verifier     process.SlashingEvidenceVerifier
+}
+
+type testValidator struct {
+	pubKey      []byte
+	multiSigner crypto.MultiSigner
+}
+
+func createTestValidator(t *testing.T) *testValidator {
+	sk, pk := testKeyGen.GeneratePair()
+	pkBytes, err := pk.ToByteArray()
+	require.Nil(t, err)
+
+	multiSigner, err := multisig.NewBLSMultisig(createLowLevelSigner(), []string{string(pkBytes)}, sk, testKeyGen, 0)
+	require.Nil(t, err)
+
+	return &testValidator{
+		pubKey:      pkBytes,
+		multiSigner: multiSigner,
+	}
+}
+
+func createLowLevelSigner() crypto.LowLevelSignerBLS {
+	return &llsig.BlsMultiSigner{Hasher: &blake2b.Blake2b{HashSize: hashing.BlsHashSize}}
+}
+
+// signShare returns the marshalled header, the header hash and the validator's signature share on the header hash
+func (tv *testValidator) signShare(t *testing.T, header *block.Header) ([]byte, []byte, []byte) {
+	headerBytes, err := testMarshalizer.Marshal(header)
+	require.Nil(t, err)
+
+	headerHash := testHasher.Compute(string(headerBytes))
+	signature, err := tv.multiSigner.CreateSignatureShare(headerHash, nil)
+	require.Nil(t, err)
+
+	return headerBytes, headerHash, signature
+}
+
+// createTestNodesCoordinator returns a nodes coordinator selecting the provided public keys as consensus group, the
+// first one being the leader
+func createTestNodesCoordinator(consensusGroup ...[]byte) sharding.NodesCoordinator {
+	return &mock.NodesCoordinatorMock{
+		ComputeValidatorsGroupCalled: func(_ []byte, _ uint64, _ uint32, _ uint32) ([]sharding.Validator, error) {
+			validators := make([]sharding.Validator, 0, len(consensusGroup))
+			for _, pubKey := range consensusGroup {
+				validator, _ := sharding.NewValidator(pubKey, 1, 0)
+				validators = append(validators, validator)
+			}
+
+			return validators, nil
+		},
+	}
+}
+
+func createTestNode(t *testing.T, network *memp2p.Network, nodesCoordinator sharding.NodesCoordinator) *testNode {
+	messenger, err := memp2p.NewMessenger(network)
+	require.Nil(t, err)
+
+	evidencePool, err := slash.NewEvidencePool(100)
+	require.Nil(t, err)
+
+	// the verifier does not need a private key, any multisigner instance can create a verifier for the evidence key
+	validator := createTestValidator(t)
+	verifier, err := slash.NewEvidenceVerifier(slash.ArgsEvidenceVerifier{
+		Marshalizer:      testMarshalizer,
+		Hasher:           testHasher,
+		KeyGen:           testKeyGen,
+		SingleSigner:     &singlesig.BlsSingleSigner{},
+		MultiSigVerifier: validator.multiSigner,
+		NodesCoordinator: nodesCoordinator,
+	})
+	require.Nil(t, err)
+
+	evidenceFactory, err := interceptorFactory.NewInterceptedSlashingEvidenceDataFactory(&interceptorFactory.ArgInterceptedDataFactory{
+		ProtoMarshalizer:         testMarshalizer,
+		Hasher:                   testHasher,
+		SlashingEvidenceVerifier: verifier,
+	})
+	require.Nil(t, err)
+
+	evidenceProcessor, err := processor.NewSlashingEvidenceInterceptorProcessor(evidencePool)
+	require.Nil(t, err)
+
+	interceptorThrottler, _ := throttler.NewNumGoRoutinesThrottler(100)
+	whiteListHandler, _ := interceptors.NewWhiteListDataVerifier(testscommon.NewCacherMock())
+	interceptor, err := interceptors.NewSingleDataInterceptor(interceptors.ArgSingleDataInterceptor{
+		Topic:            factory.SlashingEvidenceTopic,
+		DataFactory:      evidenceFactory,
+		Processor:        evidenceProcessor,
+		Throttler:        interceptorThrottler,
+		AntifloodHandler: &mock.NilAntifloodHandler{},
+		WhiteListRequest: whiteListHandler,
+		CurrentPeerId:    messenger.ID(),
+	})
+	require.Nil(t, err)
+
+	err = messenger.CreateTopic(factory.SlashingEvidenceTopic, true)
+	require.Nil(t, err)
+	err = messenger.RegisterMessageProcessor(factory.SlashingEvidenceTopic, interceptor)
+	require.Nil(t, err)
+
+	return &testNode{
+		messenger:    messenger,
+		evidencePool: evidencePool,
+		verifier:     verifier,
+	}
+}
+
+func createTestNodes(t *testing.T, consensusGroup ...[]byte) []*testNode {
+	network := memp2p.NewNetwork()
+	nodesCoordinator := createTestNodesCoordinator(consensusGroup...)
+	nodes := make([]*testNode, numNodes)
+	for i := 0; i < numNodes; i++ {
+		nodes[i] = createTestNode(t, network, nodesCoordinator)
+	}
+
+	return nodes
+}
+
+func closeTestNodes(nodes []*testNode) {
+	for _, n := range nodes {
+		_ = n.messenger.Close()
+	}
+}
+
+func createEquivocationDetector(t *testing.T, n *testNode, consensusGroup ...[]byte) spos.EquivocationDetector {
+	detector, err := slash.NewEquivocationDetector(slash.ArgsEquivocationDetector{
+		Marshalizer:      testMarshalizer,
+		Hasher:           testHasher,
+		ShardCoordinator: mock.NewMultiShardsCoordinatorMock(1),
+		NodesCoordinator: createTestNodesCoordinator(consensusGroup...),
+		Verifier:         n.verifier,
+		EvidencePool:     n.evidencePool,
+		Broadcaster:      n.messenger,
+	})
+	require.Nil(t, err)
+
+	return detector
+}
+
+func sendSignedProposal(detector spos.EquivocationDetector, headerBytes []byte, headerHash []byte, signature []byte, signer []byte, round int64) {
+	detector.ProcessConsensusMessage(&consensus.Message{
+		Header:     headerBytes,
+		RoundIndex: round,
+	})
+	detector.ProcessConsensusMessage(&consensus.Message{
+		BlockHeaderHash: headerHash,
+		SignatureShare:  signature,
+		PubKey:          signer,
+		RoundIndex:      round,
+	})
+}
+
+func TestSlashingEvidence_DoubleSignatureSharesShouldPropagateToAllNodes(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	leader := createTestValidator(t)
+	offender := createTestValidator(t)
+	nodes := createTestNodes(t, leader.pubKey, offender.pubKey)
+	defer closeTestNodes(nodes)
+
+	detector := createEquivocationDetector(t, nodes[0], leader.pubKey, offender.pubKey)
+
+	round := int64(10)
+	firstHeader, firstHash, firstSig := offender.signShare(t, &block.Header{Round: uint64(round), Nonce: 9, RootHash: []byte("root hash 1")})
+	secondHeader, secondHash, secondSig := offender.signShare(t, &block.Header{Round: uint64(round), Nonce: 9, RootHash: []byte("root hash 2")})
+
+	sendSignedProposal(detector, firstHeader, firstHash, firstSig, offender.pubKey, round)
+	sendSignedProposal(detector, secondHeader, secondHash, secondSig, offender.pubKey, round)
+
+	time.Sleep(durationToPropagate)
+
+	for i, n := range nodes {
+		require.True(t, n.evidencePool.HasEvidence(offender.pubKey, uint64(round)), "node %d does not have the evidence", i)
+
+		evidence := n.evidencePool.GetEvidence(1)
+		require.Equal(t, 1, len(evidence))
+		assert.Equal(t, block.DoubleSignatureShares, evidence[0].Type)
+		assert.Nil(t, n.verifier.Verify(evidence[0]))
+	}
+	assert.False(t, nodes[1].evidencePool.HasEvidence(leader.pubKey, uint64(round)))
+}
+
+func TestSlashingEvidence_ForgedEvidenceShouldNotBeAccepted(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	offender := createTestValidator(t)
+	innocent := createTestValidator(t)
+	nodes := createTestNodes(t, offender.pubKey, innocent.pubKey)
+	defer closeTestNodes(nodes)
+
+	round := uint64(10)
+	firstHeader, _, firstSig := offender.signShare(t, &block.Header{Round: round, Nonce: 9, RootHash: []byte("root hash 1")})
+	secondHeader, _, secondSig := offender.signShare(t, &block.Header{Round: round, Nonce: 9, RootHash: []byte("root hash 2")})
+
+	// the signatures belong to the offender while the evidence accuses the innocent validator
+	forged := &block.SlashingEvidence{
+		Type:            block.DoubleSignatureShares,
+		PubKey:          innocent.pubKey,
+		Round:           round,
+		FirstHeader:     firstHeader,
+		FirstSignature:  firstSig,
+		SecondHeader:    secondHeader,
+		SecondSignature: secondSig,
+	}
+	buff, err := testMarshalizer.Marshal(forged)
+	require.Nil(t, err)
+
+	nodes[0].messenger.Broadcast(factory.SlashingEvidenceTopic, buff)
+	time.Sleep(durationToPropagate)
+
+	for i, n := range nodes {
+		assert.Equal(t, 0, n.evidencePool.Len(), "node %d accepted forged evidence", i)
+	}
+
+	// the same headers and signatures correctly attributed are accepted by everyone
+	forged.PubKey = offender.pubKey
+	buff, err = testMarshalizer.Marshal(forged)
+	require.Nil(t, err)
+
+	nodes[1].messenger.Broadcast(factory.SlashingEvidenceTopic, buff)
+	time.Sleep(durationToPropagate)
+
+	for i, n := range nodes {
+		assert.True(t, n.evidencePool.HasEvidence(offender.pubKey, round), "node %d does not have the evidence", i)
+		assert.False(t, n.evidencePool.HasEvidence(innocent.pubKey, round), "node %d accepted forged evidence", i)
+	}
+}
@@ -17,6 +17,7 @@ import (
 	arwenConfig "github.com/ElrondNetwork/arwen-wasm-vm/config"
 	"github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/accumulator"
 	"github.com/ElrondNetwork/elrond-go/core/check"
@@ -1148,6 +1149,7 @@ func CreateHeaderIntegrityVerifier() process.HeaderIntegrityVerifier {
 		},
 		"default",
 		testscommon.NewCacherMock(),
+		consensus.BlsConsensusType,
 	)
 
 	return headerVersioning
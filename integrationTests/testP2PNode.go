@@ -176,6 +176,7 @@ func (tP2pNode *TestP2PNode) initNode() {
 		node.WithPeerHonestyHandler(&mock.PeerHonestyHandlerStub{}),
 		node.WithFallbackHeaderValidator(&testscommon.FallBackHeaderValidatorStub{}),
 		node.WithPeerSignatureHandler(psh),
+		node.WithKeysHandler(&testscommon.KeysHandlerStub{}),
 		node.WithBlockChain(&mock.BlockChainMock{}),
 	)
 	log.LogIfError(err)
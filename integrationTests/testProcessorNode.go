@@ -12,6 +12,7 @@ import (
 	arwenConfig "github.com/ElrondNetwork/arwen-wasm-vm/config"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/accumulator"
@@ -71,6 +72,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/rating"
 	"github.com/ElrondNetwork/elrond-go/process/rewardTransaction"
 	"github.com/ElrondNetwork/elrond-go/process/scToProtocol"
+	"github.com/ElrondNetwork/elrond-go/process/slash"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
@@ -145,6 +147,8 @@ var MinTxGasLimit = uint64(1000)
 const MaxGasLimitPerBlock = uint64(3000000)
 
 const maxTxNonceDeltaAllowed = 8000
+const maxSlashingEvidenceInPool = 100
+const maxSlashingEvidencePerBlock = 10
 const minConnectedPeers = 0
 
 // OpGasValueForMockVm represents the gas value that it consumed by each operation called on the mock VM
@@ -262,6 +266,10 @@ type TestProcessorNode struct {
 
 	EpochStartSystemSCProcessor process.EpochStartSystemSCProcessor
 
+	SlashingEvidencePool     process.SlashingEvidencePool
+	SlashingEvidenceVerifier process.SlashingEvidenceVerifier
+	EquivocationDetector     spos.EquivocationDetector
+
 	//Node is used to call the functionality already implemented in it
 	Node           *node.Node
 	SCQueryService external.SCQueryService
@@ -508,6 +516,7 @@ func NewTestProcessorNodeWithFullGenesis(
 		tpn.ShardCoordinator,
 		tpn.OwnAccount.SkTxSign,
 		tpn.OwnAccount.PeerSigHandler,
+		&testscommon.KeysHandlerStub{},
 		tpn.DataPool.Headers(),
 		tpn.InterceptorsContainer,
 	)
@@ -650,6 +659,7 @@ func (tpn *TestProcessorNode) initTestNode() {
 		tpn.ShardCoordinator,
 		tpn.OwnAccount.SkTxSign,
 		tpn.OwnAccount.PeerSigHandler,
+		&testscommon.KeysHandlerStub{},
 		tpn.DataPool.Headers(),
 		tpn.InterceptorsContainer,
 	)
@@ -699,6 +709,7 @@ func (tpn *TestProcessorNode) initTestNodeWithTrieDBAndGasModel(trieStore storag
 		tpn.ShardCoordinator,
 		tpn.OwnAccount.SkTxSign,
 		tpn.OwnAccount.PeerSigHandler,
+		&testscommon.KeysHandlerStub{},
 		tpn.DataPool.Headers(),
 		tpn.InterceptorsContainer,
 	)
@@ -714,10 +725,12 @@ func (tpn *TestProcessorNode) createFullSCQueryService() {
 	defaults.FillGasMapInternal(gasMap, 1)
 	gasSchedule := mock.NewGasScheduleNotifierMock(gasMap)
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasSchedule:     gasSchedule,
-		MapDNSAddresses: make(map[string]struct{}),
-		Marshalizer:     TestMarshalizer,
-		Accounts:        tpn.AccntState,
+		GasSchedule:      gasSchedule,
+		MapDNSAddresses:  make(map[string]struct{}),
+		Marshalizer:      TestMarshalizer,
+		Accounts:         tpn.AccntState,
+		ShardCoordinator: tpn.ShardCoordinator,
+		EpochNotifier:    tpn.EpochNotifier,
 	}
 	builtInFuncFactory, _ := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	builtInFuncs, _ := builtInFuncFactory.CreateBuiltInFunctionContainer()
@@ -826,6 +839,7 @@ func (tpn *TestProcessorNode) InitializeProcessors(gasMap map[string]map[string]
 		tpn.ShardCoordinator,
 		tpn.OwnAccount.SkTxSign,
 		tpn.OwnAccount.PeerSigHandler,
+		&testscommon.KeysHandlerStub{},
 		tpn.DataPool.Headers(),
 		tpn.InterceptorsContainer,
 	)
@@ -992,8 +1006,43 @@ func CreateRatingsData() *rating.RatingsData {
 	return ratingsData
 }
 
+func (tpn *TestProcessorNode) initSlashingEvidenceComponents() {
+	if !check.IfNil(tpn.SlashingEvidencePool) {
+		return
+	}
+
+	tpn.SlashingEvidencePool, _ = slash.NewEvidencePool(maxSlashingEvidenceInPool)
+	tpn.SlashingEvidenceVerifier, _ = slash.NewEvidenceVerifier(slash.ArgsEvidenceVerifier{
+		Marshalizer:      TestMarshalizer,
+		Hasher:           TestHasher,
+		KeyGen:           tpn.OwnAccount.KeygenBlockSign,
+		SingleSigner:     tpn.OwnAccount.BlockSingleSigner,
+		MultiSigVerifier: TestMultiSig,
+		NodesCoordinator: tpn.NodesCoordinator,
+	})
+}
+
+func (tpn *TestProcessorNode) initEquivocationDetector() {
+	tpn.initSlashingEvidenceComponents()
+
+	equivocationDetector, err := slash.NewEquivocationDetector(slash.ArgsEquivocationDetector{
+		Marshalizer:      TestMarshalizer,
+		Hasher:           TestHasher,
+		ShardCoordinator: tpn.ShardCoordinator,
+		NodesCoordinator: tpn.NodesCoordinator,
+		Verifier:         tpn.SlashingEvidenceVerifier,
+		EvidencePool:     tpn.SlashingEvidencePool,
+		Broadcaster:      tpn.Messenger,
+	})
+	log.LogIfError(err)
+
+	tpn.EquivocationDetector = equivocationDetector
+	tpn.DataPool.Headers().RegisterHandler(equivocationDetector.ReceivedHeader)
+}
+
 func (tpn *TestProcessorNode) initInterceptors() {
 	var err error
+	tpn.initSlashingEvidenceComponents()
 	tpn.BlockBlackListHandler = timecache.NewTimeCache(TimeSpanForBadHeaders)
 	if check.IfNil(tpn.EpochStartNotifier) {
 		tpn.EpochStartNotifier = notifier.NewEpochStartSubscriptionHandler()
@@ -1016,37 +1065,39 @@ func (tpn *TestProcessorNode) initInterceptors() {
 		tpn.EpochStartTrigger = &metachain.TestTrigger{}
 		tpn.EpochStartTrigger.SetTrigger(epochStartTrigger)
 		metaIntercContFactArgs := interceptorscontainer.MetaInterceptorsContainerFactoryArgs{
-			ShardCoordinator:        tpn.ShardCoordinator,
-			NodesCoordinator:        tpn.NodesCoordinator,
-			Messenger:               tpn.Messenger,
-			Store:                   tpn.Storage,
-			ProtoMarshalizer:        TestMarshalizer,
-			TxSignMarshalizer:       TestTxSignMarshalizer,
-			Hasher:                  TestHasher,
-			MultiSigner:             TestMultiSig,
-			DataPool:                tpn.DataPool,
-			Accounts:                tpn.AccntState,
-			AddressPubkeyConverter:  TestAddressPubkeyConverter,
-			SingleSigner:            tpn.OwnAccount.SingleSigner,
-			BlockSingleSigner:       tpn.OwnAccount.BlockSingleSigner,
-			KeyGen:                  tpn.OwnAccount.KeygenTxSign,
-			BlockKeyGen:             tpn.OwnAccount.KeygenBlockSign,
-			MaxTxNonceDeltaAllowed:  maxTxNonceDeltaAllowed,
-			TxFeeHandler:            tpn.EconomicsData,
-			BlackList:               tpn.BlockBlackListHandler,
-			HeaderSigVerifier:       tpn.HeaderSigVerifier,
-			HeaderIntegrityVerifier: tpn.HeaderIntegrityVerifier,
-			SizeCheckDelta:          sizeCheckDelta,
-			ValidityAttester:        tpn.BlockTracker,
-			EpochStartTrigger:       tpn.EpochStartTrigger,
-			WhiteListHandler:        tpn.WhiteListHandler,
-			WhiteListerVerifiedTxs:  tpn.WhiteListerVerifiedTxs,
-			AntifloodHandler:        &mock.NilAntifloodHandler{},
-			ArgumentsParser:         smartContract.NewArgumentParser(),
-			ChainID:                 tpn.ChainID,
-			MinTransactionVersion:   tpn.MinTransactionVersion,
-			TxSignHasher:            TestHasher,
-			EpochNotifier:           tpn.EpochNotifier,
+			ShardCoordinator:         tpn.ShardCoordinator,
+			NodesCoordinator:         tpn.NodesCoordinator,
+			Messenger:                tpn.Messenger,
+			Store:                    tpn.Storage,
+			ProtoMarshalizer:         TestMarshalizer,
+			TxSignMarshalizer:        TestTxSignMarshalizer,
+			Hasher:                   TestHasher,
+			MultiSigner:              TestMultiSig,
+			DataPool:                 tpn.DataPool,
+			Accounts:                 tpn.AccntState,
+			AddressPubkeyConverter:   TestAddressPubkeyConverter,
+			SingleSigner:             tpn.OwnAccount.SingleSigner,
+			BlockSingleSigner:        tpn.OwnAccount.BlockSingleSigner,
+			KeyGen:                   tpn.OwnAccount.KeygenTxSign,
+			BlockKeyGen:              tpn.OwnAccount.KeygenBlockSign,
+			MaxTxNonceDeltaAllowed:   maxTxNonceDeltaAllowed,
+			TxFeeHandler:             tpn.EconomicsData,
+			BlackList:                tpn.BlockBlackListHandler,
+			HeaderSigVerifier:        tpn.HeaderSigVerifier,
+			HeaderIntegrityVerifier:  tpn.HeaderIntegrityVerifier,
+			SizeCheckDelta:           sizeCheckDelta,
+			ValidityAttester:         tpn.BlockTracker,
+			EpochStartTrigger:        tpn.EpochStartTrigger,
+			WhiteListHandler:         tpn.WhiteListHandler,
+			WhiteListerVerifiedTxs:   tpn.WhiteListerVerifiedTxs,
+			AntifloodHandler:         &mock.NilAntifloodHandler{},
+			ArgumentsParser:          smartContract.NewArgumentParser(),
+			ChainID:                  tpn.ChainID,
+			MinTransactionVersion:    tpn.MinTransactionVersion,
+			TxSignHasher:             TestHasher,
+			EpochNotifier:            tpn.EpochNotifier,
+			SlashingEvidenceVerifier: tpn.SlashingEvidenceVerifier,
+			SlashingEvidencePool:     tpn.SlashingEvidencePool,
 		}
 		interceptorContainerFactory, _ := interceptorscontainer.NewMetaInterceptorsContainerFactory(metaIntercContFactArgs)
 
@@ -1080,37 +1131,39 @@ func (tpn *TestProcessorNode) initInterceptors() {
 		tpn.EpochStartTrigger.SetTrigger(epochStartTrigger)
 
 		shardInterContFactArgs := interceptorscontainer.ShardInterceptorsContainerFactoryArgs{
-			Accounts:                tpn.AccntState,
-			ShardCoordinator:        tpn.ShardCoordinator,
-			NodesCoordinator:        tpn.NodesCoordinator,
-			Messenger:               tpn.Messenger,
-			Store:                   tpn.Storage,
-			ProtoMarshalizer:        TestMarshalizer,
-			TxSignMarshalizer:       TestTxSignMarshalizer,
-			Hasher:                  TestHasher,
-			KeyGen:                  tpn.OwnAccount.KeygenTxSign,
-			BlockSignKeyGen:         tpn.OwnAccount.KeygenBlockSign,
-			SingleSigner:            tpn.OwnAccount.SingleSigner,
-			BlockSingleSigner:       tpn.OwnAccount.BlockSingleSigner,
-			MultiSigner:             TestMultiSig,
-			DataPool:                tpn.DataPool,
-			AddressPubkeyConverter:  TestAddressPubkeyConverter,
-			MaxTxNonceDeltaAllowed:  maxTxNonceDeltaAllowed,
-			TxFeeHandler:            tpn.EconomicsData,
-			BlockBlackList:          tpn.BlockBlackListHandler,
-			HeaderSigVerifier:       tpn.HeaderSigVerifier,
-			HeaderIntegrityVerifier: tpn.HeaderIntegrityVerifier,
-			SizeCheckDelta:          sizeCheckDelta,
-			ValidityAttester:        tpn.BlockTracker,
-			EpochStartTrigger:       tpn.EpochStartTrigger,
-			WhiteListHandler:        tpn.WhiteListHandler,
-			WhiteListerVerifiedTxs:  tpn.WhiteListerVerifiedTxs,
-			AntifloodHandler:        &mock.NilAntifloodHandler{},
-			ArgumentsParser:         smartContract.NewArgumentParser(),
-			ChainID:                 tpn.ChainID,
-			MinTransactionVersion:   tpn.MinTransactionVersion,
-			TxSignHasher:            TestTxSignHasher,
-			EpochNotifier:           tpn.EpochNotifier,
+			Accounts:                 tpn.AccntState,
+			ShardCoordinator:         tpn.ShardCoordinator,
+			NodesCoordinator:         tpn.NodesCoordinator,
+			Messenger:                tpn.Messenger,
+			Store:                    tpn.Storage,
+			ProtoMarshalizer:         TestMarshalizer,
+			TxSignMarshalizer:        TestTxSignMarshalizer,
+			Hasher:                   TestHasher,
+			KeyGen:                   tpn.OwnAccount.KeygenTxSign,
+			BlockSignKeyGen:          tpn.OwnAccount.KeygenBlockSign,
+			SingleSigner:             tpn.OwnAccount.SingleSigner,
+			BlockSingleSigner:        tpn.OwnAccount.BlockSingleSigner,
+			MultiSigner:              TestMultiSig,
+			DataPool:                 tpn.DataPool,
+			AddressPubkeyConverter:   TestAddressPubkeyConverter,
+			MaxTxNonceDeltaAllowed:   maxTxNonceDeltaAllowed,
+			TxFeeHandler:             tpn.EconomicsData,
+			BlockBlackList:           tpn.BlockBlackListHandler,
+			HeaderSigVerifier:        tpn.HeaderSigVerifier,
+			HeaderIntegrityVerifier:  tpn.HeaderIntegrityVerifier,
+			SizeCheckDelta:           sizeCheckDelta,
+			ValidityAttester:         tpn.BlockTracker,
+			EpochStartTrigger:        tpn.EpochStartTrigger,
+			WhiteListHandler:         tpn.WhiteListHandler,
+			WhiteListerVerifiedTxs:   tpn.WhiteListerVerifiedTxs,
+			AntifloodHandler:         &mock.NilAntifloodHandler{},
+			ArgumentsParser:          smartContract.NewArgumentParser(),
+			ChainID:                  tpn.ChainID,
+			MinTransactionVersion:    tpn.MinTransactionVersion,
+			TxSignHasher:             TestTxSignHasher,
+			EpochNotifier:            tpn.EpochNotifier,
+			SlashingEvidenceVerifier: tpn.SlashingEvidenceVerifier,
+			SlashingEvidencePool:     tpn.SlashingEvidencePool,
 		}
 		interceptorContainerFactory, _ := interceptorscontainer.NewShardInterceptorsContainerFactory(shardInterContFactArgs)
 
@@ -1222,10 +1275,12 @@ func (tpn *TestProcessorNode) initInnerProcessors(gasMap map[string]map[string]u
 	defaults.FillGasMapInternal(gasMap, 1)
 	gasSchedule := mock.NewGasScheduleNotifierMock(gasMap)
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasSchedule:     gasSchedule,
-		MapDNSAddresses: mapDNSAddresses,
-		Marshalizer:     TestMarshalizer,
-		Accounts:        tpn.AccntState,
+		GasSchedule:      gasSchedule,
+		MapDNSAddresses:  mapDNSAddresses,
+		Marshalizer:      TestMarshalizer,
+		Accounts:         tpn.AccntState,
+		ShardCoordinator: tpn.ShardCoordinator,
+		EpochNotifier:    tpn.EpochNotifier,
 	}
 	builtInFuncFactory, _ := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	builtInFuncs, _ := builtInFuncFactory.CreateBuiltInFunctionContainer()
@@ -1279,7 +1334,7 @@ func (tpn *TestProcessorNode) initInnerProcessors(gasMap map[string]map[string]u
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
 		PubkeyConverter:  TestAddressPubkeyConverter,
 		ShardCoordinator: tpn.ShardCoordinator,
-		BuiltInFuncNames: builtInFuncs.Keys(),
+		BuiltInFunctions: builtInFuncs,
 		ArgumentParser:   parsers.NewCallArgsParser(),
 	}
 	txTypeHandler, _ := coordinator.NewTxTypeHandler(argsTxTypeHandler)
@@ -1398,10 +1453,12 @@ func (tpn *TestProcessorNode) initMetaInnerProcessors() {
 	defaults.FillGasMapInternal(gasMap, 1)
 	gasSchedule := mock.NewGasScheduleNotifierMock(gasMap)
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasSchedule:     gasSchedule,
-		MapDNSAddresses: make(map[string]struct{}),
-		Marshalizer:     TestMarshalizer,
-		Accounts:        tpn.AccntState,
+		GasSchedule:      gasSchedule,
+		MapDNSAddresses:  make(map[string]struct{}),
+		Marshalizer:      TestMarshalizer,
+		Accounts:         tpn.AccntState,
+		ShardCoordinator: tpn.ShardCoordinator,
+		EpochNotifier:    tpn.EpochNotifier,
 	}
 	builtInFuncFactory, _ := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	builtInFuncs, _ := builtInFuncFactory.CreateBuiltInFunctionContainer()
@@ -1491,7 +1548,7 @@ func (tpn *TestProcessorNode) initMetaInnerProcessors() {
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
 		PubkeyConverter:  TestAddressPubkeyConverter,
 		ShardCoordinator: tpn.ShardCoordinator,
-		BuiltInFuncNames: builtInFuncs.Keys(),
+		BuiltInFunctions: builtInFuncs,
 		ArgumentParser:   parsers.NewCallArgsParser(),
 	}
 	txTypeHandler, _ := coordinator.NewTxTypeHandler(argsTxTypeHandler)
@@ -1719,6 +1776,7 @@ func (tpn *TestProcessorNode) initBlockProcessor(stateCheckpointModulus uint) {
 		Indexer:                 indexer.NewNilIndexer(),
 		TpsBenchmark:            &testscommon.TpsBenchmarkMock{},
 		HistoryRepository:       tpn.HistoryRepository,
+		SubscriptionNotifier:    &testscommon.SubscriptionNotifierStub{},
 		EpochNotifier:           tpn.EpochNotifier,
 		HeaderIntegrityVerifier: tpn.HeaderIntegrityVerifier,
 	}
@@ -1846,6 +1904,20 @@ func (tpn *TestProcessorNode) initBlockProcessor(stateCheckpointModulus uint) {
 		epochStartSystemSCProcessor, _ := metachain.NewSystemSCProcessor(argsEpochSystemSC)
 		tpn.EpochStartSystemSCProcessor = epochStartSystemSCProcessor
 
+		tpn.initSlashingEvidenceComponents()
+		argsSlashing := slash.ArgsSlashingProcessor{
+			SystemVM:            systemVM,
+			UserAccountsDB:      tpn.AccntState,
+			PeerAccountsDB:      tpn.PeerState,
+			Verifier:            tpn.SlashingEvidenceVerifier,
+			EvidencePool:        tpn.SlashingEvidencePool,
+			RatingsInfo:         tpn.RatingsData,
+			MaxEvidencePerBlock: maxSlashingEvidencePerBlock,
+			JailingAddress:      vm.JailingAddress,
+			StakingSCAddress:    vm.StakingSCAddress,
+		}
+		slashingProcessor, _ := slash.NewSlashingProcessor(argsSlashing)
+
 		arguments := block.ArgMetaProcessor{
 			ArgBaseProcessor:             argumentsBase,
 			SCToProtocol:                 scToProtocolInstance,
@@ -1856,6 +1928,7 @@ func (tpn *TestProcessorNode) initBlockProcessor(stateCheckpointModulus uint) {
 			EpochValidatorInfoCreator:    epochStartValidatorInfo,
 			ValidatorStatisticsProcessor: tpn.ValidatorStatisticsProcessor,
 			EpochSystemSCProcessor:       epochStartSystemSCProcessor,
+			SlashingProcessor:            slashingProcessor,
 		}
 
 		tpn.BlockProcessor, err = block.NewMetaProcessor(arguments)
@@ -1915,6 +1988,8 @@ func (tpn *TestProcessorNode) setGenesisBlock() {
 func (tpn *TestProcessorNode) initNode() {
 	var err error
 
+	tpn.initEquivocationDetector()
+
 	txAccumulator, _ := accumulator.NewTimeAccumulator(time.Millisecond*10, time.Millisecond)
 	tpn.Node, err = node.NewNode(
 		node.WithMessenger(tpn.Messenger),
@@ -1938,6 +2013,7 @@ func (tpn *TestProcessorNode) initNode() {
 		node.WithPubKey(tpn.NodeKeys.Pk),
 		node.WithInterceptorsContainer(tpn.InterceptorsContainer),
 		node.WithHeaderIntegrityVerifier(tpn.HeaderIntegrityVerifier),
+		node.WithEquivocationDetector(tpn.EquivocationDetector),
 		node.WithResolversFinder(tpn.ResolverFinder),
 		node.WithBlockProcessor(tpn.BlockProcessor),
 		node.WithTxSingleSigner(tpn.OwnAccount.SingleSigner),
@@ -2435,6 +2511,7 @@ func (tpn *TestProcessorNode) createHeartbeatWithHardforkTrigger(heartbeatPk str
 	err = tpn.Node.ApplyOptions(
 		node.WithHardforkTrigger(hardforkTrigger),
 		node.WithPeerSignatureHandler(psh),
+		node.WithKeysHandler(&testscommon.KeysHandlerStub{}),
 		node.WithInputAntifloodHandler(&mock.NilAntifloodHandler{}),
 		node.WithValidatorStatistics(&mock.ValidatorStatisticsProcessorStub{
 			GetValidatorInfoForRootHashCalled: func(_ []byte) (map[uint32][]*state.ValidatorInfo, error) {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core/forking"
 	"github.com/ElrondNetwork/elrond-go/crypto/peerSignatureHandler"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
@@ -447,6 +448,7 @@ func createHeaderIntegrityVerifier() process.HeaderIntegrityVerifier {
 		},
 		"default",
 		testscommon.NewCacherMock(),
+		consensus.BlsConsensusType,
 	)
 
 	return headerVersioning
@@ -121,6 +121,7 @@ func NewTestProcessorNodeWithStateCheckpointModulus(
 		tpn.ShardCoordinator,
 		tpn.OwnAccount.SkTxSign,
 		tpn.OwnAccount.PeerSigHandler,
+		&testscommon.KeysHandlerStub{},
 		tpn.DataPool.Headers(),
 		tpn.InterceptorsContainer,
 	)
@@ -127,6 +127,7 @@ func (tpn *TestProcessorNode) initTestNodeWithSync() {
 		tpn.ShardCoordinator,
 		tpn.OwnAccount.SkTxSign,
 		tpn.OwnAccount.PeerSigHandler,
+		&testscommon.KeysHandlerStub{},
 		tpn.DataPool.Headers(),
 		tpn.InterceptorsContainer,
 	)
@@ -188,6 +189,7 @@ func (tpn *TestProcessorNode) initBlockProcessorWithSync() {
 		Indexer:                 indexer.NewNilIndexer(),
 		TpsBenchmark:            &testscommon.TpsBenchmarkMock{},
 		HistoryRepository:       tpn.HistoryRepository,
+		SubscriptionNotifier:    &testscommon.SubscriptionNotifierStub{},
 		EpochNotifier:           tpn.EpochNotifier,
 		HeaderIntegrityVerifier: tpn.HeaderIntegrityVerifier,
 	}
@@ -206,6 +208,7 @@ func (tpn *TestProcessorNode) initBlockProcessorWithSync() {
 			EpochValidatorInfoCreator:    &mock.EpochValidatorInfoCreatorStub{},
 			ValidatorStatisticsProcessor: &mock.ValidatorStatisticsProcessorStub{},
 			EpochSystemSCProcessor:       &mock.EpochStartSystemSCStub{},
+			SlashingProcessor:            &mock.SlashingProcessorStub{},
 		}
 
 		tpn.BlockProcessor, err = block.NewMetaProcessor(arguments)
@@ -264,7 +264,7 @@ func CreateTxProcessorWithOneSCExecutorMockVM(
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
 		PubkeyConverter:  pubkeyConv,
 		ShardCoordinator: oneShardCoordinator,
-		BuiltInFuncNames: builtInFuncs.Keys(),
+		BuiltInFunctions: builtInFuncs,
 		ArgumentParser:   parsers.NewCallArgsParser(),
 	}
 	txTypeHandler, _ := coordinator.NewTxTypeHandler(argsTxTypeHandler)
@@ -360,10 +360,12 @@ func CreateVMAndBlockchainHook(
 	}
 
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasSchedule:     mock.NewGasScheduleNotifierMock(actualGasSchedule),
-		MapDNSAddresses: make(map[string]struct{}),
-		Marshalizer:     testMarshalizer,
-		Accounts:        accnts,
+		GasSchedule:      mock.NewGasScheduleNotifierMock(actualGasSchedule),
+		MapDNSAddresses:  make(map[string]struct{}),
+		Marshalizer:      testMarshalizer,
+		Accounts:         accnts,
+		ShardCoordinator: shardCoordinator,
+		EpochNotifier:    &mock.EpochNotifierStub{},
 	}
 	builtInFuncFactory, _ := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	builtInFuncs, _ := builtInFuncFactory.CreateBuiltInFunctionContainer()
@@ -423,7 +425,7 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
 		PubkeyConverter:  pubkeyConv,
 		ShardCoordinator: shardCoordinator,
-		BuiltInFuncNames: blockChainHook.GetBuiltInFunctions().Keys(),
+		BuiltInFunctions: blockChainHook.GetBuiltInFunctions(),
 		ArgumentParser:   parsers.NewCallArgsParser(),
 	}
 	txTypeHandler, _ := coordinator.NewTxTypeHandler(argsTxTypeHandler)
@@ -0,0 +1,15 @@
+package keysManagement
+
+import "errors"
+
+// ErrNilKeyGenerator signals that a nil key generator was provided
+var ErrNilKeyGenerator = errors.New("nil key generator")
+
+// ErrInvalidValue signals that an invalid value was provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrDuplicatedKey signals that a key is already managed
+var ErrDuplicatedKey = errors.New("duplicated key")
+
+// ErrMissingPrivateKey signals that the private key of the provided public key is not managed by the current node
+var ErrMissingPrivateKey = errors.New("missing private key")
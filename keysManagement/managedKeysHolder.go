@@ -0,0 +1,147 @@
+package keysManagement
+
+import (
+	"fmt"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+)
+
+var log = logger.GetOrCreate("keysManagement")
+
+// ArgsManagedKeysHolder represents the argument for the managed keys holder
+type ArgsManagedKeysHolder struct {
+	KeyGenerator               crypto.KeyGenerator
+	MaxBroadcastsPerKeyInRound uint32
+}
+
+type managedKey struct {
+	privateKey           crypto.PrivateKey
+	numBroadcastsInRound uint32
+}
+
+type managedKeysHolder struct {
+	mut                        sync.RWMutex
+	keyGenerator               crypto.KeyGenerator
+	maxBroadcastsPerKeyInRound uint32
+	keys                       map[string]*managedKey
+	orderedPublicKeys          [][]byte
+}
+
+// NewManagedKeysHolder creates a new instance of a managed keys holder, the component holding the validator keys
+// a node acts for, besides its own key
+func NewManagedKeysHolder(args ArgsManagedKeysHolder) (*managedKeysHolder, error) {
+	if check.IfNil(args.KeyGenerator) {
+		return nil, ErrNilKeyGenerator
+	}
+	if args.MaxBroadcastsPerKeyInRound == 0 {
+		return nil, fmt.Errorf("%w for MaxBroadcastsPerKeyInRound", ErrInvalidValue)
+	}
+
+	return &managedKeysHolder{
+		keyGenerator:               args.KeyGenerator,
+		maxBroadcastsPerKeyInRound: args.MaxBroadcastsPerKeyInRound,
+		keys:                       make(map[string]*managedKey),
+		orderedPublicKeys:          make([][]byte, 0),
+	}, nil
+}
+
+// AddManagedKey adds the private key provided as bytes to the managed keys
+func (holder *managedKeysHolder) AddManagedKey(privateKeyBytes []byte) error {
+	privateKey, err := holder.keyGenerator.PrivateKeyFromByteArray(privateKeyBytes)
+	if err != nil {
+		return err
+	}
+
+	publicKeyBytes, err := privateKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return err
+	}
+
+	holder.mut.Lock()
+	defer holder.mut.Unlock()
+
+	_, exists := holder.keys[string(publicKeyBytes)]
+	if exists {
+		return fmt.Errorf("%w, public key %s", ErrDuplicatedKey, core.GetTrimmedPk(fmt.Sprintf("%x", publicKeyBytes)))
+	}
+
+	holder.keys[string(publicKeyBytes)] = &managedKey{
+		privateKey: privateKey,
+	}
+	holder.orderedPublicKeys = append(holder.orderedPublicKeys, publicKeyBytes)
+
+	log.Debug("added managed key", "public key", publicKeyBytes)
+
+	return nil
+}
+
+// GetPrivateKey returns the private key of the provided managed public key
+func (holder *managedKeysHolder) GetPrivateKey(pkBytes []byte) (crypto.PrivateKey, error) {
+	holder.mut.RLock()
+	defer holder.mut.RUnlock()
+
+	key, exists := holder.keys[string(pkBytes)]
+	if !exists {
+		return nil, ErrMissingPrivateKey
+	}
+
+	return key.privateKey, nil
+}
+
+// IsKeyManaged returns true if the provided public key is managed by the current node
+func (holder *managedKeysHolder) IsKeyManaged(pkBytes []byte) bool {
+	holder.mut.RLock()
+	_, exists := holder.keys[string(pkBytes)]
+	holder.mut.RUnlock()
+
+	return exists
+}
+
+// ManagedKeys returns the managed public keys, in the order they were added
+func (holder *managedKeysHolder) ManagedKeys() [][]byte {
+	holder.mut.RLock()
+	defer holder.mut.RUnlock()
+
+	publicKeys := make([][]byte, len(holder.orderedPublicKeys))
+	copy(publicKeys, holder.orderedPublicKeys)
+
+	return publicKeys
+}
+
+// CanBroadcast returns true if the provided key did not reach the maximum number of broadcasts in the current round,
+// counting the current broadcast. Keys not managed by the current node are not limited
+func (holder *managedKeysHolder) CanBroadcast(pkBytes []byte) bool {
+	holder.mut.Lock()
+	defer holder.mut.Unlock()
+
+	key, exists := holder.keys[string(pkBytes)]
+	if !exists {
+		return true
+	}
+	if key.numBroadcastsInRound >= holder.maxBroadcastsPerKeyInRound {
+		log.Debug("broadcast limit reached for managed key", "public key", pkBytes)
+		return false
+	}
+
+	key.numBroadcastsInRound++
+
+	return true
+}
+
+// ResetRoundBroadcasts resets the number of broadcasts of all managed keys, should be called on each round start
+func (holder *managedKeysHolder) ResetRoundBroadcasts() {
+	holder.mut.Lock()
+	for _, key := range holder.keys {
+		key.numBroadcastsInRound = 0
+	}
+	holder.mut.Unlock()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (holder *managedKeysHolder) IsInterfaceNil() bool {
+	return holder == nil
+}
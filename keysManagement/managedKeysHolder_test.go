@@ -0,0 +1,124 @@
+package keysManagement
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/ed25519"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsManagedKeysHolder() ArgsManagedKeysHolder {
+	return ArgsManagedKeysHolder{
+		KeyGenerator:               signing.NewKeyGenerator(ed25519.NewEd25519()),
+		MaxBroadcastsPerKeyInRound: 2,
+	}
+}
+
+func generateKey(t *testing.T, keyGen crypto.KeyGenerator) ([]byte, []byte) {
+	sk, pk := keyGen.GeneratePair()
+	skBytes, err := sk.ToByteArray()
+	require.Nil(t, err)
+	pkBytes, err := pk.ToByteArray()
+	require.Nil(t, err)
+
+	return skBytes, pkBytes
+}
+
+func TestNewManagedKeysHolder_NilKeyGeneratorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsManagedKeysHolder()
+	args.KeyGenerator = nil
+
+	holder, err := NewManagedKeysHolder(args)
+	assert.True(t, check.IfNil(holder))
+	assert.Equal(t, ErrNilKeyGenerator, err)
+}
+
+func TestNewManagedKeysHolder_InvalidMaxBroadcastsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsManagedKeysHolder()
+	args.MaxBroadcastsPerKeyInRound = 0
+
+	holder, err := NewManagedKeysHolder(args)
+	assert.True(t, check.IfNil(holder))
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+}
+
+func TestNewManagedKeysHolder_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	holder, err := NewManagedKeysHolder(createMockArgsManagedKeysHolder())
+	assert.False(t, check.IfNil(holder))
+	assert.Nil(t, err)
+	assert.Empty(t, holder.ManagedKeys())
+}
+
+func TestManagedKeysHolder_AddManagedKey(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsManagedKeysHolder()
+	holder, _ := NewManagedKeysHolder(args)
+
+	err := holder.AddManagedKey([]byte("invalid"))
+	assert.NotNil(t, err)
+
+	skBytes1, pkBytes1 := generateKey(t, args.KeyGenerator)
+	skBytes2, pkBytes2 := generateKey(t, args.KeyGenerator)
+
+	err = holder.AddManagedKey(skBytes1)
+	assert.Nil(t, err)
+	err = holder.AddManagedKey(skBytes2)
+	assert.Nil(t, err)
+
+	err = holder.AddManagedKey(skBytes1)
+	assert.True(t, errors.Is(err, ErrDuplicatedKey))
+
+	assert.Equal(t, [][]byte{pkBytes1, pkBytes2}, holder.ManagedKeys())
+	assert.True(t, holder.IsKeyManaged(pkBytes1))
+	assert.True(t, holder.IsKeyManaged(pkBytes2))
+	assert.False(t, holder.IsKeyManaged([]byte("not managed")))
+
+	privateKey, err := holder.GetPrivateKey(pkBytes2)
+	require.Nil(t, err)
+	recoveredSkBytes, _ := privateKey.ToByteArray()
+	assert.Equal(t, skBytes2, recoveredSkBytes)
+
+	privateKey, err = holder.GetPrivateKey([]byte("not managed"))
+	assert.Nil(t, privateKey)
+	assert.Equal(t, ErrMissingPrivateKey, err)
+}
+
+func TestManagedKeysHolder_CanBroadcast(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsManagedKeysHolder()
+	holder, _ := NewManagedKeysHolder(args)
+
+	skBytes1, pkBytes1 := generateKey(t, args.KeyGenerator)
+	skBytes2, pkBytes2 := generateKey(t, args.KeyGenerator)
+	_ = holder.AddManagedKey(skBytes1)
+	_ = holder.AddManagedKey(skBytes2)
+
+	assert.True(t, holder.CanBroadcast(pkBytes1))
+	assert.True(t, holder.CanBroadcast(pkBytes1))
+	assert.False(t, holder.CanBroadcast(pkBytes1))
+
+	assert.True(t, holder.CanBroadcast(pkBytes2))
+
+	for i := 0; i < 10; i++ {
+		assert.True(t, holder.CanBroadcast([]byte("not managed")))
+	}
+
+	holder.ResetRoundBroadcasts()
+	assert.True(t, holder.CanBroadcast(pkBytes1))
+	assert.True(t, holder.CanBroadcast(pkBytes2))
+	assert.True(t, holder.CanBroadcast(pkBytes2))
+	assert.False(t, holder.CanBroadcast(pkBytes2))
+}
@@ -45,17 +45,26 @@ func (bap *baseAPIBockProcessor) getTxsByMb(mbHeader *block.MiniBlockHeader, epo
 		return nil
 	}
 
-	switch miniBlock.Type {
+	txType, unit, ok := getTxTypeAndUnitOfMiniblock(miniBlock.Type)
+	if !ok {
+		return nil
+	}
+
+	return bap.getTxsFromMiniblock(miniBlock, miniblockHash, epoch, txType, unit)
+}
+
+func getTxTypeAndUnitOfMiniblock(miniblockType block.Type) (transaction.TxType, dataRetriever.UnitType, bool) {
+	switch miniblockType {
 	case block.TxBlock:
-		return bap.getTxsFromMiniblock(miniBlock, miniblockHash, epoch, transaction.TxTypeNormal, dataRetriever.TransactionUnit)
+		return transaction.TxTypeNormal, dataRetriever.TransactionUnit, true
 	case block.RewardsBlock:
-		return bap.getTxsFromMiniblock(miniBlock, miniblockHash, epoch, transaction.TxTypeReward, dataRetriever.RewardTransactionUnit)
+		return transaction.TxTypeReward, dataRetriever.RewardTransactionUnit, true
 	case block.SmartContractResultBlock:
-		return bap.getTxsFromMiniblock(miniBlock, miniblockHash, epoch, transaction.TxTypeUnsigned, dataRetriever.UnsignedTransactionUnit)
+		return transaction.TxTypeUnsigned, dataRetriever.UnsignedTransactionUnit, true
 	case block.InvalidBlock:
-		return bap.getTxsFromMiniblock(miniBlock, miniblockHash, epoch, transaction.TxTypeInvalid, dataRetriever.TransactionUnit)
+		return transaction.TxTypeInvalid, dataRetriever.TransactionUnit, true
 	default:
-		return nil
+		return "", 0, false
 	}
 }
 
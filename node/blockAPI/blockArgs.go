@@ -1,11 +1,14 @@
 package blockAPI
 
 import (
+	"time"
+
 	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
 )
 
 // APIBlockProcessorArg is structure that store components that are needed to create an api block procesosr
@@ -16,4 +19,8 @@ type APIBlockProcessorArg struct {
 	Uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
 	HistoryRepo              dblookupext.HistoryRepository
 	UnmarshalTx              func(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error)
+	DataPool                 dataRetriever.PoolsHolder
+	RequestHandler           process.RequestHandler
+	RequestTimeout           time.Duration
+	MaxRequestedHashes       int
 }
@@ -0,0 +1,12 @@
+package blockAPI
+
+import "errors"
+
+// ErrTransactionNotFound signals that a transaction of a miniblock was not found in storage nor fetched from its shard
+var ErrTransactionNotFound = errors.New("transaction not found")
+
+// ErrMiniblockNotFound signals that a miniblock was not found in storage nor fetched from its shard
+var ErrMiniblockNotFound = errors.New("miniblock not found")
+
+// ErrTooManyMissingHashes signals that a hyperblock misses more miniblocks and transactions than can be requested
+var ErrTooManyMissingHashes = errors.New("too many missing hashes")
@@ -0,0 +1,449 @@
+package blockAPI
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	apiHyperblock "github.com/ElrondNetwork/elrond-go/api/hyperblock"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+)
+
+const pollPoolInterval = 10 * time.Millisecond
+
+// GetHyperblockByNonce will return the hyperblock of the meta block with the provided nonce
+func (mbp *metaAPIBlockProcessor) GetHyperblockByNonce(nonce uint64) (*apiHyperblock.APIHyperblock, error) {
+	nonceToByteSlice := mbp.uint64ByteSliceConverter.ToByteSlice(nonce)
+	headerHash, err := mbp.store.Get(dataRetriever.MetaHdrNonceHashDataUnit, nonceToByteSlice)
+	if err != nil {
+		return nil, err
+	}
+
+	return mbp.GetHyperblockByHash(headerHash)
+}
+
+// GetHyperblockByHash will return the hyperblock of the meta block with the provided hash
+func (mbp *metaAPIBlockProcessor) GetHyperblockByHash(hash []byte) (*apiHyperblock.APIHyperblock, error) {
+	blockBytes, err := mbp.getFromStorer(dataRetriever.MetaBlockUnit, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	metaBlock := &block.MetaBlock{}
+	err = mbp.marshalizer.Unmarshal(metaBlock, blockBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	shardBlocks := make([]*apiHyperblock.APINotarizedShardBlock, 0, len(metaBlock.ShardInfo))
+	executedMiniblocks := make([]*executedMiniblock, 0)
+	for _, shardData := range metaBlock.ShardInfo {
+		shardBlocks = append(shardBlocks, &apiHyperblock.APINotarizedShardBlock{
+			Hash:  hex.EncodeToString(shardData.HeaderHash),
+			Nonce: shardData.Nonce,
+			Round: shardData.Round,
+			Shard: shardData.ShardID,
+		})
+
+		executedMiniblocks = append(executedMiniblocks, getMiniblocksExecutedBy(shardData.ShardMiniBlockHeaders, shardData.ShardID)...)
+	}
+	executedMiniblocks = append(executedMiniblocks, getMiniblocksExecutedBy(metaBlock.MiniBlockHeaders, core.MetachainShardId)...)
+
+	// a single deadline bounds all the requests sent to the shards while building the hyperblock
+	deadline := time.Now().Add(mbp.requestTimeout)
+	transactions, err := mbp.getTxsOfExecutedMiniblocks(executedMiniblocks, metaBlock.Epoch, deadline)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiHyperblock.APIHyperblock{
+		Nonce:         metaBlock.Nonce,
+		Round:         metaBlock.Round,
+		Hash:          hex.EncodeToString(hash),
+		PrevBlockHash: hex.EncodeToString(metaBlock.PrevHash),
+		Epoch:         metaBlock.Epoch,
+		NumTxs:        uint32(len(transactions)),
+		ShardBlocks:   shardBlocks,
+		Transactions:  transactions,
+	}, nil
+}
+
+// executedMiniblock is a miniblock of a hyperblock, together with the shard which executed it
+type executedMiniblock struct {
+	hash          []byte
+	executorShard uint32
+	txType        transaction.TxType
+	unit          dataRetriever.UnitType
+	miniblock     *block.MiniBlock
+}
+
+// getMiniblocksExecutedBy returns the miniblocks received by the provided shard. A cross shard miniblock is notarized
+// both for the source and for the destination shard, but only the destination executes it
+func getMiniblocksExecutedBy(miniblockHeaders []block.MiniBlockHeader, shardID uint32) []*executedMiniblock {
+	miniblocks := make([]*executedMiniblock, 0, len(miniblockHeaders))
+	for _, mbHeader := range miniblockHeaders {
+		if mbHeader.ReceiverShardID != shardID {
+			continue
+		}
+
+		txType, unit, ok := getTxTypeAndUnitOfMiniblock(mbHeader.Type)
+		if !ok {
+			continue
+		}
+
+		miniblocks = append(miniblocks, &executedMiniblock{
+			hash:          mbHeader.Hash,
+			executorShard: shardID,
+			txType:        txType,
+			unit:          unit,
+		})
+	}
+
+	return miniblocks
+}
+
+// getTxsOfExecutedMiniblocks returns all the transactions of the provided miniblocks. The metachain only stores the
+// miniblocks, and their transactions, sent from or to itself, so the other ones are requested from the shards which
+// executed them, in parallel and until the provided deadline. An error is returned if any miniblock or transaction
+// could not be fetched or if more hashes than allowed are missing
+func (mbp *metaAPIBlockProcessor) getTxsOfExecutedMiniblocks(
+	miniblocks []*executedMiniblock,
+	metaBlockEpoch uint32,
+	deadline time.Time,
+) ([]*transaction.ApiTransactionResult, error) {
+	numRequestedHashes, err := mbp.setMiniblocks(miniblocks, metaBlockEpoch, deadline)
+	if err != nil {
+		return nil, err
+	}
+
+	marshalizedTxs, err := mbp.getMarshalizedTxs(miniblocks, metaBlockEpoch, deadline, numRequestedHashes)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := make([]*transaction.ApiTransactionResult, 0)
+	for _, mb := range miniblocks {
+		txs, errGet := mbp.getFinalizedTxsOfMiniblock(mb, marshalizedTxs)
+		if errGet != nil {
+			return nil, errGet
+		}
+		transactions = append(transactions, txs...)
+	}
+
+	return transactions, nil
+}
+
+// setMiniblocks reads the provided miniblocks from the storage and fetches the missing ones from their shards. It
+// returns the number of requested hashes
+func (mbp *metaAPIBlockProcessor) setMiniblocks(
+	miniblocks []*executedMiniblock,
+	metaBlockEpoch uint32,
+	deadline time.Time,
+) (int, error) {
+	missingHashesByShard := make(map[uint32][][]byte)
+	numMissingHashes := 0
+	for _, mb := range miniblocks {
+		mbBytes, err := mbp.getFromStorerWithEpoch(dataRetriever.MiniBlockUnit, mb.hash, mbp.getMiniblockEpoch(mb.hash, metaBlockEpoch))
+		if err != nil {
+			missingHashesByShard[mb.executorShard] = append(missingHashesByShard[mb.executorShard], mb.hash)
+			numMissingHashes++
+			continue
+		}
+
+		mb.miniblock = &block.MiniBlock{}
+		err = mbp.marshalizer.Unmarshal(mb.miniblock, mbBytes)
+		if err != nil {
+			return 0, err
+		}
+	}
+	if numMissingHashes == 0 {
+		return 0, nil
+	}
+	err := mbp.checkNumMissingHashes(numMissingHashes)
+	if err != nil {
+		return 0, err
+	}
+
+	pool := mbp.dataPool.MiniBlocks()
+	getFromPool := func(hash []byte) (interface{}, bool) {
+		return pool.Peek(hash)
+	}
+	requests := make([]*missingHashesRequest, 0, len(missingHashesByShard))
+	for shardID, missingHashes := range missingHashesByShard {
+		requests = append(requests, &missingHashesRequest{
+			shardID:     shardID,
+			hashes:      missingHashes,
+			getFromPool: getFromPool,
+			request:     mbp.createRequestMiniblocksFunc(shardID),
+		})
+	}
+
+	fetched, err := mbp.fetchAllMissing(requests, deadline)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", ErrMiniblockNotFound, err.Error())
+	}
+
+	for _, mb := range miniblocks {
+		if mb.miniblock != nil {
+			continue
+		}
+
+		miniblock, ok := fetched[string(mb.hash)].(*block.MiniBlock)
+		if !ok {
+			continue
+		}
+		mb.miniblock = miniblock
+	}
+
+	return numMissingHashes, nil
+}
+
+func (mbp *metaAPIBlockProcessor) createRequestMiniblocksFunc(shardID uint32) func(hashes [][]byte) {
+	return func(hashes [][]byte) {
+		mbp.requestHandler.RequestMiniBlocks(shardID, hashes)
+	}
+}
+
+// txsOfShard identifies the transactions of a type executed by a shard, which are requested together
+type txsOfShard struct {
+	shardID uint32
+	txType  transaction.TxType
+}
+
+// getMarshalizedTxs returns the transactions of the provided miniblocks, read from the storage or fetched from the
+// shards which executed them
+func (mbp *metaAPIBlockProcessor) getMarshalizedTxs(
+	miniblocks []*executedMiniblock,
+	metaBlockEpoch uint32,
+	deadline time.Time,
+	numRequestedHashes int,
+) (map[string][]byte, error) {
+	marshalizedTxs := make(map[string][]byte)
+	missingHashesByShard := make(map[txsOfShard][][]byte)
+	numMissingHashes := 0
+	for _, mb := range miniblocks {
+		if mb.miniblock == nil {
+			return nil, fmt.Errorf("%w: %s", ErrMiniblockNotFound, hex.EncodeToString(mb.hash))
+		}
+
+		epoch := mbp.getMiniblockEpoch(mb.hash, metaBlockEpoch)
+		storedTxs, err := mbp.store.GetStorer(mb.unit).GetBulkFromEpoch(mb.miniblock.TxHashes, epoch)
+		if err != nil {
+			storedTxs = make(map[string][]byte)
+		}
+
+		key := txsOfShard{shardID: mb.executorShard, txType: mb.txType}
+		for _, txHash := range mb.miniblock.TxHashes {
+			txBytes, found := storedTxs[string(txHash)]
+			if !found {
+				missingHashesByShard[key] = append(missingHashesByShard[key], txHash)
+				numMissingHashes++
+				continue
+			}
+			marshalizedTxs[string(txHash)] = txBytes
+		}
+	}
+	if numMissingHashes == 0 {
+		return marshalizedTxs, nil
+	}
+	err := mbp.checkNumMissingHashes(numRequestedHashes + numMissingHashes)
+	if err != nil {
+		return nil, err
+	}
+
+	requests := make([]*missingHashesRequest, 0, len(missingHashesByShard))
+	for key, missingHashes := range missingHashesByShard {
+		pool, requestTxs := mbp.getTxsPoolAndRequestFunc(key.txType, key.shardID)
+		requests = append(requests, &missingHashesRequest{
+			shardID:     key.shardID,
+			hashes:      missingHashes,
+			getFromPool: pool.SearchFirstData,
+			request:     requestTxs,
+		})
+	}
+
+	fetched, err := mbp.fetchAllMissing(requests, deadline)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrTransactionNotFound, err.Error())
+	}
+
+	for txHash, tx := range fetched {
+		txBytes, errMarshal := mbp.marshalizer.Marshal(tx)
+		if errMarshal != nil {
+			return nil, errMarshal
+		}
+		marshalizedTxs[txHash] = txBytes
+	}
+
+	return marshalizedTxs, nil
+}
+
+func (mbp *metaAPIBlockProcessor) checkNumMissingHashes(numMissingHashes int) error {
+	if numMissingHashes > mbp.maxRequestedHashes {
+		return fmt.Errorf("%w: %d missing, at most %d can be requested",
+			ErrTooManyMissingHashes, numMissingHashes, mbp.maxRequestedHashes)
+	}
+
+	return nil
+}
+
+func (mbp *metaAPIBlockProcessor) getFinalizedTxsOfMiniblock(
+	mb *executedMiniblock,
+	marshalizedTxs map[string][]byte,
+) ([]*transaction.ApiTransactionResult, error) {
+	miniblock := mb.miniblock
+	txs := make([]*transaction.ApiTransactionResult, 0, len(miniblock.TxHashes))
+	for _, txHash := range miniblock.TxHashes {
+		tx, errUnmarshalTx := mbp.unmarshalTx(marshalizedTxs[string(txHash)], mb.txType)
+		if errUnmarshalTx != nil {
+			return nil, errUnmarshalTx
+		}
+		tx.Hash = hex.EncodeToString(txHash)
+		tx.MiniBlockType = miniblock.Type.String()
+		tx.MiniBlockHash = hex.EncodeToString(mb.hash)
+		tx.SourceShard = miniblock.SenderShardID
+		tx.DestinationShard = miniblock.ReceiverShardID
+
+		tx.Status = (&transaction.StatusComputer{
+			MiniblockType:        miniblock.Type,
+			IsMiniblockFinalized: true,
+			SourceShard:          tx.SourceShard,
+			DestinationShard:     tx.DestinationShard,
+			Receiver:             tx.Tx.GetRcvAddr(),
+			TransactionData:      tx.Data,
+			SelfShard:            mbp.selfShardID,
+		}).ComputeStatusWhenInStorageKnowingMiniblock()
+
+		txs = append(txs, tx)
+	}
+
+	return txs, nil
+}
+
+func (mbp *metaAPIBlockProcessor) getTxsPoolAndRequestFunc(
+	txType transaction.TxType,
+	shardID uint32,
+) (dataRetriever.ShardedDataCacherNotifier, func(hashes [][]byte)) {
+	switch txType {
+	case transaction.TxTypeUnsigned:
+		return mbp.dataPool.UnsignedTransactions(), func(hashes [][]byte) {
+			mbp.requestHandler.RequestUnsignedTransactions(shardID, hashes)
+		}
+	case transaction.TxTypeReward:
+		return mbp.dataPool.RewardTransactions(), func(hashes [][]byte) {
+			mbp.requestHandler.RequestRewardTransactions(shardID, hashes)
+		}
+	default:
+		return mbp.dataPool.Transactions(), func(hashes [][]byte) {
+			mbp.requestHandler.RequestTransaction(shardID, hashes)
+		}
+	}
+}
+
+// missingHashesRequest holds the hashes of the data to be fetched from a shard, together with the way to find it in
+// the pool and to request it
+type missingHashesRequest struct {
+	shardID     uint32
+	hashes      [][]byte
+	getFromPool func(hash []byte) (interface{}, bool)
+	request     func(hashes [][]byte)
+}
+
+// fetchAllMissing fetches the data of all the provided requests in parallel, so the whole call is bound by the
+// provided deadline instead of one request timeout per shard
+func (mbp *metaAPIBlockProcessor) fetchAllMissing(
+	requests []*missingHashesRequest,
+	deadline time.Time,
+) (map[string]interface{}, error) {
+	fetchedOfRequests := make([]map[string]interface{}, len(requests))
+	errs := make([]error, len(requests))
+	wg := sync.WaitGroup{}
+	wg.Add(len(requests))
+	for i, req := range requests {
+		go func(idx int, req *missingHashesRequest) {
+			fetchedOfRequests[idx], errs[idx] = mbp.fetchMissing(req, deadline)
+			wg.Done()
+		}(i, req)
+	}
+	wg.Wait()
+
+	fetched := make(map[string]interface{})
+	for i := range requests {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		for hash, value := range fetchedOfRequests[i] {
+			fetched[hash] = value
+		}
+	}
+
+	return fetched, nil
+}
+
+// fetchMissing returns the data with the provided hashes from the pool, requesting it from the provided shard until
+// all of it is received or the deadline passes, in which case the hashes still missing are reported
+func (mbp *metaAPIBlockProcessor) fetchMissing(req *missingHashesRequest, deadline time.Time) (map[string]interface{}, error) {
+	fetched := make(map[string]interface{}, len(req.hashes))
+	missingHashes := takeFromPool(req.hashes, req.getFromPool, fetched)
+	if len(missingHashes) == 0 {
+		return fetched, nil
+	}
+
+	canRequest := req.shardID != mbp.selfShardID
+	nextRequestTime := time.Now()
+	for canRequest && len(missingHashes) > 0 && time.Now().Before(deadline) {
+		if !time.Now().Before(nextRequestTime) {
+			req.request(missingHashes)
+			nextRequestTime = time.Now().Add(mbp.requestHandler.RequestInterval())
+		}
+
+		time.Sleep(pollPoolInterval)
+		missingHashes = takeFromPool(missingHashes, req.getFromPool, fetched)
+	}
+
+	if len(missingHashes) > 0 {
+		return nil, fmt.Errorf("%d hashes not fetched from shard %d, first one %s",
+			len(missingHashes), req.shardID, hex.EncodeToString(missingHashes[0]))
+	}
+
+	return fetched, nil
+}
+
+func takeFromPool(
+	hashes [][]byte,
+	getFromPool func(hash []byte) (interface{}, bool),
+	fetched map[string]interface{},
+) [][]byte {
+	missingHashes := make([][]byte, 0, len(hashes))
+	for _, hash := range hashes {
+		value, ok := getFromPool(hash)
+		if !ok {
+			missingHashes = append(missingHashes, hash)
+			continue
+		}
+
+		fetched[string(hash)] = value
+	}
+
+	return missingHashes
+}
+
+// getMiniblockEpoch returns the epoch recorded by the dblookupext index for the provided miniblock. The miniblocks
+// of the notarized shard blocks are not always indexed by a metachain node, in which case the epoch of the notarizing
+// meta block is used
+func (mbp *metaAPIBlockProcessor) getMiniblockEpoch(miniblockHash []byte, metaBlockEpoch uint32) uint32 {
+	if !mbp.hasDbLookupExtensions {
+		return metaBlockEpoch
+	}
+
+	epoch, err := mbp.historyRepo.GetEpochByHash(miniblockHash)
+	if err != nil {
+		return metaBlockEpoch
+	}
+
+	return epoch
+}
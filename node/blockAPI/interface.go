@@ -1,9 +1,18 @@
 package blockAPI
 
-import apiBlock "github.com/ElrondNetwork/elrond-go/api/block"
+import (
+	apiBlock "github.com/ElrondNetwork/elrond-go/api/block"
+	apiHyperblock "github.com/ElrondNetwork/elrond-go/api/hyperblock"
+)
 
 // APIBlockHandler defines the behavior of a component able to return api blocks
 type APIBlockHandler interface {
 	GetBlockByNonce(nonce uint64, withTxs bool) (*apiBlock.APIBlock, error)
 	GetBlockByHash(hash []byte, withTxs bool) (*apiBlock.APIBlock, error)
 }
+
+// APIHyperblockHandler defines the behavior of a component able to return api hyperblocks
+type APIHyperblockHandler interface {
+	GetHyperblockByNonce(nonce uint64) (*apiHyperblock.APIHyperblock, error)
+	GetHyperblockByHash(hash []byte) (*apiHyperblock.APIHyperblock, error)
+}
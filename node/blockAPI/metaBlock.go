@@ -2,15 +2,21 @@ package blockAPI
 
 import (
 	"encoding/hex"
+	"time"
 
 	apiBlock "github.com/ElrondNetwork/elrond-go/api/block"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/process"
 )
 
 type metaAPIBlockProcessor struct {
 	*baseAPIBockProcessor
+	dataPool           dataRetriever.PoolsHolder
+	requestHandler     process.RequestHandler
+	requestTimeout     time.Duration
+	maxRequestedHashes int
 }
 
 // NewMetaApiBlockProcessor will create a new instance of meta api block processor
@@ -26,6 +32,10 @@ func NewMetaApiBlockProcessor(arg *APIBlockProcessorArg) *metaAPIBlockProcessor
 			historyRepo:              arg.HistoryRepo,
 			unmarshalTx:              arg.UnmarshalTx,
 		},
+		dataPool:           arg.DataPool,
+		requestHandler:     arg.RequestHandler,
+		requestTimeout:     arg.RequestTimeout,
+		maxRequestedHashes: arg.MaxRequestedHashes,
 	}
 }
 
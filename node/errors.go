@@ -187,6 +187,12 @@ var ErrNilHistoryRepository = errors.New("history repository is nil")
 // ErrNilPeerSignatureHandler signals that a nil peerSignatureHandler object has been provided
 var ErrNilPeerSignatureHandler = errors.New("trying to set nil peerSignatureHandler")
 
+// ErrNilKeysHandler signals that a nil keys handler has been provided
+var ErrNilKeysHandler = errors.New("nil keys handler")
+
+// ErrNilSigningHandler signals that a nil signing handler has been provided
+var ErrNilSigningHandler = errors.New("nil signing handler")
+
 // ErrNilTxSimulatorProcessor signals that a nil transaction simulator processor has been provided
 var ErrNilTxSimulatorProcessor = errors.New("nil transaction simulator processor")
 
@@ -207,3 +213,54 @@ var ErrNilTransactionVersionChecker = errors.New("nil transaction version checke
 
 // ErrNilDataTrie signals that user account has a nil data trie
 var ErrNilDataTrie = errors.New("nil data trie")
+
+// ErrDbLookupExtensionsNotEnabled signals that the db lookup extensions are not enabled
+var ErrDbLookupExtensionsNotEnabled = errors.New("db lookup extensions not enabled")
+
+// ErrNilAccountsRecreator signals that a nil accounts recreator has been provided
+var ErrNilAccountsRecreator = errors.New("trying to set nil accounts recreator")
+
+// ErrBlockNonceAndHashProvided signals that both the block nonce and the block hash were provided when selecting a block
+var ErrBlockNonceAndHashProvided = errors.New("only one of block nonce and block hash can be provided")
+
+// ErrHistoricalStateNotAvailable signals that the state of the requested block is no longer available
+var ErrHistoricalStateNotAvailable = errors.New("state is not available for the requested block, it might have been pruned")
+
+// ErrProofsNotSupported signals that the accounts adapter is not able to generate Merkle proofs
+var ErrProofsNotSupported = errors.New("the accounts adapter does not support Merkle proofs")
+
+// ErrNFTNotFound signals that the requested non-fungible token was not found in the account
+var ErrNFTNotFound = errors.New("non-fungible token not found")
+
+// ErrTransactionsPoolInspectionNotSupported signals that the transactions pool does not support inspecting its content
+var ErrTransactionsPoolInspectionNotSupported = errors.New("the transactions pool does not support inspection")
+
+// ErrSenderNotFoundInTransactionsPool signals that the transactions pool does not hold details about the requested sender
+var ErrSenderNotFoundInTransactionsPool = errors.New("sender not found in the transactions pool")
+
+// ErrNilEquivocationDetector signals that a nil equivocation detector has been provided
+var ErrNilEquivocationDetector = errors.New("nil equivocation detector")
+
+// ErrNilHealthService signals that a nil health service has been provided
+var ErrNilHealthService = errors.New("nil health service")
+
+// ErrNilCallTracer signals that a nil call tracer has been provided
+var ErrNilCallTracer = errors.New("nil call tracer")
+
+// ErrNilSimulationAccounts signals that nil simulation accounts have been provided
+var ErrNilSimulationAccounts = errors.New("nil simulation accounts")
+
+// ErrNilTxLogsCollector signals that a nil transaction logs collector has been provided
+var ErrNilTxLogsCollector = errors.New("nil transaction logs collector")
+
+// ErrNoTransactionsToSimulate signals that an empty list of transactions was provided for simulation
+var ErrNoTransactionsToSimulate = errors.New("no transactions to simulate")
+
+// ErrHyperblocksNotSupported signals that hyperblocks are only served by metachain nodes with the dblookupext enabled
+var ErrHyperblocksNotSupported = errors.New("hyperblocks are only served by metachain nodes with the DbLookupExtensions enabled")
+
+// ErrInvalidNoncesRange signals that the provided start nonce is greater than the end nonce
+var ErrInvalidNoncesRange = errors.New("invalid nonces range")
+
+// ErrNilBlockHeader signals that the blockchain does not hold any block header
+var ErrNilBlockHeader = errors.New("nil block header")
@@ -1,6 +1,8 @@
 package node
 
 import (
+	"time"
+
 	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/p2p"
@@ -13,3 +15,11 @@ func (n *Node) CreateConsensusTopic(messageProcessor p2p.MessageProcessor) error
 func PutMiniblockFieldsInTransaction(tx *transaction.ApiTransactionResult, miniblockMetadata *dblookupext.MiniblockMetadata) *transaction.ApiTransactionResult {
 	return putMiniblockFieldsInTransaction(tx, miniblockMetadata)
 }
+
+func (n *Node) SetHyperblockDataRequestTimeout(timeout time.Duration) {
+	n.hyperblockDataRequestTimeout = timeout
+}
+
+func (n *Node) SetHyperblockMaxRequestedHashes(maxRequestedHashes int) {
+	n.hyperblockMaxRequestedHashes = maxRequestedHashes
+}
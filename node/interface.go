@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/process"
 	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/ElrondNetwork/elrond-go/update"
 )
 
@@ -83,3 +84,17 @@ type HeartbeatHandler interface {
 	Sender() *process.Sender
 	IsInterfaceNil() bool
 }
+
+// HealthService defines the health service methods used by the node to register its components
+type HealthService interface {
+	RegisterHealthChecker(name string, component interface{})
+	RegisterHeartbeatSource(name string, component interface{})
+	IsInterfaceNil() bool
+}
+
+// TransactionsPoolInspector defines the transactions pool methods used to inspect the pending transactions
+type TransactionsPoolInspector interface {
+	GetTransactionsForSender(sender []byte) []*txcache.WrappedTransaction
+	GetSenderInfo(sender []byte) (*txcache.SenderInfo, bool)
+	GetStats() map[string]txcache.CacheStats
+}
@@ -0,0 +1,24 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/state"
+)
+
+// AccountsRecreatorStub -
+type AccountsRecreatorStub struct {
+	RecreateAccountsAdapterCalled func(rootHash []byte) (state.AccountsAdapter, error)
+}
+
+// RecreateAccountsAdapter -
+func (stub *AccountsRecreatorStub) RecreateAccountsAdapter(rootHash []byte) (state.AccountsAdapter, error) {
+	if stub.RecreateAccountsAdapterCalled != nil {
+		return stub.RecreateAccountsAdapterCalled(rootHash)
+	}
+
+	return &AccountsStub{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *AccountsRecreatorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,26 @@
+package mock
+
+// HealthServiceStub -
+type HealthServiceStub struct {
+	RegisterHealthCheckerCalled   func(name string, component interface{})
+	RegisterHeartbeatSourceCalled func(name string, component interface{})
+}
+
+// RegisterHealthChecker -
+func (hss *HealthServiceStub) RegisterHealthChecker(name string, component interface{}) {
+	if hss.RegisterHealthCheckerCalled != nil {
+		hss.RegisterHealthCheckerCalled(name, component)
+	}
+}
+
+// RegisterHeartbeatSource -
+func (hss *HealthServiceStub) RegisterHeartbeatSource(name string, component interface{}) {
+	if hss.RegisterHeartbeatSourceCalled != nil {
+		hss.RegisterHeartbeatSourceCalled(name, component)
+	}
+}
+
+// IsInterfaceNil -
+func (hss *HealthServiceStub) IsInterfaceNil() bool {
+	return hss == nil
+}
@@ -63,6 +63,11 @@ func (mm *MultisignMock) CreateSignatureShare(_ []byte, _ []byte) ([]byte, error
 	panic("implement me")
 }
 
+// CreateSignatureShareWithPrivateKey -
+func (mm *MultisignMock) CreateSignatureShareWithPrivateKey(_ []byte, _ crypto.PrivateKey) ([]byte, error) {
+	panic("implement me")
+}
+
 // StoreSignatureShare -
 func (mm *MultisignMock) StoreSignatureShare(_ uint16, _ []byte) error {
 	panic("implement me")
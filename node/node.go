@@ -59,6 +59,18 @@ import (
 // SendTransactionsPipe is the pipe used for sending new transactions
 const SendTransactionsPipe = "send transactions pipe"
 
+const maxTransactionsByAddressPageSize = 100
+
+const maxLogEventsPageSize = 100
+
+// defaultHyperblockDataRequestTimeout bounds the time spent requesting the shard miniblocks and transactions of a
+// hyperblock which are not stored by the metachain
+const defaultHyperblockDataRequestTimeout = 5 * time.Second
+
+// defaultHyperblockMaxRequestedHashes bounds the number of shard miniblocks and transactions which can be requested
+// while building a hyperblock
+const defaultHyperblockMaxRequestedHashes = 1000
+
 var log = logger.GetOrCreate("node")
 var numSecondsBetweenPrints = 20
 
@@ -88,6 +100,7 @@ type Node struct {
 	epochStartTrigger             epochStart.TriggerHandler
 	epochStartRegistrationHandler epochStart.RegistrationHandler
 	accounts                      state.AccountsAdapter
+	accountsRecreator             state.AccountsAdapterRecreator
 	addressPubkeyConverter        core.PubkeyConverter
 	validatorPubkeyConverter      core.PubkeyConverter
 	uint64ByteSliceConverter      typeConverters.Uint64ByteSliceConverter
@@ -109,6 +122,8 @@ type Node struct {
 	txSingleSigner    crypto.SingleSigner
 	multiSigner       crypto.MultiSigner
 	peerSigHandler    crypto.PeerSignatureHandler
+	keysHandler       consensus.KeysHandler
+	signingHandler    crypto.SigningHandler
 	forkDetector      process.ForkDetector
 
 	blkc               data.ChainHandler
@@ -132,6 +147,8 @@ type Node struct {
 	requestedItemsHandler   dataRetriever.RequestedItemsHandler
 	headerSigVerifier       consensus.HeaderSigVerifier
 	headerIntegrityVerifier spos.HeaderIntegrityVerifier
+	equivocationDetector    spos.EquivocationDetector
+	healthService           HealthService
 
 	chainID               []byte
 	minTransactionVersion uint32
@@ -165,6 +182,9 @@ type Node struct {
 	txSignHasher              hashing.Hasher
 	txVersionChecker          process.TxVersionCheckerHandler
 	isInImportMode            bool
+
+	hyperblockDataRequestTimeout time.Duration
+	hyperblockMaxRequestedHashes int
 }
 
 // ApplyOptions can set up different configurable options of a Node instance
@@ -181,10 +201,12 @@ func (n *Node) ApplyOptions(opts ...Option) error {
 // NewNode creates a new Node instance
 func NewNode(opts ...Option) (*Node, error) {
 	node := &Node{
-		ctx:                      context.Background(),
-		currentSendingGoRoutines: 0,
-		appStatusHandler:         statusHandler.NewNilStatusHandler(),
-		queryHandlers:            make(map[string]debug.QueryHandler),
+		ctx:                          context.Background(),
+		currentSendingGoRoutines:     0,
+		appStatusHandler:             statusHandler.NewNilStatusHandler(),
+		queryHandlers:                make(map[string]debug.QueryHandler),
+		hyperblockDataRequestTimeout: defaultHyperblockDataRequestTimeout,
+		hyperblockMaxRequestedHashes: defaultHyperblockMaxRequestedHashes,
 	}
 	for _, opt := range opts {
 		err := opt(node)
@@ -263,6 +285,11 @@ func (n *Node) StartConsensus() error {
 		log.Debug("cannot set app status handler for shard bootstrapper")
 	}
 
+	if !check.IfNil(n.healthService) {
+		n.healthService.RegisterHealthChecker(core.HealthComponentSync, bootstrapper)
+		n.healthService.RegisterHeartbeatSource(core.HealthComponentSync, bootstrapper)
+	}
+
 	bootstrapper.StartSyncingBlocks()
 
 	epoch := n.blkc.GetGenesisHeader().GetEpoch()
@@ -289,6 +316,7 @@ func (n *Node) StartConsensus() error {
 		n.shardCoordinator,
 		n.privKey,
 		n.peerSigHandler,
+		n.keysHandler,
 		n.dataPool.Headers(),
 		n.interceptorsContainer,
 	)
@@ -322,6 +350,7 @@ func (n *Node) StartConsensus() error {
 		NetworkShardingCollector: n.networkShardingCollector,
 		AntifloodHandler:         n.inputAntifloodHandler,
 		PoolAdder:                n.dataPool.MiniBlocks(),
+		EquivocationDetector:     n.equivocationDetector,
 		SignatureSize:            n.signatureSize,
 		PublicKeySize:            n.publicKeySize,
 	}
@@ -353,6 +382,7 @@ func (n *Node) StartConsensus() error {
 		BlsPrivateKey:                 n.privKey,
 		BlsSingleSigner:               n.singleSigner,
 		MultiSigner:                   n.multiSigner,
+		SigningHandler:                n.signingHandler,
 		Rounder:                       n.rounder,
 		ShardCoordinator:              n.shardCoordinator,
 		NodesCoordinator:              n.nodesCoordinator,
@@ -406,9 +436,9 @@ func (n *Node) addCloserInstances(closers ...update.Closer) error {
 	return nil
 }
 
-// GetBalance gets the balance for a specific address
-func (n *Node) GetBalance(address string) (*big.Int, error) {
-	account, err := n.getAccountHandler(address)
+// GetBalance gets the balance for a specific address, as it was at the block selected by the provided options
+func (n *Node) GetBalance(address string, options state.BlockQueryOptions) (*big.Int, error) {
+	account, err := n.getAccountHandler(address, options)
 	if err != nil {
 		return nil, err
 	}
@@ -423,7 +453,7 @@ func (n *Node) GetBalance(address string) (*big.Int, error) {
 
 // GetUsername gets the username for a specific address
 func (n *Node) GetUsername(address string) (string, error) {
-	account, err := n.getAccountHandler(address)
+	account, err := n.getAccountHandler(address, state.BlockQueryOptions{})
 	if err != nil {
 		return "", err
 	}
@@ -437,14 +467,15 @@ func (n *Node) GetUsername(address string) (string, error) {
 	return string(username), nil
 }
 
-// GetValueForKey will return the value for a key from a given account
-func (n *Node) GetValueForKey(address string, key string) (string, error) {
+// GetValueForKey will return the value for a key from a given account, as it was at the block selected by the
+// provided options
+func (n *Node) GetValueForKey(address string, key string, options state.BlockQueryOptions) (string, error) {
 	keyBytes, err := hex.DecodeString(key)
 	if err != nil {
 		return "", fmt.Errorf("invalid key: %w", err)
 	}
 
-	account, err := n.getAccountHandler(address)
+	account, err := n.getAccountHandler(address, options)
 	if err != nil {
 		return "", err
 	}
@@ -464,7 +495,7 @@ func (n *Node) GetValueForKey(address string, key string) (string, error) {
 
 // GetESDTBalance returns the esdt balance and properties from a given account
 func (n *Node) GetESDTBalance(address string, tokenName string) (string, string, error) {
-	account, err := n.getAccountHandler(address)
+	account, err := n.getAccountHandler(address, state.BlockQueryOptions{})
 	if err != nil {
 		return "", "", err
 	}
@@ -489,9 +520,41 @@ func (n *Node) GetESDTBalance(address string, tokenName string) (string, string,
 	return esdtToken.Value.String(), hex.EncodeToString(esdtToken.Properties), nil
 }
 
+// GetESDTAllowance returns the value a spender is allowed to transfer on behalf of the owner for the given esdt token
+func (n *Node) GetESDTAllowance(owner string, spender string, tokenName string) (string, error) {
+	spenderBytes, err := n.addressPubkeyConverter.Decode(spender)
+	if err != nil {
+		return "", errors.New("invalid spender address, could not decode from: " + err.Error())
+	}
+
+	account, err := n.getAccountHandler(owner, state.BlockQueryOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	userAccount, ok := n.castAccountToUserAccount(account)
+	if !ok {
+		return "", ErrAccountNotFound
+	}
+
+	allowanceKey := core.ElrondProtectedKeyPrefix + core.ESDTAllowanceKeyIdentifier + tokenName + string(spenderBytes)
+	valueBytes, err := userAccount.DataTrieTracker().RetrieveValue([]byte(allowanceKey))
+	if err != nil || len(valueBytes) == 0 {
+		return "0", nil
+	}
+
+	allowance := &esdt.ESDigitalToken{}
+	err = n.internalMarshalizer.Unmarshal(allowance, valueBytes)
+	if err != nil {
+		return "", err
+	}
+
+	return allowance.Value.String(), nil
+}
+
 // GetAllESDTTokens returns the value of a key from a given account
 func (n *Node) GetAllESDTTokens(address string) ([]string, error) {
-	account, err := n.getAccountHandler(address)
+	account, err := n.getAccountHandler(address, state.BlockQueryOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -525,6 +588,11 @@ func (n *Node) GetAllESDTTokens(address string) ([]string, error) {
 			continue
 		}
 
+		esdtToken, errGet := n.getESDTTokenFromKey(userAccount, leaf.Key())
+		if errGet == nil && esdtToken.TokenMetaData != nil {
+			continue
+		}
+
 		tokenName := string(leaf.Key()[lenESDTPrefix:])
 		foundTokens = append(foundTokens, tokenName)
 	}
@@ -532,7 +600,134 @@ func (n *Node) GetAllESDTTokens(address string) ([]string, error) {
 	return foundTokens, nil
 }
 
-func (n *Node) getAccountHandler(address string) (state.AccountHandler, error) {
+// GetNFT returns the non-fungible or semi-fungible token with the given identifier and nonce held by an address
+func (n *Node) GetNFT(address string, tokenIdentifier string, nonce uint64) (*esdt.ApiNFTTokenData, error) {
+	account, err := n.getAccountHandler(address, state.BlockQueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := n.castAccountToUserAccount(account)
+	if !ok {
+		return nil, ErrAccountNotFound
+	}
+
+	nonceBytes := big.NewInt(0).SetUint64(nonce).Bytes()
+	tokenKey := core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + tokenIdentifier + string(nonceBytes)
+	esdtToken, err := n.getESDTTokenFromKey(userAccount, []byte(tokenKey))
+	if err != nil {
+		return nil, err
+	}
+	if esdtToken.TokenMetaData == nil {
+		return nil, ErrNFTNotFound
+	}
+
+	return n.createApiNFTTokenData(tokenIdentifier, esdtToken), nil
+}
+
+// GetAllNFTs returns all the non-fungible and semi-fungible tokens held by an address
+func (n *Node) GetAllNFTs(address string) ([]*esdt.ApiNFTTokenData, error) {
+	account, err := n.getAccountHandler(address, state.BlockQueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := n.castAccountToUserAccount(account)
+	if !ok {
+		return nil, ErrAccountNotFound
+	}
+
+	if check.IfNil(userAccount.DataTrie()) {
+		return []*esdt.ApiNFTTokenData{}, nil
+	}
+
+	esdtPrefix := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier)
+	lenESDTPrefix := len(esdtPrefix)
+
+	rootHash, err := userAccount.DataTrie().Root()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	chLeaves, err := userAccount.DataTrie().GetAllLeavesOnChannel(rootHash, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	foundTokens := make([]*esdt.ApiNFTTokenData, 0)
+	for leaf := range chLeaves {
+		if !bytes.HasPrefix(leaf.Key(), esdtPrefix) {
+			continue
+		}
+
+		esdtToken, errGet := n.getESDTTokenFromKey(userAccount, leaf.Key())
+		if errGet != nil || esdtToken.TokenMetaData == nil {
+			continue
+		}
+
+		// the key is composed of the esdt prefix, the token identifier and the nonce
+		lenNonce := len(big.NewInt(0).SetUint64(esdtToken.TokenMetaData.Nonce).Bytes())
+		lenKey := len(leaf.Key())
+		if lenKey < lenESDTPrefix+lenNonce {
+			continue
+		}
+
+		tokenIdentifier := string(leaf.Key()[lenESDTPrefix : lenKey-lenNonce])
+		foundTokens = append(foundTokens, n.createApiNFTTokenData(tokenIdentifier, esdtToken))
+	}
+
+	sort.Slice(foundTokens, func(i, j int) bool {
+		if foundTokens[i].TokenIdentifier == foundTokens[j].TokenIdentifier {
+			return foundTokens[i].Nonce < foundTokens[j].Nonce
+		}
+		return foundTokens[i].TokenIdentifier < foundTokens[j].TokenIdentifier
+	})
+
+	return foundTokens, nil
+}
+
+func (n *Node) getESDTTokenFromKey(userAccount state.UserAccountHandler, key []byte) (*esdt.ESDigitalToken, error) {
+	valueBytes, err := userAccount.DataTrieTracker().RetrieveValue(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(valueBytes) == 0 {
+		return nil, ErrNFTNotFound
+	}
+
+	esdtToken := &esdt.ESDigitalToken{}
+	err = n.internalMarshalizer.Unmarshal(esdtToken, valueBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return esdtToken, nil
+}
+
+func (n *Node) createApiNFTTokenData(tokenIdentifier string, esdtToken *esdt.ESDigitalToken) *esdt.ApiNFTTokenData {
+	tokenType := core.NonFungibleESDT
+	if core.ESDTType(esdtToken.Type) == core.SemiFungible {
+		tokenType = core.SemiFungibleESDT
+	}
+
+	metaData := esdtToken.TokenMetaData
+	return &esdt.ApiNFTTokenData{
+		TokenIdentifier: tokenIdentifier,
+		Nonce:           metaData.Nonce,
+		Type:            tokenType,
+		Balance:         esdtToken.Value.String(),
+		Properties:      hex.EncodeToString(esdtToken.Properties),
+		Name:            string(metaData.Name),
+		Creator:         n.addressPubkeyConverter.Encode(metaData.Creator),
+		Royalties:       metaData.Royalties,
+		Hash:            metaData.Hash,
+		URIs:            metaData.URIs,
+		Attributes:      metaData.Attributes,
+	}
+}
+
+func (n *Node) getAccountHandler(address string, options state.BlockQueryOptions) (state.AccountHandler, error) {
 	if check.IfNil(n.addressPubkeyConverter) || check.IfNil(n.accounts) {
 		return nil, errors.New("initialize AccountsAdapter and PubkeyConverter first")
 	}
@@ -541,7 +736,13 @@ func (n *Node) getAccountHandler(address string) (state.AccountHandler, error) {
 	if err != nil {
 		return nil, errors.New("invalid address, could not decode from: " + err.Error())
 	}
-	return n.accounts.GetExistingAccount(addr)
+
+	accounts, err := n.getAccountsAdapter(options)
+	if err != nil {
+		return nil, err
+	}
+
+	return accounts.GetExistingAccount(addr)
 }
 
 func (n *Node) castAccountToUserAccount(ah state.AccountHandler) (state.UserAccountHandler, bool) {
@@ -753,7 +954,9 @@ func (n *Node) createConsensusState(epoch uint32) (*spos.ConsensusState, error)
 	roundConsensus := spos.NewRoundConsensus(
 		eligibleNodesPubKeys,
 		n.consensusGroupSize,
-		string(selfId))
+		string(selfId),
+		n.keysHandler,
+	)
 
 	roundConsensus.ResetRoundState()
 
@@ -1107,8 +1310,9 @@ func (n *Node) CreateTransaction(
 	return tx, txHash, nil
 }
 
-// GetAccount will return account details for a given address
-func (n *Node) GetAccount(address string) (state.UserAccountHandler, error) {
+// GetAccount will return account details for a given address, as they were at the block selected by the provided
+// options
+func (n *Node) GetAccount(address string, options state.BlockQueryOptions) (state.UserAccountHandler, error) {
 	if check.IfNil(n.addressPubkeyConverter) {
 		return nil, ErrNilPubkeyConverter
 	}
@@ -1121,7 +1325,12 @@ func (n *Node) GetAccount(address string) (state.UserAccountHandler, error) {
 		return nil, err
 	}
 
-	accWrp, err := n.accounts.GetExistingAccount(addr)
+	accounts, err := n.getAccountsAdapter(options)
+	if err != nil {
+		return nil, err
+	}
+
+	accWrp, err := accounts.GetExistingAccount(addr)
 	if err != nil {
 		if err == state.ErrAccNotFound {
 			return state.NewUserAccount(addr)
@@ -1152,6 +1361,7 @@ func (n *Node) StartHeartbeat(hbConfig config.HeartbeatConfig, versionNumber str
 		ValidatorStatistics:      n.validatorStatistics,
 		PeerSignatureHandler:     n.peerSigHandler,
 		PrivKey:                  n.privKey,
+		KeysHandler:              n.keysHandler,
 		HardforkTrigger:          n.hardforkTrigger,
 		AntifloodHandler:         n.inputAntifloodHandler,
 		ValidatorPubkeyConverter: n.validatorPubkeyConverter,
@@ -4,7 +4,9 @@ import (
 	"encoding/hex"
 
 	apiBlock "github.com/ElrondNetwork/elrond-go/api/block"
+	apiHyperblock "github.com/ElrondNetwork/elrond-go/api/hyperblock"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/node/blockAPI"
 )
 
@@ -26,6 +28,58 @@ func (n *Node) GetBlockByNonce(nonce uint64, withTxs bool) (*apiBlock.APIBlock,
 	return apiBlockProcessor.GetBlockByNonce(nonce, withTxs)
 }
 
+// GetHyperblockByHash returns the hyperblock of the meta block with the given hash
+func (n *Node) GetHyperblockByHash(hash string) (*apiHyperblock.APIHyperblock, error) {
+	decodedHash, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	apiHyperblockProcessor, err := n.createAPIHyperblockProcessor()
+	if err != nil {
+		return nil, err
+	}
+
+	return apiHyperblockProcessor.GetHyperblockByHash(decodedHash)
+}
+
+// GetHyperblockByNonce returns the hyperblock of the meta block with the given nonce
+func (n *Node) GetHyperblockByNonce(nonce uint64) (*apiHyperblock.APIHyperblock, error) {
+	apiHyperblockProcessor, err := n.createAPIHyperblockProcessor()
+	if err != nil {
+		return nil, err
+	}
+
+	return apiHyperblockProcessor.GetHyperblockByNonce(nonce)
+}
+
+func (n *Node) createAPIHyperblockProcessor() (blockAPI.APIHyperblockHandler, error) {
+	if n.shardCoordinator.SelfId() != core.MetachainShardId || !n.historyRepository.IsEnabled() {
+		return nil, ErrHyperblocksNotSupported
+	}
+	if check.IfNil(n.dataPool) {
+		return nil, ErrNilDataPool
+	}
+	if check.IfNil(n.requestHandler) {
+		return nil, ErrNilRequestHandler
+	}
+
+	return blockAPI.NewMetaApiBlockProcessor(
+		&blockAPI.APIBlockProcessorArg{
+			SelfShardID:              n.shardCoordinator.SelfId(),
+			Store:                    n.store,
+			Marshalizer:              n.internalMarshalizer,
+			Uint64ByteSliceConverter: n.uint64ByteSliceConverter,
+			HistoryRepo:              n.historyRepository,
+			UnmarshalTx:              n.unmarshalTransaction,
+			DataPool:                 n.dataPool,
+			RequestHandler:           n.requestHandler,
+			RequestTimeout:           n.hyperblockDataRequestTimeout,
+			MaxRequestedHashes:       n.hyperblockMaxRequestedHashes,
+		},
+	), nil
+}
+
 func (n *Node) createAPIBlockProcessor() blockAPI.APIBlockHandler {
 	if n.shardCoordinator.SelfId() != core.MetachainShardId {
 		return blockAPI.NewShardApiBlockProcessor(
@@ -3,17 +3,27 @@ package node_test
 import (
 	"encoding/hex"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
 	"testing"
+	"time"
 
 	apiBlock "github.com/ElrondNetwork/elrond-go/api/block"
+	apiHyperblock "github.com/ElrondNetwork/elrond-go/api/hyperblock"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/blockAPI"
 	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGetBlockByHash_InvalidShardShouldErr(t *testing.T) {
@@ -263,3 +273,378 @@ func TestGetBlockByNonceFromNormalNode(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, expectedBlock, blk)
 }
+
+func TestGetHyperblockByNonce_NotMetachainShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithHistoryRepository(&testscommon.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+		}),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{SelfShardId: 0}),
+	)
+
+	hyperblock, err := n.GetHyperblockByNonce(1)
+	assert.Equal(t, node.ErrHyperblocksNotSupported, err)
+	assert.Nil(t, hyperblock)
+}
+
+func TestGetHyperblockByHash_DbLookupExtensionsDisabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithHistoryRepository(&testscommon.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return false
+			},
+		}),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{SelfShardId: core.MetachainShardId}),
+	)
+
+	hyperblock, err := n.GetHyperblockByHash("aa")
+	assert.Equal(t, node.ErrHyperblocksNotSupported, err)
+	assert.Nil(t, hyperblock)
+}
+
+func createMetachainNodeForHyperblocks(
+	metaBlockHash []byte,
+	storers map[dataRetriever.UnitType]storage.Storer,
+	dataPool dataRetriever.PoolsHolder,
+	requestHandler process.RequestHandler,
+) *node.Node {
+	n, _ := node.NewNode(
+		node.WithUint64ByteSliceConverter(mock.NewNonceHashConverterMock()),
+		node.WithInternalMarshalizer(&mock.MarshalizerFake{}, 90),
+		node.WithAddressPubkeyConverter(&mock.PubkeyConverterMock{}),
+		node.WithHistoryRepository(&testscommon.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+			GetEpochByHashCalled: func(hash []byte) (uint32, error) {
+				return 2, nil
+			},
+		}),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{SelfShardId: core.MetachainShardId}),
+		node.WithDataStore(&mock.ChainStorerMock{
+			GetCalled: func(unitType dataRetriever.UnitType, key []byte) ([]byte, error) {
+				return metaBlockHash, nil
+			},
+			GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+				storer, ok := storers[unitType]
+				if !ok {
+					return mock.NewStorerMock()
+				}
+				return storer
+			},
+		}),
+		node.WithDataPool(dataPool),
+		node.WithRequestHandler(requestHandler),
+	)
+	n.SetHyperblockDataRequestTimeout(100 * time.Millisecond)
+
+	return n
+}
+
+func putMarshalized(storer storage.Storer, key []byte, obj interface{}) {
+	objBytes, _ := (&mock.MarshalizerFake{}).Marshal(obj)
+	_ = storer.Put(key, objBytes)
+}
+
+func TestGetHyperblockByNonce_ShouldReturnAllTheTransactionsOnce(t *testing.T) {
+	t.Parallel()
+
+	metaBlockHash := []byte("meta block hash")
+	intraShardMbHash := []byte("intra shard mb")
+	crossShardMbHash := []byte("cross shard mb")
+	toMetaMbHash := []byte("to meta mb")
+	rewardsMbHash := []byte("rewards mb")
+	metaBlock := &block.MetaBlock{
+		Nonce: 10,
+		Round: 11,
+		Epoch: 2,
+		ShardInfo: []block.ShardData{
+			{
+				HeaderHash: []byte("shard 0 hash"),
+				Nonce:      7,
+				Round:      10,
+				ShardID:    0,
+				ShardMiniBlockHeaders: []block.MiniBlockHeader{
+					{Hash: intraShardMbHash, SenderShardID: 0, ReceiverShardID: 0, Type: block.TxBlock},
+					{Hash: crossShardMbHash, SenderShardID: 0, ReceiverShardID: 1, Type: block.TxBlock},
+					{Hash: toMetaMbHash, SenderShardID: 0, ReceiverShardID: core.MetachainShardId, Type: block.TxBlock},
+				},
+			},
+			{
+				HeaderHash: []byte("shard 1 hash"),
+				Nonce:      8,
+				Round:      10,
+				ShardID:    1,
+				ShardMiniBlockHeaders: []block.MiniBlockHeader{
+					{Hash: crossShardMbHash, SenderShardID: 0, ReceiverShardID: 1, Type: block.TxBlock},
+					{Hash: rewardsMbHash, SenderShardID: core.MetachainShardId, ReceiverShardID: 1, Type: block.RewardsBlock},
+				},
+			},
+		},
+		MiniBlockHeaders: []block.MiniBlockHeader{
+			{Hash: toMetaMbHash, SenderShardID: 0, ReceiverShardID: core.MetachainShardId, Type: block.TxBlock},
+			{Hash: rewardsMbHash, SenderShardID: core.MetachainShardId, ReceiverShardID: 1, Type: block.RewardsBlock},
+		},
+	}
+
+	// the metachain stores only the miniblocks, and the transactions, sent from or to itself
+	storers := map[dataRetriever.UnitType]storage.Storer{
+		dataRetriever.MetaBlockUnit:         mock.NewStorerMock(),
+		dataRetriever.MiniBlockUnit:         mock.NewStorerMock(),
+		dataRetriever.TransactionUnit:       mock.NewStorerMock(),
+		dataRetriever.RewardTransactionUnit: mock.NewStorerMock(),
+	}
+	putMarshalized(storers[dataRetriever.MetaBlockUnit], metaBlockHash, metaBlock)
+	putMarshalized(storers[dataRetriever.MiniBlockUnit], toMetaMbHash, &block.MiniBlock{TxHashes: [][]byte{[]byte("tx to meta")}, SenderShardID: 0, ReceiverShardID: core.MetachainShardId, Type: block.TxBlock})
+	putMarshalized(storers[dataRetriever.MiniBlockUnit], rewardsMbHash, &block.MiniBlock{TxHashes: [][]byte{[]byte("reward tx")}, SenderShardID: core.MetachainShardId, ReceiverShardID: 1, Type: block.RewardsBlock})
+	putMarshalized(storers[dataRetriever.TransactionUnit], []byte("tx to meta"), &transaction.Transaction{Nonce: 1})
+	putMarshalized(storers[dataRetriever.RewardTransactionUnit], []byte("reward tx"), &rewardTx.RewardTx{Round: 10})
+
+	// the other ones are fetched from the shards which executed them
+	shardMiniblocks := map[string]*block.MiniBlock{
+		string(intraShardMbHash): {TxHashes: [][]byte{[]byte("intra shard tx")}, SenderShardID: 0, ReceiverShardID: 0, Type: block.TxBlock},
+		string(crossShardMbHash): {TxHashes: [][]byte{[]byte("cross shard tx")}, SenderShardID: 0, ReceiverShardID: 1, Type: block.TxBlock},
+	}
+	shardTxs := map[string]*transaction.Transaction{
+		"intra shard tx": {Nonce: 2},
+		"cross shard tx": {Nonce: 3},
+	}
+	dataPool := testscommon.NewPoolsHolderMock()
+	requestHandler := &mock.RequestHandlerStub{
+		RequestMiniBlocksHandlerCalled: func(destShardID uint32, miniblocksHashes [][]byte) {
+			for _, hash := range miniblocksHashes {
+				mb := shardMiniblocks[string(hash)]
+				assert.Equal(t, mb.ReceiverShardID, destShardID)
+				dataPool.MiniBlocks().Put(hash, mb, 0)
+			}
+		},
+		RequestTransactionHandlerCalled: func(destShardID uint32, txHashes [][]byte) {
+			for _, hash := range txHashes {
+				cacheID := process.ShardCacherIdentifier(0, destShardID)
+				dataPool.Transactions().AddData(hash, shardTxs[string(hash)], 0, cacheID)
+			}
+		},
+	}
+
+	n := createMetachainNodeForHyperblocks(metaBlockHash, storers, dataPool, requestHandler)
+
+	hyperblock, err := n.GetHyperblockByNonce(10)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(10), hyperblock.Nonce)
+	assert.Equal(t, hex.EncodeToString(metaBlockHash), hyperblock.Hash)
+	assert.Equal(t, []*apiHyperblock.APINotarizedShardBlock{
+		{Hash: hex.EncodeToString([]byte("shard 0 hash")), Nonce: 7, Round: 10, Shard: 0},
+		{Hash: hex.EncodeToString([]byte("shard 1 hash")), Nonce: 8, Round: 10, Shard: 1},
+	}, hyperblock.ShardBlocks)
+
+	require.Equal(t, uint32(4), hyperblock.NumTxs)
+	require.Equal(t, 4, len(hyperblock.Transactions))
+	expectedTxs := []struct {
+		hash   string
+		mbHash []byte
+		nonce  uint64
+	}{
+		{hash: "intra shard tx", mbHash: intraShardMbHash, nonce: 2},
+		{hash: "cross shard tx", mbHash: crossShardMbHash, nonce: 3},
+		{hash: "reward tx", mbHash: rewardsMbHash},
+		{hash: "tx to meta", mbHash: toMetaMbHash, nonce: 1},
+	}
+	for i, expectedTx := range expectedTxs {
+		assert.Equal(t, hex.EncodeToString([]byte(expectedTx.hash)), hyperblock.Transactions[i].Hash)
+		assert.Equal(t, hex.EncodeToString(expectedTx.mbHash), hyperblock.Transactions[i].MiniBlockHash)
+		assert.Equal(t, expectedTx.nonce, hyperblock.Transactions[i].Nonce)
+		assert.Equal(t, transaction.TxStatusSuccess, hyperblock.Transactions[i].Status)
+	}
+}
+
+func TestGetHyperblockByHash_MissingMetachainMiniblockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	metaBlockHash := []byte("meta block hash")
+	metaBlock := &block.MetaBlock{
+		MiniBlockHeaders: []block.MiniBlockHeader{
+			{Hash: []byte("missing mb"), SenderShardID: 0, ReceiverShardID: core.MetachainShardId, Type: block.TxBlock},
+		},
+	}
+	storers := map[dataRetriever.UnitType]storage.Storer{
+		dataRetriever.MetaBlockUnit: mock.NewStorerMock(),
+	}
+	putMarshalized(storers[dataRetriever.MetaBlockUnit], metaBlockHash, metaBlock)
+	requestHandler := &mock.RequestHandlerStub{
+		RequestMiniBlocksHandlerCalled: func(destShardID uint32, miniblocksHashes [][]byte) {
+			assert.Fail(t, "the miniblocks of the metachain should have not been requested")
+		},
+	}
+
+	n := createMetachainNodeForHyperblocks(metaBlockHash, storers, testscommon.NewPoolsHolderMock(), requestHandler)
+
+	hyperblock, err := n.GetHyperblockByHash(hex.EncodeToString(metaBlockHash))
+	assert.Nil(t, hyperblock)
+	assert.True(t, errors.Is(err, blockAPI.ErrMiniblockNotFound))
+}
+
+func TestGetHyperblockByHash_TransactionNotFetchedFromShardShouldErr(t *testing.T) {
+	t.Parallel()
+
+	metaBlockHash := []byte("meta block hash")
+	intraShardMbHash := []byte("intra shard mb")
+	metaBlock := &block.MetaBlock{
+		ShardInfo: []block.ShardData{
+			{
+				ShardID: 0,
+				ShardMiniBlockHeaders: []block.MiniBlockHeader{
+					{Hash: intraShardMbHash, SenderShardID: 0, ReceiverShardID: 0, Type: block.TxBlock},
+				},
+			},
+		},
+	}
+	storers := map[dataRetriever.UnitType]storage.Storer{
+		dataRetriever.MetaBlockUnit: mock.NewStorerMock(),
+		dataRetriever.MiniBlockUnit: mock.NewStorerMock(),
+	}
+	putMarshalized(storers[dataRetriever.MetaBlockUnit], metaBlockHash, metaBlock)
+	putMarshalized(storers[dataRetriever.MiniBlockUnit], intraShardMbHash, &block.MiniBlock{TxHashes: [][]byte{[]byte("missing tx")}, Type: block.TxBlock})
+	numTxRequests := 0
+	requestHandler := &mock.RequestHandlerStub{
+		RequestTransactionHandlerCalled: func(destShardID uint32, txHashes [][]byte) {
+			assert.Equal(t, uint32(0), destShardID)
+			assert.Equal(t, [][]byte{[]byte("missing tx")}, txHashes)
+			numTxRequests++
+		},
+	}
+
+	n := createMetachainNodeForHyperblocks(metaBlockHash, storers, testscommon.NewPoolsHolderMock(), requestHandler)
+
+	hyperblock, err := n.GetHyperblockByHash(hex.EncodeToString(metaBlockHash))
+	assert.Nil(t, hyperblock)
+	assert.True(t, errors.Is(err, blockAPI.ErrTransactionNotFound))
+	assert.Equal(t, 1, numTxRequests)
+}
+
+func TestGetHyperblockByHash_MissingTxsOfAShardShouldBeRequestedTogether(t *testing.T) {
+	t.Parallel()
+
+	metaBlockHash := []byte("meta block hash")
+	metaBlock := &block.MetaBlock{
+		ShardInfo: []block.ShardData{
+			{
+				ShardID: 0,
+				ShardMiniBlockHeaders: []block.MiniBlockHeader{
+					{Hash: []byte("mb A"), SenderShardID: 0, ReceiverShardID: 0, Type: block.TxBlock},
+					{Hash: []byte("mb B"), SenderShardID: 0, ReceiverShardID: 0, Type: block.TxBlock},
+				},
+			},
+			{
+				ShardID: 1,
+				ShardMiniBlockHeaders: []block.MiniBlockHeader{
+					{Hash: []byte("mb C"), SenderShardID: 1, ReceiverShardID: 1, Type: block.TxBlock},
+				},
+			},
+		},
+	}
+	storers := map[dataRetriever.UnitType]storage.Storer{
+		dataRetriever.MetaBlockUnit: mock.NewStorerMock(),
+		dataRetriever.MiniBlockUnit: mock.NewStorerMock(),
+	}
+	putMarshalized(storers[dataRetriever.MetaBlockUnit], metaBlockHash, metaBlock)
+	putMarshalized(storers[dataRetriever.MiniBlockUnit], []byte("mb A"), &block.MiniBlock{TxHashes: [][]byte{[]byte("tx A")}, Type: block.TxBlock})
+	putMarshalized(storers[dataRetriever.MiniBlockUnit], []byte("mb B"), &block.MiniBlock{TxHashes: [][]byte{[]byte("tx B")}, Type: block.TxBlock})
+	putMarshalized(storers[dataRetriever.MiniBlockUnit], []byte("mb C"), &block.MiniBlock{TxHashes: [][]byte{[]byte("tx C")}, SenderShardID: 1, ReceiverShardID: 1, Type: block.TxBlock})
+
+	dataPool := testscommon.NewPoolsHolderMock()
+	mutRequests := sync.Mutex{}
+	requestedHashes := make(map[uint32][][][]byte)
+	requestHandler := &mock.RequestHandlerStub{
+		RequestTransactionHandlerCalled: func(destShardID uint32, txHashes [][]byte) {
+			mutRequests.Lock()
+			requestedHashes[destShardID] = append(requestedHashes[destShardID], txHashes)
+			mutRequests.Unlock()
+
+			for _, hash := range txHashes {
+				cacheID := process.ShardCacherIdentifier(destShardID, destShardID)
+				dataPool.Transactions().AddData(hash, &transaction.Transaction{Nonce: 1}, 0, cacheID)
+			}
+		},
+	}
+
+	n := createMetachainNodeForHyperblocks(metaBlockHash, storers, dataPool, requestHandler)
+
+	hyperblock, err := n.GetHyperblockByHash(hex.EncodeToString(metaBlockHash))
+	require.Nil(t, err)
+	assert.Equal(t, uint32(3), hyperblock.NumTxs)
+	assert.Equal(t, map[uint32][][][]byte{
+		0: {{[]byte("tx A"), []byte("tx B")}},
+		1: {{[]byte("tx C")}},
+	}, requestedHashes)
+}
+
+func TestGetHyperblockByHash_TooManyMissingHashesShouldNotRequest(t *testing.T) {
+	t.Parallel()
+
+	metaBlockHash := []byte("meta block hash")
+	metaBlock := &block.MetaBlock{
+		ShardInfo: []block.ShardData{
+			{
+				ShardID: 0,
+				ShardMiniBlockHeaders: []block.MiniBlockHeader{
+					{Hash: []byte("mb A"), SenderShardID: 0, ReceiverShardID: 0, Type: block.TxBlock},
+					{Hash: []byte("mb B"), SenderShardID: 0, ReceiverShardID: 0, Type: block.TxBlock},
+				},
+			},
+		},
+	}
+	storers := map[dataRetriever.UnitType]storage.Storer{
+		dataRetriever.MetaBlockUnit: mock.NewStorerMock(),
+	}
+	putMarshalized(storers[dataRetriever.MetaBlockUnit], metaBlockHash, metaBlock)
+	requestHandler := &mock.RequestHandlerStub{
+		RequestMiniBlocksHandlerCalled: func(destShardID uint32, miniblocksHashes [][]byte) {
+			assert.Fail(t, "the miniblocks should have not been requested")
+		},
+	}
+
+	n := createMetachainNodeForHyperblocks(metaBlockHash, storers, testscommon.NewPoolsHolderMock(), requestHandler)
+	n.SetHyperblockMaxRequestedHashes(1)
+
+	hyperblock, err := n.GetHyperblockByHash(hex.EncodeToString(metaBlockHash))
+	assert.Nil(t, hyperblock)
+	assert.True(t, errors.Is(err, blockAPI.ErrTooManyMissingHashes))
+}
+
+func TestGetHyperblockByHash_ShardsShouldBeRequestedInParallelUntilASingleDeadline(t *testing.T) {
+	t.Parallel()
+
+	metaBlockHash := []byte("meta block hash")
+	metaBlock := &block.MetaBlock{}
+	numShards := uint32(5)
+	for shardID := uint32(0); shardID < numShards; shardID++ {
+		mbHash := []byte(fmt.Sprintf("mb of shard %d", shardID))
+		metaBlock.ShardInfo = append(metaBlock.ShardInfo, block.ShardData{
+			ShardID: shardID,
+			ShardMiniBlockHeaders: []block.MiniBlockHeader{
+				{Hash: mbHash, SenderShardID: shardID, ReceiverShardID: shardID, Type: block.TxBlock},
+			},
+		})
+	}
+	storers := map[dataRetriever.UnitType]storage.Storer{
+		dataRetriever.MetaBlockUnit: mock.NewStorerMock(),
+	}
+	putMarshalized(storers[dataRetriever.MetaBlockUnit], metaBlockHash, metaBlock)
+
+	// no shard answers, so every request lasts until the deadline
+	n := createMetachainNodeForHyperblocks(metaBlockHash, storers, testscommon.NewPoolsHolderMock(), &mock.RequestHandlerStub{})
+	timeout := 100 * time.Millisecond
+	n.SetHyperblockDataRequestTimeout(timeout)
+
+	start := time.Now()
+	hyperblock, err := n.GetHyperblockByHash(hex.EncodeToString(metaBlockHash))
+	assert.Nil(t, hyperblock)
+	assert.True(t, errors.Is(err, blockAPI.ErrMiniblockNotFound))
+	assert.True(t, time.Since(start) < time.Duration(numShards)*timeout)
+}
@@ -0,0 +1,69 @@
+package node
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// GetStateRootHashAtBlock returns the state root hash of the block selected by the provided options
+func (n *Node) GetStateRootHashAtBlock(options state.BlockQueryOptions) ([]byte, error) {
+	header, err := n.getBlockHeader(options)
+	if err != nil {
+		return nil, err
+	}
+
+	return header.GetRootHash(), nil
+}
+
+func (n *Node) getBlockHeader(options state.BlockQueryOptions) (data.HeaderHandler, error) {
+	if options.HasBlockNonce && len(options.BlockHash) > 0 {
+		return nil, ErrBlockNonceAndHashProvided
+	}
+
+	selfShardID := n.shardCoordinator.SelfId()
+	if options.HasBlockNonce {
+		header, _, err := process.GetHeaderFromStorageWithNonce(
+			options.BlockNonce,
+			selfShardID,
+			n.store,
+			n.uint64ByteSliceConverter,
+			n.internalMarshalizer,
+		)
+
+		return header, err
+	}
+
+	if selfShardID == core.MetachainShardId {
+		return process.GetMetaHeaderFromStorage(options.BlockHash, n.internalMarshalizer, n.store)
+	}
+
+	return process.GetShardHeaderFromStorage(options.BlockHash, n.internalMarshalizer, n.store)
+}
+
+// getAccountsAdapter returns the accounts adapter over the state of the block selected by the provided options
+func (n *Node) getAccountsAdapter(options state.BlockQueryOptions) (state.AccountsAdapter, error) {
+	if options.IsCurrentState() {
+		return n.accounts, nil
+	}
+	if check.IfNil(n.accountsRecreator) {
+		return nil, ErrNilAccountsRecreator
+	}
+
+	rootHash, err := n.GetStateRootHashAtBlock(options)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := n.accountsRecreator.RecreateAccountsAdapter(rootHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w, root hash %s: %s", ErrHistoricalStateNotAvailable, hex.EncodeToString(rootHash), err.Error())
+	}
+
+	return accounts, nil
+}
@@ -0,0 +1,110 @@
+package node_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createNodeWithHistoricalState(
+	t *testing.T,
+	header *block.Header,
+	headerHash []byte,
+	accountsRecreator state.AccountsAdapterRecreator,
+) *node.Node {
+	marshalizer := getMarshalizer()
+	uint64Converter := mock.NewNonceHashConverterMock()
+
+	headersStorer := mock.NewStorerMock()
+	noncesStorer := mock.NewStorerMock()
+	headerBytes, _ := marshalizer.Marshal(header)
+	_ = headersStorer.Put(headerHash, headerBytes)
+	_ = noncesStorer.Put(uint64Converter.ToByteSlice(header.Nonce), headerHash)
+
+	store := dataRetriever.NewChainStorer()
+	store.AddStorer(dataRetriever.BlockHeaderUnit, headersStorer)
+	store.AddStorer(dataRetriever.ShardHdrNonceHashDataUnit, noncesStorer)
+
+	n, err := node.NewNode(
+		node.WithInternalMarshalizer(marshalizer, testSizeCheckDelta),
+		node.WithHasher(getHasher()),
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithAccountsAdapter(getAccAdapter(big.NewInt(100))),
+		node.WithAccountsRecreator(accountsRecreator),
+		node.WithDataStore(store),
+		node.WithUint64ByteSliceConverter(uint64Converter),
+		node.WithShardCoordinator(mock.NewOneShardCoordinatorMock()),
+	)
+	require.Nil(t, err)
+
+	return n
+}
+
+func TestNode_GetStateRootHashAtBlock(t *testing.T) {
+	t.Parallel()
+
+	header := &block.Header{Nonce: 7, RootHash: []byte("root hash")}
+	headerHash := []byte("header hash")
+	n := createNodeWithHistoricalState(t, header, headerHash, &mock.AccountsRecreatorStub{})
+
+	rootHash, err := n.GetStateRootHashAtBlock(state.BlockQueryOptions{BlockNonce: 7, HasBlockNonce: true})
+	assert.Nil(t, err)
+	assert.Equal(t, header.RootHash, rootHash)
+
+	rootHash, err = n.GetStateRootHashAtBlock(state.BlockQueryOptions{BlockHash: headerHash})
+	assert.Nil(t, err)
+	assert.Equal(t, header.RootHash, rootHash)
+
+	rootHash, err = n.GetStateRootHashAtBlock(state.BlockQueryOptions{BlockNonce: 8, HasBlockNonce: true})
+	assert.NotNil(t, err)
+	assert.Nil(t, rootHash)
+
+	rootHash, err = n.GetStateRootHashAtBlock(state.BlockQueryOptions{BlockNonce: 7, HasBlockNonce: true, BlockHash: headerHash})
+	assert.Equal(t, node.ErrBlockNonceAndHashProvided, err)
+	assert.Nil(t, rootHash)
+}
+
+func TestNode_GetBalanceAtBlockShouldUseRecreatedAccounts(t *testing.T) {
+	t.Parallel()
+
+	header := &block.Header{Nonce: 7, RootHash: []byte("root hash")}
+	recreator := &mock.AccountsRecreatorStub{
+		RecreateAccountsAdapterCalled: func(rootHash []byte) (state.AccountsAdapter, error) {
+			assert.Equal(t, header.RootHash, rootHash)
+			return getAccAdapter(big.NewInt(42)), nil
+		},
+	}
+	n := createNodeWithHistoricalState(t, header, []byte("header hash"), recreator)
+
+	balance, err := n.GetBalance(createDummyHexAddress(64), state.BlockQueryOptions{BlockNonce: 7, HasBlockNonce: true})
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(42), balance)
+
+	balance, err = n.GetBalance(createDummyHexAddress(64), state.BlockQueryOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(100), balance)
+}
+
+func TestNode_GetAccountAtBlockPrunedStateShouldErr(t *testing.T) {
+	t.Parallel()
+
+	header := &block.Header{Nonce: 7, RootHash: []byte("root hash")}
+	recreator := &mock.AccountsRecreatorStub{
+		RecreateAccountsAdapterCalled: func(rootHash []byte) (state.AccountsAdapter, error) {
+			return nil, state.NewErrMissingTrie(rootHash)
+		},
+	}
+	n := createNodeWithHistoricalState(t, header, []byte("header hash"), recreator)
+
+	account, err := n.GetAccount(createDummyHexAddress(64), state.BlockQueryOptions{BlockNonce: 7, HasBlockNonce: true})
+	assert.Nil(t, account)
+	assert.True(t, errors.Is(err, node.ErrHistoricalStateNotAvailable))
+}
@@ -0,0 +1,79 @@
+package node
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
+// GetLogEvents gets a page of the smart contract log events matching the provided query, ordered from the newest to
+// the oldest one. The cursor from the response should be provided in order to fetch the next page.
+func (n *Node) GetLogEvents(query *transaction.ApiLogEventsQuery) (*transaction.ApiLogEvents, error) {
+	if !n.historyRepository.IsEnabled() {
+		return nil, ErrDbLookupExtensionsNotEnabled
+	}
+	if query.Limit <= 0 || query.Limit > maxLogEventsPageSize {
+		return nil, fmt.Errorf("%w, should be between 1 and %d", dblookupext.ErrInvalidLimit, maxLogEventsPageSize)
+	}
+	if query.FromNonce > query.ToNonce {
+		return nil, ErrInvalidNoncesRange
+	}
+
+	repositoryQuery := &dblookupext.LogEventsQuery{
+		Identifier: []byte(query.Identifier),
+		FromNonce:  query.FromNonce,
+		ToNonce:    query.ToNonce,
+	}
+
+	var err error
+	if len(query.Address) > 0 {
+		repositoryQuery.Address, err = n.addressPubkeyConverter.Decode(query.Address)
+		if err != nil {
+			return nil, err
+		}
+	}
+	repositoryQuery.Topic0, err = hex.DecodeString(query.Topic0)
+	if err != nil {
+		return nil, err
+	}
+	cursorBytes, err := hex.DecodeString(query.Cursor)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", dblookupext.ErrInvalidCursor, err.Error())
+	}
+
+	page, err := n.historyRepository.GetLogEvents(repositoryQuery, cursorBytes, query.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &transaction.ApiLogEvents{
+		Events:     make([]*transaction.ApiLogEvent, 0, len(page.Events)),
+		NextCursor: hex.EncodeToString(page.NextCursor),
+	}
+	for _, event := range page.Events {
+		result.Events = append(result.Events, n.convertIndexedLogEvent(event))
+	}
+
+	return result, nil
+}
+
+func (n *Node) convertIndexedLogEvent(event *dblookupext.IndexedLogEvent) *transaction.ApiLogEvent {
+	topics := make([]string, 0, len(event.Topics))
+	for _, topic := range event.Topics {
+		topics = append(topics, hex.EncodeToString(topic))
+	}
+
+	return &transaction.ApiLogEvent{
+		TxHash:     hex.EncodeToString(event.TxHash),
+		EventIndex: event.EventIndex,
+		Address:    n.addressPubkeyConverter.Encode(event.Address),
+		Identifier: string(event.Identifier),
+		Topics:     topics,
+		Data:       hex.EncodeToString(event.Data),
+		BlockNonce: event.HeaderNonce,
+		BlockHash:  hex.EncodeToString(event.HeaderHash),
+		Epoch:      event.Epoch,
+	}
+}
@@ -0,0 +1,112 @@
+package node
+
+import (
+	"encoding/hex"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNode_GetLogEventsInvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	query := &transaction.ApiLogEventsQuery{
+		Address: hex.EncodeToString([]byte("dex")),
+		ToNonce: math.MaxUint64,
+		Limit:   10,
+	}
+
+	n, _, _, _ := createNode(t, 42, false)
+	result, err := n.GetLogEvents(query)
+	require.Nil(t, result)
+	require.Equal(t, ErrDbLookupExtensionsNotEnabled, err)
+
+	n, _, _, _ = createNode(t, 42, true)
+	invalidQuery := *query
+	invalidQuery.Limit = maxLogEventsPageSize + 1
+	result, err = n.GetLogEvents(&invalidQuery)
+	require.Nil(t, result)
+	require.True(t, errors.Is(err, dblookupext.ErrInvalidLimit))
+
+	invalidQuery = *query
+	invalidQuery.FromNonce = 10
+	invalidQuery.ToNonce = 9
+	result, err = n.GetLogEvents(&invalidQuery)
+	require.Nil(t, result)
+	require.Equal(t, ErrInvalidNoncesRange, err)
+
+	invalidQuery = *query
+	invalidQuery.Topic0 = "zz"
+	result, err = n.GetLogEvents(&invalidQuery)
+	require.Nil(t, result)
+	require.NotNil(t, err)
+
+	invalidQuery = *query
+	invalidQuery.Cursor = "zz"
+	result, err = n.GetLogEvents(&invalidQuery)
+	require.Nil(t, result)
+	require.True(t, errors.Is(err, dblookupext.ErrInvalidCursor))
+}
+
+func TestNode_GetLogEvents(t *testing.T) {
+	t.Parallel()
+
+	n, _, _, historyRepo := createNode(t, 42, true)
+	historyRepo.GetLogEventsCalled = func(query *dblookupext.LogEventsQuery, cursor []byte, limit int) (*dblookupext.LogEventsPage, error) {
+		require.Equal(t, &dblookupext.LogEventsQuery{
+			Address:    []byte("dex"),
+			Identifier: []byte("swap"),
+			Topic0:     []byte("alice"),
+			FromNonce:  5,
+			ToNonce:    50,
+		}, query)
+		require.Equal(t, []byte("cursor"), cursor)
+		require.Equal(t, 10, limit)
+
+		return &dblookupext.LogEventsPage{
+			Events: []*dblookupext.IndexedLogEvent{
+				{
+					TxHash:      []byte("tx"),
+					EventIndex:  1,
+					Address:     []byte("dex"),
+					Identifier:  []byte("swap"),
+					Topics:      [][]byte{[]byte("alice")},
+					Data:        []byte("data"),
+					HeaderHash:  []byte("header"),
+					HeaderNonce: 42,
+					Epoch:       3,
+				},
+			},
+			NextCursor: []byte("next"),
+		}, nil
+	}
+
+	result, err := n.GetLogEvents(&transaction.ApiLogEventsQuery{
+		Address:    hex.EncodeToString([]byte("dex")),
+		Identifier: "swap",
+		Topic0:     hex.EncodeToString([]byte("alice")),
+		FromNonce:  5,
+		ToNonce:    50,
+		Cursor:     hex.EncodeToString([]byte("cursor")),
+		Limit:      10,
+	})
+	require.Nil(t, err)
+	require.Equal(t, hex.EncodeToString([]byte("next")), result.NextCursor)
+	require.Equal(t, []*transaction.ApiLogEvent{
+		{
+			TxHash:     hex.EncodeToString([]byte("tx")),
+			EventIndex: 1,
+			Address:    hex.EncodeToString([]byte("dex")),
+			Identifier: "swap",
+			Topics:     []string{hex.EncodeToString([]byte("alice"))},
+			Data:       hex.EncodeToString([]byte("data")),
+			BlockNonce: 42,
+			BlockHash:  hex.EncodeToString([]byte("header")),
+			Epoch:      3,
+		},
+	}, result.Events)
+}
@@ -0,0 +1,126 @@
+package node
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+)
+
+// GetAccountProof returns the Merkle proof of the account found at the given address. If the account does not exist,
+// the returned proof shows its absence from the accounts trie.
+func (n *Node) GetAccountProof(address string, options state.BlockQueryOptions) (*state.TrieProof, error) {
+	addr, _, proofProvider, err := n.getAccountsProofProvider(address, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return proofProvider.GetAccountProof(addr)
+}
+
+// GetKeyProof returns the Merkle proof of the account found at the given address, together with the Merkle proof
+// of the given hex encoded key in the account's data trie. The data trie leaf value holds the stored value followed
+// by the key and the account address. If the account does not exist, the returned account proof shows its absence
+// from the accounts trie and the data trie proof is empty.
+func (n *Node) GetKeyProof(address string, key string, options state.BlockQueryOptions) (*state.TrieProof, *state.TrieProof, error) {
+	keyBytes, err := hex.DecodeString(key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid key: %w", err)
+	}
+
+	addr, accounts, proofProvider, err := n.getAccountsProofProvider(address, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	accountProof, err := proofProvider.GetAccountProof(addr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	account, err := accounts.GetExistingAccount(addr)
+	if err == state.ErrAccNotFound {
+		return accountProof, &state.TrieProof{Proof: make([][]byte, 0)}, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	userAccount, ok := n.castAccountToUserAccount(account)
+	if !ok {
+		return nil, nil, ErrAccountNotFound
+	}
+
+	dataTrieProof := &state.TrieProof{
+		RootHash: userAccount.GetRootHash(),
+		Proof:    make([][]byte, 0),
+	}
+	if check.IfNil(userAccount.DataTrie()) {
+		return accountProof, dataTrieProof, nil
+	}
+
+	dataTrieProof.Proof, err = userAccount.DataTrie().GetProof(keyBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return accountProof, dataTrieProof, nil
+}
+
+func (n *Node) getAccountsProofProvider(
+	address string,
+	options state.BlockQueryOptions,
+) ([]byte, state.AccountsAdapter, state.AccountsProofProvider, error) {
+	if check.IfNil(n.addressPubkeyConverter) || check.IfNil(n.accounts) {
+		return nil, nil, nil, errors.New("initialize AccountsAdapter and PubkeyConverter first")
+	}
+
+	addr, err := n.addressPubkeyConverter.Decode(address)
+	if err != nil {
+		return nil, nil, nil, errors.New("invalid address, could not decode from: " + err.Error())
+	}
+
+	accounts, err := n.getProofsAccountsAdapter(options)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	proofProvider, ok := accounts.(state.AccountsProofProvider)
+	if !ok {
+		return nil, nil, nil, ErrProofsNotSupported
+	}
+
+	return addr, accounts, proofProvider, nil
+}
+
+// getProofsAccountsAdapter returns the accounts adapter the proofs are generated from. The live accounts adapter can
+// hold the uncommitted state of a block being processed, so the current state is served from the root hash of the
+// last committed block instead, the proofs being verifiable against a published root hash.
+func (n *Node) getProofsAccountsAdapter(options state.BlockQueryOptions) (state.AccountsAdapter, error) {
+	if !options.IsCurrentState() {
+		return n.getAccountsAdapter(options)
+	}
+	if check.IfNil(n.blkc) {
+		return nil, ErrNilBlockchain
+	}
+	if check.IfNil(n.accountsRecreator) {
+		return nil, ErrNilAccountsRecreator
+	}
+
+	header := n.blkc.GetCurrentBlockHeader()
+	if check.IfNil(header) {
+		header = n.blkc.GetGenesisHeader()
+	}
+	if check.IfNil(header) {
+		return nil, ErrNilBlockHeader
+	}
+
+	accounts, err := n.accountsRecreator.RecreateAccountsAdapter(header.GetRootHash())
+	if err != nil {
+		return nil, fmt.Errorf("%w, root hash %s: %s", ErrHistoricalStateNotAvailable, hex.EncodeToString(header.GetRootHash()), err.Error())
+	}
+
+	return accounts, nil
+}
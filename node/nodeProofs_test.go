@@ -0,0 +1,146 @@
+package node_test
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNode_GetAccountProofAccountsWithoutProofsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	accounts := getAccAdapter(big.NewInt(100))
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithAccountsAdapter(accounts),
+		node.WithBlockChain(&mock.ChainHandlerStub{}),
+		node.WithAccountsRecreator(&mock.AccountsRecreatorStub{
+			RecreateAccountsAdapterCalled: func(_ []byte) (state.AccountsAdapter, error) {
+				return accounts, nil
+			},
+		}),
+	)
+
+	accountProof, err := n.GetAccountProof(createDummyHexAddress(64), state.BlockQueryOptions{})
+	assert.Nil(t, accountProof)
+	assert.Equal(t, node.ErrProofsNotSupported, err)
+}
+
+func TestNode_GetAccountProofShouldWork(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	proof := [][]byte{[]byte("node1"), []byte("node2")}
+	trieStub := &mock.TrieStub{
+		RootCalled: func() ([]byte, error) {
+			return rootHash, nil
+		},
+		GetProofCalled: func(key []byte) ([][]byte, error) {
+			return proof, nil
+		},
+	}
+	committedAccountsDB, _ := state.NewAccountsDB(trieStub, &mock.HasherMock{}, &mock.MarshalizerMock{}, &mock.AccountsFactoryStub{})
+	liveAccountsDB, _ := state.NewAccountsDB(&mock.TrieStub{}, &mock.HasherMock{}, &mock.MarshalizerMock{}, &mock.AccountsFactoryStub{})
+
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithAccountsAdapter(liveAccountsDB),
+		node.WithBlockChain(&mock.ChainHandlerStub{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.Header{RootHash: rootHash}
+			},
+		}),
+		node.WithAccountsRecreator(&mock.AccountsRecreatorStub{
+			RecreateAccountsAdapterCalled: func(requestedRootHash []byte) (state.AccountsAdapter, error) {
+				assert.Equal(t, rootHash, requestedRootHash)
+				return committedAccountsDB, nil
+			},
+		}),
+	)
+
+	accountProof, err := n.GetAccountProof(createDummyHexAddress(64), state.BlockQueryOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, &state.TrieProof{RootHash: rootHash, Proof: proof}, accountProof)
+}
+
+func TestNode_GetAccountProofWithoutCommittedStateShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("missing trie")
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithAccountsAdapter(getAccAdapter(big.NewInt(100))),
+		node.WithBlockChain(&mock.ChainHandlerStub{}),
+		node.WithAccountsRecreator(&mock.AccountsRecreatorStub{
+			RecreateAccountsAdapterCalled: func(_ []byte) (state.AccountsAdapter, error) {
+				return nil, expectedErr
+			},
+		}),
+	)
+
+	accountProof, err := n.GetAccountProof(createDummyHexAddress(64), state.BlockQueryOptions{})
+	assert.Nil(t, accountProof)
+	assert.True(t, errors.Is(err, node.ErrHistoricalStateNotAvailable))
+	assert.True(t, strings.Contains(err.Error(), expectedErr.Error()))
+}
+
+func TestNode_GetKeyProofInvalidKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithAccountsAdapter(getAccAdapter(big.NewInt(100))),
+	)
+
+	accountProof, dataTrieProof, err := n.GetKeyProof(createDummyHexAddress(64), "not hex", state.BlockQueryOptions{})
+	assert.Nil(t, accountProof)
+	assert.Nil(t, dataTrieProof)
+	assert.NotNil(t, err)
+}
+
+func TestNode_GetKeyProofMissingAccountShouldReturnTheAbsenceProof(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	proof := [][]byte{[]byte("node1"), []byte("node2")}
+	trieStub := &mock.TrieStub{
+		RootCalled: func() ([]byte, error) {
+			return rootHash, nil
+		},
+		GetProofCalled: func(key []byte) ([][]byte, error) {
+			return proof, nil
+		},
+		GetCalled: func(key []byte) ([]byte, error) {
+			return nil, nil
+		},
+	}
+	committedAccountsDB, _ := state.NewAccountsDB(trieStub, &mock.HasherMock{}, &mock.MarshalizerMock{}, &mock.AccountsFactoryStub{})
+
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithAccountsAdapter(committedAccountsDB),
+		node.WithBlockChain(&mock.ChainHandlerStub{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.Header{RootHash: rootHash}
+			},
+		}),
+		node.WithAccountsRecreator(&mock.AccountsRecreatorStub{
+			RecreateAccountsAdapterCalled: func(_ []byte) (state.AccountsAdapter, error) {
+				return committedAccountsDB, nil
+			},
+		}),
+	)
+
+	accountProof, dataTrieProof, err := n.GetKeyProof(createDummyHexAddress(64), "0a0b", state.BlockQueryOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, &state.TrieProof{RootHash: rootHash, Proof: proof}, accountProof)
+	assert.Equal(t, &state.TrieProof{Proof: make([][]byte, 0)}, dataTrieProof)
+}
@@ -36,6 +36,50 @@ func (n *Node) GetTransaction(txHash string, withResults bool) (*transaction.Api
 	return n.getTransactionFromStorage(hash)
 }
 
+// GetTransactionsByAddress gets a page of the transactions sent or received by the given address, ordered from the
+// newest to the oldest one. The cursor from the response should be provided in order to fetch the next page.
+func (n *Node) GetTransactionsByAddress(address string, cursor string, limit int) (*transaction.ApiTransactionsByAddress, error) {
+	if !n.historyRepository.IsEnabled() {
+		return nil, ErrDbLookupExtensionsNotEnabled
+	}
+	if limit <= 0 || limit > maxTransactionsByAddressPageSize {
+		return nil, fmt.Errorf("%w, should be between 1 and %d", dblookupext.ErrInvalidLimit, maxTransactionsByAddressPageSize)
+	}
+
+	addressBytes, err := n.addressPubkeyConverter.Decode(address)
+	if err != nil {
+		return nil, err
+	}
+	cursorBytes, err := hex.DecodeString(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := n.historyRepository.GetTransactionsByAddress(addressBytes, cursorBytes, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &transaction.ApiTransactionsByAddress{
+		Transactions: make([]*transaction.ApiTransactionResult, 0, len(page.Transactions)),
+		NextCursor:   hex.EncodeToString(page.NextCursor),
+	}
+	for _, addressTransaction := range page.Transactions {
+		tx, errLookup := n.lookupHistoricalTransaction(addressTransaction.TxHash, false)
+		if errLookup != nil {
+			log.Debug("GetTransactionsByAddress(): cannot retrieve transaction",
+				"txHash", addressTransaction.TxHash,
+				"error", errLookup.Error())
+			continue
+		}
+
+		tx.Hash = hex.EncodeToString(addressTransaction.TxHash)
+		result.Transactions = append(result.Transactions, tx)
+	}
+
+	return result, nil
+}
+
 func (n *Node) optionallyGetTransactionFromPool(hash []byte) (*transaction.ApiTransactionResult, error) {
 	txObj, txType, found := n.getTxObjFromDataPool(hash)
 	if !found {
@@ -48,6 +92,7 @@ func (n *Node) optionallyGetTransactionFromPool(hash []byte) (*transaction.ApiTr
 	}
 
 	tx.Status = transaction.TxStatusPending
+	tx.FinalityLevel = transaction.TxFinalityPendingInPool
 
 	return tx, nil
 }
@@ -79,9 +124,10 @@ func (n *Node) lookupHistoricalTransaction(hash []byte, withResults bool) (*tran
 
 	putMiniblockFieldsInTransaction(tx, miniblockMetadata)
 
+	tx.FinalityLevel = computeFinalityLevel(miniblockMetadata, n.shardCoordinator.SelfId())
 	tx.Status = (&transaction.StatusComputer{
 		MiniblockType:        block.Type(miniblockMetadata.Type),
-		IsMiniblockFinalized: tx.NotarizedAtDestinationInMetaNonce > 0,
+		IsMiniblockFinalized: tx.FinalityLevel.IsExecutedAtDestination(),
 		DestinationShard:     tx.DestinationShard,
 		Receiver:             tx.Tx.GetRcvAddr(),
 		TransactionData:      tx.Data,
@@ -92,9 +138,35 @@ func (n *Node) lookupHistoricalTransaction(hash []byte, withResults bool) (*tran
 		n.putResultsInTransaction(hash, tx, miniblockMetadata.Epoch)
 	}
 
+	if tx.Status == transaction.TxStatusSuccess && n.hasSmartContractFailed(hash, tx, miniblockMetadata.Epoch, withResults) {
+		tx.Status = transaction.TxStatusFail
+	}
+
 	return tx, nil
 }
 
+// computeFinalityLevel computes the lifecycle stage of a transaction out of the notarization coordinates of its
+// miniblock. The history repository is notified only about final metachain blocks, so a miniblock notarized at
+// destination is final. A node which is not the destination of the miniblock learns about its execution only from
+// this notarization
+func computeFinalityLevel(miniblockMetadata *dblookupext.MiniblockMetadata, selfShardID uint32) transaction.TxFinalityLevel {
+	if miniblockMetadata.NotarizedAtDestinationInMetaNonce > 0 {
+		return transaction.TxFinalityFinal
+	}
+
+	isIntraShard := miniblockMetadata.SourceShardID == miniblockMetadata.DestinationShardID
+	isDestinationMe := miniblockMetadata.DestinationShardID == selfShardID
+	if isIntraShard || isDestinationMe {
+		return transaction.TxFinalityExecutedAtDestination
+	}
+
+	if miniblockMetadata.NotarizedAtSourceInMetaNonce > 0 {
+		return transaction.TxFinalityNotarizedByMetachain
+	}
+
+	return transaction.TxFinalityIncludedInSource
+}
+
 func putMiniblockFieldsInTransaction(tx *transaction.ApiTransactionResult, miniblockMetadata *dblookupext.MiniblockMetadata) *transaction.ApiTransactionResult {
 	tx.Epoch = miniblockMetadata.Epoch
 	tx.Round = miniblockMetadata.Round
@@ -125,14 +197,21 @@ func (n *Node) getTransactionFromStorage(hash []byte) (*transaction.ApiTransacti
 		return nil, err
 	}
 
-	tx.Status = (&transaction.StatusComputer{
+	statusComputer := &transaction.StatusComputer{
 		// TODO: take care of this when integrating the adaptivity
 		SourceShard:      n.shardCoordinator.ComputeId(tx.Tx.GetSndAddr()),
 		DestinationShard: n.shardCoordinator.ComputeId(tx.Tx.GetRcvAddr()),
 		Receiver:         tx.Tx.GetRcvAddr(),
 		TransactionData:  tx.Data,
 		SelfShard:        n.shardCoordinator.SelfId(),
-	}).ComputeStatusWhenInStorageNotKnowingMiniblock()
+	}
+	tx.Status = statusComputer.ComputeStatusWhenInStorageNotKnowingMiniblock()
+
+	// without the dblookupext notarization coordinates, only the presence in the own storage is known
+	tx.FinalityLevel = transaction.TxFinalityIncludedInSource
+	if statusComputer.DestinationShard == statusComputer.SelfShard {
+		tx.FinalityLevel = transaction.TxFinalityExecutedAtDestination
+	}
 
 	return tx, nil
 }
@@ -0,0 +1,196 @@
+package node
+
+import (
+	"encoding/hex"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
+)
+
+// GetTransactionsPoolForSender returns the pending transactions of the given sender, sorted by nonce, together with
+// the nonce intervals missing between the account nonce and the pending transactions
+func (n *Node) GetTransactionsPoolForSender(sender string) (*transaction.ApiTransactionsPoolForSender, error) {
+	txPool, err := n.getTransactionsPoolInspector()
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := n.GetAccount(sender, state.BlockQueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	wrappedTxs := txPool.GetTransactionsForSender(account.AddressBytes())
+	txs := make([]*transaction.ApiTransactionResult, 0, len(wrappedTxs))
+	for _, wrappedTx := range wrappedTxs {
+		tx, ok := wrappedTx.Tx.(*transaction.Transaction)
+		if !ok {
+			continue
+		}
+
+		apiTx, errPrepare := n.prepareNormalTx(tx)
+		if errPrepare != nil {
+			return nil, errPrepare
+		}
+
+		apiTx.Hash = hex.EncodeToString(wrappedTx.TxHash)
+		apiTx.SourceShard = wrappedTx.SenderShardID
+		apiTx.DestinationShard = wrappedTx.ReceiverShardID
+		apiTx.Status = transaction.TxStatusPending
+		txs = append(txs, apiTx)
+	}
+
+	return &transaction.ApiTransactionsPoolForSender{
+		Sender:       sender,
+		AccountNonce: account.GetNonce(),
+		Transactions: txs,
+		NonceGaps:    computeNonceGaps(account.GetNonce(), wrappedTxs),
+	}, nil
+}
+
+// GetTransactionsPoolSenderInfo returns the nonce and score details the transactions pool keeps about the given sender
+func (n *Node) GetTransactionsPoolSenderInfo(sender string) (*transaction.ApiTransactionsPoolSenderInfo, error) {
+	txPool, err := n.getTransactionsPoolInspector()
+	if err != nil {
+		return nil, err
+	}
+
+	if check.IfNil(n.addressPubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+
+	senderBytes, err := n.addressPubkeyConverter.Decode(sender)
+	if err != nil {
+		return nil, err
+	}
+
+	senderInfo, ok := txPool.GetSenderInfo(senderBytes)
+	if !ok {
+		return nil, ErrSenderNotFoundInTransactionsPool
+	}
+
+	return &transaction.ApiTransactionsPoolSenderInfo{
+		Sender:              sender,
+		AccountNonce:        senderInfo.AccountNonce,
+		AccountNonceKnown:   senderInfo.AccountNonceKnown,
+		NumTxs:              senderInfo.NumTxs,
+		NumBytes:            senderInfo.NumBytes,
+		TotalGas:            senderInfo.TotalGas,
+		Score:               senderInfo.Score,
+		NumFailedSelections: senderInfo.NumFailedSelections,
+		HasInitialGap:       senderInfo.HasInitialGap,
+		IsInGracePeriod:     senderInfo.IsInGracePeriod,
+	}, nil
+}
+
+// GetTransactionsPoolStats returns the statistics of each cache of the transactions pool, sorted by cache ID
+func (n *Node) GetTransactionsPoolStats() ([]*transaction.ApiTransactionsPoolCacheStats, error) {
+	txPool, err := n.getTransactionsPoolInspector()
+	if err != nil {
+		return nil, err
+	}
+
+	stats := txPool.GetStats()
+	result := make([]*transaction.ApiTransactionsPoolCacheStats, 0, len(stats))
+	for cacheID, cacheStats := range stats {
+		result = append(result, &transaction.ApiTransactionsPoolCacheStats{
+			CacheID:                    cacheID,
+			NumTxs:                     cacheStats.NumTxs,
+			NumBytes:                   cacheStats.NumBytes,
+			NumSenders:                 cacheStats.NumSenders,
+			NumEvictionPasses:          cacheStats.NumEvictionPasses,
+			LastEvictionNumTxs:         cacheStats.LastEvictionNumTxs,
+			LastEvictionNumSenders:     cacheStats.LastEvictionNumSenders,
+			LastEvictionNumSteps:       cacheStats.LastEvictionNumSteps,
+			IsEvictionInProgress:       cacheStats.IsEvictionInProgress,
+			NumSendersWithInitialGap:   cacheStats.NumSendersWithInitialGap,
+			NumSendersInGracePeriod:    cacheStats.NumSendersInGracePeriod,
+			NumSendersSelectedLastTime: cacheStats.NumSendersSelectedLastTime,
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].CacheID < result[j].CacheID
+	})
+
+	return result, nil
+}
+
+// GetLastPendingNonceForSender returns the nonce of the last pending transaction of the given sender which follows
+// the account nonce without gaps, together with the nonce its next transaction should use
+func (n *Node) GetLastPendingNonceForSender(sender string) (*transaction.ApiLastPendingNonce, error) {
+	txPool, err := n.getTransactionsPoolInspector()
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := n.GetAccount(sender, state.BlockQueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	accountNonce := account.GetNonce()
+	nextNonce := accountNonce
+	for _, wrappedTx := range txPool.GetTransactionsForSender(account.AddressBytes()) {
+		nonce := wrappedTx.Tx.GetNonce()
+		if nonce < nextNonce {
+			continue
+		}
+		if nonce > nextNonce {
+			break
+		}
+
+		nextNonce++
+	}
+
+	result := &transaction.ApiLastPendingNonce{
+		Sender:                 sender,
+		AccountNonce:           accountNonce,
+		HasPendingTransactions: nextNonce > accountNonce,
+		NextNonce:              nextNonce,
+	}
+	if result.HasPendingTransactions {
+		result.LastPendingNonce = nextNonce - 1
+	}
+
+	return result, nil
+}
+
+func (n *Node) getTransactionsPoolInspector() (TransactionsPoolInspector, error) {
+	if check.IfNil(n.dataPool) {
+		return nil, ErrNilDataPool
+	}
+
+	txPool, ok := n.dataPool.Transactions().(TransactionsPoolInspector)
+	if !ok {
+		return nil, ErrTransactionsPoolInspectionNotSupported
+	}
+
+	return txPool, nil
+}
+
+// computeNonceGaps returns the nonce intervals missing between the account nonce and the given transactions, which
+// have to be sorted by nonce. Transactions with nonces lower than the account nonce are ignored
+func computeNonceGaps(accountNonce uint64, wrappedTxs []*txcache.WrappedTransaction) []*transaction.ApiNonceGap {
+	nonceGaps := make([]*transaction.ApiNonceGap, 0)
+	expectedNonce := accountNonce
+	for _, wrappedTx := range wrappedTxs {
+		nonce := wrappedTx.Tx.GetNonce()
+		if nonce < expectedNonce {
+			continue
+		}
+		if nonce > expectedNonce {
+			nonceGaps = append(nonceGaps, &transaction.ApiNonceGap{
+				From: expectedNonce,
+				To:   nonce - 1,
+			})
+		}
+
+		expectedNonce = nonce + 1
+	}
+
+	return nonceGaps
+}
@@ -0,0 +1,167 @@
+package node
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/require"
+)
+
+func createNodeWithTransactionsPool(t *testing.T, accountNonce uint64) (*Node, *testscommon.PoolsHolderMock) {
+	dataPool := testscommon.NewPoolsHolderMock()
+	accounts := &mock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			account, _ := state.NewUserAccount(address)
+			account.Nonce = accountNonce
+			return account, nil
+		},
+	}
+
+	n, err := NewNode(
+		WithDataPool(dataPool),
+		WithAccountsAdapter(accounts),
+		WithAddressPubkeyConverter(&mock.PubkeyConverterMock{}),
+		WithShardCoordinator(createShardCoordinator()),
+	)
+	require.Nil(t, err)
+
+	return n, dataPool
+}
+
+func TestNode_GetTransactionsPoolForSender(t *testing.T) {
+	t.Parallel()
+
+	n, dataPool := createNodeWithTransactionsPool(t, 5)
+	sender := hex.EncodeToString([]byte("alice"))
+
+	nonces := []uint64{3, 5, 6, 9, 12}
+	for _, nonce := range nonces {
+		tx := &transaction.Transaction{Nonce: nonce, SndAddr: []byte("alice"), RcvAddr: []byte("bob")}
+		dataPool.Transactions().AddData([]byte{byte(nonce)}, tx, 42, "0")
+	}
+
+	txsForSender, err := n.GetTransactionsPoolForSender(sender)
+	require.Nil(t, err)
+	require.Equal(t, sender, txsForSender.Sender)
+	require.Equal(t, uint64(5), txsForSender.AccountNonce)
+	require.Len(t, txsForSender.Transactions, len(nonces))
+	for i, nonce := range nonces {
+		require.Equal(t, nonce, txsForSender.Transactions[i].Nonce)
+		require.Equal(t, hex.EncodeToString([]byte{byte(nonce)}), txsForSender.Transactions[i].Hash)
+		require.Equal(t, transaction.TxStatusPending, txsForSender.Transactions[i].Status)
+	}
+
+	expectedGaps := []*transaction.ApiNonceGap{
+		{From: 7, To: 8},
+		{From: 10, To: 11},
+	}
+	require.Equal(t, expectedGaps, txsForSender.NonceGaps)
+}
+
+func TestNode_GetTransactionsPoolNotSupportedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dataPool := testscommon.NewPoolsHolderStub()
+	dataPool.TransactionsCalled = func() dataRetriever.ShardedDataCacherNotifier {
+		return testscommon.NewShardedDataStub()
+	}
+	n, _ := NewNode(WithDataPool(dataPool))
+
+	_, err := n.GetTransactionsPoolForSender(hex.EncodeToString([]byte("alice")))
+	require.Equal(t, ErrTransactionsPoolInspectionNotSupported, err)
+	_, err = n.GetTransactionsPoolSenderInfo(hex.EncodeToString([]byte("alice")))
+	require.Equal(t, ErrTransactionsPoolInspectionNotSupported, err)
+	_, err = n.GetTransactionsPoolStats()
+	require.Equal(t, ErrTransactionsPoolInspectionNotSupported, err)
+	_, err = n.GetLastPendingNonceForSender(hex.EncodeToString([]byte("alice")))
+	require.Equal(t, ErrTransactionsPoolInspectionNotSupported, err)
+}
+
+func TestNode_GetTransactionsPoolSenderInfo(t *testing.T) {
+	t.Parallel()
+
+	n, dataPool := createNodeWithTransactionsPool(t, 0)
+	tx := &transaction.Transaction{Nonce: 7, SndAddr: []byte("alice"), RcvAddr: []byte("bob")}
+	dataPool.Transactions().AddData([]byte("a"), tx, 42, "0")
+
+	senderInfo, err := n.GetTransactionsPoolSenderInfo(hex.EncodeToString([]byte("alice")))
+	require.Nil(t, err)
+	require.Equal(t, hex.EncodeToString([]byte("alice")), senderInfo.Sender)
+	require.Equal(t, uint64(1), senderInfo.NumTxs)
+	require.Equal(t, int64(42), senderInfo.NumBytes)
+
+	senderInfo, err = n.GetTransactionsPoolSenderInfo(hex.EncodeToString([]byte("bob")))
+	require.Equal(t, ErrSenderNotFoundInTransactionsPool, err)
+	require.Nil(t, senderInfo)
+}
+
+func TestNode_GetTransactionsPoolStats(t *testing.T) {
+	t.Parallel()
+
+	n, dataPool := createNodeWithTransactionsPool(t, 0)
+	dataPool.Transactions().AddData([]byte("a"), &transaction.Transaction{Nonce: 1, SndAddr: []byte("alice")}, 42, "0")
+	dataPool.Transactions().AddData([]byte("b"), &transaction.Transaction{Nonce: 2, SndAddr: []byte("alice")}, 42, "0")
+
+	stats, err := n.GetTransactionsPoolStats()
+	require.Nil(t, err)
+	require.Len(t, stats, 1)
+	require.Equal(t, "0", stats[0].CacheID)
+	require.Equal(t, uint64(2), stats[0].NumTxs)
+	require.Equal(t, uint64(84), stats[0].NumBytes)
+	require.Equal(t, uint64(1), stats[0].NumSenders)
+}
+
+func TestNode_GetLastPendingNonceForSender(t *testing.T) {
+	t.Parallel()
+
+	n, dataPool := createNodeWithTransactionsPool(t, 5)
+	sender := hex.EncodeToString([]byte("alice"))
+
+	lastPendingNonce, err := n.GetLastPendingNonceForSender(sender)
+	require.Nil(t, err)
+	require.Equal(t, &transaction.ApiLastPendingNonce{
+		Sender:       sender,
+		AccountNonce: 5,
+		NextNonce:    5,
+	}, lastPendingNonce)
+
+	for _, nonce := range []uint64{4, 5, 6, 7, 9} {
+		tx := &transaction.Transaction{Nonce: nonce, SndAddr: []byte("alice"), RcvAddr: []byte("bob")}
+		dataPool.Transactions().AddData([]byte{byte(nonce)}, tx, 42, "0")
+	}
+
+	lastPendingNonce, err = n.GetLastPendingNonceForSender(sender)
+	require.Nil(t, err)
+	require.Equal(t, &transaction.ApiLastPendingNonce{
+		Sender:                 sender,
+		AccountNonce:           5,
+		HasPendingTransactions: true,
+		LastPendingNonce:       7,
+		NextNonce:              8,
+	}, lastPendingNonce)
+}
+
+func TestComputeNonceGaps(t *testing.T) {
+	t.Parallel()
+
+	createWrappedTxs := func(nonces ...uint64) []*txcache.WrappedTransaction {
+		wrappedTxs := make([]*txcache.WrappedTransaction, 0, len(nonces))
+		for _, nonce := range nonces {
+			wrappedTxs = append(wrappedTxs, &txcache.WrappedTransaction{Tx: &transaction.Transaction{Nonce: nonce}})
+		}
+		return wrappedTxs
+	}
+
+	require.Len(t, computeNonceGaps(3, createWrappedTxs()), 0)
+	require.Len(t, computeNonceGaps(3, createWrappedTxs(1, 2, 3, 3, 4)), 0)
+	require.Equal(t,
+		[]*transaction.ApiNonceGap{{From: 3, To: 4}, {From: 6, To: 6}},
+		computeNonceGaps(3, createWrappedTxs(2, 5, 5, 7)),
+	)
+}
@@ -2,8 +2,10 @@ package node
 
 import (
 	"encoding/hex"
+	"strings"
 
 	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
 	"github.com/ElrondNetwork/elrond-go/data/receipt"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
@@ -64,19 +66,80 @@ func (n *Node) putSmartContractResultsInTransaction(
 	tx *transaction.ApiTransactionResult,
 	scrHashesEpoch []*dblookupext.ScResultsHashesAndEpoch,
 ) {
+	tx.SmartContractResults = append(tx.SmartContractResults, n.getSmartContractResults(scrHashesEpoch)...)
+}
+
+func (n *Node) getSmartContractResults(scrHashesEpoch []*dblookupext.ScResultsHashesAndEpoch) []*transaction.ApiSmartContractResult {
+	scResults := make([]*transaction.ApiSmartContractResult, 0)
 	for _, scrHashesE := range scrHashesEpoch {
 		for _, scrHash := range scrHashesE.ScResultsHashes {
 			scr, err := n.getScrFromStorage(scrHash, scrHashesE.Epoch)
 			if err != nil {
-				log.Warn("putSmartContractResultsInTransaction cannot get result from storage",
+				log.Warn("getSmartContractResults cannot get result from storage",
 					"hash", hex.EncodeToString(scrHash),
 					"error", err.Error())
 				continue
 			}
 
-			tx.SmartContractResults = append(tx.SmartContractResults, n.adaptSmartContractResult(scrHash, scr))
+			scResults = append(scResults, n.adaptSmartContractResult(scrHash, scr))
 		}
 	}
+
+	return scResults
+}
+
+// hasSmartContractFailed returns true if the smart contract result sent back to the transaction sender carries a
+// return code other than ok. The results already put in the transaction are used, if they were requested
+func (n *Node) hasSmartContractFailed(hash []byte, tx *transaction.ApiTransactionResult, epoch uint32, withResults bool) bool {
+	scResults := tx.SmartContractResults
+	if !withResults {
+		resultsHashes, err := n.historyRepository.GetResultsHashesByTxHash(hash, epoch)
+		if err != nil || resultsHashes == nil {
+			return false
+		}
+
+		scResults = n.getSmartContractResults(resultsHashes.ScResultsHashesAndEpoch)
+	}
+
+	txHash := hex.EncodeToString(hash)
+	for _, scr := range scResults {
+		isResultForSender := scr.PrevTxHash == txHash && scr.RcvAddr == tx.Sender
+		if isResultForSender && isSmartContractResultSignalingFailure(scr, tx.Data) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isSmartContractResultSignalingFailure checks the return code put by the smart contract processor as the first argument
+// of the data field. A failed call returning ESDT tokens to the sender prefixes the data with the original call data
+func isSmartContractResultSignalingFailure(scr *transaction.ApiSmartContractResult, txData []byte) bool {
+	data := scr.Data
+	if len(txData) > 0 && strings.HasPrefix(data, string(txData)+"@") {
+		data = data[len(txData):]
+	}
+	if !strings.HasPrefix(data, "@") {
+		return false
+	}
+
+	arguments := strings.Split(data[1:], "@")
+	returnCode, err := hex.DecodeString(arguments[0])
+	if err != nil {
+		return false
+	}
+
+	return isFailureReturnCode(string(returnCode))
+}
+
+func isFailureReturnCode(returnCode string) bool {
+	for code := vmcommon.FunctionNotFound; code <= vmcommon.UpgradeFailed; code++ {
+		if returnCode == code.String() {
+			return true
+		}
+	}
+
+	return false
 }
 
 func (n *Node) getScrFromStorage(hash []byte, epoch uint32) (*smartContractResult.SmartContractResult, error) {
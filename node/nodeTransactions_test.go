@@ -3,6 +3,7 @@ package node
 import (
 	"bytes"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"math/big"
 	"testing"
@@ -61,6 +62,9 @@ func TestNode_GetTransaction_FromPool(t *testing.T) {
 	require.Equal(t, transaction.TxStatusPending, actualA.Status)
 	require.Equal(t, transaction.TxStatusPending, actualB.Status)
 	require.Equal(t, transaction.TxStatusPending, actualC.Status)
+	require.Equal(t, transaction.TxFinalityPendingInPool, actualA.FinalityLevel)
+	require.Equal(t, transaction.TxFinalityPendingInPool, actualB.FinalityLevel)
+	require.Equal(t, transaction.TxFinalityPendingInPool, actualC.FinalityLevel)
 
 	// Reward transactions
 
@@ -243,6 +247,7 @@ func TestNode_GetTransactionWithResultsFromStorage(t *testing.T) {
 		Receiver:      hex.EncodeToString(tx.RcvAddr),
 		Sender:        hex.EncodeToString(tx.SndAddr),
 		Status:        transaction.TxStatusSuccess,
+		FinalityLevel: transaction.TxFinalityExecutedAtDestination,
 		MiniBlockType: block.TxBlock.String(),
 		Type:          "normal",
 		Value:         "<nil>",
@@ -381,6 +386,145 @@ func TestNode_lookupHistoricalTransaction(t *testing.T) {
 	require.Nil(t, tx)
 }
 
+func TestNode_GetTransactionFinalityLevel(t *testing.T) {
+	t.Parallel()
+
+	n, chainStorer, _, historyRepo := createNode(t, 42, true)
+	tx := &transaction.Transaction{Nonce: 7, SndAddr: []byte("alice"), RcvAddr: []byte("bob")}
+	_ = chainStorer.Transactions.PutWithMarshalizer([]byte("a"), tx, n.internalMarshalizer)
+
+	checkFinalityLevel := func(metadata *dblookupext.MiniblockMetadata, expectedLevel transaction.TxFinalityLevel, expectedStatus transaction.TxStatus) {
+		metadata.Epoch = 42
+		historyRepo.GetMiniblockMetadataByTxHashCalled = func(hash []byte) (*dblookupext.MiniblockMetadata, error) {
+			return metadata, nil
+		}
+
+		actual, err := n.GetTransaction(hex.EncodeToString([]byte("a")), false)
+		require.Nil(t, err)
+		assert.Equal(t, expectedLevel, actual.FinalityLevel)
+		assert.Equal(t, expectedStatus, actual.Status)
+	}
+
+	// Cross-shard, we are source
+	checkFinalityLevel(&dblookupext.MiniblockMetadata{
+		SourceShardID:      1,
+		DestinationShardID: 2,
+	}, transaction.TxFinalityIncludedInSource, transaction.TxStatusPending)
+	checkFinalityLevel(&dblookupext.MiniblockMetadata{
+		SourceShardID:                1,
+		DestinationShardID:           2,
+		NotarizedAtSourceInMetaNonce: 5,
+	}, transaction.TxFinalityNotarizedByMetachain, transaction.TxStatusPending)
+	checkFinalityLevel(&dblookupext.MiniblockMetadata{
+		SourceShardID:                     1,
+		DestinationShardID:                2,
+		NotarizedAtSourceInMetaNonce:      5,
+		NotarizedAtDestinationInMetaNonce: 7,
+	}, transaction.TxFinalityFinal, transaction.TxStatusSuccess)
+
+	// Cross-shard, we are destination
+	checkFinalityLevel(&dblookupext.MiniblockMetadata{
+		SourceShardID:                2,
+		DestinationShardID:           1,
+		NotarizedAtSourceInMetaNonce: 5,
+	}, transaction.TxFinalityExecutedAtDestination, transaction.TxStatusSuccess)
+
+	// Intra-shard
+	checkFinalityLevel(&dblookupext.MiniblockMetadata{
+		SourceShardID:      1,
+		DestinationShardID: 1,
+	}, transaction.TxFinalityExecutedAtDestination, transaction.TxStatusSuccess)
+	checkFinalityLevel(&dblookupext.MiniblockMetadata{
+		SourceShardID:                     1,
+		DestinationShardID:                1,
+		NotarizedAtSourceInMetaNonce:      5,
+		NotarizedAtDestinationInMetaNonce: 5,
+	}, transaction.TxFinalityFinal, transaction.TxStatusSuccess)
+}
+
+func TestNode_GetTransactionSmartContractFailure(t *testing.T) {
+	t.Parallel()
+
+	n, chainStorer, _, historyRepo := createNode(t, 42, true)
+	tx := &transaction.Transaction{Nonce: 7, SndAddr: []byte("alice"), RcvAddr: []byte("alice"), Data: []byte("doSomething")}
+	_ = chainStorer.Transactions.PutWithMarshalizer([]byte("a"), tx, n.internalMarshalizer)
+	setupGetMiniblockMetadataByTxHash(historyRepo, block.TxBlock, 1, 1, 42)
+	historyRepo.GetEventsHashesByTxHashCalled = func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error) {
+		return &dblookupext.ResultsHashesByTxHash{
+			ScResultsHashesAndEpoch: []*dblookupext.ScResultsHashesAndEpoch{
+				{Epoch: 42, ScResultsHashes: [][]byte{[]byte("scr")}},
+			},
+		}, nil
+	}
+
+	checkStatusOfResult := func(scr *smartContractResult.SmartContractResult, withResults bool, expectedStatus transaction.TxStatus) {
+		_ = chainStorer.Unsigned.PutWithMarshalizer([]byte("scr"), scr, n.internalMarshalizer)
+
+		actual, err := n.GetTransaction(hex.EncodeToString([]byte("a")), withResults)
+		require.Nil(t, err)
+		assert.Equal(t, expectedStatus, actual.Status)
+	}
+	checkStatus := func(scrData string, withResults bool, expectedStatus transaction.TxStatus) {
+		scr := &smartContractResult.SmartContractResult{PrevTxHash: []byte("a"), RcvAddr: []byte("alice"), Data: []byte(scrData)}
+		checkStatusOfResult(scr, withResults, expectedStatus)
+	}
+
+	checkStatus("@"+hex.EncodeToString([]byte("ok")), false, transaction.TxStatusSuccess)
+	checkStatus("@"+hex.EncodeToString([]byte("ok"))+"@"+hex.EncodeToString([]byte("user error")), true, transaction.TxStatusSuccess)
+	checkStatus("@"+hex.EncodeToString([]byte("user error")), false, transaction.TxStatusFail)
+	checkStatus("@"+hex.EncodeToString([]byte("out of gas"))+"@"+hex.EncodeToString([]byte("a")), true, transaction.TxStatusFail)
+	checkStatus("doSomething@"+hex.EncodeToString([]byte("function not found")), false, transaction.TxStatusFail)
+	checkStatus("ESDTTransfer@"+hex.EncodeToString([]byte("TKN")), false, transaction.TxStatusSuccess)
+	checkStatus("@"+hex.EncodeToString([]byte("done")), true, transaction.TxStatusSuccess)
+
+	// results not sent back to the sender by the transaction itself are not inspected
+	userError := []byte("@" + hex.EncodeToString([]byte("user error")))
+	checkStatusOfResult(&smartContractResult.SmartContractResult{PrevTxHash: []byte("a"), RcvAddr: []byte("bob"), Data: userError}, false, transaction.TxStatusSuccess)
+	checkStatusOfResult(&smartContractResult.SmartContractResult{PrevTxHash: []byte("b"), OriginalTxHash: []byte("a"), RcvAddr: []byte("alice"), Data: userError}, true, transaction.TxStatusSuccess)
+}
+
+func TestNode_GetTransactionsByAddress(t *testing.T) {
+	t.Parallel()
+
+	n, _, _, _ := createNode(t, 42, false)
+	page, err := n.GetTransactionsByAddress(hex.EncodeToString([]byte("alice")), "", 10)
+	require.Nil(t, page)
+	require.Equal(t, ErrDbLookupExtensionsNotEnabled, err)
+
+	n, chainStorer, _, historyRepo := createNode(t, 42, true)
+	page, err = n.GetTransactionsByAddress(hex.EncodeToString([]byte("alice")), "", maxTransactionsByAddressPageSize+1)
+	require.Nil(t, page)
+	require.True(t, errors.Is(err, dblookupext.ErrInvalidLimit))
+
+	page, err = n.GetTransactionsByAddress(hex.EncodeToString([]byte("alice")), "zz", 10)
+	require.Nil(t, page)
+	require.NotNil(t, err)
+
+	txA := &transaction.Transaction{Nonce: 7, SndAddr: []byte("alice"), RcvAddr: []byte("bob")}
+	_ = chainStorer.Transactions.PutWithMarshalizer([]byte("a"), txA, n.internalMarshalizer)
+	setupGetMiniblockMetadataByTxHash(historyRepo, block.TxBlock, 1, 2, 42)
+	historyRepo.GetTransactionsByAddressCalled = func(address []byte, cursor []byte, limit int) (*dblookupext.TransactionsByAddressPage, error) {
+		require.Equal(t, []byte("alice"), address)
+		require.Equal(t, []byte("cursor"), cursor)
+		require.Equal(t, 10, limit)
+
+		return &dblookupext.TransactionsByAddressPage{
+			Transactions: []*dblookupext.AddressTransaction{
+				{TxHash: []byte("a")},
+				{TxHash: []byte("missing")},
+			},
+			NextCursor: []byte("next"),
+		}, nil
+	}
+
+	page, err = n.GetTransactionsByAddress(hex.EncodeToString([]byte("alice")), hex.EncodeToString([]byte("cursor")), 10)
+	require.Nil(t, err)
+	require.Equal(t, hex.EncodeToString([]byte("next")), page.NextCursor)
+	require.Equal(t, 1, len(page.Transactions))
+	require.Equal(t, hex.EncodeToString([]byte("a")), page.Transactions[0].Hash)
+	require.Equal(t, txA.Nonce, page.Transactions[0].Nonce)
+}
+
 func TestNode_PutHistoryFieldsInTransaction(t *testing.T) {
 	tx := &transaction.ApiTransactionResult{}
 	metadata := &dblookupext.MiniblockMetadata{
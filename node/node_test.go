@@ -113,7 +113,7 @@ func TestGetBalance_NoAddrConverterShouldError(t *testing.T) {
 		node.WithHasher(getHasher()),
 		node.WithAccountsAdapter(&mock.AccountsStub{}),
 	)
-	_, err := n.GetBalance("address")
+	_, err := n.GetBalance("address", state.BlockQueryOptions{})
 	assert.NotNil(t, err)
 	assert.Equal(t, "initialize AccountsAdapter and PubkeyConverter first", err.Error())
 }
@@ -126,7 +126,7 @@ func TestGetBalance_NoAccAdapterShouldError(t *testing.T) {
 		node.WithHasher(getHasher()),
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 	)
-	_, err := n.GetBalance("address")
+	_, err := n.GetBalance("address", state.BlockQueryOptions{})
 	assert.NotNil(t, err)
 	assert.Equal(t, "initialize AccountsAdapter and PubkeyConverter first", err.Error())
 }
@@ -146,7 +146,7 @@ func TestGetBalance_GetAccountFailsShouldError(t *testing.T) {
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 		node.WithAccountsAdapter(accAdapter),
 	)
-	_, err := n.GetBalance(createDummyHexAddress(64))
+	_, err := n.GetBalance(createDummyHexAddress(64), state.BlockQueryOptions{})
 	assert.Equal(t, expectedErr, err)
 }
 
@@ -175,7 +175,7 @@ func TestGetBalance_GetAccountReturnsNil(t *testing.T) {
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 		node.WithAccountsAdapter(accAdapter),
 	)
-	balance, err := n.GetBalance(createDummyHexAddress(64))
+	balance, err := n.GetBalance(createDummyHexAddress(64), state.BlockQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, big.NewInt(0), balance)
 }
@@ -190,7 +190,7 @@ func TestGetBalance(t *testing.T) {
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 		node.WithAccountsAdapter(accAdapter),
 	)
-	balance, err := n.GetBalance(createDummyHexAddress(64))
+	balance, err := n.GetBalance(createDummyHexAddress(64), state.BlockQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, big.NewInt(100), balance)
 }
@@ -244,6 +244,40 @@ func TestNode_GetESDTBalance(t *testing.T) {
 	assert.Equal(t, esdtData.Value.String(), value)
 }
 
+func TestNode_GetESDTAllowance(t *testing.T) {
+	acc, _ := state.NewUserAccount([]byte("newaddress"))
+	esdtToken := "newToken"
+	spender := bytes.Repeat([]byte{1}, 32)
+	allowanceKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTAllowanceKeyIdentifier + esdtToken + string(spender))
+
+	allowanceData := &esdt.ESDigitalToken{Value: big.NewInt(7)}
+	marshalledData, _ := getMarshalizer().Marshal(allowanceData)
+	_ = acc.DataTrieTracker().SaveKeyValue(allowanceKey, marshalledData)
+
+	accDB := &mock.AccountsStub{}
+	accDB.GetExistingAccountCalled = func(address []byte) (handler state.AccountHandler, e error) {
+		return acc, nil
+	}
+	n, _ := node.NewNode(
+		node.WithInternalMarshalizer(getMarshalizer(), testSizeCheckDelta),
+		node.WithVmMarshalizer(getMarshalizer()),
+		node.WithHasher(getHasher()),
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithAccountsAdapter(accDB),
+	)
+
+	value, err := n.GetESDTAllowance(createDummyHexAddress(64), hex.EncodeToString(spender), esdtToken)
+	assert.Nil(t, err)
+	assert.Equal(t, allowanceData.Value.String(), value)
+
+	value, err = n.GetESDTAllowance(createDummyHexAddress(64), createDummyHexAddress(64), esdtToken)
+	assert.Nil(t, err)
+	assert.Equal(t, "0", value)
+
+	_, err = n.GetESDTAllowance(createDummyHexAddress(64), "not a hex address", esdtToken)
+	assert.NotNil(t, err)
+}
+
 func TestNode_GetAllESDTTokens(t *testing.T) {
 	acc, _ := state.NewUserAccount([]byte("newaddress"))
 	esdtToken := "newToken"
@@ -286,6 +320,75 @@ func TestNode_GetAllESDTTokens(t *testing.T) {
 	assert.Equal(t, esdtToken, value[0])
 }
 
+func TestNode_GetAllNFTsAndGetNFT(t *testing.T) {
+	acc, _ := state.NewUserAccount([]byte("newaddress"))
+	fungibleKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + "fungible")
+	fungibleData, _ := getMarshalizer().Marshal(&esdt.ESDigitalToken{Value: big.NewInt(10)})
+	_ = acc.DataTrieTracker().SaveKeyValue(fungibleKey, fungibleData)
+
+	nftToken := "NFT-abcdef"
+	nftKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + nftToken + string(big.NewInt(300).Bytes()))
+	nftData, _ := getMarshalizer().Marshal(&esdt.ESDigitalToken{
+		Value: big.NewInt(3),
+		Type:  uint32(core.SemiFungible),
+		TokenMetaData: &esdt.MetaData{
+			Nonce:     300,
+			Name:      []byte("name"),
+			Creator:   []byte("creator"),
+			Royalties: 100,
+		},
+	})
+	_ = acc.DataTrieTracker().SaveKeyValue(nftKey, nftData)
+
+	acc.DataTrieTracker().SetDataTrie(
+		&mock.TrieStub{
+			GetAllLeavesOnChannelCalled: func(rootHash []byte) (chan core.KeyValueHolder, error) {
+				ch := make(chan core.KeyValueHolder)
+
+				go func() {
+					ch <- keyValStorage.NewKeyValStorage(fungibleKey, fungibleData)
+					ch <- keyValStorage.NewKeyValStorage(nftKey, nftData)
+					close(ch)
+				}()
+
+				return ch, nil
+			},
+		})
+
+	accDB := &mock.AccountsStub{}
+	accDB.GetExistingAccountCalled = func(address []byte) (handler state.AccountHandler, e error) {
+		return acc, nil
+	}
+	n, _ := node.NewNode(
+		node.WithInternalMarshalizer(getMarshalizer(), testSizeCheckDelta),
+		node.WithVmMarshalizer(getMarshalizer()),
+		node.WithHasher(getHasher()),
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithAccountsAdapter(accDB),
+	)
+
+	tokens, err := n.GetAllNFTs(createDummyHexAddress(64))
+	require.Nil(t, err)
+	require.Equal(t, 1, len(tokens))
+	assert.Equal(t, nftToken, tokens[0].TokenIdentifier)
+	assert.Equal(t, uint64(300), tokens[0].Nonce)
+	assert.Equal(t, core.SemiFungibleESDT, tokens[0].Type)
+	assert.Equal(t, "3", tokens[0].Balance)
+	assert.Equal(t, "name", tokens[0].Name)
+	assert.Equal(t, hex.EncodeToString([]byte("creator")), tokens[0].Creator)
+
+	fungibleTokens, err := n.GetAllESDTTokens(createDummyHexAddress(64))
+	require.Nil(t, err)
+	assert.Equal(t, []string{"fungible"}, fungibleTokens)
+
+	token, err := n.GetNFT(createDummyHexAddress(64), nftToken, 300)
+	require.Nil(t, err)
+	assert.Equal(t, tokens[0], token)
+
+	_, err = n.GetNFT(createDummyHexAddress(64), nftToken, 301)
+	assert.Equal(t, node.ErrNFTNotFound, err)
+}
+
 //------- GenerateTransaction
 
 func TestGenerateTransaction_NoAddrConverterShouldError(t *testing.T) {
@@ -1768,12 +1871,15 @@ func TestStartConsensus_ShardBootstrapper(t *testing.T) {
 		node.WithNetworkShardingCollector(&mock.NetworkShardingCollectorStub{}),
 		node.WithInputAntifloodHandler(&mock.P2PAntifloodHandlerStub{}),
 		node.WithHeaderIntegrityVerifier(&mock.HeaderIntegrityVerifierStub{}),
+		node.WithEquivocationDetector(&testscommon.EquivocationDetectorStub{}),
 		node.WithPeerHonestyHandler(&testscommon.PeerHonestyHandlerStub{}),
 		node.WithFallbackHeaderValidator(&testscommon.FallBackHeaderValidatorStub{}),
 		node.WithHardforkTrigger(&mock.HardforkTriggerStub{}),
 		node.WithInterceptorsContainer(&mock.InterceptorsContainerStub{}),
 		node.WithWatchdogTimer(&mock.WatchdogMock{}),
 		node.WithPeerSignatureHandler(&mock.PeerSignatureHandler{}),
+		node.WithKeysHandler(&testscommon.KeysHandlerStub{}),
+		node.WithSigningHandler(&testscommon.SigningHandlerStub{}),
 		node.WithIndexer(indexer.NewNilIndexer()),
 	)
 
@@ -1790,7 +1896,7 @@ func TestNode_GetAccountWithNilAccountsAdapterShouldErr(t *testing.T) {
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), state.BlockQueryOptions{})
 
 	assert.Nil(t, recovAccnt)
 	assert.Equal(t, node.ErrNilAccountsAdapter, err)
@@ -1809,7 +1915,7 @@ func TestNode_GetAccountWithNilPubkeyConverterShouldErr(t *testing.T) {
 		node.WithAccountsAdapter(accDB),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), state.BlockQueryOptions{})
 
 	assert.Nil(t, recovAccnt)
 	assert.Equal(t, node.ErrNilPubkeyConverter, err)
@@ -1835,7 +1941,7 @@ func TestNode_GetAccountPubkeyConverterFailsShouldErr(t *testing.T) {
 			}),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), state.BlockQueryOptions{})
 
 	assert.Nil(t, recovAccnt)
 	assert.Equal(t, errExpected, err)
@@ -1855,7 +1961,7 @@ func TestNode_GetAccountAccountDoesNotExistsShouldRetEmpty(t *testing.T) {
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), state.BlockQueryOptions{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, uint64(0), recovAccnt.GetNonce())
@@ -1879,7 +1985,7 @@ func TestNode_GetAccountAccountsAdapterFailsShouldErr(t *testing.T) {
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), state.BlockQueryOptions{})
 
 	assert.Nil(t, recovAccnt)
 	assert.NotNil(t, err)
@@ -1906,7 +2012,7 @@ func TestNode_GetAccountAccountExistsShouldReturn(t *testing.T) {
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), state.BlockQueryOptions{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, accnt, recovAccnt)
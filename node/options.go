@@ -103,6 +103,17 @@ func WithAccountsAdapter(accounts state.AccountsAdapter) Option {
 	}
 }
 
+// WithAccountsRecreator sets up the accounts recreator option for the Node
+func WithAccountsRecreator(accountsRecreator state.AccountsAdapterRecreator) Option {
+	return func(n *Node) error {
+		if check.IfNil(accountsRecreator) {
+			return ErrNilAccountsRecreator
+		}
+		n.accountsRecreator = accountsRecreator
+		return nil
+	}
+}
+
 // WithAddressPubkeyConverter sets up the address public key converter adapter option for the Node
 func WithAddressPubkeyConverter(pubkeyConverter core.PubkeyConverter) Option {
 	return func(n *Node) error {
@@ -496,6 +507,28 @@ func WithHeaderIntegrityVerifier(headerIntegrityVerifier spos.HeaderIntegrityVer
 	}
 }
 
+// WithEquivocationDetector sets up the equivocation detector option for the Node
+func WithEquivocationDetector(equivocationDetector spos.EquivocationDetector) Option {
+	return func(n *Node) error {
+		if check.IfNil(equivocationDetector) {
+			return ErrNilEquivocationDetector
+		}
+		n.equivocationDetector = equivocationDetector
+		return nil
+	}
+}
+
+// WithHealthService sets up the health service option for the Node
+func WithHealthService(healthService HealthService) Option {
+	return func(n *Node) error {
+		if check.IfNil(healthService) {
+			return ErrNilHealthService
+		}
+		n.healthService = healthService
+		return nil
+	}
+}
+
 // WithValidatorStatistics sets up the validator statistics for the node
 func WithValidatorStatistics(validatorStatistics process.ValidatorStatisticsProcessor) Option {
 	return func(n *Node) error {
@@ -724,6 +757,28 @@ func WithPeerSignatureHandler(peerSignatureHandler crypto.PeerSignatureHandler)
 	}
 }
 
+// WithKeysHandler sets up the handler of the additional validator keys the node acts for
+func WithKeysHandler(keysHandler consensus.KeysHandler) Option {
+	return func(n *Node) error {
+		if check.IfNil(keysHandler) {
+			return ErrNilKeysHandler
+		}
+		n.keysHandler = keysHandler
+		return nil
+	}
+}
+
+// WithSigningHandler sets up the handler used to sign the consensus data
+func WithSigningHandler(signingHandler crypto.SigningHandler) Option {
+	return func(n *Node) error {
+		if check.IfNil(signingHandler) {
+			return ErrNilSigningHandler
+		}
+		n.signingHandler = signingHandler
+		return nil
+	}
+}
+
 // WithHistoryRepository sets up a history repository for the node
 func WithHistoryRepository(historyRepo dblookupext.HistoryRepository) Option {
 	return func(n *Node) error {
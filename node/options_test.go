@@ -173,6 +173,32 @@ func TestWithAccountsAdapter_ShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestWithAccountsRecreator_NilRecreatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithAccountsRecreator(nil)
+	err := opt(node)
+
+	assert.Nil(t, node.accountsRecreator)
+	assert.Equal(t, ErrNilAccountsRecreator, err)
+}
+
+func TestWithAccountsRecreator_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	accountsRecreator := &mock.AccountsRecreatorStub{}
+
+	opt := WithAccountsRecreator(accountsRecreator)
+	err := opt(node)
+
+	assert.True(t, node.accountsRecreator == accountsRecreator)
+	assert.Nil(t, err)
+}
+
 func TestWithAddressPubkeyConverter_NilConverterShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -985,6 +1011,31 @@ func TestWithHeaderSigVerifier_OkHeaderIntegrityVerfierShouldWork(t *testing.T)
 	assert.Equal(t, hdrIntVerifier, node.headerIntegrityVerifier)
 }
 
+func TestWithEquivocationDetector_NilEquivocationDetectorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithEquivocationDetector(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilEquivocationDetector, err)
+}
+
+func TestWithEquivocationDetector_OkEquivocationDetectorShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	equivocationDetector := &testscommon.EquivocationDetectorStub{}
+
+	opt := WithEquivocationDetector(equivocationDetector)
+	err := opt(node)
+
+	assert.Nil(t, err)
+	assert.Equal(t, equivocationDetector, node.equivocationDetector)
+}
+
 func TestWithRequestedItemsHandler_OkRequestedItemsHandlerShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -1427,6 +1478,54 @@ func TestWithPeerSignatureHandler_OkPeerSignatureHandlerShouldWork(t *testing.T)
 	assert.Nil(t, err)
 }
 
+func TestWithKeysHandler_NilKeysHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithKeysHandler(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilKeysHandler, err)
+}
+
+func TestWithKeysHandler_OkKeysHandlerShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	keysHandler := &testscommon.KeysHandlerStub{}
+	opt := WithKeysHandler(keysHandler)
+	err := opt(node)
+
+	assert.True(t, node.keysHandler == keysHandler)
+	assert.Nil(t, err)
+}
+
+func TestWithSigningHandler_NilSigningHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithSigningHandler(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilSigningHandler, err)
+}
+
+func TestWithSigningHandler_OkSigningHandlerShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	signingHandler := &testscommon.SigningHandlerStub{}
+	opt := WithSigningHandler(signingHandler)
+	err := opt(node)
+
+	assert.True(t, node.signingHandler == signingHandler)
+	assert.Nil(t, err)
+}
+
 func TestWithSignTxWithHashEpoch_EnableSignTxWithHashEpochShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -1487,3 +1586,27 @@ func TestWithTxVersionChecker_OkTxVersionCheckerShoulWork(t *testing.T) {
 	assert.Equal(t, txVersionChecker, node.txVersionChecker)
 	assert.Nil(t, err)
 }
+
+func TestWithHealthService_NilHealthServiceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithHealthService(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilHealthService, err)
+}
+
+func TestWithHealthService_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	healthService := &mock.HealthServiceStub{}
+	opt := WithHealthService(healthService)
+	err := opt(node)
+
+	assert.Nil(t, err)
+	assert.Equal(t, healthService, node.healthService)
+}
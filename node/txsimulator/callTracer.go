@@ -0,0 +1,249 @@
+package txsimulator
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.SCCallTracer = (*callTracer)(nil)
+
+type callTracer struct {
+	pubkeyConverter core.PubkeyConverter
+
+	mutTrace     sync.Mutex
+	tracedTxHash []byte
+	rootFrames   []*transaction.SimulationCallFrame
+	openFrames   []*transaction.SimulationCallFrame
+	lastRoot     *transaction.SimulationCallFrame
+}
+
+// NewCallTracer creates a tracer able to record the calls executed while simulating a transaction
+func NewCallTracer(pubkeyConverter core.PubkeyConverter) (*callTracer, error) {
+	if check.IfNil(pubkeyConverter) {
+		return nil, node.ErrNilPubkeyConverter
+	}
+
+	return &callTracer{
+		pubkeyConverter: pubkeyConverter,
+	}, nil
+}
+
+// StartTracing starts recording the calls executed on behalf of the transaction with the provided hash
+func (ct *callTracer) StartTracing(txHash []byte) {
+	ct.mutTrace.Lock()
+	ct.tracedTxHash = txHash
+	ct.rootFrames = make([]*transaction.SimulationCallFrame, 0)
+	ct.openFrames = make([]*transaction.SimulationCallFrame, 0)
+	ct.lastRoot = nil
+	ct.mutTrace.Unlock()
+}
+
+// StopTracing stops the recording and returns the recorded call tree
+func (ct *callTracer) StopTracing() []*transaction.SimulationCallFrame {
+	ct.mutTrace.Lock()
+	defer ct.mutTrace.Unlock()
+
+	rootFrames := ct.rootFrames
+	ct.tracedTxHash = nil
+	ct.rootFrames = nil
+	ct.openFrames = nil
+	ct.lastRoot = nil
+
+	return rootFrames
+}
+
+// EnterCall opens a new frame, nested under the frame of the call currently executing, if any
+func (ct *callTracer) EnterCall(originalTxHash []byte, call *process.TracedCall) {
+	if call == nil {
+		return
+	}
+
+	ct.mutTrace.Lock()
+	defer ct.mutTrace.Unlock()
+
+	if !ct.isTracing(originalTxHash) {
+		return
+	}
+
+	frame := &transaction.SimulationCallFrame{
+		CallType:    call.CallType,
+		Sender:      ct.encodeAddress(call.Caller),
+		Receiver:    ct.encodeAddress(call.Recipient),
+		Function:    call.Function,
+		Value:       copyValue(call.Value),
+		GasProvided: call.GasProvided,
+	}
+
+	numOpenFrames := len(ct.openFrames)
+	if numOpenFrames == 0 {
+		ct.rootFrames = append(ct.rootFrames, frame)
+	} else {
+		parent := ct.openFrames[numOpenFrames-1]
+		parent.Calls = append(parent.Calls, frame)
+	}
+	ct.openFrames = append(ct.openFrames, frame)
+}
+
+// ExitCall closes the frame of the call currently executing, filling in its outcome
+func (ct *callTracer) ExitCall(originalTxHash []byte, vmOutput *vmcommon.VMOutput, err error) {
+	ct.mutTrace.Lock()
+	defer ct.mutTrace.Unlock()
+
+	numOpenFrames := len(ct.openFrames)
+	if !ct.isTracing(originalTxHash) || numOpenFrames == 0 {
+		return
+	}
+
+	frame := ct.openFrames[numOpenFrames-1]
+	ct.openFrames = ct.openFrames[:numOpenFrames-1]
+	if len(ct.openFrames) == 0 {
+		ct.lastRoot = frame
+	}
+
+	if err != nil {
+		frame.Error = err.Error()
+	}
+	if vmOutput == nil {
+		return
+	}
+
+	frame.ReturnCode = vmOutput.ReturnCode.String()
+	frame.ReturnMessage = vmOutput.ReturnMessage
+	if frame.GasProvided > vmOutput.GasRemaining {
+		frame.GasUsed = frame.GasProvided - vmOutput.GasRemaining
+	}
+	frame.Logs = append(frame.Logs, adaptLogEntries(ct.pubkeyConverter, vmOutput.Logs)...)
+	frame.Calls = append(frame.Calls, ct.createScheduledCalls(frame.Receiver, vmOutput)...)
+}
+
+// RecordStorageWrite records a storage update applied on the state. It is attached to the frame of the
+// call currently executing or, if the update is applied after the call returned, to the last finished call
+func (ct *callTracer) RecordStorageWrite(originalTxHash []byte, address []byte, key []byte, oldValue []byte, newValue []byte) {
+	ct.mutTrace.Lock()
+	defer ct.mutTrace.Unlock()
+
+	if !ct.isTracing(originalTxHash) {
+		return
+	}
+
+	frame := ct.lastRoot
+	numOpenFrames := len(ct.openFrames)
+	if numOpenFrames > 0 {
+		frame = ct.openFrames[numOpenFrames-1]
+	}
+	if frame == nil {
+		return
+	}
+
+	frame.StorageWrites = append(frame.StorageWrites, &transaction.SimulationStorageWrite{
+		Address:  ct.encodeAddress(address),
+		Key:      hex.EncodeToString(key),
+		OldValue: hex.EncodeToString(oldValue),
+		NewValue: hex.EncodeToString(newValue),
+	})
+}
+
+// IsTracing returns true if the calls originated by the provided transaction hash are recorded
+func (ct *callTracer) IsTracing(originalTxHash []byte) bool {
+	ct.mutTrace.Lock()
+	defer ct.mutTrace.Unlock()
+
+	return ct.isTracing(originalTxHash)
+}
+
+func (ct *callTracer) isTracing(originalTxHash []byte) bool {
+	return len(ct.tracedTxHash) > 0 && bytes.Equal(ct.tracedTxHash, originalTxHash)
+}
+
+// createScheduledCalls creates the frames of the calls a contract requested through its output transfers. These
+// are executed later on, as smart contract results, so only their input is known at this point
+func (ct *callTracer) createScheduledCalls(sender string, vmOutput *vmcommon.VMOutput) []*transaction.SimulationCallFrame {
+	scheduledCalls := make([]*transaction.SimulationCallFrame, 0)
+	outputAccounts := make([]*vmcommon.OutputAccount, 0, len(vmOutput.OutputAccounts))
+	for _, outAcc := range vmOutput.OutputAccounts {
+		outputAccounts = append(outputAccounts, outAcc)
+	}
+	sort.Slice(outputAccounts, func(i, j int) bool {
+		return bytes.Compare(outputAccounts[i].Address, outputAccounts[j].Address) < 0
+	})
+
+	for _, outAcc := range outputAccounts {
+		for _, outTransfer := range outAcc.OutputTransfers {
+			isCall := len(outTransfer.Data) > 0 || outTransfer.CallType != vmcommon.DirectCall
+			if !isCall {
+				continue
+			}
+
+			scheduledCalls = append(scheduledCalls, &transaction.SimulationCallFrame{
+				CallType:    process.GetTracedCallType(outTransfer.CallType),
+				Sender:      sender,
+				Receiver:    ct.encodeAddress(outAcc.Address),
+				Function:    strings.Split(string(outTransfer.Data), "@")[0],
+				Value:       copyValue(outTransfer.Value),
+				GasProvided: outTransfer.GasLimit,
+				Scheduled:   true,
+			})
+		}
+	}
+
+	return scheduledCalls
+}
+
+func (ct *callTracer) encodeAddress(address []byte) string {
+	return encodeAddress(ct.pubkeyConverter, address)
+}
+
+func encodeAddress(pubkeyConverter core.PubkeyConverter, address []byte) string {
+	if len(address) == 0 {
+		return ""
+	}
+
+	return pubkeyConverter.Encode(address)
+}
+
+func adaptLogEntries(pubkeyConverter core.PubkeyConverter, logs []*vmcommon.LogEntry) []*transaction.SimulationLogEvent {
+	events := make([]*transaction.SimulationLogEvent, 0, len(logs))
+	for _, logEntry := range logs {
+		if logEntry == nil {
+			continue
+		}
+
+		topics := make([]string, 0, len(logEntry.Topics))
+		for _, topic := range logEntry.Topics {
+			topics = append(topics, hex.EncodeToString(topic))
+		}
+
+		events = append(events, &transaction.SimulationLogEvent{
+			Address:    encodeAddress(pubkeyConverter, logEntry.Address),
+			Identifier: string(logEntry.Identifier),
+			Topics:     topics,
+			Data:       hex.EncodeToString(logEntry.Data),
+		})
+	}
+
+	return events
+}
+
+func copyValue(value *big.Int) *big.Int {
+	if value == nil {
+		return nil
+	}
+
+	return big.NewInt(0).Set(value)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ct *callTracer) IsInterfaceNil() bool {
+	return ct == nil
+}
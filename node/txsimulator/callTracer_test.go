@@ -0,0 +1,118 @@
+package txsimulator
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCallTracer(t *testing.T) {
+	t.Parallel()
+
+	tracer, err := NewCallTracer(nil)
+	assert.True(t, check.IfNil(tracer))
+	assert.Equal(t, node.ErrNilPubkeyConverter, err)
+
+	tracer, err = NewCallTracer(&mock.PubkeyConverterMock{})
+	assert.False(t, check.IfNil(tracer))
+	assert.Nil(t, err)
+}
+
+func TestCallTracer_ShouldIgnoreCallsOfOtherTransactions(t *testing.T) {
+	t.Parallel()
+
+	tracer, _ := NewCallTracer(&mock.PubkeyConverterMock{})
+
+	tracer.EnterCall([]byte("txHash"), &process.TracedCall{Function: "notTraced"})
+	tracer.ExitCall([]byte("txHash"), &vmcommon.VMOutput{}, nil)
+
+	tracer.StartTracing([]byte("txHash"))
+	tracer.EnterCall([]byte("otherTxHash"), &process.TracedCall{Function: "otherTx"})
+	tracer.ExitCall([]byte("otherTxHash"), &vmcommon.VMOutput{}, nil)
+	tracer.RecordStorageWrite([]byte("otherTxHash"), []byte("sc"), []byte("key"), nil, []byte("value"))
+
+	assert.Len(t, tracer.StopTracing(), 0)
+}
+
+func TestCallTracer_ShouldBuildTheCallTree(t *testing.T) {
+	t.Parallel()
+
+	txHash := []byte("txHash")
+	tracer, _ := NewCallTracer(&mock.PubkeyConverterMock{})
+	tracer.StartTracing(txHash)
+
+	tracer.EnterCall(txHash, &process.TracedCall{
+		CallType:    process.TracedCallTypeDirect,
+		Caller:      []byte("alice"),
+		Recipient:   []byte("sc"),
+		Function:    "swap",
+		Value:       big.NewInt(10),
+		GasProvided: 1000,
+	})
+	tracer.EnterCall(txHash, &process.TracedCall{
+		CallType:    process.TracedCallTypeBuiltInFunction,
+		Caller:      []byte("sc"),
+		Recipient:   []byte("bob"),
+		Function:    "ESDTTransfer",
+		GasProvided: 300,
+	})
+	tracer.ExitCall(txHash, nil, errors.New("insufficient funds"))
+	tracer.ExitCall(txHash, &vmcommon.VMOutput{
+		ReturnCode:    vmcommon.UserError,
+		ReturnMessage: "swap failed",
+		GasRemaining:  400,
+		Logs: []*vmcommon.LogEntry{
+			{Identifier: []byte("swapFailed"), Address: []byte("sc"), Topics: [][]byte{[]byte("topic")}, Data: []byte("data")},
+		},
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			"carol": {
+				Address: []byte("carol"),
+				OutputTransfers: []vmcommon.OutputTransfer{
+					{Value: big.NewInt(0), GasLimit: 100},
+					{Value: big.NewInt(1), GasLimit: 200, Data: []byte("deposit@01"), CallType: vmcommon.AsynchronousCall},
+				},
+			},
+		},
+	}, nil)
+	tracer.RecordStorageWrite(txHash, []byte("sc"), []byte("key"), []byte("old"), []byte("new"))
+
+	rootFrames := tracer.StopTracing()
+	require.Len(t, rootFrames, 1)
+
+	root := rootFrames[0]
+	assert.Equal(t, process.TracedCallTypeDirect, root.CallType)
+	assert.Equal(t, "616c696365", root.Sender)
+	assert.Equal(t, "swap", root.Function)
+	assert.Equal(t, big.NewInt(10), root.Value)
+	assert.Equal(t, uint64(600), root.GasUsed)
+	assert.Equal(t, vmcommon.UserError.String(), root.ReturnCode)
+	assert.Equal(t, "swap failed", root.ReturnMessage)
+
+	require.Len(t, root.Logs, 1)
+	assert.Equal(t, "swapFailed", root.Logs[0].Identifier)
+	assert.Equal(t, []string{"746f706963"}, root.Logs[0].Topics)
+
+	require.Len(t, root.StorageWrites, 1)
+	assert.Equal(t, "6b6579", root.StorageWrites[0].Key)
+	assert.Equal(t, "6f6c64", root.StorageWrites[0].OldValue)
+	assert.Equal(t, "6e6577", root.StorageWrites[0].NewValue)
+
+	require.Len(t, root.Calls, 2)
+	assert.Equal(t, "ESDTTransfer", root.Calls[0].Function)
+	assert.Equal(t, "insufficient funds", root.Calls[0].Error)
+	assert.False(t, root.Calls[0].Scheduled)
+	assert.Equal(t, process.TracedCallTypeAsync, root.Calls[1].CallType)
+	assert.Equal(t, "deposit", root.Calls[1].Function)
+	assert.Equal(t, uint64(200), root.Calls[1].GasProvided)
+	assert.True(t, root.Calls[1].Scheduled)
+
+	assert.Len(t, tracer.StopTracing(), 0)
+}
@@ -1,12 +1,35 @@
 package txsimulator
 
 import (
+	"math/big"
+
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 )
 
+// CallTracer defines the component that records the calls executed while simulating a transaction
+type CallTracer interface {
+	StartTracing(txHash []byte)
+	StopTracing() []*transaction.SimulationCallFrame
+	IsInterfaceNil() bool
+}
+
 // TransactionProcessor defines the operations needed do be done by a transaction processor
 type TransactionProcessor interface {
 	ProcessTransaction(transaction *transaction.Transaction) (vmcommon.ReturnCode, error)
 	IsInterfaceNil() bool
 }
+
+// SimulationAccountsHandler defines the accounts on top of which transactions are simulated. The changes are kept
+// in memory until Reset is called
+type SimulationAccountsHandler interface {
+	Reset()
+	GetBalanceDeltas() (map[string]*big.Int, error)
+	IsInterfaceNil() bool
+}
+
+// TxLogsCollector defines the component that collects the logs generated while simulating transactions
+type TxLogsCollector interface {
+	GetCollectedLogs() []*transaction.SimulationLogEvent
+	IsInterfaceNil() bool
+}
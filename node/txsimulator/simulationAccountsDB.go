@@ -0,0 +1,307 @@
+package txsimulator
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/node"
+)
+
+type simulationJournalEntry struct {
+	address []byte
+	account state.AccountHandler
+	found   bool
+}
+
+// simulationAccountsDB is a wrapper over an accounts db which never writes into the original accounts. The saved
+// accounts are kept in memory, so the following reads see them, until Reset is called
+type simulationAccountsDB struct {
+	originalAccounts state.AccountsAdapter
+
+	mutAccounts sync.RWMutex
+	accounts    map[string]state.AccountHandler
+	journal     []*simulationJournalEntry
+}
+
+// NewSimulationAccountsDB returns a new instance of simulationAccountsDB
+func NewSimulationAccountsDB(accountsDB state.AccountsAdapter) (*simulationAccountsDB, error) {
+	if check.IfNil(accountsDB) {
+		return nil, node.ErrNilAccountsAdapter
+	}
+
+	return &simulationAccountsDB{
+		originalAccounts: accountsDB,
+		accounts:         make(map[string]state.AccountHandler),
+		journal:          make([]*simulationJournalEntry, 0),
+	}, nil
+}
+
+// GetExistingAccount returns the account as it was last saved during the simulation, or the original one
+func (s *simulationAccountsDB) GetExistingAccount(address []byte) (state.AccountHandler, error) {
+	s.mutAccounts.RLock()
+	account, found := s.accounts[string(address)]
+	s.mutAccounts.RUnlock()
+
+	if !found {
+		return s.originalAccounts.GetExistingAccount(address)
+	}
+	if check.IfNil(account) {
+		return nil, state.ErrAccNotFound
+	}
+
+	return cloneAccount(account)
+}
+
+// LoadAccount returns the account as it was last saved during the simulation, or the original one
+func (s *simulationAccountsDB) LoadAccount(address []byte) (state.AccountHandler, error) {
+	s.mutAccounts.RLock()
+	account, found := s.accounts[string(address)]
+	s.mutAccounts.RUnlock()
+
+	if !found {
+		return s.originalAccounts.LoadAccount(address)
+	}
+	if check.IfNil(account) {
+		return state.NewUserAccount(address)
+	}
+
+	return cloneAccount(account)
+}
+
+// SaveAccount keeps a copy of the provided account in memory
+func (s *simulationAccountsDB) SaveAccount(account state.AccountHandler) error {
+	if check.IfNil(account) {
+		return state.ErrNilAccountHandler
+	}
+
+	savedAccount, err := cloneAccount(account)
+	if err != nil {
+		return err
+	}
+
+	s.mutAccounts.Lock()
+	s.journalize(account.AddressBytes())
+	s.accounts[string(account.AddressBytes())] = savedAccount
+	s.mutAccounts.Unlock()
+
+	return nil
+}
+
+// RemoveAccount marks the account as removed, in memory
+func (s *simulationAccountsDB) RemoveAccount(address []byte) error {
+	if len(address) == 0 {
+		return state.ErrNilAddress
+	}
+
+	s.mutAccounts.Lock()
+	s.journalize(address)
+	s.accounts[string(address)] = nil
+	s.mutAccounts.Unlock()
+
+	return nil
+}
+
+func (s *simulationAccountsDB) journalize(address []byte) {
+	previousAccount, found := s.accounts[string(address)]
+	s.journal = append(s.journal, &simulationJournalEntry{
+		address: address,
+		account: previousAccount,
+		found:   found,
+	})
+}
+
+// Commit won't do anything as write operations on the original accounts are disabled on this component
+func (s *simulationAccountsDB) Commit() ([]byte, error) {
+	return nil, nil
+}
+
+// JournalLen returns the number of changes done during the simulation
+func (s *simulationAccountsDB) JournalLen() int {
+	s.mutAccounts.RLock()
+	defer s.mutAccounts.RUnlock()
+
+	return len(s.journal)
+}
+
+// RevertToSnapshot reverts the changes done during the simulation, up to the provided snapshot
+func (s *simulationAccountsDB) RevertToSnapshot(snapshot int) error {
+	s.mutAccounts.Lock()
+	defer s.mutAccounts.Unlock()
+
+	if snapshot > len(s.journal) || snapshot < 0 {
+		return state.ErrSnapshotValueOutOfBounds
+	}
+
+	for i := len(s.journal) - 1; i >= snapshot; i-- {
+		entry := s.journal[i]
+		if !entry.found {
+			delete(s.accounts, string(entry.address))
+			continue
+		}
+
+		s.accounts[string(entry.address)] = entry.account
+	}
+	s.journal = s.journal[:snapshot]
+
+	return nil
+}
+
+// Reset discards all the changes done during the simulation
+func (s *simulationAccountsDB) Reset() {
+	s.mutAccounts.Lock()
+	s.accounts = make(map[string]state.AccountHandler)
+	s.journal = make([]*simulationJournalEntry, 0)
+	s.mutAccounts.Unlock()
+}
+
+// GetBalanceDeltas returns the balance changes of the accounts modified during the simulation
+func (s *simulationAccountsDB) GetBalanceDeltas() (map[string]*big.Int, error) {
+	s.mutAccounts.RLock()
+	defer s.mutAccounts.RUnlock()
+
+	balanceDeltas := make(map[string]*big.Int)
+	for address, account := range s.accounts {
+		originalBalance, err := s.getOriginalBalance([]byte(address))
+		if err != nil {
+			return nil, err
+		}
+
+		delta := big.NewInt(0).Sub(getBalance(account), originalBalance)
+		if delta.Sign() == 0 {
+			continue
+		}
+
+		balanceDeltas[address] = delta
+	}
+
+	return balanceDeltas, nil
+}
+
+func (s *simulationAccountsDB) getOriginalBalance(address []byte) (*big.Int, error) {
+	account, err := s.originalAccounts.GetExistingAccount(address)
+	if errors.Is(err, state.ErrAccNotFound) {
+		return big.NewInt(0), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return getBalance(account), nil
+}
+
+func getBalance(account state.AccountHandler) *big.Int {
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok || check.IfNil(userAccount) || userAccount.GetBalance() == nil {
+		return big.NewInt(0)
+	}
+
+	return userAccount.GetBalance()
+}
+
+// cloneAccount copies a user account, along with its code and its not yet committed data, so that the changes done
+// on the copy do not reach the saved account unless the copy is saved as well
+func cloneAccount(account state.AccountHandler) (state.AccountHandler, error) {
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return account, nil
+	}
+
+	clone, err := state.NewUserAccount(userAccount.AddressBytes())
+	if err != nil {
+		return nil, err
+	}
+
+	clone.IncreaseNonce(userAccount.GetNonce())
+	err = clone.AddToBalance(getBalance(userAccount))
+	if err != nil {
+		return nil, err
+	}
+	if userAccount.GetDeveloperReward() != nil {
+		clone.AddToDeveloperReward(userAccount.GetDeveloperReward())
+	}
+	clone.SetCode(userAccount.GetCode())
+	clone.SetCodeHash(userAccount.GetCodeHash())
+	clone.SetCodeMetadata(userAccount.GetCodeMetadata())
+	clone.SetRootHash(userAccount.GetRootHash())
+	clone.SetOwnerAddress(userAccount.GetOwnerAddress())
+	clone.SetUserName(userAccount.GetUserName())
+
+	dataTrieTracker := userAccount.DataTrieTracker()
+	if check.IfNil(dataTrieTracker) {
+		return clone, nil
+	}
+
+	clone.SetDataTrie(dataTrieTracker.DataTrie())
+	for key, value := range dataTrieTracker.DirtyData() {
+		if len(value) > 0 {
+			value, err = dataTrieTracker.RetrieveValue([]byte(key))
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		err = clone.DataTrieTracker().SaveKeyValue([]byte(key), value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return clone, nil
+}
+
+// RootHash will call the original accounts' function with the same name
+func (s *simulationAccountsDB) RootHash() ([]byte, error) {
+	return s.originalAccounts.RootHash()
+}
+
+// GetNumCheckpoints will call the original accounts' function with the same name
+func (s *simulationAccountsDB) GetNumCheckpoints() uint32 {
+	return s.originalAccounts.GetNumCheckpoints()
+}
+
+// RecreateTrie won't do anything as write operations on the original accounts are disabled on this component
+func (s *simulationAccountsDB) RecreateTrie(_ []byte) error {
+	return nil
+}
+
+// PruneTrie won't do anything as write operations on the original accounts are disabled on this component
+func (s *simulationAccountsDB) PruneTrie(_ []byte, _ data.TriePruningIdentifier) {
+}
+
+// CancelPrune won't do anything as write operations on the original accounts are disabled on this component
+func (s *simulationAccountsDB) CancelPrune(_ []byte, _ data.TriePruningIdentifier) {
+}
+
+// SnapshotState won't do anything as write operations on the original accounts are disabled on this component
+func (s *simulationAccountsDB) SnapshotState(_ []byte, _ context.Context) {
+}
+
+// SetStateCheckpoint won't do anything as write operations on the original accounts are disabled on this component
+func (s *simulationAccountsDB) SetStateCheckpoint(_ []byte, _ context.Context) {
+}
+
+// IsPruningEnabled will call the original accounts' function with the same name
+func (s *simulationAccountsDB) IsPruningEnabled() bool {
+	return s.originalAccounts.IsPruningEnabled()
+}
+
+// GetAllLeaves will call the original accounts' function with the same name
+func (s *simulationAccountsDB) GetAllLeaves(rootHash []byte, ctx context.Context) (chan core.KeyValueHolder, error) {
+	return s.originalAccounts.GetAllLeaves(rootHash, ctx)
+}
+
+// RecreateAllTries won't do anything as write operations on the original accounts are disabled on this component
+func (s *simulationAccountsDB) RecreateAllTries(_ []byte, _ context.Context) (map[string]data.Trie, error) {
+	return nil, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *simulationAccountsDB) IsInterfaceNil() bool {
+	return s == nil
+}
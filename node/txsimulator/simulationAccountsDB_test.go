@@ -0,0 +1,228 @@
+package txsimulator
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createAccountsStubWithBalances(balances map[string]int64) *mock.AccountsStub {
+	getAccount := func(address []byte) (state.AccountHandler, error) {
+		balance, ok := balances[string(address)]
+		if !ok {
+			return nil, state.ErrAccNotFound
+		}
+
+		account, _ := state.NewUserAccount(address)
+		_ = account.AddToBalance(big.NewInt(balance))
+
+		return account, nil
+	}
+
+	return &mock.AccountsStub{
+		GetExistingAccountCalled: getAccount,
+		LoadAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			account, err := getAccount(address)
+			if err != nil {
+				return state.NewUserAccount(address)
+			}
+
+			return account, nil
+		},
+	}
+}
+
+func loadUserAccount(t *testing.T, accounts state.AccountsAdapter, address []byte) state.UserAccountHandler {
+	account, err := accounts.LoadAccount(address)
+	require.NoError(t, err)
+
+	return account.(state.UserAccountHandler)
+}
+
+func TestNewSimulationAccountsDB_NilOriginalAccountsDBShouldErr(t *testing.T) {
+	t.Parallel()
+
+	simAccDb, err := NewSimulationAccountsDB(nil)
+	require.True(t, check.IfNil(simAccDb))
+	require.Equal(t, node.ErrNilAccountsAdapter, err)
+}
+
+func TestNewSimulationAccountsDB(t *testing.T) {
+	t.Parallel()
+
+	simAccDb, err := NewSimulationAccountsDB(&mock.AccountsStub{})
+	require.False(t, check.IfNil(simAccDb))
+	require.NoError(t, err)
+}
+
+func TestSimulationAccountsDB_WriteOperationsShouldNotReachTheOriginalAccounts(t *testing.T) {
+	t.Parallel()
+
+	failErrMsg := "this function should have not be called"
+	accDb := createAccountsStubWithBalances(map[string]int64{"alice": 10})
+	accDb.SaveAccountCalled = func(account state.AccountHandler) error {
+		t.Errorf(failErrMsg)
+		return nil
+	}
+	accDb.RemoveAccountCalled = func(_ []byte) error {
+		t.Errorf(failErrMsg)
+		return nil
+	}
+	accDb.CommitCalled = func() ([]byte, error) {
+		t.Errorf(failErrMsg)
+		return nil, nil
+	}
+	accDb.RevertToSnapshotCalled = func(_ int) error {
+		t.Errorf(failErrMsg)
+		return nil
+	}
+	accDb.RecreateTrieCalled = func(_ []byte) error {
+		t.Errorf(failErrMsg)
+		return nil
+	}
+	accDb.PruneTrieCalled = func(_ []byte, _ data.TriePruningIdentifier) {
+		t.Errorf(failErrMsg)
+	}
+
+	simAccDb, _ := NewSimulationAccountsDB(accDb)
+
+	account := loadUserAccount(t, simAccDb, []byte("alice"))
+	err := simAccDb.SaveAccount(account)
+	require.NoError(t, err)
+
+	err = simAccDb.RemoveAccount([]byte("alice"))
+	require.NoError(t, err)
+
+	_, err = simAccDb.Commit()
+	require.NoError(t, err)
+
+	err = simAccDb.RevertToSnapshot(0)
+	require.NoError(t, err)
+
+	err = simAccDb.RecreateTrie(nil)
+	require.NoError(t, err)
+
+	simAccDb.PruneTrie(nil, data.NewRoot)
+}
+
+func TestSimulationAccountsDB_SavedAccountsShouldBeSeenByTheFollowingReads(t *testing.T) {
+	t.Parallel()
+
+	simAccDb, _ := NewSimulationAccountsDB(createAccountsStubWithBalances(map[string]int64{"alice": 10}))
+
+	alice := loadUserAccount(t, simAccDb, []byte("alice"))
+	_ = alice.SubFromBalance(big.NewInt(3))
+	alice.IncreaseNonce(1)
+	_ = alice.DataTrieTracker().SaveKeyValue([]byte("key"), []byte("value"))
+	err := simAccDb.SaveAccount(alice)
+	require.NoError(t, err)
+
+	bob := loadUserAccount(t, simAccDb, []byte("bob"))
+	_ = bob.AddToBalance(big.NewInt(3))
+	bob.SetCode([]byte("code"))
+	err = simAccDb.SaveAccount(bob)
+	require.NoError(t, err)
+
+	alice = loadUserAccount(t, simAccDb, []byte("alice"))
+	require.Equal(t, big.NewInt(7), alice.GetBalance())
+	require.Equal(t, uint64(1), alice.GetNonce())
+	value, err := alice.DataTrieTracker().RetrieveValue([]byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+
+	existingBob, err := simAccDb.GetExistingAccount([]byte("bob"))
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(3), existingBob.(state.UserAccountHandler).GetBalance())
+	require.Equal(t, []byte("code"), existingBob.(state.UserAccountHandler).GetCode())
+}
+
+func TestSimulationAccountsDB_NotSavedChangesShouldNotBeSeen(t *testing.T) {
+	t.Parallel()
+
+	simAccDb, _ := NewSimulationAccountsDB(createAccountsStubWithBalances(map[string]int64{"alice": 10}))
+
+	alice := loadUserAccount(t, simAccDb, []byte("alice"))
+	_ = alice.SubFromBalance(big.NewInt(3))
+	_ = simAccDb.SaveAccount(alice)
+
+	_ = alice.SubFromBalance(big.NewInt(3))
+	alice = loadUserAccount(t, simAccDb, []byte("alice"))
+	_ = alice.SubFromBalance(big.NewInt(1))
+
+	alice = loadUserAccount(t, simAccDb, []byte("alice"))
+	require.Equal(t, big.NewInt(7), alice.GetBalance())
+}
+
+func TestSimulationAccountsDB_RevertToSnapshotShouldRestoreTheSavedAccounts(t *testing.T) {
+	t.Parallel()
+
+	simAccDb, _ := NewSimulationAccountsDB(createAccountsStubWithBalances(map[string]int64{"alice": 10}))
+
+	alice := loadUserAccount(t, simAccDb, []byte("alice"))
+	_ = alice.SubFromBalance(big.NewInt(3))
+	_ = simAccDb.SaveAccount(alice)
+
+	snapshot := simAccDb.JournalLen()
+	require.Equal(t, 1, snapshot)
+
+	alice = loadUserAccount(t, simAccDb, []byte("alice"))
+	_ = alice.SubFromBalance(big.NewInt(5))
+	_ = simAccDb.SaveAccount(alice)
+	bob := loadUserAccount(t, simAccDb, []byte("bob"))
+	_ = bob.AddToBalance(big.NewInt(5))
+	_ = simAccDb.SaveAccount(bob)
+	_ = simAccDb.RemoveAccount([]byte("alice"))
+
+	_, err := simAccDb.GetExistingAccount([]byte("alice"))
+	require.Equal(t, state.ErrAccNotFound, err)
+
+	err = simAccDb.RevertToSnapshot(snapshot + 10)
+	require.Equal(t, state.ErrSnapshotValueOutOfBounds, err)
+
+	err = simAccDb.RevertToSnapshot(snapshot)
+	require.NoError(t, err)
+	require.Equal(t, snapshot, simAccDb.JournalLen())
+
+	alice = loadUserAccount(t, simAccDb, []byte("alice"))
+	require.Equal(t, big.NewInt(7), alice.GetBalance())
+	_, err = simAccDb.GetExistingAccount([]byte("bob"))
+	require.Equal(t, state.ErrAccNotFound, err)
+}
+
+func TestSimulationAccountsDB_GetBalanceDeltasAndReset(t *testing.T) {
+	t.Parallel()
+
+	simAccDb, _ := NewSimulationAccountsDB(createAccountsStubWithBalances(map[string]int64{"alice": 10, "carol": 1}))
+
+	alice := loadUserAccount(t, simAccDb, []byte("alice"))
+	_ = alice.SubFromBalance(big.NewInt(4))
+	_ = simAccDb.SaveAccount(alice)
+	bob := loadUserAccount(t, simAccDb, []byte("bob"))
+	_ = bob.AddToBalance(big.NewInt(4))
+	_ = simAccDb.SaveAccount(bob)
+	carol := loadUserAccount(t, simAccDb, []byte("carol"))
+	carol.IncreaseNonce(1)
+	_ = simAccDb.SaveAccount(carol)
+
+	balanceDeltas, err := simAccDb.GetBalanceDeltas()
+	require.NoError(t, err)
+	require.Equal(t, map[string]*big.Int{
+		"alice": big.NewInt(-4),
+		"bob":   big.NewInt(4),
+	}, balanceDeltas)
+
+	simAccDb.Reset()
+
+	require.Equal(t, 0, simAccDb.JournalLen())
+	alice = loadUserAccount(t, simAccDb, []byte("alice"))
+	require.Equal(t, big.NewInt(10), alice.GetBalance())
+	balanceDeltas, err = simAccDb.GetBalanceDeltas()
+	require.NoError(t, err)
+	require.Empty(t, balanceDeltas)
+}
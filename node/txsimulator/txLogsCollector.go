@@ -0,0 +1,72 @@
+package txsimulator
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.TransactionLogProcessor = (*txLogsCollector)(nil)
+
+// txLogsCollector keeps in memory the logs generated while simulating transactions, instead of saving them
+type txLogsCollector struct {
+	pubkeyConverter core.PubkeyConverter
+
+	mutLogs sync.Mutex
+	logs    []*transaction.SimulationLogEvent
+}
+
+// NewTxLogsCollector creates a new instance of txLogsCollector
+func NewTxLogsCollector(pubkeyConverter core.PubkeyConverter) (*txLogsCollector, error) {
+	if check.IfNil(pubkeyConverter) {
+		return nil, node.ErrNilPubkeyConverter
+	}
+
+	return &txLogsCollector{
+		pubkeyConverter: pubkeyConverter,
+		logs:            make([]*transaction.SimulationLogEvent, 0),
+	}, nil
+}
+
+// GetLog returns an error as the collected logs are only available through GetCollectedLogs
+func (tlc *txLogsCollector) GetLog(_ []byte) (data.LogHandler, error) {
+	return nil, process.ErrLogNotFound
+}
+
+// SaveLog appends the provided log entries to the collected ones
+func (tlc *txLogsCollector) SaveLog(txHash []byte, tx data.TransactionHandler, logEntries []*vmcommon.LogEntry) error {
+	if len(txHash) == 0 {
+		return process.ErrNilTxHash
+	}
+	if check.IfNil(tx) {
+		return process.ErrNilTransaction
+	}
+
+	tlc.mutLogs.Lock()
+	tlc.logs = append(tlc.logs, adaptLogEntries(tlc.pubkeyConverter, logEntries)...)
+	tlc.mutLogs.Unlock()
+
+	return nil
+}
+
+// GetCollectedLogs returns the logs collected since the last call and clears them
+func (tlc *txLogsCollector) GetCollectedLogs() []*transaction.SimulationLogEvent {
+	tlc.mutLogs.Lock()
+	defer tlc.mutLogs.Unlock()
+
+	logs := tlc.logs
+	tlc.logs = make([]*transaction.SimulationLogEvent, 0)
+
+	return logs
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tlc *txLogsCollector) IsInterfaceNil() bool {
+	return tlc == nil
+}
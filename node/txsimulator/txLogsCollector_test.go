@@ -0,0 +1,57 @@
+package txsimulator
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTxLogsCollector_NilPubkeyConverterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tlc, err := NewTxLogsCollector(nil)
+	require.True(t, check.IfNil(tlc))
+	require.Equal(t, node.ErrNilPubkeyConverter, err)
+}
+
+func TestTxLogsCollector_SaveLogInvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tlc, _ := NewTxLogsCollector(&mock.PubkeyConverterMock{})
+
+	err := tlc.SaveLog(nil, &transaction.Transaction{}, nil)
+	require.Equal(t, process.ErrNilTxHash, err)
+
+	err = tlc.SaveLog([]byte("txHash"), nil, nil)
+	require.Equal(t, process.ErrNilTransaction, err)
+}
+
+func TestTxLogsCollector_GetCollectedLogsShouldReturnAndClearTheLogs(t *testing.T) {
+	t.Parallel()
+
+	tlc, _ := NewTxLogsCollector(&mock.PubkeyConverterMock{})
+
+	_ = tlc.SaveLog([]byte("txHash1"), &transaction.Transaction{}, []*vmcommon.LogEntry{
+		{Identifier: []byte("first"), Address: []byte("addr"), Topics: [][]byte{[]byte("t")}, Data: []byte("d")},
+	})
+	_ = tlc.SaveLog([]byte("txHash2"), &transaction.Transaction{}, []*vmcommon.LogEntry{
+		{Identifier: []byte("second"), Address: []byte("addr")},
+	})
+
+	logs := tlc.GetCollectedLogs()
+	require.Equal(t, []*transaction.SimulationLogEvent{
+		{Address: "61646472", Identifier: "first", Topics: []string{"74"}, Data: "64"},
+		{Address: "61646472", Identifier: "second", Topics: []string{}, Data: ""},
+	}, logs)
+
+	require.Empty(t, tlc.GetCollectedLogs())
+
+	_, err := tlc.GetLog([]byte("txHash1"))
+	require.Equal(t, process.ErrLogNotFound, err)
+}
@@ -2,6 +2,8 @@ package txsimulator
 
 import (
 	"encoding/hex"
+	"math/big"
+	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
@@ -10,6 +12,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/receipt"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/node"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -21,13 +25,24 @@ type ArgsTxSimulator struct {
 	IntermmediateProcContainer process.IntermediateProcessorContainer
 	AddressPubKeyConverter     core.PubkeyConverter
 	ShardCoordinator           sharding.Coordinator
+	CallTracer                 CallTracer
+	SimulationAccounts         SimulationAccountsHandler
+	TxLogsCollector            TxLogsCollector
+	Marshalizer                marshal.Marshalizer
+	Hasher                     hashing.Hasher
 }
 
 type transactionSimulator struct {
+	mutSimulation          sync.Mutex
 	txProcessor            TransactionProcessor
 	intermProcContainer    process.IntermediateProcessorContainer
 	addressPubKeyConverter core.PubkeyConverter
 	shardCoordinator       sharding.Coordinator
+	callTracer             CallTracer
+	simulationAccounts     SimulationAccountsHandler
+	txLogsCollector        TxLogsCollector
+	marshalizer            marshal.Marshalizer
+	hasher                 hashing.Hasher
 }
 
 // NewTransactionSimulator returns a new instance of a transactionSimulator
@@ -44,17 +59,109 @@ func NewTransactionSimulator(args ArgsTxSimulator) (*transactionSimulator, error
 	if check.IfNil(args.ShardCoordinator) {
 		return nil, node.ErrNilShardCoordinator
 	}
+	if check.IfNil(args.CallTracer) {
+		return nil, node.ErrNilCallTracer
+	}
+	if check.IfNil(args.SimulationAccounts) {
+		return nil, node.ErrNilSimulationAccounts
+	}
+	if check.IfNil(args.TxLogsCollector) {
+		return nil, node.ErrNilTxLogsCollector
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, node.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, node.ErrNilHasher
+	}
 
 	return &transactionSimulator{
 		txProcessor:            args.TransactionProcessor,
 		intermProcContainer:    args.IntermmediateProcContainer,
 		addressPubKeyConverter: args.AddressPubKeyConverter,
 		shardCoordinator:       args.ShardCoordinator,
+		callTracer:             args.CallTracer,
+		simulationAccounts:     args.SimulationAccounts,
+		txLogsCollector:        args.TxLogsCollector,
+		marshalizer:            args.Marshalizer,
+		hasher:                 args.Hasher,
 	}, nil
 }
 
-// ProcessTx will process the transaction in a special environment, where state-writing is not allowed
-func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction) (*transaction.SimulationResults, error) {
+// ProcessTx will process the transaction in a special environment, where state-writing is not allowed. If withTrace
+// is set, the results will also hold the tree of calls executed on behalf of the transaction
+func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction, withTrace bool) (*transaction.SimulationResults, error) {
+	ts.mutSimulation.Lock()
+	defer ts.mutSimulation.Unlock()
+
+	ts.simulationAccounts.Reset()
+	defer ts.simulationAccounts.Reset()
+
+	return ts.simulateTx(tx, withTrace)
+}
+
+// ProcessTxs will process the transactions one after another in a special environment, where state-writing is not
+// allowed. Each transaction sees the state changes done by the previous ones, so the returned balance deltas reflect
+// the cumulated effect of the whole batch
+func (ts *transactionSimulator) ProcessTxs(txs []*transaction.Transaction, withTrace bool) (*transaction.BatchSimulationResults, error) {
+	if len(txs) == 0 {
+		return nil, node.ErrNoTransactionsToSimulate
+	}
+
+	ts.mutSimulation.Lock()
+	defer ts.mutSimulation.Unlock()
+
+	ts.simulationAccounts.Reset()
+	defer ts.simulationAccounts.Reset()
+
+	batchResults := &transaction.BatchSimulationResults{
+		Results: make([]*transaction.SimulationResults, 0, len(txs)),
+	}
+	for _, tx := range txs {
+		results, err := ts.simulateTx(tx, withTrace)
+		if err != nil {
+			return nil, err
+		}
+
+		batchResults.Results = append(batchResults.Results, results)
+	}
+
+	balanceDeltas, err := ts.simulationAccounts.GetBalanceDeltas()
+	if err != nil {
+		return nil, err
+	}
+
+	batchResults.BalanceDeltas = make(map[string]*big.Int, len(balanceDeltas))
+	for address, delta := range balanceDeltas {
+		batchResults.BalanceDeltas[ts.addressPubKeyConverter.Encode([]byte(address))] = delta
+	}
+
+	return batchResults, nil
+}
+
+func (ts *transactionSimulator) simulateTx(tx *transaction.Transaction, withTrace bool) (*transaction.SimulationResults, error) {
+	if !withTrace {
+		return ts.processTx(tx)
+	}
+
+	txHash, err := core.CalculateHash(ts.marshalizer, ts.hasher, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	ts.callTracer.StartTracing(txHash)
+	results, err := ts.processTx(tx)
+	callTrace := ts.callTracer.StopTracing()
+	if err != nil {
+		return nil, err
+	}
+
+	results.CallTrace = callTrace
+
+	return results, nil
+}
+
+func (ts *transactionSimulator) processTx(tx *transaction.Transaction) (*transaction.SimulationResults, error) {
 	txStatus := transaction.TxStatusPending
 	failReason := ""
 
@@ -71,6 +178,7 @@ func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction) (*transac
 	results := &transaction.SimulationResults{
 		Status:     txStatus,
 		FailReason: failReason,
+		Logs:       ts.txLogsCollector.GetCollectedLogs(),
 	}
 
 	err = ts.addIntermediateTxsToResult(results)
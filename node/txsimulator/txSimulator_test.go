@@ -3,8 +3,10 @@ package txsimulator
 import (
 	"encoding/hex"
 	"errors"
+	"math/big"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
@@ -50,6 +52,51 @@ func TestNewTransactionSimulator(t *testing.T) {
 			},
 			exError: node.ErrNilIntermediateProcessorContainer,
 		},
+		{
+			name: "NilCallTracer",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.CallTracer = nil
+				return args
+			},
+			exError: node.ErrNilCallTracer,
+		},
+		{
+			name: "NilSimulationAccounts",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.SimulationAccounts = nil
+				return args
+			},
+			exError: node.ErrNilSimulationAccounts,
+		},
+		{
+			name: "NilTxLogsCollector",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.TxLogsCollector = nil
+				return args
+			},
+			exError: node.ErrNilTxLogsCollector,
+		},
+		{
+			name: "NilMarshalizer",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.Marshalizer = nil
+				return args
+			},
+			exError: node.ErrNilMarshalizer,
+		},
+		{
+			name: "NilHasher",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.Hasher = nil
+				return args
+			},
+			exError: node.ErrNilHasher,
+		},
 		{
 			name: "Ok",
 			argsFunc: func() ArgsTxSimulator {
@@ -81,7 +128,7 @@ func TestTransactionSimulator_ProcessTxProcessingErrShouldSignal(t *testing.T) {
 	}
 	ts, _ := NewTransactionSimulator(args)
 
-	results, err := ts.ProcessTx(&transaction.Transaction{Nonce: 37})
+	results, err := ts.ProcessTx(&transaction.Transaction{Nonce: 37}, false)
 	require.NoError(t, err)
 	require.Equal(t, expErr.Error(), results.FailReason)
 }
@@ -112,7 +159,7 @@ func TestTransactionSimulator_ProcessTxShouldIncludeScrsAndReceipts(t *testing.T
 	}
 	ts, _ := NewTransactionSimulator(args)
 
-	results, err := ts.ProcessTx(&transaction.Transaction{Nonce: 37})
+	results, err := ts.ProcessTx(&transaction.Transaction{Nonce: 37}, false)
 	require.NoError(t, err)
 	require.Equal(
 		t,
@@ -126,11 +173,157 @@ func TestTransactionSimulator_ProcessTxShouldIncludeScrsAndReceipts(t *testing.T
 	)
 }
 
+func TestTransactionSimulator_ProcessTxWithTraceShouldReturnTheCallTrace(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{Nonce: 37}
+	expectedTxHash, _ := core.CalculateHash(&mock.MarshalizerMock{}, &mock.HasherMock{}, tx)
+	expectedCallTrace := []*transaction.SimulationCallFrame{{Function: "swap"}}
+
+	isTracing := false
+	wasTracingWhileProcessing := false
+	args := getTxSimulatorArgs()
+	args.CallTracer = &callTracerStub{
+		StartTracingCalled: func(txHash []byte) {
+			require.Equal(t, expectedTxHash, txHash)
+			isTracing = true
+		},
+		StopTracingCalled: func() []*transaction.SimulationCallFrame {
+			isTracing = false
+			return expectedCallTrace
+		},
+	}
+	args.TransactionProcessor = &mock.TxProcessorStub{
+		ProcessTransactionCalled: func(transaction *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			wasTracingWhileProcessing = isTracing
+			return vmcommon.UserError, nil
+		},
+	}
+	args.IntermmediateProcContainer = &mock.IntermProcessorContainerStub{
+		GetCalled: func(key block.Type) (process.IntermediateTransactionHandler, error) {
+			return &mock.IntermediateTransactionHandlerStub{}, nil
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+
+	results, err := ts.ProcessTx(tx, true)
+	require.NoError(t, err)
+	require.True(t, wasTracingWhileProcessing)
+	require.False(t, isTracing)
+	require.Equal(t, expectedCallTrace, results.CallTrace)
+
+	results, err = ts.ProcessTx(tx, false)
+	require.NoError(t, err)
+	require.False(t, wasTracingWhileProcessing)
+	require.Nil(t, results.CallTrace)
+}
+
+func TestTransactionSimulator_ProcessTxsNoTransactionsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts, _ := NewTransactionSimulator(getTxSimulatorArgs())
+
+	results, err := ts.ProcessTxs(nil, false)
+	require.Nil(t, results)
+	require.Equal(t, node.ErrNoTransactionsToSimulate, err)
+}
+
+func TestTransactionSimulator_ProcessTxsShouldSimulateCumulatively(t *testing.T) {
+	t.Parallel()
+
+	simulationAccounts, _ := NewSimulationAccountsDB(createAccountsStubWithBalances(map[string]int64{"alice": 10}))
+	txLogsCollector, _ := NewTxLogsCollector(&mock.PubkeyConverterMock{})
+
+	senderBalances := make([]*big.Int, 0)
+	args := getTxSimulatorArgs()
+	args.SimulationAccounts = simulationAccounts
+	args.TxLogsCollector = txLogsCollector
+	args.IntermmediateProcContainer = &mock.IntermProcessorContainerStub{
+		GetCalled: func(key block.Type) (process.IntermediateTransactionHandler, error) {
+			return &mock.IntermediateTransactionHandlerStub{}, nil
+		},
+	}
+	args.TransactionProcessor = &mock.TxProcessorStub{
+		ProcessTransactionCalled: func(tx *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			sender := loadUserAccount(t, simulationAccounts, tx.SndAddr)
+			senderBalances = append(senderBalances, big.NewInt(0).Set(sender.GetBalance()))
+			err := sender.SubFromBalance(tx.Value)
+			if err != nil {
+				return vmcommon.UserError, err
+			}
+			receiver := loadUserAccount(t, simulationAccounts, tx.RcvAddr)
+			_ = receiver.AddToBalance(tx.Value)
+			_ = simulationAccounts.SaveAccount(sender)
+			_ = simulationAccounts.SaveAccount(receiver)
+
+			_ = txLogsCollector.SaveLog([]byte("txHash"), tx, []*vmcommon.LogEntry{{Identifier: tx.Data}})
+
+			return vmcommon.Ok, nil
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+
+	txs := []*transaction.Transaction{
+		{Nonce: 0, SndAddr: []byte("alice"), RcvAddr: []byte("bob"), Value: big.NewInt(6), Data: []byte("first")},
+		{Nonce: 0, SndAddr: []byte("bob"), RcvAddr: []byte("carol"), Value: big.NewInt(2), Data: []byte("second")},
+		{Nonce: 1, SndAddr: []byte("alice"), RcvAddr: []byte("bob"), Value: big.NewInt(6), Data: []byte("third")},
+	}
+	results, err := ts.ProcessTxs(txs, false)
+	require.NoError(t, err)
+
+	require.Equal(t, []*big.Int{big.NewInt(10), big.NewInt(6), big.NewInt(4)}, senderBalances)
+	require.Equal(t, 3, len(results.Results))
+	require.Equal(t, transaction.TxStatusSuccess, results.Results[0].Status)
+	require.Equal(t, "first", results.Results[0].Logs[0].Identifier)
+	require.Equal(t, transaction.TxStatusSuccess, results.Results[1].Status)
+	require.Equal(t, "second", results.Results[1].Logs[0].Identifier)
+	require.Equal(t, transaction.TxStatusFail, results.Results[2].Status)
+	require.Empty(t, results.Results[2].Logs)
+	require.Equal(t, map[string]*big.Int{
+		hex.EncodeToString([]byte("alice")): big.NewInt(-6),
+		hex.EncodeToString([]byte("bob")):   big.NewInt(4),
+		hex.EncodeToString([]byte("carol")): big.NewInt(2),
+	}, results.BalanceDeltas)
+
+	alice := loadUserAccount(t, simulationAccounts, []byte("alice"))
+	require.Equal(t, big.NewInt(10), alice.GetBalance())
+}
+
+type callTracerStub struct {
+	StartTracingCalled func(txHash []byte)
+	StopTracingCalled  func() []*transaction.SimulationCallFrame
+}
+
+func (cts *callTracerStub) StartTracing(txHash []byte) {
+	if cts.StartTracingCalled != nil {
+		cts.StartTracingCalled(txHash)
+	}
+}
+
+func (cts *callTracerStub) StopTracing() []*transaction.SimulationCallFrame {
+	if cts.StopTracingCalled != nil {
+		return cts.StopTracingCalled()
+	}
+	return nil
+}
+
+func (cts *callTracerStub) IsInterfaceNil() bool {
+	return cts == nil
+}
+
 func getTxSimulatorArgs() ArgsTxSimulator {
+	simulationAccounts, _ := NewSimulationAccountsDB(&mock.AccountsStub{})
+	txLogsCollector, _ := NewTxLogsCollector(&mock.PubkeyConverterMock{})
+
 	return ArgsTxSimulator{
 		TransactionProcessor:       &mock.TxProcessorStub{},
 		IntermmediateProcContainer: &mock.IntermProcessorContainerStub{},
 		AddressPubKeyConverter:     &mock.PubkeyConverterMock{},
 		ShardCoordinator:           mock.NewMultiShardsCoordinatorMock(2),
+		CallTracer:                 &callTracerStub{},
+		SimulationAccounts:         simulationAccounts,
+		TxLogsCollector:            txLogsCollector,
+		Marshalizer:                &mock.MarshalizerMock{},
+		Hasher:                     &mock.HasherMock{},
 	}
 }
@@ -25,6 +25,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/networksharding/factory"
 	randFactory "github.com/ElrondNetwork/elrond-go/p2p/libp2p/rand/factory"
 	"github.com/ElrondNetwork/elrond-go/p2p/loadBalancer"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/btcsuite/btcd/btcec"
 	logging "github.com/ipfs/go-log"
 	"github.com/libp2p/go-libp2p"
@@ -99,6 +100,8 @@ type networkMessenger struct {
 	debugger            p2p.Debugger
 	marshalizer         p2p.Marshalizer
 	syncTimer           p2p.SyncTimer
+	mutAppStatusHandler sync.RWMutex
+	appStatusHandler    core.AppStatusHandler
 }
 
 // ArgsNetworkMessenger defines the options used to create a p2p wrapper
@@ -199,6 +202,7 @@ func createMessenger(
 		peerShardResolver: &unknownPeerShardResolver{},
 		marshalizer:       args.Marshalizer,
 		syncTimer:         args.SyncTimer,
+		appStatusHandler:  statusHandler.NewNilStatusHandler(),
 	}
 	netMes.debugger = p2pDebug.NewP2PDebugger(core.PeerID(p2pHost.ID()))
 
@@ -779,7 +783,7 @@ func (netMes *networkMessenger) pubsubCallback(handler p2p.MessageProcessor, top
 			return false
 		}
 
-		err = handler.ProcessReceivedMessage(msg, fromConnectedPeer)
+		err = netMes.processReceivedMessage(handler, msg, fromConnectedPeer, topic)
 		if err != nil {
 			log.Trace("p2p validator",
 				"error", err.Error(),
@@ -797,6 +801,29 @@ func (netMes *networkMessenger) pubsubCallback(handler p2p.MessageProcessor, top
 	}
 }
 
+// processReceivedMessage calls the handler on the provided message while accounting the per topic traffic
+// and processing time
+func (netMes *networkMessenger) processReceivedMessage(
+	handler p2p.MessageProcessor,
+	msg p2p.MessageP2P,
+	fromConnectedPeer core.PeerID,
+	topic string,
+) error {
+	netMes.mutAppStatusHandler.RLock()
+	appStatusHandler := netMes.appStatusHandler
+	netMes.mutAppStatusHandler.RUnlock()
+
+	topicLabel := core.MetricLabel{Name: core.MetricLabelTopic, Value: topic}
+	appStatusHandler.AddToCounter(core.MetricP2PTopicReceivedMessages, 1, topicLabel)
+	appStatusHandler.AddToCounter(core.MetricP2PTopicReceivedBytes, uint64(len(msg.Data())), topicLabel)
+
+	startTime := time.Now()
+	err := handler.ProcessReceivedMessage(msg, fromConnectedPeer)
+	appStatusHandler.ObserveDuration(core.MetricInterceptorProcessingDuration, time.Since(startTime), topicLabel)
+
+	return err
+}
+
 func (netMes *networkMessenger) transformAndCheckMessage(pbMsg *pubsub.Message, pid core.PeerID, topic string) (p2p.MessageP2P, error) {
 	msg, errUnmarshal := NewMessage(pbMsg, netMes.marshalizer)
 	if errUnmarshal != nil {
@@ -1003,7 +1030,7 @@ func (netMes *networkMessenger) directMessageHandler(message *pubsub.Message, fr
 
 		//we won't recheck the message id against the cacher here as there might be collisions since we are using
 		// a separate sequence counter for direct sender
-		errProcess := processor.ProcessReceivedMessage(msg, fromConnectedPeer)
+		errProcess := netMes.processReceivedMessage(processor, msg, fromConnectedPeer, topic)
 		if errProcess != nil {
 			log.Trace("p2p validator",
 				"error", errProcess.Error(),
@@ -1025,6 +1052,19 @@ func (netMes *networkMessenger) IsConnectedToTheNetwork() bool {
 	return netMes.connMonitor.IsConnectedToTheNetwork(netw)
 }
 
+// CheckHealth reports whether the messenger is connected to the network
+func (netMes *networkMessenger) CheckHealth() core.HealthCheckResult {
+	if netMes.IsConnectedToTheNetwork() {
+		return core.NewHealthCheckResultOK()
+	}
+
+	return core.HealthCheckResult{
+		Status: core.HealthStatusUnavailable,
+		Reason: fmt.Sprintf("not connected to the network: %d connected peers, minimum %d",
+			len(netMes.p2pHost.Network().Peers()), netMes.ThresholdMinConnectedPeers()),
+	}
+}
+
 // SetThresholdMinConnectedPeers sets the minimum connected peers before triggering a new reconnection
 func (netMes *networkMessenger) SetThresholdMinConnectedPeers(minConnectedPeers int) error {
 	if minConnectedPeers < 0 {
@@ -1065,6 +1105,19 @@ func (netMes *networkMessenger) SetPeerDenialEvaluator(handler p2p.PeerDenialEva
 	return netMes.connMonitorWrapper.SetPeerDenialEvaluator(handler)
 }
 
+// SetAppStatusHandler sets the status handler used to report the per topic traffic metrics
+func (netMes *networkMessenger) SetAppStatusHandler(handler core.AppStatusHandler) error {
+	if check.IfNil(handler) {
+		return p2p.ErrNilStatusHandler
+	}
+
+	netMes.mutAppStatusHandler.Lock()
+	netMes.appStatusHandler = handler
+	netMes.mutAppStatusHandler.Unlock()
+
+	return nil
+}
+
 // GetConnectedPeersInfo gets the current connected peers information
 func (netMes *networkMessenger) GetConnectedPeersInfo() *p2p.ConnectedPeersInfo {
 	peers := netMes.p2pHost.Network().Peers()
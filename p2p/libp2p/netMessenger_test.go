@@ -19,6 +19,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/message"
 	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
@@ -1141,6 +1142,62 @@ func TestNetworkMessenger_SetPeerShardResolver(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+//------- SetAppStatusHandler
+
+func TestNetworkMessenger_SetAppStatusHandlerNilShouldErr(t *testing.T) {
+	netw := mocknet.New(context.Background())
+	mes, _ := libp2p.NewMockMessenger(createMockNetworkArgs(), netw)
+	defer func() {
+		_ = mes.Close()
+	}()
+
+	err := mes.SetAppStatusHandler(nil)
+
+	assert.Equal(t, p2p.ErrNilStatusHandler, err)
+}
+
+func TestNetworkMessenger_ReceivedMessagesShouldBeAccountedPerTopic(t *testing.T) {
+	msg := []byte("test message")
+
+	netw := mocknet.New(context.Background())
+	mes1, _ := libp2p.NewMockMessenger(createMockNetworkArgs(), netw)
+	mes2, _ := libp2p.NewMockMessenger(createMockNetworkArgs(), netw)
+	_ = netw.LinkAll()
+	defer func() {
+		_ = mes1.Close()
+		_ = mes2.Close()
+	}()
+
+	statusMetrics := statusHandler.NewStatusMetrics()
+	err := mes2.SetAppStatusHandler(statusMetrics)
+	assert.Nil(t, err)
+
+	_ = mes1.ConnectToPeer(mes2.Addresses()[0])
+
+	wg := &sync.WaitGroup{}
+	chanDone := make(chan bool)
+	wg.Add(2)
+
+	go func() {
+		wg.Wait()
+		chanDone <- true
+	}()
+
+	prepareMessengerForMatchDataReceive(mes1, msg, wg)
+	prepareMessengerForMatchDataReceive(mes2, msg, wg)
+
+	time.Sleep(time.Second)
+
+	mes1.Broadcast("test", msg)
+
+	waitDoneWithTimeout(t, chanDone, timeoutWaitResponses)
+
+	prometheusString := statusMetrics.StatusMetricsWithoutP2PPrometheusString()
+	assert.Contains(t, prometheusString, fmt.Sprintf("%s{shard=\"0\",topic=\"test\"} 1\n", core.MetricP2PTopicReceivedMessages))
+	assert.Contains(t, prometheusString, fmt.Sprintf("%s{shard=\"0\",topic=\"test\"} %d\n", core.MetricP2PTopicReceivedBytes, len(msg)))
+	assert.Contains(t, prometheusString, fmt.Sprintf("%s_count{shard=\"0\",topic=\"test\"} 1\n", core.MetricInterceptorProcessingDuration))
+}
+
 func TestNetworkMessenger_DoubleCloseShouldWork(t *testing.T) {
 	mes := createMessenger()
 
@@ -1,6 +1,11 @@
 package mock
 
-import "sync"
+import (
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+)
 
 // AppStatusHandlerMock -
 type AppStatusHandlerMock struct {
@@ -95,6 +100,14 @@ func (ashm *AppStatusHandlerMock) SetStringValue(key string, value string) {
 	ashm.data[key] = value
 }
 
+// AddToCounter -
+func (ashm *AppStatusHandlerMock) AddToCounter(_ string, _ uint64, _ ...core.MetricLabel) {
+}
+
+// ObserveDuration -
+func (ashm *AppStatusHandlerMock) ObserveDuration(_ string, _ time.Duration, _ ...core.MetricLabel) {
+}
+
 // GetUint64 -
 func (ashm *AppStatusHandlerMock) GetUint64(key string) uint64 {
 	ashm.mut.Lock()
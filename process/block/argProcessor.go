@@ -5,6 +5,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/core/subscription"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
@@ -42,6 +43,7 @@ type ArgBaseProcessor struct {
 	Indexer                 indexer.Indexer
 	TpsBenchmark            statistics.TPSBenchmark
 	HistoryRepository       dblookupext.HistoryRepository
+	SubscriptionNotifier    subscription.Notifier
 	EpochNotifier           process.EpochNotifier
 	HeaderIntegrityVerifier process.HeaderIntegrityVerifier
 }
@@ -64,5 +66,6 @@ type ArgMetaProcessor struct {
 	EpochValidatorInfoCreator    process.EpochStartValidatorInfoCreator
 	EpochSystemSCProcessor       process.EpochStartSystemSCProcessor
 	ValidatorStatisticsProcessor process.ValidatorStatisticsProcessor
+	SlashingProcessor            process.SlashingProcessor
 	RewardsV2EnableEpoch         uint32
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/core/subscription"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
@@ -76,10 +77,11 @@ type baseProcessor struct {
 	blockProcessor         blockProcessor
 	txCounter              *transactionCounter
 
-	indexer       indexer.Indexer
-	tpsBenchmark  statistics.TPSBenchmark
-	historyRepo   dblookupext.HistoryRepository
-	epochNotifier process.EpochNotifier
+	indexer              indexer.Indexer
+	tpsBenchmark         statistics.TPSBenchmark
+	historyRepo          dblookupext.HistoryRepository
+	subscriptionNotifier subscription.Notifier
+	epochNotifier        process.EpochNotifier
 }
 
 type bootStorerDataArgs struct {
@@ -421,6 +423,9 @@ func checkProcessorNilParameters(arguments ArgBaseProcessor) error {
 	if check.IfNil(arguments.HistoryRepository) {
 		return process.ErrNilHistoryRepository
 	}
+	if check.IfNil(arguments.SubscriptionNotifier) {
+		return process.ErrNilSubscriptionNotifier
+	}
 	if check.IfNil(arguments.HeaderIntegrityVerifier) {
 		return process.ErrNilHeaderIntegrityVerifier
 	}
@@ -1112,15 +1117,30 @@ func (bp *baseProcessor) RevertAccountState(_ data.HeaderHandler) {
 
 func (bp *baseProcessor) commitAll() error {
 	for key := range bp.accountsDB {
+		startTime := time.Now()
 		_, err := bp.accountsDB[key].Commit()
 		if err != nil {
 			return err
 		}
+
+		trieLabel := core.MetricLabel{Name: core.MetricLabelTrie, Value: getAccountsDbName(key)}
+		bp.appStatusHandler.ObserveDuration(core.MetricTrieCommitDuration, time.Since(startTime), trieLabel)
 	}
 
 	return nil
 }
 
+func getAccountsDbName(identifier state.AccountsDbIdentifier) string {
+	switch identifier {
+	case state.UserAccountsState:
+		return "user"
+	case state.PeerAccountsState:
+		return "peer"
+	default:
+		return "unknown"
+	}
+}
+
 // PruneStateOnRollback recreates the state tries to the root hashes indicated by the provided header
 func (bp *baseProcessor) PruneStateOnRollback(currHeader data.HeaderHandler, prevHeader data.HeaderHandler) {
 	for key := range bp.accountsDB {
@@ -1232,10 +1252,15 @@ func (bp *baseProcessor) requestMiniBlocksIfNeeded(headerHandler data.HeaderHand
 }
 
 func (bp *baseProcessor) recordBlockInHistory(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler) {
+	txsFromPool := bp.txCoordinator.GetAllCurrentUsedTxs(block.TxBlock)
+	rewardsFromPool := bp.txCoordinator.GetAllCurrentUsedTxs(block.RewardsBlock)
+	for hash, tx := range rewardsFromPool {
+		txsFromPool[hash] = tx
+	}
 	scrResultsFromPool := bp.txCoordinator.GetAllCurrentUsedTxs(block.SmartContractResultBlock)
 	receiptsFromPool := bp.txCoordinator.GetAllCurrentUsedTxs(block.ReceiptBlock)
 
-	err := bp.historyRepo.RecordBlock(blockHeaderHash, blockHeader, blockBody, scrResultsFromPool, receiptsFromPool)
+	err := bp.historyRepo.RecordBlock(blockHeaderHash, blockHeader, blockBody, txsFromPool, scrResultsFromPool, receiptsFromPool)
 	if err != nil {
 		log.Error("historyRepo.RecordBlock()", "blockHeaderHash", blockHeaderHash, "error", err.Error())
 	}
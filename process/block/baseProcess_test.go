@@ -316,6 +316,7 @@ func CreateMockArguments() blproc.ArgShardProcessor {
 			TpsBenchmark:            &testscommon.TpsBenchmarkMock{},
 			HeaderIntegrityVerifier: &mock.HeaderIntegrityVerifierStub{},
 			HistoryRepository:       &testscommon.HistoryRepositoryStub{},
+			SubscriptionNotifier:    &testscommon.SubscriptionNotifierStub{},
 			EpochNotifier:           &mock.EpochNotifierStub{},
 		},
 	}
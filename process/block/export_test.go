@@ -107,6 +107,7 @@ func NewShardProcessorEmptyWith3shards(
 			TpsBenchmark:            &testscommon.TpsBenchmarkMock{},
 			HeaderIntegrityVerifier: &mock.HeaderIntegrityVerifierStub{},
 			HistoryRepository:       &testscommon.HistoryRepositoryStub{},
+			SubscriptionNotifier:    &testscommon.SubscriptionNotifierStub{},
 			EpochNotifier:           &mock.EpochNotifierStub{},
 		},
 	}
@@ -35,6 +35,7 @@ type metaProcessor struct {
 	epochSystemSCProcessor       process.EpochStartSystemSCProcessor
 	pendingMiniBlocksHandler     process.PendingMiniBlocksHandler
 	validatorStatisticsProcessor process.ValidatorStatisticsProcessor
+	slashingProcessor            process.SlashingProcessor
 	shardsHeadersNonce           *sync.Map
 	shardBlockFinality           uint32
 	chRcvAllHdrs                 chan bool
@@ -78,6 +79,9 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 	if check.IfNil(arguments.EpochSystemSCProcessor) {
 		return nil, process.ErrNilEpochStartSystemSCProcessor
 	}
+	if check.IfNil(arguments.SlashingProcessor) {
+		return nil, process.ErrNilSlashingProcessor
+	}
 
 	genesisHdr := arguments.BlockChain.GetGenesisHeader()
 	base := &baseProcessor{
@@ -108,6 +112,7 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 		genesisNonce:            genesisHdr.GetNonce(),
 		headerIntegrityVerifier: arguments.HeaderIntegrityVerifier,
 		historyRepo:             arguments.HistoryRepository,
+		subscriptionNotifier:    arguments.SubscriptionNotifier,
 		epochNotifier:           arguments.EpochNotifier,
 	}
 
@@ -122,6 +127,7 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 		validatorStatisticsProcessor: arguments.ValidatorStatisticsProcessor,
 		validatorInfoCreator:         arguments.EpochValidatorInfoCreator,
 		epochSystemSCProcessor:       arguments.EpochSystemSCProcessor,
+		slashingProcessor:            arguments.SlashingProcessor,
 		rewardsV2EnableEpoch:         arguments.RewardsV2EnableEpoch,
 	}
 
@@ -239,6 +245,11 @@ func (mp *metaProcessor) ProcessBlock(
 	}
 
 	if header.IsStartOfEpochBlock() {
+		if len(header.SlashingEvidence) > 0 {
+			err = process.ErrSlashingEvidenceInEpochStartBlock
+			return err
+		}
+
 		err = mp.processEpochStartMetaBlock(header, body)
 		return err
 	}
@@ -326,6 +337,11 @@ func (mp *metaProcessor) ProcessBlock(
 		return err
 	}
 
+	err = mp.slashingProcessor.ProcessSlashingEvidence(header.SlashingEvidence)
+	if err != nil {
+		return err
+	}
+
 	err = mp.verifyFees(header)
 	if err != nil {
 		return err
@@ -661,6 +677,7 @@ func (mp *metaProcessor) RestoreBlockIntoPools(headerHandler data.HeaderHandler,
 	}
 
 	mp.restoreBlockBody(bodyHandler)
+	mp.slashingProcessor.RestoreSlashingEvidenceIntoPool(metaBlock.SlashingEvidence)
 
 	mp.blockTracker.RemoveLastNotarizedHeaders()
 
@@ -713,6 +730,11 @@ func (mp *metaProcessor) CreateBlock(
 		if err != nil {
 			return nil, nil, err
 		}
+
+		metaHdr.SlashingEvidence, err = mp.slashingProcessor.CreateSlashingEvidence()
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 
 	body, err = mp.applyBodyToHeader(metaHdr, body)
@@ -1152,6 +1174,8 @@ func (mp *metaProcessor) CommitBlock(
 		return err
 	}
 
+	mp.slashingProcessor.RemoveSlashingEvidenceFromPool(header.SlashingEvidence)
+
 	log.Info("meta block has been committed successfully",
 		"epoch", header.Epoch,
 		"round", header.Round,
@@ -1200,6 +1224,7 @@ func (mp *metaProcessor) CommitBlock(
 
 	mp.indexBlock(header, headerHash, body, lastMetaBlock, notarizedHeadersHashes, rewardsTxs)
 	mp.recordBlockInHistory(headerHash, headerHandler, bodyHandler)
+	mp.subscriptionNotifier.NotifyCommittedBlock(headerHash, headerHandler, bodyHandler)
 
 	highestFinalBlockNonce := mp.forkDetector.GetHighestFinalBlockNonce()
 	saveMetricsForCommitMetachainBlock(mp.appStatusHandler, header, headerHash, mp.nodesCoordinator, highestFinalBlockNonce)
@@ -79,6 +79,7 @@ func createMockMetaArguments() blproc.ArgMetaProcessor {
 			TpsBenchmark:            &testscommon.TpsBenchmarkMock{},
 			HeaderIntegrityVerifier: &mock.HeaderIntegrityVerifierStub{},
 			HistoryRepository:       &testscommon.HistoryRepositoryStub{},
+			SubscriptionNotifier:    &testscommon.SubscriptionNotifierStub{},
 			EpochNotifier:           &mock.EpochNotifierStub{},
 		},
 		SCToProtocol:                 &mock.SCToProtocolStub{},
@@ -89,6 +90,7 @@ func createMockMetaArguments() blproc.ArgMetaProcessor {
 		EpochValidatorInfoCreator:    &mock.EpochValidatorInfoCreatorStub{},
 		ValidatorStatisticsProcessor: &mock.ValidatorStatisticsProcessorStub{},
 		EpochSystemSCProcessor:       &mock.EpochStartSystemSCStub{},
+		SlashingProcessor:            &mock.SlashingProcessorStub{},
 	}
 	return arguments
 }
@@ -328,6 +330,17 @@ func TestNewMetaProcessor_NilBlockSizeThrottlerShouldErr(t *testing.T) {
 	assert.Nil(t, be)
 }
 
+func TestNewMetaProcessor_NilSlashingProcessorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockMetaArguments()
+	arguments.SlashingProcessor = nil
+
+	be, err := blproc.NewMetaProcessor(arguments)
+	assert.Equal(t, process.ErrNilSlashingProcessor, err)
+	assert.Nil(t, be)
+}
+
 func TestNewMetaProcessor_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -1539,6 +1552,37 @@ func TestMetaProcessor_RestoreBlockIntoPoolsShouldWork(t *testing.T) {
 	assert.Equal(t, &hdr, hdrFromPool)
 }
 
+func TestMetaProcessor_RestoreBlockIntoPoolsShouldRestoreSlashingEvidence(t *testing.T) {
+	t.Parallel()
+
+	evidence := []block.SlashingEvidence{{PubKey: []byte("pk"), Round: 1}}
+	var restoredEvidence []block.SlashingEvidence
+	arguments := createMockMetaArguments()
+	arguments.Store = &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			return &mock.StorerStub{
+				RemoveCalled: func(key []byte) error {
+					return nil
+				},
+			}
+		},
+	}
+	arguments.SlashingProcessor = &mock.SlashingProcessorStub{
+		RestoreSlashingEvidenceIntoPoolCalled: func(evidence []block.SlashingEvidence) {
+			restoredEvidence = evidence
+		},
+	}
+	mp, _ := blproc.NewMetaProcessor(arguments)
+
+	mhdr := createMetaBlockHeader()
+	mhdr.ShardInfo = nil
+	mhdr.SlashingEvidence = evidence
+
+	err := mp.RestoreBlockIntoPools(mhdr, &block.Body{})
+	assert.Nil(t, err)
+	assert.Equal(t, evidence, restoredEvidence)
+}
+
 func TestMetaProcessor_CreateLastNotarizedHdrs(t *testing.T) {
 	t.Parallel()
 
@@ -2682,3 +2726,134 @@ func TestMetaProcessor_CreateAndProcessBlockCallsProcessAfterFirstEpoch(t *testi
 	assert.Nil(t, err)
 	assert.True(t, toggleCalled, calledSaveNodesCoordinator)
 }
+
+func TestMetaProcessor_ProcessEpochStartBlockWithSlashingEvidenceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockMetaArguments()
+	arguments.SlashingProcessor = &mock.SlashingProcessorStub{
+		ProcessSlashingEvidenceCalled: func(evidence []block.SlashingEvidence) error {
+			assert.Fail(t, "should have not processed slashing evidence from an epoch start block")
+			return nil
+		},
+	}
+	mp, _ := blproc.NewMetaProcessor(arguments)
+
+	header := &block.MetaBlock{
+		Nonce: 1,
+		Round: 1,
+		EpochStart: block.EpochStart{
+			LastFinalizedHeaders: []block.EpochStartShardData{{}},
+		},
+		SlashingEvidence: []block.SlashingEvidence{{PubKey: []byte("pk"), Round: 1}},
+	}
+
+	err := mp.ProcessBlock(header, &block.Body{}, func() time.Duration { return time.Second })
+	assert.Equal(t, process.ErrSlashingEvidenceInEpochStartBlock, err)
+}
+
+func TestMetaProcessor_CreateAndProcessBlockShouldIncludeSlashingEvidence(t *testing.T) {
+	t.Parallel()
+
+	hash := []byte("hash1")
+	hdrHash1Bytes := []byte("hdr_hash1")
+	hrdHash2Bytes := []byte("hdr_hash2")
+	hasher := &mock.HasherStub{}
+	hasher.ComputeCalled = func(s string) []byte {
+		return hash
+	}
+	miniBlock1 := &block.MiniBlock{TxHashes: [][]byte{hash}}
+	dPool := initDataPool([]byte("tx_hash"))
+	dPool.TransactionsCalled = func() dataRetriever.ShardedDataCacherNotifier {
+		return testscommon.NewShardedDataStub()
+	}
+	dPool.HeadersCalled = func() dataRetriever.HeadersPool {
+		cs := &mock.HeadersCacherStub{}
+		cs.RegisterHandlerCalled = func(i func(header data.HeaderHandler, key []byte)) {
+		}
+		cs.GetHeaderByHashCalled = func(key []byte) (handler data.HeaderHandler, e error) {
+			if bytes.Equal(hdrHash1Bytes, key) {
+				return &block.Header{
+					PrevHash:         []byte("hash1"),
+					Nonce:            1,
+					Round:            1,
+					PrevRandSeed:     []byte("roothash"),
+					MiniBlockHeaders: []block.MiniBlockHeader{{Hash: []byte("hash1"), SenderShardID: 1}},
+				}, nil
+			}
+			if bytes.Equal(hrdHash2Bytes, key) {
+				return &block.Header{Nonce: 2, Round: 2}, nil
+			}
+			return nil, errors.New("err")
+		}
+		cs.LenCalled = func() int {
+			return 0
+		}
+		cs.NoncesCalled = func(shardId uint32) []uint64 {
+			return []uint64{1, 2}
+		}
+		cs.MaxSizeCalled = func() int {
+			return 1000
+		}
+		return cs
+	}
+
+	txCoordinator := &mock.TransactionCoordinatorMock{
+		CreateMbsAndProcessCrossShardTransactionsDstMeCalled: func(header data.HeaderHandler, processedMiniBlocksHashes map[string]struct{}, haveTime func() bool) (slices block.MiniBlockSlice, u uint32, b bool, err error) {
+			return block.MiniBlockSlice{miniBlock1}, 0, true, nil
+		},
+	}
+
+	arguments := createMockMetaArguments()
+	arguments.DataPool = dPool
+	arguments.TxCoordinator = txCoordinator
+	arguments.Hasher = hasher
+
+	blkc := &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.MetaBlock{
+				Nonce:                  0,
+				AccumulatedFeesInEpoch: big.NewInt(0),
+				DevFeesInEpoch:         big.NewInt(0),
+				EpochStart: block.EpochStart{
+					LastFinalizedHeaders: []block.EpochStartShardData{{}},
+					Economics:            block.Economics{},
+				},
+			}
+		},
+		GetCurrentBlockHeaderHashCalled: func() []byte {
+			return hash
+		},
+		GetGenesisHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{Nonce: 0}
+		},
+	}
+	arguments.BlockChain = blkc
+
+	evidence := []block.SlashingEvidence{{PubKey: []byte("pk"), Round: 1}}
+	var processedEvidence []block.SlashingEvidence
+	arguments.SlashingProcessor = &mock.SlashingProcessorStub{
+		CreateSlashingEvidenceCalled: func() ([]block.SlashingEvidence, error) {
+			return evidence, nil
+		},
+		ProcessSlashingEvidenceCalled: func(evidence []block.SlashingEvidence) error {
+			processedEvidence = evidence
+			return nil
+		},
+	}
+
+	mp, _ := blproc.NewMetaProcessor(arguments)
+	metaHdr := &block.MetaBlock{}
+	headerHandler, bodyHandler, err := mp.CreateBlock(metaHdr, func() bool { return true })
+	assert.Nil(t, err)
+	assert.Equal(t, evidence, headerHandler.(*block.MetaBlock).SlashingEvidence)
+
+	headerHandler.SetRound(uint64(1))
+	headerHandler.SetNonce(1)
+	headerHandler.SetPrevHash(hash)
+	headerHandler.SetAccumulatedFees(big.NewInt(0))
+
+	err = mp.ProcessBlock(headerHandler, bodyHandler, func() time.Duration { return time.Second })
+	assert.Nil(t, err)
+	assert.Equal(t, evidence, processedEvidence)
+}
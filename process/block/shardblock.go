@@ -79,6 +79,7 @@ func NewShardProcessor(arguments ArgShardProcessor) (*shardProcessor, error) {
 		genesisNonce:            genesisHdr.GetNonce(),
 		headerIntegrityVerifier: arguments.HeaderIntegrityVerifier,
 		historyRepo:             arguments.HistoryRepository,
+		subscriptionNotifier:    arguments.SubscriptionNotifier,
 		epochNotifier:           arguments.EpochNotifier,
 	}
 
@@ -869,6 +870,7 @@ func (sp *shardProcessor) CommitBlock(
 	sp.blockChain.SetCurrentBlockHeaderHash(headerHash)
 	sp.indexBlockIfNeeded(bodyHandler, headerHash, headerHandler, lastBlockHeader)
 	sp.recordBlockInHistory(headerHash, headerHandler, bodyHandler)
+	sp.subscriptionNotifier.NotifyCommittedBlock(headerHash, headerHandler, bodyHandler)
 
 	lastCrossNotarizedHeader, _, err := sp.blockTracker.GetLastCrossNotarizedHeader(core.MetachainShardId)
 	if err != nil {
@@ -247,6 +247,17 @@ func TestNewShardProcessor_NilBlockSizeThrottlerShouldErr(t *testing.T) {
 	assert.Nil(t, sp)
 }
 
+func TestNewShardProcessor_NilSubscriptionNotifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments()
+	arguments.SubscriptionNotifier = nil
+	sp, err := blproc.NewShardProcessor(arguments)
+
+	assert.Equal(t, process.ErrNilSubscriptionNotifier, err)
+	assert.Nil(t, sp)
+}
+
 func TestNewShardProcessor_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -1763,6 +1774,12 @@ func TestShardProcessor_CommitBlockOkValsShouldWork(t *testing.T) {
 		return hdrHash
 	}
 	arguments.BlockChain = blkc
+	notifiedCommittedBlockHash := make([]byte, 0)
+	arguments.SubscriptionNotifier = &testscommon.SubscriptionNotifierStub{
+		NotifyCommittedBlockCalled: func(headerHash []byte, header data.HeaderHandler, body data.BodyHandler) {
+			notifiedCommittedBlockHash = headerHash
+		},
+	}
 	sp, _ := blproc.NewShardProcessor(arguments)
 
 	err := sp.ProcessBlock(hdr, body, haveTime)
@@ -1771,6 +1788,7 @@ func TestShardProcessor_CommitBlockOkValsShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 	assert.True(t, forkDetectorAddCalled)
 	assert.Equal(t, hdrHash, blkc.GetCurrentBlockHeaderHash())
+	assert.Equal(t, hdrHash, notifiedCommittedBlockHash)
 	//this should sleep as there is an async call to display current hdr and block in CommitBlock
 	time.Sleep(time.Second)
 }
@@ -692,3 +692,15 @@ func GetSortedStorageUpdates(account *vmcommon.OutputAccount) []*vmcommon.Storag
 
 	return storageUpdates
 }
+
+// GetTracedCallType returns the traced call type matching the provided VM call type
+func GetTracedCallType(callType vmcommon.CallType) string {
+	switch callType {
+	case vmcommon.AsynchronousCall:
+		return TracedCallTypeAsync
+	case vmcommon.AsynchronousCallBack:
+		return TracedCallTypeAsyncCallBack
+	default:
+		return TracedCallTypeDirect
+	}
+}
@@ -36,6 +36,19 @@ const (
 	InvalidTransaction
 )
 
+const (
+	// TracedCallTypeDirect is the type of a traced smart contract call issued directly
+	TracedCallTypeDirect = "directCall"
+	// TracedCallTypeAsync is the type of a traced asynchronous smart contract call
+	TracedCallTypeAsync = "asyncCall"
+	// TracedCallTypeAsyncCallBack is the type of a traced asynchronous call callback
+	TracedCallTypeAsyncCallBack = "asyncCallBack"
+	// TracedCallTypeDeploy is the type of a traced smart contract deployment
+	TracedCallTypeDeploy = "deploy"
+	// TracedCallTypeBuiltInFunction is the type of a traced built-in function execution
+	TracedCallTypeBuiltInFunction = "builtInFunction"
+)
+
 // BlockFinality defines the block finality which is used in meta-chain/shards (the real finality in shards is given
 // by meta-chain)
 const BlockFinality = 1
@@ -17,7 +17,7 @@ var _ process.TxTypeHandler = (*txTypeHandler)(nil)
 type txTypeHandler struct {
 	pubkeyConv       core.PubkeyConverter
 	shardCoordinator sharding.Coordinator
-	builtInFuncNames map[string]struct{}
+	builtInFunctions process.BuiltInFunctionContainer
 	argumentParser   process.CallArgumentsParser
 }
 
@@ -25,7 +25,7 @@ type txTypeHandler struct {
 type ArgNewTxTypeHandler struct {
 	PubkeyConverter  core.PubkeyConverter
 	ShardCoordinator sharding.Coordinator
-	BuiltInFuncNames map[string]struct{}
+	BuiltInFunctions process.BuiltInFunctionContainer
 	ArgumentParser   process.CallArgumentsParser
 }
 
@@ -42,7 +42,7 @@ func NewTxTypeHandler(
 	if check.IfNil(args.ArgumentParser) {
 		return nil, process.ErrNilArgumentParser
 	}
-	if args.BuiltInFuncNames == nil {
+	if check.IfNil(args.BuiltInFunctions) {
 		return nil, process.ErrNilBuiltInFunction
 	}
 
@@ -50,7 +50,7 @@ func NewTxTypeHandler(
 		pubkeyConv:       args.PubkeyConverter,
 		shardCoordinator: args.ShardCoordinator,
 		argumentParser:   args.ArgumentParser,
-		builtInFuncNames: args.BuiltInFuncNames,
+		builtInFunctions: args.BuiltInFunctions,
 	}
 
 	return tc, nil
@@ -146,12 +146,13 @@ func (tth *txTypeHandler) getFunctionFromArguments(txData []byte) (string, [][]b
 }
 
 func (tth *txTypeHandler) isBuiltInFunctionCall(functionName string) bool {
-	if len(tth.builtInFuncNames) == 0 {
+	if len(functionName) == 0 {
 		return false
 	}
 
-	_, ok := tth.builtInFuncNames[functionName]
-	return ok
+	// the container is queried on each call, as some built in functions are enabled only starting with a given epoch
+	_, err := tth.builtInFunctions.Get(functionName)
+	return err == nil
 }
 
 func (tth *txTypeHandler) isRelayedTransaction(functionName string) bool {
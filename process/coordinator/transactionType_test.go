@@ -11,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -18,7 +19,7 @@ func createMockArguments() ArgNewTxTypeHandler {
 	return ArgNewTxTypeHandler{
 		PubkeyConverter:  createMockPubkeyConverter(),
 		ShardCoordinator: mock.NewMultiShardsCoordinatorMock(3),
-		BuiltInFuncNames: make(map[string]struct{}),
+		BuiltInFunctions: builtInFunctions.NewBuiltInFunctionContainer(),
 		ArgumentParser:   parsers.NewCallArgsParser(),
 	}
 }
@@ -64,7 +65,7 @@ func TestNewTxTypeHandler_NilBuiltInFuncs(t *testing.T) {
 	t.Parallel()
 
 	arg := createMockArguments()
-	arg.BuiltInFuncNames = nil
+	arg.BuiltInFunctions = nil
 	tth, err := NewTxTypeHandler(arg)
 
 	assert.Nil(t, tth)
@@ -243,7 +244,7 @@ func TestTxTypeHandler_ComputeTransactionTypeBuiltInFunc(t *testing.T) {
 		},
 	}
 	builtIn := "builtIn"
-	arg.BuiltInFuncNames[builtIn] = struct{}{}
+	_ = arg.BuiltInFunctions.Add(builtIn, &mock.BuiltInFunctionStub{})
 	tth, err := NewTxTypeHandler(arg)
 
 	assert.NotNil(t, tth)
@@ -0,0 +1,32 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// CallTracer is a disabled implementation of SCCallTracer that does not record anything
+type CallTracer struct {
+}
+
+// IsTracing returns false
+func (ct *CallTracer) IsTracing(_ []byte) bool {
+	return false
+}
+
+// EnterCall does nothing
+func (ct *CallTracer) EnterCall(_ []byte, _ *process.TracedCall) {
+}
+
+// ExitCall does nothing
+func (ct *CallTracer) ExitCall(_ []byte, _ *vmcommon.VMOutput, _ error) {
+}
+
+// RecordStorageWrite does nothing
+func (ct *CallTracer) RecordStorageWrite(_ []byte, _ []byte, _ []byte, _ []byte, _ []byte) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ct *CallTracer) IsInterfaceNil() bool {
+	return ct == nil
+}
@@ -719,6 +719,9 @@ var ErrNilBlockSizeThrottler = errors.New("block size throttler is nil")
 // ErrNilHistoryRepository signals that history processor is nil
 var ErrNilHistoryRepository = errors.New("history repository is nil")
 
+// ErrNilSubscriptionNotifier signals that a nil subscription notifier has been provided
+var ErrNilSubscriptionNotifier = errors.New("nil subscription notifier")
+
 // ErrInvalidMetaTransaction signals that meta transaction is invalid
 var ErrInvalidMetaTransaction = errors.New("meta transaction is invalid")
 
@@ -934,3 +937,48 @@ var ErrNilOrEmptyList = errors.New("nil or empty provided list")
 
 // ErrNilScQueryElement signals that a nil sc query service element was provided
 var ErrNilScQueryElement = errors.New("nil SC query service element")
+
+// ErrNilAccountsStateSwitcher signals that a nil accounts state switcher has been provided
+var ErrNilAccountsStateSwitcher = errors.New("nil accounts state switcher")
+
+// ErrHistoricalQueriesNotSupported signals that the component is not able to execute queries against a past state
+var ErrHistoricalQueriesNotSupported = errors.New("queries against a past state are not supported")
+
+// ErrInsufficientESDTAllowance signals that the allowance granted by the token owner is lower than the requested value
+var ErrInsufficientESDTAllowance = errors.New("insufficient esdt allowance")
+
+// ErrActionNotAllowed signals that the account does not have the special role needed for the esdt action
+var ErrActionNotAllowed = errors.New("action is not allowed, the account does not have the needed esdt special role")
+
+// ErrNFTTokenDoesNotExist signals that the non-fungible token nonce does not exist in the account
+var ErrNFTTokenDoesNotExist = errors.New("non fungible token does not exist")
+
+// ErrInvalidNFTQuantity signals that an invalid quantity was given for a non-fungible token
+var ErrInvalidNFTQuantity = errors.New("invalid quantity for non fungible token")
+
+// ErrNFTTransferDataMismatch signals that the non-fungible token data carried by a transfer does not match its arguments
+var ErrNFTTransferDataMismatch = errors.New("transferred non fungible token data does not match the arguments")
+
+// ErrInvalidNFTRoyalties signals that the given royalties exceed the maximum value
+var ErrInvalidNFTRoyalties = errors.New("invalid royalties for non fungible token")
+
+// ErrNilSlashingEvidence signals that a nil slashing evidence has been provided
+var ErrNilSlashingEvidence = errors.New("nil slashing evidence")
+
+// ErrNilSlashingEvidenceVerifier signals that a nil slashing evidence verifier has been provided
+var ErrNilSlashingEvidenceVerifier = errors.New("nil slashing evidence verifier")
+
+// ErrNilSlashingEvidencePool signals that a nil slashing evidence pool has been provided
+var ErrNilSlashingEvidencePool = errors.New("nil slashing evidence pool")
+
+// ErrNilSlashingProcessor signals that a nil slashing processor has been provided
+var ErrNilSlashingProcessor = errors.New("nil slashing processor")
+
+// ErrSlashingEvidenceInEpochStartBlock signals that an epoch start block contains slashing evidence
+var ErrSlashingEvidenceInEpochStartBlock = errors.New("epoch start block should not contain slashing evidence")
+
+// ErrSlashingExecutionFailed signals that the slash call on the staking system smart contract failed
+var ErrSlashingExecutionFailed = errors.New("slashing execution failed")
+
+// ErrNilCallTracer signals that a nil smart contract call tracer has been provided
+var ErrNilCallTracer = errors.New("nil call tracer")
@@ -19,6 +19,8 @@ const (
 	AccountTrieNodesTopic = "accountTrieNodes"
 	// ValidatorTrieNodesTopic is used for sharding validator state trie nodes
 	ValidatorTrieNodesTopic = "validatorTrieNodes"
+	// SlashingEvidenceTopic is the topic used for sharing double signing evidence
+	SlashingEvidenceTopic = "slashingEvidence"
 )
 
 // SystemVirtualMachine is a byte array identifier for the smart contract address created for system VM
@@ -45,6 +45,8 @@ type ShardInterceptorsContainerFactoryArgs struct {
 	EnableSignTxWithHashEpoch uint32
 	TxSignHasher              hashing.Hasher
 	EpochNotifier             process.EpochNotifier
+	SlashingEvidenceVerifier  process.SlashingEvidenceVerifier
+	SlashingEvidencePool      process.SlashingEvidencePool
 }
 
 // MetaInterceptorsContainerFactoryArgs holds the arguments needed for MetaInterceptorsContainerFactory
@@ -81,4 +83,6 @@ type MetaInterceptorsContainerFactoryArgs struct {
 	EnableSignTxWithHashEpoch uint32
 	TxSignHasher              hashing.Hasher
 	EpochNotifier             process.EpochNotifier
+	SlashingEvidenceVerifier  process.SlashingEvidenceVerifier
+	SlashingEvidencePool      process.SlashingEvidencePool
 }
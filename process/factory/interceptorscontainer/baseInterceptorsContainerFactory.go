@@ -39,6 +39,7 @@ type baseInterceptorsContainerFactory struct {
 	whiteListHandler       process.WhiteListHandler
 	whiteListerVerifiedTxs process.WhiteListHandler
 	addressPubkeyConverter core.PubkeyConverter
+	slashingEvidencePool   process.SlashingEvidencePool
 }
 
 func checkBaseParams(
@@ -513,3 +514,47 @@ func (bicf *baseInterceptorsContainerFactory) generateUnsignedTxsInterceptors()
 
 	return bicf.container.AddMultiple(keys, interceptorsSlice)
 }
+
+//------- Slashing evidence interceptor
+
+// generateSlashingEvidenceInterceptor creates the interceptor for the double signing evidence, only if an evidence
+// pool has been provided
+func (bicf *baseInterceptorsContainerFactory) generateSlashingEvidenceInterceptor() error {
+	if check.IfNil(bicf.slashingEvidencePool) {
+		return nil
+	}
+
+	identifier := factory.SlashingEvidenceTopic
+
+	evidenceFactory, err := interceptorFactory.NewInterceptedSlashingEvidenceDataFactory(bicf.argInterceptorFactory)
+	if err != nil {
+		return err
+	}
+
+	evidenceProcessor, err := processor.NewSlashingEvidenceInterceptorProcessor(bicf.slashingEvidencePool)
+	if err != nil {
+		return err
+	}
+
+	interceptor, err := interceptors.NewSingleDataInterceptor(
+		interceptors.ArgSingleDataInterceptor{
+			Topic:            identifier,
+			DataFactory:      evidenceFactory,
+			Processor:        evidenceProcessor,
+			Throttler:        bicf.globalThrottler,
+			AntifloodHandler: bicf.antifloodHandler,
+			WhiteListRequest: bicf.whiteListHandler,
+			CurrentPeerId:    bicf.messenger.ID(),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = bicf.createTopicAndAssignHandler(identifier, interceptor, true)
+	if err != nil {
+		return err
+	}
+
+	return bicf.container.Add(identifier, interceptor)
+}
@@ -113,6 +113,7 @@ func NewMetaInterceptorsContainerFactory(
 		EnableSignTxWithHashEpoch: args.EnableSignTxWithHashEpoch,
 		TxSignHasher:              args.TxSignHasher,
 		EpochNotifier:             args.EpochNotifier,
+		SlashingEvidenceVerifier:  args.SlashingEvidenceVerifier,
 	}
 
 	container := containers.NewInterceptorsContainer()
@@ -134,6 +135,7 @@ func NewMetaInterceptorsContainerFactory(
 		whiteListHandler:       args.WhiteListHandler,
 		whiteListerVerifiedTxs: args.WhiteListerVerifiedTxs,
 		addressPubkeyConverter: args.AddressPubkeyConverter,
+		slashingEvidencePool:   args.SlashingEvidencePool,
 	}
 
 	icf := &metaInterceptorsContainerFactory{
@@ -185,6 +187,11 @@ func (micf *metaInterceptorsContainerFactory) Create() (process.InterceptorsCont
 		return nil, err
 	}
 
+	err = micf.generateSlashingEvidenceInterceptor()
+	if err != nil {
+		return nil, err
+	}
+
 	return micf.container, nil
 }
 
@@ -11,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/process/factory/interceptorscontainer"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/slash"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
@@ -446,6 +447,55 @@ func TestMetaInterceptorsContainerFactory_CreateShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestMetaInterceptorsContainerFactory_CreateWithSlashingEvidencePoolShouldAddInterceptor(t *testing.T) {
+	t.Parallel()
+
+	createdTopics := make(map[string]struct{})
+	args := getArgumentsMeta()
+	args.Messenger = &mock.TopicHandlerStub{
+		CreateTopicCalled: func(name string, createChannelForTopic bool) error {
+			createdTopics[name] = struct{}{}
+			return nil
+		},
+		RegisterMessageProcessorCalled: func(topic string, handler p2p.MessageProcessor) error {
+			return nil
+		},
+	}
+	args.SlashingEvidencePool, _ = slash.NewEvidencePool(10)
+	args.SlashingEvidenceVerifier = &mock.SlashingEvidenceVerifierStub{}
+	icf, _ := interceptorscontainer.NewMetaInterceptorsContainerFactory(args)
+
+	container, err := icf.Create()
+	require.Nil(t, err)
+
+	interceptor, err := container.Get(factory.SlashingEvidenceTopic)
+	assert.Nil(t, err)
+	assert.NotNil(t, interceptor)
+	_, topicCreated := createdTopics[factory.SlashingEvidenceTopic]
+	assert.True(t, topicCreated)
+}
+
+func TestMetaInterceptorsContainerFactory_CreateWithoutSlashingEvidencePoolShouldNotAddInterceptor(t *testing.T) {
+	t.Parallel()
+
+	args := getArgumentsMeta()
+	args.Messenger = &mock.TopicHandlerStub{
+		CreateTopicCalled: func(name string, createChannelForTopic bool) error {
+			return nil
+		},
+		RegisterMessageProcessorCalled: func(topic string, handler p2p.MessageProcessor) error {
+			return nil
+		},
+	}
+	icf, _ := interceptorscontainer.NewMetaInterceptorsContainerFactory(args)
+
+	container, err := icf.Create()
+	require.Nil(t, err)
+
+	_, err = container.Get(factory.SlashingEvidenceTopic)
+	assert.NotNil(t, err)
+}
+
 func TestMetaInterceptorsContainerFactory_With4ShardsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -113,6 +113,7 @@ func NewShardInterceptorsContainerFactory(
 		EnableSignTxWithHashEpoch: args.EnableSignTxWithHashEpoch,
 		TxSignHasher:              args.TxSignHasher,
 		EpochNotifier:             args.EpochNotifier,
+		SlashingEvidenceVerifier:  args.SlashingEvidenceVerifier,
 	}
 
 	container := containers.NewInterceptorsContainer()
@@ -134,6 +135,7 @@ func NewShardInterceptorsContainerFactory(
 		whiteListHandler:       args.WhiteListHandler,
 		whiteListerVerifiedTxs: args.WhiteListerVerifiedTxs,
 		addressPubkeyConverter: args.AddressPubkeyConverter,
+		slashingEvidencePool:   args.SlashingEvidencePool,
 	}
 
 	icf := &shardInterceptorsContainerFactory{
@@ -187,6 +189,11 @@ func (sicf *shardInterceptorsContainerFactory) Create() (process.InterceptorsCon
 		return nil, err
 	}
 
+	err = sicf.generateSlashingEvidenceInterceptor()
+	if err != nil {
+		return nil, err
+	}
+
 	return sicf.container, nil
 }
 
@@ -10,9 +10,11 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/process/factory/interceptorscontainer"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/slash"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func createShardStubTopicHandler(matchStrToErrOnCreate string, matchStrToErrOnRegister string) process.TopicHandler {
@@ -498,6 +500,55 @@ func TestShardInterceptorsContainerFactory_CreateShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestShardInterceptorsContainerFactory_CreateWithSlashingEvidencePoolShouldAddInterceptor(t *testing.T) {
+	t.Parallel()
+
+	createdTopics := make(map[string]struct{})
+	args := getArgumentsShard()
+	args.Messenger = &mock.TopicHandlerStub{
+		CreateTopicCalled: func(name string, createChannelForTopic bool) error {
+			createdTopics[name] = struct{}{}
+			return nil
+		},
+		RegisterMessageProcessorCalled: func(topic string, handler p2p.MessageProcessor) error {
+			return nil
+		},
+	}
+	args.SlashingEvidencePool, _ = slash.NewEvidencePool(10)
+	args.SlashingEvidenceVerifier = &mock.SlashingEvidenceVerifierStub{}
+	icf, _ := interceptorscontainer.NewShardInterceptorsContainerFactory(args)
+
+	container, err := icf.Create()
+	require.Nil(t, err)
+
+	interceptor, err := container.Get(factory.SlashingEvidenceTopic)
+	assert.Nil(t, err)
+	assert.NotNil(t, interceptor)
+	_, topicCreated := createdTopics[factory.SlashingEvidenceTopic]
+	assert.True(t, topicCreated)
+}
+
+func TestShardInterceptorsContainerFactory_CreateWithoutSlashingEvidencePoolShouldNotAddInterceptor(t *testing.T) {
+	t.Parallel()
+
+	args := getArgumentsShard()
+	args.Messenger = &mock.TopicHandlerStub{
+		CreateTopicCalled: func(name string, createChannelForTopic bool) error {
+			return nil
+		},
+		RegisterMessageProcessorCalled: func(topic string, handler p2p.MessageProcessor) error {
+			return nil
+		},
+	}
+	icf, _ := interceptorscontainer.NewShardInterceptorsContainerFactory(args)
+
+	container, err := icf.Create()
+	require.Nil(t, err)
+
+	_, err = container.Get(factory.SlashingEvidenceTopic)
+	assert.NotNil(t, err)
+}
+
 func TestShardInterceptorsContainerFactory_With4ShardsShouldWork(t *testing.T) {
 	t.Parallel()
 
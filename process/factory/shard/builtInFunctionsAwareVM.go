@@ -0,0 +1,145 @@
+package shard
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+)
+
+type vmCreator func(builtInFunctionNames vmcommon.FunctionNames) (vmcommon.VMExecutionHandler, error)
+
+type builtInFunctionNamesGetter interface {
+	GetBuiltinFunctionNames() vmcommon.FunctionNames
+}
+
+// builtInFunctionsAwareVM holds an Arwen VM created with the names of the built in functions enabled at the time of
+// the call. Arwen routes the calls to these names towards the built in functions, and only receives them at creation,
+// so the VM is recreated whenever the enabled built in functions change. This way, a call to a built in function
+// which is not enabled yet is executed as a normal smart contract call
+type builtInFunctionsAwareVM struct {
+	createVM       vmCreator
+	namesGetter    builtInFunctionNamesGetter
+	mutVM          sync.RWMutex
+	vm             vmcommon.VMExecutionHandler
+	functionsNames vmcommon.FunctionNames
+}
+
+func newBuiltInFunctionsAwareVM(createVM vmCreator, namesGetter builtInFunctionNamesGetter) (*builtInFunctionsAwareVM, error) {
+	names := namesGetter.GetBuiltinFunctionNames()
+	vm, err := createVM(names)
+	if err != nil {
+		return nil, err
+	}
+
+	return &builtInFunctionsAwareVM{
+		createVM:       createVM,
+		namesGetter:    namesGetter,
+		vm:             vm,
+		functionsNames: names,
+	}, nil
+}
+
+// RunSmartContractCreate runs the smart contract create on the VM created with the enabled built in functions
+func (bvm *builtInFunctionsAwareVM) RunSmartContractCreate(input *vmcommon.ContractCreateInput) (*vmcommon.VMOutput, error) {
+	err := bvm.recreateVMIfNeeded()
+	if err != nil {
+		return nil, err
+	}
+
+	bvm.mutVM.RLock()
+	defer bvm.mutVM.RUnlock()
+
+	return bvm.vm.RunSmartContractCreate(input)
+}
+
+// RunSmartContractCall runs the smart contract call on the VM created with the enabled built in functions
+func (bvm *builtInFunctionsAwareVM) RunSmartContractCall(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+	err := bvm.recreateVMIfNeeded()
+	if err != nil {
+		return nil, err
+	}
+
+	bvm.mutVM.RLock()
+	defer bvm.mutVM.RUnlock()
+
+	return bvm.vm.RunSmartContractCall(input)
+}
+
+// recreateVMIfNeeded replaces the VM if the enabled built in functions changed since its creation. The replaced VM
+// is closed only after the executions started on it are finished
+func (bvm *builtInFunctionsAwareVM) recreateVMIfNeeded() error {
+	names := bvm.namesGetter.GetBuiltinFunctionNames()
+
+	bvm.mutVM.Lock()
+	defer bvm.mutVM.Unlock()
+
+	if areFunctionNamesEqual(bvm.functionsNames, names) {
+		return nil
+	}
+
+	vm, err := bvm.createVM(names)
+	if err != nil {
+		return err
+	}
+
+	logVMContainerFactory.Debug("the Arwen VM was recreated as the enabled built in functions changed",
+		"num built in functions", len(names))
+
+	closeVM(bvm.vm)
+	bvm.vm = vm
+	bvm.functionsNames = names
+
+	return nil
+}
+
+// GasScheduleChange sets a new gas schedule for the VM
+func (bvm *builtInFunctionsAwareVM) GasScheduleChange(newGasSchedule map[string]map[string]uint64) {
+	bvm.mutVM.RLock()
+	bvm.vm.GasScheduleChange(newGasSchedule)
+	bvm.mutVM.RUnlock()
+}
+
+// Close closes the VM (meaningful for Arwen out-of-process)
+func (bvm *builtInFunctionsAwareVM) Close() error {
+	bvm.mutVM.Lock()
+	defer bvm.mutVM.Unlock()
+
+	asCloser, ok := bvm.vm.(interface{ Close() error })
+	if !ok {
+		return nil
+	}
+
+	return asCloser.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bvm *builtInFunctionsAwareVM) IsInterfaceNil() bool {
+	return bvm == nil
+}
+
+func closeVM(vm vmcommon.VMExecutionHandler) {
+	asCloser, ok := vm.(interface{ Close() error })
+	if !ok {
+		return
+	}
+
+	err := asCloser.Close()
+	if err != nil {
+		logVMContainerFactory.Error("cannot close the replaced Arwen VM", "error", err)
+	}
+}
+
+func areFunctionNamesEqual(first vmcommon.FunctionNames, second vmcommon.FunctionNames) bool {
+	if len(first) != len(second) {
+		return false
+	}
+
+	for name := range first {
+		_, found := second[name]
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
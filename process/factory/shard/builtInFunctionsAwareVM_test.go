@@ -0,0 +1,124 @@
+package shard
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type builtInFunctionNamesGetterStub struct {
+	names vmcommon.FunctionNames
+}
+
+func (stub *builtInFunctionNamesGetterStub) GetBuiltinFunctionNames() vmcommon.FunctionNames {
+	names := make(vmcommon.FunctionNames, len(stub.names))
+	for name := range stub.names {
+		names[name] = struct{}{}
+	}
+
+	return names
+}
+
+type closableVMStub struct {
+	mock.VMExecutionHandlerStub
+	closed bool
+}
+
+func (stub *closableVMStub) Close() error {
+	stub.closed = true
+	return nil
+}
+
+func TestBuiltInFunctionsAwareVM_CreateErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	createVM := func(_ vmcommon.FunctionNames) (vmcommon.VMExecutionHandler, error) {
+		return nil, expectedErr
+	}
+
+	bvm, err := newBuiltInFunctionsAwareVM(createVM, &builtInFunctionNamesGetterStub{})
+
+	assert.Nil(t, bvm)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestBuiltInFunctionsAwareVM_CallToDisabledFunctionShouldNotBeHandledAsBuiltIn(t *testing.T) {
+	t.Parallel()
+
+	enabledFunc := "ESDTTransfer"
+	disabledFunc := "ESDTApprove"
+	namesGetter := &builtInFunctionNamesGetterStub{
+		names: vmcommon.FunctionNames{enabledFunc: {}},
+	}
+
+	vms := make([]*closableVMStub, 0)
+	createVM := func(builtInFunctionNames vmcommon.FunctionNames) (vmcommon.VMExecutionHandler, error) {
+		vm := &closableVMStub{}
+		vm.RunSmartContractCallCalled = func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			// Arwen routes a call towards the built in functions only if its name was provided at creation
+			_, isBuiltIn := builtInFunctionNames[input.Function]
+			if isBuiltIn {
+				return &vmcommon.VMOutput{ReturnMessage: "built in function"}, nil
+			}
+
+			return &vmcommon.VMOutput{ReturnMessage: "smart contract call"}, nil
+		}
+		vms = append(vms, vm)
+
+		return vm, nil
+	}
+
+	bvm, err := newBuiltInFunctionsAwareVM(createVM, namesGetter)
+	require.Nil(t, err)
+
+	vmOutput, err := bvm.RunSmartContractCall(&vmcommon.ContractCallInput{Function: disabledFunc})
+	require.Nil(t, err)
+	assert.Equal(t, "smart contract call", vmOutput.ReturnMessage)
+	vmOutput, err = bvm.RunSmartContractCall(&vmcommon.ContractCallInput{Function: enabledFunc})
+	require.Nil(t, err)
+	assert.Equal(t, "built in function", vmOutput.ReturnMessage)
+	assert.Equal(t, 1, len(vms))
+
+	namesGetter.names[disabledFunc] = struct{}{}
+
+	vmOutput, err = bvm.RunSmartContractCall(&vmcommon.ContractCallInput{Function: disabledFunc})
+	require.Nil(t, err)
+	assert.Equal(t, "built in function", vmOutput.ReturnMessage)
+	require.Equal(t, 2, len(vms))
+	assert.True(t, vms[0].closed)
+	assert.False(t, vms[1].closed)
+}
+
+func TestBuiltInFunctionsAwareVM_RecreateErrorShouldKeepTheVM(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	namesGetter := &builtInFunctionNamesGetterStub{
+		names: vmcommon.FunctionNames{},
+	}
+	vm := &closableVMStub{}
+	numCreateCalls := 0
+	createVM := func(_ vmcommon.FunctionNames) (vmcommon.VMExecutionHandler, error) {
+		numCreateCalls++
+		if numCreateCalls > 1 {
+			return nil, expectedErr
+		}
+
+		return vm, nil
+	}
+
+	bvm, err := newBuiltInFunctionsAwareVM(createVM, namesGetter)
+	require.Nil(t, err)
+
+	namesGetter.names["ESDTApprove"] = struct{}{}
+	vmOutput, err := bvm.RunSmartContractCreate(&vmcommon.ContractCreateInput{})
+
+	assert.Nil(t, vmOutput)
+	assert.Equal(t, expectedErr, err)
+	assert.False(t, vm.closed)
+}
@@ -27,7 +27,6 @@ type vmContainerFactory struct {
 	cryptoHook                     vmcommon.CryptoHook
 	blockGasLimit                  uint64
 	gasSchedule                    core.GasScheduleNotifier
-	builtinFunctions               vmcommon.FunctionNames
 	deployEnableEpoch              uint32
 	aheadOfTimeGasUsageEnableEpoch uint32
 }
@@ -51,7 +50,6 @@ func NewVMContainerFactory(
 	}
 
 	cryptoHook := hooks.NewVMCryptoHook()
-	builtinFunctions := blockChainHookImpl.GetBuiltinFunctionNames()
 
 	return &vmContainerFactory{
 		config:                         config,
@@ -59,7 +57,6 @@ func NewVMContainerFactory(
 		cryptoHook:                     cryptoHook,
 		blockGasLimit:                  blockGasLimit,
 		gasSchedule:                    gasSchedule,
-		builtinFunctions:               builtinFunctions,
 		deployEnableEpoch:              deployEnableEpoch,
 		aheadOfTimeGasUsageEnableEpoch: aheadOfTimeGasUsageEnableEpoch,
 	}, nil
@@ -69,7 +66,7 @@ func NewVMContainerFactory(
 func (vmf *vmContainerFactory) Create() (process.VirtualMachinesContainer, error) {
 	container := containers.NewVirtualMachinesContainer()
 
-	currVm, err := vmf.createArwenVM()
+	currVm, err := newBuiltInFunctionsAwareVM(vmf.createArwenVM, vmf.blockChainHookImpl)
 	if err != nil {
 		return nil, err
 	}
@@ -83,15 +80,15 @@ func (vmf *vmContainerFactory) Create() (process.VirtualMachinesContainer, error
 	return container, nil
 }
 
-func (vmf *vmContainerFactory) createArwenVM() (vmcommon.VMExecutionHandler, error) {
+func (vmf *vmContainerFactory) createArwenVM(builtinFunctions vmcommon.FunctionNames) (vmcommon.VMExecutionHandler, error) {
 	if vmf.config.OutOfProcessEnabled {
-		return vmf.createOutOfProcessArwenVM()
+		return vmf.createOutOfProcessArwenVM(builtinFunctions)
 	}
 
-	return vmf.createInProcessArwenVM()
+	return vmf.createInProcessArwenVM(builtinFunctions)
 }
 
-func (vmf *vmContainerFactory) createOutOfProcessArwenVM() (vmcommon.VMExecutionHandler, error) {
+func (vmf *vmContainerFactory) createOutOfProcessArwenVM(builtinFunctions vmcommon.FunctionNames) (vmcommon.VMExecutionHandler, error) {
 	logVMContainerFactory.Info("createOutOfProcessArwenVM", "config", vmf.config)
 
 	outOfProcessConfig := vmf.config.OutOfProcessConfig
@@ -108,7 +105,7 @@ func (vmf *vmContainerFactory) createOutOfProcessArwenVM() (vmcommon.VMExecution
 				VMType:                   factory.ArwenVirtualMachine,
 				BlockGasLimit:            vmf.blockGasLimit,
 				GasSchedule:              vmf.gasSchedule.LatestGasSchedule(),
-				ProtocolBuiltinFunctions: vmf.builtinFunctions,
+				ProtocolBuiltinFunctions: builtinFunctions,
 				ElrondProtectedKeyPrefix: []byte(core.ElrondProtectedKeyPrefix),
 				ArwenV2EnableEpoch:       vmf.deployEnableEpoch,
 				AheadOfTimeEnableEpoch:   vmf.aheadOfTimeGasUsageEnableEpoch,
@@ -121,7 +118,7 @@ func (vmf *vmContainerFactory) createOutOfProcessArwenVM() (vmcommon.VMExecution
 	return arwenVM, err
 }
 
-func (vmf *vmContainerFactory) createInProcessArwenVM() (vmcommon.VMExecutionHandler, error) {
+func (vmf *vmContainerFactory) createInProcessArwenVM(builtinFunctions vmcommon.FunctionNames) (vmcommon.VMExecutionHandler, error) {
 	logVMContainerFactory.Info("createInProcessArwenVM", "config", vmf.config)
 
 	return arwenHost.NewArwenVM(
@@ -130,7 +127,7 @@ func (vmf *vmContainerFactory) createInProcessArwenVM() (vmcommon.VMExecutionHan
 			VMType:                   factory.ArwenVirtualMachine,
 			BlockGasLimit:            vmf.blockGasLimit,
 			GasSchedule:              vmf.gasSchedule.LatestGasSchedule(),
-			ProtocolBuiltinFunctions: vmf.builtinFunctions,
+			ProtocolBuiltinFunctions: builtinFunctions,
 			ElrondProtectedKeyPrefix: []byte(core.ElrondProtectedKeyPrefix),
 			ArwenV2EnableEpoch:       vmf.deployEnableEpoch,
 			AheadOfTimeEnableEpoch:   vmf.aheadOfTimeGasUsageEnableEpoch,
@@ -18,6 +18,12 @@ type BuiltInCost struct {
 	SaveKeyValue          uint64
 	ESDTTransfer          uint64
 	ESDTBurn              uint64
+	ESDTApprove           uint64
+	ESDTTransferFrom      uint64
+	ESDTNFTCreate         uint64
+	ESDTNFTAddQuantity    uint64
+	ESDTNFTBurn           uint64
+	ESDTNFTTransfer       uint64
 }
 
 // GasCost holds all the needed gas costs for system smart contracts
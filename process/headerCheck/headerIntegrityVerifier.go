@@ -8,6 +8,7 @@ import (
 	"sort"
 
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data"
@@ -19,10 +20,11 @@ const wildcard = "*"
 const keySize = 4
 
 type headerIntegrityVerifier struct {
-	referenceChainID []byte
-	versions         []config.VersionByEpochs
-	defaultVersion   string
-	versionCache     storage.Cacher
+	referenceChainID       []byte
+	versions               []config.VersionByEpochs
+	defaultVersion         string
+	versionCache           storage.Cacher
+	isConsensusViewEnabled bool
 }
 
 // NewHeaderIntegrityVerifier returns a new instance of a structure capable of verifying the integrity of a provided header
@@ -31,6 +33,7 @@ func NewHeaderIntegrityVerifier(
 	versionsByEpochs []config.VersionByEpochs,
 	defaultVersion string,
 	versionCache storage.Cacher,
+	consensusType string,
 ) (*headerIntegrityVerifier, error) {
 
 	if len(referenceChainID) == 0 {
@@ -41,9 +44,10 @@ func NewHeaderIntegrityVerifier(
 	}
 
 	hdrIntVer := &headerIntegrityVerifier{
-		referenceChainID: referenceChainID,
-		defaultVersion:   defaultVersion,
-		versionCache:     versionCache,
+		referenceChainID:       referenceChainID,
+		defaultVersion:         defaultVersion,
+		versionCache:           versionCache,
+		isConsensusViewEnabled: consensusType == consensus.PbftConsensusType,
 	}
 	var err error
 	hdrIntVer.versions, err = hdrIntVer.prepareVersions(versionsByEpochs)
@@ -145,11 +149,14 @@ func (hdrIntVer *headerIntegrityVerifier) setInCache(epoch uint32, version strin
 
 // Verify will check the header's fields such as the chain ID or the software version
 func (hdrIntVer *headerIntegrityVerifier) Verify(hdr data.HeaderHandler) error {
-	if len(hdr.GetReserved()) > 0 {
+	// the reserved field only holds the consensus view in which a block was proposed after leader view changes, on the
+	// consensus types which change views
+	_, err := core.GetConsensusView(hdr.GetReserved(), hdrIntVer.isConsensusViewEnabled)
+	if err != nil {
 		return process.ErrReservedFieldNotSupportedYet
 	}
 
-	err := hdrIntVer.checkSoftwareVersion(hdr)
+	err = hdrIntVer.checkSoftwareVersion(hdr)
 	if err != nil {
 		return err
 	}
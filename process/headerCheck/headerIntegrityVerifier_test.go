@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data/block"
@@ -43,6 +44,7 @@ func TestNewHeaderIntegrityVerifier_InvalidReferenceChainIDShouldErr(t *testing.
 		make([]config.VersionByEpochs, 0),
 		defaultVersion,
 		&testscommon.CacherStub{},
+		consensus.BlsConsensusType,
 	)
 	require.True(t, check.IfNil(hdrIntVer))
 	require.Equal(t, ErrInvalidReferenceChainID, err)
@@ -65,6 +67,7 @@ func TestNewHeaderIntegrityVerifierr_InvalidVersionElementOnEpochValuesEqualShou
 		},
 		defaultVersion,
 		&testscommon.CacherStub{},
+		consensus.BlsConsensusType,
 	)
 	require.True(t, check.IfNil(hdrIntVer))
 	require.True(t, errors.Is(err, ErrInvalidVersionOnEpochValues))
@@ -83,6 +86,7 @@ func TestNewHeaderIntegrityVerifier_InvalidVersionElementOnStringTooLongShouldEr
 		},
 		defaultVersion,
 		&testscommon.CacherStub{},
+		consensus.BlsConsensusType,
 	)
 	require.True(t, check.IfNil(hdrIntVer))
 	require.True(t, errors.Is(err, ErrInvalidVersionStringTooLong))
@@ -96,6 +100,7 @@ func TestNewHeaderIntegrityVerifierr_InvalidDefaultVersionShouldErr(t *testing.T
 		versionsCorrectlyConstructed,
 		defaultVersion,
 		nil,
+		consensus.BlsConsensusType,
 	)
 	require.True(t, check.IfNil(hdrIntVer))
 	require.True(t, errors.Is(err, ErrNilCacher))
@@ -109,6 +114,7 @@ func TestNewHeaderIntegrityVerifier_NilCacherShouldErr(t *testing.T) {
 		versionsCorrectlyConstructed,
 		"",
 		&testscommon.CacherStub{},
+		consensus.BlsConsensusType,
 	)
 	require.True(t, check.IfNil(hdrIntVer))
 	require.True(t, errors.Is(err, ErrInvalidSoftwareVersion))
@@ -122,6 +128,7 @@ func TestNewHeaderIntegrityVerifier_EmptyListShouldErr(t *testing.T) {
 		make([]config.VersionByEpochs, 0),
 		"",
 		&testscommon.CacherStub{},
+		consensus.BlsConsensusType,
 	)
 	require.True(t, check.IfNil(hdrIntVer))
 	require.True(t, errors.Is(err, ErrEmptyVersionsByEpochsList))
@@ -140,6 +147,7 @@ func TestNewHeaderIntegrityVerifier_ZerothElementIsNotOnEpochZeroShouldErr(t *te
 		},
 		"",
 		&testscommon.CacherStub{},
+		consensus.BlsConsensusType,
 	)
 	require.True(t, check.IfNil(hdrIntVer))
 	require.True(t, errors.Is(err, ErrInvalidVersionOnEpochValues))
@@ -153,6 +161,7 @@ func TestNewHeaderIntegrityVerifier_ShouldWork(t *testing.T) {
 		versionsCorrectlyConstructed,
 		defaultVersion,
 		&testscommon.CacherStub{},
+		consensus.BlsConsensusType,
 	)
 	require.False(t, check.IfNil(hdrIntVer))
 	require.NoError(t, err)
@@ -166,22 +175,68 @@ func TestHeaderIntegrityVerifier_PopulatedReservedShouldErr(t *testing.T) {
 	}
 	hdrIntVer, _ := NewHeaderIntegrityVerifier(
 		[]byte("chainID"),
-		make([]config.VersionByEpochs, 0),
+		versionsCorrectlyConstructed,
 		defaultVersion,
 		&testscommon.CacherStub{},
+		consensus.BlsConsensusType,
 	)
 	err := hdrIntVer.Verify(hdr)
 	require.Equal(t, process.ErrReservedFieldNotSupportedYet, err)
 }
 
+func TestHeaderIntegrityVerifier_ConsensusViewInReservedShouldWorkForPbft(t *testing.T) {
+	t.Parallel()
+
+	expectedChainID := []byte("#chainID")
+	hdrIntVer, _ := NewHeaderIntegrityVerifier(
+		expectedChainID,
+		versionsCorrectlyConstructed,
+		"software",
+		&testscommon.CacherStub{},
+		consensus.PbftConsensusType,
+	)
+	mb := &block.MetaBlock{
+		SoftwareVersion: []byte("software"),
+		ChainID:         expectedChainID,
+		Reserved:        core.EncodeConsensusView(2),
+	}
+	err := hdrIntVer.Verify(mb)
+	require.NoError(t, err)
+
+	mb.Reserved = []byte("r")
+	err = hdrIntVer.Verify(mb)
+	require.Equal(t, process.ErrReservedFieldNotSupportedYet, err)
+}
+
+func TestHeaderIntegrityVerifier_ConsensusViewInReservedShouldErrForBls(t *testing.T) {
+	t.Parallel()
+
+	expectedChainID := []byte("#chainID")
+	hdrIntVer, _ := NewHeaderIntegrityVerifier(
+		expectedChainID,
+		versionsCorrectlyConstructed,
+		"software",
+		&testscommon.CacherStub{},
+		consensus.BlsConsensusType,
+	)
+	mb := &block.MetaBlock{
+		SoftwareVersion: []byte("software"),
+		ChainID:         expectedChainID,
+		Reserved:        core.EncodeConsensusView(2),
+	}
+	err := hdrIntVer.Verify(mb)
+	require.Equal(t, process.ErrReservedFieldNotSupportedYet, err)
+}
+
 func TestHeaderIntegrityVerifier_VerifySoftwareVersionEmptyVersionInHeaderShouldErr(t *testing.T) {
 	t.Parallel()
 
 	hdrIntVer, _ := NewHeaderIntegrityVerifier(
 		[]byte("chainID"),
-		make([]config.VersionByEpochs, 0),
+		versionsCorrectlyConstructed,
 		defaultVersion,
 		&testscommon.CacherStub{},
+		consensus.BlsConsensusType,
 	)
 	err := hdrIntVer.Verify(&block.MetaBlock{})
 	require.True(t, errors.Is(err, ErrInvalidSoftwareVersion))
@@ -204,6 +259,7 @@ func TestHeaderIntegrityVerifierr_VerifySoftwareVersionWrongVersionShouldErr(t *
 		},
 		defaultVersion,
 		&testscommon.CacherStub{},
+		consensus.BlsConsensusType,
 	)
 	err := hdrIntVer.Verify(
 		&block.MetaBlock{
@@ -232,6 +288,7 @@ func TestHeaderIntegrityVerifier_VerifySoftwareVersionWildcardShouldWork(t *test
 		},
 		defaultVersion,
 		&testscommon.CacherStub{},
+		consensus.BlsConsensusType,
 	)
 	err := hdrIntVer.Verify(
 		&block.MetaBlock{
@@ -252,6 +309,7 @@ func TestHeaderIntegrityVerifier_VerifyHdrChainIDAndReferenceChainIDMismatchShou
 		versionsCorrectlyConstructed,
 		"software",
 		&testscommon.CacherStub{},
+		consensus.BlsConsensusType,
 	)
 	mb := &block.MetaBlock{
 		SoftwareVersion: []byte("software"),
@@ -270,6 +328,7 @@ func TestHeaderIntegrityVerifier_VerifyShouldWork(t *testing.T) {
 		versionsCorrectlyConstructed,
 		"software",
 		&testscommon.CacherStub{},
+		consensus.BlsConsensusType,
 	)
 	mb := &block.MetaBlock{
 		SoftwareVersion: []byte("software"),
@@ -288,6 +347,7 @@ func TestHeaderIntegrityVerifier_VerifyNotWildcardShouldWork(t *testing.T) {
 		versionsCorrectlyConstructed,
 		"software",
 		&testscommon.CacherStub{},
+		consensus.BlsConsensusType,
 	)
 	mb := &block.MetaBlock{
 		SoftwareVersion: []byte("v1"),
@@ -336,6 +396,7 @@ func TestHeaderIntegrityVerifier_GetVersionShouldWork(t *testing.T) {
 				return false
 			},
 		},
+		consensus.BlsConsensusType,
 	)
 
 	assert.Equal(t, defaultVersion, hdrIntVer.GetVersion(0))
@@ -363,6 +424,7 @@ func TestHeaderIntegrityVerifier_ExistsInInternalCacheShouldReturn(t *testing.T)
 				return cachedVersion, true
 			},
 		},
+		consensus.BlsConsensusType,
 	)
 
 	assert.Equal(t, cachedVersion, hdrIntVer.GetVersion(0))
@@ -4,6 +4,7 @@ import (
 	"math/bits"
 
 	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/crypto"
@@ -27,6 +28,7 @@ type ArgsHeaderSigVerifier struct {
 	SingleSigVerifier       crypto.SingleSigner
 	KeyGen                  crypto.KeyGenerator
 	FallbackHeaderValidator process.FallbackHeaderValidator
+	ConsensusType           string
 }
 
 //HeaderSigVerifier is component used to check if a header is valid
@@ -38,6 +40,7 @@ type HeaderSigVerifier struct {
 	singleSigVerifier       crypto.SingleSigner
 	keyGen                  crypto.KeyGenerator
 	fallbackHeaderValidator process.FallbackHeaderValidator
+	isConsensusViewEnabled  bool
 }
 
 // NewHeaderSigVerifier will create a new instance of HeaderSigVerifier
@@ -55,6 +58,7 @@ func NewHeaderSigVerifier(arguments *ArgsHeaderSigVerifier) (*HeaderSigVerifier,
 		singleSigVerifier:       arguments.SingleSigVerifier,
 		keyGen:                  arguments.KeyGen,
 		fallbackHeaderValidator: arguments.FallbackHeaderValidator,
+		isConsensusViewEnabled:  arguments.ConsensusType == consensus.PbftConsensusType,
 	}, nil
 }
 
@@ -94,7 +98,11 @@ func (hsv *HeaderSigVerifier) VerifySignature(header data.HeaderHandler) error {
 	if len(bitmap) == 0 {
 		return process.ErrNilPubKeysBitmap
 	}
-	if bitmap[0]&1 == 0 {
+	view, err := core.GetConsensusView(header.GetReserved(), hsv.isConsensusViewEnabled)
+	if err != nil {
+		return err
+	}
+	if view == 0 && bitmap[0]&1 == 0 {
 		return process.ErrBlockProposerSignatureMissing
 	}
 
@@ -119,6 +127,12 @@ func (hsv *HeaderSigVerifier) VerifySignature(header data.HeaderHandler) error {
 		return err
 	}
 
+	// blocks proposed after view changes are signed by the leader of that view
+	leaderIndex := core.GetConsensusLeaderIndex(view, len(consensusPubKeys))
+	if bitmap[leaderIndex/8]&(1<<uint8(leaderIndex%8)) == 0 {
+		return process.ErrBlockProposerSignatureMissing
+	}
+
 	verifier, err := hsv.multiSigVerifier.Create(consensusPubKeys, 0)
 	if err != nil {
 		return err
@@ -274,7 +288,12 @@ func (hsv *HeaderSigVerifier) getLeader(header data.HeaderHandler) (crypto.Publi
 		return nil, err
 	}
 
-	leaderPubKeyValidator := headerConsensusGroup[0]
+	view, err := core.GetConsensusView(header.GetReserved(), hsv.isConsensusViewEnabled)
+	if err != nil {
+		return nil, err
+	}
+
+	leaderPubKeyValidator := headerConsensusGroup[core.GetConsensusLeaderIndex(view, len(headerConsensusGroup))]
 	return hsv.keyGen.PublicKeyFromByteArray(leaderPubKeyValidator.PubKey())
 }
 
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data"
 	dataBlock "github.com/ElrondNetwork/elrond-go/data/block"
@@ -26,6 +28,7 @@ func createHeaderSigVerifierArgs() *ArgsHeaderSigVerifier {
 		SingleSigVerifier:       &mock.SignerMock{},
 		KeyGen:                  &mock.SingleSignKeyGenMock{},
 		FallbackHeaderValidator: &testscommon.FallBackHeaderValidatorStub{},
+		ConsensusType:           consensus.BlsConsensusType,
 	}
 }
 
@@ -437,6 +440,90 @@ func TestHeaderSigVerifier_VerifyLeaderSignatureOk(t *testing.T) {
 	require.Equal(t, 1, count)
 }
 
+func TestHeaderSigVerifier_VerifyLeaderSignatureAfterViewChangeShouldUseViewLeader(t *testing.T) {
+	t.Parallel()
+
+	args := createHeaderSigVerifierArgs()
+	args.ConsensusType = consensus.PbftConsensusType
+	var leaderPubKey []byte
+	args.KeyGen = &mock.SingleSignKeyGenMock{
+		PublicKeyFromByteArrayCalled: func(b []byte) (key crypto.PublicKey, err error) {
+			leaderPubKey = b
+			return &mock.SingleSignPublicKey{}, nil
+		},
+	}
+	args.SingleSigVerifier = &mock.SignerMock{
+		VerifyStub: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+			return nil
+		},
+	}
+
+	pkAddresses := [][]byte{
+		[]byte("aaa00000000000000000000000000000"),
+		[]byte("bbb00000000000000000000000000000"),
+		[]byte("ccc00000000000000000000000000000"),
+	}
+	args.NodesCoordinator = &mock.NodesCoordinatorMock{
+		ComputeValidatorsGroupCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) (validators []sharding.Validator, err error) {
+			validators = make([]sharding.Validator, 0, len(pkAddresses))
+			for _, pkAddr := range pkAddresses {
+				v, _ := sharding.NewValidator(pkAddr, 1, defaultChancesSelection)
+				validators = append(validators, v)
+			}
+			return validators, nil
+		},
+	}
+	hdrSigVerifier, _ := NewHeaderSigVerifier(args)
+	header := &dataBlock.Header{
+		Reserved: core.EncodeConsensusView(4),
+	}
+
+	err := hdrSigVerifier.VerifyLeaderSignature(header)
+	require.Nil(t, err)
+	require.Equal(t, pkAddresses[1], leaderPubKey)
+}
+
+func TestHeaderSigVerifier_VerifyLeaderSignatureInvalidConsensusViewShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createHeaderSigVerifierArgs()
+	args.ConsensusType = consensus.PbftConsensusType
+	pkAddr := []byte("aaa00000000000000000000000000000")
+	args.NodesCoordinator = &mock.NodesCoordinatorMock{
+		ComputeValidatorsGroupCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) (validators []sharding.Validator, err error) {
+			v, _ := sharding.NewValidator(pkAddr, 1, defaultChancesSelection)
+			return []sharding.Validator{v}, nil
+		},
+	}
+	hdrSigVerifier, _ := NewHeaderSigVerifier(args)
+	header := &dataBlock.Header{
+		Reserved: []byte("r"),
+	}
+
+	err := hdrSigVerifier.VerifyLeaderSignature(header)
+	require.Equal(t, core.ErrInvalidConsensusView, err)
+}
+
+func TestHeaderSigVerifier_VerifyLeaderSignatureConsensusViewOnBlsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createHeaderSigVerifierArgs()
+	pkAddr := []byte("aaa00000000000000000000000000000")
+	args.NodesCoordinator = &mock.NodesCoordinatorMock{
+		ComputeValidatorsGroupCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) (validators []sharding.Validator, err error) {
+			v, _ := sharding.NewValidator(pkAddr, 1, defaultChancesSelection)
+			return []sharding.Validator{v, v, v}, nil
+		},
+	}
+	hdrSigVerifier, _ := NewHeaderSigVerifier(args)
+	header := &dataBlock.Header{
+		Reserved: core.EncodeConsensusView(4),
+	}
+
+	err := hdrSigVerifier.VerifyLeaderSignature(header)
+	require.Equal(t, core.ErrInvalidConsensusView, err)
+}
+
 func TestHeaderSigVerifier_VerifySignatureNilBitmapShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -461,6 +548,49 @@ func TestHeaderSigVerifier_VerifySignatureBlockProposerSigMissingShouldErr(t *te
 	require.Equal(t, process.ErrBlockProposerSignatureMissing, err)
 }
 
+func TestHeaderSigVerifier_VerifySignatureViewLeaderSigMissingShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createHeaderSigVerifierArgs()
+	args.ConsensusType = consensus.PbftConsensusType
+	pkAddr := []byte("aaa00000000000000000000000000000")
+	args.NodesCoordinator = &mock.NodesCoordinatorMock{
+		ComputeValidatorsGroupCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) (validators []sharding.Validator, err error) {
+			v, _ := sharding.NewValidator(pkAddr, 1, defaultChancesSelection)
+			return []sharding.Validator{v, v, v, v, v}, nil
+		},
+	}
+	hdrSigVerifier, _ := NewHeaderSigVerifier(args)
+	header := &dataBlock.Header{
+		PubKeysBitmap: []byte{0x1D},
+		Reserved:      core.EncodeConsensusView(1),
+	}
+
+	err := hdrSigVerifier.VerifySignature(header)
+	require.Equal(t, process.ErrBlockProposerSignatureMissing, err)
+}
+
+func TestHeaderSigVerifier_VerifySignatureConsensusViewOnBlsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createHeaderSigVerifierArgs()
+	pkAddr := []byte("aaa00000000000000000000000000000")
+	args.NodesCoordinator = &mock.NodesCoordinatorMock{
+		ComputeValidatorsGroupCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) (validators []sharding.Validator, err error) {
+			v, _ := sharding.NewValidator(pkAddr, 1, defaultChancesSelection)
+			return []sharding.Validator{v, v, v, v, v}, nil
+		},
+	}
+	hdrSigVerifier, _ := NewHeaderSigVerifier(args)
+	header := &dataBlock.Header{
+		PubKeysBitmap: []byte{0x1F},
+		Reserved:      core.EncodeConsensusView(1),
+	}
+
+	err := hdrSigVerifier.VerifySignature(header)
+	require.Equal(t, core.ErrInvalidConsensusView, err)
+}
+
 func TestHeaderSigVerifier_VerifySignatureNilRandomnessShouldErr(t *testing.T) {
 	t.Parallel()
 
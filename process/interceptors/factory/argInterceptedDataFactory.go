@@ -35,4 +35,5 @@ type ArgInterceptedDataFactory struct {
 	EnableSignTxWithHashEpoch uint32
 	TxSignHasher              hashing.Hasher
 	EpochNotifier             process.EpochNotifier
+	SlashingEvidenceVerifier  process.SlashingEvidenceVerifier
 }
@@ -52,28 +52,29 @@ func createMockFeeHandler() process.FeeHandler {
 
 func createMockArgument() *ArgInterceptedDataFactory {
 	return &ArgInterceptedDataFactory{
-		ProtoMarshalizer:        &mock.MarshalizerMock{},
-		TxSignMarshalizer:       &mock.MarshalizerMock{},
-		Hasher:                  mock.HasherMock{},
-		ShardCoordinator:        mock.NewOneShardCoordinatorMock(),
-		MultiSigVerifier:        mock.NewMultiSigner(),
-		NodesCoordinator:        mock.NewNodesCoordinatorMock(),
-		KeyGen:                  createMockKeyGen(),
-		BlockKeyGen:             createMockKeyGen(),
-		Signer:                  createMockSigner(),
-		BlockSigner:             createMockSigner(),
-		AddressPubkeyConv:       createMockPubkeyConverter(),
-		FeeHandler:              createMockFeeHandler(),
-		HeaderSigVerifier:       &mock.HeaderSigVerifierStub{},
-		HeaderIntegrityVerifier: &mock.HeaderIntegrityVerifierStub{},
-		ValidityAttester:        &mock.ValidityAttesterStub{},
-		EpochStartTrigger:       &mock.EpochStartTriggerStub{},
-		WhiteListerVerifiedTxs:  &mock.WhiteListHandlerStub{},
-		ArgsParser:              &mock.ArgumentParserMock{},
-		ChainID:                 []byte("chainID"),
-		MinTransactionVersion:   1,
-		TxSignHasher:            mock.HasherMock{},
-		EpochNotifier:           &mock.EpochNotifierStub{},
+		ProtoMarshalizer:         &mock.MarshalizerMock{},
+		TxSignMarshalizer:        &mock.MarshalizerMock{},
+		Hasher:                   mock.HasherMock{},
+		ShardCoordinator:         mock.NewOneShardCoordinatorMock(),
+		MultiSigVerifier:         mock.NewMultiSigner(),
+		NodesCoordinator:         mock.NewNodesCoordinatorMock(),
+		KeyGen:                   createMockKeyGen(),
+		BlockKeyGen:              createMockKeyGen(),
+		Signer:                   createMockSigner(),
+		BlockSigner:              createMockSigner(),
+		AddressPubkeyConv:        createMockPubkeyConverter(),
+		FeeHandler:               createMockFeeHandler(),
+		HeaderSigVerifier:        &mock.HeaderSigVerifierStub{},
+		HeaderIntegrityVerifier:  &mock.HeaderIntegrityVerifierStub{},
+		ValidityAttester:         &mock.ValidityAttesterStub{},
+		EpochStartTrigger:        &mock.EpochStartTriggerStub{},
+		WhiteListerVerifiedTxs:   &mock.WhiteListHandlerStub{},
+		ArgsParser:               &mock.ArgumentParserMock{},
+		ChainID:                  []byte("chainID"),
+		MinTransactionVersion:    1,
+		TxSignHasher:             mock.HasherMock{},
+		EpochNotifier:            &mock.EpochNotifierStub{},
+		SlashingEvidenceVerifier: &mock.SlashingEvidenceVerifierStub{},
 	}
 }
 
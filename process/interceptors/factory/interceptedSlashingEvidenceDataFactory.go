@@ -0,0 +1,51 @@
+package factory
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/slash"
+)
+
+var _ process.InterceptedDataFactory = (*interceptedSlashingEvidenceDataFactory)(nil)
+
+type interceptedSlashingEvidenceDataFactory struct {
+	marshalizer marshal.Marshalizer
+	hasher      hashing.Hasher
+	verifier    process.SlashingEvidenceVerifier
+}
+
+// NewInterceptedSlashingEvidenceDataFactory creates an instance of interceptedSlashingEvidenceDataFactory
+func NewInterceptedSlashingEvidenceDataFactory(
+	argument *ArgInterceptedDataFactory,
+) (*interceptedSlashingEvidenceDataFactory, error) {
+	if argument == nil {
+		return nil, process.ErrNilArgumentStruct
+	}
+	if check.IfNil(argument.ProtoMarshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(argument.Hasher) {
+		return nil, process.ErrNilHasher
+	}
+	if check.IfNil(argument.SlashingEvidenceVerifier) {
+		return nil, process.ErrNilSlashingEvidenceVerifier
+	}
+
+	return &interceptedSlashingEvidenceDataFactory{
+		marshalizer: argument.ProtoMarshalizer,
+		hasher:      argument.Hasher,
+		verifier:    argument.SlashingEvidenceVerifier,
+	}, nil
+}
+
+// Create creates instances of InterceptedData by unmarshalling provided buffer
+func (isedf *interceptedSlashingEvidenceDataFactory) Create(buff []byte) (process.InterceptedData, error) {
+	return slash.NewInterceptedSlashingEvidence(buff, isedf.marshalizer, isedf.hasher, isedf.verifier)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (isedf *interceptedSlashingEvidenceDataFactory) IsInterfaceNil() bool {
+	return isedf == nil
+}
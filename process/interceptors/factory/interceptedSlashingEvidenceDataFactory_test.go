@@ -0,0 +1,75 @@
+package factory
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/slash"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewInterceptedSlashingEvidenceDataFactory_NilArgumentShouldErr(t *testing.T) {
+	t.Parallel()
+
+	isedf, err := NewInterceptedSlashingEvidenceDataFactory(nil)
+
+	assert.True(t, check.IfNil(isedf))
+	assert.Equal(t, process.ErrNilArgumentStruct, err)
+}
+
+func TestNewInterceptedSlashingEvidenceDataFactory_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	arg.ProtoMarshalizer = nil
+
+	isedf, err := NewInterceptedSlashingEvidenceDataFactory(arg)
+	assert.True(t, check.IfNil(isedf))
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestNewInterceptedSlashingEvidenceDataFactory_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	arg.Hasher = nil
+
+	isedf, err := NewInterceptedSlashingEvidenceDataFactory(arg)
+	assert.True(t, check.IfNil(isedf))
+	assert.Equal(t, process.ErrNilHasher, err)
+}
+
+func TestNewInterceptedSlashingEvidenceDataFactory_NilSlashingEvidenceVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	arg.SlashingEvidenceVerifier = nil
+
+	isedf, err := NewInterceptedSlashingEvidenceDataFactory(arg)
+	assert.True(t, check.IfNil(isedf))
+	assert.Equal(t, process.ErrNilSlashingEvidenceVerifier, err)
+}
+
+func TestInterceptedSlashingEvidenceDataFactory_ShouldWorkAndCreate(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	arg.ProtoMarshalizer = &marshal.GogoProtoMarshalizer{}
+
+	isedf, err := NewInterceptedSlashingEvidenceDataFactory(arg)
+	assert.False(t, check.IfNil(isedf))
+	assert.Nil(t, err)
+
+	evidence := &block.SlashingEvidence{PubKey: []byte("pk"), Round: 3}
+	buff, _ := arg.ProtoMarshalizer.Marshal(evidence)
+
+	interceptedData, err := isedf.Create(buff)
+	assert.Nil(t, err)
+
+	interceptedEvidence, ok := interceptedData.(*slash.InterceptedSlashingEvidence)
+	assert.True(t, ok)
+	assert.Equal(t, evidence, interceptedEvidence.Evidence())
+}
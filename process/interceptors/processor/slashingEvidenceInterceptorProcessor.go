@@ -0,0 +1,52 @@
+package processor
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/slash"
+)
+
+var _ process.InterceptorProcessor = (*SlashingEvidenceInterceptorProcessor)(nil)
+
+// SlashingEvidenceInterceptorProcessor is the processor used when intercepting slashing evidence
+type SlashingEvidenceInterceptorProcessor struct {
+	evidencePool process.SlashingEvidencePool
+}
+
+// NewSlashingEvidenceInterceptorProcessor creates a new instance of SlashingEvidenceInterceptorProcessor
+func NewSlashingEvidenceInterceptorProcessor(evidencePool process.SlashingEvidencePool) (*SlashingEvidenceInterceptorProcessor, error) {
+	if check.IfNil(evidencePool) {
+		return nil, process.ErrNilSlashingEvidencePool
+	}
+
+	return &SlashingEvidenceInterceptorProcessor{
+		evidencePool: evidencePool,
+	}, nil
+}
+
+// Validate checks if the intercepted data can be processed
+func (seip *SlashingEvidenceInterceptorProcessor) Validate(_ process.InterceptedData, _ core.PeerID) error {
+	return nil
+}
+
+// Save saves the intercepted slashing evidence in the evidence pool
+func (seip *SlashingEvidenceInterceptorProcessor) Save(data process.InterceptedData, _ core.PeerID, _ string) error {
+	interceptedEvidence, ok := data.(*slash.InterceptedSlashingEvidence)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+
+	seip.evidencePool.AddEvidence(interceptedEvidence.Evidence())
+	return nil
+}
+
+// RegisterHandler registers a callback function to be notified of incoming slashing evidence
+func (seip *SlashingEvidenceInterceptorProcessor) RegisterHandler(_ func(topic string, hash []byte, data interface{})) {
+	log.Error("slashingEvidenceInterceptorProcessor.RegisterHandler", "error", "not implemented")
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (seip *SlashingEvidenceInterceptorProcessor) IsInterfaceNil() bool {
+	return seip == nil
+}
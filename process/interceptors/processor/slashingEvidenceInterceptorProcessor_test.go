@@ -0,0 +1,81 @@
+package processor_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/interceptors/processor"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/slash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSlashingEvidenceInterceptorProcessor_NilPoolShouldErr(t *testing.T) {
+	t.Parallel()
+
+	seip, err := processor.NewSlashingEvidenceInterceptorProcessor(nil)
+	assert.Nil(t, seip)
+	assert.Equal(t, process.ErrNilSlashingEvidencePool, err)
+}
+
+func TestNewSlashingEvidenceInterceptorProcessor_OkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	pool, _ := slash.NewEvidencePool(10)
+	seip, err := processor.NewSlashingEvidenceInterceptorProcessor(pool)
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(seip))
+}
+
+//------- Validate
+
+func TestSlashingEvidenceInterceptorProcessor_ValidateShouldWork(t *testing.T) {
+	t.Parallel()
+
+	pool, _ := slash.NewEvidencePool(10)
+	seip, _ := processor.NewSlashingEvidenceInterceptorProcessor(pool)
+
+	assert.Nil(t, seip.Validate(nil, ""))
+}
+
+//------- Save
+
+func TestSlashingEvidenceInterceptorProcessor_SaveWrongTypeAssertion(t *testing.T) {
+	t.Parallel()
+
+	pool, _ := slash.NewEvidencePool(10)
+	seip, _ := processor.NewSlashingEvidenceInterceptorProcessor(pool)
+
+	err := seip.Save(nil, "", "")
+	assert.Equal(t, process.ErrWrongTypeAssertion, err)
+}
+
+func TestSlashingEvidenceInterceptorProcessor_SaveShouldAddInPool(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	evidence := &block.SlashingEvidence{PubKey: []byte("pk"), Round: 3}
+	buff, _ := marshalizer.Marshal(evidence)
+	interceptedEvidence, err := slash.NewInterceptedSlashingEvidence(buff, marshalizer, mock.HasherMock{}, &mock.SlashingEvidenceVerifierStub{})
+	require.Nil(t, err)
+
+	pool, _ := slash.NewEvidencePool(10)
+	seip, _ := processor.NewSlashingEvidenceInterceptorProcessor(pool)
+
+	err = seip.Save(interceptedEvidence, "", "")
+	assert.Nil(t, err)
+	assert.True(t, pool.HasEvidence([]byte("pk"), 3))
+}
+
+//------- IsInterfaceNil
+
+func TestSlashingEvidenceInterceptorProcessor_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var seip *processor.SlashingEvidenceInterceptorProcessor
+	assert.True(t, check.IfNil(seip))
+}
@@ -310,6 +310,7 @@ type HashAccesser interface {
 type Bootstrapper interface {
 	Close() error
 	AddSyncStateListener(func(isSyncing bool))
+	AddBeforeSyncBlockHandler(handler func())
 	GetNodeState() core.NodeState
 	StartSyncingBlocks()
 	SetStatusHandler(handler core.AppStatusHandler) error
@@ -448,6 +449,27 @@ type BlockChainHookHandler interface {
 	GetBuiltInFunctions() BuiltInFunctionContainer
 	NewAddress(creatorAddress []byte, creatorNonce uint64, vmType []byte) ([]byte, error)
 	DeleteCompiledCode(codeHash []byte)
+	SetCallTracer(tracer SCCallTracer) error
+	IsInterfaceNil() bool
+}
+
+// TracedCall holds the input of a call recorded by a SCCallTracer
+type TracedCall struct {
+	CallType    string
+	Caller      []byte
+	Recipient   []byte
+	Function    string
+	Value       *big.Int
+	GasProvided uint64
+}
+
+// SCCallTracer records the calls executed on behalf of a transaction, along with their outcome. The calls are
+// identified by the hash of the transaction that originated them
+type SCCallTracer interface {
+	IsTracing(originalTxHash []byte) bool
+	EnterCall(originalTxHash []byte, call *TracedCall)
+	ExitCall(originalTxHash []byte, vmOutput *vmcommon.VMOutput, err error)
+	RecordStorageWrite(originalTxHash []byte, address []byte, key []byte, oldValue []byte, newValue []byte)
 	IsInterfaceNil() bool
 }
 
@@ -674,6 +696,10 @@ type SCQuery struct {
 	CallerAddr []byte
 	CallValue  *big.Int
 	Arguments  [][]byte
+	// BlockQueryOptions selects the block at which the query should be executed
+	BlockQueryOptions state.BlockQueryOptions
+	// BlockRootHash is the state root hash of the selected block, an empty value meaning the current state
+	BlockRootHash []byte
 }
 
 // GasHandler is able to perform some gas calculation
@@ -805,6 +831,13 @@ type SCQueryService interface {
 	IsInterfaceNil() bool
 }
 
+// AccountsStateSwitcher defines an accounts adapter that can temporarily serve the state found at another root hash
+type AccountsStateSwitcher interface {
+	SwitchToRootHash(rootHash []byte) error
+	SwitchToCurrentState()
+	IsInterfaceNil() bool
+}
+
 // EpochStartDataCreator defines the functionality for node to create epoch start data
 type EpochStartDataCreator interface {
 	CreateEpochStartData() (*block.EpochStart, error)
@@ -1020,3 +1053,28 @@ type FallbackHeaderValidator interface {
 	ShouldApplyFallbackValidation(headerHandler data.HeaderHandler) bool
 	IsInterfaceNil() bool
 }
+
+// SlashingEvidenceVerifier checks that a slashing evidence proves that one key signed two different messages in the same round
+type SlashingEvidenceVerifier interface {
+	Verify(evidence *block.SlashingEvidence) error
+	IsInterfaceNil() bool
+}
+
+// SlashingEvidencePool holds the verified slashing evidence until it is included in a metachain block
+type SlashingEvidencePool interface {
+	AddEvidence(evidence *block.SlashingEvidence) bool
+	HasEvidence(pubKey []byte, round uint64) bool
+	GetEvidence(maxNum int) []*block.SlashingEvidence
+	RemoveEvidence(evidence *block.SlashingEvidence)
+	Len() int
+	IsInterfaceNil() bool
+}
+
+// SlashingProcessor is able to select, execute and verify the slashing evidence included in a metachain block
+type SlashingProcessor interface {
+	CreateSlashingEvidence() ([]block.SlashingEvidence, error)
+	ProcessSlashingEvidence(evidence []block.SlashingEvidence) error
+	RemoveSlashingEvidenceFromPool(evidence []block.SlashingEvidence)
+	RestoreSlashingEvidenceIntoPool(evidence []block.SlashingEvidence)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,28 @@
+package mock
+
+// AccountsStateSwitcherStub -
+type AccountsStateSwitcherStub struct {
+	SwitchToRootHashCalled     func(rootHash []byte) error
+	SwitchToCurrentStateCalled func()
+}
+
+// SwitchToRootHash -
+func (stub *AccountsStateSwitcherStub) SwitchToRootHash(rootHash []byte) error {
+	if stub.SwitchToRootHashCalled != nil {
+		return stub.SwitchToRootHashCalled(rootHash)
+	}
+
+	return nil
+}
+
+// SwitchToCurrentState -
+func (stub *AccountsStateSwitcherStub) SwitchToCurrentState() {
+	if stub.SwitchToCurrentStateCalled != nil {
+		stub.SwitchToCurrentStateCalled()
+	}
+}
+
+// IsInterfaceNil -
+func (stub *AccountsStateSwitcherStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -11,6 +11,7 @@ type BlockChainHookHandlerMock struct {
 	NewAddressCalled         func(creatorAddress []byte, creatorNonce uint64, vmType []byte) ([]byte, error)
 	IsPayableCalled          func(address []byte) (bool, error)
 	DeleteCompiledCodeCalled func(codeHash []byte)
+	SetCallTracerCalled      func(tracer process.SCCallTracer) error
 }
 
 // IsPayable -
@@ -49,6 +50,14 @@ func (e *BlockChainHookHandlerMock) DeleteCompiledCode(codeHash []byte) {
 	}
 }
 
+// SetCallTracer -
+func (e *BlockChainHookHandlerMock) SetCallTracer(tracer process.SCCallTracer) error {
+	if e.SetCallTracerCalled != nil {
+		return e.SetCallTracerCalled(tracer)
+	}
+	return nil
+}
+
 // IsInterfaceNil -
 func (e *BlockChainHookHandlerMock) IsInterfaceNil() bool {
 	return e == nil
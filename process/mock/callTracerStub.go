@@ -0,0 +1,48 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// CallTracerStub -
+type CallTracerStub struct {
+	IsTracingCalled          func(originalTxHash []byte) bool
+	EnterCallCalled          func(originalTxHash []byte, call *process.TracedCall)
+	ExitCallCalled           func(originalTxHash []byte, vmOutput *vmcommon.VMOutput, err error)
+	RecordStorageWriteCalled func(originalTxHash []byte, address []byte, key []byte, oldValue []byte, newValue []byte)
+}
+
+// IsTracing -
+func (cts *CallTracerStub) IsTracing(originalTxHash []byte) bool {
+	if cts.IsTracingCalled != nil {
+		return cts.IsTracingCalled(originalTxHash)
+	}
+	return false
+}
+
+// EnterCall -
+func (cts *CallTracerStub) EnterCall(originalTxHash []byte, call *process.TracedCall) {
+	if cts.EnterCallCalled != nil {
+		cts.EnterCallCalled(originalTxHash, call)
+	}
+}
+
+// ExitCall -
+func (cts *CallTracerStub) ExitCall(originalTxHash []byte, vmOutput *vmcommon.VMOutput, err error) {
+	if cts.ExitCallCalled != nil {
+		cts.ExitCallCalled(originalTxHash, vmOutput, err)
+	}
+}
+
+// RecordStorageWrite -
+func (cts *CallTracerStub) RecordStorageWrite(originalTxHash []byte, address []byte, key []byte, oldValue []byte, newValue []byte) {
+	if cts.RecordStorageWriteCalled != nil {
+		cts.RecordStorageWriteCalled(originalTxHash, address, key, oldValue, newValue)
+	}
+}
+
+// IsInterfaceNil -
+func (cts *CallTracerStub) IsInterfaceNil() bool {
+	return cts == nil
+}
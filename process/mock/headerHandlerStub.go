@@ -203,6 +203,11 @@ func (hhs *HeaderHandlerStub) SetLeaderSignature(_ []byte) {
 	panic("implement me")
 }
 
+// SetReserved -
+func (hhs *HeaderHandlerStub) SetReserved(_ []byte) {
+	panic("implement me")
+}
+
 // SetChainID -
 func (hhs *HeaderHandlerStub) SetChainID(_ []byte) {
 	panic("implement me")
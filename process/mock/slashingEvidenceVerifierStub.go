@@ -0,0 +1,23 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/block"
+)
+
+// SlashingEvidenceVerifierStub -
+type SlashingEvidenceVerifierStub struct {
+	VerifyCalled func(evidence *block.SlashingEvidence) error
+}
+
+// Verify -
+func (sevs *SlashingEvidenceVerifierStub) Verify(evidence *block.SlashingEvidence) error {
+	if sevs.VerifyCalled != nil {
+		return sevs.VerifyCalled(evidence)
+	}
+	return nil
+}
+
+// IsInterfaceNil -
+func (sevs *SlashingEvidenceVerifierStub) IsInterfaceNil() bool {
+	return sevs == nil
+}
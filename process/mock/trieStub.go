@@ -22,6 +22,7 @@ type TrieStub struct {
 	SnapshotCalled              func() error
 	GetSerializedNodesCalled    func([]byte, uint64) ([][]byte, uint64, error)
 	GetAllHashesCalled          func() ([][]byte, error)
+	GetProofCalled              func(key []byte) ([][]byte, error)
 	DatabaseCalled              func() data.DBWriteCacher
 	GetAllLeavesOnChannelCalled func(rootHash []byte) (chan core.KeyValueHolder, error)
 }
@@ -200,6 +201,15 @@ func (ts *TrieStub) GetAllHashes() ([][]byte, error) {
 	return nil, nil
 }
 
+// GetProof -
+func (ts *TrieStub) GetProof(key []byte) ([][]byte, error) {
+	if ts.GetProofCalled != nil {
+		return ts.GetProofCalled(key)
+	}
+
+	return nil, nil
+}
+
 // GetSnapshotDbBatchDelay -
 func (ts *TrieStub) GetSnapshotDbBatchDelay() int {
 	return 0
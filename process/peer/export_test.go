@@ -66,3 +66,8 @@ func (vs *validatorStatistics) UpdateShardDataPeerState(
 func GetActualList(peerAccount state.PeerAccountHandler) string {
 	return getActualList(peerAccount)
 }
+
+// GetLeaderIndex -
+func (vs *validatorStatistics) GetLeaderIndex(header data.HeaderHandler, consensusSize int) (int, error) {
+	return vs.getLeaderIndex(header, consensusSize)
+}
@@ -9,6 +9,7 @@ import (
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/atomic"
 	"github.com/ElrondNetwork/elrond-go/core/check"
@@ -53,6 +54,7 @@ type ArgValidatorStatisticsProcessor struct {
 	SwitchJailWaitingEnableEpoch    uint32
 	BelowSignedThresholdEnableEpoch uint32
 	EpochNotifier                   process.EpochNotifier
+	ConsensusType                   string
 }
 
 type validatorStatistics struct {
@@ -74,6 +76,7 @@ type validatorStatistics struct {
 	jailedEnableEpoch               uint32
 	belowSignedThresholdEnableEpoch uint32
 	flagJailedEnabled               atomic.Flag
+	isConsensusViewEnabled          bool
 }
 
 // NewValidatorStatisticsProcessor instantiates a new validatorStatistics structure responsible of keeping account of
@@ -132,6 +135,7 @@ func NewValidatorStatisticsProcessor(arguments ArgValidatorStatisticsProcessor)
 		ratingEnableEpoch:               arguments.RatingEnableEpoch,
 		jailedEnableEpoch:               arguments.SwitchJailWaitingEnableEpoch,
 		belowSignedThresholdEnableEpoch: arguments.BelowSignedThresholdEnableEpoch,
+		isConsensusViewEnabled:          arguments.ConsensusType == consensus.PbftConsensusType,
 	}
 
 	arguments.EpochNotifier.RegisterNotifyHandler(vs)
@@ -364,10 +368,15 @@ func (vs *validatorStatistics) UpdatePeerState(header data.HeaderHandler, cache
 	if err != nil {
 		return nil, err
 	}
-	leaderPK := core.GetTrimmedPk(vs.pubkeyConv.Encode(consensusGroup[0].PubKey()))
+	leaderIndex, err := vs.getLeaderIndex(previousHeader, len(consensusGroup))
+	if err != nil {
+		return nil, err
+	}
+	leaderPK := core.GetTrimmedPk(vs.pubkeyConv.Encode(consensusGroup[leaderIndex].PubKey()))
 	log.Trace("Increasing for leader", "leader", leaderPK, "round", previousHeader.GetRound())
 	err = vs.updateValidatorInfoOnSuccessfulBlock(
 		consensusGroup,
+		leaderIndex,
 		previousHeader.GetPubKeysBitmap(),
 		big.NewInt(0).Sub(previousHeader.GetAccumulatedFees(), previousHeader.GetDeveloperFees()),
 		previousHeader.GetShardID())
@@ -825,6 +834,17 @@ func (vs *validatorStatistics) decreaseForConsensusValidators(
 	return nil
 }
 
+// getLeaderIndex returns the consensus group index of the validator that proposed the provided header, which differs
+// from the first validator only for blocks proposed after leader view changes, on the consensus types which change views
+func (vs *validatorStatistics) getLeaderIndex(header data.HeaderHandler, consensusSize int) (int, error) {
+	view, err := core.GetConsensusView(header.GetReserved(), vs.isConsensusViewEnabled)
+	if err != nil {
+		return 0, err
+	}
+
+	return core.GetConsensusLeaderIndex(view, consensusSize), nil
+}
+
 // RevertPeerState takes the current and previous headers and undos the peer state
 //  for all of the consensus members
 func (vs *validatorStatistics) RevertPeerState(header data.HeaderHandler) error {
@@ -862,8 +882,14 @@ func (vs *validatorStatistics) updateShardDataPeerState(
 			return shardInfoErr
 		}
 
+		leaderIndex, shardInfoErr := vs.getLeaderIndex(currentHeader, len(shardConsensus))
+		if shardInfoErr != nil {
+			return shardInfoErr
+		}
+
 		shardInfoErr = vs.updateValidatorInfoOnSuccessfulBlock(
 			shardConsensus,
+			leaderIndex,
 			h.PubKeysBitmap,
 			big.NewInt(0).Sub(h.AccumulatedFees, h.DeveloperFees),
 			h.ShardID,
@@ -956,6 +982,7 @@ func (vs *validatorStatistics) savePeerAccountData(
 
 func (vs *validatorStatistics) updateValidatorInfoOnSuccessfulBlock(
 	validatorList []sharding.Validator,
+	leaderIndex int,
 	signingBitmap []byte,
 	accumulatedFees *big.Int,
 	shardId uint32,
@@ -974,7 +1001,7 @@ func (vs *validatorStatistics) updateValidatorInfoOnSuccessfulBlock(
 		peerAcc.IncreaseNumSelectedInSuccessBlocks()
 
 		newRating := peerAcc.GetRating()
-		isLeader := i == 0
+		isLeader := i == leaderIndex
 		validatorSigned := (signingBitmap[i/8] & (1 << (uint16(i) % 8))) != 0
 		actionType := vs.computeValidatorActionType(isLeader, validatorSigned)
 
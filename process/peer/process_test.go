@@ -8,6 +8,7 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/keyValStorage"
@@ -2518,3 +2519,30 @@ func TestValidatorStatisticsProcessor_getActualList(t *testing.T) {
 	computedJailedList := peer.GetActualList(jailedPeer)
 	assert.Equal(t, jailedList, computedJailedList)
 }
+
+func TestValidatorStatisticsProcessor_GetLeaderIndexOnBlsShouldRejectConsensusView(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockArguments()
+	arguments.ConsensusType = consensus.BlsConsensusType
+	validatorStatistics, _ := peer.NewValidatorStatisticsProcessor(arguments)
+
+	leaderIndex, err := validatorStatistics.GetLeaderIndex(&block.Header{}, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, leaderIndex)
+
+	_, err = validatorStatistics.GetLeaderIndex(&block.Header{Reserved: core.EncodeConsensusView(2)}, 5)
+	assert.Equal(t, core.ErrInvalidConsensusView, err)
+}
+
+func TestValidatorStatisticsProcessor_GetLeaderIndexOnPbftShouldReturnTheViewLeader(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockArguments()
+	arguments.ConsensusType = consensus.PbftConsensusType
+	validatorStatistics, _ := peer.NewValidatorStatisticsProcessor(arguments)
+
+	leaderIndex, err := validatorStatistics.GetLeaderIndex(&block.Header{Reserved: core.EncodeConsensusView(7)}, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, leaderIndex)
+}
@@ -0,0 +1,52 @@
+package slash
+
+import (
+	"encoding/binary"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+func createEvidenceKey(pubKey []byte, round uint64) string {
+	roundBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(roundBytes, round)
+
+	return string(pubKey) + string(roundBytes)
+}
+
+func computeConsensusGroup(nodesCoordinator sharding.NodesCoordinator, header data.HeaderHandler) ([]sharding.Validator, error) {
+	// TODO: remove if start of epoch block needs to be validated by the new epoch nodes
+	epoch := header.GetEpoch()
+	if header.IsStartOfEpochBlock() && epoch > 0 {
+		epoch = epoch - 1
+	}
+
+	consensusGroup, err := nodesCoordinator.ComputeConsensusGroup(
+		header.GetPrevRandSeed(),
+		header.GetRound(),
+		header.GetShardID(),
+		epoch,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(consensusGroup) == 0 {
+		return nil, sharding.ErrInvalidConsensusGroupSize
+	}
+
+	return consensusGroup, nil
+}
+
+func getConsensusLeader(
+	consensusGroup []sharding.Validator,
+	header data.HeaderHandler,
+	isConsensusViewEnabled bool,
+) ([]byte, error) {
+	view, err := core.GetConsensusView(header.GetReserved(), isConsensusViewEnabled)
+	if err != nil {
+		return nil, err
+	}
+
+	return consensusGroup[core.GetConsensusLeaderIndex(view, len(consensusGroup))].PubKey(), nil
+}
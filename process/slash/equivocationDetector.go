@@ -0,0 +1,336 @@
+package slash
+
+import (
+	"bytes"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+var log = logger.GetOrCreate("process/slash")
+
+const numRoundsToKeep = 10
+const maxProposedHeadersPerRound = 10
+const maxSignedMessagesPerKeyAndRound = 4
+
+// ArgsEquivocationDetector holds the arguments needed to create a new equivocation detector
+type ArgsEquivocationDetector struct {
+	Marshalizer      marshal.Marshalizer
+	Hasher           hashing.Hasher
+	ShardCoordinator sharding.Coordinator
+	NodesCoordinator sharding.NodesCoordinator
+	Verifier         process.SlashingEvidenceVerifier
+	EvidencePool     process.SlashingEvidencePool
+	Broadcaster      Broadcaster
+	ConsensusType    string
+}
+
+type signedMessage struct {
+	hash      []byte
+	message   []byte
+	signature []byte
+}
+
+// equivocationDetector collects the signed headers and signature shares seen in the last rounds and creates
+// slashing evidence whenever the same key signs two different headers in the same round
+type equivocationDetector struct {
+	marshalizer            marshal.Marshalizer
+	hasher                 hashing.Hasher
+	shardCoordinator       sharding.Coordinator
+	nodesCoordinator       sharding.NodesCoordinator
+	verifier               process.SlashingEvidenceVerifier
+	evidencePool           process.SlashingEvidencePool
+	broadcaster            Broadcaster
+	isConsensusViewEnabled bool
+
+	mut             sync.Mutex
+	highestRound    uint64
+	proposedHeaders map[uint64]map[string][]byte
+	signatureShares map[uint64]map[string][]*signedMessage
+	leaderHeaders   map[uint64]map[string][]*signedMessage
+}
+
+// NewEquivocationDetector creates a new equivocation detector
+func NewEquivocationDetector(args ArgsEquivocationDetector) (*equivocationDetector, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, process.ErrNilHasher
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+	if check.IfNil(args.NodesCoordinator) {
+		return nil, process.ErrNilNodesCoordinator
+	}
+	if check.IfNil(args.Verifier) {
+		return nil, process.ErrNilSlashingEvidenceVerifier
+	}
+	if check.IfNil(args.EvidencePool) {
+		return nil, process.ErrNilSlashingEvidencePool
+	}
+	if check.IfNil(args.Broadcaster) {
+		return nil, ErrNilBroadcaster
+	}
+
+	return &equivocationDetector{
+		marshalizer:            args.Marshalizer,
+		hasher:                 args.Hasher,
+		shardCoordinator:       args.ShardCoordinator,
+		nodesCoordinator:       args.NodesCoordinator,
+		verifier:               args.Verifier,
+		evidencePool:           args.EvidencePool,
+		broadcaster:            args.Broadcaster,
+		proposedHeaders:        make(map[uint64]map[string][]byte),
+		signatureShares:        make(map[uint64]map[string][]*signedMessage),
+		leaderHeaders:          make(map[uint64]map[string][]*signedMessage),
+		isConsensusViewEnabled: args.ConsensusType == consensus.PbftConsensusType,
+	}, nil
+}
+
+// ProcessConsensusMessage records the proposed headers and the signature shares from a consensus message
+func (ed *equivocationDetector) ProcessConsensusMessage(cnsMsg *consensus.Message) {
+	if cnsMsg == nil || cnsMsg.RoundIndex < 0 {
+		return
+	}
+
+	round := uint64(cnsMsg.RoundIndex)
+
+	ed.mut.Lock()
+	defer ed.mut.Unlock()
+
+	if !ed.updateHighestRound(round) {
+		return
+	}
+
+	if len(cnsMsg.Header) > 0 {
+		ed.addProposedHeader(round, cnsMsg.Header)
+	}
+
+	isSignatureShare := len(cnsMsg.SignatureShare) > 0 && len(cnsMsg.BlockHeaderHash) > 0 && len(cnsMsg.PubKey) > 0
+	if isSignatureShare {
+		msg := &signedMessage{
+			hash:      cnsMsg.BlockHeaderHash,
+			signature: cnsMsg.SignatureShare,
+		}
+		addSignedMessage(ed.signatureShares, round, cnsMsg.PubKey, msg)
+		ed.checkSignatureShares(round, cnsMsg.PubKey)
+	}
+}
+
+// ReceivedHeader records the leader signature of a received header
+func (ed *equivocationDetector) ReceivedHeader(header data.HeaderHandler, _ []byte) {
+	if check.IfNil(header) || len(header.GetLeaderSignature()) == 0 {
+		return
+	}
+
+	leaderPubKey, err := ed.getLeader(header)
+	if err != nil {
+		log.Trace("equivocationDetector.ReceivedHeader: getLeader", "error", err.Error())
+		return
+	}
+
+	headerCopy := header.Clone()
+	headerCopy.SetLeaderSignature(nil)
+	headerBytes, err := ed.marshalizer.Marshal(headerCopy)
+	if err != nil {
+		log.Trace("equivocationDetector.ReceivedHeader: Marshal", "error", err.Error())
+		return
+	}
+
+	round := header.GetRound()
+	msg := &signedMessage{
+		hash:      ed.hasher.Compute(string(headerBytes)),
+		message:   headerBytes,
+		signature: header.GetLeaderSignature(),
+	}
+
+	ed.mut.Lock()
+	defer ed.mut.Unlock()
+
+	if !ed.updateHighestRound(round) {
+		return
+	}
+
+	addSignedMessage(ed.leaderHeaders, round, leaderPubKey, msg)
+	ed.checkLeaderHeaders(round, leaderPubKey, header.GetShardID())
+}
+
+func (ed *equivocationDetector) getLeader(header data.HeaderHandler) ([]byte, error) {
+	consensusGroup, err := computeConsensusGroup(ed.nodesCoordinator, header)
+	if err != nil {
+		return nil, err
+	}
+
+	return getConsensusLeader(consensusGroup, header, ed.isConsensusViewEnabled)
+}
+
+// updateHighestRound returns false if the round is too old to be tracked, removing the stale data otherwise
+func (ed *equivocationDetector) updateHighestRound(round uint64) bool {
+	if round+numRoundsToKeep < ed.highestRound {
+		return false
+	}
+	if round <= ed.highestRound {
+		return true
+	}
+
+	ed.highestRound = round
+	for r := range ed.proposedHeaders {
+		if r+numRoundsToKeep < round {
+			delete(ed.proposedHeaders, r)
+		}
+	}
+	for r := range ed.signatureShares {
+		if r+numRoundsToKeep < round {
+			delete(ed.signatureShares, r)
+		}
+	}
+	for r := range ed.leaderHeaders {
+		if r+numRoundsToKeep < round {
+			delete(ed.leaderHeaders, r)
+		}
+	}
+
+	return true
+}
+
+func (ed *equivocationDetector) addProposedHeader(round uint64, headerBytes []byte) {
+	headers, ok := ed.proposedHeaders[round]
+	if !ok {
+		headers = make(map[string][]byte)
+		ed.proposedHeaders[round] = headers
+	}
+	if len(headers) >= maxProposedHeadersPerRound {
+		return
+	}
+
+	hash := ed.hasher.Compute(string(headerBytes))
+	headers[string(hash)] = headerBytes
+
+	for pubKey := range ed.signatureShares[round] {
+		ed.checkSignatureShares(round, []byte(pubKey))
+	}
+}
+
+func addSignedMessage(messages map[uint64]map[string][]*signedMessage, round uint64, pubKey []byte, msg *signedMessage) {
+	messagesInRound, ok := messages[round]
+	if !ok {
+		messagesInRound = make(map[string][]*signedMessage)
+		messages[round] = messagesInRound
+	}
+
+	existing := messagesInRound[string(pubKey)]
+	if len(existing) >= maxSignedMessagesPerKeyAndRound {
+		return
+	}
+	for _, existingMsg := range existing {
+		if bytes.Equal(existingMsg.hash, msg.hash) {
+			return
+		}
+	}
+
+	messagesInRound[string(pubKey)] = append(existing, msg)
+}
+
+func (ed *equivocationDetector) checkSignatureShares(round uint64, pubKey []byte) {
+	if ed.evidencePool.HasEvidence(pubKey, round) {
+		return
+	}
+
+	shares := make([]*signedMessage, 0)
+	for _, share := range ed.signatureShares[round][string(pubKey)] {
+		headerBytes, ok := ed.proposedHeaders[round][string(share.hash)]
+		if !ok {
+			continue
+		}
+
+		shares = append(shares, &signedMessage{
+			hash:      share.hash,
+			message:   headerBytes,
+			signature: share.signature,
+		})
+	}
+
+	ed.tryCreateEvidence(block.DoubleSignatureShares, round, ed.shardCoordinator.SelfId(), pubKey, shares)
+}
+
+func (ed *equivocationDetector) checkLeaderHeaders(round uint64, pubKey []byte, shardID uint32) {
+	if ed.evidencePool.HasEvidence(pubKey, round) {
+		return
+	}
+
+	ed.tryCreateEvidence(block.DoubleSignedHeaders, round, shardID, pubKey, ed.leaderHeaders[round][string(pubKey)])
+}
+
+func (ed *equivocationDetector) tryCreateEvidence(
+	evidenceType block.SlashingEvidenceType,
+	round uint64,
+	shardID uint32,
+	pubKey []byte,
+	messages []*signedMessage,
+) {
+	for i := 0; i < len(messages); i++ {
+		for j := i + 1; j < len(messages); j++ {
+			first, second := messages[i], messages[j]
+			if bytes.Compare(first.hash, second.hash) > 0 {
+				first, second = second, first
+			}
+
+			evidence := &block.SlashingEvidence{
+				Type:            evidenceType,
+				PubKey:          pubKey,
+				ShardID:         shardID,
+				Round:           round,
+				FirstHeader:     first.message,
+				FirstSignature:  first.signature,
+				SecondHeader:    second.message,
+				SecondSignature: second.signature,
+			}
+
+			err := ed.verifier.Verify(evidence)
+			if err != nil {
+				log.Trace("equivocationDetector: invalid evidence", "type", evidenceType.String(), "error", err.Error())
+				continue
+			}
+
+			ed.addAndBroadcast(evidence)
+			return
+		}
+	}
+}
+
+func (ed *equivocationDetector) addAndBroadcast(evidence *block.SlashingEvidence) {
+	log.Warn("double signing detected",
+		"type", evidence.Type.String(),
+		"pk", evidence.PubKey,
+		"shard", evidence.ShardID,
+		"round", evidence.Round,
+	)
+
+	if !ed.evidencePool.AddEvidence(evidence) {
+		return
+	}
+
+	buff, err := ed.marshalizer.Marshal(evidence)
+	if err != nil {
+		log.Debug("equivocationDetector.addAndBroadcast: Marshal", "error", err.Error())
+		return
+	}
+
+	ed.broadcaster.Broadcast(factory.SlashingEvidenceTopic, buff)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ed *equivocationDetector) IsInterfaceNil() bool {
+	return ed == nil
+}
@@ -0,0 +1,274 @@
+package slash
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type broadcastRecorder struct {
+	mut      sync.Mutex
+	messages map[string][][]byte
+}
+
+func (br *broadcastRecorder) broadcast(topic string, buff []byte) {
+	br.mut.Lock()
+	br.messages[topic] = append(br.messages[topic], buff)
+	br.mut.Unlock()
+}
+
+func (br *broadcastRecorder) get(topic string) [][]byte {
+	br.mut.Lock()
+	defer br.mut.Unlock()
+
+	return br.messages[topic]
+}
+
+func createMockArgsEquivocationDetector(
+	t *testing.T,
+	leader []byte,
+	recorder *broadcastRecorder,
+	validators ...[]byte,
+) ArgsEquivocationDetector {
+	pool, _ := NewEvidencePool(100)
+	nodesCoordinator := createTestNodesCoordinator(append([][]byte{leader}, validators...)...)
+	verifierArgs := createMockArgsEvidenceVerifier(t)
+	verifierArgs.NodesCoordinator = nodesCoordinator
+	verifier, err := NewEvidenceVerifier(verifierArgs)
+	require.Nil(t, err)
+
+	return ArgsEquivocationDetector{
+		Marshalizer:      testMarshalizer,
+		Hasher:           testHasher,
+		ShardCoordinator: mock.NewOneShardCoordinatorMock(),
+		NodesCoordinator: nodesCoordinator,
+		Verifier:         verifier,
+		EvidencePool:     pool,
+		Broadcaster: &mock.MessengerStub{
+			BroadcastCalled: recorder.broadcast,
+		},
+		ConsensusType: consensus.BlsConsensusType,
+	}
+}
+
+func newBroadcastRecorder() *broadcastRecorder {
+	return &broadcastRecorder{messages: make(map[string][][]byte)}
+}
+
+func createProposedBlockMessage(headerBytes []byte, round int64) *consensus.Message {
+	return &consensus.Message{
+		Header:     headerBytes,
+		RoundIndex: round,
+	}
+}
+
+func createSignatureMessage(signer *testSigner, headerBytes []byte, signature []byte, round int64) *consensus.Message {
+	return &consensus.Message{
+		BlockHeaderHash: testHasher.Compute(string(headerBytes)),
+		SignatureShare:  signature,
+		PubKey:          signer.pubKey,
+		RoundIndex:      round,
+	}
+}
+
+func createSignedHeader(t *testing.T, signer *testSigner, round uint64, rootHash string) data.HeaderHandler {
+	header := createTestHeader(round, 0, rootHash)
+	_, signature := signer.signHeader(t, header)
+	header.SetLeaderSignature(signature)
+
+	return header
+}
+
+func TestNewEquivocationDetector(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		changeArgs  func(args *ArgsEquivocationDetector)
+		expectedErr error
+	}{
+		{name: "nil marshalizer", changeArgs: func(args *ArgsEquivocationDetector) { args.Marshalizer = nil }, expectedErr: process.ErrNilMarshalizer},
+		{name: "nil hasher", changeArgs: func(args *ArgsEquivocationDetector) { args.Hasher = nil }, expectedErr: process.ErrNilHasher},
+		{name: "nil shard coordinator", changeArgs: func(args *ArgsEquivocationDetector) { args.ShardCoordinator = nil }, expectedErr: process.ErrNilShardCoordinator},
+		{name: "nil nodes coordinator", changeArgs: func(args *ArgsEquivocationDetector) { args.NodesCoordinator = nil }, expectedErr: process.ErrNilNodesCoordinator},
+		{name: "nil verifier", changeArgs: func(args *ArgsEquivocationDetector) { args.Verifier = nil }, expectedErr: process.ErrNilSlashingEvidenceVerifier},
+		{name: "nil evidence pool", changeArgs: func(args *ArgsEquivocationDetector) { args.EvidencePool = nil }, expectedErr: process.ErrNilSlashingEvidencePool},
+		{name: "nil broadcaster", changeArgs: func(args *ArgsEquivocationDetector) { args.Broadcaster = nil }, expectedErr: ErrNilBroadcaster},
+		{name: "should work", changeArgs: func(args *ArgsEquivocationDetector) {}, expectedErr: nil},
+	}
+
+	for _, tt := range tests {
+		args := createMockArgsEquivocationDetector(t, []byte("leader"), newBroadcastRecorder())
+		tt.changeArgs(&args)
+
+		ed, err := NewEquivocationDetector(args)
+		assert.Equal(t, tt.expectedErr, err, tt.name)
+		assert.Equal(t, tt.expectedErr == nil, !check.IfNil(ed), tt.name)
+	}
+}
+
+func TestEquivocationDetector_DoubleSignatureSharesShouldCreateAndBroadcastEvidence(t *testing.T) {
+	t.Parallel()
+
+	offender := createTestSigner(t)
+	recorder := newBroadcastRecorder()
+	args := createMockArgsEquivocationDetector(t, []byte("leader"), recorder, offender.pubKey)
+	ed, _ := NewEquivocationDetector(args)
+
+	round := int64(7)
+	firstHeader, firstSig := offender.signShare(t, createTestHeader(uint64(round), 0, "root hash 1"))
+	secondHeader, secondSig := offender.signShare(t, createTestHeader(uint64(round), 0, "root hash 2"))
+
+	ed.ProcessConsensusMessage(createProposedBlockMessage(firstHeader, round))
+	ed.ProcessConsensusMessage(createSignatureMessage(offender, firstHeader, firstSig, round))
+	assert.Equal(t, 0, args.EvidencePool.Len())
+
+	ed.ProcessConsensusMessage(createProposedBlockMessage(secondHeader, round))
+	ed.ProcessConsensusMessage(createSignatureMessage(offender, secondHeader, secondSig, round))
+	// the same messages received again should not create new evidence
+	ed.ProcessConsensusMessage(createSignatureMessage(offender, secondHeader, secondSig, round))
+
+	require.True(t, args.EvidencePool.HasEvidence(offender.pubKey, uint64(round)))
+	evidence := args.EvidencePool.GetEvidence(1)[0]
+	assert.Equal(t, block.DoubleSignatureShares, evidence.Type)
+	assert.Nil(t, args.Verifier.Verify(evidence))
+
+	broadcast := recorder.get(factory.SlashingEvidenceTopic)
+	require.Equal(t, 1, len(broadcast))
+	broadcastEvidence := &block.SlashingEvidence{}
+	err := testMarshalizer.Unmarshal(broadcastEvidence, broadcast[0])
+	require.Nil(t, err)
+	assert.Equal(t, evidence, broadcastEvidence)
+}
+
+func TestEquivocationDetector_SignatureSharesBeforeProposedBlocksShouldCreateEvidence(t *testing.T) {
+	t.Parallel()
+
+	offender := createTestSigner(t)
+	args := createMockArgsEquivocationDetector(t, []byte("leader"), newBroadcastRecorder(), offender.pubKey)
+	ed, _ := NewEquivocationDetector(args)
+
+	round := int64(7)
+	firstHeader, firstSig := offender.signShare(t, createTestHeader(uint64(round), 0, "root hash 1"))
+	secondHeader, secondSig := offender.signShare(t, createTestHeader(uint64(round), 0, "root hash 2"))
+
+	ed.ProcessConsensusMessage(createSignatureMessage(offender, firstHeader, firstSig, round))
+	ed.ProcessConsensusMessage(createSignatureMessage(offender, secondHeader, secondSig, round))
+	assert.Equal(t, 0, args.EvidencePool.Len())
+
+	ed.ProcessConsensusMessage(createProposedBlockMessage(firstHeader, round))
+	ed.ProcessConsensusMessage(createProposedBlockMessage(secondHeader, round))
+
+	assert.True(t, args.EvidencePool.HasEvidence(offender.pubKey, uint64(round)))
+}
+
+func TestEquivocationDetector_InvalidSignatureSharesShouldNotCreateEvidence(t *testing.T) {
+	t.Parallel()
+
+	offender := createTestSigner(t)
+	innocent := createTestSigner(t)
+	recorder := newBroadcastRecorder()
+	args := createMockArgsEquivocationDetector(t, []byte("leader"), recorder, offender.pubKey)
+	ed, _ := NewEquivocationDetector(args)
+
+	round := int64(7)
+	firstHeader, firstSig := offender.signShare(t, createTestHeader(uint64(round), 0, "root hash 1"))
+	secondHeader, secondSig := offender.signShare(t, createTestHeader(uint64(round), 0, "root hash 2"))
+
+	ed.ProcessConsensusMessage(createProposedBlockMessage(firstHeader, round))
+	ed.ProcessConsensusMessage(createProposedBlockMessage(secondHeader, round))
+	// shares signed by the offender, attributed to an innocent key
+	ed.ProcessConsensusMessage(createSignatureMessage(innocent, firstHeader, firstSig, round))
+	ed.ProcessConsensusMessage(createSignatureMessage(innocent, secondHeader, secondSig, round))
+
+	assert.Equal(t, 0, args.EvidencePool.Len())
+	assert.Equal(t, 0, len(recorder.get(factory.SlashingEvidenceTopic)))
+}
+
+func TestEquivocationDetector_DifferentRoundsShouldNotCreateEvidence(t *testing.T) {
+	t.Parallel()
+
+	offender := createTestSigner(t)
+	args := createMockArgsEquivocationDetector(t, []byte("leader"), newBroadcastRecorder(), offender.pubKey)
+	ed, _ := NewEquivocationDetector(args)
+
+	firstHeader, firstSig := offender.signShare(t, createTestHeader(7, 0, "root hash 1"))
+	secondHeader, secondSig := offender.signShare(t, createTestHeader(8, 0, "root hash 2"))
+
+	ed.ProcessConsensusMessage(createProposedBlockMessage(firstHeader, 7))
+	ed.ProcessConsensusMessage(createSignatureMessage(offender, firstHeader, firstSig, 7))
+	ed.ProcessConsensusMessage(createProposedBlockMessage(secondHeader, 8))
+	ed.ProcessConsensusMessage(createSignatureMessage(offender, secondHeader, secondSig, 8))
+
+	assert.Equal(t, 0, args.EvidencePool.Len())
+}
+
+func TestEquivocationDetector_OldRoundsShouldBeIgnored(t *testing.T) {
+	t.Parallel()
+
+	offender := createTestSigner(t)
+	args := createMockArgsEquivocationDetector(t, []byte("leader"), newBroadcastRecorder(), offender.pubKey)
+	ed, _ := NewEquivocationDetector(args)
+
+	round := int64(7)
+	firstHeader, firstSig := offender.signShare(t, createTestHeader(uint64(round), 0, "root hash 1"))
+	secondHeader, secondSig := offender.signShare(t, createTestHeader(uint64(round), 0, "root hash 2"))
+
+	ed.ProcessConsensusMessage(createProposedBlockMessage(firstHeader, round))
+	ed.ProcessConsensusMessage(createSignatureMessage(offender, firstHeader, firstSig, round))
+	ed.ProcessConsensusMessage(&consensus.Message{RoundIndex: round + numRoundsToKeep + 1})
+	ed.ProcessConsensusMessage(createProposedBlockMessage(secondHeader, round))
+	ed.ProcessConsensusMessage(createSignatureMessage(offender, secondHeader, secondSig, round))
+
+	assert.Equal(t, 0, args.EvidencePool.Len())
+}
+
+func TestEquivocationDetector_ReceivedHeadersSignedTwiceByLeaderShouldCreateEvidence(t *testing.T) {
+	t.Parallel()
+
+	offender := createTestSigner(t)
+	recorder := newBroadcastRecorder()
+	args := createMockArgsEquivocationDetector(t, offender.pubKey, recorder)
+	ed, _ := NewEquivocationDetector(args)
+
+	round := uint64(9)
+	firstHeader := createSignedHeader(t, offender, round, "root hash 1")
+	secondHeader := createSignedHeader(t, offender, round, "root hash 2")
+
+	ed.ReceivedHeader(firstHeader, nil)
+	ed.ReceivedHeader(firstHeader, nil)
+	assert.Equal(t, 0, args.EvidencePool.Len())
+
+	ed.ReceivedHeader(secondHeader, nil)
+
+	require.True(t, args.EvidencePool.HasEvidence(offender.pubKey, round))
+	evidence := args.EvidencePool.GetEvidence(1)[0]
+	assert.Equal(t, block.DoubleSignedHeaders, evidence.Type)
+	assert.Nil(t, args.Verifier.Verify(evidence))
+	assert.Equal(t, 1, len(recorder.get(factory.SlashingEvidenceTopic)))
+}
+
+func TestEquivocationDetector_ReceivedHeadersNotSignedByLeaderShouldNotCreateEvidence(t *testing.T) {
+	t.Parallel()
+
+	offender := createTestSigner(t)
+	leader := createTestSigner(t)
+	args := createMockArgsEquivocationDetector(t, leader.pubKey, newBroadcastRecorder())
+	ed, _ := NewEquivocationDetector(args)
+
+	round := uint64(9)
+	ed.ReceivedHeader(createSignedHeader(t, offender, round, "root hash 1"), nil)
+	ed.ReceivedHeader(createSignedHeader(t, offender, round, "root hash 2"), nil)
+	ed.ReceivedHeader(createTestHeader(round, 0, "root hash 3"), nil)
+
+	assert.Equal(t, 0, args.EvidencePool.Len())
+}
@@ -0,0 +1,36 @@
+package slash
+
+import "errors"
+
+// ErrInvalidEvidenceType signals that the slashing evidence has an unknown type
+var ErrInvalidEvidenceType = errors.New("invalid slashing evidence type")
+
+// ErrEmptyPubKey signals that the slashing evidence does not contain the public key of the offender
+var ErrEmptyPubKey = errors.New("empty public key in slashing evidence")
+
+// ErrRoundMismatch signals that a header from the slashing evidence does not belong to the evidence round
+var ErrRoundMismatch = errors.New("header round does not match the slashing evidence round")
+
+// ErrShardMismatch signals that a header from the slashing evidence does not belong to the evidence shard
+var ErrShardMismatch = errors.New("header shard does not match the slashing evidence shard")
+
+// ErrSameHeaders signals that the slashing evidence contains the same header twice
+var ErrSameHeaders = errors.New("slashing evidence contains the same header twice")
+
+// ErrNilBroadcaster signals that a nil broadcaster has been provided
+var ErrNilBroadcaster = errors.New("nil broadcaster")
+
+// ErrInvalidMaxNumEvidence signals that an invalid maximum number of evidence has been provided
+var ErrInvalidMaxNumEvidence = errors.New("invalid maximum number of slashing evidence")
+
+// ErrNilSystemVM signals that a nil system VM has been provided
+var ErrNilSystemVM = errors.New("nil system VM")
+
+// ErrEmptyAddress signals that an empty system address has been provided
+var ErrEmptyAddress = errors.New("empty address")
+
+// ErrNotConsensusLeader signals that the slashing evidence public key was not the leader of the consensus group
+var ErrNotConsensusLeader = errors.New("slashing evidence public key was not the consensus leader")
+
+// ErrNotInConsensusGroup signals that the slashing evidence public key was not part of the consensus group
+var ErrNotInConsensusGroup = errors.New("slashing evidence public key was not in the consensus group")
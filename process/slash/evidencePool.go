@@ -0,0 +1,108 @@
+package slash
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.SlashingEvidencePool = (*evidencePool)(nil)
+
+type evidencePool struct {
+	mut         sync.RWMutex
+	evidence    map[string]*block.SlashingEvidence
+	maxEvidence int
+}
+
+// NewEvidencePool creates a bounded pool holding slashing evidence, at most one for each public key and round
+func NewEvidencePool(maxEvidence int) (*evidencePool, error) {
+	if maxEvidence < 1 {
+		return nil, ErrInvalidMaxNumEvidence
+	}
+
+	return &evidencePool{
+		evidence:    make(map[string]*block.SlashingEvidence),
+		maxEvidence: maxEvidence,
+	}, nil
+}
+
+// AddEvidence adds the evidence in the pool, returning true if it was not already present
+func (ep *evidencePool) AddEvidence(evidence *block.SlashingEvidence) bool {
+	if evidence == nil {
+		return false
+	}
+
+	key := createEvidenceKey(evidence.PubKey, evidence.Round)
+
+	ep.mut.Lock()
+	defer ep.mut.Unlock()
+
+	_, exists := ep.evidence[key]
+	if exists || len(ep.evidence) >= ep.maxEvidence {
+		return false
+	}
+
+	ep.evidence[key] = evidence
+
+	return true
+}
+
+// HasEvidence returns true if the pool holds an evidence for the provided public key and round
+func (ep *evidencePool) HasEvidence(pubKey []byte, round uint64) bool {
+	ep.mut.RLock()
+	_, exists := ep.evidence[createEvidenceKey(pubKey, round)]
+	ep.mut.RUnlock()
+
+	return exists
+}
+
+// GetEvidence returns at most maxNum evidence, sorted by round and public key
+func (ep *evidencePool) GetEvidence(maxNum int) []*block.SlashingEvidence {
+	ep.mut.RLock()
+	sorted := make([]*block.SlashingEvidence, 0, len(ep.evidence))
+	for _, evidence := range ep.evidence {
+		sorted = append(sorted, evidence)
+	}
+	ep.mut.RUnlock()
+
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Round == sorted[j].Round {
+			return bytes.Compare(sorted[i].PubKey, sorted[j].PubKey) < 0
+		}
+
+		return sorted[i].Round < sorted[j].Round
+	})
+
+	if maxNum >= 0 && len(sorted) > maxNum {
+		sorted = sorted[:maxNum]
+	}
+
+	return sorted
+}
+
+// RemoveEvidence removes the evidence with the same public key and round from the pool
+func (ep *evidencePool) RemoveEvidence(evidence *block.SlashingEvidence) {
+	if evidence == nil {
+		return
+	}
+
+	ep.mut.Lock()
+	delete(ep.evidence, createEvidenceKey(evidence.PubKey, evidence.Round))
+	ep.mut.Unlock()
+}
+
+// Len returns the number of evidence held by the pool
+func (ep *evidencePool) Len() int {
+	ep.mut.RLock()
+	defer ep.mut.RUnlock()
+
+	return len(ep.evidence)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ep *evidencePool) IsInterfaceNil() bool {
+	return ep == nil
+}
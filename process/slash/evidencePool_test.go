@@ -0,0 +1,118 @@
+package slash
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEvidencePool_InvalidMaxEvidenceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ep, err := NewEvidencePool(0)
+
+	assert.Equal(t, ErrInvalidMaxNumEvidence, err)
+	assert.True(t, check.IfNil(ep))
+}
+
+func TestNewEvidencePool_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ep, err := NewEvidencePool(1)
+
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(ep))
+	assert.Equal(t, 0, ep.Len())
+}
+
+func TestEvidencePool_AddEvidence(t *testing.T) {
+	t.Parallel()
+
+	ep, _ := NewEvidencePool(2)
+
+	assert.False(t, ep.AddEvidence(nil))
+	assert.True(t, ep.AddEvidence(&block.SlashingEvidence{PubKey: []byte("pk1"), Round: 1}))
+	assert.False(t, ep.AddEvidence(&block.SlashingEvidence{PubKey: []byte("pk1"), Round: 1, Type: block.DoubleSignatureShares}))
+	assert.True(t, ep.AddEvidence(&block.SlashingEvidence{PubKey: []byte("pk1"), Round: 2}))
+	assert.False(t, ep.AddEvidence(&block.SlashingEvidence{PubKey: []byte("pk2"), Round: 1}), "pool is full")
+
+	assert.Equal(t, 2, ep.Len())
+	assert.True(t, ep.HasEvidence([]byte("pk1"), 1))
+	assert.True(t, ep.HasEvidence([]byte("pk1"), 2))
+	assert.False(t, ep.HasEvidence([]byte("pk2"), 1))
+}
+
+func TestEvidencePool_GetEvidenceShouldReturnSortedAndLimited(t *testing.T) {
+	t.Parallel()
+
+	ep, _ := NewEvidencePool(10)
+	_ = ep.AddEvidence(&block.SlashingEvidence{PubKey: []byte("pk2"), Round: 2})
+	_ = ep.AddEvidence(&block.SlashingEvidence{PubKey: []byte("pk1"), Round: 3})
+	_ = ep.AddEvidence(&block.SlashingEvidence{PubKey: []byte("pk3"), Round: 2})
+	_ = ep.AddEvidence(&block.SlashingEvidence{PubKey: []byte("pk1"), Round: 2})
+
+	evidence := ep.GetEvidence(3)
+	require.Equal(t, 3, len(evidence))
+	assert.Equal(t, []byte("pk1"), evidence[0].PubKey)
+	assert.Equal(t, uint64(2), evidence[0].Round)
+	assert.Equal(t, []byte("pk2"), evidence[1].PubKey)
+	assert.Equal(t, []byte("pk3"), evidence[2].PubKey)
+
+	assert.Equal(t, 4, len(ep.GetEvidence(100)))
+	assert.Equal(t, 0, len(ep.GetEvidence(0)))
+}
+
+func TestEvidencePool_RemoveEvidence(t *testing.T) {
+	t.Parallel()
+
+	ep, _ := NewEvidencePool(10)
+	_ = ep.AddEvidence(&block.SlashingEvidence{PubKey: []byte("pk1"), Round: 1})
+	_ = ep.AddEvidence(&block.SlashingEvidence{PubKey: []byte("pk2"), Round: 1})
+
+	ep.RemoveEvidence(nil)
+	ep.RemoveEvidence(&block.SlashingEvidence{PubKey: []byte("pk1"), Round: 1})
+	ep.RemoveEvidence(&block.SlashingEvidence{PubKey: []byte("pk3"), Round: 1})
+
+	assert.Equal(t, 1, ep.Len())
+	assert.False(t, ep.HasEvidence([]byte("pk1"), 1))
+	assert.True(t, ep.HasEvidence([]byte("pk2"), 1))
+}
+
+func TestEvidencePool_ConcurrentOperationsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panicked: %v", r))
+		}
+	}()
+
+	ep, _ := NewEvidencePool(1000)
+	numCalls := 1000
+	wg := sync.WaitGroup{}
+	wg.Add(numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func(idx int) {
+			evidence := &block.SlashingEvidence{PubKey: []byte(fmt.Sprintf("pk%d", idx%10)), Round: uint64(idx)}
+			switch idx % 4 {
+			case 0:
+				_ = ep.AddEvidence(evidence)
+			case 1:
+				_ = ep.HasEvidence(evidence.PubKey, evidence.Round)
+			case 2:
+				_ = ep.GetEvidence(10)
+			case 3:
+				ep.RemoveEvidence(evidence)
+			}
+			wg.Done()
+		}(i)
+	}
+
+	wg.Wait()
+}
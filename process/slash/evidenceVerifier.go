@@ -0,0 +1,205 @@
+package slash
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+var _ process.SlashingEvidenceVerifier = (*evidenceVerifier)(nil)
+
+// ArgsEvidenceVerifier holds the arguments needed to create a new evidence verifier
+type ArgsEvidenceVerifier struct {
+	Marshalizer      marshal.Marshalizer
+	Hasher           hashing.Hasher
+	KeyGen           crypto.KeyGenerator
+	SingleSigner     crypto.SingleSigner
+	MultiSigVerifier crypto.MultiSigVerifier
+	NodesCoordinator sharding.NodesCoordinator
+	ConsensusType    string
+}
+
+type evidenceVerifier struct {
+	marshalizer            marshal.Marshalizer
+	hasher                 hashing.Hasher
+	keyGen                 crypto.KeyGenerator
+	singleSigner           crypto.SingleSigner
+	multiSigVerifier       crypto.MultiSigVerifier
+	nodesCoordinator       sharding.NodesCoordinator
+	isConsensusViewEnabled bool
+}
+
+// NewEvidenceVerifier creates a component able to check the double signing evidence
+func NewEvidenceVerifier(args ArgsEvidenceVerifier) (*evidenceVerifier, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, process.ErrNilHasher
+	}
+	if check.IfNil(args.KeyGen) {
+		return nil, process.ErrNilKeyGen
+	}
+	if check.IfNil(args.SingleSigner) {
+		return nil, process.ErrNilSingleSigner
+	}
+	if check.IfNil(args.MultiSigVerifier) {
+		return nil, process.ErrNilMultiSigVerifier
+	}
+	if check.IfNil(args.NodesCoordinator) {
+		return nil, process.ErrNilNodesCoordinator
+	}
+
+	return &evidenceVerifier{
+		marshalizer:            args.Marshalizer,
+		hasher:                 args.Hasher,
+		keyGen:                 args.KeyGen,
+		singleSigner:           args.SingleSigner,
+		multiSigVerifier:       args.MultiSigVerifier,
+		nodesCoordinator:       args.NodesCoordinator,
+		isConsensusViewEnabled: args.ConsensusType == consensus.PbftConsensusType,
+	}, nil
+}
+
+// Verify checks that the evidence holds two different headers from the same round and shard, both signed
+// with the evidence public key. For DoubleSignedHeaders the headers are marshalled without the leader signature and
+// the signatures are leader signatures, for DoubleSignatureShares the signatures are consensus signature shares on the
+// headers hashes. The evidence public key has to be the leader (for DoubleSignedHeaders) or a member (for
+// DoubleSignatureShares) of the consensus group selected for each of the headers.
+func (ev *evidenceVerifier) Verify(evidence *block.SlashingEvidence) error {
+	if evidence == nil {
+		return process.ErrNilSlashingEvidence
+	}
+	if len(evidence.PubKey) == 0 {
+		return ErrEmptyPubKey
+	}
+
+	err := ev.checkHeader(evidence, evidence.FirstHeader)
+	if err != nil {
+		return err
+	}
+	err = ev.checkHeader(evidence, evidence.SecondHeader)
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(evidence.FirstHeader, evidence.SecondHeader) {
+		return ErrSameHeaders
+	}
+
+	switch evidence.Type {
+	case block.DoubleSignedHeaders:
+		return ev.verifyLeaderSignatures(evidence)
+	case block.DoubleSignatureShares:
+		return ev.verifySignatureShares(evidence)
+	default:
+		return fmt.Errorf("%w: %d", ErrInvalidEvidenceType, evidence.Type)
+	}
+}
+
+func (ev *evidenceVerifier) checkHeader(evidence *block.SlashingEvidence, buff []byte) error {
+	header, err := DecodeHeader(ev.marshalizer, evidence.ShardID, buff)
+	if err != nil {
+		return err
+	}
+	if header.GetRound() != evidence.Round {
+		return fmt.Errorf("%w: header round %d, evidence round %d", ErrRoundMismatch, header.GetRound(), evidence.Round)
+	}
+	if header.GetShardID() != evidence.ShardID {
+		return fmt.Errorf("%w: header shard %d, evidence shard %d", ErrShardMismatch, header.GetShardID(), evidence.ShardID)
+	}
+
+	return ev.checkConsensusGroup(evidence, header)
+}
+
+func (ev *evidenceVerifier) checkConsensusGroup(evidence *block.SlashingEvidence, header data.HeaderHandler) error {
+	consensusGroup, err := computeConsensusGroup(ev.nodesCoordinator, header)
+	if err != nil {
+		return err
+	}
+
+	if evidence.Type == block.DoubleSignedHeaders {
+		leader, errLeader := getConsensusLeader(consensusGroup, header, ev.isConsensusViewEnabled)
+		if errLeader != nil {
+			return errLeader
+		}
+		if !bytes.Equal(leader, evidence.PubKey) {
+			return ErrNotConsensusLeader
+		}
+
+		return nil
+	}
+
+	for _, validator := range consensusGroup {
+		if bytes.Equal(validator.PubKey(), evidence.PubKey) {
+			return nil
+		}
+	}
+
+	return ErrNotInConsensusGroup
+}
+
+func (ev *evidenceVerifier) verifyLeaderSignatures(evidence *block.SlashingEvidence) error {
+	pubKey, err := ev.keyGen.PublicKeyFromByteArray(evidence.PubKey)
+	if err != nil {
+		return err
+	}
+
+	err = ev.singleSigner.Verify(pubKey, evidence.FirstHeader, evidence.FirstSignature)
+	if err != nil {
+		return err
+	}
+
+	return ev.singleSigner.Verify(pubKey, evidence.SecondHeader, evidence.SecondSignature)
+}
+
+func (ev *evidenceVerifier) verifySignatureShares(evidence *block.SlashingEvidence) error {
+	verifier, err := ev.multiSigVerifier.Create([]string{string(evidence.PubKey)}, 0)
+	if err != nil {
+		return err
+	}
+
+	firstHash := ev.hasher.Compute(string(evidence.FirstHeader))
+	err = verifier.VerifySignatureShare(0, evidence.FirstSignature, firstHash, nil)
+	if err != nil {
+		return err
+	}
+
+	secondHash := ev.hasher.Compute(string(evidence.SecondHeader))
+	return verifier.VerifySignatureShare(0, evidence.SecondSignature, secondHash, nil)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ev *evidenceVerifier) IsInterfaceNil() bool {
+	return ev == nil
+}
+
+// DecodeHeader unmarshalls the provided buffer in a metachain or a shard header, depending on the provided shard ID
+func DecodeHeader(marshalizer marshal.Marshalizer, shardID uint32, buff []byte) (data.HeaderHandler, error) {
+	if len(buff) == 0 {
+		return nil, process.ErrNilBuffer
+	}
+
+	var header data.HeaderHandler
+	if shardID == core.MetachainShardId {
+		header = &block.MetaBlock{}
+	} else {
+		header = &block.Header{}
+	}
+
+	err := marshalizer.Unmarshal(header, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return header, nil
+}
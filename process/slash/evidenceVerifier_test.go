@@ -0,0 +1,409 @@
+package slash
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl"
+	llsig "github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/multisig"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/singlesig"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/multisig"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testMarshalizer = &marshal.GogoProtoMarshalizer{}
+var testHasher = &mock.HasherMock{}
+var testKeyGen = signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+
+type testSigner struct {
+	pubKey       []byte
+	privKey      crypto.PrivateKey
+	singleSigner crypto.SingleSigner
+	multiSigner  crypto.MultiSigner
+}
+
+func createTestSigner(t *testing.T) *testSigner {
+	sk, pk := testKeyGen.GeneratePair()
+	pkBytes, err := pk.ToByteArray()
+	require.Nil(t, err)
+
+	llSigner := &llsig.BlsMultiSigner{Hasher: &blake2b.Blake2b{HashSize: hashing.BlsHashSize}}
+	multiSigner, err := multisig.NewBLSMultisig(llSigner, []string{string(pkBytes)}, sk, testKeyGen, 0)
+	require.Nil(t, err)
+
+	return &testSigner{
+		pubKey:       pkBytes,
+		privKey:      sk,
+		singleSigner: &singlesig.BlsSingleSigner{},
+		multiSigner:  multiSigner,
+	}
+}
+
+// signHeader returns the header marshalled without the leader signature, together with the leader signature
+func (ts *testSigner) signHeader(t *testing.T, header data.HeaderHandler) ([]byte, []byte) {
+	headerBytes, err := testMarshalizer.Marshal(header)
+	require.Nil(t, err)
+
+	signature, err := ts.singleSigner.Sign(ts.privKey, headerBytes)
+	require.Nil(t, err)
+
+	return headerBytes, signature
+}
+
+// signShare returns the marshalled header, together with the signature share on the header hash
+func (ts *testSigner) signShare(t *testing.T, header data.HeaderHandler) ([]byte, []byte) {
+	headerBytes, err := testMarshalizer.Marshal(header)
+	require.Nil(t, err)
+
+	signature, err := ts.multiSigner.CreateSignatureShare(testHasher.Compute(string(headerBytes)), nil)
+	require.Nil(t, err)
+
+	return headerBytes, signature
+}
+
+func createTestHeader(round uint64, shardID uint32, rootHash string) data.HeaderHandler {
+	if shardID == core.MetachainShardId {
+		return &block.MetaBlock{Round: round, Nonce: round, RootHash: []byte(rootHash)}
+	}
+
+	return &block.Header{Round: round, Nonce: round, ShardID: shardID, RootHash: []byte(rootHash)}
+}
+
+func createDoubleSignedHeadersEvidence(t *testing.T, signer *testSigner, round uint64, shardID uint32) *block.SlashingEvidence {
+	firstHeader, firstSig := signer.signHeader(t, createTestHeader(round, shardID, "root hash 1"))
+	secondHeader, secondSig := signer.signHeader(t, createTestHeader(round, shardID, "root hash 2"))
+
+	return &block.SlashingEvidence{
+		Type:            block.DoubleSignedHeaders,
+		PubKey:          signer.pubKey,
+		ShardID:         shardID,
+		Round:           round,
+		FirstHeader:     firstHeader,
+		FirstSignature:  firstSig,
+		SecondHeader:    secondHeader,
+		SecondSignature: secondSig,
+	}
+}
+
+func createDoubleSignatureSharesEvidence(t *testing.T, signer *testSigner, round uint64, shardID uint32) *block.SlashingEvidence {
+	firstHeader, firstSig := signer.signShare(t, createTestHeader(round, shardID, "root hash 1"))
+	secondHeader, secondSig := signer.signShare(t, createTestHeader(round, shardID, "root hash 2"))
+
+	return &block.SlashingEvidence{
+		Type:            block.DoubleSignatureShares,
+		PubKey:          signer.pubKey,
+		ShardID:         shardID,
+		Round:           round,
+		FirstHeader:     firstHeader,
+		FirstSignature:  firstSig,
+		SecondHeader:    secondHeader,
+		SecondSignature: secondSig,
+	}
+}
+
+// createTestNodesCoordinator returns a nodes coordinator selecting the provided public keys as consensus group, the
+// first one being the leader
+func createTestNodesCoordinator(consensusGroup ...[]byte) sharding.NodesCoordinator {
+	return &mock.NodesCoordinatorMock{
+		ComputeValidatorsGroupCalled: func(_ []byte, _ uint64, _ uint32, _ uint32) ([]sharding.Validator, error) {
+			validators := make([]sharding.Validator, 0, len(consensusGroup))
+			for _, pubKey := range consensusGroup {
+				validators = append(validators, mock.NewValidatorMock(pubKey))
+			}
+
+			return validators, nil
+		},
+	}
+}
+
+func createMockArgsEvidenceVerifier(t *testing.T, consensusGroup ...*testSigner) ArgsEvidenceVerifier {
+	// the verifying node uses its own key, different from the offender's one
+	ownSigner := createTestSigner(t)
+	consensusGroupPubKeys := make([][]byte, 0, len(consensusGroup))
+	for _, signer := range consensusGroup {
+		consensusGroupPubKeys = append(consensusGroupPubKeys, signer.pubKey)
+	}
+
+	return ArgsEvidenceVerifier{
+		Marshalizer:      testMarshalizer,
+		Hasher:           testHasher,
+		KeyGen:           testKeyGen,
+		SingleSigner:     &singlesig.BlsSingleSigner{},
+		MultiSigVerifier: ownSigner.multiSigner,
+		NodesCoordinator: createTestNodesCoordinator(consensusGroupPubKeys...),
+		ConsensusType:    consensus.BlsConsensusType,
+	}
+}
+
+func createTestEvidenceVerifier(t *testing.T, consensusGroup ...*testSigner) *evidenceVerifier {
+	ev, err := NewEvidenceVerifier(createMockArgsEvidenceVerifier(t, consensusGroup...))
+	require.Nil(t, err)
+
+	return ev
+}
+
+func TestNewEvidenceVerifier(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		argsFunc    func() ArgsEvidenceVerifier
+		expectedErr error
+	}{
+		{
+			name: "nil marshalizer",
+			argsFunc: func() ArgsEvidenceVerifier {
+				args := createMockArgsEvidenceVerifier(t)
+				args.Marshalizer = nil
+				return args
+			},
+			expectedErr: process.ErrNilMarshalizer,
+		},
+		{
+			name: "nil hasher",
+			argsFunc: func() ArgsEvidenceVerifier {
+				args := createMockArgsEvidenceVerifier(t)
+				args.Hasher = nil
+				return args
+			},
+			expectedErr: process.ErrNilHasher,
+		},
+		{
+			name: "nil key generator",
+			argsFunc: func() ArgsEvidenceVerifier {
+				args := createMockArgsEvidenceVerifier(t)
+				args.KeyGen = nil
+				return args
+			},
+			expectedErr: process.ErrNilKeyGen,
+		},
+		{
+			name: "nil single signer",
+			argsFunc: func() ArgsEvidenceVerifier {
+				args := createMockArgsEvidenceVerifier(t)
+				args.SingleSigner = nil
+				return args
+			},
+			expectedErr: process.ErrNilSingleSigner,
+		},
+		{
+			name: "nil multi signature verifier",
+			argsFunc: func() ArgsEvidenceVerifier {
+				args := createMockArgsEvidenceVerifier(t)
+				args.MultiSigVerifier = nil
+				return args
+			},
+			expectedErr: process.ErrNilMultiSigVerifier,
+		},
+		{
+			name: "nil nodes coordinator",
+			argsFunc: func() ArgsEvidenceVerifier {
+				args := createMockArgsEvidenceVerifier(t)
+				args.NodesCoordinator = nil
+				return args
+			},
+			expectedErr: process.ErrNilNodesCoordinator,
+		},
+		{
+			name:        "should work",
+			argsFunc:    func() ArgsEvidenceVerifier { return createMockArgsEvidenceVerifier(t) },
+			expectedErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		ev, err := NewEvidenceVerifier(tt.argsFunc())
+		assert.Equal(t, tt.expectedErr, err, tt.name)
+		assert.Equal(t, tt.expectedErr == nil, !check.IfNil(ev), tt.name)
+	}
+}
+
+func TestEvidenceVerifier_VerifyValidEvidenceShouldWork(t *testing.T) {
+	t.Parallel()
+
+	offender := createTestSigner(t)
+	ev := createTestEvidenceVerifier(t, offender)
+
+	assert.Nil(t, ev.Verify(createDoubleSignedHeadersEvidence(t, offender, 10, 0)))
+	assert.Nil(t, ev.Verify(createDoubleSignedHeadersEvidence(t, offender, 10, core.MetachainShardId)))
+	assert.Nil(t, ev.Verify(createDoubleSignatureSharesEvidence(t, offender, 10, 1)))
+	assert.Nil(t, ev.Verify(createDoubleSignatureSharesEvidence(t, offender, 10, core.MetachainShardId)))
+}
+
+func createViewChangedDoubleSignedHeadersEvidence(t *testing.T, signer *testSigner, round uint64, view uint32) *block.SlashingEvidence {
+	firstHeader := &block.Header{Round: round, Nonce: round, RootHash: []byte("root hash 1"), Reserved: core.EncodeConsensusView(view)}
+	secondHeader := &block.Header{Round: round, Nonce: round, RootHash: []byte("root hash 2"), Reserved: core.EncodeConsensusView(view)}
+	evidence := createDoubleSignedHeadersEvidence(t, signer, round, 0)
+	evidence.FirstHeader, evidence.FirstSignature = signer.signHeader(t, firstHeader)
+	evidence.SecondHeader, evidence.SecondSignature = signer.signHeader(t, secondHeader)
+
+	return evidence
+}
+
+func TestEvidenceVerifier_VerifyViewLeaderEvidenceOnPbftShouldWork(t *testing.T) {
+	t.Parallel()
+
+	firstLeader := createTestSigner(t)
+	offender := createTestSigner(t)
+	args := createMockArgsEvidenceVerifier(t, firstLeader, offender)
+	args.ConsensusType = consensus.PbftConsensusType
+	ev, _ := NewEvidenceVerifier(args)
+
+	assert.Nil(t, ev.Verify(createViewChangedDoubleSignedHeadersEvidence(t, offender, 10, 1)))
+	assert.Equal(t, ErrNotConsensusLeader, ev.Verify(createViewChangedDoubleSignedHeadersEvidence(t, offender, 10, 2)))
+}
+
+func TestEvidenceVerifier_VerifyConsensusViewOnBlsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	firstLeader := createTestSigner(t)
+	offender := createTestSigner(t)
+	ev := createTestEvidenceVerifier(t, firstLeader, offender)
+
+	assert.Equal(t, core.ErrInvalidConsensusView, ev.Verify(createViewChangedDoubleSignedHeadersEvidence(t, offender, 10, 1)))
+}
+
+func TestEvidenceVerifier_VerifyNilOrIncompleteEvidenceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	offender := createTestSigner(t)
+	ev := createTestEvidenceVerifier(t, offender)
+
+	assert.Equal(t, process.ErrNilSlashingEvidence, ev.Verify(nil))
+
+	evidence := createDoubleSignedHeadersEvidence(t, offender, 10, 0)
+	evidence.PubKey = nil
+	assert.Equal(t, ErrEmptyPubKey, ev.Verify(evidence))
+
+	evidence = createDoubleSignedHeadersEvidence(t, offender, 10, 0)
+	evidence.SecondHeader = nil
+	assert.Equal(t, process.ErrNilBuffer, ev.Verify(evidence))
+}
+
+func TestEvidenceVerifier_VerifyMismatchedHeadersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	offender := createTestSigner(t)
+	ev := createTestEvidenceVerifier(t, offender)
+
+	evidence := createDoubleSignedHeadersEvidence(t, offender, 10, 0)
+	evidence.Round = 11
+	assert.True(t, errors.Is(ev.Verify(evidence), ErrRoundMismatch))
+
+	evidence = createDoubleSignedHeadersEvidence(t, offender, 10, 0)
+	evidence.SecondHeader, evidence.SecondSignature = offender.signHeader(t, createTestHeader(10, 1, "root hash 2"))
+	assert.True(t, errors.Is(ev.Verify(evidence), ErrShardMismatch))
+
+	evidence = createDoubleSignedHeadersEvidence(t, offender, 10, 0)
+	evidence.SecondHeader = evidence.FirstHeader
+	evidence.SecondSignature = evidence.FirstSignature
+	assert.Equal(t, ErrSameHeaders, ev.Verify(evidence))
+}
+
+func TestEvidenceVerifier_VerifyInvalidTypeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	offender := createTestSigner(t)
+	ev := createTestEvidenceVerifier(t, offender)
+
+	evidence := createDoubleSignedHeadersEvidence(t, offender, 10, 0)
+	evidence.Type = 100
+
+	assert.True(t, errors.Is(ev.Verify(evidence), ErrInvalidEvidenceType))
+}
+
+func TestEvidenceVerifier_VerifyForgedEvidenceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	offender := createTestSigner(t)
+	innocent := createTestSigner(t)
+	ev := createTestEvidenceVerifier(t, offender, innocent)
+
+	// headers signed by the offender, attributed to another key
+	evidence := createDoubleSignedHeadersEvidence(t, offender, 10, 0)
+	evidence.PubKey = innocent.pubKey
+	assert.NotNil(t, ev.Verify(evidence))
+
+	evidence = createDoubleSignatureSharesEvidence(t, offender, 10, 0)
+	evidence.PubKey = innocent.pubKey
+	assert.NotNil(t, ev.Verify(evidence))
+
+	// leader signatures presented as signature shares
+	evidence = createDoubleSignedHeadersEvidence(t, offender, 10, 0)
+	evidence.Type = block.DoubleSignatureShares
+	assert.NotNil(t, ev.Verify(evidence))
+
+	// only one of the headers is signed by the offender
+	evidence = createDoubleSignatureSharesEvidence(t, offender, 10, 0)
+	_, evidence.SecondSignature = innocent.signShare(t, createTestHeader(10, 0, "root hash 2"))
+	assert.NotNil(t, ev.Verify(evidence))
+}
+
+func TestEvidenceVerifier_VerifyOutsideOfTheConsensusGroupShouldErr(t *testing.T) {
+	t.Parallel()
+
+	leader := createTestSigner(t)
+	validator := createTestSigner(t)
+	outsider := createTestSigner(t)
+	ev := createTestEvidenceVerifier(t, leader, validator)
+
+	assert.Equal(t, ErrNotConsensusLeader, ev.Verify(createDoubleSignedHeadersEvidence(t, outsider, 10, 0)))
+	assert.Equal(t, ErrNotInConsensusGroup, ev.Verify(createDoubleSignatureSharesEvidence(t, outsider, 10, 0)))
+
+	// a consensus group member which is not the leader can only double sign signature shares
+	assert.Equal(t, ErrNotConsensusLeader, ev.Verify(createDoubleSignedHeadersEvidence(t, validator, 10, 0)))
+	assert.Nil(t, ev.Verify(createDoubleSignatureSharesEvidence(t, validator, 10, 0)))
+	assert.Nil(t, ev.Verify(createDoubleSignedHeadersEvidence(t, leader, 10, 0)))
+}
+
+func TestEvidenceVerifier_VerifyConsensusGroupComputationErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	offender := createTestSigner(t)
+	args := createMockArgsEvidenceVerifier(t, offender)
+	args.NodesCoordinator = &mock.NodesCoordinatorMock{
+		ComputeValidatorsGroupCalled: func(_ []byte, _ uint64, _ uint32, _ uint32) ([]sharding.Validator, error) {
+			return nil, expectedErr
+		},
+	}
+	ev, _ := NewEvidenceVerifier(args)
+
+	assert.Equal(t, expectedErr, ev.Verify(createDoubleSignatureSharesEvidence(t, offender, 10, 0)))
+}
+
+func TestDecodeHeader(t *testing.T) {
+	t.Parallel()
+
+	_, err := DecodeHeader(testMarshalizer, 0, nil)
+	assert.Equal(t, process.ErrNilBuffer, err)
+
+	_, err = DecodeHeader(testMarshalizer, 0, []byte("not a header"))
+	assert.NotNil(t, err)
+
+	buff, _ := testMarshalizer.Marshal(createTestHeader(5, 2, "root hash"))
+	header, err := DecodeHeader(testMarshalizer, 2, buff)
+	require.Nil(t, err)
+	assert.IsType(t, &block.Header{}, header)
+	assert.Equal(t, uint64(5), header.GetRound())
+
+	buff, _ = testMarshalizer.Marshal(createTestHeader(6, core.MetachainShardId, "root hash"))
+	header, err = DecodeHeader(testMarshalizer, core.MetachainShardId, buff)
+	require.Nil(t, err)
+	assert.IsType(t, &block.MetaBlock{}, header)
+	assert.Equal(t, uint64(6), header.GetRound())
+}
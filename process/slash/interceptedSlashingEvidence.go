@@ -0,0 +1,99 @@
+package slash
+
+import (
+	"fmt"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.InterceptedData = (*InterceptedSlashingEvidence)(nil)
+
+// InterceptedSlashingEvidence is a wrapper over a slashing evidence received on the slashing evidence topic
+type InterceptedSlashingEvidence struct {
+	evidence *block.SlashingEvidence
+	verifier process.SlashingEvidenceVerifier
+	hash     []byte
+}
+
+// NewInterceptedSlashingEvidence creates a new instance of InterceptedSlashingEvidence
+func NewInterceptedSlashingEvidence(
+	buff []byte,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	verifier process.SlashingEvidenceVerifier,
+) (*InterceptedSlashingEvidence, error) {
+	if len(buff) == 0 {
+		return nil, process.ErrNilBuffer
+	}
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(hasher) {
+		return nil, process.ErrNilHasher
+	}
+	if check.IfNil(verifier) {
+		return nil, process.ErrNilSlashingEvidenceVerifier
+	}
+
+	evidence := &block.SlashingEvidence{}
+	err := marshalizer.Unmarshal(evidence, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return &InterceptedSlashingEvidence{
+		evidence: evidence,
+		verifier: verifier,
+		hash:     hasher.Compute(string(buff)),
+	}, nil
+}
+
+// CheckValidity checks that the evidence proves a double signing
+func (ise *InterceptedSlashingEvidence) CheckValidity() error {
+	return ise.verifier.Verify(ise.evidence)
+}
+
+// IsForCurrentShard returns true as the slashing evidence is relevant for all shards
+func (ise *InterceptedSlashingEvidence) IsForCurrentShard() bool {
+	return true
+}
+
+// Hash returns the hash of the received buffer
+func (ise *InterceptedSlashingEvidence) Hash() []byte {
+	return ise.hash
+}
+
+// Type returns the type of this intercepted data
+func (ise *InterceptedSlashingEvidence) Type() string {
+	return "intercepted slashing evidence"
+}
+
+// Identifiers returns the identifiers used in requests
+func (ise *InterceptedSlashingEvidence) Identifiers() [][]byte {
+	return [][]byte{ise.hash}
+}
+
+// String returns the slashing evidence's most important fields as string
+func (ise *InterceptedSlashingEvidence) String() string {
+	return fmt.Sprintf("type=%s, pk=%s, shard=%d, round=%d",
+		ise.evidence.Type.String(),
+		logger.DisplayByteSlice(ise.evidence.PubKey),
+		ise.evidence.ShardID,
+		ise.evidence.Round,
+	)
+}
+
+// Evidence returns the intercepted slashing evidence
+func (ise *InterceptedSlashingEvidence) Evidence() *block.SlashingEvidence {
+	return ise.evidence
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ise *InterceptedSlashingEvidence) IsInterfaceNil() bool {
+	return ise == nil
+}
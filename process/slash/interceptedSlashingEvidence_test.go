@@ -0,0 +1,102 @@
+package slash
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMarshalledEvidence(t *testing.T) ([]byte, *block.SlashingEvidence) {
+	evidence := &block.SlashingEvidence{
+		Type:    block.DoubleSignatureShares,
+		PubKey:  []byte("pk"),
+		ShardID: 1,
+		Round:   37,
+	}
+	buff, err := testMarshalizer.Marshal(evidence)
+	require.Nil(t, err)
+
+	return buff, evidence
+}
+
+func TestNewInterceptedSlashingEvidence_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	buff, _ := createMarshalledEvidence(t)
+	verifier := &mock.SlashingEvidenceVerifierStub{}
+
+	ise, err := NewInterceptedSlashingEvidence(nil, testMarshalizer, testHasher, verifier)
+	assert.Equal(t, process.ErrNilBuffer, err)
+	assert.True(t, check.IfNil(ise))
+
+	ise, err = NewInterceptedSlashingEvidence(buff, nil, testHasher, verifier)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+	assert.True(t, check.IfNil(ise))
+
+	ise, err = NewInterceptedSlashingEvidence(buff, testMarshalizer, nil, verifier)
+	assert.Equal(t, process.ErrNilHasher, err)
+	assert.True(t, check.IfNil(ise))
+
+	ise, err = NewInterceptedSlashingEvidence(buff, testMarshalizer, testHasher, nil)
+	assert.Equal(t, process.ErrNilSlashingEvidenceVerifier, err)
+	assert.True(t, check.IfNil(ise))
+}
+
+func TestNewInterceptedSlashingEvidence_UnmarshalErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	marshalizer := &mock.MarshalizerStub{
+		UnmarshalCalled: func(obj interface{}, buff []byte) error {
+			return expectedErr
+		},
+	}
+
+	ise, err := NewInterceptedSlashingEvidence([]byte("buff"), marshalizer, testHasher, &mock.SlashingEvidenceVerifierStub{})
+
+	assert.Equal(t, expectedErr, err)
+	assert.True(t, check.IfNil(ise))
+}
+
+func TestNewInterceptedSlashingEvidence_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	buff, evidence := createMarshalledEvidence(t)
+
+	ise, err := NewInterceptedSlashingEvidence(buff, testMarshalizer, testHasher, &mock.SlashingEvidenceVerifierStub{})
+	require.Nil(t, err)
+	require.False(t, check.IfNil(ise))
+
+	expectedHash := testHasher.Compute(string(buff))
+	assert.Equal(t, evidence, ise.Evidence())
+	assert.Equal(t, expectedHash, ise.Hash())
+	assert.Equal(t, [][]byte{expectedHash}, ise.Identifiers())
+	assert.True(t, ise.IsForCurrentShard())
+	assert.Equal(t, "intercepted slashing evidence", ise.Type())
+	assert.True(t, strings.Contains(ise.String(), "round=37"))
+	assert.True(t, strings.Contains(ise.String(), "shard=1"))
+}
+
+func TestInterceptedSlashingEvidence_CheckValidityShouldCallVerifier(t *testing.T) {
+	t.Parallel()
+
+	buff, evidence := createMarshalledEvidence(t)
+	expectedErr := errors.New("expected error")
+	verifier := &mock.SlashingEvidenceVerifierStub{
+		VerifyCalled: func(received *block.SlashingEvidence) error {
+			assert.Equal(t, evidence, received)
+			return expectedErr
+		},
+	}
+
+	ise, _ := NewInterceptedSlashingEvidence(buff, testMarshalizer, testHasher, verifier)
+
+	assert.Equal(t, expectedErr, ise.CheckValidity())
+}
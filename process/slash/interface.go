@@ -0,0 +1,7 @@
+package slash
+
+// Broadcaster is able to send a message on a p2p topic
+type Broadcaster interface {
+	Broadcast(topic string, buff []byte)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,246 @@
+package slash
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.SlashingProcessor = (*slashingProcessor)(nil)
+
+var zero = big.NewInt(0)
+
+// ArgsSlashingProcessor holds the arguments needed to create a new slashing processor
+type ArgsSlashingProcessor struct {
+	SystemVM            vmcommon.VMExecutionHandler
+	UserAccountsDB      state.AccountsAdapter
+	PeerAccountsDB      state.AccountsAdapter
+	Verifier            process.SlashingEvidenceVerifier
+	EvidencePool        process.SlashingEvidencePool
+	RatingsInfo         process.RatingsInfoHandler
+	MaxEvidencePerBlock int
+
+	JailingAddress   []byte
+	StakingSCAddress []byte
+}
+
+// slashingProcessor executes the slash call on the staking system smart contract for every evidence included in a
+// metachain block and lowers the rating of the offender so that the node gets jailed at the end of the epoch
+type slashingProcessor struct {
+	systemVM            vmcommon.VMExecutionHandler
+	userAccountsDB      state.AccountsAdapter
+	peerAccountsDB      state.AccountsAdapter
+	verifier            process.SlashingEvidenceVerifier
+	evidencePool        process.SlashingEvidencePool
+	minRating           uint32
+	maxEvidencePerBlock int
+	jailingAddress      []byte
+	stakingSCAddress    []byte
+}
+
+// NewSlashingProcessor creates a new slashing processor
+func NewSlashingProcessor(args ArgsSlashingProcessor) (*slashingProcessor, error) {
+	if check.IfNil(args.SystemVM) {
+		return nil, ErrNilSystemVM
+	}
+	if check.IfNil(args.UserAccountsDB) {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.PeerAccountsDB) {
+		return nil, process.ErrNilPeerAccountsAdapter
+	}
+	if check.IfNil(args.Verifier) {
+		return nil, process.ErrNilSlashingEvidenceVerifier
+	}
+	if check.IfNil(args.EvidencePool) {
+		return nil, process.ErrNilSlashingEvidencePool
+	}
+	if check.IfNil(args.RatingsInfo) {
+		return nil, process.ErrNilRatingsInfoHandler
+	}
+	if args.MaxEvidencePerBlock < 1 {
+		return nil, ErrInvalidMaxNumEvidence
+	}
+	if len(args.JailingAddress) == 0 || len(args.StakingSCAddress) == 0 {
+		return nil, ErrEmptyAddress
+	}
+
+	return &slashingProcessor{
+		systemVM:            args.SystemVM,
+		userAccountsDB:      args.UserAccountsDB,
+		peerAccountsDB:      args.PeerAccountsDB,
+		verifier:            args.Verifier,
+		evidencePool:        args.EvidencePool,
+		minRating:           args.RatingsInfo.MinRating(),
+		maxEvidencePerBlock: args.MaxEvidencePerBlock,
+		jailingAddress:      args.JailingAddress,
+		stakingSCAddress:    args.StakingSCAddress,
+	}, nil
+}
+
+// CreateSlashingEvidence executes the evidence from the pool and returns the successfully executed ones, in order to be
+// included in the metachain block under construction. The evidence that can not be executed is removed from the pool.
+func (sp *slashingProcessor) CreateSlashingEvidence() ([]block.SlashingEvidence, error) {
+	included := make([]block.SlashingEvidence, 0)
+	for _, evidence := range sp.evidencePool.GetEvidence(sp.maxEvidencePerBlock) {
+		err := sp.verifier.Verify(evidence)
+		if err != nil {
+			log.Debug("slashingProcessor.CreateSlashingEvidence: invalid evidence", "error", err.Error())
+			sp.evidencePool.RemoveEvidence(evidence)
+			continue
+		}
+
+		vmOutput, err := sp.executeSlash(evidence)
+		if err != nil {
+			return nil, err
+		}
+		if vmOutput.ReturnCode != vmcommon.Ok {
+			log.Debug("slashingProcessor.CreateSlashingEvidence: slash not executed",
+				"pk", evidence.PubKey,
+				"round", evidence.Round,
+				"returnCode", vmOutput.ReturnCode.String(),
+				"returnMessage", vmOutput.ReturnMessage,
+			)
+			sp.evidencePool.RemoveEvidence(evidence)
+			continue
+		}
+
+		err = sp.applySlash(evidence, vmOutput)
+		if err != nil {
+			return nil, err
+		}
+
+		included = append(included, *evidence)
+	}
+
+	return included, nil
+}
+
+// ProcessSlashingEvidence verifies and executes the evidence included in a metachain block
+func (sp *slashingProcessor) ProcessSlashingEvidence(evidence []block.SlashingEvidence) error {
+	if len(evidence) > sp.maxEvidencePerBlock {
+		return fmt.Errorf("%w: too many slashing evidence in block, %d > %d",
+			ErrInvalidMaxNumEvidence, len(evidence), sp.maxEvidencePerBlock)
+	}
+
+	for i := range evidence {
+		err := sp.verifier.Verify(&evidence[i])
+		if err != nil {
+			return err
+		}
+
+		vmOutput, err := sp.executeSlash(&evidence[i])
+		if err != nil {
+			return err
+		}
+		if vmOutput.ReturnCode != vmcommon.Ok {
+			return fmt.Errorf("%w: %s, %s", process.ErrSlashingExecutionFailed, vmOutput.ReturnCode.String(), vmOutput.ReturnMessage)
+		}
+
+		err = sp.applySlash(&evidence[i], vmOutput)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (sp *slashingProcessor) executeSlash(evidence *block.SlashingEvidence) (*vmcommon.VMOutput, error) {
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: sp.jailingAddress,
+			Arguments:  [][]byte{evidence.PubKey, big.NewInt(0).SetUint64(evidence.Round).Bytes()},
+			CallValue:  big.NewInt(0),
+		},
+		RecipientAddr: sp.stakingSCAddress,
+		Function:      "slash",
+	}
+
+	return sp.systemVM.RunSmartContractCall(vmInput)
+}
+
+func (sp *slashingProcessor) applySlash(evidence *block.SlashingEvidence, vmOutput *vmcommon.VMOutput) error {
+	err := sp.processSCOutputAccounts(vmOutput)
+	if err != nil {
+		return err
+	}
+
+	account, err := sp.peerAccountsDB.GetExistingAccount(evidence.PubKey)
+	if err != nil {
+		log.Debug("slashingProcessor: peer account not found", "pk", evidence.PubKey, "error", err.Error())
+		return nil
+	}
+
+	peerAccount, ok := account.(state.PeerAccountHandler)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+
+	peerAccount.SetTempRating(sp.minRating)
+
+	return sp.peerAccountsDB.SaveAccount(peerAccount)
+}
+
+// save account changes in state from vmOutput - protected by VM - every output can be treated as is.
+func (sp *slashingProcessor) processSCOutputAccounts(vmOutput *vmcommon.VMOutput) error {
+	outputAccounts := process.SortVMOutputInsideData(vmOutput)
+	for _, outAcc := range outputAccounts {
+		account, err := sp.userAccountsDB.LoadAccount(outAcc.Address)
+		if err != nil {
+			return err
+		}
+
+		userAccount, ok := account.(state.UserAccountHandler)
+		if !ok {
+			return process.ErrWrongTypeAssertion
+		}
+
+		storageUpdates := process.GetSortedStorageUpdates(outAcc)
+		for _, storeUpdate := range storageUpdates {
+			err = userAccount.DataTrieTracker().SaveKeyValue(storeUpdate.Offset, storeUpdate.Data)
+			if err != nil {
+				return err
+			}
+		}
+
+		if outAcc.BalanceDelta != nil && outAcc.BalanceDelta.Cmp(zero) != 0 {
+			err = userAccount.AddToBalance(outAcc.BalanceDelta)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = sp.userAccountsDB.SaveAccount(userAccount)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RemoveSlashingEvidenceFromPool removes the evidence included in a committed block from the pool
+func (sp *slashingProcessor) RemoveSlashingEvidenceFromPool(evidence []block.SlashingEvidence) {
+	for i := range evidence {
+		sp.evidencePool.RemoveEvidence(&evidence[i])
+	}
+}
+
+// RestoreSlashingEvidenceIntoPool puts back in the pool the evidence included in a reverted block
+func (sp *slashingProcessor) RestoreSlashingEvidenceIntoPool(evidence []block.SlashingEvidence) {
+	for i := range evidence {
+		evidenceCopy := evidence[i]
+		sp.evidencePool.AddEvidence(&evidenceCopy)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sp *slashingProcessor) IsInterfaceNil() bool {
+	return sp == nil
+}
@@ -0,0 +1,292 @@
+package slash
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var jailingAddress = []byte("jailing address")
+var stakingSCAddress = []byte("staking sc address")
+var validatorSCAddress = []byte("validator sc address")
+
+const minRating = uint32(1)
+
+type testAccounts struct {
+	userAccounts map[string]state.UserAccountHandler
+	peerAccounts map[string]state.PeerAccountHandler
+}
+
+func newTestAccounts() *testAccounts {
+	return &testAccounts{
+		userAccounts: make(map[string]state.UserAccountHandler),
+		peerAccounts: make(map[string]state.PeerAccountHandler),
+	}
+}
+
+func (ta *testAccounts) userAccountsDB() state.AccountsAdapter {
+	return &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			account, ok := ta.userAccounts[string(address)]
+			if ok {
+				return account, nil
+			}
+
+			return state.NewUserAccount(address)
+		},
+		SaveAccountCalled: func(account state.AccountHandler) error {
+			ta.userAccounts[string(account.AddressBytes())] = account.(state.UserAccountHandler)
+			return nil
+		},
+	}
+}
+
+func (ta *testAccounts) peerAccountsDB() state.AccountsAdapter {
+	return &mock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			account, ok := ta.peerAccounts[string(address)]
+			if !ok {
+				return nil, state.ErrAccNotFound
+			}
+
+			return account, nil
+		},
+		SaveAccountCalled: func(account state.AccountHandler) error {
+			ta.peerAccounts[string(account.AddressBytes())] = account.(state.PeerAccountHandler)
+			return nil
+		},
+	}
+}
+
+func createMockArgsSlashingProcessor(accounts *testAccounts) ArgsSlashingProcessor {
+	pool, _ := NewEvidencePool(100)
+
+	return ArgsSlashingProcessor{
+		SystemVM:            &mock.VMExecutionHandlerStub{},
+		UserAccountsDB:      accounts.userAccountsDB(),
+		PeerAccountsDB:      accounts.peerAccountsDB(),
+		Verifier:            &mock.SlashingEvidenceVerifierStub{},
+		EvidencePool:        pool,
+		RatingsInfo:         &mock.RatingsInfoMock{MinRatingProperty: minRating},
+		MaxEvidencePerBlock: 2,
+		JailingAddress:      jailingAddress,
+		StakingSCAddress:    stakingSCAddress,
+	}
+}
+
+func createSlashVMOutput(slashedValue int64) *vmcommon.VMOutput {
+	return &vmcommon.VMOutput{
+		ReturnCode: vmcommon.Ok,
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			string(validatorSCAddress): {
+				Address:      validatorSCAddress,
+				BalanceDelta: big.NewInt(-slashedValue),
+				StorageUpdates: map[string]*vmcommon.StorageUpdate{
+					"key": {Offset: []byte("key"), Data: []byte("value")},
+				},
+			},
+		},
+	}
+}
+
+func TestNewSlashingProcessor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		changeArgs  func(args *ArgsSlashingProcessor)
+		expectedErr error
+	}{
+		{name: "nil system VM", changeArgs: func(args *ArgsSlashingProcessor) { args.SystemVM = nil }, expectedErr: ErrNilSystemVM},
+		{name: "nil user accounts", changeArgs: func(args *ArgsSlashingProcessor) { args.UserAccountsDB = nil }, expectedErr: process.ErrNilAccountsAdapter},
+		{name: "nil peer accounts", changeArgs: func(args *ArgsSlashingProcessor) { args.PeerAccountsDB = nil }, expectedErr: process.ErrNilPeerAccountsAdapter},
+		{name: "nil verifier", changeArgs: func(args *ArgsSlashingProcessor) { args.Verifier = nil }, expectedErr: process.ErrNilSlashingEvidenceVerifier},
+		{name: "nil evidence pool", changeArgs: func(args *ArgsSlashingProcessor) { args.EvidencePool = nil }, expectedErr: process.ErrNilSlashingEvidencePool},
+		{name: "nil ratings info", changeArgs: func(args *ArgsSlashingProcessor) { args.RatingsInfo = nil }, expectedErr: process.ErrNilRatingsInfoHandler},
+		{name: "invalid max evidence", changeArgs: func(args *ArgsSlashingProcessor) { args.MaxEvidencePerBlock = 0 }, expectedErr: ErrInvalidMaxNumEvidence},
+		{name: "empty jailing address", changeArgs: func(args *ArgsSlashingProcessor) { args.JailingAddress = nil }, expectedErr: ErrEmptyAddress},
+		{name: "empty staking address", changeArgs: func(args *ArgsSlashingProcessor) { args.StakingSCAddress = nil }, expectedErr: ErrEmptyAddress},
+		{name: "should work", changeArgs: func(args *ArgsSlashingProcessor) {}, expectedErr: nil},
+	}
+
+	for _, tt := range tests {
+		args := createMockArgsSlashingProcessor(newTestAccounts())
+		tt.changeArgs(&args)
+
+		sp, err := NewSlashingProcessor(args)
+		assert.Equal(t, tt.expectedErr, err, tt.name)
+		assert.Equal(t, tt.expectedErr == nil, !check.IfNil(sp), tt.name)
+	}
+}
+
+func TestSlashingProcessor_CreateSlashingEvidenceShouldExecuteAndFilter(t *testing.T) {
+	t.Parallel()
+
+	accounts := newTestAccounts()
+	validatorAccount, _ := state.NewUserAccount(validatorSCAddress)
+	_ = validatorAccount.AddToBalance(big.NewInt(1000))
+	accounts.userAccounts[string(validatorSCAddress)] = validatorAccount
+	offenderPeerAccount, _ := state.NewPeerAccount([]byte("offender"))
+	offenderPeerAccount.SetTempRating(500000)
+	accounts.peerAccounts["offender"] = offenderPeerAccount
+
+	args := createMockArgsSlashingProcessor(accounts)
+	args.MaxEvidencePerBlock = 10
+	args.Verifier = &mock.SlashingEvidenceVerifierStub{
+		VerifyCalled: func(evidence *block.SlashingEvidence) error {
+			if string(evidence.PubKey) == "forged" {
+				return errors.New("invalid signature")
+			}
+			return nil
+		},
+	}
+	args.SystemVM = &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			assert.Equal(t, jailingAddress, input.CallerAddr)
+			assert.Equal(t, stakingSCAddress, input.RecipientAddr)
+			assert.Equal(t, "slash", input.Function)
+			if string(input.Arguments[0]) == "already slashed" {
+				return &vmcommon.VMOutput{ReturnCode: vmcommon.UserError}, nil
+			}
+
+			return createSlashVMOutput(100), nil
+		},
+	}
+	_ = args.EvidencePool.AddEvidence(&block.SlashingEvidence{PubKey: []byte("offender"), Round: 1})
+	_ = args.EvidencePool.AddEvidence(&block.SlashingEvidence{PubKey: []byte("forged"), Round: 2})
+	_ = args.EvidencePool.AddEvidence(&block.SlashingEvidence{PubKey: []byte("already slashed"), Round: 3})
+	sp, _ := NewSlashingProcessor(args)
+
+	included, err := sp.CreateSlashingEvidence()
+	require.Nil(t, err)
+	require.Equal(t, 1, len(included))
+	assert.Equal(t, []byte("offender"), included[0].PubKey)
+
+	assert.Equal(t, big.NewInt(900), accounts.userAccounts[string(validatorSCAddress)].GetBalance())
+	value, _ := accounts.userAccounts[string(validatorSCAddress)].DataTrieTracker().RetrieveValue([]byte("key"))
+	assert.Equal(t, []byte("value"), value)
+	assert.Equal(t, minRating, accounts.peerAccounts["offender"].GetTempRating())
+
+	assert.Equal(t, 1, args.EvidencePool.Len(), "invalid and not executable evidence should have been removed")
+	assert.True(t, args.EvidencePool.HasEvidence([]byte("offender"), 1))
+}
+
+func TestSlashingProcessor_CreateSlashingEvidenceVMErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createMockArgsSlashingProcessor(newTestAccounts())
+	args.SystemVM = &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			return nil, expectedErr
+		},
+	}
+	_ = args.EvidencePool.AddEvidence(&block.SlashingEvidence{PubKey: []byte("offender"), Round: 1})
+	sp, _ := NewSlashingProcessor(args)
+
+	included, err := sp.CreateSlashingEvidence()
+	assert.Equal(t, expectedErr, err)
+	assert.Nil(t, included)
+}
+
+func TestSlashingProcessor_CreateSlashingEvidenceShouldRespectMaxEvidencePerBlock(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSlashingProcessor(newTestAccounts())
+	args.SystemVM = &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+		},
+	}
+	for i := uint64(0); i < 5; i++ {
+		_ = args.EvidencePool.AddEvidence(&block.SlashingEvidence{PubKey: []byte("offender"), Round: i})
+	}
+	sp, _ := NewSlashingProcessor(args)
+
+	included, err := sp.CreateSlashingEvidence()
+	assert.Nil(t, err)
+	assert.Equal(t, args.MaxEvidencePerBlock, len(included))
+}
+
+func TestSlashingProcessor_ProcessSlashingEvidence(t *testing.T) {
+	t.Parallel()
+
+	evidence := []block.SlashingEvidence{{PubKey: []byte("offender"), Round: 1}}
+
+	t.Run("too many evidence", func(t *testing.T) {
+		args := createMockArgsSlashingProcessor(newTestAccounts())
+		sp, _ := NewSlashingProcessor(args)
+
+		err := sp.ProcessSlashingEvidence(make([]block.SlashingEvidence, args.MaxEvidencePerBlock+1))
+		assert.True(t, errors.Is(err, ErrInvalidMaxNumEvidence))
+	})
+	t.Run("invalid evidence", func(t *testing.T) {
+		expectedErr := errors.New("expected error")
+		args := createMockArgsSlashingProcessor(newTestAccounts())
+		args.Verifier = &mock.SlashingEvidenceVerifierStub{
+			VerifyCalled: func(evidence *block.SlashingEvidence) error {
+				return expectedErr
+			},
+		}
+		sp, _ := NewSlashingProcessor(args)
+
+		err := sp.ProcessSlashingEvidence(evidence)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("slash not executed", func(t *testing.T) {
+		args := createMockArgsSlashingProcessor(newTestAccounts())
+		args.SystemVM = &mock.VMExecutionHandlerStub{
+			RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				return &vmcommon.VMOutput{ReturnCode: vmcommon.UserError, ReturnMessage: "already slashed"}, nil
+			},
+		}
+		sp, _ := NewSlashingProcessor(args)
+
+		err := sp.ProcessSlashingEvidence(evidence)
+		assert.True(t, errors.Is(err, process.ErrSlashingExecutionFailed))
+	})
+	t.Run("should work", func(t *testing.T) {
+		accounts := newTestAccounts()
+		validatorAccount, _ := state.NewUserAccount(validatorSCAddress)
+		_ = validatorAccount.AddToBalance(big.NewInt(1000))
+		accounts.userAccounts[string(validatorSCAddress)] = validatorAccount
+		args := createMockArgsSlashingProcessor(accounts)
+		args.SystemVM = &mock.VMExecutionHandlerStub{
+			RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				return createSlashVMOutput(100), nil
+			},
+		}
+		sp, _ := NewSlashingProcessor(args)
+
+		err := sp.ProcessSlashingEvidence(evidence)
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(900), accounts.userAccounts[string(validatorSCAddress)].GetBalance())
+	})
+}
+
+func TestSlashingProcessor_RemoveAndRestoreSlashingEvidence(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSlashingProcessor(newTestAccounts())
+	sp, _ := NewSlashingProcessor(args)
+	evidence := []block.SlashingEvidence{
+		{PubKey: []byte("pk1"), Round: 1},
+		{PubKey: []byte("pk2"), Round: 1},
+	}
+
+	sp.RestoreSlashingEvidenceIntoPool(evidence)
+	assert.Equal(t, 2, args.EvidencePool.Len())
+
+	sp.RemoveSlashingEvidenceFromPool(evidence[:1])
+	assert.Equal(t, 1, args.EvidencePool.Len())
+	assert.True(t, args.EvidencePool.HasEvidence([]byte("pk2"), 1))
+}
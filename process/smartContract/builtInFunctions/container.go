@@ -23,8 +23,22 @@ func NewBuiltInFunctionContainer() *functionContainer {
 }
 
 // Get returns the object stored at a certain key.
-// Returns an error if the element does not exist
+// Returns an error if the element does not exist or if it is not enabled yet
 func (f *functionContainer) Get(key string) (process.BuiltinFunction, error) {
+	function, err := f.getIncludingDisabled(key)
+	if err != nil {
+		return nil, err
+	}
+
+	handler, ok := function.(enabledHandler)
+	if ok && !handler.IsEnabled() {
+		return nil, fmt.Errorf("%w in function container for key %v", process.ErrInvalidContainerKey, key)
+	}
+
+	return function, nil
+}
+
+func (f *functionContainer) getIncludingDisabled(key string) (process.BuiltinFunction, error) {
 	value, ok := f.objects.Get(key)
 	if !ok {
 		return nil, fmt.Errorf("%w in function container for key %v", process.ErrInvalidContainerKey, key)
@@ -79,17 +93,33 @@ func (f *functionContainer) Len() int {
 	return f.objects.Len()
 }
 
-// Keys returns all the keys in the containers
+// Keys returns the keys of the enabled functions. These are the names handed to the VM, which routes the calls to
+// them towards the built in functions, so a function which is not enabled yet must not be listed
 func (f *functionContainer) Keys() map[string]struct{} {
 	keys := make(map[string]struct{}, f.Len())
 
+	for _, key := range f.keysIncludingDisabled() {
+		_, err := f.Get(key)
+		if err != nil {
+			continue
+		}
+
+		keys[key] = struct{}{}
+	}
+
+	return keys
+}
+
+func (f *functionContainer) keysIncludingDisabled() []string {
+	keys := make([]string, 0, f.Len())
+
 	for _, key := range f.objects.Keys() {
 		stringKey, ok := key.(string)
 		if !ok {
 			continue
 		}
 
-		keys[stringKey] = struct{}{}
+		keys = append(keys, stringKey)
 	}
 
 	return keys
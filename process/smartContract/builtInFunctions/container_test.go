@@ -85,6 +85,31 @@ func TestBuiltInFunctionContainer_GetShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestBuiltInFunctionContainer_GetNotEnabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	c := NewBuiltInFunctionContainer()
+
+	key := "key"
+	val, _ := newEpochEnabledFunction(key, &mock.BuiltInFunctionStub{}, 1, &mock.EpochNotifierStub{})
+
+	_ = c.Add(key, val)
+	valRecovered, err := c.Get(key)
+
+	assert.Nil(t, valRecovered)
+	assert.True(t, errors.Is(err, process.ErrInvalidContainerKey))
+	_, found := c.Keys()[key]
+	assert.False(t, found)
+
+	val.EpochConfirmed(1)
+	valRecovered, err = c.Get(key)
+
+	assert.True(t, val == valRecovered)
+	assert.Nil(t, err)
+	_, found = c.Keys()[key]
+	assert.True(t, found)
+}
+
 //------- Replace
 
 func TestBuiltInFunctionContainer_ReplaceNilValueShouldErrAndNotModify(t *testing.T) {
@@ -0,0 +1,61 @@
+package builtInFunctions
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// enabledHandler is implemented by the built in functions which can be disabled
+type enabledHandler interface {
+	IsEnabled() bool
+}
+
+// epochEnabledFunction wraps a built in function which is enabled only starting with a given epoch. Until then, the
+// function container acts as if the function did not exist, so that the transactions calling it are processed the
+// same way as before the function was added
+type epochEnabledFunction struct {
+	process.BuiltinFunction
+	name        string
+	enableEpoch uint32
+	flagEnabled atomic.Flag
+}
+
+func newEpochEnabledFunction(
+	name string,
+	function process.BuiltinFunction,
+	enableEpoch uint32,
+	epochNotifier process.EpochNotifier,
+) (*epochEnabledFunction, error) {
+	if check.IfNil(function) {
+		return nil, process.ErrNilBuiltInFunction
+	}
+	if check.IfNil(epochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+
+	e := &epochEnabledFunction{
+		BuiltinFunction: function,
+		name:            name,
+		enableEpoch:     enableEpoch,
+	}
+	epochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
+
+// IsEnabled returns true if the wrapped function is enabled in the current epoch
+func (e *epochEnabledFunction) IsEnabled() bool {
+	return e.flagEnabled.IsSet()
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (e *epochEnabledFunction) EpochConfirmed(epoch uint32) {
+	e.flagEnabled.Toggle(epoch >= e.enableEpoch)
+	log.Debug("built in function", "name", e.name, "enabled", e.flagEnabled.IsSet())
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (e *epochEnabledFunction) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,118 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.BuiltinFunction = (*esdtApprove)(nil)
+
+type esdtApprove struct {
+	funcGasCost  uint64
+	marshalizer  marshal.Marshalizer
+	keyPrefix    []byte
+	mutExecution sync.RWMutex
+}
+
+// NewESDTApproveFunc returns the esdt approve built-in function component
+func NewESDTApproveFunc(
+	funcGasCost uint64,
+	marshalizer marshal.Marshalizer,
+) (*esdtApprove, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+
+	e := &esdtApprove{
+		funcGasCost: funcGasCost,
+		marshalizer: marshalizer,
+		keyPrefix:   []byte(core.ElrondProtectedKeyPrefix + core.ESDTAllowanceKeyIdentifier),
+	}
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *esdtApprove) SetNewGasConfig(gasCost *process.GasCost) {
+	e.mutExecution.Lock()
+	e.funcGasCost = gasCost.BuiltInCost.ESDTApprove
+	e.mutExecution.Unlock()
+}
+
+// ProcessBuiltinFunction resolves ESDT approve function call. The owner sends the transaction to its own address with
+// the token name, the spender address and the allowed value as arguments. A zero value revokes the allowance.
+func (e *esdtApprove) ProcessBuiltinFunction(
+	_, acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	if vmInput == nil {
+		return nil, process.ErrNilVmInput
+	}
+	if vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, process.ErrBuiltInFunctionCalledWithValue
+	}
+	if len(vmInput.Arguments) != 3 {
+		return nil, process.ErrInvalidArguments
+	}
+	if !bytes.Equal(vmInput.CallerAddr, vmInput.RecipientAddr) {
+		return nil, process.ErrOperationNotPermitted
+	}
+	if len(vmInput.Arguments[1]) != len(vmInput.CallerAddr) {
+		return nil, process.ErrInvalidAddressLength
+	}
+	if check.IfNil(acntDst) {
+		return nil, process.ErrNilUserAccount
+	}
+	if vmInput.GasProvided < e.funcGasCost {
+		return nil, process.ErrNotEnoughGas
+	}
+
+	value := big.NewInt(0).SetBytes(vmInput.Arguments[2])
+	allowanceKey := computeESDTAllowanceKey(e.keyPrefix, vmInput.Arguments[0], vmInput.Arguments[1])
+	log.Trace("esdtApprove", "owner", vmInput.CallerAddr, "spender", vmInput.Arguments[1], "value", value, "token", vmInput.Arguments[0])
+
+	err := saveESDTAllowance(acntDst, allowanceKey, value, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{GasRemaining: vmInput.GasProvided - e.funcGasCost, ReturnCode: vmcommon.Ok}
+	return vmOutput, nil
+}
+
+func computeESDTAllowanceKey(keyPrefix []byte, tokenName []byte, spender []byte) []byte {
+	allowanceKey := make([]byte, 0, len(keyPrefix)+len(tokenName)+len(spender))
+	allowanceKey = append(allowanceKey, keyPrefix...)
+	allowanceKey = append(allowanceKey, tokenName...)
+	return append(allowanceKey, spender...)
+}
+
+func saveESDTAllowance(
+	userAcnt state.UserAccountHandler,
+	key []byte,
+	value *big.Int,
+	marshalizer marshal.Marshalizer,
+) error {
+	if value.Cmp(zero) == 0 {
+		return userAcnt.DataTrieTracker().SaveKeyValue(key, nil)
+	}
+
+	return saveESDTData(userAcnt, &esdt.ESDigitalToken{Value: value}, key, marshalizer)
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtApprove) IsInterfaceNil() bool {
+	return e == nil
+}
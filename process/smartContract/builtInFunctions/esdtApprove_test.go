@@ -0,0 +1,105 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewESDTApproveFunc_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	approveFunc, err := NewESDTApproveFunc(10, nil)
+	assert.Nil(t, approveFunc)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestESDTApprove_ProcessBuiltInFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	approveFunc, _ := NewESDTApproveFunc(10, &mock.MarshalizerMock{})
+	_, err := approveFunc.ProcessBuiltinFunction(nil, nil, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	owner := bytes.Repeat([]byte{1}, 32)
+	spender := bytes.Repeat([]byte{2}, 32)
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  owner,
+			CallValue:   big.NewInt(1),
+			GasProvided: 50,
+		},
+		RecipientAddr: owner,
+	}
+	_, err = approveFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrBuiltInFunctionCalledWithValue, err)
+
+	input.CallValue = big.NewInt(0)
+	input.Arguments = [][]byte{[]byte("token"), spender}
+	_, err = approveFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input.Arguments = [][]byte{[]byte("token"), spender, big.NewInt(10).Bytes()}
+	input.RecipientAddr = spender
+	_, err = approveFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrOperationNotPermitted, err)
+
+	input.RecipientAddr = owner
+	input.Arguments[1] = []byte("short")
+	_, err = approveFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrInvalidAddressLength, err)
+
+	input.Arguments[1] = spender
+	_, err = approveFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrNilUserAccount, err)
+
+	acnt, _ := state.NewUserAccount(owner)
+	input.GasProvided = approveFunc.funcGasCost - 1
+	_, err = approveFunc.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrNotEnoughGas, err)
+}
+
+func TestESDTApprove_ProcessBuiltInFunctionShouldSetAndRevokeAllowance(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	approveFunc, _ := NewESDTApproveFunc(10, marshalizer)
+
+	owner := bytes.Repeat([]byte{1}, 32)
+	spender := bytes.Repeat([]byte{2}, 32)
+	token := []byte("token")
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  owner,
+			CallValue:   big.NewInt(0),
+			GasProvided: 50,
+			Arguments:   [][]byte{token, spender, big.NewInt(100).Bytes()},
+		},
+		RecipientAddr: owner,
+	}
+	acnt, _ := state.NewUserAccount(owner)
+
+	vmOutput, err := approveFunc.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(40), vmOutput.GasRemaining)
+
+	allowanceKey := computeESDTAllowanceKey(approveFunc.keyPrefix, token, spender)
+	marshaledData, _ := acnt.DataTrieTracker().RetrieveValue(allowanceKey)
+	allowance := &esdt.ESDigitalToken{}
+	_ = marshalizer.Unmarshal(allowance, marshaledData)
+	assert.Equal(t, big.NewInt(100), allowance.Value)
+
+	input.Arguments[2] = big.NewInt(0).Bytes()
+	_, err = approveFunc.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Nil(t, err)
+
+	marshaledData, _ = acnt.DataTrieTracker().RetrieveValue(allowanceKey)
+	assert.Equal(t, 0, len(marshaledData))
+}
@@ -0,0 +1,106 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.BuiltinFunction = (*esdtNFTAddQuantity)(nil)
+
+type esdtNFTAddQuantity struct {
+	keyPrefix    []byte
+	marshalizer  marshal.Marshalizer
+	pauseHandler process.ESDTPauseHandler
+	funcGasCost  uint64
+	mutExecution sync.RWMutex
+}
+
+// NewESDTNFTAddQuantityFunc returns the esdt NFT add quantity built-in function component
+func NewESDTNFTAddQuantityFunc(
+	funcGasCost uint64,
+	marshalizer marshal.Marshalizer,
+	pauseHandler process.ESDTPauseHandler,
+) (*esdtNFTAddQuantity, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(pauseHandler) {
+		return nil, process.ErrNilPauseHandler
+	}
+
+	e := &esdtNFTAddQuantity{
+		keyPrefix:    []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier),
+		marshalizer:  marshalizer,
+		pauseHandler: pauseHandler,
+		funcGasCost:  funcGasCost,
+	}
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *esdtNFTAddQuantity) SetNewGasConfig(gasCost *process.GasCost) {
+	e.mutExecution.Lock()
+	e.funcGasCost = gasCost.BuiltInCost.ESDTNFTAddQuantity
+	e.mutExecution.Unlock()
+}
+
+// ProcessBuiltinFunction resolves ESDT NFT add quantity function call. The holder of the add quantity role sends the
+// transaction to its own address with the token identifier, the nonce and the quantity to be added as arguments
+func (e *esdtNFTAddQuantity) ProcessBuiltinFunction(
+	acntSnd, _ state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	err := checkESDTNFTCreateBurnAddInput(acntSnd, vmInput, e.funcGasCost)
+	if err != nil {
+		return nil, err
+	}
+	if len(vmInput.Arguments) != 3 {
+		return nil, process.ErrInvalidArguments
+	}
+
+	tokenID := vmInput.Arguments[0]
+	err = checkESDTRole(acntSnd, tokenID, []byte(core.ESDTRoleNFTAddQuantity), e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+	if e.pauseHandler.IsPaused(computeESDTTokenKey(e.keyPrefix, tokenID)) {
+		return nil, process.ErrESDTTokenIsPaused
+	}
+
+	nonce := big.NewInt(0).SetBytes(vmInput.Arguments[1]).Uint64()
+	esdtData, err := getESDTNFTToken(acntSnd, e.keyPrefix, tokenID, nonce, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	value := big.NewInt(0).SetBytes(vmInput.Arguments[2])
+	if value.Cmp(zero) <= 0 {
+		return nil, process.ErrInvalidNFTQuantity
+	}
+	esdtData.Value.Add(esdtData.Value, value)
+	log.Trace("esdtNFTAddQuantity", "address", vmInput.CallerAddr, "token", tokenID, "nonce", nonce, "value", value)
+
+	err = saveESDTNFTToken(acntSnd, e.keyPrefix, tokenID, esdtData, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRemaining: vmInput.GasProvided - e.funcGasCost}
+	return vmOutput, nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtNFTAddQuantity) IsInterfaceNil() bool {
+	return e == nil
+}
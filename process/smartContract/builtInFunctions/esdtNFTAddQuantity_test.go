@@ -0,0 +1,92 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createNFTQuantityInput(nonce uint64, quantity int64) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  testOwner,
+			CallValue:   big.NewInt(0),
+			GasProvided: 50,
+			Arguments:   [][]byte{testToken, big.NewInt(0).SetUint64(nonce).Bytes(), big.NewInt(quantity).Bytes()},
+		},
+		RecipientAddr: testOwner,
+	}
+}
+
+func saveTestNFT(t *testing.T, acnt state.UserAccountHandler, marshalizer marshal.Marshalizer, nonce uint64, quantity int64) {
+	esdtData := &esdt.ESDigitalToken{
+		Type:          uint32(core.SemiFungible),
+		Value:         big.NewInt(quantity),
+		TokenMetaData: &esdt.MetaData{Nonce: nonce, Name: []byte("name"), Creator: testOwner},
+	}
+	keyPrefix := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier)
+	require.Nil(t, saveESDTNFTToken(acnt, keyPrefix, testToken, esdtData, marshalizer))
+}
+
+func TestNewESDTNFTAddQuantityFunc_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	addQuantityFunc, err := NewESDTNFTAddQuantityFunc(10, nil, &mock.PauseHandlerStub{})
+	assert.Nil(t, addQuantityFunc)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	addQuantityFunc, err = NewESDTNFTAddQuantityFunc(10, &mock.MarshalizerMock{}, nil)
+	assert.Nil(t, addQuantityFunc)
+	assert.Equal(t, process.ErrNilPauseHandler, err)
+}
+
+func TestESDTNFTAddQuantity_ProcessBuiltInFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	addQuantityFunc, _ := NewESDTNFTAddQuantityFunc(10, marshalizer, &mock.PauseHandlerStub{})
+	_, err := addQuantityFunc.ProcessBuiltinFunction(nil, nil, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	acnt := createAccountWithRoles(t, marshalizer, core.ESDTRoleNFTCreate)
+	input := createNFTQuantityInput(1, 10)
+	input.Arguments = input.Arguments[:2]
+	_, err = addQuantityFunc.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	_, err = addQuantityFunc.ProcessBuiltinFunction(acnt, acnt, createNFTQuantityInput(1, 10))
+	assert.Equal(t, process.ErrActionNotAllowed, err)
+
+	acnt = createAccountWithRoles(t, marshalizer, core.ESDTRoleNFTAddQuantity)
+	_, err = addQuantityFunc.ProcessBuiltinFunction(acnt, acnt, createNFTQuantityInput(1, 10))
+	assert.Equal(t, process.ErrNFTTokenDoesNotExist, err)
+
+	saveTestNFT(t, acnt, marshalizer, 1, 5)
+	_, err = addQuantityFunc.ProcessBuiltinFunction(acnt, acnt, createNFTQuantityInput(1, 0))
+	assert.Equal(t, process.ErrInvalidNFTQuantity, err)
+}
+
+func TestESDTNFTAddQuantity_ProcessBuiltInFunctionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	addQuantityFunc, _ := NewESDTNFTAddQuantityFunc(10, marshalizer, &mock.PauseHandlerStub{})
+	acnt := createAccountWithRoles(t, marshalizer, core.ESDTRoleNFTAddQuantity)
+	saveTestNFT(t, acnt, marshalizer, 1, 5)
+
+	vmOutput, err := addQuantityFunc.ProcessBuiltinFunction(acnt, acnt, createNFTQuantityInput(1, 10))
+	require.Nil(t, err)
+	assert.Equal(t, uint64(40), vmOutput.GasRemaining)
+
+	esdtData, _ := getESDTNFTToken(acnt, addQuantityFunc.keyPrefix, testToken, 1, marshalizer)
+	assert.Equal(t, big.NewInt(15), esdtData.Value)
+}
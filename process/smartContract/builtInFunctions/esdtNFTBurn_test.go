@@ -0,0 +1,65 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewESDTNFTBurnFunc_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	burnFunc, err := NewESDTNFTBurnFunc(10, nil, &mock.PauseHandlerStub{})
+	assert.Nil(t, burnFunc)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	burnFunc, err = NewESDTNFTBurnFunc(10, &mock.MarshalizerMock{}, nil)
+	assert.Nil(t, burnFunc)
+	assert.Equal(t, process.ErrNilPauseHandler, err)
+}
+
+func TestESDTNFTBurn_ProcessBuiltInFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	burnFunc, _ := NewESDTNFTBurnFunc(10, marshalizer, &mock.PauseHandlerStub{})
+
+	acnt := createAccountWithRoles(t, marshalizer, core.ESDTRoleNFTCreate)
+	saveTestNFT(t, acnt, marshalizer, 1, 5)
+	_, err := burnFunc.ProcessBuiltinFunction(acnt, acnt, createNFTQuantityInput(1, 1))
+	assert.Equal(t, process.ErrActionNotAllowed, err)
+
+	acnt = createAccountWithRoles(t, marshalizer, core.ESDTRoleNFTBurn)
+	saveTestNFT(t, acnt, marshalizer, 1, 5)
+	_, err = burnFunc.ProcessBuiltinFunction(acnt, acnt, createNFTQuantityInput(1, 6))
+	assert.Equal(t, process.ErrInsufficientFunds, err)
+
+	input := createNFTQuantityInput(1, 1)
+	input.GasProvided = 5
+	_, err = burnFunc.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrNotEnoughGas, err)
+}
+
+func TestESDTNFTBurn_ProcessBuiltInFunctionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	burnFunc, _ := NewESDTNFTBurnFunc(10, marshalizer, &mock.PauseHandlerStub{})
+	acnt := createAccountWithRoles(t, marshalizer, core.ESDTRoleNFTBurn)
+	saveTestNFT(t, acnt, marshalizer, 1, 5)
+
+	_, err := burnFunc.ProcessBuiltinFunction(acnt, acnt, createNFTQuantityInput(1, 2))
+	require.Nil(t, err)
+	esdtData, _ := getESDTNFTToken(acnt, burnFunc.keyPrefix, testToken, 1, marshalizer)
+	assert.Equal(t, big.NewInt(3), esdtData.Value)
+
+	_, err = burnFunc.ProcessBuiltinFunction(acnt, acnt, createNFTQuantityInput(1, 3))
+	require.Nil(t, err)
+	_, err = getESDTNFTToken(acnt, burnFunc.keyPrefix, testToken, 1, marshalizer)
+	assert.Equal(t, process.ErrNFTTokenDoesNotExist, err)
+}
@@ -0,0 +1,249 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.BuiltinFunction = (*esdtNFTCreate)(nil)
+
+type esdtNFTCreate struct {
+	keyPrefix    []byte
+	marshalizer  marshal.Marshalizer
+	pauseHandler process.ESDTPauseHandler
+	funcGasCost  uint64
+	gasConfig    process.BaseOperationCost
+	mutExecution sync.RWMutex
+}
+
+// NewESDTNFTCreateFunc returns the esdt NFT create built-in function component
+func NewESDTNFTCreateFunc(
+	funcGasCost uint64,
+	gasConfig process.BaseOperationCost,
+	marshalizer marshal.Marshalizer,
+	pauseHandler process.ESDTPauseHandler,
+) (*esdtNFTCreate, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(pauseHandler) {
+		return nil, process.ErrNilPauseHandler
+	}
+
+	e := &esdtNFTCreate{
+		keyPrefix:    []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier),
+		marshalizer:  marshalizer,
+		pauseHandler: pauseHandler,
+		funcGasCost:  funcGasCost,
+		gasConfig:    gasConfig,
+	}
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *esdtNFTCreate) SetNewGasConfig(gasCost *process.GasCost) {
+	e.mutExecution.Lock()
+	e.funcGasCost = gasCost.BuiltInCost.ESDTNFTCreate
+	e.gasConfig = gasCost.BaseOperationCost
+	e.mutExecution.Unlock()
+}
+
+// ProcessBuiltinFunction resolves ESDT NFT create function call. The creator sends the transaction to its own address
+// with the token identifier, the initial quantity, the name, the royalties, the hash, the attributes and the URIs as
+// arguments. The newly created nonce is returned.
+func (e *esdtNFTCreate) ProcessBuiltinFunction(
+	acntSnd, _ state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	err := checkESDTNFTCreateBurnAddInput(acntSnd, vmInput, e.funcGasCost)
+	if err != nil {
+		return nil, err
+	}
+	if len(vmInput.Arguments) < 7 {
+		return nil, process.ErrInvalidArguments
+	}
+
+	tokenID := vmInput.Arguments[0]
+	err = checkESDTRole(acntSnd, tokenID, []byte(core.ESDTRoleNFTCreate), e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	quantity := big.NewInt(0).SetBytes(vmInput.Arguments[1])
+	if quantity.Cmp(zero) <= 0 {
+		return nil, process.ErrInvalidNFTQuantity
+	}
+	esdtType := core.NonFungible
+	if quantity.Cmp(big.NewInt(1)) > 0 {
+		err = checkESDTRole(acntSnd, tokenID, []byte(core.ESDTRoleNFTAddQuantity), e.marshalizer)
+		if err != nil {
+			return nil, err
+		}
+		esdtType = core.SemiFungible
+	}
+
+	royalties := uint32(big.NewInt(0).SetBytes(vmInput.Arguments[3]).Uint64())
+	if royalties > core.MaxRoyalty || len(vmInput.Arguments[3]) > 4 {
+		return nil, process.ErrInvalidNFTRoyalties
+	}
+
+	if e.pauseHandler.IsPaused(computeESDTTokenKey(e.keyPrefix, tokenID)) {
+		return nil, process.ErrESDTTokenIsPaused
+	}
+
+	nonce, err := getLatestNonce(acntSnd, tokenID)
+	if err != nil {
+		return nil, err
+	}
+	nonce++
+
+	esdtData := &esdt.ESDigitalToken{
+		Type:  uint32(esdtType),
+		Value: quantity,
+		TokenMetaData: &esdt.MetaData{
+			Nonce:      nonce,
+			Name:       vmInput.Arguments[2],
+			Creator:    vmInput.CallerAddr,
+			Royalties:  royalties,
+			Hash:       vmInput.Arguments[4],
+			Attributes: vmInput.Arguments[5],
+			URIs:       vmInput.Arguments[6:],
+		},
+	}
+
+	marshaledData, err := e.marshalizer.Marshal(esdtData)
+	if err != nil {
+		return nil, err
+	}
+	gasToUse := e.funcGasCost + e.gasConfig.StorePerByte*uint64(len(marshaledData))
+	if vmInput.GasProvided < gasToUse {
+		return nil, process.ErrNotEnoughGas
+	}
+
+	esdtTokenKey := computeESDTNFTTokenKey(e.keyPrefix, tokenID, nonce)
+	log.Trace("esdtNFTCreate", "creator", vmInput.CallerAddr, "token", tokenID, "nonce", nonce, "quantity", quantity)
+
+	err = acntSnd.DataTrieTracker().SaveKeyValue(esdtTokenKey, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+	err = saveLatestNonce(acntSnd, tokenID, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: vmInput.GasProvided - gasToUse,
+		ReturnData:   [][]byte{big.NewInt(0).SetUint64(nonce).Bytes()},
+	}
+	return vmOutput, nil
+}
+
+// checkESDTNFTCreateBurnAddInput verifies the input of the built-in functions which can be called only by an account
+// on itself
+func checkESDTNFTCreateBurnAddInput(
+	acntSnd state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+	funcGasCost uint64,
+) error {
+	if vmInput == nil {
+		return process.ErrNilVmInput
+	}
+	if vmInput.CallValue.Cmp(zero) != 0 {
+		return process.ErrBuiltInFunctionCalledWithValue
+	}
+	if !bytes.Equal(vmInput.CallerAddr, vmInput.RecipientAddr) {
+		return process.ErrOperationNotPermitted
+	}
+	if check.IfNil(acntSnd) {
+		return process.ErrNilUserAccount
+	}
+	if vmInput.GasProvided < funcGasCost {
+		return process.ErrNotEnoughGas
+	}
+
+	return nil
+}
+
+func computeESDTTokenKey(esdtTokenKeyPrefix []byte, tokenID []byte) []byte {
+	esdtTokenKey := make([]byte, 0, len(esdtTokenKeyPrefix)+len(tokenID))
+	esdtTokenKey = append(esdtTokenKey, esdtTokenKeyPrefix...)
+	return append(esdtTokenKey, tokenID...)
+}
+
+func computeESDTNFTTokenKey(esdtTokenKeyPrefix []byte, tokenID []byte, nonce uint64) []byte {
+	return append(computeESDTTokenKey(esdtTokenKeyPrefix, tokenID), big.NewInt(0).SetUint64(nonce).Bytes()...)
+}
+
+func computeLatestNonceKey(tokenID []byte) []byte {
+	return []byte(core.ElrondProtectedKeyPrefix + core.ESDTNFTLatestNonceIdentifier + string(tokenID))
+}
+
+func getLatestNonce(acnt state.UserAccountHandler, tokenID []byte) (uint64, error) {
+	nonceData, err := acnt.DataTrieTracker().RetrieveValue(computeLatestNonceKey(tokenID))
+	if err != nil || len(nonceData) == 0 {
+		return 0, nil
+	}
+
+	return big.NewInt(0).SetBytes(nonceData).Uint64(), nil
+}
+
+func saveLatestNonce(acnt state.UserAccountHandler, tokenID []byte, nonce uint64) error {
+	return acnt.DataTrieTracker().SaveKeyValue(computeLatestNonceKey(tokenID), big.NewInt(0).SetUint64(nonce).Bytes())
+}
+
+// getESDTNFTToken returns the non-fungible token saved under the given token identifier and nonce
+func getESDTNFTToken(
+	acnt state.UserAccountHandler,
+	esdtTokenKeyPrefix []byte,
+	tokenID []byte,
+	nonce uint64,
+	marshalizer marshal.Marshalizer,
+) (*esdt.ESDigitalToken, error) {
+	esdtTokenKey := computeESDTNFTTokenKey(esdtTokenKeyPrefix, tokenID, nonce)
+	esdtData, err := getESDTDataFromKey(acnt, esdtTokenKey, marshalizer)
+	if err != nil {
+		return nil, err
+	}
+	if esdtData.TokenMetaData == nil {
+		return nil, process.ErrNFTTokenDoesNotExist
+	}
+
+	return esdtData, nil
+}
+
+// saveESDTNFTToken saves the non-fungible token under the given token identifier and its nonce. A zero quantity
+// removes the token from the account
+func saveESDTNFTToken(
+	acnt state.UserAccountHandler,
+	esdtTokenKeyPrefix []byte,
+	tokenID []byte,
+	esdtData *esdt.ESDigitalToken,
+	marshalizer marshal.Marshalizer,
+) error {
+	esdtTokenKey := computeESDTNFTTokenKey(esdtTokenKeyPrefix, tokenID, esdtData.TokenMetaData.Nonce)
+	if esdtData.Value.Cmp(zero) == 0 {
+		return acnt.DataTrieTracker().SaveKeyValue(esdtTokenKey, nil)
+	}
+
+	return saveESDTData(acnt, esdtData, esdtTokenKey, marshalizer)
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtNFTCreate) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,149 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createNFTCreateInput(quantity int64) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  testOwner,
+			CallValue:   big.NewInt(0),
+			GasProvided: 1000,
+			Arguments: [][]byte{
+				testToken,
+				big.NewInt(quantity).Bytes(),
+				[]byte("name"),
+				big.NewInt(500).Bytes(),
+				[]byte("hash"),
+				[]byte("attributes"),
+				[]byte("uri1"),
+				[]byte("uri2"),
+			},
+		},
+		RecipientAddr: testOwner,
+	}
+}
+
+func createAccountWithRoles(t *testing.T, marshalizer marshal.Marshalizer, roles ...string) state.UserAccountHandler {
+	acnt, _ := state.NewUserAccount(testOwner)
+	esdtRoles := &esdt.ESDTRoles{}
+	for _, role := range roles {
+		esdtRoles.Roles = append(esdtRoles.Roles, []byte(role))
+	}
+	require.Nil(t, saveESDTRoles(acnt, esdtRoles, computeESDTRoleKey(testToken), marshalizer))
+
+	return acnt
+}
+
+func TestNewESDTNFTCreateFunc_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nftCreateFunc, err := NewESDTNFTCreateFunc(10, process.BaseOperationCost{}, nil, &mock.PauseHandlerStub{})
+	assert.Nil(t, nftCreateFunc)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	nftCreateFunc, err = NewESDTNFTCreateFunc(10, process.BaseOperationCost{}, &mock.MarshalizerMock{}, nil)
+	assert.Nil(t, nftCreateFunc)
+	assert.Equal(t, process.ErrNilPauseHandler, err)
+}
+
+func TestESDTNFTCreate_ProcessBuiltInFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	nftCreateFunc, _ := NewESDTNFTCreateFunc(10, process.BaseOperationCost{StorePerByte: 1}, marshalizer, &mock.PauseHandlerStub{})
+	_, err := nftCreateFunc.ProcessBuiltinFunction(nil, nil, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	input := createNFTCreateInput(1)
+	input.RecipientAddr = testDestination
+	_, err = nftCreateFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrOperationNotPermitted, err)
+
+	_, err = nftCreateFunc.ProcessBuiltinFunction(nil, nil, createNFTCreateInput(1))
+	assert.Equal(t, process.ErrNilUserAccount, err)
+
+	acnt := createAccountWithRoles(t, marshalizer, core.ESDTRoleNFTCreate)
+	input = createNFTCreateInput(1)
+	input.Arguments = input.Arguments[:6]
+	_, err = nftCreateFunc.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	_, err = nftCreateFunc.ProcessBuiltinFunction(acnt, acnt, createNFTCreateInput(0))
+	assert.Equal(t, process.ErrInvalidNFTQuantity, err)
+
+	_, err = nftCreateFunc.ProcessBuiltinFunction(acnt, acnt, createNFTCreateInput(10))
+	assert.Equal(t, process.ErrActionNotAllowed, err)
+
+	input = createNFTCreateInput(1)
+	input.Arguments[3] = big.NewInt(int64(core.MaxRoyalty) + 1).Bytes()
+	_, err = nftCreateFunc.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrInvalidNFTRoyalties, err)
+
+	input = createNFTCreateInput(1)
+	input.GasProvided = 20
+	_, err = nftCreateFunc.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrNotEnoughGas, err)
+
+	acnt, _ = state.NewUserAccount(testOwner)
+	_, err = nftCreateFunc.ProcessBuiltinFunction(acnt, acnt, createNFTCreateInput(1))
+	assert.Equal(t, process.ErrActionNotAllowed, err)
+}
+
+func TestESDTNFTCreate_ProcessBuiltInFunctionShouldCreateIncrementalNonces(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	nftCreateFunc, _ := NewESDTNFTCreateFunc(10, process.BaseOperationCost{StorePerByte: 1}, marshalizer, &mock.PauseHandlerStub{})
+	acnt := createAccountWithRoles(t, marshalizer, core.ESDTRoleNFTCreate, core.ESDTRoleNFTAddQuantity)
+
+	vmOutput, err := nftCreateFunc.ProcessBuiltinFunction(acnt, acnt, createNFTCreateInput(1))
+	require.Nil(t, err)
+	assert.Equal(t, [][]byte{big.NewInt(1).Bytes()}, vmOutput.ReturnData)
+	assert.True(t, vmOutput.GasRemaining < 1000-10)
+
+	vmOutput, err = nftCreateFunc.ProcessBuiltinFunction(acnt, acnt, createNFTCreateInput(20))
+	require.Nil(t, err)
+	assert.Equal(t, [][]byte{big.NewInt(2).Bytes()}, vmOutput.ReturnData)
+
+	esdtData, err := getESDTNFTToken(acnt, nftCreateFunc.keyPrefix, testToken, 2, marshalizer)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(20), esdtData.Value)
+	assert.Equal(t, uint32(core.SemiFungible), esdtData.Type)
+	assert.Equal(t, uint64(2), esdtData.TokenMetaData.Nonce)
+	assert.Equal(t, testOwner, esdtData.TokenMetaData.Creator)
+	assert.Equal(t, uint32(500), esdtData.TokenMetaData.Royalties)
+	assert.Equal(t, [][]byte{[]byte("uri1"), []byte("uri2")}, esdtData.TokenMetaData.URIs)
+
+	esdtData, err = getESDTNFTToken(acnt, nftCreateFunc.keyPrefix, testToken, 1, marshalizer)
+	require.Nil(t, err)
+	assert.Equal(t, uint32(core.NonFungible), esdtData.Type)
+}
+
+func TestESDTNFTCreate_ProcessBuiltInFunctionPausedTokenShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	pauseHandler := &mock.PauseHandlerStub{
+		IsPausedCalled: func(token []byte) bool {
+			return true
+		},
+	}
+	nftCreateFunc, _ := NewESDTNFTCreateFunc(10, process.BaseOperationCost{StorePerByte: 1}, marshalizer, pauseHandler)
+	acnt := createAccountWithRoles(t, marshalizer, core.ESDTRoleNFTCreate)
+
+	_, err := nftCreateFunc.ProcessBuiltinFunction(acnt, acnt, createNFTCreateInput(1))
+	assert.Equal(t, process.ErrESDTTokenIsPaused, err)
+}
@@ -0,0 +1,309 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+var _ process.BuiltinFunction = (*esdtNFTTransfer)(nil)
+
+// ArgsNewESDTNFTTransferFunc defines the arguments needed to create the esdt NFT transfer built-in function
+type ArgsNewESDTNFTTransferFunc struct {
+	FuncGasCost      uint64
+	Marshalizer      marshal.Marshalizer
+	PauseHandler     process.ESDTPauseHandler
+	Accounts         state.AccountsAdapter
+	ShardCoordinator sharding.Coordinator
+}
+
+type esdtNFTTransfer struct {
+	keyPrefix        []byte
+	marshalizer      marshal.Marshalizer
+	pauseHandler     process.ESDTPauseHandler
+	accounts         state.AccountsAdapter
+	shardCoordinator sharding.Coordinator
+	funcGasCost      uint64
+	mutExecution     sync.RWMutex
+}
+
+// NewESDTNFTTransferFunc returns the esdt NFT transfer built-in function component
+func NewESDTNFTTransferFunc(args ArgsNewESDTNFTTransferFunc) (*esdtNFTTransfer, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.PauseHandler) {
+		return nil, process.ErrNilPauseHandler
+	}
+	if check.IfNil(args.Accounts) {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+
+	e := &esdtNFTTransfer{
+		keyPrefix:        []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier),
+		marshalizer:      args.Marshalizer,
+		pauseHandler:     args.PauseHandler,
+		accounts:         args.Accounts,
+		shardCoordinator: args.ShardCoordinator,
+		funcGasCost:      args.FuncGasCost,
+	}
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *esdtNFTTransfer) SetNewGasConfig(gasCost *process.GasCost) {
+	e.mutExecution.Lock()
+	e.funcGasCost = gasCost.BuiltInCost.ESDTNFTTransfer
+	e.mutExecution.Unlock()
+}
+
+// ProcessBuiltinFunction resolves ESDT NFT transfer function call. The holder sends the transaction to its own address
+// with the token identifier, the nonce, the quantity and the destination address as arguments. A destination from the
+// same shard is credited directly, otherwise a smart contract result carrying the token data is sent to it and the
+// destination shard credits the tokens.
+func (e *esdtNFTTransfer) ProcessBuiltinFunction(
+	acntSnd, acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	if vmInput == nil {
+		return nil, process.ErrNilVmInput
+	}
+	if vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, process.ErrBuiltInFunctionCalledWithValue
+	}
+	if len(vmInput.Arguments) != 4 {
+		return nil, process.ErrInvalidArguments
+	}
+
+	if check.IfNil(acntSnd) {
+		// cross-shard NFT transfer, the sender shard already decreased the quantity
+		return e.processNFTTransferOnDestination(acntDst, vmInput)
+	}
+
+	if !bytes.Equal(vmInput.CallerAddr, vmInput.RecipientAddr) {
+		return nil, process.ErrOperationNotPermitted
+	}
+	destination := vmInput.Arguments[3]
+	if len(destination) != len(vmInput.CallerAddr) {
+		return nil, process.ErrInvalidAddressLength
+	}
+	if bytes.Equal(destination, vmInput.CallerAddr) {
+		return nil, process.ErrInvalidArguments
+	}
+	if vmInput.GasProvided < e.funcGasCost {
+		return nil, process.ErrNotEnoughGas
+	}
+
+	tokenID := vmInput.Arguments[0]
+	err := e.checkTransferAllowed(acntSnd, tokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := big.NewInt(0).SetBytes(vmInput.Arguments[1]).Uint64()
+	esdtData, err := getESDTNFTToken(acntSnd, e.keyPrefix, tokenID, nonce, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	quantity := big.NewInt(0).SetBytes(vmInput.Arguments[2])
+	if quantity.Cmp(zero) <= 0 {
+		return nil, process.ErrInvalidNFTQuantity
+	}
+	if esdtData.Value.Cmp(quantity) < 0 {
+		return nil, process.ErrInsufficientFunds
+	}
+
+	esdtData.Value.Sub(esdtData.Value, quantity)
+	err = saveESDTNFTToken(acntSnd, e.keyPrefix, tokenID, esdtData, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+	esdtData.Value.Set(quantity)
+	log.Trace("esdtNFTTransfer", "sender", vmInput.CallerAddr, "destination", destination, "token", tokenID,
+		"nonce", nonce, "quantity", quantity)
+
+	vmOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRemaining: vmInput.GasProvided - e.funcGasCost}
+	if e.shardCoordinator.ComputeId(destination) != e.shardCoordinator.SelfId() {
+		err = e.addNFTTransferToVMOutput(tokenID, esdtData, destination, vmOutput)
+		if err != nil {
+			return nil, err
+		}
+
+		return vmOutput, nil
+	}
+
+	err = e.creditSameShardDestination(destination, tokenID, esdtData)
+	if err != nil {
+		return nil, err
+	}
+
+	return vmOutput, nil
+}
+
+// processNFTTransferOnDestination credits the tokens carried by the smart contract result which was created on the
+// sender shard by addNFTTransferToVMOutput. The carried token data has to match the nonce and the quantity arguments.
+func (e *esdtNFTTransfer) processNFTTransferOnDestination(
+	acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if check.IfNil(acntDst) {
+		return nil, process.ErrNilUserAccount
+	}
+	if !e.isTransferFromOtherShardBuiltInFunction(vmInput) {
+		return nil, process.ErrOperationNotPermitted
+	}
+
+	transferredData := &esdt.ESDigitalToken{}
+	err := e.marshalizer.Unmarshal(transferredData, vmInput.Arguments[3])
+	if err != nil {
+		return nil, err
+	}
+	if transferredData.TokenMetaData == nil || transferredData.Value == nil {
+		return nil, process.ErrNFTTokenDoesNotExist
+	}
+
+	nonce := big.NewInt(0).SetBytes(vmInput.Arguments[1]).Uint64()
+	if transferredData.TokenMetaData.Nonce != nonce {
+		return nil, process.ErrNFTTransferDataMismatch
+	}
+	quantity := big.NewInt(0).SetBytes(vmInput.Arguments[2])
+	if quantity.Cmp(zero) <= 0 {
+		return nil, process.ErrInvalidNFTQuantity
+	}
+	if transferredData.Value.Cmp(quantity) != 0 {
+		return nil, process.ErrNFTTransferDataMismatch
+	}
+
+	err = e.addNFTToDestination(acntDst, vmInput.Arguments[0], transferredData)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRemaining: vmInput.GasProvided}
+	return vmOutput, nil
+}
+
+// isTransferFromOtherShardBuiltInFunction returns true if the call comes from a smart contract result which was created
+// in another shard by addNFTTransferToVMOutput. The smart contract processor sets the hash of a user transaction as its
+// original transaction hash, while a smart contract result keeps the hash of the transaction it originates from. The
+// call type of the smart contract results created by a smart contract is set by the VM, so a smart contract can not
+// send token data to be credited by the destination shard.
+func (e *esdtNFTTransfer) isTransferFromOtherShardBuiltInFunction(vmInput *vmcommon.ContractCallInput) bool {
+	isSCR := len(vmInput.OriginalTxHash) > 0 && !bytes.Equal(vmInput.OriginalTxHash, vmInput.CurrentTxHash)
+	isFromBuiltInFunction := vmInput.CallType == vmcommon.ESDTTransferFromBuiltInFunction
+	isFromOtherShard := e.shardCoordinator.ComputeId(vmInput.CallerAddr) != e.shardCoordinator.SelfId()
+
+	return isSCR && isFromBuiltInFunction && isFromOtherShard
+}
+
+func (e *esdtNFTTransfer) creditSameShardDestination(destination []byte, tokenID []byte, esdtData *esdt.ESDigitalToken) error {
+	account, err := e.accounts.LoadAccount(destination)
+	if err != nil {
+		return err
+	}
+	destinationAcnt, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+
+	err = e.addNFTToDestination(destinationAcnt, tokenID, esdtData)
+	if err != nil {
+		return err
+	}
+
+	return e.accounts.SaveAccount(destinationAcnt)
+}
+
+func (e *esdtNFTTransfer) addNFTToDestination(
+	acntDst state.UserAccountHandler,
+	tokenID []byte,
+	transferredData *esdt.ESDigitalToken,
+) error {
+	err := e.checkTransferAllowed(acntDst, tokenID)
+	if err != nil {
+		return err
+	}
+
+	esdtTokenKey := computeESDTNFTTokenKey(e.keyPrefix, tokenID, transferredData.TokenMetaData.Nonce)
+	currentData, err := getESDTDataFromKey(acntDst, esdtTokenKey, e.marshalizer)
+	if err != nil {
+		return err
+	}
+	if currentData.TokenMetaData != nil {
+		transferredData.Value.Add(transferredData.Value, currentData.Value)
+	}
+
+	return saveESDTNFTToken(acntDst, e.keyPrefix, tokenID, transferredData, e.marshalizer)
+}
+
+func (e *esdtNFTTransfer) checkTransferAllowed(acnt state.UserAccountHandler, tokenID []byte) error {
+	esdtTokenKey := computeESDTTokenKey(e.keyPrefix, tokenID)
+	if e.pauseHandler.IsPaused(esdtTokenKey) {
+		return process.ErrESDTTokenIsPaused
+	}
+
+	esdtData, err := getESDTDataFromKey(acnt, esdtTokenKey, e.marshalizer)
+	if err != nil {
+		return err
+	}
+	esdtUserMetaData := ESDTUserMetadataFromBytes(esdtData.Properties)
+	if esdtUserMetaData.Frozen {
+		return process.ErrESDTIsFrozenForAccount
+	}
+
+	return nil
+}
+
+func (e *esdtNFTTransfer) addNFTTransferToVMOutput(
+	tokenID []byte,
+	esdtData *esdt.ESDigitalToken,
+	destination []byte,
+	vmOutput *vmcommon.VMOutput,
+) error {
+	marshaledData, err := e.marshalizer.Marshal(esdtData)
+	if err != nil {
+		return err
+	}
+
+	nonceBytes := big.NewInt(0).SetUint64(esdtData.TokenMetaData.Nonce).Bytes()
+	nftTransferTxData := core.BuiltInFunctionESDTNFTTransfer + "@" + hex.EncodeToString(tokenID) +
+		"@" + hex.EncodeToString(nonceBytes) + "@" + hex.EncodeToString(esdtData.Value.Bytes()) +
+		"@" + hex.EncodeToString(marshaledData)
+	outTransfer := vmcommon.OutputTransfer{
+		Value:    big.NewInt(0),
+		GasLimit: vmOutput.GasRemaining,
+		Data:     []byte(nftTransferTxData),
+		CallType: vmcommon.ESDTTransferFromBuiltInFunction,
+	}
+	vmOutput.OutputAccounts = make(map[string]*vmcommon.OutputAccount)
+	vmOutput.OutputAccounts[string(destination)] = &vmcommon.OutputAccount{
+		Address:         destination,
+		OutputTransfers: []vmcommon.OutputTransfer{outTransfer},
+	}
+	vmOutput.GasRemaining = 0
+
+	return nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtNFTTransfer) IsInterfaceNil() bool {
+	return e == nil
+}
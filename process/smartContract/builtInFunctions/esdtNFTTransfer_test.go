@@ -0,0 +1,272 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsESDTNFTTransfer() ArgsNewESDTNFTTransferFunc {
+	return ArgsNewESDTNFTTransferFunc{
+		FuncGasCost:      10,
+		Marshalizer:      &mock.MarshalizerMock{},
+		PauseHandler:     &mock.PauseHandlerStub{},
+		Accounts:         &mock.AccountsStub{},
+		ShardCoordinator: mock.NewMultiShardsCoordinatorMock(2),
+	}
+}
+
+func createNFTTransferInput(nonce uint64, quantity int64, destination []byte) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  testOwner,
+			CallValue:   big.NewInt(0),
+			GasProvided: 50,
+			Arguments: [][]byte{
+				testToken,
+				big.NewInt(0).SetUint64(nonce).Bytes(),
+				big.NewInt(quantity).Bytes(),
+				destination,
+			},
+		},
+		RecipientAddr: testOwner,
+	}
+}
+
+func TestNewESDTNFTTransferFunc_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsESDTNFTTransfer()
+	args.Marshalizer = nil
+	nftTransferFunc, err := NewESDTNFTTransferFunc(args)
+	assert.Nil(t, nftTransferFunc)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	args = createMockArgsESDTNFTTransfer()
+	args.PauseHandler = nil
+	nftTransferFunc, err = NewESDTNFTTransferFunc(args)
+	assert.Nil(t, nftTransferFunc)
+	assert.Equal(t, process.ErrNilPauseHandler, err)
+
+	args = createMockArgsESDTNFTTransfer()
+	args.Accounts = nil
+	nftTransferFunc, err = NewESDTNFTTransferFunc(args)
+	assert.Nil(t, nftTransferFunc)
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+
+	args = createMockArgsESDTNFTTransfer()
+	args.ShardCoordinator = nil
+	nftTransferFunc, err = NewESDTNFTTransferFunc(args)
+	assert.Nil(t, nftTransferFunc)
+	assert.Equal(t, process.ErrNilShardCoordinator, err)
+}
+
+func TestESDTNFTTransfer_ProcessBuiltInFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsESDTNFTTransfer()
+	nftTransferFunc, _ := NewESDTNFTTransferFunc(args)
+	_, err := nftTransferFunc.ProcessBuiltinFunction(nil, nil, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	acntSnd, _ := state.NewUserAccount(testOwner)
+	input := createNFTTransferInput(1, 1, testDestination)
+	input.Arguments = input.Arguments[:3]
+	_, err = nftTransferFunc.ProcessBuiltinFunction(acntSnd, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input = createNFTTransferInput(1, 1, testDestination)
+	input.RecipientAddr = testDestination
+	_, err = nftTransferFunc.ProcessBuiltinFunction(acntSnd, nil, input)
+	assert.Equal(t, process.ErrOperationNotPermitted, err)
+
+	_, err = nftTransferFunc.ProcessBuiltinFunction(acntSnd, nil, createNFTTransferInput(1, 1, []byte("short")))
+	assert.Equal(t, process.ErrInvalidAddressLength, err)
+
+	_, err = nftTransferFunc.ProcessBuiltinFunction(acntSnd, nil, createNFTTransferInput(1, 1, testDestination))
+	assert.Equal(t, process.ErrNFTTokenDoesNotExist, err)
+
+	saveTestNFT(t, acntSnd, args.Marshalizer, 1, 5)
+	_, err = nftTransferFunc.ProcessBuiltinFunction(acntSnd, nil, createNFTTransferInput(1, 6, testDestination))
+	assert.Equal(t, process.ErrInsufficientFunds, err)
+
+	esdtTokenKey := computeESDTTokenKey(nftTransferFunc.keyPrefix, testToken)
+	frozen := ESDTUserMetadata{Frozen: true}
+	require.Nil(t, saveESDTData(acntSnd, &esdt.ESDigitalToken{Value: big.NewInt(0), Properties: frozen.ToBytes()}, esdtTokenKey, args.Marshalizer))
+	_, err = nftTransferFunc.ProcessBuiltinFunction(acntSnd, nil, createNFTTransferInput(1, 1, testDestination))
+	assert.Equal(t, process.ErrESDTIsFrozenForAccount, err)
+}
+
+func TestESDTNFTTransfer_ProcessBuiltInFunctionToSameShardShouldWork(t *testing.T) {
+	t.Parallel()
+
+	destinationAcnt, _ := state.NewUserAccount(testDestination)
+	saveCalled := false
+	args := createMockArgsESDTNFTTransfer()
+	args.Accounts = &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			assert.Equal(t, testDestination, address)
+			return destinationAcnt, nil
+		},
+		SaveAccountCalled: func(account state.AccountHandler) error {
+			saveCalled = true
+			return nil
+		},
+	}
+	nftTransferFunc, _ := NewESDTNFTTransferFunc(args)
+	acntSnd, _ := state.NewUserAccount(testOwner)
+	saveTestNFT(t, acntSnd, args.Marshalizer, 1, 5)
+	saveTestNFT(t, destinationAcnt, args.Marshalizer, 1, 1)
+
+	vmOutput, err := nftTransferFunc.ProcessBuiltinFunction(acntSnd, acntSnd, createNFTTransferInput(1, 2, testDestination))
+	require.Nil(t, err)
+	assert.Equal(t, uint64(40), vmOutput.GasRemaining)
+	assert.Equal(t, 0, len(vmOutput.OutputAccounts))
+	assert.True(t, saveCalled)
+
+	esdtData, _ := getESDTNFTToken(acntSnd, nftTransferFunc.keyPrefix, testToken, 1, args.Marshalizer)
+	assert.Equal(t, big.NewInt(3), esdtData.Value)
+	esdtData, _ = getESDTNFTToken(destinationAcnt, nftTransferFunc.keyPrefix, testToken, 1, args.Marshalizer)
+	assert.Equal(t, big.NewInt(3), esdtData.Value)
+	assert.Equal(t, []byte("name"), esdtData.TokenMetaData.Name)
+}
+
+func TestESDTNFTTransfer_ProcessBuiltInFunctionCrossShardShouldWork(t *testing.T) {
+	t.Parallel()
+
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		if bytes.Equal(address, testDestination) {
+			return 1
+		}
+		return 0
+	}
+	args := createMockArgsESDTNFTTransfer()
+	args.ShardCoordinator = shardCoordinator
+	nftTransferFunc, _ := NewESDTNFTTransferFunc(args)
+	acntSnd, _ := state.NewUserAccount(testOwner)
+	saveTestNFT(t, acntSnd, args.Marshalizer, 1, 1)
+
+	vmOutput, err := nftTransferFunc.ProcessBuiltinFunction(acntSnd, acntSnd, createNFTTransferInput(1, 1, testDestination))
+	require.Nil(t, err)
+	assert.Equal(t, uint64(0), vmOutput.GasRemaining)
+	_, err = getESDTNFTToken(acntSnd, nftTransferFunc.keyPrefix, testToken, 1, args.Marshalizer)
+	assert.Equal(t, process.ErrNFTTokenDoesNotExist, err)
+
+	outAcc, ok := vmOutput.OutputAccounts[string(testDestination)]
+	require.True(t, ok)
+	require.Equal(t, 1, len(outAcc.OutputTransfers))
+	assert.Equal(t, uint64(40), outAcc.OutputTransfers[0].GasLimit)
+	assert.Equal(t, vmcommon.ESDTTransferFromBuiltInFunction, outAcc.OutputTransfers[0].CallType)
+	assert.True(t, bytes.HasPrefix(outAcc.OutputTransfers[0].Data, []byte(core.BuiltInFunctionESDTNFTTransfer+"@"+hex.EncodeToString(testToken))))
+
+	// the destination shard receives the token data as the last argument
+	tokens := bytes.Split(outAcc.OutputTransfers[0].Data, []byte("@"))
+	require.Equal(t, 5, len(tokens))
+	marshaledData, _ := hex.DecodeString(string(tokens[4]))
+	destinationInput := createNFTTransferOnDestinationInput(1, 1, marshaledData)
+	acntDst, _ := state.NewUserAccount(testDestination)
+
+	destinationNFTTransferFunc := createDestinationShardNFTTransferFunc()
+	vmOutput, err = destinationNFTTransferFunc.ProcessBuiltinFunction(nil, acntDst, destinationInput)
+	require.Nil(t, err)
+	assert.Equal(t, destinationInput.GasProvided, vmOutput.GasRemaining)
+
+	esdtData, err := getESDTNFTToken(acntDst, nftTransferFunc.keyPrefix, testToken, 1, args.Marshalizer)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(1), esdtData.Value)
+	assert.Equal(t, testOwner, esdtData.TokenMetaData.Creator)
+}
+
+func TestESDTNFTTransfer_ProcessBuiltInFunctionOnDestinationMismatchedDataShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nftTransferFunc := createDestinationShardNFTTransferFunc()
+	marshaledData, _ := nftTransferFunc.marshalizer.Marshal(&esdt.ESDigitalToken{
+		Value:         big.NewInt(1),
+		TokenMetaData: &esdt.MetaData{Nonce: 1, Creator: testOwner},
+	})
+
+	// the carried data credits a single token, while the call claims a larger quantity
+	acntDst, _ := state.NewUserAccount(testDestination)
+	_, err := nftTransferFunc.ProcessBuiltinFunction(nil, acntDst, createNFTTransferOnDestinationInput(1, 1000, marshaledData))
+	assert.Equal(t, process.ErrNFTTransferDataMismatch, err)
+
+	_, err = nftTransferFunc.ProcessBuiltinFunction(nil, acntDst, createNFTTransferOnDestinationInput(2, 1, marshaledData))
+	assert.Equal(t, process.ErrNFTTransferDataMismatch, err)
+
+	_, err = nftTransferFunc.ProcessBuiltinFunction(nil, acntDst, createNFTTransferOnDestinationInput(1, 0, marshaledData))
+	assert.Equal(t, process.ErrInvalidNFTQuantity, err)
+
+	_, err = getESDTNFTToken(acntDst, nftTransferFunc.keyPrefix, testToken, 1, nftTransferFunc.marshalizer)
+	assert.Equal(t, process.ErrNFTTokenDoesNotExist, err)
+}
+
+func TestESDTNFTTransfer_ProcessBuiltInFunctionOnDestinationNotFromCrossShardSCRShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nftTransferFunc := createDestinationShardNFTTransferFunc()
+	marshaledData, _ := nftTransferFunc.marshalizer.Marshal(&esdt.ESDigitalToken{
+		Value:         big.NewInt(1),
+		TokenMetaData: &esdt.MetaData{Nonce: 1, Creator: testOwner},
+	})
+	acntDst, _ := state.NewUserAccount(testDestination)
+
+	// a user transaction has its own hash as the original transaction hash
+	userTxInput := createNFTTransferOnDestinationInput(1, 1, marshaledData)
+	userTxInput.OriginalTxHash = userTxInput.CurrentTxHash
+	_, err := nftTransferFunc.ProcessBuiltinFunction(nil, acntDst, userTxInput)
+	assert.Equal(t, process.ErrOperationNotPermitted, err)
+
+	sameShardInput := createNFTTransferOnDestinationInput(1, 1, marshaledData)
+	sameShardInput.CallerAddr = testDestination
+	_, err = nftTransferFunc.ProcessBuiltinFunction(nil, acntDst, sameShardInput)
+	assert.Equal(t, process.ErrOperationNotPermitted, err)
+
+	// a smart contract from another shard can send the same data, but the VM sets the call type of its results
+	for _, callType := range []vmcommon.CallType{vmcommon.DirectCall, vmcommon.AsynchronousCall, vmcommon.AsynchronousCallBack} {
+		scGeneratedInput := createNFTTransferOnDestinationInput(1, 1, marshaledData)
+		scGeneratedInput.CallType = callType
+		_, err = nftTransferFunc.ProcessBuiltinFunction(nil, acntDst, scGeneratedInput)
+		assert.Equal(t, process.ErrOperationNotPermitted, err)
+	}
+
+	_, err = getESDTNFTToken(acntDst, nftTransferFunc.keyPrefix, testToken, 1, nftTransferFunc.marshalizer)
+	assert.Equal(t, process.ErrNFTTokenDoesNotExist, err)
+}
+
+func createDestinationShardNFTTransferFunc() *esdtNFTTransfer {
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.CurrentShard = 1
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		if bytes.Equal(address, testDestination) {
+			return 1
+		}
+		return 0
+	}
+	args := createMockArgsESDTNFTTransfer()
+	args.ShardCoordinator = shardCoordinator
+	nftTransferFunc, _ := NewESDTNFTTransferFunc(args)
+
+	return nftTransferFunc
+}
+
+func createNFTTransferOnDestinationInput(nonce uint64, quantity int64, marshaledData []byte) *vmcommon.ContractCallInput {
+	input := createNFTTransferInput(nonce, quantity, marshaledData)
+	input.RecipientAddr = testDestination
+	input.OriginalTxHash = []byte("original tx hash")
+	input.CurrentTxHash = []byte("scr hash")
+	input.CallType = vmcommon.ESDTTransferFromBuiltInFunction
+
+	return input
+}
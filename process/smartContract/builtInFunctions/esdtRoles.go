@@ -0,0 +1,185 @@
+package builtInFunctions
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm"
+)
+
+var _ process.BuiltinFunction = (*esdtRoles)(nil)
+
+type esdtRoles struct {
+	set         bool
+	marshalizer marshal.Marshalizer
+}
+
+// NewESDTRolesFunc returns the esdt set/unset special roles built-in function component
+func NewESDTRolesFunc(
+	marshalizer marshal.Marshalizer,
+	set bool,
+) (*esdtRoles, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+
+	e := &esdtRoles{
+		set:         set,
+		marshalizer: marshalizer,
+	}
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *esdtRoles) SetNewGasConfig(_ *process.GasCost) {
+}
+
+// ProcessBuiltinFunction resolves ESDT set and unset special roles function calls, which can be sent only by the
+// ESDT system smart contract
+func (e *esdtRoles) ProcessBuiltinFunction(
+	_, acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if vmInput == nil {
+		return nil, process.ErrNilVmInput
+	}
+	if vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, process.ErrBuiltInFunctionCalledWithValue
+	}
+	if len(vmInput.Arguments) < 2 {
+		return nil, process.ErrInvalidArguments
+	}
+	if !bytes.Equal(vmInput.CallerAddr, vm.ESDTSCAddress) {
+		return nil, process.ErrAddressIsNotESDTSystemSC
+	}
+	if check.IfNil(acntDst) {
+		return nil, process.ErrNilUserAccount
+	}
+
+	esdtTokenRoleKey := computeESDTRoleKey(vmInput.Arguments[0])
+	log.Trace(vmInput.Function, "receiver", vmInput.RecipientAddr, "token", vmInput.Arguments[0])
+
+	roles, err := getESDTRolesForAcnt(acntDst, esdtTokenRoleKey, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.set {
+		addRoles(roles, vmInput.Arguments[1:])
+	} else {
+		deleteRoles(roles, vmInput.Arguments[1:])
+	}
+
+	err = saveESDTRoles(acntDst, roles, esdtTokenRoleKey, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}
+	return vmOutput, nil
+}
+
+func computeESDTRoleKey(tokenID []byte) []byte {
+	return []byte(core.ElrondProtectedKeyPrefix + core.ESDTRoleIdentifier + core.ESDTKeyIdentifier + string(tokenID))
+}
+
+func addRoles(roles *esdt.ESDTRoles, rolesToAdd [][]byte) {
+	for _, role := range rolesToAdd {
+		_, exists := doesRoleExist(roles, role)
+		if !exists {
+			roles.Roles = append(roles.Roles, role)
+		}
+	}
+}
+
+func deleteRoles(roles *esdt.ESDTRoles, rolesToDelete [][]byte) {
+	remainingRoles := make([][]byte, 0, len(roles.Roles))
+	for _, role := range roles.Roles {
+		_, exists := doesRoleExist(&esdt.ESDTRoles{Roles: rolesToDelete}, role)
+		if !exists {
+			remainingRoles = append(remainingRoles, role)
+		}
+	}
+
+	roles.Roles = remainingRoles
+}
+
+func doesRoleExist(roles *esdt.ESDTRoles, role []byte) (int, bool) {
+	for i, currentRole := range roles.Roles {
+		if bytes.Equal(currentRole, role) {
+			return i, true
+		}
+	}
+
+	return -1, false
+}
+
+func getESDTRolesForAcnt(
+	acnt state.UserAccountHandler,
+	key []byte,
+	marshalizer marshal.Marshalizer,
+) (*esdt.ESDTRoles, error) {
+	roles := &esdt.ESDTRoles{Roles: make([][]byte, 0)}
+	marshaledData, err := acnt.DataTrieTracker().RetrieveValue(key)
+	if err != nil || len(marshaledData) == 0 {
+		return roles, nil
+	}
+
+	err = marshalizer.Unmarshal(roles, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
+
+func saveESDTRoles(
+	acnt state.UserAccountHandler,
+	roles *esdt.ESDTRoles,
+	key []byte,
+	marshalizer marshal.Marshalizer,
+) error {
+	if len(roles.Roles) == 0 {
+		return acnt.DataTrieTracker().SaveKeyValue(key, nil)
+	}
+
+	marshaledData, err := marshalizer.Marshal(roles)
+	if err != nil {
+		return err
+	}
+
+	return acnt.DataTrieTracker().SaveKeyValue(key, marshaledData)
+}
+
+// checkESDTRole returns an error if the account does not have the given special role for the token
+func checkESDTRole(
+	acnt state.UserAccountHandler,
+	tokenID []byte,
+	role []byte,
+	marshalizer marshal.Marshalizer,
+) error {
+	esdtTokenRoleKey := computeESDTRoleKey(tokenID)
+	roles, err := getESDTRolesForAcnt(acnt, esdtTokenRoleKey, marshalizer)
+	if err != nil {
+		return err
+	}
+
+	_, exists := doesRoleExist(roles, role)
+	if !exists {
+		return process.ErrActionNotAllowed
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtRoles) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,92 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createRolesInput(roles ...string) *vmcommon.ContractCallInput {
+	arguments := [][]byte{testToken}
+	for _, role := range roles {
+		arguments = append(arguments, []byte(role))
+	}
+
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: vm.ESDTSCAddress,
+			CallValue:  big.NewInt(0),
+			Arguments:  arguments,
+		},
+		RecipientAddr: testOwner,
+	}
+}
+
+func TestNewESDTRolesFunc_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rolesFunc, err := NewESDTRolesFunc(nil, true)
+	assert.Nil(t, rolesFunc)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestESDTRoles_ProcessBuiltInFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	rolesFunc, _ := NewESDTRolesFunc(&mock.MarshalizerMock{}, true)
+	_, err := rolesFunc.ProcessBuiltinFunction(nil, nil, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	input := createRolesInput(core.ESDTRoleNFTCreate)
+	input.CallValue = big.NewInt(1)
+	_, err = rolesFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrBuiltInFunctionCalledWithValue, err)
+
+	_, err = rolesFunc.ProcessBuiltinFunction(nil, nil, createRolesInput())
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input = createRolesInput(core.ESDTRoleNFTCreate)
+	input.CallerAddr = testOwner
+	_, err = rolesFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrAddressIsNotESDTSystemSC, err)
+
+	_, err = rolesFunc.ProcessBuiltinFunction(nil, nil, createRolesInput(core.ESDTRoleNFTCreate))
+	assert.Equal(t, process.ErrNilUserAccount, err)
+}
+
+func TestESDTRoles_ProcessBuiltInFunctionSetAndUnSetShouldWork(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	setRolesFunc, _ := NewESDTRolesFunc(marshalizer, true)
+	unSetRolesFunc, _ := NewESDTRolesFunc(marshalizer, false)
+	acnt, _ := state.NewUserAccount(testOwner)
+
+	_, err := setRolesFunc.ProcessBuiltinFunction(nil, acnt, createRolesInput(core.ESDTRoleNFTCreate, core.ESDTRoleNFTBurn))
+	require.Nil(t, err)
+	_, err = setRolesFunc.ProcessBuiltinFunction(nil, acnt, createRolesInput(core.ESDTRoleNFTCreate))
+	require.Nil(t, err)
+
+	roles, _ := getESDTRolesForAcnt(acnt, computeESDTRoleKey(testToken), marshalizer)
+	assert.Equal(t, &esdt.ESDTRoles{Roles: [][]byte{[]byte(core.ESDTRoleNFTCreate), []byte(core.ESDTRoleNFTBurn)}}, roles)
+	assert.Nil(t, checkESDTRole(acnt, testToken, []byte(core.ESDTRoleNFTBurn), marshalizer))
+	assert.Equal(t, process.ErrActionNotAllowed, checkESDTRole(acnt, testToken, []byte(core.ESDTRoleNFTAddQuantity), marshalizer))
+
+	_, err = unSetRolesFunc.ProcessBuiltinFunction(nil, acnt, createRolesInput(core.ESDTRoleNFTCreate))
+	require.Nil(t, err)
+	assert.Equal(t, process.ErrActionNotAllowed, checkESDTRole(acnt, testToken, []byte(core.ESDTRoleNFTCreate), marshalizer))
+
+	_, err = unSetRolesFunc.ProcessBuiltinFunction(nil, acnt, createRolesInput(core.ESDTRoleNFTBurn))
+	require.Nil(t, err)
+	marshaledData, _ := acnt.DataTrieTracker().RetrieveValue(computeESDTRoleKey(testToken))
+	assert.Equal(t, 0, len(marshaledData))
+}
@@ -0,0 +1,233 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+var _ process.BuiltinFunction = (*esdtTransferFrom)(nil)
+
+// ArgsNewESDTTransferFromFunc defines the arguments needed to create the esdt transfer from built-in function
+type ArgsNewESDTTransferFromFunc struct {
+	FuncGasCost      uint64
+	Marshalizer      marshal.Marshalizer
+	PauseHandler     process.ESDTPauseHandler
+	Accounts         state.AccountsAdapter
+	ShardCoordinator sharding.Coordinator
+}
+
+type esdtTransferFrom struct {
+	funcGasCost        uint64
+	marshalizer        marshal.Marshalizer
+	keyPrefix          []byte
+	allowanceKeyPrefix []byte
+	pauseHandler       process.ESDTPauseHandler
+	accounts           state.AccountsAdapter
+	shardCoordinator   sharding.Coordinator
+	mutExecution       sync.RWMutex
+}
+
+// NewESDTTransferFromFunc returns the esdt transfer from built-in function component
+func NewESDTTransferFromFunc(args ArgsNewESDTTransferFromFunc) (*esdtTransferFrom, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.PauseHandler) {
+		return nil, process.ErrNilPauseHandler
+	}
+	if check.IfNil(args.Accounts) {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+
+	e := &esdtTransferFrom{
+		funcGasCost:        args.FuncGasCost,
+		marshalizer:        args.Marshalizer,
+		keyPrefix:          []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier),
+		allowanceKeyPrefix: []byte(core.ElrondProtectedKeyPrefix + core.ESDTAllowanceKeyIdentifier),
+		pauseHandler:       args.PauseHandler,
+		accounts:           args.Accounts,
+		shardCoordinator:   args.ShardCoordinator,
+	}
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *esdtTransferFrom) SetNewGasConfig(gasCost *process.GasCost) {
+	e.mutExecution.Lock()
+	e.funcGasCost = gasCost.BuiltInCost.ESDTTransferFrom
+	e.mutExecution.Unlock()
+}
+
+// ProcessBuiltinFunction resolves ESDT transfer from function calls. The spender sends the transaction to the owner
+// of the tokens with the token name, the destination address and the value as arguments. The allowance and the
+// balance are decreased in the owner's shard, while the destination is credited either directly, if it is in the same
+// shard, or through a smart contract result carrying an ESDT transfer
+func (e *esdtTransferFrom) ProcessBuiltinFunction(
+	acntSnd, acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	if vmInput == nil {
+		return nil, process.ErrNilVmInput
+	}
+	if vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, process.ErrBuiltInFunctionCalledWithValue
+	}
+	if len(vmInput.Arguments) != 3 {
+		return nil, process.ErrInvalidArguments
+	}
+	destination := vmInput.Arguments[1]
+	if len(destination) != len(vmInput.CallerAddr) {
+		return nil, process.ErrInvalidAddressLength
+	}
+	value := big.NewInt(0).SetBytes(vmInput.Arguments[2])
+	if value.Cmp(zero) <= 0 {
+		return nil, process.ErrNegativeValue
+	}
+
+	if !check.IfNil(acntSnd) {
+		// gas is paid only by sender
+		if vmInput.GasProvided < e.funcGasCost {
+			return nil, process.ErrNotEnoughGas
+		}
+	}
+
+	gasRemaining := computeGasRemaining(acntSnd, vmInput.GasProvided, e.funcGasCost)
+	vmOutput := &vmcommon.VMOutput{GasRemaining: gasRemaining, ReturnCode: vmcommon.Ok}
+	if check.IfNil(acntDst) {
+		// cross-shard ESDT transfer from call through a smart contract
+		if core.IsSmartContractAddress(vmInput.CallerAddr) {
+			addOutPutTransferToVMOutput(
+				core.BuiltInFunctionESDTTransferFrom,
+				vmInput.Arguments,
+				vmInput.RecipientAddr,
+				vmInput.GasLocked,
+				vmOutput)
+		}
+
+		return vmOutput, nil
+	}
+
+	tokenName := vmInput.Arguments[0]
+	esdtTokenKey := append(e.keyPrefix, tokenName...)
+	log.Trace("esdtTransferFrom", "spender", vmInput.CallerAddr, "owner", vmInput.RecipientAddr,
+		"destination", destination, "value", value, "token", esdtTokenKey)
+
+	err := e.spendAllowance(acntDst, tokenName, vmInput.CallerAddr, value)
+	if err != nil {
+		return nil, err
+	}
+
+	err = addToESDTBalance(vmInput.CallerAddr, acntDst, esdtTokenKey, big.NewInt(0).Neg(value), e.marshalizer, e.pauseHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.creditDestination(acntSnd, acntDst, vmInput, esdtTokenKey, value, vmOutput)
+	if err != nil {
+		return nil, err
+	}
+
+	return vmOutput, nil
+}
+
+func (e *esdtTransferFrom) spendAllowance(
+	ownerAcnt state.UserAccountHandler,
+	tokenName []byte,
+	spender []byte,
+	value *big.Int,
+) error {
+	allowanceKey := computeESDTAllowanceKey(e.allowanceKeyPrefix, tokenName, spender)
+	allowance, err := getESDTDataFromKey(ownerAcnt, allowanceKey, e.marshalizer)
+	if err != nil {
+		return err
+	}
+	if allowance.Value.Cmp(value) < 0 {
+		return process.ErrInsufficientESDTAllowance
+	}
+
+	return saveESDTAllowance(ownerAcnt, allowanceKey, big.NewInt(0).Sub(allowance.Value, value), e.marshalizer)
+}
+
+func (e *esdtTransferFrom) creditDestination(
+	acntSnd, acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+	esdtTokenKey []byte,
+	value *big.Int,
+	vmOutput *vmcommon.VMOutput,
+) error {
+	destination := vmInput.Arguments[1]
+
+	// the already loaded accounts are saved by the caller, so they must not be loaded and saved a second time
+	if bytes.Equal(destination, vmInput.RecipientAddr) {
+		return addToESDTBalance(vmInput.CallerAddr, acntDst, esdtTokenKey, value, e.marshalizer, e.pauseHandler)
+	}
+	if !check.IfNil(acntSnd) && bytes.Equal(destination, vmInput.CallerAddr) {
+		return addToESDTBalance(vmInput.CallerAddr, acntSnd, esdtTokenKey, value, e.marshalizer, e.pauseHandler)
+	}
+
+	if e.shardCoordinator.ComputeId(destination) != e.shardCoordinator.SelfId() {
+		addESDTTransferToVMOutput(vmInput.Arguments[0], value, destination, vmOutput)
+		return nil
+	}
+
+	account, err := e.accounts.LoadAccount(destination)
+	if err != nil {
+		return err
+	}
+	destinationAcnt, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+
+	err = addToESDTBalance(vmInput.CallerAddr, destinationAcnt, esdtTokenKey, value, e.marshalizer, e.pauseHandler)
+	if err != nil {
+		return err
+	}
+
+	return e.accounts.SaveAccount(destinationAcnt)
+}
+
+// addESDTTransferToVMOutput adds an ESDT transfer from the owner towards a cross-shard destination, the destination
+// shard will only credit the tokens as the sender's balance was already decreased
+func addESDTTransferToVMOutput(
+	tokenName []byte,
+	value *big.Int,
+	destination []byte,
+	vmOutput *vmcommon.VMOutput,
+) {
+	esdtTransferTxData := core.BuiltInFunctionESDTTransfer + "@" + hex.EncodeToString(tokenName) + "@" + hex.EncodeToString(value.Bytes())
+	outTransfer := vmcommon.OutputTransfer{
+		Value:    big.NewInt(0),
+		GasLimit: vmOutput.GasRemaining,
+		Data:     []byte(esdtTransferTxData),
+		CallType: vmcommon.DirectCall,
+	}
+	vmOutput.OutputAccounts = make(map[string]*vmcommon.OutputAccount)
+	vmOutput.OutputAccounts[string(destination)] = &vmcommon.OutputAccount{
+		Address:         destination,
+		OutputTransfers: []vmcommon.OutputTransfer{outTransfer},
+	}
+	vmOutput.GasRemaining = 0
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtTransferFrom) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,235 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testOwner       = bytes.Repeat([]byte{1}, 32)
+	testSpender     = bytes.Repeat([]byte{2}, 32)
+	testDestination = bytes.Repeat([]byte{3}, 32)
+	testToken       = []byte("token")
+)
+
+func createMockArgsESDTTransferFrom() ArgsNewESDTTransferFromFunc {
+	return ArgsNewESDTTransferFromFunc{
+		FuncGasCost:      10,
+		Marshalizer:      &mock.MarshalizerMock{},
+		PauseHandler:     &mock.PauseHandlerStub{},
+		Accounts:         &mock.AccountsStub{},
+		ShardCoordinator: mock.NewMultiShardsCoordinatorMock(2),
+	}
+}
+
+func createTransferFromInput(destination []byte, value int64) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  testSpender,
+			CallValue:   big.NewInt(0),
+			GasProvided: 50,
+			Arguments:   [][]byte{testToken, destination, big.NewInt(value).Bytes()},
+		},
+		RecipientAddr: testOwner,
+	}
+}
+
+func createOwnerAccount(t *testing.T, marshalizer marshal.Marshalizer, balance int64, allowance int64) state.UserAccountHandler {
+	acnt, _ := state.NewUserAccount(testOwner)
+	esdtKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + string(testToken))
+	marshaledData, _ := marshalizer.Marshal(&esdt.ESDigitalToken{Value: big.NewInt(balance)})
+	require.Nil(t, acnt.DataTrieTracker().SaveKeyValue(esdtKey, marshaledData))
+
+	allowanceKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTAllowanceKeyIdentifier + string(testToken) + string(testSpender))
+	marshaledData, _ = marshalizer.Marshal(&esdt.ESDigitalToken{Value: big.NewInt(allowance)})
+	require.Nil(t, acnt.DataTrieTracker().SaveKeyValue(allowanceKey, marshaledData))
+
+	return acnt
+}
+
+func getESDTValue(acnt state.UserAccountHandler, key []byte, marshalizer marshal.Marshalizer) *big.Int {
+	esdtData, _ := getESDTDataFromKey(acnt, key, marshalizer)
+	return esdtData.Value
+}
+
+func TestNewESDTTransferFromFunc_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsESDTTransferFrom()
+	args.Marshalizer = nil
+	transferFromFunc, err := NewESDTTransferFromFunc(args)
+	assert.Nil(t, transferFromFunc)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	args = createMockArgsESDTTransferFrom()
+	args.PauseHandler = nil
+	transferFromFunc, err = NewESDTTransferFromFunc(args)
+	assert.Nil(t, transferFromFunc)
+	assert.Equal(t, process.ErrNilPauseHandler, err)
+
+	args = createMockArgsESDTTransferFrom()
+	args.Accounts = nil
+	transferFromFunc, err = NewESDTTransferFromFunc(args)
+	assert.Nil(t, transferFromFunc)
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+
+	args = createMockArgsESDTTransferFrom()
+	args.ShardCoordinator = nil
+	transferFromFunc, err = NewESDTTransferFromFunc(args)
+	assert.Nil(t, transferFromFunc)
+	assert.Equal(t, process.ErrNilShardCoordinator, err)
+}
+
+func TestESDTTransferFrom_ProcessBuiltInFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	transferFromFunc, _ := NewESDTTransferFromFunc(createMockArgsESDTTransferFrom())
+	_, err := transferFromFunc.ProcessBuiltinFunction(nil, nil, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	input := createTransferFromInput(testDestination, 10)
+	input.CallValue = big.NewInt(1)
+	_, err = transferFromFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrBuiltInFunctionCalledWithValue, err)
+
+	input = createTransferFromInput(testDestination, 10)
+	input.Arguments = input.Arguments[:2]
+	_, err = transferFromFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input = createTransferFromInput([]byte("short"), 10)
+	_, err = transferFromFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrInvalidAddressLength, err)
+
+	input = createTransferFromInput(testDestination, 0)
+	_, err = transferFromFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrNegativeValue, err)
+
+	input = createTransferFromInput(testDestination, 10)
+	input.GasProvided = transferFromFunc.funcGasCost - 1
+	acntSnd, _ := state.NewUserAccount(testSpender)
+	_, err = transferFromFunc.ProcessBuiltinFunction(acntSnd, nil, input)
+	assert.Equal(t, process.ErrNotEnoughGas, err)
+}
+
+func TestESDTTransferFrom_ProcessBuiltInFunctionOnSenderShardShouldOnlyConsumeGas(t *testing.T) {
+	t.Parallel()
+
+	transferFromFunc, _ := NewESDTTransferFromFunc(createMockArgsESDTTransferFrom())
+	acntSnd, _ := state.NewUserAccount(testSpender)
+
+	vmOutput, err := transferFromFunc.ProcessBuiltinFunction(acntSnd, nil, createTransferFromInput(testDestination, 10))
+	require.Nil(t, err)
+	assert.Equal(t, uint64(40), vmOutput.GasRemaining)
+	assert.Equal(t, 0, len(vmOutput.OutputAccounts))
+}
+
+func TestESDTTransferFrom_ProcessBuiltInFunctionInsufficientAllowanceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsESDTTransferFrom()
+	transferFromFunc, _ := NewESDTTransferFromFunc(args)
+	acntDst := createOwnerAccount(t, args.Marshalizer, 100, 5)
+
+	_, err := transferFromFunc.ProcessBuiltinFunction(nil, acntDst, createTransferFromInput(testSpender, 10))
+	assert.Equal(t, process.ErrInsufficientESDTAllowance, err)
+}
+
+func TestESDTTransferFrom_ProcessBuiltInFunctionToSpenderShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsESDTTransferFrom()
+	args.Accounts = &mock.AccountsStub{
+		LoadAccountCalled: func(_ []byte) (state.AccountHandler, error) {
+			assert.Fail(t, "should have not loaded the spender account again")
+			return nil, nil
+		},
+	}
+	transferFromFunc, _ := NewESDTTransferFromFunc(args)
+	acntSnd, _ := state.NewUserAccount(testSpender)
+	acntDst := createOwnerAccount(t, args.Marshalizer, 100, 30)
+
+	vmOutput, err := transferFromFunc.ProcessBuiltinFunction(acntSnd, acntDst, createTransferFromInput(testSpender, 10))
+	require.Nil(t, err)
+	assert.Equal(t, uint64(40), vmOutput.GasRemaining)
+
+	allowanceKey := computeESDTAllowanceKey(transferFromFunc.allowanceKeyPrefix, testToken, testSpender)
+	esdtKey := append(transferFromFunc.keyPrefix, testToken...)
+	assert.Equal(t, big.NewInt(20), getESDTValue(acntDst, allowanceKey, args.Marshalizer))
+	assert.Equal(t, big.NewInt(90), getESDTValue(acntDst, esdtKey, args.Marshalizer))
+	assert.Equal(t, big.NewInt(10), getESDTValue(acntSnd, esdtKey, args.Marshalizer))
+}
+
+func TestESDTTransferFrom_ProcessBuiltInFunctionToSameShardDestinationShouldWork(t *testing.T) {
+	t.Parallel()
+
+	destinationAcnt, _ := state.NewUserAccount(testDestination)
+	saveCalled := false
+	args := createMockArgsESDTTransferFrom()
+	args.Accounts = &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			assert.Equal(t, testDestination, address)
+			return destinationAcnt, nil
+		},
+		SaveAccountCalled: func(account state.AccountHandler) error {
+			saveCalled = true
+			return nil
+		},
+	}
+	transferFromFunc, _ := NewESDTTransferFromFunc(args)
+	acntDst := createOwnerAccount(t, args.Marshalizer, 100, 30)
+
+	vmOutput, err := transferFromFunc.ProcessBuiltinFunction(nil, acntDst, createTransferFromInput(testDestination, 30))
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(vmOutput.OutputAccounts))
+	assert.True(t, saveCalled)
+
+	allowanceKey := computeESDTAllowanceKey(transferFromFunc.allowanceKeyPrefix, testToken, testSpender)
+	esdtKey := append(transferFromFunc.keyPrefix, testToken...)
+	assert.Equal(t, big.NewInt(0), getESDTValue(acntDst, allowanceKey, args.Marshalizer))
+	assert.Equal(t, big.NewInt(70), getESDTValue(acntDst, esdtKey, args.Marshalizer))
+	assert.Equal(t, big.NewInt(30), getESDTValue(destinationAcnt, esdtKey, args.Marshalizer))
+}
+
+func TestESDTTransferFrom_ProcessBuiltInFunctionToCrossShardDestinationShouldCreateTransfer(t *testing.T) {
+	t.Parallel()
+
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		if bytes.Equal(address, testDestination) {
+			return 1
+		}
+		return 0
+	}
+	args := createMockArgsESDTTransferFrom()
+	args.ShardCoordinator = shardCoordinator
+	transferFromFunc, _ := NewESDTTransferFromFunc(args)
+	acntSnd, _ := state.NewUserAccount(testSpender)
+	acntDst := createOwnerAccount(t, args.Marshalizer, 100, 30)
+
+	vmOutput, err := transferFromFunc.ProcessBuiltinFunction(acntSnd, acntDst, createTransferFromInput(testDestination, 25))
+	require.Nil(t, err)
+	assert.Equal(t, uint64(0), vmOutput.GasRemaining)
+
+	esdtKey := append(transferFromFunc.keyPrefix, testToken...)
+	assert.Equal(t, big.NewInt(75), getESDTValue(acntDst, esdtKey, args.Marshalizer))
+
+	outAcc, ok := vmOutput.OutputAccounts[string(testDestination)]
+	require.True(t, ok)
+	require.Equal(t, 1, len(outAcc.OutputTransfers))
+	expectedData := core.BuiltInFunctionESDTTransfer + "@" + hex.EncodeToString(testToken) + "@" + hex.EncodeToString(big.NewInt(25).Bytes())
+	assert.Equal(t, []byte(expectedData), outAcc.OutputTransfers[0].Data)
+	assert.Equal(t, uint64(40), outAcc.OutputTransfers[0].GasLimit)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/mitchellh/mapstructure"
 )
 
@@ -14,20 +15,28 @@ var log = logger.GetOrCreate("process/smartContract/builtInFunctions")
 
 // ArgsCreateBuiltInFunctionContainer -
 type ArgsCreateBuiltInFunctionContainer struct {
-	GasSchedule          core.GasScheduleNotifier
-	MapDNSAddresses      map[string]struct{}
-	EnableUserNameChange bool
-	Marshalizer          marshal.Marshalizer
-	Accounts             state.AccountsAdapter
+	GasSchedule              core.GasScheduleNotifier
+	MapDNSAddresses          map[string]struct{}
+	EnableUserNameChange     bool
+	Marshalizer              marshal.Marshalizer
+	Accounts                 state.AccountsAdapter
+	ShardCoordinator         sharding.Coordinator
+	EpochNotifier            process.EpochNotifier
+	ESDTAllowanceEnableEpoch uint32
+	ESDTNFTEnableEpoch       uint32
 }
 
 type builtInFuncFactory struct {
-	mapDNSAddresses      map[string]struct{}
-	enableUserNameChange bool
-	marshalizer          marshal.Marshalizer
-	accounts             state.AccountsAdapter
-	builtInFunctions     process.BuiltInFunctionContainer
-	gasConfig            *process.GasCost
+	mapDNSAddresses          map[string]struct{}
+	enableUserNameChange     bool
+	marshalizer              marshal.Marshalizer
+	accounts                 state.AccountsAdapter
+	shardCoordinator         sharding.Coordinator
+	epochNotifier            process.EpochNotifier
+	builtInFunctions         *functionContainer
+	gasConfig                *process.GasCost
+	esdtAllowanceEnableEpoch uint32
+	esdtNFTEnableEpoch       uint32
 }
 
 // NewBuiltInFunctionsFactory creates a factory which will instantiate the built in functions contracts
@@ -44,12 +53,22 @@ func NewBuiltInFunctionsFactory(args ArgsCreateBuiltInFunctionContainer) (*built
 	if args.MapDNSAddresses == nil {
 		return nil, process.ErrNilDnsAddresses
 	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+	if check.IfNil(args.EpochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
 
 	b := &builtInFuncFactory{
-		mapDNSAddresses:      args.MapDNSAddresses,
-		enableUserNameChange: args.EnableUserNameChange,
-		marshalizer:          args.Marshalizer,
-		accounts:             args.Accounts,
+		mapDNSAddresses:          args.MapDNSAddresses,
+		enableUserNameChange:     args.EnableUserNameChange,
+		marshalizer:              args.Marshalizer,
+		accounts:                 args.Accounts,
+		shardCoordinator:         args.ShardCoordinator,
+		epochNotifier:            args.EpochNotifier,
+		esdtAllowanceEnableEpoch: args.ESDTAllowanceEnableEpoch,
+		esdtNFTEnableEpoch:       args.ESDTNFTEnableEpoch,
 	}
 
 	var err error
@@ -72,8 +91,8 @@ func (b *builtInFuncFactory) GasScheduleChange(gasSchedule map[string]map[string
 	}
 
 	b.gasConfig = newGasConfig
-	for key := range b.builtInFunctions.Keys() {
-		builtInFunc, errGet := b.builtInFunctions.Get(key)
+	for _, key := range b.builtInFunctions.keysIncludingDisabled() {
+		builtInFunc, errGet := b.builtInFunctions.getIncludingDisabled(key)
 		if errGet != nil {
 			return
 		}
@@ -180,9 +199,102 @@ func (b *builtInFuncFactory) CreateBuiltInFunctionContainer() (process.BuiltInFu
 		return nil, err
 	}
 
+	newFunc, err = NewESDTApproveFunc(b.gasConfig.BuiltInCost.ESDTApprove, b.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+	err = b.addEpochEnabledFunction(core.BuiltInFunctionESDTApprove, newFunc, b.esdtAllowanceEnableEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTTransferFromFunc(ArgsNewESDTTransferFromFunc{
+		FuncGasCost:      b.gasConfig.BuiltInCost.ESDTTransferFrom,
+		Marshalizer:      b.marshalizer,
+		PauseHandler:     pauseFunc,
+		Accounts:         b.accounts,
+		ShardCoordinator: b.shardCoordinator,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = b.addEpochEnabledFunction(core.BuiltInFunctionESDTTransferFrom, newFunc, b.esdtAllowanceEnableEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTRolesFunc(b.marshalizer, true)
+	if err != nil {
+		return nil, err
+	}
+	err = b.addEpochEnabledFunction(core.BuiltInFunctionESDTSetRole, newFunc, b.esdtNFTEnableEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTRolesFunc(b.marshalizer, false)
+	if err != nil {
+		return nil, err
+	}
+	err = b.addEpochEnabledFunction(core.BuiltInFunctionESDTUnSetRole, newFunc, b.esdtNFTEnableEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTNFTCreateFunc(b.gasConfig.BuiltInCost.ESDTNFTCreate, b.gasConfig.BaseOperationCost, b.marshalizer, pauseFunc)
+	if err != nil {
+		return nil, err
+	}
+	err = b.addEpochEnabledFunction(core.BuiltInFunctionESDTNFTCreate, newFunc, b.esdtNFTEnableEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTNFTAddQuantityFunc(b.gasConfig.BuiltInCost.ESDTNFTAddQuantity, b.marshalizer, pauseFunc)
+	if err != nil {
+		return nil, err
+	}
+	err = b.addEpochEnabledFunction(core.BuiltInFunctionESDTNFTAddQuantity, newFunc, b.esdtNFTEnableEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTNFTBurnFunc(b.gasConfig.BuiltInCost.ESDTNFTBurn, b.marshalizer, pauseFunc)
+	if err != nil {
+		return nil, err
+	}
+	err = b.addEpochEnabledFunction(core.BuiltInFunctionESDTNFTBurn, newFunc, b.esdtNFTEnableEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	newFunc, err = NewESDTNFTTransferFunc(ArgsNewESDTNFTTransferFunc{
+		FuncGasCost:      b.gasConfig.BuiltInCost.ESDTNFTTransfer,
+		Marshalizer:      b.marshalizer,
+		PauseHandler:     pauseFunc,
+		Accounts:         b.accounts,
+		ShardCoordinator: b.shardCoordinator,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = b.addEpochEnabledFunction(core.BuiltInFunctionESDTNFTTransfer, newFunc, b.esdtNFTEnableEpoch)
+	if err != nil {
+		return nil, err
+	}
+
 	return b.builtInFunctions, nil
 }
 
+func (b *builtInFuncFactory) addEpochEnabledFunction(key string, function process.BuiltinFunction, enableEpoch uint32) error {
+	epochEnabledFunc, err := newEpochEnabledFunction(key, function, enableEpoch, b.epochNotifier)
+	if err != nil {
+		return err
+	}
+
+	return b.builtInFunctions.Add(key, epochEnabledFunc)
+}
+
 func createGasConfig(gasMap map[string]map[string]uint64) (*process.GasCost, error) {
 	baseOps := &process.BaseOperationCost{}
 	err := mapstructure.Decode(gasMap[core.BaseOperationCost], baseOps)
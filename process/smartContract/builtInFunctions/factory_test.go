@@ -1,6 +1,7 @@
 package builtInFunctions
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/core"
@@ -20,6 +21,8 @@ func createMockArguments() ArgsCreateBuiltInFunctionContainer {
 		EnableUserNameChange: false,
 		Marshalizer:          &mock.MarshalizerMock{},
 		Accounts:             &mock.AccountsStub{},
+		ShardCoordinator:     mock.NewMultiShardsCoordinatorMock(2),
+		EpochNotifier:        &mock.EpochNotifierStub{},
 	}
 
 	return args
@@ -52,6 +55,12 @@ func fillGasMapBuiltInCosts(value uint64) map[string]uint64 {
 	gasMap["SaveKeyValue"] = value
 	gasMap["ESDTTransfer"] = value
 	gasMap["ESDTBurn"] = value
+	gasMap["ESDTApprove"] = value
+	gasMap["ESDTTransferFrom"] = value
+	gasMap["ESDTNFTCreate"] = value
+	gasMap["ESDTNFTAddQuantity"] = value
+	gasMap["ESDTNFTBurn"] = value
+	gasMap["ESDTNFTTransfer"] = value
 
 	return gasMap
 }
@@ -71,10 +80,100 @@ func TestCreateBuiltInFunctionContainer_Errors(t *testing.T) {
 	assert.Equal(t, process.ErrNilDnsAddresses, err)
 	assert.Nil(t, factory)
 
+	args = createMockArguments()
+	args.ShardCoordinator = nil
+	factory, err = NewBuiltInFunctionsFactory(args)
+	assert.Equal(t, process.ErrNilShardCoordinator, err)
+	assert.Nil(t, factory)
+
+	args = createMockArguments()
+	args.EpochNotifier = nil
+	factory, err = NewBuiltInFunctionsFactory(args)
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+	assert.Nil(t, factory)
+
 	args = createMockArguments()
 	factory, err = NewBuiltInFunctionsFactory(args)
 	assert.Nil(t, err)
 	container, err := factory.CreateBuiltInFunctionContainer()
 	assert.Nil(t, err)
-	assert.Equal(t, len(container.Keys()), 11)
+	assert.Equal(t, len(container.Keys()), 19)
+}
+
+func TestCreateBuiltInFunctionContainer_ESDTAllowanceFunctionsShouldBeEnabledByEpoch(t *testing.T) {
+	t.Parallel()
+
+	var epochHandlers []core.EpochSubscriberHandler
+	args := createMockArguments()
+	args.ESDTAllowanceEnableEpoch = 2
+	args.EpochNotifier = &mock.EpochNotifierStub{
+		RegisterNotifyHandlerCalled: func(handler core.EpochSubscriberHandler) {
+			handler.EpochConfirmed(1)
+			epochHandlers = append(epochHandlers, handler)
+		},
+	}
+	factory, _ := NewBuiltInFunctionsFactory(args)
+	container, err := factory.CreateBuiltInFunctionContainer()
+	assert.Nil(t, err)
+
+	allowanceFunctions := []string{core.BuiltInFunctionESDTApprove, core.BuiltInFunctionESDTTransferFrom}
+	for _, name := range allowanceFunctions {
+		_, err = container.Get(name)
+		assert.True(t, errors.Is(err, process.ErrInvalidContainerKey))
+		_, found := container.Keys()[name]
+		assert.False(t, found)
+	}
+	_, err = container.Get(core.BuiltInFunctionESDTTransfer)
+	assert.Nil(t, err)
+
+	for _, handler := range epochHandlers {
+		handler.EpochConfirmed(2)
+	}
+	for _, name := range allowanceFunctions {
+		function, errGet := container.Get(name)
+		assert.Nil(t, errGet)
+		assert.NotNil(t, function)
+		_, found := container.Keys()[name]
+		assert.True(t, found)
+	}
+}
+
+func TestCreateBuiltInFunctionContainer_ESDTNFTFunctionsShouldBeEnabledByEpoch(t *testing.T) {
+	t.Parallel()
+
+	var epochHandlers []core.EpochSubscriberHandler
+	args := createMockArguments()
+	args.ESDTNFTEnableEpoch = 2
+	args.EpochNotifier = &mock.EpochNotifierStub{
+		RegisterNotifyHandlerCalled: func(handler core.EpochSubscriberHandler) {
+			handler.EpochConfirmed(1)
+			epochHandlers = append(epochHandlers, handler)
+		},
+	}
+	factory, _ := NewBuiltInFunctionsFactory(args)
+	container, err := factory.CreateBuiltInFunctionContainer()
+	assert.Nil(t, err)
+
+	nftFunctions := []string{
+		core.BuiltInFunctionESDTSetRole,
+		core.BuiltInFunctionESDTUnSetRole,
+		core.BuiltInFunctionESDTNFTCreate,
+		core.BuiltInFunctionESDTNFTAddQuantity,
+		core.BuiltInFunctionESDTNFTBurn,
+		core.BuiltInFunctionESDTNFTTransfer,
+	}
+	for _, name := range nftFunctions {
+		_, err = container.Get(name)
+		assert.True(t, errors.Is(err, process.ErrInvalidContainerKey))
+	}
+	_, err = container.Get(core.BuiltInFunctionESDTApprove)
+	assert.Nil(t, err)
+
+	for _, handler := range epochHandlers {
+		handler.EpochConfirmed(2)
+	}
+	for _, name := range nftFunctions {
+		_, err = container.Get(name)
+		assert.Nil(t, err)
+	}
 }
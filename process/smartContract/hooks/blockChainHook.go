@@ -20,6 +20,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/hashing/keccak"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/disabled"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/factory"
@@ -69,6 +70,9 @@ type BlockChainHookImpl struct {
 	configSCStorage    config.StorageConfig
 	workingDir         string
 	nilCompiledSCStore bool
+
+	mutCallTracer sync.RWMutex
+	callTracer    process.SCCallTracer
 }
 
 // NewBlockChainHookImpl creates a new BlockChainHookImpl instance
@@ -93,6 +97,7 @@ func NewBlockChainHookImpl(
 		configSCStorage:    args.ConfigSCStorage,
 		workingDir:         args.WorkingDir,
 		nilCompiledSCStore: args.NilCompiledSCStore,
+		callTracer:         &disabled.CallTracer{},
 	}
 
 	err = blockChainHookImpl.makeCompiledSCStorage()
@@ -342,6 +347,25 @@ func (bh *BlockChainHookImpl) ProcessBuiltInFunction(input *vmcommon.ContractCal
 		return nil, process.ErrNilVmInput
 	}
 
+	bh.mutCallTracer.RLock()
+	callTracer := bh.callTracer
+	bh.mutCallTracer.RUnlock()
+
+	callTracer.EnterCall(input.OriginalTxHash, &process.TracedCall{
+		CallType:    process.TracedCallTypeBuiltInFunction,
+		Caller:      input.CallerAddr,
+		Recipient:   input.RecipientAddr,
+		Function:    input.Function,
+		Value:       input.CallValue,
+		GasProvided: input.GasProvided,
+	})
+	vmOutput, err := bh.processBuiltInFunction(input)
+	callTracer.ExitCall(input.OriginalTxHash, vmOutput, err)
+
+	return vmOutput, err
+}
+
+func (bh *BlockChainHookImpl) processBuiltInFunction(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
 	function, err := bh.builtInFunctions.Get(input.Function)
 	if err != nil {
 		return nil, err
@@ -374,6 +398,19 @@ func (bh *BlockChainHookImpl) ProcessBuiltInFunction(input *vmcommon.ContractCal
 	return vmOutput, nil
 }
 
+// SetCallTracer sets the tracer notified each time a smart contract executes a built in function
+func (bh *BlockChainHookImpl) SetCallTracer(tracer process.SCCallTracer) error {
+	if check.IfNil(tracer) {
+		return process.ErrNilCallTracer
+	}
+
+	bh.mutCallTracer.Lock()
+	bh.callTracer = tracer
+	bh.mutCallTracer.Unlock()
+
+	return nil
+}
+
 // GetShardOfAddress is the hook that returns the shard of a given address
 func (bh *BlockChainHookImpl) GetShardOfAddress(address []byte) uint32 {
 	return bh.shardCoordinator.ComputeId(address)
@@ -460,3 +460,72 @@ func TestBlockChainHookImpl_IsPayablePayable(t *testing.T) {
 	assert.True(t, isPayable)
 	assert.Nil(t, err)
 }
+
+func TestBlockChainHookImpl_SetCallTracerNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bh, _ := hooks.NewBlockChainHookImpl(createMockVMAccountsArguments())
+
+	err := bh.SetCallTracer(nil)
+	assert.Equal(t, process.ErrNilCallTracer, err)
+}
+
+func TestBlockChainHookImpl_ProcessBuiltInFunctionShouldNotifyCallTracer(t *testing.T) {
+	t.Parallel()
+
+	funcName := "builtInFunc"
+	args := createMockVMAccountsArguments()
+	container := builtInFunctions.NewBuiltInFunctionContainer()
+	_ = container.Add(funcName, &mock.BuiltInFunctionStub{
+		ProcessBuiltinFunctionCalled: func(acntSnd, acntDst state.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRemaining: 10}, nil
+		},
+	})
+	args.BuiltInFunctions = container
+	args.Accounts = &mock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			return mock.NewAccountWrapMock(address), nil
+		},
+		LoadAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			return mock.NewAccountWrapMock(address), nil
+		},
+		SaveAccountCalled: func(account state.AccountHandler) error {
+			return nil
+		},
+	}
+	bh, _ := hooks.NewBlockChainHookImpl(args)
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:     []byte("caller"),
+			GasProvided:    100,
+			OriginalTxHash: []byte("txHash"),
+		},
+		RecipientAddr: []byte("recipient"),
+		Function:      funcName,
+	}
+
+	var tracedCall *process.TracedCall
+	var tracedOutput *vmcommon.VMOutput
+	err := bh.SetCallTracer(&mock.CallTracerStub{
+		EnterCallCalled: func(originalTxHash []byte, call *process.TracedCall) {
+			assert.Equal(t, input.OriginalTxHash, originalTxHash)
+			tracedCall = call
+		},
+		ExitCallCalled: func(originalTxHash []byte, vmOutput *vmcommon.VMOutput, err error) {
+			assert.Equal(t, input.OriginalTxHash, originalTxHash)
+			assert.Nil(t, err)
+			tracedOutput = vmOutput
+		},
+	})
+	assert.Nil(t, err)
+
+	vmOutput, err := bh.ProcessBuiltInFunction(input)
+	assert.Nil(t, err)
+
+	assert.Equal(t, process.TracedCallTypeBuiltInFunction, tracedCall.CallType)
+	assert.Equal(t, funcName, tracedCall.Function)
+	assert.Equal(t, input.CallerAddr, tracedCall.Caller)
+	assert.Equal(t, uint64(100), tracedCall.GasProvided)
+	assert.Equal(t, vmOutput, tracedOutput)
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/disabled"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/vm"
 )
@@ -68,6 +69,9 @@ type scProcessor struct {
 	mutGasLock           sync.RWMutex
 
 	txLogsProcessor process.TransactionLogProcessor
+
+	mutCallTracer sync.RWMutex
+	callTracer    process.SCCallTracer
 }
 
 // ArgsNewSmartContractProcessor defines the arguments needed for new smart contract processor
@@ -174,6 +178,7 @@ func NewSmartContractProcessor(args ArgsNewSmartContractProcessor) (*scProcessor
 		esdtTransferCost:               builtInFuncCost[core.BuiltInFunctionESDTTransfer],
 		builtInFunctions:               args.BuiltInFunctions,
 		txLogsProcessor:                args.TxLogsProcessor,
+		callTracer:                     &disabled.CallTracer{},
 		badTxForwarder:                 args.BadTxForwarder,
 		deployEnableEpoch:              args.DeployEnableEpoch,
 		builtinEnableEpoch:             args.BuiltinEnableEpoch,
@@ -349,8 +354,18 @@ func (sc *scProcessor) executeSmartContractCall(
 		return userErrorVmOutput, sc.ProcessIfError(acntSnd, txHash, tx, err.Error(), []byte(returnMessage), snapshot, vmInput.GasLocked)
 	}
 
+	callTracer := sc.getCallTracer()
+	callTracer.EnterCall(vmInput.OriginalTxHash, &process.TracedCall{
+		CallType:    process.GetTracedCallType(vmInput.CallType),
+		Caller:      vmInput.CallerAddr,
+		Recipient:   vmInput.RecipientAddr,
+		Function:    vmInput.Function,
+		Value:       vmInput.CallValue,
+		GasProvided: vmInput.GasProvided,
+	})
 	var vmOutput *vmcommon.VMOutput
 	vmOutput, err = vmExec.RunSmartContractCall(vmInput)
+	callTracer.ExitCall(vmInput.OriginalTxHash, vmOutput, err)
 	if err != nil {
 		log.Debug("run smart contract call error", "error", err.Error())
 		return userErrorVmOutput, sc.ProcessIfError(acntSnd, txHash, tx, err.Error(), []byte(""), snapshot, vmInput.GasLocked)
@@ -824,7 +839,17 @@ func (sc *scProcessor) resolveBuiltInFunctions(
 		return vmOutput, nil
 	}
 
+	callTracer := sc.getCallTracer()
+	callTracer.EnterCall(vmInput.OriginalTxHash, &process.TracedCall{
+		CallType:    process.TracedCallTypeBuiltInFunction,
+		Caller:      vmInput.CallerAddr,
+		Recipient:   vmInput.RecipientAddr,
+		Function:    vmInput.Function,
+		Value:       vmInput.CallValue,
+		GasProvided: vmInput.GasProvided,
+	})
 	vmOutput, err = builtIn.ProcessBuiltinFunction(acntSnd, acntDst, vmInput)
+	callTracer.ExitCall(vmInput.OriginalTxHash, vmOutput, err)
 	if err != nil {
 		vmOutput = &vmcommon.VMOutput{
 			ReturnCode:    vmcommon.UserError,
@@ -1158,7 +1183,15 @@ func (sc *scProcessor) DeploySmartContract(tx data.TransactionHandler, acntSnd s
 		return vmcommon.UserError, sc.ProcessIfError(acntSnd, txHash, tx, err.Error(), []byte(""), snapshot, vmInput.GasLocked)
 	}
 
+	callTracer := sc.getCallTracer()
+	callTracer.EnterCall(txHash, &process.TracedCall{
+		CallType:    process.TracedCallTypeDeploy,
+		Caller:      vmInput.CallerAddr,
+		Value:       vmInput.CallValue,
+		GasProvided: vmInput.GasProvided,
+	})
 	vmOutput, err = vmExec.RunSmartContractCreate(vmInput)
+	callTracer.ExitCall(txHash, vmOutput, err)
 	if err != nil {
 		log.Debug("VM error", "error", err.Error())
 		return vmcommon.UserError, sc.ProcessIfError(acntSnd, txHash, tx, err.Error(), []byte(""), snapshot, vmInput.GasLocked)
@@ -1472,12 +1505,16 @@ func setOriginalTxHash(
 	txHash []byte,
 	tx data.TransactionHandler,
 ) {
+	scr.OriginalTxHash = getOriginalTxHash(tx, txHash)
+}
+
+func getOriginalTxHash(tx data.TransactionHandler, txHash []byte) []byte {
 	currSCR, isSCR := tx.(*smartContractResult.SmartContractResult)
 	if isSCR {
-		scr.OriginalTxHash = currSCR.OriginalTxHash
-	} else {
-		scr.OriginalTxHash = txHash
+		return currSCR.OriginalTxHash
 	}
+
+	return txHash
 }
 
 // reloadLocalAccount will reload from current account state the sender account
@@ -1650,6 +1687,10 @@ func (sc *scProcessor) useLastTransferAsAsyncCallBackWhenNeeded(
 	if !sc.isTransferWithNoDataOrBuiltInCall(outputTransfer.Data) {
 		return false
 	}
+	// the destination shard credits the tokens only if the call type set by the built-in function is kept
+	if outputTransfer.CallType == vmcommon.ESDTTransferFromBuiltInFunction {
+		return false
+	}
 
 	result.CallType = vmcommon.AsynchronousCallBack
 	result.GasLimit += vmOutput.GasRemaining
@@ -1768,6 +1809,9 @@ func (sc *scProcessor) processSCOutputAccounts(
 	sumOfAllDiff := big.NewInt(0)
 	sumOfAllDiff.Sub(sumOfAllDiff, tx.GetValue())
 
+	callTracer := sc.getCallTracer()
+	originalTxHash := getOriginalTxHash(tx, txHash)
+	isTracing := callTracer.IsTracing(originalTxHash)
 	createdAsyncCallback := false
 	for _, outAcc := range outputAccounts {
 		acc, err := sc.getAccountFromAddress(outAcc.Address)
@@ -1799,11 +1843,20 @@ func (sc *scProcessor) processSCOutputAccounts(
 				continue
 			}
 
+			var oldValue []byte
+			if isTracing {
+				oldValue, _ = acc.DataTrieTracker().RetrieveValue(storeUpdate.Offset)
+			}
+
 			err = acc.DataTrieTracker().SaveKeyValue(storeUpdate.Offset, storeUpdate.Data)
 			if err != nil {
 				log.Warn("saveKeyValue", "error", err)
 				return false, nil, err
 			}
+
+			if isTracing {
+				callTracer.RecordStorageWrite(originalTxHash, outAcc.Address, storeUpdate.Offset, oldValue, storeUpdate.Data)
+			}
 			log.Trace("storeUpdate", "acc", outAcc.Address, "key", storeUpdate.Offset, "data", storeUpdate.Data)
 		}
 
@@ -2087,6 +2140,27 @@ func (sc *scProcessor) EpochConfirmed(epoch uint32) {
 	log.Debug("scProcessor: penalized too much gas", "enabled", sc.flagPenalizedTooMuchGas.IsSet())
 }
 
+// SetCallTracer sets the tracer notified about the calls, storage writes and logs produced while executing
+// smart contracts and built in functions
+func (sc *scProcessor) SetCallTracer(tracer process.SCCallTracer) error {
+	if check.IfNil(tracer) {
+		return process.ErrNilCallTracer
+	}
+
+	sc.mutCallTracer.Lock()
+	sc.callTracer = tracer
+	sc.mutCallTracer.Unlock()
+
+	return nil
+}
+
+func (sc *scProcessor) getCallTracer() process.SCCallTracer {
+	sc.mutCallTracer.RLock()
+	defer sc.mutCallTracer.RUnlock()
+
+	return sc.callTracer
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (sc *scProcessor) IsInterfaceNil() bool {
 	return sc == nil
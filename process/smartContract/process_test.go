@@ -702,6 +702,64 @@ func TestScProcessor_ExecuteSmartContractTransactionSaveLogCalled(t *testing.T)
 	require.True(t, slCalled)
 }
 
+func TestScProcessor_SetCallTracerNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sc, _ := NewSmartContractProcessor(createMockSmartContractProcessorArguments())
+
+	err := sc.SetCallTracer(nil)
+	require.Equal(t, process.ErrNilCallTracer, err)
+}
+
+func TestScProcessor_ExecuteSmartContractTransactionShouldNotifyCallTracer(t *testing.T) {
+	t.Parallel()
+
+	vm := &mock.VMContainerMock{}
+	argParser := &mock.ArgumentParserMock{}
+	accntState := &mock.AccountsStub{}
+	arguments := createMockSmartContractProcessorArguments()
+	arguments.VmContainer = vm
+	arguments.ArgsParser = argParser
+	arguments.AccountsDB = accntState
+	sc, _ := NewSmartContractProcessor(arguments)
+
+	tx := &transaction.Transaction{}
+	tx.Nonce = 0
+	tx.SndAddr = []byte("SRC")
+	tx.RcvAddr = []byte("DST0000000")
+	tx.Data = []byte("data")
+	tx.Value = big.NewInt(0)
+	acntSrc, acntDst := createAccounts(tx)
+	txHash, _ := core.CalculateHash(arguments.Marshalizer, arguments.Hasher, tx)
+
+	accntState.LoadAccountCalled = func(address []byte) (handler state.AccountHandler, e error) {
+		return acntSrc, nil
+	}
+
+	var tracedCall *process.TracedCall
+	exitCalled := false
+	err := sc.SetCallTracer(&mock.CallTracerStub{
+		EnterCallCalled: func(originalTxHash []byte, call *process.TracedCall) {
+			require.Equal(t, txHash, originalTxHash)
+			tracedCall = call
+		},
+		ExitCallCalled: func(originalTxHash []byte, vmOutput *vmcommon.VMOutput, err error) {
+			require.Equal(t, txHash, originalTxHash)
+			exitCalled = true
+		},
+	})
+	require.Nil(t, err)
+
+	acntDst.SetCode([]byte("code"))
+	_, _ = sc.ExecuteSmartContractTransaction(tx, acntSrc, acntDst)
+
+	require.NotNil(t, tracedCall)
+	require.Equal(t, process.TracedCallTypeDirect, tracedCall.CallType)
+	require.Equal(t, tx.SndAddr, tracedCall.Caller)
+	require.Equal(t, tx.RcvAddr, tracedCall.Recipient)
+	require.True(t, exitCalled)
+}
+
 func TestScProcessor_CreateVMCallInputWrongCode(t *testing.T) {
 	t.Parallel()
 
@@ -1554,6 +1612,56 @@ func TestScProcessor_processSCOutputAccounts(t *testing.T) {
 	require.Equal(t, currentBalance+vmOutBalance, testAcc.Balance.Uint64())
 }
 
+func TestScProcessor_processSCOutputAccountsShouldRecordStorageWritesWhenTracing(t *testing.T) {
+	t.Parallel()
+
+	accountsDB := &mock.AccountsStub{}
+	arguments := createMockSmartContractProcessorArguments()
+	arguments.AccountsDB = accountsDB
+	sc, _ := NewSmartContractProcessor(arguments)
+
+	scAddress := []byte("smartcontract")
+	scAccount, _ := state.NewUserAccount(scAddress)
+	accountsDB.LoadAccountCalled = func(address []byte) (handler state.AccountHandler, e error) {
+		return scAccount, nil
+	}
+	accountsDB.SaveAccountCalled = func(accountHandler state.AccountHandler) error {
+		return nil
+	}
+
+	type storageWrite struct {
+		oldValue []byte
+		newValue []byte
+	}
+	writes := make([]storageWrite, 0)
+	isTracing := false
+	_ = sc.SetCallTracer(&mock.CallTracerStub{
+		IsTracingCalled: func(originalTxHash []byte) bool {
+			return isTracing && bytes.Equal(originalTxHash, []byte("hash"))
+		},
+		RecordStorageWriteCalled: func(originalTxHash []byte, address []byte, key []byte, oldValue []byte, newValue []byte) {
+			require.Equal(t, scAddress, address)
+			require.Equal(t, []byte("key"), key)
+			writes = append(writes, storageWrite{oldValue: oldValue, newValue: newValue})
+		},
+	})
+
+	tx := &transaction.Transaction{Value: big.NewInt(0)}
+	outAcc := &vmcommon.OutputAccount{
+		Address:        scAddress,
+		StorageUpdates: map[string]*vmcommon.StorageUpdate{"key": {Offset: []byte("key"), Data: []byte("first")}},
+	}
+	_, _, err := sc.processSCOutputAccounts(&vmcommon.VMOutput{}, vmcommon.DirectCall, []*vmcommon.OutputAccount{outAcc}, tx, []byte("hash"))
+	require.Nil(t, err)
+	require.Len(t, writes, 0)
+
+	isTracing = true
+	outAcc.StorageUpdates["key"] = &vmcommon.StorageUpdate{Offset: []byte("key"), Data: []byte("second")}
+	_, _, err = sc.processSCOutputAccounts(&vmcommon.VMOutput{}, vmcommon.DirectCall, []*vmcommon.OutputAccount{outAcc}, tx, []byte("hash"))
+	require.Nil(t, err)
+	require.Equal(t, []storageWrite{{oldValue: []byte("first"), newValue: []byte("second")}}, writes)
+}
+
 func TestScProcessor_processSCOutputAccountsNotInShard(t *testing.T) {
 	t.Parallel()
 
@@ -2343,6 +2451,38 @@ func TestGasLockedInSmartContractProcessor(t *testing.T) {
 	require.Equal(t, gasLocked, outTransfer.GasLocked)
 }
 
+func TestSmartContractProcessor_createSmartContractResultsShouldKeepBuiltInFunctionTransferCallType(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockSmartContractProcessorArguments()
+	arguments.ArgsParser = NewArgumentParser()
+	_ = arguments.BuiltInFunctions.Add(core.BuiltInFunctionESDTNFTTransfer, &mock.BuiltInFunctionStub{})
+	sc, _ := NewSmartContractProcessor(arguments)
+
+	sndAddress := []byte("sender")
+	outAcc := &vmcommon.OutputAccount{
+		Address:      sndAddress,
+		BalanceDelta: big.NewInt(0),
+	}
+	outTransfer := vmcommon.OutputTransfer{
+		Value:    big.NewInt(0),
+		CallType: vmcommon.ESDTTransferFromBuiltInFunction,
+		Data:     []byte(core.BuiltInFunctionESDTNFTTransfer + "@746f6b656e@01@01@" + hex.EncodeToString(sndAddress)),
+	}
+	outAcc.OutputTransfers = append(outAcc.OutputTransfers, outTransfer)
+	vmOutput := &vmcommon.VMOutput{
+		OutputAccounts: map[string]*vmcommon.OutputAccount{string(sndAddress): outAcc},
+	}
+	tx := &transaction.Transaction{SndAddr: sndAddress}
+
+	asyncCallback, results := sc.createSmartContractResults(vmOutput, vmcommon.AsynchronousCall, outAcc, tx, []byte("hash"))
+	require.False(t, asyncCallback)
+	require.Equal(t, 1, len(results))
+
+	scr := results[0].(*smartContractResult.SmartContractResult)
+	assert.Equal(t, vmcommon.ESDTTransferFromBuiltInFunction, scr.CallType)
+}
+
 func TestSmartContractProcessor_computeTotalConsumedFeeAndDevRwd(t *testing.T) {
 	t.Parallel()
 
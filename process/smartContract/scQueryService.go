@@ -63,6 +63,9 @@ func (service *SCQueryService) ExecuteQuery(query *process.SCQuery) (*vmcommon.V
 	if len(query.FuncName) == 0 {
 		return nil, process.ErrEmptyFunctionName
 	}
+	if len(query.BlockRootHash) > 0 {
+		return nil, process.ErrHistoricalQueriesNotSupported
+	}
 
 	service.mutRunSc.Lock()
 	defer service.mutRunSc.Unlock()
@@ -0,0 +1,72 @@
+package smartContract
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.SCQueryService = (*scQueryServiceWithHistory)(nil)
+
+type scQueryServiceWithHistory struct {
+	mutQuery         sync.Mutex
+	scQueryService   process.SCQueryService
+	accountsSwitcher process.AccountsStateSwitcher
+}
+
+// NewSCQueryServiceWithHistory returns a smart contract query service able to execute queries against the state of
+// a past block. The provided accounts switcher should be the accounts adapter used by the wrapped query service.
+func NewSCQueryServiceWithHistory(
+	scQueryService process.SCQueryService,
+	accountsSwitcher process.AccountsStateSwitcher,
+) (*scQueryServiceWithHistory, error) {
+	if check.IfNil(scQueryService) {
+		return nil, process.ErrNilScQueryElement
+	}
+	if check.IfNil(accountsSwitcher) {
+		return nil, process.ErrNilAccountsStateSwitcher
+	}
+
+	return &scQueryServiceWithHistory{
+		scQueryService:   scQueryService,
+		accountsSwitcher: accountsSwitcher,
+	}, nil
+}
+
+// ExecuteQuery executes the query against the state found at the query's block root hash, if provided, or against
+// the current state otherwise
+func (sqsh *scQueryServiceWithHistory) ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+	sqsh.mutQuery.Lock()
+	defer sqsh.mutQuery.Unlock()
+
+	if len(query.BlockRootHash) == 0 {
+		return sqsh.scQueryService.ExecuteQuery(query)
+	}
+
+	err := sqsh.accountsSwitcher.SwitchToRootHash(query.BlockRootHash)
+	if err != nil {
+		return nil, err
+	}
+	defer sqsh.accountsSwitcher.SwitchToCurrentState()
+
+	queryOnCurrentState := *query
+	queryOnCurrentState.BlockRootHash = nil
+
+	return sqsh.scQueryService.ExecuteQuery(&queryOnCurrentState)
+}
+
+// ComputeScCallGasLimit will call the wrapped query service's function with the same name
+func (sqsh *scQueryServiceWithHistory) ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error) {
+	sqsh.mutQuery.Lock()
+	defer sqsh.mutQuery.Unlock()
+
+	return sqsh.scQueryService.ComputeScCallGasLimit(tx)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sqsh *scQueryServiceWithHistory) IsInterfaceNil() bool {
+	return sqsh == nil
+}
@@ -0,0 +1,109 @@
+package smartContract
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSCQueryServiceWithHistory_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sqsh, err := NewSCQueryServiceWithHistory(nil, &mock.AccountsStateSwitcherStub{})
+	assert.True(t, check.IfNil(sqsh))
+	assert.Equal(t, process.ErrNilScQueryElement, err)
+
+	sqsh, err = NewSCQueryServiceWithHistory(&mock.ScQueryStub{}, nil)
+	assert.True(t, check.IfNil(sqsh))
+	assert.Equal(t, process.ErrNilAccountsStateSwitcher, err)
+
+	sqsh, err = NewSCQueryServiceWithHistory(&mock.ScQueryStub{}, &mock.AccountsStateSwitcherStub{})
+	assert.False(t, check.IfNil(sqsh))
+	assert.Nil(t, err)
+}
+
+func TestScQueryServiceWithHistory_ExecuteQueryOnCurrentStateShouldNotSwitch(t *testing.T) {
+	t.Parallel()
+
+	executeCalled := false
+	sqsh, _ := NewSCQueryServiceWithHistory(
+		&mock.ScQueryStub{
+			ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+				executeCalled = true
+				return &vmcommon.VMOutput{}, nil
+			},
+		},
+		&mock.AccountsStateSwitcherStub{
+			SwitchToRootHashCalled: func(rootHash []byte) error {
+				assert.Fail(t, "should have not been called")
+				return nil
+			},
+		},
+	)
+
+	_, err := sqsh.ExecuteQuery(&process.SCQuery{})
+	assert.Nil(t, err)
+	assert.True(t, executeCalled)
+}
+
+func TestScQueryServiceWithHistory_ExecuteQueryOnPastStateShouldSwitchAndRestore(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	switchedRootHash := make([]byte, 0)
+	isSwitched := false
+	sqsh, _ := NewSCQueryServiceWithHistory(
+		&mock.ScQueryStub{
+			ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+				assert.True(t, isSwitched)
+				assert.Nil(t, query.BlockRootHash)
+				return &vmcommon.VMOutput{}, nil
+			},
+		},
+		&mock.AccountsStateSwitcherStub{
+			SwitchToRootHashCalled: func(rootHash []byte) error {
+				switchedRootHash = rootHash
+				isSwitched = true
+				return nil
+			},
+			SwitchToCurrentStateCalled: func() {
+				isSwitched = false
+			},
+		},
+	)
+
+	query := &process.SCQuery{BlockRootHash: rootHash}
+	_, err := sqsh.ExecuteQuery(query)
+	assert.Nil(t, err)
+	assert.Equal(t, rootHash, switchedRootHash)
+	assert.Equal(t, rootHash, query.BlockRootHash)
+	assert.False(t, isSwitched)
+}
+
+func TestScQueryServiceWithHistory_ExecuteQueryOnMissingStateShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("missing trie")
+	sqsh, _ := NewSCQueryServiceWithHistory(
+		&mock.ScQueryStub{
+			ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+				assert.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		},
+		&mock.AccountsStateSwitcherStub{
+			SwitchToRootHashCalled: func(rootHash []byte) error {
+				return expectedErr
+			},
+		},
+	)
+
+	output, err := sqsh.ExecuteQuery(&process.SCQuery{BlockRootHash: []byte("root hash")})
+	assert.Nil(t, output)
+	assert.Equal(t, expectedErr, err)
+}
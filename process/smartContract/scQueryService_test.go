@@ -81,6 +81,24 @@ func TestExecuteQuery_EmptyFunctionShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrEmptyFunctionName, err)
 }
 
+func TestExecuteQuery_BlockRootHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	target, _ := NewSCQueryService(&mock.VMContainerMock{}, &mock.FeeHandlerStub{}, &mock.BlockChainHookHandlerMock{}, &mock.BlockChainMock{})
+
+	query := process.SCQuery{
+		ScAddress:     []byte{0},
+		FuncName:      "function",
+		Arguments:     [][]byte{},
+		BlockRootHash: []byte("root hash"),
+	}
+
+	output, err := target.ExecuteQuery(&query)
+
+	assert.Nil(t, output)
+	assert.Equal(t, process.ErrHistoricalQueriesNotSupported, err)
+}
+
 func TestExecuteQuery_ShouldReceiveQueryCorrectly(t *testing.T) {
 	t.Parallel()
 
@@ -3,6 +3,7 @@ package sync
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"math"
 	"sync"
 	"time"
@@ -10,6 +11,7 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/closing"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
@@ -90,6 +92,8 @@ type baseBootstrap struct {
 	mutRcvHdrHash            sync.RWMutex
 	syncStateListeners       []func(bool)
 	mutSyncStateListeners    sync.RWMutex
+	beforeSyncBlockHandlers  []func()
+	mutBeforeSyncBlock       sync.RWMutex
 	uint64Converter          typeConverters.Uint64ByteSliceConverter
 	mapNonceSyncedWithErrors map[uint64]uint32
 	mutNonceSyncedWithErrors sync.RWMutex
@@ -113,6 +117,7 @@ type baseBootstrap struct {
 	poolsHolder        dataRetriever.PoolsHolder
 	mutRequestHeaders  sync.Mutex
 	cancelFunc         func()
+	lastHeartbeat      atomic.Int64
 }
 
 // setRequestedHeaderNonce method sets the header nonce requested by the sync mechanism
@@ -212,6 +217,14 @@ func (boot *baseBootstrap) AddSyncStateListener(syncStateListener func(isSyncing
 	boot.mutSyncStateListeners.Unlock()
 }
 
+// AddBeforeSyncBlockHandler adds a handler which is called, and waited for, each time the node is not synchronized and
+// the bootstrapper is about to change the blockchain and the accounts state
+func (boot *baseBootstrap) AddBeforeSyncBlockHandler(handler func()) {
+	boot.mutBeforeSyncBlock.Lock()
+	boot.beforeSyncBlockHandlers = append(boot.beforeSyncBlockHandlers, handler)
+	boot.mutBeforeSyncBlock.Unlock()
+}
+
 // SetStatusHandler will set the instance of the AppStatusHandler
 func (boot *baseBootstrap) SetStatusHandler(handler core.AppStatusHandler) error {
 	if handler == nil || handler.IsInterfaceNil() {
@@ -230,6 +243,14 @@ func (boot *baseBootstrap) notifySyncStateListeners(isNodeSynchronized bool) {
 	boot.mutSyncStateListeners.RUnlock()
 }
 
+func (boot *baseBootstrap) callBeforeSyncBlockHandlers() {
+	boot.mutBeforeSyncBlock.RLock()
+	for i := 0; i < len(boot.beforeSyncBlockHandlers); i++ {
+		boot.beforeSyncBlockHandlers[i]()
+	}
+	boot.mutBeforeSyncBlock.RUnlock()
+}
+
 // getNonceForNextBlock will get the nonce for the next block
 func (boot *baseBootstrap) getNonceForNextBlock() uint64 {
 	nonce := boot.chainHandler.GetGenesisHeader().GetNonce() + 1 // first block nonce after genesis block
@@ -477,6 +498,8 @@ func (boot *baseBootstrap) syncBlocks(ctx context.Context) {
 		case <-time.After(sleepTime):
 		}
 
+		boot.lastHeartbeat.Set(time.Now().UnixNano())
+
 		if !boot.networkWatcher.IsConnectedToTheNetwork() {
 			continue
 		}
@@ -547,6 +570,8 @@ func (boot *baseBootstrap) syncBlock() error {
 		boot.mutNodeState.Unlock()
 	}()
 
+	boot.callBeforeSyncBlockHandlers()
+
 	if boot.forkInfo.IsDetected {
 		boot.statusHandler.Increment(core.MetricNumTimesInForkChoice)
 
@@ -975,6 +1000,7 @@ func (boot *baseBootstrap) init() {
 	boot.statusHandler = statusHandler.NewNilStatusHandler()
 
 	boot.syncStateListeners = make([]func(bool), 0)
+	boot.beforeSyncBlockHandlers = make([]func(), 0)
 	boot.requestedHashes = process.RequiredDataPool{}
 	boot.mapNonceSyncedWithErrors = make(map[uint64]uint32)
 }
@@ -1015,6 +1041,37 @@ func (boot *baseBootstrap) GetNodeState() core.NodeState {
 	return core.NsNotSynchronized
 }
 
+// GetLastHeartbeat returns the time of the last iteration of the sync loop, so that a stalled loop can be detected
+func (boot *baseBootstrap) GetLastHeartbeat() time.Time {
+	return time.Unix(0, boot.lastHeartbeat.Get())
+}
+
+// CheckHealth reports whether the node is synchronized with the network
+func (boot *baseBootstrap) CheckHealth() core.HealthCheckResult {
+	boot.mutNodeState.RLock()
+	isNodeSynchronized := boot.isNodeSynchronized
+	isForkDetected := boot.forkInfo.IsDetected
+	boot.mutNodeState.RUnlock()
+
+	if isNodeSynchronized {
+		return core.NewHealthCheckResultOK()
+	}
+
+	reason := fmt.Sprintf("node is syncing: current nonce %d, probable highest nonce %d",
+		boot.getNonceForCurrentBlock(), boot.forkDetector.ProbableHighestNonce())
+	if isForkDetected {
+		reason = "node is resolving a fork"
+	}
+	if !boot.networkWatcher.IsConnectedToTheNetwork() {
+		reason = "node is not connected to the network"
+	}
+
+	return core.HealthCheckResult{
+		Status: core.HealthStatusUnavailable,
+		Reason: reason,
+	}
+}
+
 // Close will close the endless running go routine
 func (boot *baseBootstrap) Close() error {
 	if boot.cancelFunc != nil {
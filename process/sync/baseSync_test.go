@@ -6,6 +6,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
@@ -42,6 +44,29 @@ func TestBaseBootstrap_SyncBlocksShouldNotCallSyncIfNotConnectedToTheNetwork(t *
 	assert.Equal(t, uint32(0), atomic.LoadUint32(&numCalls))
 }
 
+func TestBaseBootstrap_SyncBlocksShouldBeatEvenIfNotConnectedToTheNetwork(t *testing.T) {
+	t.Parallel()
+
+	boot := &baseBootstrap{
+		chStopSync: make(chan bool),
+		networkWatcher: &mock.NetworkConnectionWatcherStub{
+			IsConnectedToTheNetworkCalled: func() bool {
+				return false
+			},
+		},
+	}
+	startTime := time.Now()
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	go boot.syncBlocks(ctx)
+
+	//make sure go routine started and waited a few cycles of boot.syncBlocks
+	time.Sleep(sleepTime * 10)
+	cancelFunc()
+
+	assert.True(t, boot.GetLastHeartbeat().After(startTime))
+}
+
 func TestBaseBootstrap_SyncBlocksShouldCallSyncIfConnectedToTheNetwork(t *testing.T) {
 	t.Parallel()
 
@@ -122,3 +147,45 @@ func TestBaseBootstrap_GetOrderedMiniBlocksShouldWork(t *testing.T) {
 	assert.Equal(t, uint32(1), orderedMiniBlocks[1].SenderShardID)
 	assert.Equal(t, uint32(2), orderedMiniBlocks[2].SenderShardID)
 }
+
+func TestBaseBootstrap_CheckHealth(t *testing.T) {
+	t.Parallel()
+
+	isConnected := true
+	boot := &baseBootstrap{
+		chainHandler: &mock.BlockChainMock{
+			GetGenesisHeaderCalled: func() data.HeaderHandler {
+				return &block.Header{}
+			},
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.Header{Nonce: 5}
+			},
+		},
+		forkDetector: &mock.ForkDetectorMock{
+			ProbableHighestNonceCalled: func() uint64 {
+				return 10
+			},
+		},
+		networkWatcher: &mock.NetworkConnectionWatcherStub{
+			IsConnectedToTheNetworkCalled: func() bool {
+				return isConnected
+			},
+		},
+		forkInfo: process.NewForkInfo(),
+	}
+
+	result := boot.CheckHealth()
+	assert.Equal(t, core.HealthStatusUnavailable, result.Status)
+	assert.Equal(t, "node is syncing: current nonce 5, probable highest nonce 10", result.Reason)
+
+	boot.forkInfo.IsDetected = true
+	result = boot.CheckHealth()
+	assert.Equal(t, "node is resolving a fork", result.Reason)
+
+	isConnected = false
+	result = boot.CheckHealth()
+	assert.Equal(t, "node is not connected to the network", result.Reason)
+
+	boot.isNodeSynchronized = true
+	assert.Equal(t, core.HealthStatusOK, boot.CheckHealth().Status)
+}
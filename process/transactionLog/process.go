@@ -7,6 +7,7 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/subscription"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
@@ -21,16 +22,18 @@ var log = logger.GetOrCreate("process/transactionLog")
 
 // ArgTxLogProcessor defines the arguments needed for transaction logs processor
 type ArgTxLogProcessor struct {
-	Storer      storage.Storer
-	Marshalizer marshal.Marshalizer
+	Storer               storage.Storer
+	Marshalizer          marshal.Marshalizer
+	SubscriptionNotifier subscription.Notifier
 }
 
 type txLogProcessor struct {
-	storeLogsInCache bool
-	logs             map[string]*transaction.Log
-	mut              sync.RWMutex
-	storer           storage.Storer
-	marshalizer      marshal.Marshalizer
+	storeLogsInCache     bool
+	logs                 map[string]*transaction.Log
+	mut                  sync.RWMutex
+	storer               storage.Storer
+	marshalizer          marshal.Marshalizer
+	subscriptionNotifier subscription.Notifier
 }
 
 // NewTxLogProcessor creates a transaction log processor capable of parsing logs from the VM
@@ -42,13 +45,17 @@ func NewTxLogProcessor(args ArgTxLogProcessor) (*txLogProcessor, error) {
 	if check.IfNil(args.Marshalizer) {
 		return nil, process.ErrNilMarshalizer
 	}
+	if check.IfNil(args.SubscriptionNotifier) {
+		return nil, process.ErrNilSubscriptionNotifier
+	}
 
 	return &txLogProcessor{
-		storer:           args.Storer,
-		marshalizer:      args.Marshalizer,
-		logs:             make(map[string]*transaction.Log),
-		storeLogsInCache: false,
-		mut:              sync.RWMutex{},
+		storer:               args.Storer,
+		marshalizer:          args.Marshalizer,
+		subscriptionNotifier: args.SubscriptionNotifier,
+		logs:                 make(map[string]*transaction.Log),
+		storeLogsInCache:     false,
+		mut:                  sync.RWMutex{},
 	}, nil
 }
 
@@ -125,6 +132,7 @@ func (tlp *txLogProcessor) SaveLog(txHash []byte, tx data.TransactionHandler, lo
 	}
 
 	tlp.saveLogToCache(txHash, txLog)
+	tlp.subscriptionNotifier.NotifyLog(txHash, txLog)
 
 	buff, err := tlp.marshalizer.Marshal(txLog)
 	if err != nil {
@@ -9,34 +9,46 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/ElrondNetwork/elrond-go/process/transactionLog"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/require"
 )
 
 func TestNewTxLogProcessor_NilParameters(t *testing.T) {
 	_, nilMarshalizer := transactionLog.NewTxLogProcessor(transactionLog.ArgTxLogProcessor{
-		Storer: &mock.StorerStub{},
+		Storer:               &mock.StorerStub{},
+		SubscriptionNotifier: &testscommon.SubscriptionNotifierStub{},
 	})
 
 	require.Equal(t, process.ErrNilMarshalizer, nilMarshalizer)
 
 	_, nilStorer := transactionLog.NewTxLogProcessor(transactionLog.ArgTxLogProcessor{
-		Marshalizer: &mock.MarshalizerMock{},
+		Marshalizer:          &mock.MarshalizerMock{},
+		SubscriptionNotifier: &testscommon.SubscriptionNotifierStub{},
 	})
 
 	require.Equal(t, process.ErrNilStore, nilStorer)
 
-	_, nilError := transactionLog.NewTxLogProcessor(transactionLog.ArgTxLogProcessor{
+	_, nilNotifier := transactionLog.NewTxLogProcessor(transactionLog.ArgTxLogProcessor{
 		Storer:      &mock.StorerStub{},
 		Marshalizer: &mock.MarshalizerMock{},
 	})
 
+	require.Equal(t, process.ErrNilSubscriptionNotifier, nilNotifier)
+
+	_, nilError := transactionLog.NewTxLogProcessor(transactionLog.ArgTxLogProcessor{
+		Storer:               &mock.StorerStub{},
+		Marshalizer:          &mock.MarshalizerMock{},
+		SubscriptionNotifier: &testscommon.SubscriptionNotifierStub{},
+	})
+
 	require.Nil(t, nilError)
 }
 
 func TestTxLogProcessor_SaveLogsNilTxHash(t *testing.T) {
 	txLogProcessor, _ := transactionLog.NewTxLogProcessor(transactionLog.ArgTxLogProcessor{
-		Storer:      &mock.StorerStub{},
-		Marshalizer: &mock.MarshalizerMock{},
+		Storer:               &mock.StorerStub{},
+		Marshalizer:          &mock.MarshalizerMock{},
+		SubscriptionNotifier: &testscommon.SubscriptionNotifierStub{},
 	})
 
 	err := txLogProcessor.SaveLog(nil, nil, make([]*vmcommon.LogEntry, 0))
@@ -45,8 +57,9 @@ func TestTxLogProcessor_SaveLogsNilTxHash(t *testing.T) {
 
 func TestTxLogProcessor_SaveLogsNilTx(t *testing.T) {
 	txLogProcessor, _ := transactionLog.NewTxLogProcessor(transactionLog.ArgTxLogProcessor{
-		Storer:      &mock.StorerStub{},
-		Marshalizer: &mock.MarshalizerMock{},
+		Storer:               &mock.StorerStub{},
+		Marshalizer:          &mock.MarshalizerMock{},
+		SubscriptionNotifier: &testscommon.SubscriptionNotifierStub{},
 	})
 
 	err := txLogProcessor.SaveLog([]byte("txhash"), nil, make([]*vmcommon.LogEntry, 0))
@@ -55,8 +68,9 @@ func TestTxLogProcessor_SaveLogsNilTx(t *testing.T) {
 
 func TestTxLogProcessor_SaveLogsEmptyLogsReturnsNil(t *testing.T) {
 	txLogProcessor, _ := transactionLog.NewTxLogProcessor(transactionLog.ArgTxLogProcessor{
-		Storer:      &mock.StorerStub{},
-		Marshalizer: &mock.MarshalizerMock{},
+		Storer:               &mock.StorerStub{},
+		Marshalizer:          &mock.MarshalizerMock{},
+		SubscriptionNotifier: &testscommon.SubscriptionNotifierStub{},
 	})
 
 	err := txLogProcessor.SaveLog([]byte("txhash"), &transaction.Transaction{}, make([]*vmcommon.LogEntry, 0))
@@ -72,6 +86,7 @@ func TestTxLogProcessor_SaveLogsMarshalErr(t *testing.T) {
 				return nil, retErr
 			},
 		},
+		SubscriptionNotifier: &testscommon.SubscriptionNotifierStub{},
 	})
 
 	logs := []*vmcommon.LogEntry{
@@ -94,6 +109,7 @@ func TestTxLogProcessor_SaveLogsStoreErr(t *testing.T) {
 				return nil, nil
 			},
 		},
+		SubscriptionNotifier: &testscommon.SubscriptionNotifierStub{},
 	})
 
 	logs := []*vmcommon.LogEntry{
@@ -118,6 +134,7 @@ func TestTxLogProcessor_SaveLogsCallsPutWithMarshalBuff(t *testing.T) {
 				return buffExpected, nil
 			},
 		},
+		SubscriptionNotifier: &testscommon.SubscriptionNotifierStub{},
 	})
 
 	logs := []*vmcommon.LogEntry{
@@ -128,6 +145,34 @@ func TestTxLogProcessor_SaveLogsCallsPutWithMarshalBuff(t *testing.T) {
 	require.Equal(t, buffExpected, buffActual)
 }
 
+func TestTxLogProcessor_SaveLogsShouldNotifySubscriptions(t *testing.T) {
+	var notifiedTxHash []byte
+	var notifiedLog *transaction.Log
+	txLogProcessor, _ := transactionLog.NewTxLogProcessor(transactionLog.ArgTxLogProcessor{
+		Storer: &mock.StorerStub{
+			PutCalled: func(key, data []byte) error {
+				return nil
+			},
+		},
+		Marshalizer: &mock.MarshalizerMock{},
+		SubscriptionNotifier: &testscommon.SubscriptionNotifierStub{
+			NotifyLogCalled: func(txHash []byte, txLog *transaction.Log) {
+				notifiedTxHash = txHash
+				notifiedLog = txLog
+			},
+		},
+	})
+
+	logs := []*vmcommon.LogEntry{
+		{Address: []byte("first log"), Identifier: []byte("identifier")},
+	}
+	err := txLogProcessor.SaveLog([]byte("txhash"), &transaction.Transaction{}, logs)
+
+	require.Nil(t, err)
+	require.Equal(t, []byte("txhash"), notifiedTxHash)
+	require.Equal(t, []byte("identifier"), notifiedLog.Events[0].Identifier)
+}
+
 func TestTxLogProcessor_GetLogErrNotFound(t *testing.T) {
 	txLogProcessor, _ := transactionLog.NewTxLogProcessor(transactionLog.ArgTxLogProcessor{
 		Storer: &mock.StorerStub{
@@ -135,7 +180,8 @@ func TestTxLogProcessor_GetLogErrNotFound(t *testing.T) {
 				return nil, errors.New("storer error")
 			},
 		},
-		Marshalizer: &mock.MarshalizerStub{},
+		Marshalizer:          &mock.MarshalizerStub{},
+		SubscriptionNotifier: &testscommon.SubscriptionNotifierStub{},
 	})
 
 	_, err := txLogProcessor.GetLog([]byte("texhash"))
@@ -156,6 +202,7 @@ func TestTxLogProcessor_GetLogUnmarshalErr(t *testing.T) {
 				return retErr
 			},
 		},
+		SubscriptionNotifier: &testscommon.SubscriptionNotifierStub{},
 	})
 
 	_, err := txLogProcessor.GetLog([]byte("texhash"))
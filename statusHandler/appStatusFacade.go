@@ -1,6 +1,8 @@
 package statusHandler
 
 import (
+	"time"
+
 	"github.com/ElrondNetwork/elrond-go/core"
 )
 
@@ -83,6 +85,24 @@ func (asf *AppStatusFacade) SetStringValue(key string, value string) {
 	}()
 }
 
+// AddToCounter method - will increase the value of a labeled counter for every handler
+func (asf *AppStatusFacade) AddToCounter(key string, value uint64, labels ...core.MetricLabel) {
+	go func() {
+		for _, ash := range asf.handlers {
+			ash.AddToCounter(key, value, labels...)
+		}
+	}()
+}
+
+// ObserveDuration method - will record a duration in a labeled histogram for every handler
+func (asf *AppStatusFacade) ObserveDuration(key string, duration time.Duration, labels ...core.MetricLabel) {
+	go func() {
+		for _, ash := range asf.handlers {
+			ash.ObserveDuration(key, duration, labels...)
+		}
+	}()
+}
+
 // Close method will close all the handlers
 func (asf *AppStatusFacade) Close() {
 	go func() {
@@ -0,0 +1,237 @@
+package statusHandler
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+const counterType = "counter"
+const histogramType = "histogram"
+const gaugeType = "gauge"
+
+// durationBuckets are the upper bounds, in seconds, of the buckets used by all duration histograms
+var durationBuckets = []float64{0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+var typedMetricsHelp = map[string]string{
+	core.MetricSubroundDuration:              "Time spent in each consensus subround",
+	core.MetricTxCacheSelectionDuration:      "Time spent selecting transactions from the transactions cache",
+	core.MetricInterceptorProcessingDuration: "Time spent processing a received message, per topic",
+	core.MetricTrieCommitDuration:            "Time spent committing a state trie",
+	core.MetricTrieSnapshotDuration:          "Time spent taking a snapshot or a checkpoint of a state trie",
+	core.MetricP2PTopicReceivedMessages:      "Number of messages received, per topic",
+	core.MetricP2PTopicReceivedBytes:         "Number of bytes received, per topic",
+}
+
+type labeledCounter struct {
+	labels []core.MetricLabel
+	value  uint64
+}
+
+type labeledHistogram struct {
+	labels       []core.MetricLabel
+	bucketCounts []uint64
+	count        uint64
+	sum          float64
+}
+
+// metricsRegistry holds the labeled counters and histograms and renders them in the prometheus exposition format
+type metricsRegistry struct {
+	mut        sync.RWMutex
+	counters   map[string]map[string]*labeledCounter
+	histograms map[string]map[string]*labeledHistogram
+}
+
+func newMetricsRegistry() *metricsRegistry {
+	return &metricsRegistry{
+		counters:   make(map[string]map[string]*labeledCounter),
+		histograms: make(map[string]map[string]*labeledHistogram),
+	}
+}
+
+func (mr *metricsRegistry) addToCounter(key string, value uint64, labels []core.MetricLabel) {
+	sortedLabels := sortLabels(labels)
+	labelsKey := createLabelsKey(sortedLabels)
+
+	mr.mut.Lock()
+	defer mr.mut.Unlock()
+
+	series, ok := mr.counters[key]
+	if !ok {
+		series = make(map[string]*labeledCounter)
+		mr.counters[key] = series
+	}
+
+	counter, ok := series[labelsKey]
+	if !ok {
+		counter = &labeledCounter{labels: sortedLabels}
+		series[labelsKey] = counter
+	}
+
+	counter.value += value
+}
+
+func (mr *metricsRegistry) observeDuration(key string, duration time.Duration, labels []core.MetricLabel) {
+	sortedLabels := sortLabels(labels)
+	labelsKey := createLabelsKey(sortedLabels)
+	seconds := duration.Seconds()
+
+	mr.mut.Lock()
+	defer mr.mut.Unlock()
+
+	series, ok := mr.histograms[key]
+	if !ok {
+		series = make(map[string]*labeledHistogram)
+		mr.histograms[key] = series
+	}
+
+	histogram, ok := series[labelsKey]
+	if !ok {
+		histogram = &labeledHistogram{
+			labels:       sortedLabels,
+			bucketCounts: make([]uint64, len(durationBuckets)),
+		}
+		series[labelsKey] = histogram
+	}
+
+	for i, upperBound := range durationBuckets {
+		if seconds <= upperBound {
+			histogram.bucketCounts[i]++
+		}
+	}
+	histogram.count++
+	histogram.sum += seconds
+}
+
+// writePrometheusString writes all the counters and histograms, adding the provided label to each series
+func (mr *metricsRegistry) writePrometheusString(builder *strings.Builder, commonLabel core.MetricLabel) {
+	mr.mut.RLock()
+	defer mr.mut.RUnlock()
+
+	counterKeys := make([]string, 0, len(mr.counters))
+	for key := range mr.counters {
+		counterKeys = append(counterKeys, key)
+	}
+	sort.Strings(counterKeys)
+
+	for _, key := range counterKeys {
+		writeMetricHeader(builder, key, getTypedMetricHelp(key), counterType)
+
+		counters := make([]*labeledCounter, 0, len(mr.counters[key]))
+		for _, counter := range mr.counters[key] {
+			counters = append(counters, counter)
+		}
+		sort.Slice(counters, func(i, j int) bool {
+			return formatLabels(counters[i].labels) < formatLabels(counters[j].labels)
+		})
+
+		for _, counter := range counters {
+			labels := withLabel([]core.MetricLabel{commonLabel}, counter.labels...)
+			builder.WriteString(fmt.Sprintf("%s%s %d\n", key, formatLabels(labels), counter.value))
+		}
+	}
+
+	histogramKeys := make([]string, 0, len(mr.histograms))
+	for key := range mr.histograms {
+		histogramKeys = append(histogramKeys, key)
+	}
+	sort.Strings(histogramKeys)
+
+	for _, key := range histogramKeys {
+		writeMetricHeader(builder, key, getTypedMetricHelp(key), histogramType)
+
+		histograms := make([]*labeledHistogram, 0, len(mr.histograms[key]))
+		for _, histogram := range mr.histograms[key] {
+			histograms = append(histograms, histogram)
+		}
+		sort.Slice(histograms, func(i, j int) bool {
+			return formatLabels(histograms[i].labels) < formatLabels(histograms[j].labels)
+		})
+
+		for _, histogram := range histograms {
+			writeHistogram(builder, key, histogram, commonLabel)
+		}
+	}
+}
+
+func writeHistogram(builder *strings.Builder, key string, histogram *labeledHistogram, commonLabel core.MetricLabel) {
+	labels := withLabel([]core.MetricLabel{commonLabel}, histogram.labels...)
+	for i, upperBound := range durationBuckets {
+		bucketLabels := withLabel(labels, core.MetricLabel{Name: "le", Value: strconv.FormatFloat(upperBound, 'g', -1, 64)})
+		builder.WriteString(fmt.Sprintf("%s_bucket%s %d\n", key, formatLabels(bucketLabels), histogram.bucketCounts[i]))
+	}
+
+	infBucketLabels := withLabel(labels, core.MetricLabel{Name: "le", Value: "+Inf"})
+	builder.WriteString(fmt.Sprintf("%s_bucket%s %d\n", key, formatLabels(infBucketLabels), histogram.count))
+	builder.WriteString(fmt.Sprintf("%s_sum%s %s\n", key, formatLabels(labels), strconv.FormatFloat(histogram.sum, 'g', -1, 64)))
+	builder.WriteString(fmt.Sprintf("%s_count%s %d\n", key, formatLabels(labels), histogram.count))
+}
+
+// withLabel returns a new slice holding the provided labels followed by the extra ones
+func withLabel(labels []core.MetricLabel, extra ...core.MetricLabel) []core.MetricLabel {
+	result := make([]core.MetricLabel, 0, len(labels)+len(extra))
+	result = append(result, labels...)
+
+	return append(result, extra...)
+}
+
+func writeMetricHeader(builder *strings.Builder, key string, help string, metricType string) {
+	builder.WriteString(fmt.Sprintf("# HELP %s %s\n", key, help))
+	builder.WriteString(fmt.Sprintf("# TYPE %s %s\n", key, metricType))
+}
+
+func getTypedMetricHelp(key string) string {
+	help, ok := typedMetricsHelp[key]
+	if !ok {
+		return key
+	}
+
+	return help
+}
+
+func sortLabels(labels []core.MetricLabel) []core.MetricLabel {
+	sortedLabels := make([]core.MetricLabel, len(labels))
+	copy(sortedLabels, labels)
+	sort.Slice(sortedLabels, func(i, j int) bool {
+		return sortedLabels[i].Name < sortedLabels[j].Name
+	})
+
+	return sortedLabels
+}
+
+func createLabelsKey(sortedLabels []core.MetricLabel) string {
+	builder := strings.Builder{}
+	for _, label := range sortedLabels {
+		builder.WriteString(label.Name)
+		builder.WriteByte(0)
+		builder.WriteString(label.Value)
+		builder.WriteByte(0)
+	}
+
+	return builder.String()
+}
+
+func formatLabels(labels []core.MetricLabel) string {
+	if len(labels) == 0 {
+		return ""
+	}
+
+	formattedLabels := make([]string, 0, len(labels))
+	for _, label := range labels {
+		formattedLabels = append(formattedLabels, fmt.Sprintf("%s=\"%s\"", label.Name, escapeLabelValue(label.Value)))
+	}
+
+	return "{" + strings.Join(formattedLabels, ",") + "}"
+}
+
+func escapeLabelValue(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `"`, `\"`)
+
+	return strings.ReplaceAll(value, "\n", `\n`)
+}
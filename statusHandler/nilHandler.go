@@ -1,5 +1,11 @@
 package statusHandler
 
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
 // NilStatusHandler will be used when an AppStatusHandler is required, but another one isn't necessary or available
 type NilStatusHandler struct {
 }
@@ -38,6 +44,14 @@ func (nsh *NilStatusHandler) SetUInt64Value(_ string, _ uint64) {
 func (nsh *NilStatusHandler) SetStringValue(_ string, _ string) {
 }
 
+// AddToCounter method - won't do anything
+func (nsh *NilStatusHandler) AddToCounter(_ string, _ uint64, _ ...core.MetricLabel) {
+}
+
+// ObserveDuration method - won't do anything
+func (nsh *NilStatusHandler) ObserveDuration(_ string, _ time.Duration, _ ...core.MetricLabel) {
+}
+
 // Close method - won't do anything
 func (nsh *NilStatusHandler) Close() {
 }
@@ -218,6 +218,14 @@ func (psh *PersistentStatusHandler) Decrement(key string) {
 	psh.persistentMetrics.Store(key, keyValue)
 }
 
+// AddToCounter method - won't do anything as the labeled counters are not persisted
+func (psh *PersistentStatusHandler) AddToCounter(_ string, _ uint64, _ ...core.MetricLabel) {
+}
+
+// ObserveDuration method - won't do anything as the histograms are not persisted
+func (psh *PersistentStatusHandler) ObserveDuration(_ string, _ time.Duration, _ ...core.MetricLabel) {
+}
+
 // Close method - won't do anything
 func (psh *PersistentStatusHandler) Close() {
 }
@@ -4,6 +4,9 @@ import (
 	"math/big"
 	"strings"
 	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
 )
 
 //maxLogLines is used to specify how many lines of logs need to store in slice
@@ -100,6 +103,14 @@ func (psh *PresenterStatusHandler) Decrement(key string) {
 	psh.presenterMetrics.Store(key, keyValue)
 }
 
+// AddToCounter method - won't do anything as the labeled counters are not displayed
+func (psh *PresenterStatusHandler) AddToCounter(_ string, _ uint64, _ ...core.MetricLabel) {
+}
+
+// ObserveDuration method - won't do anything as the histograms are not displayed
+func (psh *PresenterStatusHandler) ObserveDuration(_ string, _ time.Duration, _ ...core.MetricLabel) {
+}
+
 // Close method - won't do anything
 func (psh *PresenterStatusHandler) Close() {
 }
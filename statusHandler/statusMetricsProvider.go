@@ -2,8 +2,10 @@ package statusHandler
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core"
 )
@@ -11,12 +13,14 @@ import (
 // statusMetrics will handle displaying at /node/details all metrics already collected for other status handlers
 type statusMetrics struct {
 	nodeMetrics *sync.Map
+	registry    *metricsRegistry
 }
 
 // NewStatusMetrics will return an instance of the struct
 func NewStatusMetrics() *statusMetrics {
 	return &statusMetrics{
 		nodeMetrics: &sync.Map{},
+		registry:    newMetricsRegistry(),
 	}
 }
 
@@ -91,6 +95,16 @@ func (sm *statusMetrics) SetStringValue(key string, value string) {
 	sm.nodeMetrics.Store(key, value)
 }
 
+// AddToCounter method - increases the value of the counter series identified by the key and the labels
+func (sm *statusMetrics) AddToCounter(key string, value uint64, labels ...core.MetricLabel) {
+	sm.registry.addToCounter(key, value, labels)
+}
+
+// ObserveDuration method - records a duration in the histogram series identified by the key and the labels
+func (sm *statusMetrics) ObserveDuration(key string, duration time.Duration, labels ...core.MetricLabel) {
+	sm.registry.observeDuration(key, duration, labels)
+}
+
 // Close method - won't do anything
 func (sm *statusMetrics) Close() {
 }
@@ -127,20 +141,34 @@ func (sm *statusMetrics) StatusP2pMetricsMap() map[string]interface{} {
 	return statusMetricsMap
 }
 
-// StatusMetricsWithoutP2PPrometheusString returns the metrics in a string format which respects prometheus style
+// StatusMetricsWithoutP2PPrometheusString returns the metrics in a string format which respects prometheus style.
+// The numeric status metrics are exposed as gauges labeled with the shard ID, followed by the labeled counters and
+// histograms, each series of those carrying the shard label
 func (sm *statusMetrics) StatusMetricsWithoutP2PPrometheusString() string {
 	shardID := sm.loadUint64Metric(core.MetricShardId)
 	metrics := sm.StatusMetricsMapWithoutP2P()
+
+	keys := make([]string, 0, len(metrics))
+	for key := range metrics {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
 	stringBuilder := strings.Builder{}
-	for key, value := range metrics {
+	for _, key := range keys {
+		value := metrics[key]
 		_, isUint64 := value.(uint64)
 		_, isInt64 := value.(int64)
 		isNumericValue := isUint64 || isInt64
 		if isNumericValue {
+			writeMetricHeader(&stringBuilder, key, key, gaugeType)
 			stringBuilder.WriteString(fmt.Sprintf("%s{%s=\"%d\"} %v\n", key, core.MetricShardId, shardID, value))
 		}
 	}
 
+	shardLabel := core.MetricLabel{Name: core.MetricLabelShard, Value: fmt.Sprintf("%d", shardID)}
+	sm.registry.writePrometheusString(&stringBuilder, shardLabel)
+
 	return stringBuilder.String()
 }
 
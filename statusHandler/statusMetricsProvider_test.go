@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
@@ -144,6 +145,71 @@ func TestStatusMetrics_StatusMetricsWithoutP2PPrometheusStringShouldPutCorrectSh
 	assert.True(t, strings.Contains(strRes, expectedMetricOutput))
 }
 
+func TestStatusMetrics_StatusMetricsWithoutP2PPrometheusStringShouldWriteGaugeHeaders(t *testing.T) {
+	t.Parallel()
+
+	sm := statusHandler.NewStatusMetrics()
+	key, value := "test-key9", uint64(100)
+	sm.SetUInt64Value(key, value)
+
+	strRes := sm.StatusMetricsWithoutP2PPrometheusString()
+
+	expectedMetricOutput := fmt.Sprintf("# HELP %s %s\n# TYPE %s gauge\n%s{%s=\"%d\"} %v\n", key, key, key, key, core.MetricShardId, 0, value)
+	assert.True(t, strings.Contains(strRes, expectedMetricOutput))
+}
+
+func TestStatusMetrics_AddToCounterShouldAccumulatePerLabels(t *testing.T) {
+	t.Parallel()
+
+	sm := statusHandler.NewStatusMetrics()
+	sm.SetUInt64Value(core.MetricShardId, 2)
+	key := core.MetricP2PTopicReceivedBytes
+	sm.AddToCounter(key, 10, core.MetricLabel{Name: core.MetricLabelTopic, Value: "transactions"})
+	sm.AddToCounter(key, 5, core.MetricLabel{Name: core.MetricLabelTopic, Value: "transactions"})
+	sm.AddToCounter(key, 7, core.MetricLabel{Name: core.MetricLabelTopic, Value: "shardBlocks"})
+
+	strRes := sm.StatusMetricsWithoutP2PPrometheusString()
+
+	expectedMetricOutput := fmt.Sprintf("# HELP %s Number of bytes received, per topic\n", key) +
+		fmt.Sprintf("# TYPE %s counter\n", key) +
+		fmt.Sprintf("%s{shard=\"2\",topic=\"shardBlocks\"} 7\n", key) +
+		fmt.Sprintf("%s{shard=\"2\",topic=\"transactions\"} 15\n", key)
+	assert.True(t, strings.Contains(strRes, expectedMetricOutput))
+}
+
+func TestStatusMetrics_ObserveDurationShouldFillHistogramBuckets(t *testing.T) {
+	t.Parallel()
+
+	sm := statusHandler.NewStatusMetrics()
+	key := core.MetricSubroundDuration
+	label := core.MetricLabel{Name: core.MetricLabelSubround, Value: "(BLOCK)"}
+	sm.ObserveDuration(key, 3*time.Millisecond, label)
+	sm.ObserveDuration(key, 200*time.Millisecond, label)
+	sm.ObserveDuration(key, time.Minute, label)
+
+	strRes := sm.StatusMetricsWithoutP2PPrometheusString()
+
+	assert.True(t, strings.Contains(strRes, fmt.Sprintf("# TYPE %s histogram\n", key)))
+	assert.True(t, strings.Contains(strRes, fmt.Sprintf("%s_bucket{shard=\"0\",subround=\"(BLOCK)\",le=\"0.001\"} 0\n", key)))
+	assert.True(t, strings.Contains(strRes, fmt.Sprintf("%s_bucket{shard=\"0\",subround=\"(BLOCK)\",le=\"0.005\"} 1\n", key)))
+	assert.True(t, strings.Contains(strRes, fmt.Sprintf("%s_bucket{shard=\"0\",subround=\"(BLOCK)\",le=\"0.25\"} 2\n", key)))
+	assert.True(t, strings.Contains(strRes, fmt.Sprintf("%s_bucket{shard=\"0\",subround=\"(BLOCK)\",le=\"10\"} 2\n", key)))
+	assert.True(t, strings.Contains(strRes, fmt.Sprintf("%s_bucket{shard=\"0\",subround=\"(BLOCK)\",le=\"+Inf\"} 3\n", key)))
+	assert.True(t, strings.Contains(strRes, fmt.Sprintf("%s_sum{shard=\"0\",subround=\"(BLOCK)\"} 60.203\n", key)))
+	assert.True(t, strings.Contains(strRes, fmt.Sprintf("%s_count{shard=\"0\",subround=\"(BLOCK)\"} 3\n", key)))
+}
+
+func TestStatusMetrics_TypedMetricsShouldEscapeLabelValues(t *testing.T) {
+	t.Parallel()
+
+	sm := statusHandler.NewStatusMetrics()
+	sm.AddToCounter("test-counter", 1, core.MetricLabel{Name: core.MetricLabelTopic, Value: "a\"b\\c"})
+
+	strRes := sm.StatusMetricsWithoutP2PPrometheusString()
+
+	assert.True(t, strings.Contains(strRes, `test-counter{shard="0",topic="a\"b\\c"} 1`))
+}
+
 func TestStatusMetrics_NetworkConfig(t *testing.T) {
 	t.Parallel()
 
@@ -124,3 +124,5 @@ var ErrNilTimeCache = errors.New("nil time cache")
 // ErrNilTxGasHandler signals that a nil tx gas handler was provided
 var ErrNilTxGasHandler = errors.New("nil tx gas handler")
 
+// ErrNilAppStatusHandler signals that a nil app status handler was provided
+var ErrNilAppStatusHandler = errors.New("nil AppStatusHandler")
@@ -17,6 +17,8 @@ func GetCacherFromConfig(cfg config.CacheConfig) storageUnit.CacheConfig {
 		SizeInBytesPerSender: cfg.SizeInBytesPerSender,
 		Type:                 storageUnit.CacheType(cfg.Type),
 		Shards:               cfg.Shards,
+
+		MinGasPriceIncreasePercentForReplacement: cfg.MinGasPriceIncreasePercentForReplacement,
 	}
 }
 
@@ -439,6 +439,28 @@ func (psf *StorageServiceFactory) setupDbLookupExtensions(chainStorer *dataRetri
 	*createdStorers = append(*createdStorers, miniblocksMetadataPruningStorer)
 	chainStorer.AddStorer(dataRetriever.MiniblocksMetadataUnit, miniblocksMetadataPruningStorer)
 
+	// Create the txsByAddress (PRUNING) storer
+	txsByAddressConfig := psf.generalConfig.DbLookupExtensions.TxsByAddressStorageConfig
+	txsByAddressPruningStorerArgs := psf.createPruningStorerArgs(txsByAddressConfig)
+	txsByAddressPruningStorer, err := pruning.NewPruningStorer(txsByAddressPruningStorerArgs)
+	if err != nil {
+		return err
+	}
+
+	*createdStorers = append(*createdStorers, txsByAddressPruningStorer)
+	chainStorer.AddStorer(dataRetriever.TransactionsByAddressUnit, txsByAddressPruningStorer)
+
+	// Create the logEvents (PRUNING) storer
+	logEventsConfig := psf.generalConfig.DbLookupExtensions.LogEventsStorageConfig
+	logEventsPruningStorerArgs := psf.createPruningStorerArgs(logEventsConfig)
+	logEventsPruningStorer, err := pruning.NewPruningStorer(logEventsPruningStorerArgs)
+	if err != nil {
+		return err
+	}
+
+	*createdStorers = append(*createdStorers, logEventsPruningStorer)
+	chainStorer.AddStorer(dataRetriever.LogEventsUnit, logEventsPruningStorer)
+
 	// Create the miniblocksHashByTxHash (STATIC) storer
 	miniblockHashByTxHashConfig := psf.generalConfig.DbLookupExtensions.MiniblockHashByTxHashStorageConfig
 	miniblockHashByTxHashDbConfig := GetDBFromConfig(miniblockHashByTxHashConfig.DB)
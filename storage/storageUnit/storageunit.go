@@ -78,6 +78,8 @@ type CacheConfig struct {
 	Capacity             uint32
 	SizePerSender        uint32
 	Shards               uint32
+
+	MinGasPriceIncreasePercentForReplacement uint32
 }
 
 // String returns a readable representation of the object
@@ -17,6 +17,7 @@ const maxNumBytesPerSenderLowerBound = maxNumItemsPerSenderLowerBound * 1
 const maxNumBytesPerSenderUpperBound = 33_554_432 // 32 MB
 const numTxsToPreemptivelyEvictLowerBound = 1
 const numSendersToPreemptivelyEvictLowerBound = 1
+const minGasPriceIncreasePercentForReplacementUpperBound = 1000
 
 // ConfigSourceMe holds cache configuration
 type ConfigSourceMe struct {
@@ -28,11 +29,17 @@ type ConfigSourceMe struct {
 	CountThreshold                uint32
 	CountPerSenderThreshold       uint32
 	NumSendersToPreemptivelyEvict uint32
+
+	// MinGasPriceIncreasePercentForReplacement enables replace-by-fee when set: a transaction having the same sender
+	// and nonce as a transaction in the cache replaces it only if its gas price is higher by at least this percent.
+	// When zero, transactions with the same sender and nonce are kept side by side, ordered by gas price.
+	MinGasPriceIncreasePercentForReplacement uint32
 }
 
 type senderConstraints struct {
-	maxNumTxs   uint32
-	maxNumBytes uint32
+	maxNumTxs                                uint32
+	maxNumBytes                              uint32
+	minGasPriceIncreasePercentForReplacement uint32
 }
 
 // TODO: Upon further analysis and brainstorming, add some sensible minimum accepted values for the appropriate fields.
@@ -49,6 +56,9 @@ func (config *ConfigSourceMe) verify() error {
 	if config.CountPerSenderThreshold < maxNumItemsPerSenderLowerBound {
 		return fmt.Errorf("%w: config.CountPerSenderThreshold is invalid", storage.ErrInvalidConfig)
 	}
+	if config.MinGasPriceIncreasePercentForReplacement > minGasPriceIncreasePercentForReplacementUpperBound {
+		return fmt.Errorf("%w: config.MinGasPriceIncreasePercentForReplacement is invalid", storage.ErrInvalidConfig)
+	}
 	if config.EvictionEnabled {
 		if config.NumBytesThreshold < maxNumBytesLowerBound || config.NumBytesThreshold > maxNumBytesUpperBound {
 			return fmt.Errorf("%w: config.NumBytesThreshold is invalid", storage.ErrInvalidConfig)
@@ -68,6 +78,8 @@ func (config *ConfigSourceMe) getSenderConstraints() senderConstraints {
 	return senderConstraints{
 		maxNumBytes: config.NumBytesPerSenderThreshold,
 		maxNumTxs:   config.CountPerSenderThreshold,
+
+		minGasPriceIncreasePercentForReplacement: config.MinGasPriceIncreasePercentForReplacement,
 	}
 }
 
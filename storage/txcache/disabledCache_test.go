@@ -26,6 +26,15 @@ func TestDisabledCache_DoesNothing(t *testing.T) {
 	length := cache.Len()
 	require.Equal(t, 0, length)
 
+	txs := cache.GetTransactionsForSender([]byte{})
+	require.Equal(t, 0, len(txs))
+
+	info, ok := cache.GetSenderInfo([]byte{})
+	require.Nil(t, info)
+	require.False(t, ok)
+
+	require.Equal(t, CacheStats{}, cache.GetStats())
+
 	require.NotPanics(t, func() { cache.ForEachTransaction(func(_ []byte, _ *WrappedTransaction) {}) })
 
 	cache.Clear()
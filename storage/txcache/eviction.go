@@ -32,6 +32,7 @@ func (cache *TxCache) doEviction() {
 	journal.passOneNumSteps, journal.passOneNumTxs, journal.passOneNumSenders = cache.evictSendersInLoop()
 	journal.evictionPerformed = true
 	cache.evictionJournal = journal
+	cache.numEvictionPasses.Increment()
 
 	cache.monitorEvictionEnd(stopWatch)
 	cache.destroySnapshotOfSenders()
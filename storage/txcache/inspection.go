@@ -0,0 +1,120 @@
+package txcache
+
+import (
+	"bytes"
+	"sort"
+)
+
+// SenderInfo holds the details the cache keeps about a sender of transactions
+type SenderInfo struct {
+	AccountNonce        uint64
+	AccountNonceKnown   bool
+	NumTxs              uint64
+	NumBytes            int64
+	TotalGas            uint64
+	Score               uint32
+	NumFailedSelections int64
+	HasInitialGap       bool
+	IsInGracePeriod     bool
+}
+
+// CacheStats holds statistics about the content of a cache and about its eviction passes
+type CacheStats struct {
+	NumTxs                     uint64
+	NumBytes                   uint64
+	NumSenders                 uint64
+	NumEvictionPasses          uint64
+	LastEvictionNumTxs         uint32
+	LastEvictionNumSenders     uint32
+	LastEvictionNumSteps       uint32
+	IsEvictionInProgress       bool
+	NumSendersWithInitialGap   uint64
+	NumSendersInGracePeriod    uint64
+	NumSendersSelectedLastTime uint64
+}
+
+// GetTransactionsForSender returns the transactions of the given sender, sorted by nonce
+func (cache *TxCache) GetTransactionsForSender(sender []byte) []*WrappedTransaction {
+	listForSender, ok := cache.txListBySender.getListForSender(string(sender))
+	if !ok {
+		return make([]*WrappedTransaction, 0)
+	}
+
+	return listForSender.getTxs()
+}
+
+// GetSenderInfo returns the nonce and score details the cache holds about the given sender
+func (cache *TxCache) GetSenderInfo(sender []byte) (*SenderInfo, bool) {
+	listForSender, ok := cache.txListBySender.getListForSender(string(sender))
+	if !ok {
+		return nil, false
+	}
+
+	return listForSender.getSenderInfo(), true
+}
+
+// GetStats returns the statistics of the cache
+func (cache *TxCache) GetStats() CacheStats {
+	cache.evictionMutex.Lock()
+	journal := cache.evictionJournal
+	cache.evictionMutex.Unlock()
+
+	return CacheStats{
+		NumTxs:                     cache.CountTx(),
+		NumBytes:                   uint64(cache.NumBytes()),
+		NumSenders:                 cache.CountSenders(),
+		NumEvictionPasses:          cache.numEvictionPasses.GetUint64(),
+		LastEvictionNumTxs:         journal.passOneNumTxs,
+		LastEvictionNumSenders:     journal.passOneNumSenders,
+		LastEvictionNumSteps:       journal.passOneNumSteps,
+		IsEvictionInProgress:       cache.isEvictionInProgress.IsSet(),
+		NumSendersWithInitialGap:   cache.numSendersWithInitialGap.GetUint64(),
+		NumSendersInGracePeriod:    cache.numSendersInGracePeriod.GetUint64(),
+		NumSendersSelectedLastTime: cache.numSendersSelected.GetUint64(),
+	}
+}
+
+// GetTransactionsForSender returns the transactions of the given sender, sorted by nonce.
+// The cross-shard cache is not organised by sender, so all its transactions are inspected.
+func (cache *CrossTxCache) GetTransactionsForSender(sender []byte) []*WrappedTransaction {
+	result := make([]*WrappedTransaction, 0)
+	cache.ForEachTransaction(func(_ []byte, tx *WrappedTransaction) {
+		if bytes.Equal(tx.Tx.GetSndAddr(), sender) {
+			result = append(result, tx)
+		}
+	})
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Tx.GetNonce() < result[j].Tx.GetNonce()
+	})
+
+	return result
+}
+
+// GetSenderInfo returns nothing, since the cross-shard cache does not keep details about senders
+func (cache *CrossTxCache) GetSenderInfo(_ []byte) (*SenderInfo, bool) {
+	return nil, false
+}
+
+// GetStats returns the statistics of the cache
+func (cache *CrossTxCache) GetStats() CacheStats {
+	return CacheStats{
+		NumTxs:   uint64(cache.Len()),
+		NumBytes: uint64(cache.NumBytes()),
+	}
+}
+
+// GetTransactionsForSender returns an empty slice
+func (cache *DisabledCache) GetTransactionsForSender(_ []byte) []*WrappedTransaction {
+	return make([]*WrappedTransaction, 0)
+}
+
+// GetSenderInfo returns nothing
+func (cache *DisabledCache) GetSenderInfo(_ []byte) (*SenderInfo, bool) {
+	return nil, false
+}
+
+// GetStats returns empty statistics
+func (cache *DisabledCache) GetStats() CacheStats {
+	return CacheStats{}
+}
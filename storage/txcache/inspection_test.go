@@ -0,0 +1,108 @@
+package txcache
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxCache_GetTransactionsForSender(t *testing.T) {
+	cache := newUnconstrainedCacheToTest()
+
+	cache.AddTx(createTx([]byte("hash-alice-3"), "alice", 3))
+	cache.AddTx(createTx([]byte("hash-alice-1"), "alice", 1))
+	cache.AddTx(createTx([]byte("hash-alice-2"), "alice", 2))
+	cache.AddTx(createTx([]byte("hash-bob-5"), "bob", 5))
+
+	txs := cache.GetTransactionsForSender([]byte("alice"))
+	require.Len(t, txs, 3)
+	require.Equal(t, []byte("hash-alice-1"), txs[0].TxHash)
+	require.Equal(t, []byte("hash-alice-2"), txs[1].TxHash)
+	require.Equal(t, []byte("hash-alice-3"), txs[2].TxHash)
+
+	txs = cache.GetTransactionsForSender([]byte("carol"))
+	require.NotNil(t, txs)
+	require.Len(t, txs, 0)
+}
+
+func TestTxCache_GetSenderInfo(t *testing.T) {
+	cache := newUnconstrainedCacheToTest()
+
+	cache.AddTx(createTx([]byte("hash-alice-5"), "alice", 5))
+	cache.AddTx(createTx([]byte("hash-alice-6"), "alice", 6))
+	cache.NotifyAccountNonce([]byte("alice"), 3)
+
+	info, ok := cache.GetSenderInfo([]byte("alice"))
+	require.True(t, ok)
+	require.Equal(t, uint64(3), info.AccountNonce)
+	require.True(t, info.AccountNonceKnown)
+	require.Equal(t, uint64(2), info.NumTxs)
+	require.Equal(t, int64(2*estimatedSizeOfBoundedTxFields), info.NumBytes)
+	require.True(t, info.HasInitialGap)
+	require.False(t, info.IsInGracePeriod)
+
+	info, ok = cache.GetSenderInfo([]byte("bob"))
+	require.False(t, ok)
+	require.Nil(t, info)
+}
+
+func TestTxCache_GetStats(t *testing.T) {
+	config := ConfigSourceMe{
+		Name:                          "untitled",
+		NumChunks:                     16,
+		EvictionEnabled:               true,
+		CountThreshold:                100,
+		CountPerSenderThreshold:       math.MaxUint32,
+		NumSendersToPreemptivelyEvict: 20,
+		NumBytesThreshold:             maxNumBytesUpperBound,
+		NumBytesPerSenderThreshold:    maxNumBytesPerSenderUpperBound,
+	}
+	txGasHandler, _ := dummyParams()
+	cache, err := NewTxCache(config, txGasHandler)
+	require.Nil(t, err)
+
+	for index := 0; index < 50; index++ {
+		sender := string(createFakeSenderAddress(index))
+		cache.AddTx(createTx([]byte{byte(index)}, sender, uint64(1)))
+	}
+
+	stats := cache.GetStats()
+	require.Equal(t, uint64(50), stats.NumTxs)
+	require.Equal(t, uint64(50), stats.NumSenders)
+	require.Equal(t, uint64(50*estimatedSizeOfBoundedTxFields), stats.NumBytes)
+	require.Equal(t, uint64(0), stats.NumEvictionPasses)
+
+	for index := 50; index < 102; index++ {
+		sender := string(createFakeSenderAddress(index))
+		cache.AddTx(createTx([]byte{byte(index)}, sender, uint64(1)))
+	}
+
+	stats = cache.GetStats()
+	require.Equal(t, uint64(1), stats.NumEvictionPasses)
+	require.Equal(t, uint32(20), stats.LastEvictionNumTxs)
+	require.Equal(t, uint32(20), stats.LastEvictionNumSenders)
+	require.Equal(t, uint64(82), stats.NumTxs)
+	require.False(t, stats.IsEvictionInProgress)
+}
+
+func TestCrossTxCache_Inspection(t *testing.T) {
+	cache := newCrossTxCacheToTest(1, 8, math.MaxUint16)
+
+	cache.AddTx(createTx([]byte("hash-alice-2"), "alice", 2))
+	cache.AddTx(createTx([]byte("hash-alice-1"), "alice", 1))
+	cache.AddTx(createTx([]byte("hash-bob-1"), "bob", 1))
+
+	txs := cache.GetTransactionsForSender([]byte("alice"))
+	require.Len(t, txs, 2)
+	require.Equal(t, []byte("hash-alice-1"), txs[0].TxHash)
+	require.Equal(t, []byte("hash-alice-2"), txs[1].TxHash)
+
+	info, ok := cache.GetSenderInfo([]byte("alice"))
+	require.False(t, ok)
+	require.Nil(t, info)
+
+	stats := cache.GetStats()
+	require.Equal(t, uint64(3), stats.NumTxs)
+	require.Equal(t, uint64(0), stats.NumSenders)
+}
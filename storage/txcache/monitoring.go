@@ -46,6 +46,11 @@ func (cache *TxCache) monitorSelectionStart() *core.StopWatch {
 func (cache *TxCache) monitorSelectionEnd(selection []*WrappedTransaction, stopWatch *core.StopWatch) {
 	stopWatch.Stop("selection")
 	duration := stopWatch.GetMeasurement("selection")
+	cache.appStatusHandler.ObserveDuration(
+		core.MetricTxCacheSelectionDuration,
+		duration,
+		core.MetricLabel{Name: core.MetricLabelCache, Value: cache.name},
+	)
 	numSendersSelected := cache.numSendersSelected.Reset()
 	numSendersWithInitialGap := cache.numSendersWithInitialGap.Reset()
 	numSendersWithMiddleGap := cache.numSendersWithMiddleGap.Reset()
@@ -1,10 +1,13 @@
 package txcache
 
 import (
+	"bytes"
 	"sync"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/atomic"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
@@ -18,6 +21,7 @@ type TxCache struct {
 	config                    ConfigSourceMe
 	evictionMutex             sync.Mutex
 	evictionJournal           evictionJournal
+	numEvictionPasses         atomic.Counter
 	evictionSnapshotOfSenders []*txListForSender
 	isEvictionInProgress      atomic.Flag
 	numSendersSelected        atomic.Counter
@@ -26,6 +30,7 @@ type TxCache struct {
 	numSendersInGracePeriod   atomic.Counter
 	sweepingMutex             sync.Mutex
 	sweepingListOfSenders     []*txListForSender
+	appStatusHandler          core.AppStatusHandler
 }
 
 // NewTxCache creates a new transaction cache
@@ -48,17 +53,29 @@ func NewTxCache(config ConfigSourceMe, txGasHandler TxGasHandler) (*TxCache, err
 	scoreComputerObj := newDefaultScoreComputer(txFeeHelper)
 
 	txCache := &TxCache{
-		name:            config.Name,
-		txListBySender:  newTxListBySenderMap(numChunks, senderConstraintsObj, scoreComputerObj, txGasHandler, txFeeHelper),
-		txByHash:        newTxByHashMap(numChunks),
-		config:          config,
-		evictionJournal: evictionJournal{},
+		name:             config.Name,
+		txListBySender:   newTxListBySenderMap(numChunks, senderConstraintsObj, scoreComputerObj, txGasHandler, txFeeHelper),
+		txByHash:         newTxByHashMap(numChunks),
+		config:           config,
+		evictionJournal:  evictionJournal{},
+		appStatusHandler: statusHandler.NewNilStatusHandler(),
 	}
 
 	txCache.initSweepable()
 	return txCache, nil
 }
 
+// SetAppStatusHandler sets the handler receiving the duration of the transactions selection.
+// It should be called before the cache is used.
+func (cache *TxCache) SetAppStatusHandler(handler core.AppStatusHandler) error {
+	if check.IfNil(handler) {
+		return storage.ErrNilAppStatusHandler
+	}
+
+	cache.appStatusHandler = handler
+	return nil
+}
+
 // AddTx adds a transaction in the cache
 // Eviction happens if maximum capacity is reached
 func (cache *TxCache) AddTx(tx *WrappedTransaction) (ok bool, added bool) {
@@ -86,6 +103,12 @@ func (cache *TxCache) AddTx(tx *WrappedTransaction) (ok bool, added bool) {
 		cache.txByHash.RemoveTxsBulk(evicted)
 	}
 
+	isRejectedReplacement := !addedInBySender && len(evicted) == 1 && bytes.Equal(evicted[0], tx.TxHash)
+	if isRejectedReplacement {
+		log.Trace("TxCache.AddTx(): gas price too low for replacement", "name", cache.name, "tx", tx.TxHash, "sender", tx.Tx.GetSndAddr(), "nonce", tx.Tx.GetNonce())
+		return false, false
+	}
+
 	// The return value "added" is true even if transaction added, but then removed due to limits be sender.
 	// This it to ensure that onAdded() notification is triggered.
 	return true, addedInByHash || addedInBySender
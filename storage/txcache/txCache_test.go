@@ -10,6 +10,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -55,6 +56,10 @@ func Test_NewTxCache(t *testing.T) {
 	badConfig.CountPerSenderThreshold = 0
 	requireErrorOnNewTxCache(t, badConfig, storage.ErrInvalidConfig, "config.CountPerSenderThreshold", txGasHandler)
 
+	badConfig = config
+	badConfig.MinGasPriceIncreasePercentForReplacement = minGasPriceIncreasePercentForReplacementUpperBound + 1
+	requireErrorOnNewTxCache(t, badConfig, storage.ErrInvalidConfig, "config.MinGasPriceIncreasePercentForReplacement", txGasHandler)
+
 	badConfig = config
 	cache, err = NewTxCache(config, nil)
 	require.Nil(t, cache)
@@ -101,6 +106,45 @@ func Test_AddTx(t *testing.T) {
 	require.Equal(t, tx, foundTx)
 }
 
+func Test_AddTx_ReplacesByFee(t *testing.T) {
+	cache := newCacheWithReplaceByFeeToTest(10)
+
+	ok, added := cache.AddTx(createTxWithParams([]byte("tx-alice-1"), "alice", 1, 128, 50000, 100))
+	require.True(t, ok)
+	require.True(t, added)
+	cache.AddTx(createTxWithParams([]byte("tx-alice-2"), "alice", 2, 128, 50000, 100))
+
+	// Gas price not high enough, the replacement is rejected
+	ok, added = cache.AddTx(createTxWithParams([]byte("tx-alice-1-cheap"), "alice", 1, 128, 50000, 109))
+	require.False(t, ok)
+	require.False(t, added)
+	require.False(t, cache.Has([]byte("tx-alice-1-cheap")))
+	require.Equal(t, []string{"tx-alice-1", "tx-alice-2"}, cache.getHashesForSender("alice"))
+	require.True(t, cache.areInternalMapsConsistent())
+
+	// Gas price high enough, the old transaction is evicted
+	ok, added = cache.AddTx(createTxWithParams([]byte("tx-alice-1-bumped"), "alice", 1, 128, 50000, 110))
+	require.True(t, ok)
+	require.True(t, added)
+	require.False(t, cache.Has([]byte("tx-alice-1")))
+	require.True(t, cache.Has([]byte("tx-alice-1-bumped")))
+	require.Equal(t, []string{"tx-alice-1-bumped", "tx-alice-2"}, cache.getHashesForSender("alice"))
+	require.Equal(t, uint64(2), cache.CountTx())
+	require.True(t, cache.areInternalMapsConsistent())
+}
+
+func Test_AddTx_KeepsSameNonceTransactionsWhenReplaceByFeeDisabled(t *testing.T) {
+	cache := newUnconstrainedCacheToTest()
+
+	cache.AddTx(createTxWithParams([]byte("tx-alice-1"), "alice", 1, 128, 50000, 100))
+	ok, added := cache.AddTx(createTxWithParams([]byte("tx-alice-1-bumped"), "alice", 1, 128, 50000, 200))
+	require.True(t, ok)
+	require.True(t, added)
+	require.True(t, cache.Has([]byte("tx-alice-1")))
+	require.True(t, cache.Has([]byte("tx-alice-1-bumped")))
+	require.Equal(t, uint64(2), cache.CountTx())
+}
+
 func Test_AddNilTx_DoesNothing(t *testing.T) {
 	cache := newUnconstrainedCacheToTest()
 
@@ -272,6 +316,24 @@ func Test_SelectTransactions_Dummy(t *testing.T) {
 	require.Len(t, sorted, 8)
 }
 
+func Test_SetAppStatusHandler(t *testing.T) {
+	cache := newUnconstrainedCacheToTest()
+
+	err := cache.SetAppStatusHandler(nil)
+	require.Equal(t, storage.ErrNilAppStatusHandler, err)
+
+	statusMetrics := statusHandler.NewStatusMetrics()
+	err = cache.SetAppStatusHandler(statusMetrics)
+	require.Nil(t, err)
+
+	cache.AddTx(createTx([]byte("hash-alice-1"), "alice", 1))
+	sorted := cache.SelectTransactions(10, 2)
+	require.Len(t, sorted, 1)
+
+	expectedOutput := fmt.Sprintf("%s_count{shard=\"0\",cache=\"test\"} 1\n", core.MetricTxCacheSelectionDuration)
+	require.Contains(t, statusMetrics.StatusMetricsWithoutP2PPrometheusString(), expectedOutput)
+}
+
 func Test_SelectTransactions_BreaksAtNonceGaps(t *testing.T) {
 	cache := newUnconstrainedCacheToTest()
 
@@ -593,3 +655,19 @@ func newCacheToTest(numBytesPerSenderThreshold uint32, countPerSenderThreshold u
 
 	return cache
 }
+
+func newCacheWithReplaceByFeeToTest(minGasPriceIncreasePercent uint32) *TxCache {
+	txGasHandler, _ := dummyParams()
+	cache, err := NewTxCache(ConfigSourceMe{
+		Name:                                     "test",
+		NumChunks:                                16,
+		NumBytesPerSenderThreshold:               maxNumBytesPerSenderUpperBound,
+		CountPerSenderThreshold:                  math.MaxUint32,
+		MinGasPriceIncreasePercentForReplacement: minGasPriceIncreasePercent,
+	}, txGasHandler)
+	if err != nil {
+		panic(fmt.Sprintf("newCacheWithReplaceByFeeToTest(): %s", err))
+	}
+
+	return cache
+}
@@ -3,6 +3,7 @@ package txcache
 import (
 	"bytes"
 	"container/list"
+	"math/big"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/core/atomic"
@@ -54,6 +55,13 @@ func (listForSender *txListForSender) AddTx(tx *WrappedTransaction, gasHandler T
 	listForSender.mutex.Lock()
 	defer listForSender.mutex.Unlock()
 
+	if listForSender.isReplaceByFeeEnabled() {
+		element := listForSender.findListElementWithNonce(tx.Tx.GetNonce())
+		if element != nil {
+			return listForSender.replaceTx(element, tx, gasHandler, txFeeHelper)
+		}
+	}
+
 	insertionPlace, err := listForSender.findInsertionPlace(tx)
 	if err != nil {
 		return false, nil
@@ -71,6 +79,48 @@ func (listForSender *txListForSender) AddTx(tx *WrappedTransaction, gasHandler T
 	return true, evicted
 }
 
+func (listForSender *txListForSender) isReplaceByFeeEnabled() bool {
+	return listForSender.constraints.minGasPriceIncreasePercentForReplacement > 0
+}
+
+// replaceTx applies the replace-by-fee rule on a transaction having the same nonce as the one held by the given list
+// element. The incoming transaction takes the place of the existing one only if its gas price is high enough, otherwise
+// it is rejected. Cancelling a transaction is done the same way, by sending a transaction with the same nonce, which
+// moves no value, and has a higher gas price. The returned hashes are the ones of the replaced or rejected transaction.
+// This function should only be used in critical section (listForSender.mutex)
+func (listForSender *txListForSender) replaceTx(
+	element *list.Element,
+	incomingTx *WrappedTransaction,
+	gasHandler TxGasHandler,
+	txFeeHelper feeHelper,
+) (bool, [][]byte) {
+	existingTx := element.Value.(*WrappedTransaction)
+	if incomingTx.sameAs(existingTx) {
+		return false, nil
+	}
+	if !listForSender.isGasPriceHighEnoughForReplacement(existingTx, incomingTx) {
+		return false, [][]byte{incomingTx.TxHash}
+	}
+
+	// The list element is reused, so that a selection in progress is not disturbed by the replacement
+	listForSender.onRemovedListElement(element)
+	element.Value = incomingTx
+	listForSender.onAddedTransaction(incomingTx, gasHandler, txFeeHelper)
+	evicted := append([][]byte{existingTx.TxHash}, listForSender.applySizeConstraints()...)
+	listForSender.triggerScoreChange()
+	return true, evicted
+}
+
+func (listForSender *txListForSender) isGasPriceHighEnoughForReplacement(existingTx *WrappedTransaction, incomingTx *WrappedTransaction) bool {
+	percent := uint64(listForSender.constraints.minGasPriceIncreasePercentForReplacement)
+	minGasPrice := big.NewInt(0).SetUint64(existingTx.Tx.GetGasPrice())
+	minGasPrice.Mul(minGasPrice, big.NewInt(0).SetUint64(100+percent))
+	incomingGasPrice := big.NewInt(0).SetUint64(incomingTx.Tx.GetGasPrice())
+	incomingGasPrice.Mul(incomingGasPrice, big.NewInt(100))
+
+	return incomingGasPrice.Cmp(minGasPrice) >= 0
+}
+
 // This function should only be used in critical section (listForSender.mutex)
 func (listForSender *txListForSender) applySizeConstraints() [][]byte {
 	evictedTxHashes := make([][]byte, 0)
@@ -199,6 +249,25 @@ func (listForSender *txListForSender) findListElementWithTx(txToFind *WrappedTra
 	return nil
 }
 
+// This function should only be used in critical section (listForSender.mutex)
+func (listForSender *txListForSender) findListElementWithNonce(nonce uint64) *list.Element {
+	for element := listForSender.items.Back(); element != nil; element = element.Prev() {
+		value := element.Value.(*WrappedTransaction)
+		valueNonce := value.Tx.GetNonce()
+
+		if valueNonce == nonce {
+			return element
+		}
+
+		// Optimization: stop search at this point, since the list is sorted by nonce
+		if valueNonce < nonce {
+			break
+		}
+	}
+
+	return nil
+}
+
 // IsEmpty checks whether the list is empty
 func (listForSender *txListForSender) IsEmpty() bool {
 	return listForSender.countTxWithLock() == 0
@@ -284,6 +353,39 @@ func (listForSender *txListForSender) getTxHashes() [][]byte {
 	return result
 }
 
+// getTxs returns a copy of the transactions in the list, sorted by nonce
+func (listForSender *txListForSender) getTxs() []*WrappedTransaction {
+	listForSender.mutex.RLock()
+	defer listForSender.mutex.RUnlock()
+
+	result := make([]*WrappedTransaction, 0, listForSender.countTx())
+
+	for element := listForSender.items.Front(); element != nil; element = element.Next() {
+		value := element.Value.(*WrappedTransaction)
+		result = append(result, value)
+	}
+
+	return result
+}
+
+// getSenderInfo returns the nonce and score details of the sender
+func (listForSender *txListForSender) getSenderInfo() *SenderInfo {
+	listForSender.mutex.RLock()
+	defer listForSender.mutex.RUnlock()
+
+	return &SenderInfo{
+		AccountNonce:        listForSender.accountNonce.Get(),
+		AccountNonceKnown:   listForSender.accountNonceKnown.IsSet(),
+		NumTxs:              listForSender.countTx(),
+		NumBytes:            listForSender.totalBytes.Get(),
+		TotalGas:            listForSender.totalGas.GetUint64(),
+		Score:               listForSender.getLastComputedScore(),
+		NumFailedSelections: listForSender.numFailedSelections.Get(),
+		HasInitialGap:       listForSender.hasInitialGap(),
+		IsInGracePeriod:     listForSender.isInGracePeriod(),
+	}
+}
+
 // This function should only be used in critical section (listForSender.mutex)
 func (listForSender *txListForSender) countTx() uint64 {
 	return uint64(listForSender.items.Len())
@@ -63,6 +63,68 @@ func TestListForSender_AddTx_IgnoresDuplicates(t *testing.T) {
 	require.False(t, added)
 }
 
+func TestListForSender_AddTx_ReplacesByFee(t *testing.T) {
+	list := newListWithReplaceByFeeToTest(10)
+	txGasHandler, txFeeHelper := dummyParams()
+
+	list.AddTx(createTxWithParams([]byte("a"), ".", 1, 128, 42, 100), txGasHandler, txFeeHelper)
+	list.AddTx(createTxWithParams([]byte("b"), ".", 2, 128, 42, 100), txGasHandler, txFeeHelper)
+	list.AddTx(createTxWithParams([]byte("c"), ".", 3, 128, 42, 100), txGasHandler, txFeeHelper)
+
+	// Gas price not high enough
+	added, evicted := list.AddTx(createTxWithParams([]byte("d"), ".", 2, 128, 42, 109), txGasHandler, txFeeHelper)
+	require.False(t, added)
+	require.Equal(t, [][]byte{[]byte("d")}, evicted)
+	require.Equal(t, []string{"a", "b", "c"}, list.getTxHashesAsStrings())
+
+	// Duplicate
+	added, evicted = list.AddTx(createTxWithParams([]byte("b"), ".", 2, 128, 42, 100), txGasHandler, txFeeHelper)
+	require.False(t, added)
+	require.Nil(t, evicted)
+
+	added, evicted = list.AddTx(createTxWithParams([]byte("e"), ".", 2, 256, 42, 110), txGasHandler, txFeeHelper)
+	require.True(t, added)
+	require.Equal(t, [][]byte{[]byte("b")}, evicted)
+	require.Equal(t, []string{"a", "e", "c"}, list.getTxHashesAsStrings())
+	require.Equal(t, int64(128+256+128), list.totalBytes.Get())
+	require.Equal(t, uint64(3), list.countTx())
+
+	// New nonces are still inserted in order
+	added, evicted = list.AddTx(createTxWithParams([]byte("f"), ".", 4, 128, 42, 1), txGasHandler, txFeeHelper)
+	require.True(t, added)
+	require.Len(t, evicted, 0)
+	require.Equal(t, []string{"a", "e", "c", "f"}, list.getTxHashesAsStrings())
+}
+
+func TestListForSender_AddTx_ReplacesByFeeAndAppliesSizeConstraints(t *testing.T) {
+	list := newTxListForSender(".", &senderConstraints{
+		maxNumBytes:                              1024,
+		maxNumTxs:                                math.MaxUint32,
+		minGasPriceIncreasePercentForReplacement: 50,
+	}, func(_ *txListForSender, _ senderScoreParams) {})
+	txGasHandler, txFeeHelper := dummyParams()
+
+	list.AddTx(createTxWithParams([]byte("a"), ".", 1, 512, 42, 100), txGasHandler, txFeeHelper)
+	list.AddTx(createTxWithParams([]byte("b"), ".", 2, 512, 42, 100), txGasHandler, txFeeHelper)
+
+	added, evicted := list.AddTx(createTxWithParams([]byte("c"), ".", 1, 768, 42, 150), txGasHandler, txFeeHelper)
+	require.True(t, added)
+	require.Equal(t, [][]byte{[]byte("a"), []byte("b")}, evicted)
+	require.Equal(t, []string{"c"}, list.getTxHashesAsStrings())
+}
+
+func TestListForSender_isGasPriceHighEnoughForReplacement(t *testing.T) {
+	list := newListWithReplaceByFeeToTest(10)
+	existingTx := createTxWithParams([]byte("a"), ".", 1, 128, 42, math.MaxUint64/2)
+
+	require.False(t, list.isGasPriceHighEnoughForReplacement(existingTx, createTxWithParams([]byte("b"), ".", 1, 128, 42, math.MaxUint64/2)))
+	require.True(t, list.isGasPriceHighEnoughForReplacement(existingTx, createTxWithParams([]byte("b"), ".", 1, 128, 42, math.MaxUint64)))
+
+	existingTx = createTxWithParams([]byte("a"), ".", 1, 128, 42, 1000000000)
+	require.False(t, list.isGasPriceHighEnoughForReplacement(existingTx, createTxWithParams([]byte("b"), ".", 1, 128, 42, 1099999999)))
+	require.True(t, list.isGasPriceHighEnoughForReplacement(existingTx, createTxWithParams([]byte("b"), ".", 1, 128, 42, 1100000000)))
+}
+
 func TestListForSender_AddTx_AppliesSizeConstraintsForNumTransactions(t *testing.T) {
 	list := newListToTest(math.MaxUint32, 3)
 	txGasHandler, txFeeHelper := dummyParams()
@@ -396,6 +458,14 @@ func newUnconstrainedListToTest() *txListForSender {
 	}, func(_ *txListForSender, _ senderScoreParams) {})
 }
 
+func newListWithReplaceByFeeToTest(minGasPriceIncreasePercent uint32) *txListForSender {
+	return newTxListForSender(".", &senderConstraints{
+		maxNumBytes:                              math.MaxUint32,
+		maxNumTxs:                                math.MaxUint32,
+		minGasPriceIncreasePercentForReplacement: minGasPriceIncreasePercent,
+	}, func(_ *txListForSender, _ senderScoreParams) {})
+}
+
 func newListToTest(maxNumBytes uint32, maxNumTxs uint32) *txListForSender {
 	return newTxListForSender(".", &senderConstraints{
 		maxNumBytes: maxNumBytes,
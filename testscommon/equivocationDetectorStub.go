@@ -0,0 +1,20 @@
+package testscommon
+
+import "github.com/ElrondNetwork/elrond-go/consensus"
+
+// EquivocationDetectorStub -
+type EquivocationDetectorStub struct {
+	ProcessConsensusMessageCalled func(cnsMsg *consensus.Message)
+}
+
+// ProcessConsensusMessage -
+func (eds *EquivocationDetectorStub) ProcessConsensusMessage(cnsMsg *consensus.Message) {
+	if eds.ProcessConsensusMessageCalled != nil {
+		eds.ProcessConsensusMessageCalled(cnsMsg)
+	}
+}
+
+// IsInterfaceNil -
+func (eds *EquivocationDetectorStub) IsInterfaceNil() bool {
+	return eds == nil
+}
@@ -146,7 +146,15 @@ func (sm *StorerMock) GetFromEpochWithMarshalizer(key []byte, epoch uint32, obj
 
 // SearchFirst -
 func (sm *StorerMock) SearchFirst(key []byte) ([]byte, error) {
-	return sm.Get(key)
+	currentEpoch := sm.currentEpoch.Get()
+	for epoch := int64(currentEpoch); epoch >= 0; epoch-- {
+		value, err := sm.GetFromEpoch(key, uint32(epoch))
+		if err == nil {
+			return value, nil
+		}
+	}
+
+	return nil, sm.newErrNotFound(key, currentEpoch)
 }
 
 // Close -
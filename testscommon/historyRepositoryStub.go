@@ -9,11 +9,13 @@ import (
 
 // HistoryRepositoryStub -
 type HistoryRepositoryStub struct {
-	RecordBlockCalled                  func(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler, scrsPool map[string]data.TransactionHandler, receipts map[string]data.TransactionHandler) error
+	RecordBlockCalled                  func(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler, txsPool map[string]data.TransactionHandler, scrsPool map[string]data.TransactionHandler, receipts map[string]data.TransactionHandler) error
 	OnNotarizedBlocksCalled            func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)
 	GetMiniblockMetadataByTxHashCalled func(hash []byte) (*dblookupext.MiniblockMetadata, error)
 	GetEpochByHashCalled               func(hash []byte) (uint32, error)
 	GetEventsHashesByTxHashCalled      func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error)
+	GetTransactionsByAddressCalled     func(address []byte, cursor []byte, limit int) (*dblookupext.TransactionsByAddressPage, error)
+	GetLogEventsCalled                 func(query *dblookupext.LogEventsQuery, cursor []byte, limit int) (*dblookupext.LogEventsPage, error)
 	IsEnabledCalled                    func() bool
 }
 
@@ -22,11 +24,12 @@ func (hp *HistoryRepositoryStub) RecordBlock(
 	blockHeaderHash []byte,
 	blockHeader data.HeaderHandler,
 	blockBody data.BodyHandler,
+	txsPool map[string]data.TransactionHandler,
 	scrsPool map[string]data.TransactionHandler,
 	receipts map[string]data.TransactionHandler,
 ) error {
 	if hp.RecordBlockCalled != nil {
-		return hp.RecordBlockCalled(blockHeaderHash, blockHeader, blockBody, scrsPool, receipts)
+		return hp.RecordBlockCalled(blockHeaderHash, blockHeader, blockBody, txsPool, scrsPool, receipts)
 	}
 	return nil
 }
@@ -67,6 +70,22 @@ func (hp *HistoryRepositoryStub) GetResultsHashesByTxHash(hash []byte, epoch uin
 	return nil, nil
 }
 
+// GetTransactionsByAddress -
+func (hp *HistoryRepositoryStub) GetTransactionsByAddress(address []byte, cursor []byte, limit int) (*dblookupext.TransactionsByAddressPage, error) {
+	if hp.GetTransactionsByAddressCalled != nil {
+		return hp.GetTransactionsByAddressCalled(address, cursor, limit)
+	}
+	return &dblookupext.TransactionsByAddressPage{}, nil
+}
+
+// GetLogEvents -
+func (hp *HistoryRepositoryStub) GetLogEvents(query *dblookupext.LogEventsQuery, cursor []byte, limit int) (*dblookupext.LogEventsPage, error) {
+	if hp.GetLogEventsCalled != nil {
+		return hp.GetLogEventsCalled(query, cursor, limit)
+	}
+	return &dblookupext.LogEventsPage{}, nil
+}
+
 // IsInterfaceNil -
 func (hp *HistoryRepositoryStub) IsInterfaceNil() bool {
 	return hp == nil
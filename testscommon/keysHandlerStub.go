@@ -0,0 +1,60 @@
+package testscommon
+
+import "github.com/ElrondNetwork/elrond-go/crypto"
+
+// KeysHandlerStub -
+type KeysHandlerStub struct {
+	GetPrivateKeyCalled        func(pkBytes []byte) (crypto.PrivateKey, error)
+	IsKeyManagedCalled         func(pkBytes []byte) bool
+	ManagedKeysCalled          func() [][]byte
+	CanBroadcastCalled         func(pkBytes []byte) bool
+	ResetRoundBroadcastsCalled func()
+}
+
+// GetPrivateKey -
+func (khs *KeysHandlerStub) GetPrivateKey(pkBytes []byte) (crypto.PrivateKey, error) {
+	if khs.GetPrivateKeyCalled != nil {
+		return khs.GetPrivateKeyCalled(pkBytes)
+	}
+
+	return nil, nil
+}
+
+// IsKeyManaged -
+func (khs *KeysHandlerStub) IsKeyManaged(pkBytes []byte) bool {
+	if khs.IsKeyManagedCalled != nil {
+		return khs.IsKeyManagedCalled(pkBytes)
+	}
+
+	return false
+}
+
+// ManagedKeys -
+func (khs *KeysHandlerStub) ManagedKeys() [][]byte {
+	if khs.ManagedKeysCalled != nil {
+		return khs.ManagedKeysCalled()
+	}
+
+	return nil
+}
+
+// CanBroadcast -
+func (khs *KeysHandlerStub) CanBroadcast(pkBytes []byte) bool {
+	if khs.CanBroadcastCalled != nil {
+		return khs.CanBroadcastCalled(pkBytes)
+	}
+
+	return true
+}
+
+// ResetRoundBroadcasts -
+func (khs *KeysHandlerStub) ResetRoundBroadcasts() {
+	if khs.ResetRoundBroadcastsCalled != nil {
+		khs.ResetRoundBroadcastsCalled()
+	}
+}
+
+// IsInterfaceNil -
+func (khs *KeysHandlerStub) IsInterfaceNil() bool {
+	return khs == nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/dataPool/headersCache"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/shardedData"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/txpool"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/testscommon/txcachemocks"
 )
@@ -24,6 +25,7 @@ func CreateTxPool(numShards uint32, selfShard uint32) (dataRetriever.ShardedData
 			},
 			NumberOfShards: numShards,
 			SelfShardID:    selfShard,
+			StatusHandler:  statusHandler.NewNilStatusHandler(),
 			TxGasHandler: &txcachemocks.TxGasHandlerMock{
 				MinimumGasMove:       50000,
 				MinimumGasPrice:      200000000000,
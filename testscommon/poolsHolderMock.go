@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/dataPool/headersCache"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/shardedData"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/txpool"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/testscommon/txcachemocks"
@@ -45,6 +46,7 @@ func NewPoolsHolderMock() *PoolsHolderMock {
 				GasProcessingDivisor: 100,
 			},
 			NumberOfShards: 1,
+			StatusHandler:  statusHandler.NewNilStatusHandler(),
 		},
 	)
 	panicIfError("NewPoolsHolderMock", err)
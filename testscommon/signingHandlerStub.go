@@ -0,0 +1,50 @@
+package testscommon
+
+// SigningHandlerStub -
+type SigningHandlerStub struct {
+	SignRandSeedCalled         func(pubKey []byte, prevRandSeed []byte, marshalizedHeader []byte) ([]byte, error)
+	SignBlockHeaderCalled      func(pubKey []byte, marshalizedHeader []byte) ([]byte, error)
+	CreateSignatureShareCalled func(pubKey []byte, headerHash []byte, marshalizedHeader []byte) ([]byte, error)
+	SignPeerMessageCalled      func(pubKey []byte, message []byte) ([]byte, error)
+}
+
+// SignRandSeed -
+func (shs *SigningHandlerStub) SignRandSeed(pubKey []byte, prevRandSeed []byte, marshalizedHeader []byte) ([]byte, error) {
+	if shs.SignRandSeedCalled != nil {
+		return shs.SignRandSeedCalled(pubKey, prevRandSeed, marshalizedHeader)
+	}
+
+	return make([]byte, 0), nil
+}
+
+// SignBlockHeader -
+func (shs *SigningHandlerStub) SignBlockHeader(pubKey []byte, marshalizedHeader []byte) ([]byte, error) {
+	if shs.SignBlockHeaderCalled != nil {
+		return shs.SignBlockHeaderCalled(pubKey, marshalizedHeader)
+	}
+
+	return make([]byte, 0), nil
+}
+
+// CreateSignatureShare -
+func (shs *SigningHandlerStub) CreateSignatureShare(pubKey []byte, headerHash []byte, marshalizedHeader []byte) ([]byte, error) {
+	if shs.CreateSignatureShareCalled != nil {
+		return shs.CreateSignatureShareCalled(pubKey, headerHash, marshalizedHeader)
+	}
+
+	return make([]byte, 0), nil
+}
+
+// SignPeerMessage -
+func (shs *SigningHandlerStub) SignPeerMessage(pubKey []byte, message []byte) ([]byte, error) {
+	if shs.SignPeerMessageCalled != nil {
+		return shs.SignPeerMessageCalled(pubKey, message)
+	}
+
+	return make([]byte, 0), nil
+}
+
+// IsInterfaceNil -
+func (shs *SigningHandlerStub) IsInterfaceNil() bool {
+	return shs == nil
+}
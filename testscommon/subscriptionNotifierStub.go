@@ -0,0 +1,31 @@
+package testscommon
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
+// SubscriptionNotifierStub -
+type SubscriptionNotifierStub struct {
+	NotifyCommittedBlockCalled func(headerHash []byte, header data.HeaderHandler, body data.BodyHandler)
+	NotifyLogCalled            func(txHash []byte, txLog *transaction.Log)
+}
+
+// NotifyCommittedBlock -
+func (sns *SubscriptionNotifierStub) NotifyCommittedBlock(headerHash []byte, header data.HeaderHandler, body data.BodyHandler) {
+	if sns.NotifyCommittedBlockCalled != nil {
+		sns.NotifyCommittedBlockCalled(headerHash, header, body)
+	}
+}
+
+// NotifyLog -
+func (sns *SubscriptionNotifierStub) NotifyLog(txHash []byte, txLog *transaction.Log) {
+	if sns.NotifyLogCalled != nil {
+		sns.NotifyLogCalled(txHash, txLog)
+	}
+}
+
+// IsInterfaceNil -
+func (sns *SubscriptionNotifierStub) IsInterfaceNil() bool {
+	return sns == nil
+}
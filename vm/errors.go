@@ -164,6 +164,9 @@ var ErrNegativeBleedPercentagePerRound = errors.New("negative bleed percentage p
 // ErrNegativeMaximumPercentageToBleed signals that negative maximum percentage to bleed has been provided
 var ErrNegativeMaximumPercentageToBleed = errors.New("negative maximum percentage to bleed")
 
+// ErrInvalidSlashingPercentage signals that an invalid slashing percentage has been provided
+var ErrInvalidSlashingPercentage = errors.New("invalid slashing percentage")
+
 // ErrInvalidMaxNumberOfNodes signals that invalid number of max number of nodes has been provided
 var ErrInvalidMaxNumberOfNodes = errors.New("invalid number of max number of nodes")
 
@@ -229,3 +232,12 @@ var ErrOwnerCannotUnDelegate = errors.New("owner cannot undelegate, contract sti
 
 // ErrNotEnoughInitialOwnerFunds signals that not enough initial owner funds has been provided
 var ErrNotEnoughInitialOwnerFunds = errors.New("not enough initial owner funds")
+
+// ErrVotingPowerAlreadyUsed signals that the voting power of a validator was already used for the given proposal
+var ErrVotingPowerAlreadyUsed = errors.New("voting power already used for this proposal")
+
+// ErrNotEnoughVotingPower signals that not enough voting power is available for the requested operation
+var ErrNotEnoughVotingPower = errors.New("not enough voting power")
+
+// ErrVotePowerNotDelegated signals that no vote power was delegated to the given address
+var ErrVotePowerNotDelegated = errors.New("vote power was not delegated to the given address")
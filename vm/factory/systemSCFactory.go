@@ -1,6 +1,8 @@
 package factory
 
 import (
+	"fmt"
+
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
@@ -165,6 +167,16 @@ func (scf *systemSCFactory) createStakingContract() (vm.SystemSmartContract, err
 }
 
 func (scf *systemSCFactory) createValidatorContract() (vm.SystemSmartContract, error) {
+	var slashedFundsDestination []byte
+	destinationAddress := scf.systemSCConfig.StakingSystemSCConfig.SlashedFundsDestinationAddress
+	if len(destinationAddress) > 0 {
+		var err error
+		slashedFundsDestination, err = scf.addressPubKeyConverter.Decode(destinationAddress)
+		if err != nil {
+			return nil, fmt.Errorf("%w for SlashedFundsDestinationAddress", err)
+		}
+	}
+
 	args := systemSmartContracts.ArgsValidatorSmartContract{
 		Eei:                scf.systemEI,
 		SigVerifier:        scf.sigVerifier,
@@ -177,6 +189,8 @@ func (scf *systemSCFactory) createValidatorContract() (vm.SystemSmartContract, e
 		GenesisTotalSupply: scf.economics.GenesisTotalSupply(),
 		EpochNotifier:      scf.epochNotifier,
 		MinDeposit:         scf.systemSCConfig.DelegationManagerSystemSCConfig.MinCreationDeposit,
+
+		SlashedFundsDestinationAddress: slashedFundsDestination,
 	}
 	validatorSC, err := systemSmartContracts.NewValidatorSmartContract(args)
 	return validatorSC, err
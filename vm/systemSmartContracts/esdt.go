@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math/big"
+	"strings"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/config"
@@ -52,6 +53,8 @@ type esdt struct {
 	hasher                 hashing.Hasher
 	enabledEpoch           uint32
 	flagEnabled            atomic.Flag
+	nftEnableEpoch         uint32
+	flagNFT                atomic.Flag
 	mutExecution           sync.RWMutex
 	addressPubKeyConverter core.PubkeyConverter
 }
@@ -101,6 +104,7 @@ func NewESDTSmartContract(args ArgsNewESDTSmartContract) (*esdt, error) {
 		hasher:                 args.Hasher,
 		marshalizer:            args.Marshalizer,
 		enabledEpoch:           args.ESDTSCConfig.EnabledEpoch,
+		nftEnableEpoch:         args.ESDTSCConfig.NFTEnableEpoch,
 		endOfEpochSCAddress:    args.EndOfEpochSCAddress,
 		addressPubKeyConverter: args.AddressPubKeyConverter,
 	}
@@ -126,10 +130,19 @@ func (e *esdt) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 		e.eei.AddReturnMessage("ESDT SC disabled")
 		return vmcommon.UserError
 	}
+	if isNFTFunction(args.Function) && !e.flagNFT.IsSet() {
+		// backward compatibility
+		e.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.FunctionNotFound
+	}
 
 	switch args.Function {
 	case "issue":
 		return e.issue(args)
+	case "issueNonFungible":
+		return e.issueNonFungible(args, []byte(core.NonFungibleESDT))
+	case "issueSemiFungible":
+		return e.issueNonFungible(args, []byte(core.SemiFungibleESDT))
 	case core.BuiltInFunctionESDTBurn:
 		return e.burn(args)
 	case "mint":
@@ -156,6 +169,12 @@ func (e *esdt) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 		return e.getAllESDTTokens(args)
 	case "getTokenProperties":
 		return e.getTokenProperties(args)
+	case "setSpecialRole":
+		return e.setSpecialRole(args)
+	case "unSetSpecialRole":
+		return e.unSetSpecialRole(args)
+	case "getSpecialRoles":
+		return e.getSpecialRoles(args)
 	}
 
 	e.eei.AddReturnMessage("invalid method to call")
@@ -211,6 +230,82 @@ func (e *esdt) issue(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	return vmcommon.Ok
 }
 
+// format: issueNonFungible@tokenName@ticker@optional-list-of-properties
+func (e *esdt) issueNonFungible(args *vmcommon.ContractCallInput, tokenType []byte) vmcommon.ReturnCode {
+	if len(args.Arguments) < 2 {
+		e.eei.AddReturnMessage("not enough arguments")
+		return vmcommon.FunctionWrongSignature
+	}
+	err := e.eei.UseGas(e.gasCost.MetaChainSystemSCsCost.ESDTIssue)
+	if err != nil {
+		e.eei.AddReturnMessage("not enough gas")
+		return vmcommon.OutOfGas
+	}
+	esdtConfig, err := e.getESDTConfig()
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if len(args.Arguments[0]) < minLengthForTokenName ||
+		len(args.Arguments[0]) > int(esdtConfig.MaxTokenNameLength) {
+		e.eei.AddReturnMessage("token name length not in parameters")
+		return vmcommon.FunctionWrongSignature
+	}
+	if args.CallValue.Cmp(esdtConfig.BaseIssuingCost) != 0 {
+		e.eei.AddReturnMessage("callValue not equals with baseIssuingCost")
+		return vmcommon.OutOfFunds
+	}
+
+	tokenIdentifier, err := e.issueNonFungibleToken(args.CallerAddr, args.Arguments, tokenType)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	e.eei.Finish(tokenIdentifier)
+
+	return vmcommon.Ok
+}
+
+func (e *esdt) issueNonFungibleToken(owner []byte, arguments [][]byte, tokenType []byte) ([]byte, error) {
+	tokenName := arguments[0]
+	if !isTokenNameHumanReadable(tokenName) {
+		return nil, vm.ErrTokenNameNotHumanReadable
+	}
+
+	tickerName := arguments[1]
+	if !isTickerValid(tickerName) {
+		return nil, vm.ErrTickerNameNotValid
+	}
+
+	tokenIdentifier, err := e.createNewTokenIdentifier(owner, tickerName)
+	if err != nil {
+		return nil, err
+	}
+
+	newESDTToken := &ESDTData{
+		OwnerAddress: owner,
+		TokenName:    tokenName,
+		TickerName:   tickerName,
+		TokenType:    tokenType,
+		MintedValue:  big.NewInt(0),
+		BurntValue:   big.NewInt(0),
+		Upgradable:   true,
+	}
+	err = upgradeProperties(newESDTToken, arguments[2:])
+	if err != nil {
+		return nil, err
+	}
+	err = e.saveToken(tokenIdentifier, newESDTToken)
+	if err != nil {
+		return nil, err
+	}
+
+	e.addToIssuedTokens(string(tokenIdentifier))
+
+	return tokenIdentifier, nil
+}
+
 func isTickerValid(tickerName []byte) bool {
 	if len(tickerName) < minLengthForTickerName || len(tickerName) > maxLengthForTickerName {
 		return false
@@ -440,6 +535,10 @@ func (e *esdt) mint(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 		e.eei.AddReturnMessage("negative or zero mint value")
 		return vmcommon.UserError
 	}
+	if !isFungible(token) {
+		e.eei.AddReturnMessage("only fungible tokens can be minted")
+		return vmcommon.UserError
+	}
 	if !token.Mintable {
 		e.eei.AddReturnMessage("token is not mintable")
 		return vmcommon.UserError
@@ -701,10 +800,210 @@ func (e *esdt) getTokenProperties(args *vmcommon.ContractCallInput) vmcommon.Ret
 	e.eei.Finish([]byte("CanPause-" + getStringFromBool(esdtToken.CanPause)))
 	e.eei.Finish([]byte("CanFreeze-" + getStringFromBool(esdtToken.CanFreeze)))
 	e.eei.Finish([]byte("CanWipe-" + getStringFromBool(esdtToken.CanWipe)))
+	if e.flagNFT.IsSet() {
+		e.eei.Finish([]byte("TokenType-" + string(getTokenType(esdtToken))))
+	}
+
+	return vmcommon.Ok
+}
+
+// format: setSpecialRole@tokenIdentifier@address@role1@role2...
+func (e *esdt) setSpecialRole(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	token, returnCode := e.checkSpecialRoleArguments(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	address := args.Arguments[1]
+	roles := args.Arguments[2:]
+	for _, role := range roles {
+		if !isRoleAllowedForToken(token, role) {
+			e.eei.AddReturnMessage(fmt.Sprintf("role %s is not allowed for this token", role))
+			return vmcommon.UserError
+		}
+	}
+
+	addressRoles := getOrAddRolesForAddress(token, address)
+	for _, role := range roles {
+		if !containsRole(addressRoles.Roles, role) {
+			addressRoles.Roles = append(addressRoles.Roles, role)
+		}
+	}
+
+	return e.saveTokenAndSendRoles(args, token, core.BuiltInFunctionESDTSetRole)
+}
+
+// format: unSetSpecialRole@tokenIdentifier@address@role1@role2...
+func (e *esdt) unSetSpecialRole(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	token, returnCode := e.checkSpecialRoleArguments(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	address := args.Arguments[1]
+	roles := args.Arguments[2:]
+	addressRoles := getOrAddRolesForAddress(token, address)
+	for _, role := range roles {
+		if !containsRole(addressRoles.Roles, role) {
+			e.eei.AddReturnMessage(fmt.Sprintf("address does not have the role %s", role))
+			return vmcommon.UserError
+		}
+	}
+
+	remainingRoles := make([][]byte, 0, len(addressRoles.Roles))
+	for _, role := range addressRoles.Roles {
+		if !containsRole(roles, role) {
+			remainingRoles = append(remainingRoles, role)
+		}
+	}
+	addressRoles.Roles = remainingRoles
+	removeAddressesWithoutRoles(token)
+
+	return e.saveTokenAndSendRoles(args, token, core.BuiltInFunctionESDTUnSetRole)
+}
+
+func (e *esdt) checkSpecialRoleArguments(args *vmcommon.ContractCallInput) (*ESDTData, vmcommon.ReturnCode) {
+	if len(args.Arguments) < 3 {
+		e.eei.AddReturnMessage("not enough arguments")
+		return nil, vmcommon.FunctionWrongSignature
+	}
+	token, returnCode := e.basicOwnershipChecks(args)
+	if returnCode != vmcommon.Ok {
+		return nil, returnCode
+	}
+	if isFungible(token) {
+		e.eei.AddReturnMessage("special roles are allowed for non-fungible and semi-fungible tokens only")
+		return nil, vmcommon.UserError
+	}
+	if !e.isAddressValid(args.Arguments[1]) {
+		e.eei.AddReturnMessage("invalid address to set/unset special roles")
+		return nil, vmcommon.UserError
+	}
+
+	return token, vmcommon.Ok
+}
+
+func (e *esdt) saveTokenAndSendRoles(args *vmcommon.ContractCallInput, token *ESDTData, builtInFunc string) vmcommon.ReturnCode {
+	err := e.saveToken(args.Arguments[0], token)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	esdtTransferData := builtInFunc + "@" + hex.EncodeToString(args.Arguments[0])
+	for _, role := range args.Arguments[2:] {
+		esdtTransferData += "@" + hex.EncodeToString(role)
+	}
+	err = e.eei.Transfer(args.Arguments[1], e.eSDTSCAddress, big.NewInt(0), []byte(esdtTransferData), 0)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (e *esdt) getSpecialRoles(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		e.eei.AddReturnMessage("callValue must be 0")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 1 {
+		e.eei.AddReturnMessage(vm.ErrInvalidNumOfArguments.Error())
+		return vmcommon.UserError
+	}
+	err := e.eei.UseGas(e.gasCost.MetaChainSystemSCsCost.ESDTOperations)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.OutOfGas
+	}
+
+	esdtToken, err := e.getExistingToken(args.Arguments[0])
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	for _, addressRoles := range esdtToken.SpecialRoles {
+		roles := make([]string, 0, len(addressRoles.Roles))
+		for _, role := range addressRoles.Roles {
+			roles = append(roles, string(role))
+		}
+
+		encodedAddress := e.addressPubKeyConverter.Encode(addressRoles.Address)
+		e.eei.Finish([]byte(encodedAddress + ":" + strings.Join(roles, ",")))
+	}
 
 	return vmcommon.Ok
 }
 
+func isNFTFunction(function string) bool {
+	switch function {
+	case "issueNonFungible", "issueSemiFungible", "setSpecialRole", "unSetSpecialRole", "getSpecialRoles":
+		return true
+	}
+
+	return false
+}
+
+// getTokenType returns the type of the token, the fungible tokens being saved without a type
+func getTokenType(token *ESDTData) []byte {
+	if len(token.TokenType) == 0 {
+		return []byte(core.FungibleESDT)
+	}
+
+	return token.TokenType
+}
+
+func isFungible(token *ESDTData) bool {
+	return bytes.Equal(getTokenType(token), []byte(core.FungibleESDT))
+}
+
+func isRoleAllowedForToken(token *ESDTData, role []byte) bool {
+	switch string(role) {
+	case core.ESDTRoleNFTCreate, core.ESDTRoleNFTBurn:
+		return true
+	case core.ESDTRoleNFTAddQuantity:
+		return bytes.Equal(token.TokenType, []byte(core.SemiFungibleESDT))
+	}
+
+	return false
+}
+
+func getOrAddRolesForAddress(token *ESDTData, address []byte) *ESDTRoles {
+	for _, addressRoles := range token.SpecialRoles {
+		if bytes.Equal(addressRoles.Address, address) {
+			return addressRoles
+		}
+	}
+
+	addressRoles := &ESDTRoles{Address: address, Roles: make([][]byte, 0)}
+	token.SpecialRoles = append(token.SpecialRoles, addressRoles)
+
+	return addressRoles
+}
+
+func removeAddressesWithoutRoles(token *ESDTData) {
+	specialRoles := make([]*ESDTRoles, 0, len(token.SpecialRoles))
+	for _, addressRoles := range token.SpecialRoles {
+		if len(addressRoles.Roles) > 0 {
+			specialRoles = append(specialRoles, addressRoles)
+		}
+	}
+
+	token.SpecialRoles = specialRoles
+}
+
+func containsRole(roles [][]byte, role []byte) bool {
+	for _, existingRole := range roles {
+		if bytes.Equal(existingRole, role) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (e *esdt) addToIssuedTokens(newToken string) {
 	allTokens := e.eei.GetStorage([]byte(allIssuedTokens))
 	if len(allTokens) == 0 {
@@ -846,6 +1145,9 @@ func (e *esdt) saveESDTConfig(esdtConfig *ESDTConfig) error {
 func (e *esdt) EpochConfirmed(epoch uint32) {
 	e.flagEnabled.Toggle(epoch >= e.enabledEpoch)
 	log.Debug("esdt contract", "enabled", e.flagEnabled.IsSet())
+
+	e.flagNFT.Toggle(epoch >= e.nftEnableEpoch)
+	log.Debug("esdt contract: non fungible tokens", "enabled", e.flagNFT.IsSet())
 }
 
 // SetNewGasCost is called whenever a gas cost was changed
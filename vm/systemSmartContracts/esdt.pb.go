@@ -43,6 +43,8 @@ type ESDTData struct {
 	MintedValue    *math_big.Int `protobuf:"bytes,12,opt,name=MintedValue,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"MintedValue"`
 	BurntValue     *math_big.Int `protobuf:"bytes,13,opt,name=BurntValue,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"BurntValue"`
 	NumDecimals    uint32        `protobuf:"varint,14,opt,name=NumDecimals,proto3" json:"NumDecimals"`
+	TokenType      []byte        `protobuf:"bytes,15,opt,name=TokenType,proto3" json:"TokenType"`
+	SpecialRoles   []*ESDTRoles  `protobuf:"bytes,16,rep,name=SpecialRoles,proto3" json:"SpecialRoles"`
 }
 
 func (m *ESDTData) Reset()      { *m = ESDTData{} }
@@ -171,6 +173,67 @@ func (m *ESDTData) GetNumDecimals() uint32 {
 	return 0
 }
 
+func (m *ESDTData) GetTokenType() []byte {
+	if m != nil {
+		return m.TokenType
+	}
+	return nil
+}
+
+func (m *ESDTData) GetSpecialRoles() []*ESDTRoles {
+	if m != nil {
+		return m.SpecialRoles
+	}
+	return nil
+}
+
+type ESDTRoles struct {
+	Address []byte   `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address"`
+	Roles   [][]byte `protobuf:"bytes,2,rep,name=Roles,proto3" json:"Roles"`
+}
+
+func (m *ESDTRoles) Reset()      { *m = ESDTRoles{} }
+func (*ESDTRoles) ProtoMessage() {}
+func (*ESDTRoles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e413e402abc6a34c, []int{1}
+}
+func (m *ESDTRoles) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ESDTRoles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ESDTRoles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ESDTRoles.Merge(m, src)
+}
+func (m *ESDTRoles) XXX_Size() int {
+	return m.Size()
+}
+func (m *ESDTRoles) XXX_DiscardUnknown() {
+	xxx_messageInfo_ESDTRoles.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ESDTRoles proto.InternalMessageInfo
+
+func (m *ESDTRoles) GetAddress() []byte {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *ESDTRoles) GetRoles() [][]byte {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
 type ESDTConfig struct {
 	OwnerAddress       []byte        `protobuf:"bytes,1,opt,name=OwnerAddress,proto3" json:"OwnerAddress"`
 	BaseIssuingCost    *math_big.Int `protobuf:"bytes,2,opt,name=BaseIssuingCost,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"BaseIssuingCost"`
@@ -181,7 +244,7 @@ type ESDTConfig struct {
 func (m *ESDTConfig) Reset()      { *m = ESDTConfig{} }
 func (*ESDTConfig) ProtoMessage() {}
 func (*ESDTConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e413e402abc6a34c, []int{1}
+	return fileDescriptor_e413e402abc6a34c, []int{2}
 }
 func (m *ESDTConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -236,52 +299,58 @@ func (m *ESDTConfig) GetMaxTokenNameLength() uint32 {
 
 func init() {
 	proto.RegisterType((*ESDTData)(nil), "proto.ESDTData")
+	proto.RegisterType((*ESDTRoles)(nil), "proto.ESDTRoles")
 	proto.RegisterType((*ESDTConfig)(nil), "proto.ESDTConfig")
 }
 
 func init() { proto.RegisterFile("esdt.proto", fileDescriptor_e413e402abc6a34c) }
 
 var fileDescriptor_e413e402abc6a34c = []byte{
-	// 616 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x10, 0xc7, 0xb3, 0xfd, 0xf5, 0x4f, 0xba, 0x49, 0xda, 0x9f, 0x56, 0x08, 0x59, 0x1c, 0xd6, 0x55,
-	0x25, 0xa4, 0x48, 0xa8, 0x89, 0x10, 0x9c, 0xe0, 0x54, 0xbb, 0xad, 0x14, 0x89, 0x06, 0xb4, 0x0d,
-	0x7f, 0xc4, 0x6d, 0x13, 0x6f, 0x1d, 0xab, 0xf1, 0xba, 0xda, 0x5d, 0x53, 0xe0, 0x84, 0x78, 0x02,
-	0x5e, 0x02, 0x09, 0xf1, 0x24, 0x1c, 0x7b, 0xa3, 0x27, 0x43, 0xdd, 0x0b, 0xf2, 0xa9, 0x8f, 0x80,
-	0x3c, 0x25, 0xb6, 0x1b, 0x72, 0x42, 0x3d, 0xe5, 0x3b, 0x9f, 0xf9, 0xee, 0x6c, 0x66, 0x36, 0x13,
-	0x8c, 0x85, 0xf6, 0x4c, 0xe7, 0x58, 0x45, 0x26, 0x22, 0x4b, 0xf0, 0x71, 0x67, 0xcb, 0x0f, 0xcc,
-	0x38, 0x1e, 0x76, 0x46, 0x51, 0xd8, 0xf5, 0x23, 0x3f, 0xea, 0x02, 0x1e, 0xc6, 0x87, 0x10, 0x41,
-	0x00, 0xea, 0xea, 0xd4, 0xe6, 0xe7, 0x65, 0x5c, 0xdf, 0x3d, 0xd8, 0x19, 0xec, 0x70, 0xc3, 0xc9,
-	0x43, 0xdc, 0x7c, 0x7a, 0x22, 0x85, 0xda, 0xf6, 0x3c, 0x25, 0xb4, 0xb6, 0xd0, 0x06, 0x6a, 0x37,
-	0x9d, 0xff, 0xb3, 0xc4, 0xbe, 0xc6, 0xd9, 0xb5, 0x88, 0xdc, 0xc3, 0xab, 0x83, 0xe8, 0x48, 0xc8,
-	0x3e, 0x0f, 0x85, 0xb5, 0x00, 0x47, 0x5a, 0x59, 0x62, 0x97, 0x90, 0x95, 0x92, 0x74, 0x30, 0x1e,
-	0x04, 0xa3, 0x23, 0xa1, 0xc0, 0xfd, 0x1f, 0xb8, 0xd7, 0xb2, 0xc4, 0xae, 0x50, 0x56, 0xd1, 0xa4,
-	0x8d, 0xeb, 0xfb, 0x81, 0x34, 0x7c, 0x38, 0x11, 0xd6, 0xe2, 0x06, 0x6a, 0xd7, 0x9d, 0x66, 0x96,
-	0xd8, 0x05, 0x63, 0x85, 0xca, 0x9d, 0x4e, 0xac, 0x24, 0x38, 0x97, 0x4a, 0xe7, 0x94, 0xb1, 0x42,
-	0xe5, 0x4e, 0x97, 0xcb, 0x67, 0x3c, 0xd6, 0xc2, 0x5a, 0x2e, 0x9d, 0x53, 0xc6, 0x0a, 0x95, 0xb7,
-	0xe6, 0x72, 0xb9, 0xa7, 0x84, 0x78, 0x2f, 0xac, 0x15, 0xb0, 0x42, 0x6b, 0x05, 0x64, 0xa5, 0x24,
-	0x77, 0xf1, 0x8a, 0xcb, 0xe5, 0xcb, 0xe0, 0x58, 0x58, 0x75, 0xb0, 0x36, 0xb2, 0xc4, 0x9e, 0x22,
-	0x36, 0x15, 0xf9, 0x04, 0x9e, 0x1f, 0xfb, 0x8a, 0x7b, 0xf0, 0x4d, 0x57, 0xc1, 0x09, 0x13, 0x70,
-	0xb9, 0xbc, 0x4a, 0x08, 0x56, 0x71, 0x90, 0x47, 0x78, 0xcd, 0xe5, 0xd2, 0x1d, 0x73, 0xe9, 0x0b,
-	0x98, 0xbb, 0x85, 0xe1, 0x0c, 0xc9, 0x12, 0x7b, 0x26, 0xc3, 0x66, 0xe2, 0xbc, 0xd3, 0x9e, 0x86,
-	0x56, 0x3c, 0xab, 0x51, 0x76, 0x3a, 0x65, 0xac, 0x50, 0xe4, 0x0d, 0x6e, 0xe4, 0x93, 0x14, 0xde,
-	0x0b, 0x3e, 0x89, 0x85, 0xd5, 0x84, 0x87, 0x19, 0x64, 0x89, 0x5d, 0xc5, 0x5f, 0x7f, 0xd8, 0xdb,
-	0x21, 0x37, 0xe3, 0xee, 0x30, 0xf0, 0x3b, 0x3d, 0x69, 0x1e, 0x57, 0x7e, 0x6b, 0xbb, 0x13, 0x15,
-	0x49, 0xaf, 0x2f, 0xcc, 0x49, 0xa4, 0x8e, 0xba, 0x02, 0xa2, 0x2d, 0x3f, 0xea, 0x7a, 0xdc, 0xf0,
-	0x8e, 0x13, 0xf8, 0x3d, 0x69, 0x5c, 0xae, 0x8d, 0x50, 0xac, 0x5a, 0x91, 0x68, 0x8c, 0xf3, 0x77,
-	0x31, 0x57, 0xd7, 0xb6, 0xe0, 0xda, 0x83, 0x7c, 0x1a, 0x25, 0xbd, 0x99, 0x5b, 0x2b, 0x05, 0xc9,
-	0x7d, 0xdc, 0xe8, 0xc7, 0xe1, 0x8e, 0x18, 0x05, 0x21, 0x9f, 0x68, 0x6b, 0x6d, 0x03, 0xb5, 0x5b,
-	0xce, 0x7a, 0xde, 0x6c, 0x05, 0xb3, 0x6a, 0xb0, 0xf9, 0x7d, 0x01, 0xe3, 0x7c, 0x4f, 0xdc, 0x48,
-	0x1e, 0x06, 0xfe, 0x3f, 0x6e, 0xca, 0x47, 0x84, 0xd7, 0x1d, 0xae, 0x45, 0x4f, 0xeb, 0x38, 0x90,
-	0xbe, 0x1b, 0x69, 0xf3, 0x67, 0x61, 0x5e, 0x65, 0x89, 0x3d, 0x9b, 0xba, 0x99, 0xbe, 0x67, 0xab,
-	0x92, 0x3d, 0x4c, 0xf6, 0x03, 0x59, 0x6c, 0xe4, 0x13, 0x21, 0x7d, 0x33, 0x86, 0x4d, 0x6c, 0x39,
-	0xb7, 0xb3, 0xc4, 0x9e, 0x93, 0x65, 0x73, 0x18, 0xd4, 0xe1, 0x6f, 0x67, 0xeb, 0x2c, 0x56, 0xea,
-	0xfc, 0x95, 0x65, 0x73, 0x98, 0xd3, 0x3f, 0x3d, 0xa7, 0xb5, 0xb3, 0x73, 0x5a, 0xbb, 0x3c, 0xa7,
-	0xe8, 0x43, 0x4a, 0xd1, 0x97, 0x94, 0xa2, 0x6f, 0x29, 0x45, 0xa7, 0x29, 0x45, 0x67, 0x29, 0x45,
-	0x3f, 0x53, 0x8a, 0x7e, 0xa5, 0xb4, 0x76, 0x99, 0x52, 0xf4, 0xe9, 0x82, 0xd6, 0x4e, 0x2f, 0x68,
-	0xed, 0xec, 0x82, 0xd6, 0x5e, 0xdf, 0xd2, 0xef, 0xb4, 0x11, 0xe1, 0x41, 0xc8, 0x95, 0x71, 0x23,
-	0x69, 0x14, 0x1f, 0x19, 0x3d, 0x5c, 0x86, 0x3f, 0xb6, 0x07, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0x1d, 0x26, 0x99, 0x78, 0x1c, 0x05, 0x00, 0x00,
+	// 692 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4d, 0x6f, 0xd3, 0x4c,
+	0x10, 0x8e, 0xfb, 0x99, 0x6c, 0x92, 0xb6, 0x5a, 0xbd, 0x7a, 0x65, 0x71, 0x58, 0x47, 0x95, 0x90,
+	0x22, 0xa1, 0x26, 0xe2, 0xe3, 0x04, 0xa7, 0xda, 0x6d, 0xa5, 0x48, 0x34, 0xa0, 0x4d, 0xf8, 0x10,
+	0xb7, 0x4d, 0xbc, 0x75, 0xac, 0xc6, 0xeb, 0xc8, 0xbb, 0xa6, 0x94, 0x13, 0xe2, 0x17, 0x70, 0xe6,
+	0x17, 0x20, 0x7e, 0x09, 0xc7, 0xde, 0xe8, 0xc9, 0x50, 0xf7, 0x82, 0x7c, 0xea, 0x4f, 0x40, 0xbb,
+	0xc6, 0x1f, 0x09, 0x39, 0xa1, 0x9e, 0xfc, 0xcc, 0x33, 0xcf, 0xce, 0x78, 0x66, 0x67, 0x16, 0x00,
+	0xca, 0x6d, 0xd1, 0x99, 0x05, 0xbe, 0xf0, 0xe1, 0xba, 0xfa, 0xdc, 0xd9, 0x73, 0x5c, 0x31, 0x09,
+	0x47, 0x9d, 0xb1, 0xef, 0x75, 0x1d, 0xdf, 0xf1, 0xbb, 0x8a, 0x1e, 0x85, 0x27, 0xca, 0x52, 0x86,
+	0x42, 0xe9, 0xa9, 0xdd, 0xcf, 0x9b, 0xa0, 0x7a, 0x38, 0x38, 0x18, 0x1e, 0x10, 0x41, 0xe0, 0x23,
+	0xd0, 0x78, 0x76, 0xc6, 0x68, 0xb0, 0x6f, 0xdb, 0x01, 0xe5, 0x5c, 0xd7, 0x5a, 0x5a, 0xbb, 0x61,
+	0xee, 0x24, 0x91, 0x31, 0xc7, 0xe3, 0x39, 0x0b, 0xde, 0x03, 0xb5, 0xa1, 0x7f, 0x4a, 0x59, 0x9f,
+	0x78, 0x54, 0x5f, 0x51, 0x47, 0x9a, 0x49, 0x64, 0x14, 0x24, 0x2e, 0x20, 0xec, 0x00, 0x30, 0x74,
+	0xc7, 0xa7, 0x34, 0x50, 0xea, 0x55, 0xa5, 0xde, 0x4a, 0x22, 0xa3, 0xc4, 0xe2, 0x12, 0x86, 0x6d,
+	0x50, 0x3d, 0x76, 0x99, 0x20, 0xa3, 0x29, 0xd5, 0xd7, 0x5a, 0x5a, 0xbb, 0x6a, 0x36, 0x92, 0xc8,
+	0xc8, 0x39, 0x9c, 0x23, 0xa9, 0x34, 0xc3, 0x80, 0x29, 0xe5, 0x7a, 0xa1, 0xcc, 0x38, 0x9c, 0x23,
+	0xa9, 0xb4, 0x08, 0x7b, 0x4e, 0x42, 0x4e, 0xf5, 0x8d, 0x42, 0x99, 0x71, 0x38, 0x47, 0xb2, 0x34,
+	0x8b, 0xb0, 0xa3, 0x80, 0xd2, 0xf7, 0x54, 0xdf, 0x54, 0x52, 0x55, 0x5a, 0x4e, 0xe2, 0x02, 0xc2,
+	0xbb, 0x60, 0xd3, 0x22, 0xec, 0x95, 0x3b, 0xa3, 0x7a, 0x55, 0x49, 0xeb, 0x49, 0x64, 0x64, 0x14,
+	0xce, 0x80, 0xec, 0xc0, 0x8b, 0x99, 0x13, 0x10, 0x5b, 0xfd, 0x69, 0x4d, 0x29, 0x55, 0x07, 0x2c,
+	0xc2, 0x52, 0x07, 0xc5, 0x25, 0x05, 0x7c, 0x0c, 0xb6, 0x2c, 0xc2, 0xac, 0x09, 0x61, 0x0e, 0x55,
+	0x7d, 0xd7, 0x81, 0x3a, 0x03, 0x93, 0xc8, 0x58, 0xf0, 0xe0, 0x05, 0x5b, 0x56, 0xda, 0xe3, 0xaa,
+	0x14, 0x5b, 0xaf, 0x17, 0x95, 0x66, 0x1c, 0xce, 0x11, 0x7c, 0x0b, 0xea, 0xb2, 0x93, 0xd4, 0x7e,
+	0x49, 0xa6, 0x21, 0xd5, 0x1b, 0xea, 0x62, 0x86, 0x49, 0x64, 0x94, 0xe9, 0xaf, 0x3f, 0x8c, 0x7d,
+	0x8f, 0x88, 0x49, 0x77, 0xe4, 0x3a, 0x9d, 0x1e, 0x13, 0x4f, 0x4a, 0xb3, 0x76, 0x38, 0x0d, 0x7c,
+	0x66, 0xf7, 0xa9, 0x38, 0xf3, 0x83, 0xd3, 0x2e, 0x55, 0xd6, 0x9e, 0xe3, 0x77, 0x6d, 0x22, 0x48,
+	0xc7, 0x74, 0x9d, 0x1e, 0x13, 0x16, 0xe1, 0x82, 0x06, 0xb8, 0x1c, 0x11, 0x72, 0x00, 0xe4, 0xbd,
+	0x88, 0x34, 0x6d, 0x53, 0xa5, 0x1d, 0xc8, 0x6e, 0x14, 0xec, 0xed, 0x64, 0x2d, 0x05, 0x84, 0xf7,
+	0x41, 0xbd, 0x1f, 0x7a, 0x07, 0x74, 0xec, 0x7a, 0x64, 0xca, 0xf5, 0xad, 0x96, 0xd6, 0x6e, 0x9a,
+	0xdb, 0xb2, 0xd8, 0x12, 0x8d, 0xcb, 0x46, 0x3e, 0xe4, 0xc3, 0xf3, 0x19, 0xd5, 0xb7, 0x17, 0x86,
+	0x5c, 0x92, 0xb8, 0x80, 0xf0, 0x08, 0x34, 0x06, 0x33, 0x3a, 0x76, 0xc9, 0x14, 0xfb, 0x53, 0xca,
+	0xf5, 0x9d, 0xd6, 0x6a, 0xbb, 0xfe, 0x60, 0x27, 0x5d, 0xb9, 0x8e, 0x5c, 0x37, 0xc5, 0xa7, 0x9b,
+	0x55, 0x56, 0xe2, 0x39, 0x6b, 0x77, 0x00, 0x6a, 0xb9, 0x58, 0x8e, 0xd7, 0xfc, 0x5e, 0xaa, 0xf1,
+	0xca, 0x56, 0x32, 0x03, 0xd0, 0x00, 0xeb, 0x69, 0xd2, 0x95, 0xd6, 0x6a, 0xbb, 0x61, 0xd6, 0x92,
+	0xc8, 0x48, 0x09, 0x9c, 0x7e, 0x76, 0xbf, 0xaf, 0x00, 0x20, 0xa3, 0x5a, 0x3e, 0x3b, 0x71, 0x9d,
+	0x7f, 0xdc, 0xf9, 0x8f, 0x1a, 0xd8, 0x36, 0x09, 0xa7, 0x3d, 0xce, 0x43, 0x97, 0x39, 0x96, 0xcf,
+	0xc5, 0x9f, 0xd5, 0x7f, 0x9d, 0x44, 0xc6, 0xa2, 0xeb, 0x76, 0x6e, 0x70, 0x31, 0x2a, 0x3c, 0x02,
+	0xf0, 0xd8, 0x65, 0xf9, 0xdb, 0xf2, 0x94, 0x32, 0x47, 0x4c, 0xd4, 0x9b, 0xd2, 0x34, 0xff, 0x4f,
+	0x22, 0x63, 0x89, 0x17, 0x2f, 0xe1, 0x54, 0x1c, 0xf2, 0x6e, 0x31, 0xce, 0x5a, 0x29, 0xce, 0x5f,
+	0x5e, 0xbc, 0x84, 0x33, 0xfb, 0x17, 0x57, 0xa8, 0x72, 0x79, 0x85, 0x2a, 0x37, 0x57, 0x48, 0xfb,
+	0x10, 0x23, 0xed, 0x4b, 0x8c, 0xb4, 0x6f, 0x31, 0xd2, 0x2e, 0x62, 0xa4, 0x5d, 0xc6, 0x48, 0xfb,
+	0x19, 0x23, 0xed, 0x57, 0x8c, 0x2a, 0x37, 0x31, 0xd2, 0x3e, 0x5d, 0xa3, 0xca, 0xc5, 0x35, 0xaa,
+	0x5c, 0x5e, 0xa3, 0xca, 0x9b, 0xff, 0xf8, 0x39, 0x17, 0xd4, 0x1b, 0x78, 0x24, 0x10, 0x96, 0xcf,
+	0x44, 0x40, 0xc6, 0x82, 0x8f, 0x36, 0xd4, 0xbc, 0x3c, 0xfc, 0x3d, 0x00, 0x15, 0x4c, 0x30, 0x8b,
+	0xe6, 0x05, 0x00, 0x00,
 }
 
 func (this *ESDTData) Equal(that interface{}) bool {
@@ -351,6 +420,49 @@ func (this *ESDTData) Equal(that interface{}) bool {
 	if this.NumDecimals != that1.NumDecimals {
 		return false
 	}
+	if !bytes.Equal(this.TokenType, that1.TokenType) {
+		return false
+	}
+	if len(this.SpecialRoles) != len(that1.SpecialRoles) {
+		return false
+	}
+	for i := range this.SpecialRoles {
+		if !this.SpecialRoles[i].Equal(that1.SpecialRoles[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ESDTRoles) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ESDTRoles)
+	if !ok {
+		that2, ok := that.(ESDTRoles)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Address, that1.Address) {
+		return false
+	}
+	if len(this.Roles) != len(that1.Roles) {
+		return false
+	}
+	for i := range this.Roles {
+		if !bytes.Equal(this.Roles[i], that1.Roles[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *ESDTConfig) Equal(that interface{}) bool {
@@ -393,7 +505,7 @@ func (this *ESDTData) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 18)
+	s := make([]string, 0, 20)
 	s = append(s, "&systemSmartContracts.ESDTData{")
 	s = append(s, "OwnerAddress: "+fmt.Sprintf("%#v", this.OwnerAddress)+",\n")
 	s = append(s, "TokenName: "+fmt.Sprintf("%#v", this.TokenName)+",\n")
@@ -409,6 +521,21 @@ func (this *ESDTData) GoString() string {
 	s = append(s, "MintedValue: "+fmt.Sprintf("%#v", this.MintedValue)+",\n")
 	s = append(s, "BurntValue: "+fmt.Sprintf("%#v", this.BurntValue)+",\n")
 	s = append(s, "NumDecimals: "+fmt.Sprintf("%#v", this.NumDecimals)+",\n")
+	s = append(s, "TokenType: "+fmt.Sprintf("%#v", this.TokenType)+",\n")
+	if this.SpecialRoles != nil {
+		s = append(s, "SpecialRoles: "+fmt.Sprintf("%#v", this.SpecialRoles)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ESDTRoles) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&systemSmartContracts.ESDTRoles{")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "Roles: "+fmt.Sprintf("%#v", this.Roles)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -453,6 +580,29 @@ func (m *ESDTData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SpecialRoles) > 0 {
+		for iNdEx := len(m.SpecialRoles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpecialRoles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEsdt(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.TokenType) > 0 {
+		i -= len(m.TokenType)
+		copy(dAtA[i:], m.TokenType)
+		i = encodeVarintEsdt(dAtA, i, uint64(len(m.TokenType)))
+		i--
+		dAtA[i] = 0x7a
+	}
 	if m.NumDecimals != 0 {
 		i = encodeVarintEsdt(dAtA, i, uint64(m.NumDecimals))
 		i--
@@ -584,6 +734,45 @@ func (m *ESDTData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ESDTRoles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ESDTRoles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ESDTRoles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Roles[iNdEx])
+			copy(dAtA[i:], m.Roles[iNdEx])
+			i = encodeVarintEsdt(dAtA, i, uint64(len(m.Roles[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEsdt(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ESDTConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -701,6 +890,35 @@ func (m *ESDTData) Size() (n int) {
 	if m.NumDecimals != 0 {
 		n += 1 + sovEsdt(uint64(m.NumDecimals))
 	}
+	l = len(m.TokenType)
+	if l > 0 {
+		n += 1 + l + sovEsdt(uint64(l))
+	}
+	if len(m.SpecialRoles) > 0 {
+		for _, e := range m.SpecialRoles {
+			l = e.Size()
+			n += 2 + l + sovEsdt(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ESDTRoles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEsdt(uint64(l))
+	}
+	if len(m.Roles) > 0 {
+		for _, b := range m.Roles {
+			l = len(b)
+			n += 1 + l + sovEsdt(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -738,6 +956,11 @@ func (this *ESDTData) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForSpecialRoles := "[]*ESDTRoles{"
+	for _, f := range this.SpecialRoles {
+		repeatedStringForSpecialRoles += strings.Replace(f.String(), "ESDTRoles", "ESDTRoles", 1) + ","
+	}
+	repeatedStringForSpecialRoles += "}"
 	s := strings.Join([]string{`&ESDTData{`,
 		`OwnerAddress:` + fmt.Sprintf("%v", this.OwnerAddress) + `,`,
 		`TokenName:` + fmt.Sprintf("%v", this.TokenName) + `,`,
@@ -753,6 +976,19 @@ func (this *ESDTData) String() string {
 		`MintedValue:` + fmt.Sprintf("%v", this.MintedValue) + `,`,
 		`BurntValue:` + fmt.Sprintf("%v", this.BurntValue) + `,`,
 		`NumDecimals:` + fmt.Sprintf("%v", this.NumDecimals) + `,`,
+		`TokenType:` + fmt.Sprintf("%v", this.TokenType) + `,`,
+		`SpecialRoles:` + repeatedStringForSpecialRoles + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ESDTRoles) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ESDTRoles{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Roles:` + fmt.Sprintf("%v", this.Roles) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1164,6 +1400,193 @@ func (m *ESDTData) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenType = append(m.TokenType[:0], dAtA[iNdEx:postIndex]...)
+			if m.TokenType == nil {
+				m.TokenType = []byte{}
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecialRoles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecialRoles = append(m.SpecialRoles, &ESDTRoles{})
+			if err := m.SpecialRoles[len(m.SpecialRoles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEsdt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ESDTRoles) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEsdt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ESDTRoles: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ESDTRoles: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, make([]byte, postIndex-iNdEx))
+			copy(m.Roles[len(m.Roles)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEsdt(dAtA[iNdEx:])
@@ -2190,8 +2190,9 @@ func TestEsdt_ExecuteEsdtControlChangesSavesTokenWithUpgradedPropreties(t *testi
 	output = e.Execute(vmInput)
 	assert.Equal(t, vmcommon.Ok, output)
 
-	assert.Equal(t, 13, len(eei.output))
+	assert.Equal(t, 14, len(eei.output))
 	assert.Equal(t, []byte("esdtToken"), eei.output[0])
+	assert.Equal(t, []byte("TokenType-"+core.FungibleESDT), eei.output[13])
 	assert.Equal(t, vmInput.CallerAddr, eei.output[1])
 }
 
@@ -2267,3 +2268,221 @@ func getAddress() []byte {
 	_, _ = rand.Read(key)
 	return key
 }
+
+func createESDTWithToken(t *testing.T, token *ESDTData) (*esdt, *vmContext, ArgsNewESDTSmartContract) {
+	args := createMockArgumentsForESDT()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+	args.Eei = eei
+
+	marshalizedData, err := args.Marshalizer.Marshal(token)
+	assert.Nil(t, err)
+	eei.storageUpdate[string(eei.scAddress)] = map[string][]byte{string(token.TokenName): marshalizedData}
+
+	e, _ := NewESDTSmartContract(args)
+	return e, eei, args
+}
+
+func TestEsdt_ExecuteIssueNonFungibleAndSemiFungible(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForESDT()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+	args.Eei = eei
+	e, _ := NewESDTSmartContract(args)
+
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  []byte("addr"),
+			CallValue:   big.NewInt(0),
+			GasProvided: 100000,
+			Arguments:   [][]byte{[]byte("name")},
+		},
+		RecipientAddr: []byte("addr"),
+		Function:      "issueNonFungible",
+	}
+	eei.gasRemaining = vmInput.GasProvided
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.FunctionWrongSignature, output)
+
+	vmInput.Arguments = [][]byte{[]byte("name"), []byte("TICKER")}
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.OutOfFunds, output)
+
+	vmInput.CallValue, _ = big.NewInt(0).SetString(args.ESDTSCConfig.BaseIssuingCost, 10)
+	for _, function := range []string{"issueNonFungible", "issueSemiFungible"} {
+		eei.output = make([][]byte, 0)
+		vmInput.Function = function
+		output = e.Execute(vmInput)
+		assert.Equal(t, vmcommon.Ok, output)
+		assert.Equal(t, 1, len(eei.output))
+
+		esdtData := &ESDTData{}
+		_ = args.Marshalizer.Unmarshal(esdtData, eei.GetStorage(eei.output[0]))
+		assert.Equal(t, []byte("name"), esdtData.TokenName)
+		assert.Equal(t, big.NewInt(0), esdtData.MintedValue)
+	}
+
+	esdtData := &ESDTData{}
+	_ = args.Marshalizer.Unmarshal(esdtData, eei.GetStorage(eei.output[0]))
+	assert.Equal(t, []byte(core.SemiFungibleESDT), esdtData.TokenType)
+}
+
+func TestEsdt_ExecuteNFTFunctionsBeforeEnableEpochShouldNotBeFound(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForESDT()
+	args.ESDTSCConfig.NFTEnableEpoch = 1
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+	args.Eei = eei
+	e, _ := NewESDTSmartContract(args)
+
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  []byte("addr"),
+			GasProvided: 100000,
+			Arguments:   [][]byte{[]byte("name"), []byte("TICKER")},
+		},
+		RecipientAddr: []byte("addr"),
+	}
+	vmInput.CallValue, _ = big.NewInt(0).SetString(args.ESDTSCConfig.BaseIssuingCost, 10)
+	eei.gasRemaining = vmInput.GasProvided
+
+	nftFunctions := []string{"issueNonFungible", "issueSemiFungible", "setSpecialRole", "unSetSpecialRole", "getSpecialRoles"}
+	for _, function := range nftFunctions {
+		vmInput.Function = function
+		eei.returnMessage = ""
+		output := e.Execute(vmInput)
+		assert.Equal(t, vmcommon.FunctionNotFound, output)
+		assert.Equal(t, "invalid method to call", eei.returnMessage)
+	}
+
+	e.EpochConfirmed(1)
+	vmInput.Function = "issueNonFungible"
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+}
+
+func TestEsdt_ExecuteMintNonFungibleTokenShouldFail(t *testing.T) {
+	t.Parallel()
+
+	tokenName := []byte("esdtToken")
+	e, eei, _ := createESDTWithToken(t, &ESDTData{
+		TokenName:    tokenName,
+		OwnerAddress: []byte("owner"),
+		TokenType:    []byte(core.NonFungibleESDT),
+		Mintable:     true,
+	})
+
+	output := e.Execute(getDefaultVmInputForFunc("mint", [][]byte{tokenName, {200}}))
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "only fungible tokens can be minted"))
+}
+
+func TestEsdt_ExecuteSetSpecialRoleErrors(t *testing.T) {
+	t.Parallel()
+
+	tokenName := []byte("esdtToken")
+	address := getAddress()
+	e, eei, _ := createESDTWithToken(t, &ESDTData{
+		TokenName:    tokenName,
+		OwnerAddress: []byte("owner"),
+		TokenType:    []byte(core.NonFungibleESDT),
+	})
+
+	output := e.Execute(getDefaultVmInputForFunc("setSpecialRole", [][]byte{tokenName, address}))
+	assert.Equal(t, vmcommon.FunctionWrongSignature, output)
+
+	vmInput := getDefaultVmInputForFunc("setSpecialRole", [][]byte{tokenName, address, []byte(core.ESDTRoleNFTCreate)})
+	vmInput.CallerAddr = []byte("not owner")
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "can be called by owner only"))
+
+	output = e.Execute(getDefaultVmInputForFunc("setSpecialRole", [][]byte{tokenName, []byte("short"), []byte(core.ESDTRoleNFTCreate)}))
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "invalid address"))
+
+	output = e.Execute(getDefaultVmInputForFunc("setSpecialRole", [][]byte{tokenName, address, []byte(core.ESDTRoleNFTAddQuantity)}))
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "is not allowed for this token"))
+
+	output = e.Execute(getDefaultVmInputForFunc("unSetSpecialRole", [][]byte{tokenName, address, []byte(core.ESDTRoleNFTCreate)}))
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "address does not have the role"))
+}
+
+func TestEsdt_ExecuteSetSpecialRoleOnFungibleTokenShouldFail(t *testing.T) {
+	t.Parallel()
+
+	tokenName := []byte("esdtToken")
+	e, eei, _ := createESDTWithToken(t, &ESDTData{
+		TokenName:    tokenName,
+		OwnerAddress: []byte("owner"),
+	})
+
+	output := e.Execute(getDefaultVmInputForFunc("setSpecialRole", [][]byte{tokenName, getAddress(), []byte(core.ESDTRoleNFTCreate)}))
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "special roles are allowed for non-fungible and semi-fungible tokens only"))
+}
+
+func TestEsdt_ExecuteSetAndUnSetSpecialRoleShouldWork(t *testing.T) {
+	t.Parallel()
+
+	tokenName := []byte("esdtToken")
+	address := getAddress()
+	e, eei, args := createESDTWithToken(t, &ESDTData{
+		TokenName:    tokenName,
+		OwnerAddress: []byte("owner"),
+		TokenType:    []byte(core.SemiFungibleESDT),
+	})
+
+	roles := [][]byte{[]byte(core.ESDTRoleNFTCreate), []byte(core.ESDTRoleNFTAddQuantity)}
+	output := e.Execute(getDefaultVmInputForFunc("setSpecialRole", append([][]byte{tokenName, address}, roles...)))
+	assert.Equal(t, vmcommon.Ok, output)
+
+	esdtData := &ESDTData{}
+	_ = args.Marshalizer.Unmarshal(esdtData, eei.GetStorage(tokenName))
+	assert.Equal(t, 1, len(esdtData.SpecialRoles))
+	assert.Equal(t, address, esdtData.SpecialRoles[0].Address)
+	assert.Equal(t, roles, esdtData.SpecialRoles[0].Roles)
+
+	destAcc, accCreated := eei.CreateVMOutput().OutputAccounts[string(address)]
+	assert.True(t, accCreated)
+	expectedInput := core.BuiltInFunctionESDTSetRole + "@" + hex.EncodeToString(tokenName) +
+		"@" + hex.EncodeToString(roles[0]) + "@" + hex.EncodeToString(roles[1])
+	assert.Equal(t, []byte(expectedInput), destAcc.OutputTransfers[0].Data)
+
+	eei.output = make([][]byte, 0)
+	output = e.Execute(getDefaultVmInputForFunc("getSpecialRoles", [][]byte{tokenName}))
+	assert.Equal(t, vmcommon.Ok, output)
+	assert.Equal(t, 1, len(eei.output))
+	expectedRoles := args.AddressPubKeyConverter.Encode(address) + ":" + core.ESDTRoleNFTCreate + "," + core.ESDTRoleNFTAddQuantity
+	assert.Equal(t, []byte(expectedRoles), eei.output[0])
+
+	output = e.Execute(getDefaultVmInputForFunc("unSetSpecialRole", [][]byte{tokenName, address, roles[0]}))
+	assert.Equal(t, vmcommon.Ok, output)
+	esdtData = &ESDTData{}
+	_ = args.Marshalizer.Unmarshal(esdtData, eei.GetStorage(tokenName))
+	assert.Equal(t, [][]byte{roles[1]}, esdtData.SpecialRoles[0].Roles)
+
+	output = e.Execute(getDefaultVmInputForFunc("unSetSpecialRole", [][]byte{tokenName, address, roles[1]}))
+	assert.Equal(t, vmcommon.Ok, output)
+	esdtData = &ESDTData{}
+	_ = args.Marshalizer.Unmarshal(esdtData, eei.GetStorage(tokenName))
+	assert.Equal(t, 0, len(esdtData.SpecialRoles))
+}
@@ -4,6 +4,7 @@ package systemSmartContracts
 import (
 	"bytes"
 	"fmt"
+	"math"
 	"math/big"
 	"sync"
 
@@ -22,6 +23,8 @@ const hardForkPrefix = "hardFork"
 const proposalPrefix = "proposal"
 const whiteListPrefix = "whiteList"
 const validatorPrefix = "validator"
+const delegatedVotePowerPrefix = "delegatedVotePower"
+const validatorVotesPrefix = "validatorVotes"
 const hardForkEpochGracePeriod = 2
 const githubCommitLength = 40
 
@@ -123,6 +126,10 @@ func (g *governanceContract) Execute(args *vmcommon.ContractCallInput) vmcommon.
 		return g.delegateVotePower(args)
 	case "revokeVotePower":
 		return g.revokeVotePower(args)
+	case "getValidatorVotePower":
+		return g.getValidatorVotePower(args)
+	case "getDelegatedVotePower":
+		return g.getDelegatedVotePower(args)
 	case "changeConfig":
 		return g.changeConfig(args)
 	case "closeProposal":
@@ -616,7 +623,6 @@ func (g *governanceContract) vote(args *vmcommon.ContractCallInput) vmcommon.Ret
 		return vmcommon.UserError
 	}
 
-	numNodesToVote := int32(0)
 	validatorData, err := g.getOrCreateValidatorData(validatorAddress, int32(numStakedNodes))
 	if err != nil {
 		log.Warn("getOrCreateValidatorData", "err", err)
@@ -624,20 +630,17 @@ func (g *governanceContract) vote(args *vmcommon.ContractCallInput) vmcommon.Ret
 		return vmcommon.UserError
 	}
 
-	found := false
-	for _, voter := range validatorData.Delegators {
-		if bytes.Equal(voter.Address, voterAddress) {
-			found = true
-			numNodesToVote = voter.NumNodes
-			break
-		}
+	numNodesToVote := int32(0)
+	voterData := findVoterData(validatorData.Delegators, voterAddress)
+	if voterData != nil {
+		numNodesToVote = voterData.NumNodes
 	}
-	if !found || numNodesToVote <= 0 {
+	if numNodesToVote <= 0 {
 		g.eei.AddReturnMessage("address has 0 voting power")
 		return vmcommon.UserError
 	}
 
-	err = g.voteForProposal(proposalToVote, voteString, voterAddress, numNodesToVote)
+	err = g.voteForProposal(proposalToVote, voteString, voterAddress, validatorAddress, numNodesToVote, validatorData.NumNodes)
 	if err != nil {
 		g.eei.AddReturnMessage("voteForProposal " + err.Error())
 		return vmcommon.UserError
@@ -660,25 +663,71 @@ func (g *governanceContract) isValidVoteString(vote string) bool {
 	return false
 }
 
+// voteForProposal registers the vote of the voter using the voting power held from the provided validator. The total
+// number of votes used from a validator's power on a proposal can not exceed the validator's number of staked nodes,
+// no matter how many addresses hold parts of that power.
 func (g *governanceContract) voteForProposal(
 	proposal []byte,
 	vote string,
 	voter []byte,
-	numVotes int32,
+	validator []byte,
+	numHeldVotes int32,
+	maxValidatorVotes int32,
 ) error {
+	generalProposal, err := g.getGeneralProposal(proposal)
+	if err != nil {
+		return err
+	}
+	currentNonce := g.eei.BlockChainHook().CurrentNonce()
+	if currentNonce < generalProposal.StartVoteNonce {
+		return vm.ErrVotedForAProposalThatNotBeginsYet
+	}
+	if currentNonce > generalProposal.EndVoteNonce {
+		return vm.ErrVotedForAnExpiredProposal
+	}
+
+	validatorVotes, err := g.getValidatorVotes(proposal, validator)
+	if err != nil {
+		return err
+	}
+
+	usedByOthers := int32(0)
+	for _, voterData := range validatorVotes.Voters {
+		if !bytes.Equal(voterData.Address, voter) {
+			usedByOthers += voterData.NumNodes
+		}
+	}
+	numVotes := maxValidatorVotes - usedByOthers
+	if numHeldVotes < numVotes {
+		numVotes = numHeldVotes
+	}
+	if numVotes <= 0 {
+		return vm.ErrVotingPowerAlreadyUsed
+	}
+
+	voterData := findVoterData(validatorVotes.Voters, voter)
+	if voterData == nil {
+		voterData = &VoterData{Address: voter}
+		validatorVotes.Voters = append(validatorVotes.Voters, voterData)
+	}
+	previouslyUsed := voterData.NumNodes
+	voterData.NumNodes = numVotes
+
 	voteData, err := g.getOrCreateVoteData(proposal, voter)
 	if err != nil {
 		log.Warn("getOrCreateVoteData", "err", err)
 		return err
 	}
-	if voteData.NumVotes == numVotes && voteData.VoteValue == vote {
+
+	newNumVotes := voteData.NumVotes - previouslyUsed + numVotes
+	if voteData.NumVotes == newNumVotes && voteData.VoteValue == vote {
 		return nil
 	}
 
 	oldNum := voteData.NumVotes
 	oldValue := voteData.VoteValue
 
-	voteData.NumVotes = numVotes
+	voteData.NumVotes = newNumVotes
 	voteData.VoteValue = vote
 	err = g.saveVoteValue(proposal, voter, voteData)
 	if err != nil {
@@ -686,22 +735,20 @@ func (g *governanceContract) voteForProposal(
 		return err
 	}
 
-	generalProposal, err := g.getGeneralProposal(proposal)
+	err = g.saveValidatorVotes(proposal, validator, validatorVotes)
 	if err != nil {
+		log.Warn("saveValidatorVotes", "err", err)
 		return err
 	}
-	currentNonce := g.eei.BlockChainHook().CurrentNonce()
-	if currentNonce < generalProposal.StartVoteNonce {
-		return vm.ErrVotedForAProposalThatNotBeginsYet
-	}
 
-	if currentNonce > generalProposal.EndVoteNonce {
-		return vm.ErrVotedForAnExpiredProposal
+	if !isAddressInList(generalProposal.Voters, voter) {
+		generalProposal.Voters = append(generalProposal.Voters, voter)
+	}
+	if !isAddressInList(generalProposal.VotedValidators, validator) {
+		generalProposal.VotedValidators = append(generalProposal.VotedValidators, validator)
 	}
-
-	generalProposal.Voters = append(generalProposal.Voters, voter)
 	g.addVotedDataToProposal(generalProposal, oldValue, -oldNum)
-	g.addVotedDataToProposal(generalProposal, vote, numVotes)
+	g.addVotedDataToProposal(generalProposal, vote, newNumVotes)
 
 	err = g.saveGeneralProposal(proposal, generalProposal)
 	if err != nil {
@@ -752,6 +799,38 @@ func (g *governanceContract) getOrCreateVoteData(proposal []byte, voter []byte)
 	return voteData, nil
 }
 
+func (g *governanceContract) getValidatorVotes(proposal []byte, validator []byte) (*ValidatorVotes, error) {
+	validatorVotes := &ValidatorVotes{
+		Voters: make([]*VoterData, 0),
+	}
+	marshaledData := g.eei.GetStorage(createValidatorVotesKey(proposal, validator))
+	if len(marshaledData) == 0 {
+		return validatorVotes, nil
+	}
+
+	err := g.marshalizer.Unmarshal(validatorVotes, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return validatorVotes, nil
+}
+
+func (g *governanceContract) saveValidatorVotes(proposal []byte, validator []byte, validatorVotes *ValidatorVotes) error {
+	marshaledData, err := g.marshalizer.Marshal(validatorVotes)
+	if err != nil {
+		return err
+	}
+
+	g.eei.SetStorage(createValidatorVotesKey(proposal, validator), marshaledData)
+	return nil
+}
+
+func createValidatorVotesKey(proposal []byte, validator []byte) []byte {
+	key := append([]byte(validatorVotesPrefix), proposal...)
+	return append(key, validator...)
+}
+
 func (g *governanceContract) getOrCreateValidatorData(address []byte, numNodes int32) (*ValidatorData, error) {
 	validatorData := &ValidatorData{
 		Delegators: make([]*VoterData, 1),
@@ -775,23 +854,382 @@ func (g *governanceContract) getOrCreateValidatorData(address []byte, numNodes i
 
 	oldNumNodes := validatorData.NumNodes
 	validatorData.NumNodes = numNodes
-	if len(validatorData.Delegators) == 1 {
+	if oldNumNodes == numNodes {
+		return validatorData, nil
+	}
+
+	log.Trace("difference in old num nodes and new num nodes with delegated voting", "old", oldNumNodes, "new", numNodes)
+
+	// the validator keeps the voting power that was not delegated, so a change in the number of staked nodes is
+	// reflected only in its own share
+	selfData := findVoterData(validatorData.Delegators, address)
+	if selfData == nil {
 		return validatorData, nil
 	}
 
-	log.Trace("difference in old num nodes and new num nodes with delegated voting", oldNumNodes, numNodes)
+	delegatedNodes := int32(0)
+	for _, voterData := range validatorData.Delegators {
+		if voterData != selfData {
+			delegatedNodes += voterData.NumNodes
+		}
+	}
+	selfData.NumNodes = numNodes - delegatedNodes
+	if selfData.NumNodes < 0 {
+		selfData.NumNodes = 0
+	}
 
 	return validatorData, nil
 }
 
-func (g *governanceContract) delegateVotePower(_ *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	g.eei.AddReturnMessage("delegateVotePower not yet implemented")
-	return vmcommon.UserError
+func (g *governanceContract) saveValidatorData(address []byte, validatorData *ValidatorData) error {
+	marshaledData, err := g.marshalizer.Marshal(validatorData)
+	if err != nil {
+		return err
+	}
+
+	key := append([]byte(validatorPrefix), address...)
+	g.eei.SetStorage(key, marshaledData)
+	return nil
+}
+
+func (g *governanceContract) getDelegatedVotePowerData(address []byte) (*DelegatedVotePower, error) {
+	delegatedVotePower := &DelegatedVotePower{
+		Validators: make([][]byte, 0),
+	}
+	key := append([]byte(delegatedVotePowerPrefix), address...)
+	marshaledData := g.eei.GetStorage(key)
+	if len(marshaledData) == 0 {
+		return delegatedVotePower, nil
+	}
+
+	err := g.marshalizer.Unmarshal(delegatedVotePower, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return delegatedVotePower, nil
 }
 
-func (g *governanceContract) revokeVotePower(_ *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	g.eei.AddReturnMessage("revokeVotePower not yet implemented")
-	return vmcommon.UserError
+func (g *governanceContract) saveDelegatedVotePowerData(address []byte, delegatedVotePower *DelegatedVotePower) error {
+	key := append([]byte(delegatedVotePowerPrefix), address...)
+	if len(delegatedVotePower.Validators) == 0 {
+		g.eei.SetStorage(key, nil)
+		return nil
+	}
+
+	marshaledData, err := g.marshalizer.Marshal(delegatedVotePower)
+	if err != nil {
+		return err
+	}
+
+	g.eei.SetStorage(key, marshaledData)
+	return nil
+}
+
+func (g *governanceContract) checkDelegationArguments(args *vmcommon.ContractCallInput, gasCost uint64) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		g.eei.AddReturnMessage(vm.ErrCallValueMustBeZero.Error())
+		return vmcommon.UserError
+	}
+	err := g.eei.UseGas(gasCost)
+	if err != nil {
+		g.eei.AddReturnMessage("not enough gas")
+		return vmcommon.OutOfGas
+	}
+	if len(args.Arguments) < 1 || len(args.Arguments) > 2 {
+		g.eei.AddReturnMessage("invalid number of arguments, expected 1 or 2")
+		return vmcommon.FunctionWrongSignature
+	}
+	if len(args.Arguments[0]) != len(args.CallerAddr) {
+		g.eei.AddReturnMessage("first argument should be a valid address")
+		return vmcommon.FunctionWrongSignature
+	}
+	if bytes.Equal(args.Arguments[0], args.CallerAddr) {
+		g.eei.AddReturnMessage("first argument should be different than caller")
+		return vmcommon.FunctionWrongSignature
+	}
+
+	return vmcommon.Ok
+}
+
+func numNodesFromArgument(arg []byte) (int32, bool) {
+	numNodes, okConvert := big.NewInt(0).SetString(string(arg), conversionBase)
+	if !okConvert || numNodes.Cmp(zero) <= 0 || !numNodes.IsInt64() || numNodes.Int64() > math.MaxInt32 {
+		return 0, false
+	}
+
+	return int32(numNodes.Int64()), true
+}
+
+// delegateVotePower moves a part of the caller's voting power to the provided address. Arguments are the destination
+// address and the number of nodes whose voting power is delegated.
+func (g *governanceContract) delegateVotePower(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	returnCode := g.checkDelegationArguments(args, g.gasCost.MetaChainSystemSCsCost.DelegateVote)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	if len(args.Arguments) != 2 {
+		g.eei.AddReturnMessage("invalid number of arguments, expected 2")
+		return vmcommon.FunctionWrongSignature
+	}
+	numNodesToDelegate, ok := numNodesFromArgument(args.Arguments[1])
+	if !ok {
+		g.eei.AddReturnMessage("invalid number of nodes to delegate")
+		return vmcommon.UserError
+	}
+
+	validatorAddress := args.CallerAddr
+	destination := args.Arguments[0]
+	numStakedNodes, err := g.numOfStakedNodes(validatorAddress)
+	if err != nil || numStakedNodes == 0 {
+		g.eei.AddReturnMessage("address has 0 voting power")
+		return vmcommon.UserError
+	}
+
+	validatorData, err := g.getOrCreateValidatorData(validatorAddress, int32(numStakedNodes))
+	if err != nil {
+		g.eei.AddReturnMessage("getOrCreateValidatorData error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	selfData := findVoterData(validatorData.Delegators, validatorAddress)
+	if selfData == nil || selfData.NumNodes < numNodesToDelegate {
+		g.eei.AddReturnMessage(vm.ErrNotEnoughVotingPower.Error())
+		return vmcommon.UserError
+	}
+
+	destinationData := findVoterData(validatorData.Delegators, destination)
+	if destinationData == nil {
+		destinationData = &VoterData{Address: destination}
+		validatorData.Delegators = append(validatorData.Delegators, destinationData)
+	}
+	selfData.NumNodes -= numNodesToDelegate
+	destinationData.NumNodes += numNodesToDelegate
+
+	err = g.saveValidatorData(validatorAddress, validatorData)
+	if err != nil {
+		g.eei.AddReturnMessage("saveValidatorData error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	delegatedVotePower, err := g.getDelegatedVotePowerData(destination)
+	if err != nil {
+		g.eei.AddReturnMessage("getDelegatedVotePowerData error " + err.Error())
+		return vmcommon.UserError
+	}
+	if !isAddressInList(delegatedVotePower.Validators, validatorAddress) {
+		delegatedVotePower.Validators = append(delegatedVotePower.Validators, validatorAddress)
+	}
+	err = g.saveDelegatedVotePowerData(destination, delegatedVotePower)
+	if err != nil {
+		g.eei.AddReturnMessage("saveDelegatedVotePowerData error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+// revokeVotePower gives back to the caller the voting power previously delegated to the provided address. Arguments
+// are the address holding the voting power and, optionally, the number of nodes to revoke. If the number of nodes is
+// missing, all the delegated voting power is revoked. Votes already cast by the address remain counted.
+func (g *governanceContract) revokeVotePower(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	returnCode := g.checkDelegationArguments(args, g.gasCost.MetaChainSystemSCsCost.RevokeVote)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	validatorAddress := args.CallerAddr
+	destination := args.Arguments[0]
+	numStakedNodes, err := g.numOfStakedNodes(validatorAddress)
+	if err != nil {
+		g.eei.AddReturnMessage("numOfStakedNodes error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	validatorData, err := g.getOrCreateValidatorData(validatorAddress, int32(numStakedNodes))
+	if err != nil {
+		g.eei.AddReturnMessage("getOrCreateValidatorData error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	destinationData := findVoterData(validatorData.Delegators, destination)
+	if destinationData == nil {
+		g.eei.AddReturnMessage(vm.ErrVotePowerNotDelegated.Error())
+		return vmcommon.UserError
+	}
+
+	numNodesToRevoke := destinationData.NumNodes
+	if len(args.Arguments) == 2 {
+		var ok bool
+		numNodesToRevoke, ok = numNodesFromArgument(args.Arguments[1])
+		if !ok || numNodesToRevoke > destinationData.NumNodes {
+			g.eei.AddReturnMessage("invalid number of nodes to revoke")
+			return vmcommon.UserError
+		}
+	}
+
+	selfData := findVoterData(validatorData.Delegators, validatorAddress)
+	if selfData == nil {
+		selfData = &VoterData{Address: validatorAddress}
+		validatorData.Delegators = append(validatorData.Delegators, selfData)
+	}
+	selfData.NumNodes += numNodesToRevoke
+	destinationData.NumNodes -= numNodesToRevoke
+	if destinationData.NumNodes > 0 {
+		err = g.saveValidatorData(validatorAddress, validatorData)
+		if err != nil {
+			g.eei.AddReturnMessage("saveValidatorData error " + err.Error())
+			return vmcommon.UserError
+		}
+
+		return vmcommon.Ok
+	}
+
+	validatorData.Delegators = removeVoterData(validatorData.Delegators, destination)
+	err = g.saveValidatorData(validatorAddress, validatorData)
+	if err != nil {
+		g.eei.AddReturnMessage("saveValidatorData error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	delegatedVotePower, err := g.getDelegatedVotePowerData(destination)
+	if err != nil {
+		g.eei.AddReturnMessage("getDelegatedVotePowerData error " + err.Error())
+		return vmcommon.UserError
+	}
+	delegatedVotePower.Validators = removeAddressFromList(delegatedVotePower.Validators, validatorAddress)
+	err = g.saveDelegatedVotePowerData(destination, delegatedVotePower)
+	if err != nil {
+		g.eei.AddReturnMessage("saveDelegatedVotePowerData error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (g *governanceContract) checkArgumentsForViewFunc(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		g.eei.AddReturnMessage(vm.ErrCallValueMustBeZero.Error())
+		return vmcommon.UserError
+	}
+	err := g.eei.UseGas(g.gasCost.MetaChainSystemSCsCost.Get)
+	if err != nil {
+		g.eei.AddReturnMessage("not enough gas")
+		return vmcommon.OutOfGas
+	}
+	if len(args.Arguments) != 1 {
+		g.eei.AddReturnMessage(vm.ErrInvalidNumOfArguments.Error())
+		return vmcommon.FunctionWrongSignature
+	}
+
+	return vmcommon.Ok
+}
+
+// getValidatorVotePower returns pairs of address and number of nodes for all the holders of the provided
+// validator's voting power, including the validator itself
+func (g *governanceContract) getValidatorVotePower(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	returnCode := g.checkArgumentsForViewFunc(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	validatorAddress := args.Arguments[0]
+	numStakedNodes, err := g.numOfStakedNodes(validatorAddress)
+	if err != nil {
+		g.eei.AddReturnMessage("numOfStakedNodes error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	validatorData, err := g.getOrCreateValidatorData(validatorAddress, int32(numStakedNodes))
+	if err != nil {
+		g.eei.AddReturnMessage("getOrCreateValidatorData error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	for _, voterData := range validatorData.Delegators {
+		g.eei.Finish(voterData.Address)
+		g.eei.Finish(big.NewInt(int64(voterData.NumNodes)).Bytes())
+	}
+
+	return vmcommon.Ok
+}
+
+// getDelegatedVotePower returns pairs of validator address and number of nodes for all the validators that
+// delegated voting power to the provided address
+func (g *governanceContract) getDelegatedVotePower(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	returnCode := g.checkArgumentsForViewFunc(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	address := args.Arguments[0]
+	delegatedVotePower, err := g.getDelegatedVotePowerData(address)
+	if err != nil {
+		g.eei.AddReturnMessage("getDelegatedVotePowerData error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	for _, validatorAddress := range delegatedVotePower.Validators {
+		key := append([]byte(validatorPrefix), validatorAddress...)
+		marshaledData := g.eei.GetStorage(key)
+		validatorData := &ValidatorData{}
+		err = g.marshalizer.Unmarshal(validatorData, marshaledData)
+		if err != nil {
+			g.eei.AddReturnMessage("unmarshal validator data error " + err.Error())
+			return vmcommon.UserError
+		}
+
+		numNodes := int32(0)
+		voterData := findVoterData(validatorData.Delegators, address)
+		if voterData != nil {
+			numNodes = voterData.NumNodes
+		}
+
+		g.eei.Finish(validatorAddress)
+		g.eei.Finish(big.NewInt(int64(numNodes)).Bytes())
+	}
+
+	return vmcommon.Ok
+}
+
+func findVoterData(voters []*VoterData, address []byte) *VoterData {
+	for _, voterData := range voters {
+		if bytes.Equal(voterData.Address, address) {
+			return voterData
+		}
+	}
+
+	return nil
+}
+
+func removeVoterData(voters []*VoterData, address []byte) []*VoterData {
+	for i, voterData := range voters {
+		if bytes.Equal(voterData.Address, address) {
+			return append(voters[:i], voters[i+1:]...)
+		}
+	}
+
+	return voters
+}
+
+func isAddressInList(addresses [][]byte, address []byte) bool {
+	for _, addressInList := range addresses {
+		if bytes.Equal(addressInList, address) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func removeAddressFromList(addresses [][]byte, address []byte) [][]byte {
+	for i, addressInList := range addresses {
+		if bytes.Equal(addressInList, address) {
+			return append(addresses[:i], addresses[i+1:]...)
+		}
+	}
+
+	return addresses
 }
 
 func (g *governanceContract) numOfStakedNodes(address []byte) (uint32, error) {
@@ -880,6 +1318,9 @@ func (g *governanceContract) closeProposal(args *vmcommon.ContractCallInput) vmc
 		key := append(proposal, voter...)
 		g.eei.SetStorage(key, nil)
 	}
+	for _, validator := range generalProposal.VotedValidators {
+		g.eei.SetStorage(createValidatorVotesKey(proposal, validator), nil)
+	}
 
 	return vmcommon.Ok
 }
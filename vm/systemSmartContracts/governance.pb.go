@@ -29,18 +29,19 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GeneralProposal struct {
-	IssuerAddress  []byte   `protobuf:"bytes,1,opt,name=IssuerAddress,proto3" json:"IssuerAddress"`
-	GitHubCommit   []byte   `protobuf:"bytes,2,opt,name=GitHubCommit,proto3" json:"GitHubCommit"`
-	StartVoteNonce uint64   `protobuf:"varint,3,opt,name=StartVoteNonce,proto3" json:"StartVoteNonce"`
-	EndVoteNonce   uint64   `protobuf:"varint,4,opt,name=EndVoteNonce,proto3" json:"EndVoteNonce"`
-	Yes            int32    `protobuf:"varint,5,opt,name=Yes,proto3" json:"Yes"`
-	No             int32    `protobuf:"varint,6,opt,name=No,proto3" json:"No"`
-	Veto           int32    `protobuf:"varint,7,opt,name=Veto,proto3" json:"Veto"`
-	DontCare       int32    `protobuf:"varint,8,opt,name=DontCare,proto3" json:"DontCare"`
-	Voted          bool     `protobuf:"varint,9,opt,name=Voted,proto3" json:"Voted"`
-	Voters         [][]byte `protobuf:"bytes,10,rep,name=Voters,proto3" json:"Voters"`
-	TopReference   []byte   `protobuf:"bytes,11,opt,name=TopReference,proto3" json:"TopReference"`
-	Closed         bool     `protobuf:"varint,12,opt,name=Closed,proto3" json:"Closed"`
+	IssuerAddress   []byte   `protobuf:"bytes,1,opt,name=IssuerAddress,proto3" json:"IssuerAddress"`
+	GitHubCommit    []byte   `protobuf:"bytes,2,opt,name=GitHubCommit,proto3" json:"GitHubCommit"`
+	StartVoteNonce  uint64   `protobuf:"varint,3,opt,name=StartVoteNonce,proto3" json:"StartVoteNonce"`
+	EndVoteNonce    uint64   `protobuf:"varint,4,opt,name=EndVoteNonce,proto3" json:"EndVoteNonce"`
+	Yes             int32    `protobuf:"varint,5,opt,name=Yes,proto3" json:"Yes"`
+	No              int32    `protobuf:"varint,6,opt,name=No,proto3" json:"No"`
+	Veto            int32    `protobuf:"varint,7,opt,name=Veto,proto3" json:"Veto"`
+	DontCare        int32    `protobuf:"varint,8,opt,name=DontCare,proto3" json:"DontCare"`
+	Voted           bool     `protobuf:"varint,9,opt,name=Voted,proto3" json:"Voted"`
+	Voters          [][]byte `protobuf:"bytes,10,rep,name=Voters,proto3" json:"Voters"`
+	TopReference    []byte   `protobuf:"bytes,11,opt,name=TopReference,proto3" json:"TopReference"`
+	Closed          bool     `protobuf:"varint,12,opt,name=Closed,proto3" json:"Closed"`
+	VotedValidators [][]byte `protobuf:"bytes,13,rep,name=VotedValidators,proto3" json:"VotedValidators"`
 }
 
 func (m *GeneralProposal) Reset()      { *m = GeneralProposal{} }
@@ -155,6 +156,13 @@ func (m *GeneralProposal) GetClosed() bool {
 	return false
 }
 
+func (m *GeneralProposal) GetVotedValidators() [][]byte {
+	if m != nil {
+		return m.VotedValidators
+	}
+	return nil
+}
+
 type WhiteListProposal struct {
 	WhiteListAddress []byte `protobuf:"bytes,1,opt,name=WhiteListAddress,proto3" json:"WhiteListAddress"`
 	ProposalStatus   []byte `protobuf:"bytes,2,opt,name=ProposalStatus,proto3" json:"ProposalStatus"`
@@ -469,6 +477,84 @@ func (m *VoteData) GetVoteValue() string {
 	return ""
 }
 
+type DelegatedVotePower struct {
+	Validators [][]byte `protobuf:"bytes,1,rep,name=Validators,proto3" json:"Validators"`
+}
+
+func (m *DelegatedVotePower) Reset()      { *m = DelegatedVotePower{} }
+func (*DelegatedVotePower) ProtoMessage() {}
+func (*DelegatedVotePower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a03da5266c714, []int{7}
+}
+func (m *DelegatedVotePower) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatedVotePower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DelegatedVotePower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatedVotePower.Merge(m, src)
+}
+func (m *DelegatedVotePower) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatedVotePower) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatedVotePower.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatedVotePower proto.InternalMessageInfo
+
+func (m *DelegatedVotePower) GetValidators() [][]byte {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+type ValidatorVotes struct {
+	Voters []*VoterData `protobuf:"bytes,1,rep,name=Voters,proto3" json:"Voters"`
+}
+
+func (m *ValidatorVotes) Reset()      { *m = ValidatorVotes{} }
+func (*ValidatorVotes) ProtoMessage() {}
+func (*ValidatorVotes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a03da5266c714, []int{8}
+}
+func (m *ValidatorVotes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorVotes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ValidatorVotes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorVotes.Merge(m, src)
+}
+func (m *ValidatorVotes) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorVotes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorVotes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorVotes proto.InternalMessageInfo
+
+func (m *ValidatorVotes) GetVoters() []*VoterData {
+	if m != nil {
+		return m.Voters
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GeneralProposal)(nil), "proto.GeneralProposal")
 	proto.RegisterType((*WhiteListProposal)(nil), "proto.WhiteListProposal")
@@ -477,65 +563,70 @@ func init() {
 	proto.RegisterType((*VoterData)(nil), "proto.VoterData")
 	proto.RegisterType((*ValidatorData)(nil), "proto.ValidatorData")
 	proto.RegisterType((*VoteData)(nil), "proto.VoteData")
+	proto.RegisterType((*DelegatedVotePower)(nil), "proto.DelegatedVotePower")
+	proto.RegisterType((*ValidatorVotes)(nil), "proto.ValidatorVotes")
 }
 
 func init() { proto.RegisterFile("governance.proto", fileDescriptor_e18a03da5266c714) }
 
 var fileDescriptor_e18a03da5266c714 = []byte{
-	// 834 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4d, 0x8b, 0x23, 0x45,
-	0x18, 0x4e, 0xe7, 0x63, 0x26, 0xa9, 0xc9, 0xec, 0x66, 0xdb, 0x65, 0x69, 0x45, 0xba, 0x42, 0x40,
-	0x08, 0xc8, 0x26, 0xa0, 0x82, 0xa0, 0x08, 0xbb, 0x9d, 0xf9, 0xd8, 0x01, 0xb7, 0x59, 0x6b, 0x86,
-	0x88, 0xe2, 0xa5, 0x92, 0xae, 0xe9, 0x34, 0x9b, 0xf4, 0x1b, 0xaa, 0xaa, 0x77, 0x10, 0x2f, 0xfe,
-	0x00, 0x0f, 0xfa, 0x2f, 0xc4, 0x5f, 0xe2, 0x71, 0x2e, 0xc2, 0x9c, 0x5a, 0x27, 0x83, 0x20, 0x75,
-	0xda, 0x9f, 0x20, 0x55, 0x9d, 0x74, 0xd2, 0xc9, 0x1c, 0xf4, 0xd2, 0xf5, 0x3e, 0xcf, 0xd3, 0xef,
-	0x47, 0xd5, 0xfb, 0x56, 0xa1, 0x56, 0x08, 0x6f, 0x18, 0x8f, 0x69, 0x3c, 0x66, 0xbd, 0x39, 0x07,
-	0x09, 0x76, 0xcd, 0x2c, 0xef, 0x3d, 0x0d, 0x23, 0x39, 0x49, 0x46, 0xbd, 0x31, 0xcc, 0xfa, 0x21,
-	0x84, 0xd0, 0x37, 0xf4, 0x28, 0xb9, 0x34, 0xc8, 0x00, 0x63, 0x65, 0x5e, 0x9d, 0x9f, 0xaa, 0xe8,
-	0xe1, 0x29, 0x8b, 0x19, 0xa7, 0xd3, 0x57, 0x1c, 0xe6, 0x20, 0xe8, 0xd4, 0xfe, 0x14, 0x1d, 0x9e,
-	0x09, 0x91, 0x30, 0xfe, 0x3c, 0x08, 0x38, 0x13, 0xc2, 0xb1, 0xda, 0x56, 0xb7, 0xe9, 0x3d, 0x52,
-	0x29, 0x2e, 0x0a, 0xa4, 0x08, 0xed, 0x4f, 0x50, 0xf3, 0x34, 0x92, 0x2f, 0x92, 0xd1, 0x00, 0x66,
-	0xb3, 0x48, 0x3a, 0x65, 0xe3, 0xd7, 0x52, 0x29, 0x2e, 0xf0, 0xa4, 0x80, 0xec, 0xcf, 0xd0, 0x83,
-	0x73, 0x49, 0xb9, 0x1c, 0x82, 0x64, 0x3e, 0xc4, 0x63, 0xe6, 0x54, 0xda, 0x56, 0xb7, 0xea, 0xd9,
-	0x2a, 0xc5, 0x5b, 0x0a, 0xd9, 0xc2, 0x3a, 0xe3, 0x71, 0x1c, 0xac, 0x3d, 0xab, 0xc6, 0xd3, 0x64,
-	0xdc, 0xe4, 0x49, 0x01, 0xd9, 0xef, 0xa2, 0xca, 0x37, 0x4c, 0x38, 0xb5, 0xb6, 0xd5, 0xad, 0x79,
-	0xfb, 0x2a, 0xc5, 0x1a, 0x12, 0xfd, 0xb1, 0x9f, 0xa0, 0xb2, 0x0f, 0xce, 0x9e, 0x51, 0xf6, 0x54,
-	0x8a, 0xcb, 0x3e, 0x90, 0xb2, 0x0f, 0xf6, 0xfb, 0xa8, 0x3a, 0x64, 0x12, 0x9c, 0x7d, 0xa3, 0xd4,
-	0x55, 0x8a, 0x0d, 0x26, 0xe6, 0x6b, 0x77, 0x51, 0xfd, 0x08, 0x62, 0x39, 0xa0, 0x9c, 0x39, 0x75,
-	0xf3, 0x47, 0x53, 0xa5, 0x38, 0xe7, 0x48, 0x6e, 0xd9, 0x18, 0xd5, 0x74, 0x1d, 0x81, 0xd3, 0x68,
-	0x5b, 0xdd, 0xba, 0xd7, 0x50, 0x29, 0xce, 0x08, 0x92, 0x2d, 0x76, 0x07, 0xed, 0x69, 0x83, 0x0b,
-	0x07, 0xb5, 0x2b, 0xdd, 0xa6, 0x87, 0x54, 0x8a, 0x97, 0x0c, 0x59, 0xae, 0x7a, 0xd7, 0x17, 0x30,
-	0x27, 0xec, 0x92, 0x71, 0xa6, 0x77, 0x7d, 0xb0, 0x3e, 0xe7, 0x4d, 0x9e, 0x14, 0x90, 0x8e, 0x3c,
-	0x98, 0x82, 0x60, 0x81, 0xd3, 0x34, 0xb9, 0x4d, 0xe4, 0x8c, 0x21, 0xcb, 0xb5, 0xf3, 0x8b, 0x85,
-	0x1e, 0x7d, 0x3d, 0x89, 0x24, 0xfb, 0x32, 0x12, 0x32, 0x1f, 0x88, 0x67, 0xa8, 0x95, 0x93, 0xc5,
-	0x99, 0x78, 0xac, 0x52, 0xbc, 0xa3, 0x91, 0x1d, 0x46, 0xf7, 0x78, 0x15, 0xed, 0x5c, 0x52, 0x99,
-	0x88, 0xe5, 0x6c, 0x98, 0x1e, 0x17, 0x15, 0xb2, 0x85, 0x3b, 0x7f, 0x58, 0xa8, 0xf5, 0x82, 0xf2,
-	0xe0, 0x04, 0xf8, 0xeb, 0xbc, 0xa4, 0x2f, 0xd0, 0xc3, 0xe3, 0x39, 0x8c, 0x27, 0x17, 0xb0, 0x92,
-	0x4c, 0x45, 0x87, 0xde, 0x3b, 0x2a, 0xc5, 0xdb, 0x12, 0xd9, 0x26, 0xec, 0x13, 0x64, 0xfb, 0xec,
-	0xea, 0x1c, 0x2e, 0xe5, 0x15, 0xe5, 0x6c, 0xc8, 0xb8, 0x88, 0x20, 0x5e, 0xd6, 0xf4, 0x44, 0xa5,
-	0xf8, 0x1e, 0x95, 0xdc, 0xc3, 0xdd, 0xb3, 0xaf, 0xca, 0x7f, 0xde, 0xd7, 0xdf, 0x65, 0xd4, 0x3a,
-	0xcd, 0x6f, 0xf1, 0x00, 0xe2, 0xcb, 0x28, 0xd4, 0x93, 0xe4, 0x27, 0x33, 0x1f, 0x02, 0x96, 0x1d,
-	0x71, 0x25, 0x9b, 0xa4, 0x15, 0x47, 0x72, 0xcb, 0xfe, 0x10, 0x35, 0x5e, 0x46, 0xf1, 0x57, 0x09,
-	0xf0, 0x64, 0x66, 0x2a, 0xaf, 0x79, 0x87, 0x2a, 0xc5, 0x6b, 0x92, 0xac, 0x4d, 0xdd, 0xc1, 0x97,
-	0x51, 0xfc, 0x8a, 0x0a, 0x71, 0x31, 0xe1, 0x4c, 0x4c, 0x60, 0x1a, 0x98, 0x4a, 0x6b, 0x59, 0x07,
-	0xb7, 0x35, 0xb2, 0xc3, 0x2c, 0x23, 0xe8, 0x69, 0x5f, 0x47, 0xa8, 0x16, 0x22, 0x14, 0x34, 0xb2,
-	0xc3, 0xd8, 0x6f, 0xd0, 0xc1, 0xea, 0x04, 0x4e, 0x18, 0x33, 0xb7, 0xaf, 0xe9, 0x5d, 0xa8, 0x14,
-	0x6f, 0xd2, 0xbf, 0xfd, 0x89, 0x9f, 0xcf, 0xa8, 0x9c, 0xf4, 0x47, 0x51, 0xd8, 0x3b, 0x8b, 0xe5,
-	0xe7, 0x1b, 0xcf, 0xd9, 0xf1, 0x94, 0x43, 0x1c, 0xf8, 0x4c, 0x5e, 0x01, 0x7f, 0xdd, 0x67, 0x06,
-	0x3d, 0x0d, 0xa1, 0x1f, 0x50, 0x49, 0x7b, 0x5e, 0x14, 0x9e, 0xe9, 0x3b, 0x26, 0x24, 0xe3, 0x64,
-	0x33, 0x62, 0xe7, 0x3b, 0xd4, 0x30, 0xf7, 0xe6, 0x88, 0x4a, 0x6a, 0x7f, 0x80, 0xf6, 0x8b, 0x13,
-	0x7c, 0xa0, 0x52, 0xbc, 0xa2, 0xc8, 0xca, 0x28, 0xb4, 0xa1, 0xbc, 0xbe, 0xd0, 0xbb, 0x6d, 0xe8,
-	0xfc, 0x80, 0x0e, 0x87, 0x74, 0x1a, 0x05, 0x54, 0x42, 0x96, 0xe1, 0x19, 0x42, 0x47, 0x6c, 0xca,
-	0x42, 0x4d, 0xe8, 0x24, 0x95, 0xee, 0xc1, 0x47, 0xad, 0xec, 0xb5, 0xed, 0xe5, 0x75, 0x78, 0x0f,
-	0x54, 0x8a, 0x37, 0xfe, 0x23, 0x1b, 0xf6, 0xff, 0x48, 0x4e, 0x51, 0x5d, 0x87, 0x34, 0x79, 0x33,
-	0x2f, 0x0d, 0xb3, 0xad, 0x2d, 0xbd, 0x56, 0x3a, 0xc9, 0x55, 0x3d, 0x39, 0xda, 0x18, 0xd2, 0x69,
-	0xc2, 0x4c, 0x82, 0x46, 0x36, 0x39, 0x39, 0x49, 0xd6, 0xa6, 0xe7, 0x5f, 0xdf, 0xba, 0xa5, 0x9b,
-	0x5b, 0xb7, 0xf4, 0xf6, 0xd6, 0xb5, 0x7e, 0x5c, 0xb8, 0xd6, 0xaf, 0x0b, 0xd7, 0xfa, 0x7d, 0xe1,
-	0x5a, 0xd7, 0x0b, 0xd7, 0xba, 0x59, 0xb8, 0xd6, 0x5f, 0x0b, 0xd7, 0xfa, 0x67, 0xe1, 0x96, 0xde,
-	0x2e, 0x5c, 0xeb, 0xe7, 0x3b, 0xb7, 0x74, 0x7d, 0xe7, 0x96, 0x6e, 0xee, 0xdc, 0xd2, 0xb7, 0x8f,
-	0xc5, 0xf7, 0x42, 0xb2, 0xd9, 0xf9, 0x8c, 0x72, 0x39, 0x80, 0x58, 0x72, 0x3a, 0x96, 0x62, 0xb4,
-	0x67, 0x4e, 0xe2, 0xe3, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x16, 0x85, 0xae, 0x19, 0xc1, 0x06,
-	0x00, 0x00,
+	// 893 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xdd, 0x6a, 0x23, 0x37,
+	0x1b, 0xb6, 0xfc, 0x93, 0xd8, 0x8a, 0x9d, 0x78, 0xf5, 0x2d, 0xcb, 0x7c, 0xa5, 0x8c, 0x8c, 0xa1,
+	0x60, 0x28, 0x6b, 0x43, 0xbb, 0x50, 0x68, 0x59, 0xd8, 0x1d, 0xe7, 0x67, 0x03, 0xdd, 0x21, 0x55,
+	0x82, 0x4b, 0x4b, 0x4f, 0x64, 0x8f, 0x32, 0x1e, 0xd6, 0x1e, 0x05, 0x49, 0xb3, 0xa1, 0xf4, 0xa4,
+	0x97, 0xd0, 0xde, 0x45, 0xe9, 0x95, 0x14, 0x7a, 0x92, 0x93, 0x42, 0x8e, 0xa6, 0x8d, 0x43, 0xa1,
+	0xcc, 0xd1, 0x5e, 0x42, 0x91, 0xc6, 0x1e, 0xcf, 0xd8, 0x29, 0xb4, 0x27, 0xa3, 0xf7, 0x79, 0x9e,
+	0xd1, 0xfb, 0x23, 0xbd, 0x7a, 0x61, 0xdb, 0xe7, 0x6f, 0x99, 0x08, 0x69, 0x38, 0x61, 0xfd, 0x2b,
+	0xc1, 0x15, 0x47, 0x35, 0xb3, 0xbc, 0xf7, 0xd4, 0x0f, 0xd4, 0x34, 0x1a, 0xf7, 0x27, 0x7c, 0x3e,
+	0xf0, 0xb9, 0xcf, 0x07, 0x86, 0x1e, 0x47, 0x97, 0x06, 0x19, 0x60, 0xac, 0x74, 0x57, 0xf7, 0xd7,
+	0x2a, 0x3c, 0x38, 0x61, 0x21, 0x13, 0x74, 0x76, 0x26, 0xf8, 0x15, 0x97, 0x74, 0x86, 0x3e, 0x81,
+	0xad, 0x53, 0x29, 0x23, 0x26, 0x5e, 0x7a, 0x9e, 0x60, 0x52, 0x5a, 0xa0, 0x03, 0x7a, 0x4d, 0xe7,
+	0x51, 0x12, 0xe3, 0xa2, 0x40, 0x8a, 0x10, 0x3d, 0x83, 0xcd, 0x93, 0x40, 0xbd, 0x8a, 0xc6, 0x43,
+	0x3e, 0x9f, 0x07, 0xca, 0x2a, 0x9b, 0x7d, 0xed, 0x24, 0xc6, 0x05, 0x9e, 0x14, 0x10, 0xfa, 0x14,
+	0xee, 0x9f, 0x2b, 0x2a, 0xd4, 0x88, 0x2b, 0xe6, 0xf2, 0x70, 0xc2, 0xac, 0x4a, 0x07, 0xf4, 0xaa,
+	0x0e, 0x4a, 0x62, 0xbc, 0xa1, 0x90, 0x0d, 0xac, 0x23, 0x1e, 0x85, 0xde, 0x7a, 0x67, 0xd5, 0xec,
+	0x34, 0x11, 0xf3, 0x3c, 0x29, 0x20, 0xf4, 0x7f, 0x58, 0xf9, 0x8a, 0x49, 0xab, 0xd6, 0x01, 0xbd,
+	0x9a, 0xb3, 0x9b, 0xc4, 0x58, 0x43, 0xa2, 0x3f, 0xe8, 0x09, 0x2c, 0xbb, 0xdc, 0xda, 0x31, 0xca,
+	0x4e, 0x12, 0xe3, 0xb2, 0xcb, 0x49, 0xd9, 0xe5, 0xe8, 0x7d, 0x58, 0x1d, 0x31, 0xc5, 0xad, 0x5d,
+	0xa3, 0xd4, 0x93, 0x18, 0x1b, 0x4c, 0xcc, 0x17, 0xf5, 0x60, 0xfd, 0x90, 0x87, 0x6a, 0x48, 0x05,
+	0xb3, 0xea, 0xe6, 0x8f, 0x66, 0x12, 0xe3, 0x8c, 0x23, 0x99, 0x85, 0x30, 0xac, 0xe9, 0x3c, 0x3c,
+	0xab, 0xd1, 0x01, 0xbd, 0xba, 0xd3, 0x48, 0x62, 0x9c, 0x12, 0x24, 0x5d, 0x50, 0x17, 0xee, 0x68,
+	0x43, 0x48, 0x0b, 0x76, 0x2a, 0xbd, 0xa6, 0x03, 0x93, 0x18, 0x2f, 0x19, 0xb2, 0x5c, 0x75, 0xd5,
+	0x17, 0xfc, 0x8a, 0xb0, 0x4b, 0x26, 0x98, 0xae, 0x7a, 0x6f, 0x7d, 0xce, 0x79, 0x9e, 0x14, 0x90,
+	0xf6, 0x3c, 0x9c, 0x71, 0xc9, 0x3c, 0xab, 0x69, 0x62, 0x1b, 0xcf, 0x29, 0x43, 0x96, 0x2b, 0x7a,
+	0x0e, 0x0f, 0x4c, 0x1a, 0x23, 0x3a, 0x0b, 0x3c, 0xaa, 0xb8, 0x90, 0x56, 0xcb, 0xa4, 0xf1, 0xbf,
+	0x24, 0xc6, 0x9b, 0x12, 0xd9, 0x24, 0xba, 0x3f, 0x02, 0xf8, 0xe8, 0xcb, 0x69, 0xa0, 0xd8, 0xe7,
+	0x81, 0x54, 0x59, 0x3f, 0xbd, 0x80, 0xed, 0x8c, 0x2c, 0xb6, 0xd4, 0xe3, 0x24, 0xc6, 0x5b, 0x1a,
+	0xd9, 0x62, 0x74, 0x8b, 0xac, 0xbc, 0x9d, 0x2b, 0xaa, 0x22, 0xb9, 0x6c, 0x2d, 0xd3, 0x22, 0x45,
+	0x85, 0x6c, 0xe0, 0xee, 0x6f, 0x00, 0xb6, 0x5f, 0x51, 0xe1, 0x1d, 0x73, 0xf1, 0x26, 0x4b, 0xe9,
+	0x39, 0x3c, 0x38, 0xba, 0xe2, 0x93, 0xe9, 0x05, 0x5f, 0x49, 0x26, 0xa3, 0x56, 0x5a, 0xe7, 0x86,
+	0x44, 0x36, 0x09, 0x74, 0x0c, 0x91, 0xcb, 0xae, 0xcf, 0xf9, 0xa5, 0xba, 0xa6, 0x82, 0x8d, 0x98,
+	0x90, 0x01, 0x0f, 0x97, 0x39, 0x3d, 0x49, 0x62, 0xfc, 0x80, 0x4a, 0x1e, 0xe0, 0x1e, 0xa8, 0xab,
+	0xf2, 0xaf, 0xeb, 0xfa, 0xb3, 0x0c, 0xdb, 0x27, 0xd9, 0x10, 0x18, 0xf2, 0xf0, 0x32, 0xf0, 0x75,
+	0x23, 0xba, 0xd1, 0xdc, 0xe5, 0x1e, 0x4b, 0x8f, 0xb8, 0x92, 0x36, 0xe2, 0x8a, 0x23, 0x99, 0x85,
+	0x3e, 0x84, 0x8d, 0xd7, 0x41, 0xf8, 0x45, 0xc4, 0x45, 0x34, 0x37, 0x99, 0xd7, 0x9c, 0x56, 0x12,
+	0xe3, 0x35, 0x49, 0xd6, 0xa6, 0xbe, 0xc1, 0xd7, 0x41, 0x78, 0x46, 0xa5, 0xbc, 0x98, 0x0a, 0x26,
+	0xa7, 0x7c, 0xe6, 0x99, 0x4c, 0x6b, 0xe9, 0x0d, 0x6e, 0x6a, 0x64, 0x8b, 0x59, 0x7a, 0xd0, 0x8f,
+	0x65, 0xed, 0xa1, 0x5a, 0xf0, 0x50, 0xd0, 0xc8, 0x16, 0x83, 0xde, 0xc2, 0xbd, 0xd5, 0x09, 0x1c,
+	0x33, 0x66, 0x1e, 0x6f, 0xd3, 0xb9, 0x48, 0x62, 0x9c, 0xa7, 0x7f, 0xfe, 0x1d, 0xbf, 0x9c, 0x53,
+	0x35, 0x1d, 0x8c, 0x03, 0xbf, 0x7f, 0x1a, 0xaa, 0xcf, 0x72, 0xd3, 0xf0, 0x68, 0x26, 0x78, 0xe8,
+	0xb9, 0x4c, 0x5d, 0x73, 0xf1, 0x66, 0xc0, 0x0c, 0x7a, 0xea, 0xf3, 0x81, 0x47, 0x15, 0xed, 0x3b,
+	0x81, 0x7f, 0xaa, 0x9f, 0xa8, 0x54, 0x4c, 0x90, 0xbc, 0xc7, 0xee, 0x37, 0xb0, 0x61, 0x9e, 0xdd,
+	0x21, 0x55, 0x14, 0x7d, 0x00, 0x77, 0x8b, 0x1d, 0xbc, 0x97, 0xc4, 0x78, 0x45, 0x91, 0x95, 0x51,
+	0xb8, 0x86, 0xf2, 0x7a, 0x1e, 0x6c, 0x5f, 0x43, 0xf7, 0x3b, 0xd8, 0xca, 0xde, 0x8f, 0x89, 0xf0,
+	0x02, 0xc2, 0x43, 0x36, 0x63, 0x7e, 0xfa, 0xf8, 0x40, 0xa7, 0xd2, 0xdb, 0xfb, 0xa8, 0x9d, 0x0e,
+	0xeb, 0x7e, 0x96, 0x87, 0xb3, 0x9f, 0xc4, 0x38, 0xf7, 0x1f, 0xc9, 0xd9, 0xff, 0x21, 0x38, 0x85,
+	0x75, 0xed, 0xd2, 0xc4, 0x4d, 0x77, 0x69, 0x98, 0x96, 0xb6, 0xdc, 0xb5, 0xd2, 0x49, 0xa6, 0xea,
+	0xce, 0xd1, 0xc6, 0x88, 0xce, 0x22, 0x66, 0x02, 0x34, 0xd2, 0xce, 0xc9, 0x48, 0xb2, 0x36, 0xbb,
+	0x87, 0x10, 0x2d, 0x53, 0x63, 0x66, 0x00, 0x9f, 0xf1, 0x6b, 0x26, 0x50, 0x1f, 0xc2, 0xdc, 0x84,
+	0x01, 0x66, 0xc2, 0x98, 0x92, 0xd6, 0x2c, 0xc9, 0xd9, 0xdd, 0x63, 0xb8, 0x9f, 0xa1, 0x34, 0x89,
+	0x67, 0xd9, 0x98, 0xfc, 0xa7, 0x23, 0x7a, 0x60, 0x70, 0x3a, 0xee, 0xcd, 0x9d, 0x5d, 0xba, 0xbd,
+	0xb3, 0x4b, 0xef, 0xee, 0x6c, 0xf0, 0xfd, 0xc2, 0x06, 0x3f, 0x2d, 0x6c, 0xf0, 0xcb, 0xc2, 0x06,
+	0x37, 0x0b, 0x1b, 0xdc, 0x2e, 0x6c, 0xf0, 0xc7, 0xc2, 0x06, 0x7f, 0x2d, 0xec, 0xd2, 0xbb, 0x85,
+	0x0d, 0x7e, 0xb8, 0xb7, 0x4b, 0x37, 0xf7, 0x76, 0xe9, 0xf6, 0xde, 0x2e, 0x7d, 0xfd, 0x58, 0x7e,
+	0x2b, 0x15, 0x9b, 0x9f, 0xcf, 0xa9, 0x50, 0x43, 0x1e, 0x2a, 0x41, 0x27, 0x4a, 0x8e, 0x77, 0x4c,
+	0xd0, 0x8f, 0xff, 0x1e, 0x00, 0x96, 0xb2, 0x07, 0x61, 0x8e, 0x07, 0x00, 0x00,
 }
 
 func (this *GeneralProposal) Equal(that interface{}) bool {
@@ -598,6 +689,14 @@ func (this *GeneralProposal) Equal(that interface{}) bool {
 	if this.Closed != that1.Closed {
 		return false
 	}
+	if len(this.VotedValidators) != len(that1.VotedValidators) {
+		return false
+	}
+	for i := range this.VotedValidators {
+		if !bytes.Equal(this.VotedValidators[i], that1.VotedValidators[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *WhiteListProposal) Equal(that interface{}) bool {
@@ -782,11 +881,69 @@ func (this *VoteData) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *DelegatedVotePower) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegatedVotePower)
+	if !ok {
+		that2, ok := that.(DelegatedVotePower)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Validators) != len(that1.Validators) {
+		return false
+	}
+	for i := range this.Validators {
+		if !bytes.Equal(this.Validators[i], that1.Validators[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ValidatorVotes) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorVotes)
+	if !ok {
+		that2, ok := that.(ValidatorVotes)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Voters) != len(that1.Voters) {
+		return false
+	}
+	for i := range this.Voters {
+		if !this.Voters[i].Equal(that1.Voters[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *GeneralProposal) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 16)
+	s := make([]string, 0, 17)
 	s = append(s, "&systemSmartContracts.GeneralProposal{")
 	s = append(s, "IssuerAddress: "+fmt.Sprintf("%#v", this.IssuerAddress)+",\n")
 	s = append(s, "GitHubCommit: "+fmt.Sprintf("%#v", this.GitHubCommit)+",\n")
@@ -800,6 +957,7 @@ func (this *GeneralProposal) GoString() string {
 	s = append(s, "Voters: "+fmt.Sprintf("%#v", this.Voters)+",\n")
 	s = append(s, "TopReference: "+fmt.Sprintf("%#v", this.TopReference)+",\n")
 	s = append(s, "Closed: "+fmt.Sprintf("%#v", this.Closed)+",\n")
+	s = append(s, "VotedValidators: "+fmt.Sprintf("%#v", this.VotedValidators)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -875,6 +1033,28 @@ func (this *VoteData) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *DelegatedVotePower) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&systemSmartContracts.DelegatedVotePower{")
+	s = append(s, "Validators: "+fmt.Sprintf("%#v", this.Validators)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ValidatorVotes) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&systemSmartContracts.ValidatorVotes{")
+	if this.Voters != nil {
+		s = append(s, "Voters: "+fmt.Sprintf("%#v", this.Voters)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringGovernance(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -903,6 +1083,15 @@ func (m *GeneralProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VotedValidators) > 0 {
+		for iNdEx := len(m.VotedValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VotedValidators[iNdEx])
+			copy(dAtA[i:], m.VotedValidators[iNdEx])
+			i = encodeVarintGovernance(dAtA, i, uint64(len(m.VotedValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
 	if m.Closed {
 		i--
 		if m.Closed {
@@ -1231,6 +1420,75 @@ func (m *VoteData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DelegatedVotePower) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatedVotePower) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatedVotePower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintGovernance(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorVotes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorVotes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorVotes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Voters) > 0 {
+		for iNdEx := len(m.Voters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Voters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGovernance(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGovernance(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGovernance(v)
 	base := offset
@@ -1290,6 +1548,12 @@ func (m *GeneralProposal) Size() (n int) {
 	if m.Closed {
 		n += 2
 	}
+	if len(m.VotedValidators) > 0 {
+		for _, b := range m.VotedValidators {
+			l = len(b)
+			n += 1 + l + sovGovernance(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1406,6 +1670,36 @@ func (m *VoteData) Size() (n int) {
 	return n
 }
 
+func (m *DelegatedVotePower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, b := range m.Validators {
+			l = len(b)
+			n += 1 + l + sovGovernance(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorVotes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Voters) > 0 {
+		for _, e := range m.Voters {
+			l = e.Size()
+			n += 1 + l + sovGovernance(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovGovernance(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1429,6 +1723,7 @@ func (this *GeneralProposal) String() string {
 		`Voters:` + fmt.Sprintf("%v", this.Voters) + `,`,
 		`TopReference:` + fmt.Sprintf("%v", this.TopReference) + `,`,
 		`Closed:` + fmt.Sprintf("%v", this.Closed) + `,`,
+		`VotedValidators:` + fmt.Sprintf("%v", this.VotedValidators) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1508,6 +1803,31 @@ func (this *VoteData) String() string {
 	}, "")
 	return s
 }
+func (this *DelegatedVotePower) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DelegatedVotePower{`,
+		`Validators:` + fmt.Sprintf("%v", this.Validators) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ValidatorVotes) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForVoters := "[]*VoterData{"
+	for _, f := range this.Voters {
+		repeatedStringForVoters += strings.Replace(f.String(), "VoterData", "VoterData", 1) + ","
+	}
+	repeatedStringForVoters += "}"
+	s := strings.Join([]string{`&ValidatorVotes{`,
+		`Voters:` + repeatedStringForVoters + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringGovernance(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1833,6 +2153,38 @@ func (m *GeneralProposal) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Closed = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotedValidators", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotedValidators = append(m.VotedValidators, make([]byte, postIndex-iNdEx))
+			copy(m.VotedValidators[len(m.VotedValidators)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGovernance(dAtA[iNdEx:])
@@ -2601,6 +2953,178 @@ func (m *VoteData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DelegatedVotePower) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGovernance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatedVotePower: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatedVotePower: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, make([]byte, postIndex-iNdEx))
+			copy(m.Validators[len(m.Validators)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGovernance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorVotes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGovernance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorVotes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorVotes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voters = append(m.Voters, &VoterData{})
+			if err := m.Voters[len(m.Voters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGovernance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGovernance(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -669,3 +669,218 @@ func openProposal(t *testing.T, g *governanceContract, propType string, WLAddr,
 	retCode := g.Execute(callInput)
 	require.Equal(t, vmcommon.Ok, retCode)
 }
+
+func createGovernanceWithStakedValidators(
+	t *testing.T,
+	blockChainHook *mock.BlockChainHookStub,
+	validators map[string]int,
+) (*governanceContract, *vmContext) {
+	atArgParser := parsers.NewCallArgsParser()
+	eei, _ := NewVMContext(
+		blockChainHook,
+		hooks.NewVMCryptoHook(),
+		atArgParser,
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+	eei.SetSCAddress([]byte("addr"))
+
+	args := createMockGovernanceArgs()
+	nodeData := &StakedDataV2_0{
+		Staked: true,
+	}
+	stakedDataBytes, _ := json.Marshal(nodeData)
+	for validatorAddress, numNodes := range validators {
+		validatorData := &ValidatorDataV2{
+			NumRegistered: uint32(numNodes),
+			BlsPubKeys:    make([][]byte, 0, numNodes),
+		}
+		for i := 0; i < numNodes; i++ {
+			blsKey := []byte(fmt.Sprintf("%s_blsKey%d", validatorAddress, i))
+			validatorData.BlsPubKeys = append(validatorData.BlsPubKeys, blsKey)
+			eei.SetStorageForAddress(args.StakingSCAddress, blsKey, stakedDataBytes)
+		}
+		validatorDataBytes, _ := json.Marshal(validatorData)
+		eei.SetStorageForAddress(args.ValidatorSCAddress, []byte(validatorAddress), validatorDataBytes)
+	}
+
+	args.Eei = eei
+	gsc, err := NewGovernanceContract(args)
+	require.Nil(t, err)
+	gsc.EpochConfirmed(0)
+
+	return gsc, eei
+}
+
+func createProposalForDelegatedVoting(t *testing.T, gsc *governanceContract, blockChainHook *mock.BlockChainHookStub) []byte {
+	recipientAddr := []byte("recipientAddress")
+	initGovernanceSc(t, gsc, []byte("owner"), recipientAddr)
+	whiteListAddrAtGenesis(t, gsc, []byte("genWL"), recipientAddr)
+
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return 1
+	}
+	gitHubCommit := []byte("0123456789012345678901234567890123456789")
+	openProposal(t, gsc, "proposal", []byte("genWL"), recipientAddr, gitHubCommit, 10, 100)
+
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return 11
+	}
+
+	return gitHubCommit
+}
+
+func delegateVotePower(gsc *governanceContract, validator []byte, destination []byte, numNodes int) vmcommon.ReturnCode {
+	callInput := createVMInput(big.NewInt(0), "delegateVotePower", validator, []byte("recipientAddress"))
+	callInput.Arguments = [][]byte{destination, []byte(fmt.Sprintf("%d", numNodes))}
+	return gsc.Execute(callInput)
+}
+
+func voteWithDelegatedPower(gsc *governanceContract, voter []byte, proposal []byte, validator []byte) vmcommon.ReturnCode {
+	callInput := createVMInput(big.NewInt(0), "vote", voter, []byte("recipientAddress"))
+	callInput.Arguments = [][]byte{proposal, []byte("yes")}
+	if !bytes.Equal(voter, validator) {
+		callInput.Arguments = append(callInput.Arguments, validator)
+	}
+	return gsc.Execute(callInput)
+}
+
+func getProposalForTest(gsc *governanceContract, proposal []byte) *GeneralProposal {
+	generalProposal, _ := gsc.getGeneralProposal(proposal)
+	return generalProposal
+}
+
+func TestGovernanceContract_DelegateVotePowerInvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	gsc, _ := createGovernanceWithStakedValidators(t, &mock.BlockChainHookStub{}, map[string]int{"vala1": 2})
+	validator := []byte("vala1")
+
+	retCode := delegateVotePower(gsc, validator, []byte("longerAddress"), 1)
+	require.Equal(t, vmcommon.FunctionWrongSignature, retCode)
+
+	retCode = delegateVotePower(gsc, validator, validator, 1)
+	require.Equal(t, vmcommon.FunctionWrongSignature, retCode)
+
+	retCode = delegateVotePower(gsc, validator, []byte("deleg"), 0)
+	require.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = delegateVotePower(gsc, validator, []byte("deleg"), 3)
+	require.Equal(t, vmcommon.UserError, retCode)
+	require.True(t, strings.Contains(gsc.eei.(*vmContext).returnMessage, vm.ErrNotEnoughVotingPower.Error()))
+
+	retCode = delegateVotePower(gsc, []byte("nonSt"), []byte("deleg"), 1)
+	require.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestGovernanceContract_DelegateVotePowerShouldWork(t *testing.T) {
+	t.Parallel()
+
+	gsc, eei := createGovernanceWithStakedValidators(t, &mock.BlockChainHookStub{}, map[string]int{"vala1": 3})
+	validator := []byte("vala1")
+	destination := []byte("deleg")
+
+	retCode := delegateVotePower(gsc, validator, destination, 2)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	eei.output = make([][]byte, 0)
+	callInput := createVMInput(big.NewInt(0), "getValidatorVotePower", []byte("caller"), []byte("recipientAddress"))
+	callInput.Arguments = [][]byte{validator}
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+	require.Equal(t, [][]byte{validator, big.NewInt(1).Bytes(), destination, big.NewInt(2).Bytes()}, eei.output)
+
+	eei.output = make([][]byte, 0)
+	callInput = createVMInput(big.NewInt(0), "getDelegatedVotePower", []byte("caller"), []byte("recipientAddress"))
+	callInput.Arguments = [][]byte{destination}
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+	require.Equal(t, [][]byte{validator, big.NewInt(2).Bytes()}, eei.output)
+}
+
+func TestGovernanceContract_RevokeVotePowerShouldWork(t *testing.T) {
+	t.Parallel()
+
+	gsc, eei := createGovernanceWithStakedValidators(t, &mock.BlockChainHookStub{}, map[string]int{"vala1": 3})
+	validator := []byte("vala1")
+	destination := []byte("deleg")
+
+	callInput := createVMInput(big.NewInt(0), "revokeVotePower", validator, []byte("recipientAddress"))
+	callInput.Arguments = [][]byte{destination}
+	retCode := gsc.Execute(callInput)
+	require.Equal(t, vmcommon.UserError, retCode)
+	require.True(t, strings.Contains(eei.returnMessage, vm.ErrVotePowerNotDelegated.Error()))
+
+	retCode = delegateVotePower(gsc, validator, destination, 3)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	callInput.Arguments = [][]byte{destination, []byte("1")}
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	validatorData, _ := gsc.getOrCreateValidatorData(validator, 3)
+	require.Equal(t, int32(1), findVoterData(validatorData.Delegators, validator).NumNodes)
+	require.Equal(t, int32(2), findVoterData(validatorData.Delegators, destination).NumNodes)
+
+	callInput.Arguments = [][]byte{destination}
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	validatorData, _ = gsc.getOrCreateValidatorData(validator, 3)
+	require.Equal(t, int32(3), findVoterData(validatorData.Delegators, validator).NumNodes)
+	require.Nil(t, findVoterData(validatorData.Delegators, destination))
+
+	delegatedVotePower, _ := gsc.getDelegatedVotePowerData(destination)
+	require.Equal(t, 0, len(delegatedVotePower.Validators))
+}
+
+func TestGovernanceContract_VoteWithDelegatedPowerFromSeveralValidators(t *testing.T) {
+	t.Parallel()
+
+	blockChainHook := &mock.BlockChainHookStub{}
+	gsc, _ := createGovernanceWithStakedValidators(t, blockChainHook, map[string]int{"vala1": 2, "vala2": 3})
+	proposal := createProposalForDelegatedVoting(t, gsc, blockChainHook)
+	destination := []byte("deleg")
+
+	require.Equal(t, vmcommon.Ok, delegateVotePower(gsc, []byte("vala1"), destination, 2))
+	require.Equal(t, vmcommon.Ok, delegateVotePower(gsc, []byte("vala2"), destination, 1))
+
+	require.Equal(t, vmcommon.Ok, voteWithDelegatedPower(gsc, destination, proposal, []byte("vala1")))
+	require.Equal(t, vmcommon.Ok, voteWithDelegatedPower(gsc, destination, proposal, []byte("vala2")))
+	require.Equal(t, vmcommon.Ok, voteWithDelegatedPower(gsc, []byte("vala2"), proposal, []byte("vala2")))
+
+	generalProposal := getProposalForTest(gsc, proposal)
+	require.Equal(t, int32(5), generalProposal.Yes)
+	require.Equal(t, 2, len(generalProposal.Voters))
+
+	voteData, _ := gsc.getOrCreateVoteData(proposal, destination)
+	require.Equal(t, int32(3), voteData.NumVotes)
+
+	// voting again does not count the same power twice
+	require.Equal(t, vmcommon.Ok, voteWithDelegatedPower(gsc, destination, proposal, []byte("vala1")))
+	generalProposal = getProposalForTest(gsc, proposal)
+	require.Equal(t, int32(5), generalProposal.Yes)
+}
+
+func TestGovernanceContract_VoteAfterRevokeShouldNotDoubleVote(t *testing.T) {
+	t.Parallel()
+
+	blockChainHook := &mock.BlockChainHookStub{}
+	gsc, eei := createGovernanceWithStakedValidators(t, blockChainHook, map[string]int{"vala1": 2})
+	proposal := createProposalForDelegatedVoting(t, gsc, blockChainHook)
+	validator := []byte("vala1")
+	destination := []byte("deleg")
+
+	require.Equal(t, vmcommon.Ok, delegateVotePower(gsc, validator, destination, 2))
+	require.Equal(t, vmcommon.Ok, voteWithDelegatedPower(gsc, destination, proposal, validator))
+
+	callInput := createVMInput(big.NewInt(0), "revokeVotePower", validator, []byte("recipientAddress"))
+	callInput.Arguments = [][]byte{destination}
+	require.Equal(t, vmcommon.Ok, gsc.Execute(callInput))
+
+	retCode := voteWithDelegatedPower(gsc, validator, proposal, validator)
+	require.Equal(t, vmcommon.UserError, retCode)
+	require.True(t, strings.Contains(eei.returnMessage, vm.ErrVotingPowerAlreadyUsed.Error()))
+
+	generalProposal := getProposalForTest(gsc, proposal)
+	require.Equal(t, int32(2), generalProposal.Yes)
+}
@@ -26,6 +26,7 @@ const ownerKey = "owner"
 const nodesConfigKey = "nodesConfig"
 const waitingListHeadKey = "waitingList"
 const waitingElementPrefix = "w_"
+const slashedElementPrefix = "s_"
 
 type stakingSC struct {
 	eei                      vm.SystemEI
@@ -44,7 +45,9 @@ type stakingSC struct {
 	stakeValue               *big.Int
 	flagEnableStaking        atomic.Flag
 	flagStakingV2            atomic.Flag
+	flagSlashing             atomic.Flag
 	stakingV2Epoch           uint32
+	slashingEnableEpoch      uint32
 	walletAddressLen         int
 	mutExecution             sync.RWMutex
 	minNodePrice             *big.Int
@@ -125,6 +128,7 @@ func NewStakingSmartContract(
 		endOfEpochAccessAddr:     args.EndOfEpochAccessAddr,
 		enableStakingEpoch:       args.StakingSCConfig.StakeEnableEpoch,
 		stakingV2Epoch:           args.StakingSCConfig.StakingV2Epoch,
+		slashingEnableEpoch:      args.StakingSCConfig.SlashingEnableEpoch,
 		walletAddressLen:         len(args.StakingAccessAddr),
 		minNodePrice:             minStakeValue,
 	}
@@ -774,10 +778,91 @@ func (s *stakingSC) unBond(args *vmcommon.ContractCallInput) vmcommon.ReturnCode
 	return vmcommon.Ok
 }
 
-// backward compatibility
-func (s *stakingSC) slash(_ *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	s.eei.AddReturnMessage("slash function called by not the owners address")
-	return vmcommon.UserError
+// slash jails the provided BLS key and asks the validator system smart contract to take away a part of
+// the owner's stake. A key can be slashed only once for the same round.
+func (s *stakingSC) slash(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !s.flagSlashing.IsSet() {
+		// backward compatibility
+		s.eei.AddReturnMessage("slash function called by not the owners address")
+		return vmcommon.UserError
+	}
+	if !bytes.Equal(args.CallerAddr, s.jailAccessAddr) {
+		s.eei.AddReturnMessage("slash function not allowed to be called by address " + string(args.CallerAddr))
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		s.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 2 {
+		s.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments: expected %d, got %d", 2, len(args.Arguments)))
+		return vmcommon.UserError
+	}
+
+	blsKey := args.Arguments[0]
+	slashedKey := createSlashedElementKey(blsKey, args.Arguments[1])
+	if len(s.eei.GetStorage(slashedKey)) > 0 {
+		s.eei.AddReturnMessage("key was already slashed for the provided round")
+		return vmcommon.UserError
+	}
+
+	stakedData, err := s.getOrCreateRegisteredData(blsKey)
+	if err != nil {
+		s.eei.AddReturnMessage("cannot get or create registered data: error " + err.Error())
+		return vmcommon.UserError
+	}
+	if len(stakedData.RewardAddress) == 0 {
+		s.eei.AddReturnMessage("cannot slash a key that is not registered")
+		return vmcommon.UserError
+	}
+
+	ownerAddress := stakedData.OwnerAddress
+	if len(ownerAddress) == 0 {
+		ownerAddress = stakedData.RewardAddress
+	}
+
+	txData := "slash@" + hex.EncodeToString(ownerAddress) + "@" + hex.EncodeToString(blsKey)
+	vmOutput, err := s.eei.ExecuteOnDestContext(s.stakeAccessAddr, args.RecipientAddr, big.NewInt(0), []byte(txData))
+	if err != nil {
+		s.eei.AddReturnMessage("cannot slash the stake of the owner: error " + err.Error())
+		return vmcommon.UserError
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok || len(vmOutput.ReturnData) == 0 {
+		s.eei.AddReturnMessage("cannot slash the stake of the owner: " + vmOutput.ReturnMessage)
+		return vmcommon.UserError
+	}
+
+	slashedValue := big.NewInt(0).SetBytes(vmOutput.ReturnData[len(vmOutput.ReturnData)-1])
+	if stakedData.SlashValue == nil {
+		stakedData.SlashValue = big.NewInt(0)
+	}
+	stakedData.SlashValue.Add(stakedData.SlashValue, slashedValue)
+
+	if !stakedData.Jailed {
+		stakedData.JailedRound = s.eei.BlockChainHook().CurrentRound()
+		stakedData.JailedNonce = s.eei.BlockChainHook().CurrentNonce()
+		stakedData.Jailed = true
+		stakedData.NumJailed++
+	}
+
+	err = s.saveStakingData(blsKey, stakedData)
+	if err != nil {
+		s.eei.AddReturnMessage("cannot save staking data: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	s.eei.SetStorage(slashedKey, []byte{1})
+	s.eei.Finish(slashedValue.Bytes())
+
+	return vmcommon.Ok
+}
+
+func createSlashedElementKey(blsKey []byte, round []byte) []byte {
+	key := make([]byte, 0, len(slashedElementPrefix)+len(blsKey)+len(round))
+	key = append(key, []byte(slashedElementPrefix)...)
+	key = append(key, blsKey...)
+
+	return append(key, round...)
 }
 
 func (s *stakingSC) isStaked(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
@@ -1640,6 +1725,9 @@ func (s *stakingSC) EpochConfirmed(epoch uint32) {
 
 	s.flagStakingV2.Toggle(epoch >= s.stakingV2Epoch)
 	log.Debug("stakingSC: set owner", "enabled", s.flagStakingV2.IsSet())
+
+	s.flagSlashing.Toggle(epoch >= s.slashingEnableEpoch)
+	log.Debug("stakingSC: slashing", "enabled", s.flagSlashing.IsSet())
 }
 
 // CanUseContract returns true if contract can be used
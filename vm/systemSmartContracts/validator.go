@@ -19,6 +19,7 @@ import (
 )
 
 const unJailedFunds = "unJailFunds"
+const slashedFunds = "slashedFunds"
 const unStakeUnBondPauseKey = "unStakeUnBondPause"
 
 var zero = big.NewInt(0)
@@ -51,6 +52,8 @@ type validatorSC struct {
 	minDeposit            *big.Int
 	mutExecution          sync.RWMutex
 	endOfEpochAddress     []byte
+	slashingPercentage    float64
+	slashedFundsAddress   []byte
 }
 
 // ArgsValidatorSmartContract is the arguments structure to create a new ValidatorSmartContract
@@ -66,6 +69,8 @@ type ArgsValidatorSmartContract struct {
 	EpochNotifier      vm.EpochNotifier
 	EndOfEpochAddress  []byte
 	MinDeposit         string
+
+	SlashedFundsDestinationAddress []byte
 }
 
 // NewValidatorSmartContract creates an validator smart contract
@@ -96,6 +101,9 @@ func NewValidatorSmartContract(
 	if len(args.EndOfEpochAddress) < 1 {
 		return nil, vm.ErrInvalidEndOfEpochAccessAddress
 	}
+	if args.StakingSCConfig.SlashingPercentage < 0 || args.StakingSCConfig.SlashingPercentage > 1 {
+		return nil, fmt.Errorf("%w, value is %v", vm.ErrInvalidSlashingPercentage, args.StakingSCConfig.SlashingPercentage)
+	}
 
 	baseConfig := ValidatorConfig{
 		TotalSupply: big.NewInt(0).Set(args.GenesisTotalSupply),
@@ -143,6 +151,8 @@ func NewValidatorSmartContract(
 		enableDoubleKeyEpoch:  args.StakingSCConfig.DoubleKeyProtectionEnableEpoch,
 		endOfEpochAddress:     args.EndOfEpochAddress,
 		minDeposit:            minDeposit,
+		slashingPercentage:    args.StakingSCConfig.SlashingPercentage,
+		slashedFundsAddress:   args.SlashedFundsDestinationAddress,
 	}
 
 	args.EpochNotifier.RegisterNotifyHandler(reg)
@@ -204,6 +214,8 @@ func (v *validatorSC) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnC
 		return v.getUnStakedTokensList(args)
 	case "reStakeUnStakedNodes":
 		return v.reStakeUnStakedNodes(args)
+	case "slash":
+		return v.slash(args)
 	}
 
 	v.eei.AddReturnMessage("invalid method to call")
@@ -258,6 +270,81 @@ func (v *validatorSC) addToUnJailFunds(value *big.Int) {
 	v.eei.SetStorage([]byte(unJailedFunds), currentValue.Bytes())
 }
 
+func (v *validatorSC) addToSlashedFunds(value *big.Int) {
+	currentValue := big.NewInt(0)
+	storageData := v.eei.GetStorage([]byte(slashedFunds))
+	if len(storageData) > 0 {
+		currentValue.SetBytes(storageData)
+	}
+
+	currentValue.Add(currentValue, value)
+	v.eei.SetStorage([]byte(slashedFunds), currentValue.Bytes())
+}
+
+// slash takes away the configured percentage of the stake of one node from the owner's total stake. The slashed
+// funds are either sent to the configured destination address or burnt - kept forever in the contract, outside
+// any owner's stake. It can only be called by the staking system smart contract and returns the slashed value.
+func (v *validatorSC) slash(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !bytes.Equal(args.CallerAddr, v.stakingSCAddress) {
+		v.eei.AddReturnMessage("slash function not allowed to be called by address " + string(args.CallerAddr))
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		v.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 2 {
+		v.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments: expected %d, got %d", 2, len(args.Arguments)))
+		return vmcommon.UserError
+	}
+
+	ownerAddress := args.Arguments[0]
+	blsKey := args.Arguments[1]
+	registrationData, err := v.getOrCreateRegistrationData(ownerAddress)
+	if err != nil {
+		v.eei.AddReturnMessage(vm.CannotGetOrCreateRegistrationData + err.Error())
+		return vmcommon.UserError
+	}
+	if len(registrationData.RewardAddress) == 0 {
+		v.eei.AddReturnMessage("cannot slash an owner that is not registered")
+		return vmcommon.UserError
+	}
+
+	err = verifyBLSPublicKeys(registrationData, [][]byte{blsKey})
+	if err != nil {
+		v.eei.AddReturnMessage(vm.CannotGetAllBlsKeysFromRegistrationData + err.Error())
+		return vmcommon.UserError
+	}
+
+	nodeStake := big.NewInt(0).Div(registrationData.TotalStakeValue, big.NewInt(int64(len(registrationData.BlsPubKeys))))
+	slashedValue := core.GetPercentageOfValue(nodeStake, v.slashingPercentage)
+	if slashedValue.Cmp(registrationData.TotalStakeValue) > 0 {
+		slashedValue.Set(registrationData.TotalStakeValue)
+	}
+
+	registrationData.TotalStakeValue.Sub(registrationData.TotalStakeValue, slashedValue)
+
+	err = v.saveRegistrationData(ownerAddress, registrationData)
+	if err != nil {
+		v.eei.AddReturnMessage("cannot save registration data: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	if len(v.slashedFundsAddress) > 0 {
+		err = v.eei.Transfer(v.slashedFundsAddress, args.RecipientAddr, slashedValue, nil, 0)
+		if err != nil {
+			v.eei.AddReturnMessage("transfer error on slash function")
+			return vmcommon.UserError
+		}
+	} else {
+		v.addToSlashedFunds(slashedValue)
+	}
+
+	v.eei.Finish(slashedValue.Bytes())
+
+	return vmcommon.Ok
+}
+
 func (v *validatorSC) unJailV1(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	if len(args.Arguments) == 0 {
 		v.eei.AddReturnMessage("invalid number of arguments: expected min 1, got 0")
@@ -1749,12 +1836,6 @@ func (v *validatorSC) getTotalStakedTopUpStakedBlsKeys(args *vmcommon.ContractCa
 	return vmcommon.Ok
 }
 
-func (v *validatorSC) slash(_ *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	// TODO: implement this. It is needed as last component of slashing. Slashing should happen to the funds of the
-	// validator which is running the nodes
-	return vmcommon.Ok
-}
-
 // EpochConfirmed is called whenever a new epoch is confirmed
 func (v *validatorSC) EpochConfirmed(epoch uint32) {
 	v.flagEnableStaking.Toggle(epoch >= v.enableStakingEpoch)
@@ -2526,6 +2526,152 @@ func TestValidatorStakingSC_ExecuteSlashOwnerAddrNotOkShouldErr(t *testing.T) {
 	assert.Equal(t, vmcommon.UserError, retCode)
 }
 
+func TestNewValidatorSmartContract_InvalidSlashingPercentageShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForValidatorSC()
+	args.StakingSCConfig.SlashingPercentage = 1.1
+
+	sc, err := NewValidatorSmartContract(args)
+	assert.Nil(t, sc)
+	assert.True(t, errors.Is(err, vm.ErrInvalidSlashingPercentage))
+
+	args.StakingSCConfig.SlashingPercentage = -0.1
+	sc, err = NewValidatorSmartContract(args)
+	assert.Nil(t, sc)
+	assert.True(t, errors.Is(err, vm.ErrInvalidSlashingPercentage))
+}
+
+func TestValidatorStakingSC_ExecuteSlashBurnsFundsAndJailsKey(t *testing.T) {
+	t.Parallel()
+
+	stakerAddress := []byte("staker")
+	blsKey := []byte("blsKey")
+	eei, stakingSc, validatorSc := createStakingAndValidatorSCsForSlashing(nil)
+
+	eei.SetSCAddress([]byte("validator"))
+	stake(t, validatorSc, big.NewInt(1000), []byte("validator"), stakerAddress, blsKey, big.NewInt(1).Bytes())
+
+	retCode := doSlash(eei, stakingSc, blsKey, 7)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	eei.SetSCAddress([]byte("validator"))
+	registrationData, _ := validatorSc.getOrCreateRegistrationData(stakerAddress)
+	assert.Equal(t, big.NewInt(900), registrationData.TotalStakeValue)
+	assert.Equal(t, big.NewInt(100).Bytes(), eei.GetStorage([]byte(slashedFunds)))
+
+	eei.SetSCAddress([]byte("staking"))
+	stakedData, _ := stakingSc.getOrCreateRegisteredData(blsKey)
+	assert.True(t, stakedData.Jailed)
+	assert.Equal(t, uint32(1), stakedData.NumJailed)
+	assert.Equal(t, big.NewInt(100), stakedData.SlashValue)
+
+	retCode = doSlash(eei, stakingSc, blsKey, 7)
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = doSlash(eei, stakingSc, blsKey, 8)
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	eei.SetSCAddress([]byte("staking"))
+	stakedData, _ = stakingSc.getOrCreateRegisteredData(blsKey)
+	assert.Equal(t, uint32(1), stakedData.NumJailed)
+	assert.Equal(t, big.NewInt(190), stakedData.SlashValue)
+}
+
+func TestValidatorStakingSC_ExecuteSlashSendsFundsToDestination(t *testing.T) {
+	t.Parallel()
+
+	stakerAddress := []byte("staker")
+	blsKey := []byte("blsKey")
+	destination := []byte("destination")
+	eei, stakingSc, validatorSc := createStakingAndValidatorSCsForSlashing(destination)
+
+	eei.SetSCAddress([]byte("validator"))
+	stake(t, validatorSc, big.NewInt(1000), []byte("validator"), stakerAddress, blsKey, big.NewInt(1).Bytes())
+
+	retCode := doSlash(eei, stakingSc, blsKey, 7)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	destinationAccount, exists := eei.outputAccounts[string(destination)]
+	require.True(t, exists)
+	assert.Equal(t, big.NewInt(100), destinationAccount.BalanceDelta)
+
+	eei.SetSCAddress([]byte("validator"))
+	assert.Equal(t, 0, len(eei.GetStorage([]byte(slashedFunds))))
+}
+
+func TestValidatorStakingSC_ExecuteSlashNotEnabledOrWrongCallerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	stakerAddress := []byte("staker")
+	blsKey := []byte("blsKey")
+	eei, stakingSc, validatorSc := createStakingAndValidatorSCsForSlashing(nil)
+
+	eei.SetSCAddress([]byte("validator"))
+	stake(t, validatorSc, big.NewInt(1000), []byte("validator"), stakerAddress, blsKey, big.NewInt(1).Bytes())
+
+	stakingSc.flagSlashing.Unset()
+	retCode := doSlash(eei, stakingSc, blsKey, 7)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, "slash function called by not the owners address", eei.returnMessage)
+
+	stakingSc.flagSlashing.Set()
+	eei.SetSCAddress([]byte("staking"))
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "slash"
+	arguments.CallerAddr = stakerAddress
+	arguments.RecipientAddr = []byte("staking")
+	arguments.Arguments = [][]byte{blsKey, big.NewInt(7).Bytes()}
+	assert.Equal(t, vmcommon.UserError, stakingSc.Execute(arguments))
+
+	eei.SetSCAddress([]byte("validator"))
+	arguments.CallerAddr = stakerAddress
+	arguments.RecipientAddr = []byte("validator")
+	arguments.Arguments = [][]byte{stakerAddress, blsKey}
+	assert.Equal(t, vmcommon.UserError, validatorSc.Execute(arguments))
+
+	retCode = doSlash(eei, stakingSc, []byte("unknownKey"), 7)
+	assert.Equal(t, vmcommon.UserError, retCode)
+}
+
+func createStakingAndValidatorSCsForSlashing(slashedFundsDestination []byte) (*vmContext, *stakingSC, *validatorSC) {
+	atArgParser := parsers.NewCallArgsParser()
+	eei, _ := NewVMContext(&mock.BlockChainHookStub{}, hooks.NewVMCryptoHook(), atArgParser, &mock.AccountsStub{}, &mock.RaterMock{})
+
+	argsStaking := createMockStakingScArguments()
+	argsStaking.StakingSCConfig.GenesisNodePrice = "1000"
+	argsStaking.StakingSCConfig.SlashingPercentage = 0.1
+	argsStaking.Eei = eei
+	stakingSc, _ := NewStakingSmartContract(argsStaking)
+	stakingSc.flagSlashing.Set()
+
+	args := createMockArgumentsForValidatorSC()
+	args.Eei = eei
+	args.StakingSCConfig = argsStaking.StakingSCConfig
+	args.SlashedFundsDestinationAddress = slashedFundsDestination
+	validatorSc, _ := NewValidatorSmartContract(args)
+
+	_ = eei.SetSystemSCContainer(&mock.SystemSCContainerStub{GetCalled: func(key []byte) (contract vm.SystemSmartContract, err error) {
+		if bytes.Equal(key, []byte("validator")) {
+			return validatorSc, nil
+		}
+		return stakingSc, nil
+	}})
+
+	return eei, stakingSc, validatorSc
+}
+
+func doSlash(eei *vmContext, sc *stakingSC, blsKey []byte, round int64) vmcommon.ReturnCode {
+	eei.SetSCAddress([]byte("staking"))
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "slash"
+	arguments.CallerAddr = []byte("jail")
+	arguments.RecipientAddr = []byte("staking")
+	arguments.Arguments = [][]byte{blsKey, big.NewInt(round).Bytes()}
+
+	return sc.Execute(arguments)
+}
+
 func TestValidatorStakingSC_ExecuteUnStakeAndUnBondStake(t *testing.T) {
 	t.Parallel()
 